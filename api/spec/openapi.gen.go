@@ -19,200 +19,266 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x963Ibt9Lgq6C4WxW7lqTsXE5OtH9WkeSEiR3pk2S7TsUuFjQDkrCGgwmAEc3j8ta+",
-	"xr7ePskWGpfBzGBulKg4J/qVWMTg0uhu9L0/jSK2zlhKUilGh59GIlqRNYb/PYoiIsQVuyHpBREZSwVR",
-	"f46JiDjNJGXp6HD0isUkQQvGkR6OYDyyH0xH41HGWUa4pARmxTBsLtWw+nRXK4L0CAQjEBUiJzG63iKp",
-	"fsrlinH6b6yGI0H4LeFqCbnNyOhwJCSn6XL0eTwqDZzHRGKaiPpyF6f/9Xp2cXqCNiuSouBHKMMcr4kk",
-	"HFGBckFiJBni5I+cCAnbw2lEEFsgjCLCJaYpOuYkJqmkOEFqZwgLFJMFTUmMaIouSQTb/276fPp8imYS",
-	"vXp9eYV+O7tC10SvwOSK8A0VBH6mAuEUYc7xVq3Drj+QSIpxw7TfqzG/X7w4/uGbH/7xXkGHSrKGw/93",
-	"Thajw9H0IGLrNUunW7xO/ttBgQAH5vYPjnxInBjofXZwhq2of0fzlKVRAC0u4SZQxFIFEPW/GMFQBTx7",
-	"SslQxAmWBGGUcaaOtkAZE4IIoU7CFuiGbNEaS8IVLOGSDOT1lJEDdBALzPbm5GNGORFzGsC4WSrJknAU",
-	"k5TBrArPErogkq6JgqsgEUtjoXajfjJzeutRPYNasG2hq/Z5fawPT87JghOxaiMdM0TPMkabFY1WKMKp",
-	"D3J2DTiakk1pTRGEoIhYFrjes/Or2dlvRy/HiC4QhSuIFLIzOAp8ZC+qIN4ooSSV/7NA7jGy9BdcG7Y1",
-	"138OHRZIy0DPZxaByQB6f+SUk3h0+HuZB5UWej8eSSoT9W2I/bmJNQ2OxqOPE4mXQk3KaBx9G9HR+8/j",
-	"0VF0c8o548188yi6QbyRSRL1cf0jmBN5f+s+qp6pdKybXY5zoW9z6EEKAoV/VjlRmPlEmVltJsm6znYq",
-	"J/SXqJ5T77n/MUsLB45a+r12abckDQDoykNTxWIWNNLPF4wPYj78Mi9NU53153yN0wknOMbXCUFHl8ez",
-	"GZLko1Sc9JbGwB/jmKrhOEE0XTC+hnXHjhNgIaiQsDHvxZopIlJYdksSdTzFq/I0JlxInMaWQ8IWkVxh",
-	"iVgU5ZwH6W48ApLkc80jFpQEsPoss5vUKxdjgzP6MJzTOIyRs5Nu0qhOZOAOSFTCl8/j0Y9YRqsCSI3U",
-	"UIhDZ7OTY3StPvOBa5hiG6HMzZj+BFPfV3+aKVbzaKfhtH3pqPZ5t/AI0PqxDq1GvtIkePxyefYbEg8j",
-	"fRzfXfqA7dL7FEFKV6vBV8YklpKzxejw90+1HffHMj1v5Z5Hn98Pwju7uTbEG/hQFZ8es3RBlzkH6haX",
-	"eZYxLkmIW6RGoNbMTP94TQQSGYkUf3Bg96V6NTTMN4VeSviqQQB/E0zXAYXkBeNoLdh8HbMI4TRGt9H/",
-	"EPHkw0ai2wixNNlO0Znebgm7E8XI2QKleE0ObnGSE5RhyoWSAQkniOBoBT8W3FUo+VltA+FrluvjiFzP",
-	"zRYLwrVaUT7lFCnJSy9g5EqcgkCHRB6tLCifpFryi7HEihrzSOaciKdjxHhJl/E+8gXQgvF6GAO6DrXP",
-	"YW9dptj8STFBeWZBlwqOc5ws53A2MRctGGM3H2FBkCCpoJLeEsN1hEYOA2ajtiZLxqlcrUWBOQZdckGU",
-	"AI7UFuDvRuEt8xZHvHUhuaqR8W0m2ZLjbEWj+TWFF3u+JnLF4ns81YptqvhPBbpmeRpbLaB4xi0Bnabx",
-	"5LUgHG1WzHJadfoyhg06bkxFluBtkKzrCrNHC6xERHoTZjJUkKrduYObp3HCu1Xo/AlOlzlekpDC3YWX",
-	"5hCh87EorACVGIVjDUbtttdk35KKPaJqOfh9dnk2ff7PZ8+/mXz3PviUaeExAGXkv7fVZfVXGoZUeKAb",
-	"Izol0zH6sJHz22j+QajnlqMkzua30RSdkIxoSZOl/kRAmmP4S/X6FjkHJkQSslZQ1sezG9FGmDRGT5iR",
-	"NZPtU5RhLmmUJ5hrPqiRwLvgV0f/sivA154QbXgmkAFziFP+PghJxuOQDOyoTyvKiisDt9bcSBOf4vGw",
-	"x7XlyzCZ+r8tEiuWJ7Hix2Yzhd79FicJkcPoCgQiUIkrTKPQKc5LD1obpp+ryZQaVDzDCrXLSkC/N1hJ",
-	"ZLC3J+Jpn1c4+KY0GDXakVkbNfTLZxamou39V+wBxvh41o4ct5EMU3pACjCkHhP1cmBZQnUwRh575Fam",
-	"95WUmTg8OFCvs+Q4uiF8SolcTBlfHsQsOljJdXIQc7yQE/X3CcO5XE30Dia30eTZ807lynAMT7brlM0s",
-	"URfv/LRV8NPqYkXuOykehLLEdY2jmyVXD9Q8Yom2rtQuIGERTkjDT0vWhegv1RilouJ1eBKloLcsn/Mk",
-	"8PfPIRjaczYAqBE+MyOV/kyFZHx7giWuo1zrcMRJxokALlthmE7kXenh5gk2TLlV6Q0p8j5xhU2E3gTA",
-	"qxoULCcJROWHUAxjiqDIGecAlgEOcuoGoBMsSaNBRMGoYQoL8PYJQk/IrJf1JONsQRMyvyVcBA1LZppz",
-	"PQ6ZcWEDLcepwFGjIeaq+L2XQaaMDu6kgWsOspUKrjrrwXAm0te8M9Cwsz8Fps28Zh4KX37Xyl9Vn1UC",
-	"PFFDC8u9NpcYszp6uyKpe9LKPrGxL6cVvyqpCadbbfL3FzQj7ftefCJKzjDDaLpo3970nKSg/5Qh3NOa",
-	"cVp82yLxvvBk2hLv06Br9EAYkaprW7+8vQJpqYHrD7XE7WCE62V+w1FEMglsrMEbVRamSrYK7asR+bVQ",
-	"p0llsq36pkqmNY0QBTJoQ1zp1UEpk4gTmfO0AfiP9sJue2GXcbAiR79voRIfqqVdLkrkE7R1DDf4B7Re",
-	"nNYnL/QFrREhmkZJHhNh1Skc3aRsk5B4CfKKz9N7CbslYL4P0+/OBs0mo2ub1GQEr7ph/aKHwy4ws9W2",
-	"g/c2EHW+wFvtlmis3ztoacHohCwI5yRGTorzJpyiK7CCgHKv/kdDs7CyWnaL6KJBq91ggfIUHH6SIbpe",
-	"k5hiSZKtBkuLrZaKVoZrlycR2Py8lTdUruBndzbvx9M0zhhN5RDRrp0wqti9O52clkSBoLHB4/e+aUc9",
-	"hVaQqBvQWiKbkmWAE749RThZFibgAdPX/cJpFF6BpNH9rPBhc9MHXBgJmi4TgrL8OqERPHxYyZS/vP1V",
-	"49bOe6ggjtrQGECrj9+KPd6d3wfitHiN2jFIGwc3KwJib4efqJBZA44mJUA3cm8wj7JMfXb18jKEj729",
-	"GUFnktqLwq7fL14cf//d83+89/fq+TSeKATXKz21g//53jOaG0Nk17ksO1GMiaQRi6scDTHeAg0QHH95",
-	"e2W38MP7gep9Gj0QvBS5/kfAyxxuXlBsFVw/MpYQnJpnSOt78Fq2U4eZUFuYvDgWn1h85DfW1jCTQTN9",
-	"N+4plNz6EVpW9pYCZnZL+DYIR3U36ihkwTjxJRFQXHQ4DvGnuyFbUXetIqPc1be7wIkw+7UzH/0LRSsm",
-	"iAMjtYE/5Z3DUowrBcnjtdf6UupxcSGO0UAY4fvvyZ7vxdR7KbHMRasALGBI/akW7tMGLP/U8SyZCczw",
-	"4KkvS0OGHussk02RUtq1oL4FpbUkhJeP2e8sXUdQW+l5ihOScRJhSeJjts6YIGezk+Nvj2dVfcWOGh0C",
-	"KVaOWcwyRa8FQQd6hQNjuxQHn8z/zU4+u/9/ow2Vnw+Ubsu1yC0OALuwJBP15k8ivakpKmwe+k8KkGar",
-	"rQBt044u8AapUydEkqqbGLz7ik9EuZBsbQKrQ74pGs8lWWdJ2Dh8EjA82eFqt2meJEo9sHCtux9vCec0",
-	"JvMmK/KZGWCC8VomdUzEm9XEj8zjoPJkp/Y2bwNOYhr3WyojXMlZc3WkSCq2RGMclvLP9VCkh6JiaJ+V",
-	"PPNbD6QOXOTpx2iF0yUphdIfs5j0MC4T/S1IF7lcIXjaF5ytbYgkOOQCQUWUpHKOhVB/Yw0x4vpZgbfJ",
-	"OrflhilBQIyRIBnm2MggGL0b/e93IxStsCIowrVGuaBcSBAcqPACuxGWkii+qPj7L2+v9IOlTVEtI8/Z",
-	"uRodtohVDtQQDH6prchGWtCxLkWQay5XOj5dktIesiyxkbgmYiWUXYKevDm+fKoPztJk60lp7n1+N8p5",
-	"ekiJXByCHVscwv0c6pUmbvsTtf3DDxs5sb8UcHg30qkeaQw79QKFzH7XuZDlw+SabSkEQ19Pn6GjYrbJ",
-	"j1gd/1h/elR8pQ6mAdQG8KAzTs81OwEMfXN8qc3FHrcNxztkc7WnHs+QG+k9RZ1E1PNdapmnySzuxLv1",
-	"XcmyMRdpf3k58qO5w46XH4b1g/cwX9rMvLvWENbg/L9LOOarPJE0S2oyPDam7kDA5TwOursvDEjgks85",
-	"mdjjKxJSd/wiYZtpgfOXhN/SiCAcSYGwQGfn8OVGy+YeYxHND40X4Qg7I0aXCxEepmtkf7enN9oKYJ8O",
-	"a/NeVW1jhODLFRbGiVF49fBC6njNiAixyJNki3CkQACYXc0J6pQpjFTV5drq8YxW4z1b8h+8S/d/aPcT",
-	"Ws9KyGVxolhqxbUkvLCqiKWCxoSrC9fzKB3IOkBGsRIyJV2Tji3Y0JDG08CAjlAHI/GFne7mx5Ck6Hlz",
-	"0WZFE1JGgoiB6Vzb66go8XaXqjW25mkjdxtTNtC0fnFzxTQtcQYEVRG2+lke1JN13EGF7LnCcYHXD8Sj",
-	"9q5dfFm0UCgjATy2PzrLjpI8KEnAXVJMcqkViCm6tPZUg2Y0XfbjXqH93KdyFFpg/3qSt+qfoDI9HA3b",
-	"R0TTag/dyn5ooh30dyH6dPa4/gJdhakbaiQCbRSfuKFpDKGZ+oV1Pj0IpGNoSW/Brffm+LJVNjf7n7tA",
-	"MhM1WF789cVL38sOBzKfQu6hJ05gGyGMrvANEUg90woaEUEKYY0CMt+QJLlJ2cYFNRRBO2CyvGZKJG7Z",
-	"pGZR1ckwh7RIa70EU2rq+ULtdblTqJNtaJI47VVzvYaRNHUxBxlJaTxxFiE77PDgoA3ebqd9kty1CHiw",
-	"YglwR0/FBGwzqlxx+KhEDa8vXoZ30vIQVZMc7vwk9cpdGPiCBjSUJcepbNDnDWVEOHXWc3PH8JUO3URy",
-	"xVm+XFUC0oyXvRjoScBgEtByj6/KpeWKE5DWUbIEgJ4HKR4gN0uSgQhD0nwNVvMSO1CDR+MGiwBsS5sB",
-	"Mk4m2OkZ+rP3HQp0EP1MMhaEJoVcRwaaivhYhv/IiTV3GF+Cjf2zBpNrqv0Z6s2ZmIgB3/CgIGI5gIsO",
-	"qK8nGcJAGuSjRIJIlGcozmHHGSe3lOXCgNL6Owx1KO5DbyFCUR/ND6TXlzxG1HhXTLCH+rdxqBRhDlW7",
-	"h+Hn9vgBEGkDkoW4F8cIG5nW63TQFJVUZa0uLhK20eJT4JIVqNvCGl0sY5g2XAyO45CA5OYS4RjkYwac",
-	"QOmrRhzXSG8EAWvsrmC5jYtBJ2SB80Q/StVyFJ2VIdz+4HfRb2N+lFyd8sBM7zTa8v40Ux/mt8wF4fOM",
-	"tnkte1oEejk3K4c3d4+twx8rOHB0PvsN4YSpby1N2Uo6ptJMCnGHPj4Z8KitjEIyoH6N3GMcu9e42U27",
-	"SPBSeFZIexAlnKR+NBMC/dBMrLhOkWXUQy4MS227iX7DZb6/gqxXtlb19Zcdgr+sSdqmqZAEx1P05Rm8",
-	"7vmAf7bN7FF4fxTe6/aFqNP0/UVL8+F082Zz7X3T9H1YfO95TzsYyqZ3sxrvD6i7GJ7veTd/Tdv1ozL7",
-	"qMw+KrOPyuyjMvu3VmbvqsV252f2UWObklOgotPce8uDioeNgQyL497DYzhzwR4zLBQZJ+RWvVV+MkSF",
-	"QbPA5HDrhQcPlJGfr67O0U+nV8Dr4R8XJKYcfH16WYHWUKxHZ4X+14XGIE+gt4wdlDoFQIWcutqSeo5B",
-	"D5QrQjlas2tFum+dQhvODvsY9riXwGLZr6cUm0BTzkliBJ4FSgmJG3JVLUkH3HNlitFg+4mkRIfsnV2d",
-	"o0zrTA623Rk2QcwY12ODmhB2F3x/c24LT5Sx1OcnRbL7C5pIwnuUmWn7GDKwQwNmcZDRZjm3TpfwcxGw",
-	"Ar00iSBGwPNfDV1+Rfi5DKboUGFjAIT8WaufkqE3hLs6Dn0fhCb2ZADedle3ZrnQbfncqcU+5pniAsQz",
-	"O+mOSAtOZz5+33i2RlxUJ1Eo6JVQCEaAFTzWPHCtQeANtfsundpn1HQlUy1MmGZAl2gP0GgNEqIp+rAR",
-	"TzQQnyLG0QfB0iR+omd6aswmYoe83L0GYO09+um4DmYEtUQCqog2WHbZRsroYzIwyoQWwLC+TDE8+50T",
-	"P6KVesnSZQjYK5zgdAmiO45j4ur1QU2DJhMWDubCXa2Ielydvq6nUCoQW1OpWJrYCknWCAoTgN3PvJQd",
-	"prIitadfDY8iUQVq5q1x6PU8gb8POLfmiPoRfwVB02EQvL6YWQjUPynSYcMQ0tH0JP76u++e/+Dn07IF",
-	"OpmdoCdGoGBFTZ6T2cnTLmg246dFsp4o6iqS1Fh/tJEtHRXoAhVF5BD5I8eJQNFGTtElXaZK9Xh7pZRU",
-	"V0oDSru5choN2cmDV/zgrfjL8BWhJGE2dFH91RS9pOkNiRFUzQIgdizf6Toplmre0lRXXrkMVN/QS6vP",
-	"p+g451zXApD11IZioCKXrz5s5FfdgqS3Oe+pdvjTNyP7pSm0Vk1mlnNJPsqGumm0w6IEMpirFomBZLUL",
-	"yNNNlFLgFURI2JIFUrJnLvavHRxqUx4c4Fj9qrVBSse5q4jUJK6Abq2QyKv366s/Xk0lpbnlNImNJ4Nx",
-	"EraXoCcXL47/8f23PzzVCqdmPfCRMV5qZc+ECRoHIOj85fnANjhtylCiYZHb/CpIxEn4omv2pGZLzgCJ",
-	"2b+18gp+Rkx1f3Yt746rF9eTxZ5zkmHeXdmlkFLNF6GK6XuoL29WK5b5EYeDupoU5IF14PQ0464q9Q1g",
-	"GwZ08BQrBn3UoMh0XYF2NQOLL1tPh4cU7C8fqCULq9NI+6bIF1SqjbbhvBtFLCbvRu3W1HuiwVBmWK/r",
-	"ux9U6DbM9cCFxqIxJWRozgLSrPgrUWHGZa5LmuvxVFti8QLD20i/ytG8CpJqPn0vcymTkLFKS6uuxh8k",
-	"KmpnxNXVy3A1siwXKxLPg3sdDp3zo4t2mPRiWFD5zVjvCMqziK3rxn3eVlWnZrteJGwziNC1hGLNHvGL",
-	"hG1Az2y1n7hLHjeh2djx2oZb7U9xw6yBtSdFy3iJsVTs8hr1IM8e7+S9PmEB6A18p4KwggOHjMPlYUiN",
-	"0+mrIb4TU5JG+jrDau07NejdyLirjCczdmZz4+IMInwwr+VEk5JuSmY8+Z5ZrHBtQxuBQXXod6/KucLA",
-	"cBqqWP4Mvxpf+iAIOKvu/G51Si/sPF0FSxvqHxeF5SHOoBtCO77ZevlxBa8q8G2jB0DqXbnHBRF50k9c",
-	"69VdaB81MQscreH+X6Xs5RgU9XnTCbVyWS3yG6YOyQNNO64uXp8iuvBjMU1x1y2RCN9iCuYRu3Fjqz87",
-	"t51AdbgMWMas17cIYpXMVEmsFq+18UeVAuEuJuFJqPShesGf9iipFPkM3wHEB6OFRhtxGPzuTx7tXrQy",
-	"tkNWpRgor3tbbVmrt78p0CKiqeZe2ZSzJhIDohRNjDzjVc8OEVUnJGOLP7MnUKCFRGG4251F9zhXCQdr",
-	"N9IX/XKxCimmfZTqXKwqqpP5uFli+7LU6aaiJk0tiX2Id8BtAPhJPFyHhc96661tRapN7e80X19D2BCW",
-	"1Z4Rrli1kUes+fH1xcyvXw0lRTNmaMmoiboWj/9FUfpaIENJMRURJ35RzWBxn+tc6udCbjMa4STZ6qj/",
-	"BKsVE2irwyV6QqbL6RhdE7khJEXfQUzKP549sxt92tSwV+utQfN09RCgYSpo6xjWUEUiF7rPBNRGg9cO",
-	"QCZcRdZJLqANMOHE1C+v1PYtBcXUwwzDYXSd+o5/1FIb5Ap+NyFmX+fABVlSIQkHu4QuSdTRaLeoj+RC",
-	"MtUUJvQY2uMOb8R7qavf6q6reg4IPtLQCdfuVaN27enqjbP4rFd1zsWYXOfLZXjxrpbAnUC9w+00Mv32",
-	"e2m2Y2sbfDiQoAJAU4AeGmmxUliu1jMNSypcwSSNJ+DMMLG9JWJoyzMJUvjri5d2CxAauSHXKMNL4nXo",
-	"rRcN7lArQe6JZJuiZ0UOx3J1bstWaDsWfI8ywrLElRynClpO2NDLjz2eSNaYJgjHMYeOfcMiVIvg+LZd",
-	"F+hQDosvF0FTjC5J2MYF67uoQVuPTRyiegj7GO0SwT7smB82N6KpatpXQr+Ib8k1+pVs0SWRKGZRDuqW",
-	"6WpnerX7/Qgj+3ERFRBuaKbW7sRB+yhYZ3AU3NqTX97++rS0wV22Vm6b1bk1IyKYR0s9ZuB7tUETLfSQ",
-	"sYRG234LgMlT6Fj+VZlTZJze4miL9HTF3VTSr2zXy5hkCdvCCMaXOC0ivJNEd5rMBRFjxAlAbAzyghJJ",
-	"EiaIQBnhAiIAIQQ8rB/rUFd1sDaqscRgx+tEtJnjARUIFomfoGQDSTllo042HikOo4WSA6cf1ZcyAOqE",
-	"H+EUQuzNXxvcHgFmMJyQG3IBLgM9YkSGIzIpambaQuBer8Dmo9T6w3QmkQq2kBvMw5FvRyhP6R95qe+q",
-	"wX4QX9Hr17OTp9DzHYJhTAS32VTR7Z1xZNfRxC1WhLvo5rLwZOAONFVSbi1u2Yn0extvU7w2Two3okKD",
-	"WdYdtbFP2ZFtTRY4cBnti224kXCWdz5AG1yZcBvOX6K9I+uGUDBnqnUlRkN1N93mtO2pDXdTlpIxKkUd",
-	"zJXsX/3bNRY0mqLfWEpc7pNaxfBmPVigJyloNQhnmRjbkHf1j6eWw+MUjG0rfAuFWzmRwmWoHAYXDcNM",
-	"3JkhS8LXYK0WJvXcseTK3VY4tM7S4jiSOZjwdMC9WNHMaW8lQc/Ubi/NVh4AxkKhqdWynfIT2h591yIT",
-	"30ms7qxbCuFBBZkVljLIRjAZdlUpvCNkJ1gStqPjoJtAl1WLgxXnrpT6jqVBRF/iK4h7g0Xdk+N3svoi",
-	"VYMimikIPP2z0eVdRWE/xwYSVIsqBXaT5brGLMRSOnfVWgSw8Ur0t9puoidQj8YzJVNQ82fFRfRPrVf1",
-	"qDY9qk2PatOj2vSoNj2qTY9q06Pa9Kg2/e3VplLsRD32vqRFtOJZWYJ636GQDXZ09InK6tE1sEj+fexA",
-	"GUoHDvV97Af8nt7yS8n4Tj16hGR8cIMeFodD8Fvj8x8uetiLVnAlfAzQ2+F0R2AP6MGyC9hbuqF0HW9Y",
-	"VPPrLMaSVNNSG5Gpdbhz1AvJ80gz8Fx9oE7/5rixtV0RcBbMt797lq2XA9CwQrkjW3eQTDFb7dtx+TyB",
-	"3Xs42g7+nnf4Rte8J+cFPpC4J0+w9fJ1WahacRv1amY0nT427nps3PXFN+4KlXQL5RygCpYPLGnzWkmL",
-	"hii6uES4xpwh/k66vTv9dwfR7coAelYZdlnnJbG69JFX580rg2ffEldxCSyrEeHARfxY8W1GEBamXA3U",
-	"hLs0BpLvps+nzwHXa5XjmFwRvqHQaldbG+ulTMcN036vxvx+8eL4h29++Mf7UM3S/cRtVgts6My05nzG",
-	"kD3GWS4ql20+GGI+acg7KhUpi7trORUCnNtDLRWpG8P7kgrhdLH1qnquSHTTlIqhBwcD7D19aIFpknOC",
-	"IjUVMjgdqm9CoptQbRP1FZyzOQav/hkEu6E1EQIvyc6VQN54Y5pZdVXFhYPYnQUX8m+uBeC9Q+2rk3RV",
-	"RPJuzN/dsPZVD1O7qGdNnyoE/KI+DbkbLZcwrLBW09qtJX9uq7Sz74o/91RC53Mz1PpUoWkFXJ/n2HGY",
-	"UmaP6MJjRVX9qyO0EWVb5kzjgQaCxM/A6cOBSzU7/zI8uJVv1qizCSZ3AG0XmyyBtR3BBrEpfw+OUZVr",
-	"GQbl8mIze2O4dQG92FLrlezCMkNw6MM0/V0NZpvw0xfAN0OHvwP8hvLOAbi9E/NsItdu9hk8VW/IvCVJ",
-	"8mvKNulZRtLZiU6/62hQ3P1NNdnJNBosjzDABQELC2I8JUo7B/MF5D7NTs53Lxji9RA5O/9K+OaGkrXk",
-	"tC1a6BrLaOVnsPdar5Zs+ZWoVypy69o0ppdar8yFtvaspMwEAjzRivOro385u1fGuByjDMsV/PRHTvjW",
-	"03wLRPNL7Y0bMkFjRnSSsbEQwbDm/Q5p8lHJGS2Kv56X7rSf+bWEQqJIy/w83rk/bShLvDlV1jcfmGtj",
-	"JY8WhKAYlTjFa3LgVSYbm3prBEcrHXcHWWt177vZWmGuqxUrsAeKp109bnfF1ofH0w6sKuDTmobcq4Z7",
-	"ywVzInOelquL+mv71qW0bnp1Rihb7d1wOa/XgS4Iz9WVa4upWsysXyfWWDvRC4vtAiclv2G4421T/96r",
-	"huvuimC9UwWPNi9lhYh1mYF74behmgX3hMrjffHc1j2Hy8yILMHbXq2USvynyrbMRKh4arWFtL5xaKji",
-	"LKdKr86NwtJL3vHMBmbv7aGfbcQOAYj6mKXwKsuB4el3r/5PEJR2ta1FWFHo5+aXQ+hvtSzVKNkZV3/z",
-	"ZvnikTS82R4eFH2rOGXpds1yMdeBa50XbFm6xy4DDTlsvA2uNNoAdouDXT907rtcsVwqjLbh9tpjZhlv",
-	"O8v1w9oGiKInOqDNerku/OC4VoiWAyTvjzZK894jeWgb/P3t83dTnfV9MFSSCuv63HG3EOE4t3kijbGc",
-	"tscSRsLVVTbU+svbq4Kp1gnKpaB4pWmxMI0QegQSDtFyNB20olNz9Nid7qwtjFF4ci2EklJRi2g8KWjv",
-	"3ShlqSmzuUNxnl666hCfj5qcpgumg5kgJwKqJKwxTUaHoxVJEva/JM+FvE5YNI3J7Wg80gk5oyv15x8T",
-	"FiFJ8HoKbcXgI8XQDw8Oyp/VlJric1CSDUf2dAOnnCjG7xspjL/97TfH6M3x5Oh85vcm0pD59g1UjZQs",
-	"Yn4biANrLfC95fq7okNQQiNibCnmpEcZjlZk8vX0We2Qm81miuHnKePLA/OtOHg5Oz797fJUfTOVH7Xl",
-	"wzd0UAjv9CjKtmSFKAftONLBNqNnU7UweENIijM6Ohx9M30Ge1EPI6DQgTmfZxQ/EC4aKGPN0UrCB3kR",
-	"g6TEJmy7qYzOmZDFXoWJ1HEFUX5k8dZiENFU7QV1HHwQWqjWMlOXRNUe9PP582fv3YDTff3s2aDFKwrm",
-	"5xpmnv0KRCfy9RrzbRek6jQ1dtex5CzPxMEn+O/s5HPgfg4+6f/OTj6rzS1DqWUXRHJKbk1YTY/7+okE",
-	"ryvzSpD/3tDX8Ce1VVMSk6q/KxwriN6cZORbinVt+xqAC+Nn/d3RJw4vIYpf+6/x/sGRoseltKGGx4DE",
-	"gWn4WIiXOnbIxuiE6ffUfBTsSleNoXQ1dOvIYudpCQbdB513LnsPpL7j+uYF7YMFu13CENzIdO3ACQhV",
-	"EyVtAZb8e+JVfA4jiKk6aIWoYDVzX3Lz2iWVyi4H3gM9c0ON7n1gS6/y4HvGmH4Fk/tgTd9a8zvhSSlq",
-	"o+HpN2lMLnjQY1+uGbkXZlbu22ta8xpHSLnpXxOqlOok7xNBinUeCBuqNT0H3X+pevTuNz0Bv8793TdM",
-	"VymfuuPF17s57PH2q4vdAwrs1lCj0d/ZHzeqDqtBGJKLVUWW6Hwtajhi0ub8mvuQbQ7CcKnbqjZKlRiY",
-	"F2VSQYuGopj7QoyOGpzNGNJ1TY2VTYdclJCMD5P6ILFF3FXm68r+2cdVtK+5Z27dkQ/UhzB3gfwQXDCx",
-	"5mRStjN34IMN/hWNAeq5F5FfxoIeIfb7QITOZfeMC93x0n3QoT/gO5DAZEiJg08ub+qz/i32nnjRZh3I",
-	"ed08C0/ziioOs61ffTHYjv1ZDx3dEfADTateEKczJpuK99db09jbgGUHn1zlbDpHcoc32SpLHSAOhNS3",
-	"mlxsR50mS4ifRzfAFNKFW5/KOXllqxR8COymh7GoOMD0Pk8w7ljObLx9zSLjcJAVKcxlZ9Uuwg1G0kpr",
-	"zn1JNaEOtX+KZRQ2gqK+Qmo/dCy9inB6QSY4jSc2Y3piVY9HPG0Q4j1Pst+gX8n1s6CPxfeHUIhbtGXZ",
-	"y/HkItDt//XFS69yhs0h89dV21FaYklS8jvq16nJJrf74XKACZbx7ou0ql3xH0Qkqaxqjuot3k2J/h0j",
-	"M0Howbp/EnVkyWgcPZLk34gk/w60OEgpqFDhYOprJbrphiTJ5CZlm/SAZSSlvn4wKWIknZaQcRLpRu8a",
-	"e8N6g50Kwgjqt34GP5fv3AYdjPZ4DT1i+YeI7m+OL9Hs5DwQvP/lSO7jpmUKhnTPTEuhnuLaB05/bVQz",
-	"m/INDIBteUTb2FcxG103zxV0q0bl+XVNKzhH48ip5l2O685+tgAziBwsgFZtSXuHS7oKFbdtWtcvgXWH",
-	"NY+QS3RCMeGVLoYsJshFutjoLgEbTJvb0IxNeTrzZYzwUr0uEiVYthyIxWReZF3d8VSmZAjseYOLegf6",
-	"jPpkbrF+Wyrqhw2802ARElthUnv9c0H4BC9NBd9SQVC/FKUzn2ec3FKWi2SLiJBYVxWMTQx905KmQLFX",
-	"gaRUfTDjDOiLcZ1ytMY3dnhj758wRRS1NocDS8cv2tZMmuI7FtQFJochSIpYhv/Ibe2cUlllV0l5jamO",
-	"Hta9if2Cd9bBhdMYRThJrnF0o6WqIOhdZ0VZVHM29SrN7RpIe4igpixjg16gCFq+/Pns9csTJ5WZZNNb",
-	"U6I44kyIiaCy2O2C8SXRJqYgIF2FiN6APE0VkcRFUH1z6kfE0luyFSZ9Q//Nq9HsGfDUv3VtM7TBpqIh",
-	"u1Y3MUWv8kTSLGlcxJNSNTVsFTqB6DEvOyHdFZYujKa6Ux9boLVdqmKtCYEuXKdlECh14OBXwkQeKtki",
-	"JZG0IbKvL17q+zf/hnLaNvY9piJitxDSbqgYeJ0kfE1T4gH0KwWiDF/ThEIyg8JfV3Z0ii5Oj89evTr9",
-	"7eT0REHCxWP7JfpaadGWpNPiz440CfbuFbgJC0x4dfQvOK4ix6IrmaU9jSOZpGv6b+Io6SuByMeMcGg8",
-	"ew+ng2pFK90fe1CYGjBek6vkd2V1+SLm2mxFXPJR2tK8FY2O8Ck6MlMVXSD90j5FmfEMC6Fr6pj2r0Yd",
-	"BNXC7xvnXvxCrywgbyK4eTXOxy8jpFaCT8wMutiM2WaJkdVPc1WsCxWxJL4BnZUp9s9yW0XUVrCxjV+X",
-	"OVZSIdEbYJwuaap+NmehpiUAH6OI5UmsuAJOEZZSceqG+/U3v9MVe7kYuhupK7OuQ41xqbquOka1fnDo",
-	"+WipVdZRqIzGE50Qo/88sXwCXyfElCx7N7LZn0QoadfKle9G9Zw+xzKhkNPPV1fnl+ga6pK9vngZblT4",
-	"zivpDxXRWpouurQanHCC460unGsqwBUtKgBRi8rDtrw+1aWguQmnrHynsEKP/H//5/8KVGjAKGFFynqr",
-	"pD3XoBwNCR/95tnXLYrsx8lms5ksGF9Pcp4Q/ZaWNdtwndBw9a+QAKLrjpOUuBqA7VgW+Bo0ItPPAdpe",
-	"JluEF4AWgNrGzaYEJirp0hqFOBU36hlNCL5pqL8dLrnlipnRhUEhGFhCSCXTm1x6i5xedkVdVoWzkY84",
-	"simjA3q2VyuM2PpyXU6OFyxP44oVAawGXSF6RUFhp1ZX8+2b/fhXbTnq+q5EIdp4LiYFR5YGPnbZuors",
-	"s4yz2wKRTtN4ApX68gxUCK8cBORJQiwCOtJy/JXplu/10QBGrSfVxYvq+vvDBH5VVnkgE2FtVWciHJdn",
-	"3cigh82haLf9CjCvJRYsgHR90G2mESoq45GNQ9dZsZWKhDqvKXzZe7/nB7/iB7zdvvdK4+yeDcT3bA5+",
-	"8/WjQfg/xSDsZ4I/GBs5ihTyJiRekjVJ9xV/dhTdtDKRbwPG7xsl+Hx7j9h8FN2UezIHcBcGhDiGn7Pe",
-	"zjMyzJtvz7UsS2ObJBJuUK6NXcnWlnGuqQA4jdGSSFFt/F60swG1yrPyYFHvam5bmHuGAjtfbeF250Gw",
-	"NfmwmMbBQn7Pmr8109t/uNltSGnrRldKoAFcye1w+GU4SDq22dgyZwfHR2vLib+vHcuZm75kG1Zrn7Mw",
-	"VfwHO6Paq4IEI97b/b3het9huHb4rfraPh4dU+EWAatgkY8vzGXQ2KCnoerYX87j024Yq4ZClLp0lZ/Z",
-	"kPmsLj8/v9fsrZoY1ywvH+smyFpU/y5QDFU/sr8xiY50x0gY+vybxiZ26DSVVG7RFWPoJeZLAh98/UOA",
-	"mTCGXuF0a+EuQnK7Ps8uhkRje/Nl+Vq6pRoQhtXeZF4az0GdC2iGJ8ZuWJQ/NZqgV0MHrLmZ5nqOpTnj",
-	"fyHuvjnXkw1hyZfSPclhpQZKtTJum+MF2wRkTcezOyq2zVJom7xmHNRzW+LFL2grGkoDd5NUICPxMlfs",
-	"Q+3yu9DPL3TR72olEyMwifx6TetGd6usMV865ixfrtCb48sqht5mPobal6c5gExRgB0F0F/hNE501zxb",
-	"PrcIRlX81a9CoJ9Gpt6inCCWmyIFLnCtIQ1ZaYMXdmsdRhyvB1lRCsFL5WsKNrqbTce6LdtCO3YvhPLN",
-	"syB3MwAJ8CgPWC38yJFFq13I7+UK96crpYN2gJX+z4lYmZ+ti9AZj6qqsb4Z3z+7wsJoukoZA9eWyGHJ",
-	"RZ40IHcYQ4CW98cmW1Re6zUbW7dZ4XsGl6rHMG2Jq0ZPoMKbPEkU37GIEtRI+6gYAOy6t+1O685dse6Q",
-	"vs63mWRLjrOV7Y2L05itS61SPZ3Psm7SrF2U2+h7Yn3nbouKnb31j3rf6AZtpFcjrhJa2C+AxfXZfrs+",
-	"WUO5d6UPag5b88TFHcYR00OWclvG0IJImxwi7Sjs3Lv8OBgkemn9XcjF7EnFZ4tFL4StyMgePrzv/2Df",
-	"k6FYMTRgUF2pCM5CXSkijWNUGLxrDL9UYLSd67d6n2wr68cMoNprqwEjSp2/ceoVtzRM37H3N8eXjaw2",
-	"JN/oBbQ9f09ek2Cb5RYvyvP9rtxTC3y2z110OnA6KM9OaRDBXV+YAu3jWU6+q1Z5KRqBhPVEaMfxqCU+",
-	"aoldWuL1tlAC/bzAcvaitoCVAojgRQ6rjV6zlmaM/iQ/QqnMBNO1p0yW0dhWX5x5X0I1tT3Ur4Cd+PUr",
-	"/GKPua2uu0NZ0S4wL4k0tZQLNccY4I0CXuv6GuqK0/4Yn4D1u6jmFH4X1Z0MjyRwFzy8DoXu9tQtS5xY",
-	"472Dol8uZG9CxZvKauj2AcSKer2Jas+5fRWcCPZI3HeZnqZ+er2q81Q7LPbgQvtPff/7IqtLqqZx5PHs",
-	"h0gcf3P+ENhaWXIQsj74e9sP0/1V7oEh/yko/mewY1+Y2ys/rrVgfBCOHGzRN4AnZ2XwhHBVfQb6rsaw",
-	"ouT+4cFBwiKcrJiQh/989v2zkboQM0UVJ7QBf6KthDFas5gkFUdqNYdoVMcsu6+e87hjBAz92ne/IjiR",
-	"K2Q7nprv9F/1Hz+///z/AwAA//8nT9Phxx8BAA==",
+	"H4sIAAAAAAAC/+y963LcNvIo/iqo+f+rYteZGdm57Ubny1EkZaOsHWsl2a5frV1TEImZQcwhGACUPJvy",
+	"r85rnNc7T3IK3QAIkuBlRpKT3eiTrSGJS6O70ff+bZKITSFylms1OfxtUlBJN0wzCX8dJQkr9Auar0q6",
+	"YuaXlKlE8kJzkU8OJ+eSLZmULCWZSGjGFBFLoteMpFwVGd2SDdM0pZq63xPJUpZrTjM1n0wn3AyyZjRl",
+	"cjKd5HTDJod20pmfdTpRyZptqJlebwvzitKS56vJp0/TyQuYuL00/H2H9UwJm6/m5N2E5bPXl+8mc3JF",
+	"PzBFCskS81LCiLhhkjSWR3D5fje/lkxuq80gXAb28I+LUymFPBZSsgTX39wOvEAS/wbJ2A3L3Db+cUES",
+	"kbKuRfwqF8x8v6i+r60oZUtaZnpyOHk5mU5YXm4mh/+cvJhM4e9/TKaTHyfvp9GF/yDkhur2es82BjZL",
+	"eNpYpftTsl9LpjR5ffFiTq6C51wRyXQpc5YSqgglcGKvL84Ixy/tsFwRxXTPpvG92lbd5op8ZX6/WXVt",
+	"7JL/K4JVb3mq14TmKVkzvlq3tsZh2zwnBf/IMtWzNmXGjx7Cl998O51s6Ee+MUv98tnXf51ONjzHP5/7",
+	"1fJcsxWTk0+fPrlhPM0qdSU+sPyCqULkKrKPlyJlmYEjwdcJvE/cB2bdhRQFk5ozGJXCawttXmsPZ04P",
+	"3yDwBuFKlSwl11uADi31Wkj+L2peJ4rJGySYBtynk9qLi5RpyjPVnu7i9B+vzy5OT8jtmuUk+hHxjMyg",
+	"SalYSrTwKGeWR4Gil4SShElNeU6OPTMgZmUG91K25AYNeU4uLeF9M38+fz4nZ5q8fH15RX5+dUWuGc4g",
+	"9JrJW64YPOaK0JxQKenWzCOuf2GJVtOOYf9i3vnnxQ/H33313bfvAXE028Dm/3/JlpPDyfwgEZuNyOdb",
+	"usn+v4OKbx/Y0z84CiFxYqH3ycMZlmL+Tha5yJMIWlzCSZBE5AYg5r+UwKsGeG6XWpBEMqoZoaSQwmxt",
+	"SQqhFFPK7EQsyQe2JRuqmTSwhEOykMchK64bxQK7vAX7WHDJ1IJHMO4MsZ+kLBcwqsGzjC+Z5hsgQMUS",
+	"kaf+QrJjzidt+plO+ia66h83xPr44JItJVPrPtKxr+AoU3K75smaJDQPQS6uAUdzdlubU0UhqBJRRI73",
+	"1fnV2aufj15MDSPlcASJQXYBW4GP3EFVxJtknOX6f1bIPSWO/qJzw7IWehtbgNmseeKgFzKLyGAAvV9L",
+	"LllqeHaNB9UmMjyc68xJD0325wdGGpxMJx9nmq6UGVTwNPk64ZP3n6aTo+QD3LPdfPMo+UBkJ5OES7br",
+	"7g5+G94qjlTb1od9tnOBp7nrRgKxyPzZ5ERx5pMUdrYzzTZtttPYYThFc5+45vHbrE0c2WrteevQblge",
+	"AdBVgKa50HzJE7y+4P0o5sOTRW2Y5qg/lhuazySjKb3OGDm6PD47I5p91IaT3vAU+GOacvM6zQjPUYTh",
+	"Ip96TkCV4krDwoIb68wQkcGyG5aZ7RGekzJPmVSa5qnjkLBEotdUE5EkpZRRuptOgCTlAnnEkrMIVr8q",
+	"3CJx5urd6IghDBc8jWPk2ckwaTQHsnCfvG/ii0GN8EJ09HPBUi7NC225wkmdliPe0ixj2kDS/KXohs1S",
+	"dsMTRpaZuG2TjbQjL0rJ26MbAfaW6zUMJnI2gyvFEeEC5MdgVjeYgh9LxSTRYhg8tTWEhNULij5au2ES",
+	"UcAQ2/dUJ+sK7Tr5SyVgvjo7OSbX5rMQXe0108d6Fvad8Syova7xXKiaLQBax27HcqbW58PiOEDr+za0",
+	"Ojl1lyj30+Wrn4n6PPLc8d3lOVguv0+hrna0CL46JomcvVpODv/Z0sin47EMx22c8+TT+53wzi2uD/F2",
+	"vPqrT49FvuSrUgLdq8uyKITULMZ/c6ui4PWAD6+ZIqpgieG4HuyhnmRejd9ECqdSobIVwd+M8k1ExftB",
+	"SLJRYrFJRQL69k3yP1Q6++VWk5uEiDzbzskrXG4NuzOuQCk3ivbBDc1KRgrKpTJSNZOMMJqs4WF1Xymj",
+	"kZhlEHotStyOKnFssVwyiYpafZdoscAJrKROcxCRiSqTtQPlkxxlabBgKC3LRJeSqadTImRNOww+CkX6",
+	"6ioLMAa0R+4EjNHaYbX4k2qA+siKrwwcFzRbLWBvaqF6MMYtPqGKEcVyxTW/YZbrKEQOC2ZrCMhWQnK9",
+	"3qgKcyy6lIopogUxS4DfrQmhzls88bbVjqaOK7eFFitJizVPFtccZKDFhum1SO9xV2tx28R/rsi1KHMv",
+	"RVSCkSOg0zydvVZMktu1cJyWqSaG7bRda+CMknXbBBHQgqgRkapZSytSdSv3cAt0eLi3KitKZg2jMRPG",
+	"EF7aTcT2J5K4SlljFJ41WEOGOyZ3lzQsPE1bzD/PLl/Nn//12fOvZt+8j15lyw5z5xEJ79vmtKEZlKsA",
+	"dFPC52w+Jb/c6sVNsvhFiZwISbK0WNwkc3LCCoayu8jDgYA0p/BL8/iWpQQmxDK2MVDG7bmFoFkrT8kT",
+	"YaX3bPuUFFRqnpQZlcgHVcM0Tl4e/ZebAb4O1BLLM4EMhEec+vdRSAqZMtlDfTAEcGXg1siNkPgMjzf/",
+	"ZRvHl2Ew878tUWtRZqnhx3YxlSXjLQjWu9EVCERgZGgwjUpLO69daH2Yfm4GM4pldQ1/mjYA8GrcHWwk",
+	"MljbE/V0zC0cvVM6zET9yAwf2ZvPTsxVz8zAHuCdEM/6keMm0XFKj0gBltRTZm4OqmuoDubd44Dc6vS+",
+	"1rpQhwcH5nbWkiYfmJxzppdzIVcHqUgO1nqTHaSSLvXM/D4TxtY8wxXMbpLZs+eD+pjlGIFsNyibOaKu",
+	"7vl5r+CHCnhD7jupLoS6xHVNkw8raS6oRSIytFe1DiDzXrXIo5UYQvQX5p1Pzt0RIzP2UfdMX8os7ilr",
+	"w9DtswNAnfA5s1Lpj1xpIbcnVNM2yvW+TiQrJFPAZRsM04u8a3zdXsGWKfcqvTztW0bcQlKT4cwz1aFg",
+	"eUkgqV+EajemCIqcdbdQHeEgp/4FckI16zQxGRh1DOEA3j8Ak/Evh+1RhWQ3XJRqMQD7s5O2r9gZ7N3V",
+	"UrvTO2YTS56xxQ2TKmoYtIs+x/eIfS86lpY0VzTpNKRdVc9HGdTqAPBwjSBVlIk1KMPbKnZnWWONSTua",
+	"kR5OXeozj9prKdQWUNVsas9GXWDm1crzgsYZ6xYhb9cs9xdo3ac5DaXC6qmR0Wi+RZdNOKF900kT1Seq",
+	"5sy0bG2I03jTJctB26pDeKTt5LT6tke+/qEWSBBQIoKu04NkBbihZf309gpks447Zle73x4mv1HGPgqh",
+	"J8A0O7yJddGtZhmBL4xhQ5nd5DrbNn2LNUMeIkSFDGj2q91xJBfaR2r0Wf4erZM91skhU2RDan/fQyUh",
+	"VGurrMfhRC0ruztsIjo2zduDV9rJW+dOSbIyZcopbzT5kIvbjKUrkI5Cnj5KtK4B832cfvc2n3aZePtk",
+	"NCvmtc34FyMcrpGRnW4fPbcdUecPeKrDEo2LW4jadSg5cUGIXmYMBjSGWq6sKcH8B6FZ2XQdu3XBZW1N",
+	"9pYqUubgsNWC8M2GpZxqlm0RLD2WYa56Ga6bniVgYQxm9n5Cv7fg4WmeFoLnehfRrp8wmti9P52c1kSB",
+	"qGkj4PehIclchU6QaJvreiLTslWEE749NcbmyuC8w/At9GR5Ep+B5cn9zPDL7Ycx4KJE8XyVMVKU1xlP",
+	"4OKDAMmf3v4dcWvvNTQQxyxoCqDF7fdiT3Dm94E4PT6qfgxCU+TtmoHYO+CVqmTWiFvLCNCd3BuMsaIw",
+	"n129uIzh42jfSdR1ZdZisMsEA/7lm+ffvg/XGnhQnhgEx5meupf/+j4w0Vuz59C+HDshWpiZXBhC8IaQ",
+	"PdAAwfGnt1duCd+939GYkCefCV6GXP8j4GU3t6gotgmu74XIGM3tNYT6HtyW/dRhB0R7VhCHFBJLiPzW",
+	"thtnMuQMz8ZfhVo6r0XPzMFUZnB2w+Q2CkdzNmYrbCkkCyURUFwwnIqFw31gW9V25BKr3LWXu6SZYtPa",
+	"yMYPshaKeTByF7jFVGsqIUkuwkv6Gg+lHdcY4xgdhBE//5Hs+V4My5ea6lL1CsAKXmlf1cp/2oHlvw1c",
+	"S3YA+3p015e1V3bd1qtCd0W6oSPDfAtKa00Ir29z3F6GtmCWMnIXJ8f/eHEMnrt/QJ5By+aPQnXbNkL1",
+	"OnKQMBIxDwkwOibn5NT5E6kM/XJqam3KPE95whDpywxiihXLDLLRLKubv2vG5giDQ5yPXAFZJm5Z6lyB",
+	"oY+wd8QG3GHTAdybwBsdCgcf+kN7yTRtA94MtejekGYypxhdwLSKGeantXB5simVJmt6wwCs8Ib5SuQ+",
+	"VtUMVLtQxt8srYNI9GLwMBIdHMjlyczcbM0sr5Er+NQ4lDps9zyXDoqo4o5GxSg0kSQCrXGmy8rIG0YB",
+	"QOZZEAQwtSEAJHR5xp0gMZdFK+CkmotAJpINJqaQocLzotQ+PF3ILh/TxmL4IJzq59akP/AxtL2isVPb",
+	"6cj9QdeBccJXXNMsuPEVgVeJz+ADkLiUnUTkN0xaKzzNCU1na5E4KygqGvX78+6h87G97xk/X0FiPPBY",
+	"IVlCNUuPzdIUMwbpr4/PmtYu99bkEAS5Jpz98zl5rRg5wPvpwHq+1MFv9n9nJ5/8/9+gm+vTAdwyaLBR",
+	"BwBbqtnMaIyzBBc1J5XFHH8y17Bdau913Gdbu6C3xOw6Y5o1Q5ogEs1ImUmptNjY0OlYHAVPF5ptiizu",
+	"yIw5EN3rZrXmvjTGJQfXFuGJGyYlT9miy+P5yr5gQ/F7BvUiaDCqjXVcpFHTmxs6WLz9gKQ8HTdVwaQh",
+	"+IXZEt4pPKVxG9E5vkrwVVK9Omam8AIZRurIQZ5+TNY0X7FasPyxSNkI1yTDb0E3LfUa80GXUmzsqRAI",
+	"HokEwHKW6wVVikkcM5Y0hUoJaDYuEEvfCqNGqilRzPjxrAZLybvJf7+bkGRNDUEZ2c0MsORSafM+KGI+",
+	"rYtQrZmybO2nt1eo7qAjo+fNc3Fu3o77Uxob6kgFu0QfpNU1MS6zSnEp9Rqz0zSrraEoMpeHY6MrY7ml",
+	"5Mmb48unuHETJBTo+F67ezcpZX7ImV4eghdUHcL5HOJMM7/8mVn+oYn3cU8qOLyb4K1hZF+qWRDUatcL",
+	"4lptMyWyLYNg5Mv5M3JUjTb7nprtH+OnR9VXZmMIoD6ARwNHcKyzE8DQN8eX6GwMuG10RFEszJpGKDH+",
+	"zeAKGiSikVpNzzhdTlX3hGzuSpadmcgPl5WrPy54OgxyfG0cvHeLxDiz965zo3QEqt0ldeBlmWleZC0L",
+	"ELWO0oiQbuKDaOyoESRwyOeSzdz2DQmZM/7BpGhVOH/JJGRu0UQrQhV5dQ5f2mSrgLGo7osmiMaHlTFr",
+	"CezQoYl77nZvbV2AfRiCHdyqMCQmCqypsi7wSl2gS425BQlTallm2ZbQxIAAMLuZETwoU1ipalAyHb5G",
+	"m7kJPdmP1VdhNuxAlInzy8d0KxMb1gxMUEEIcCJyxVMmzYHjOIHycThJjZCp+YYNLMGFMXbuJqeDYziJ",
+	"Lx6yZR/GJMUgFshkhmesjgSJAMcrenu4qvF2n6g9dc5NK3dbRQdoGm/c0jBNR5wRQVXFfUaOB41kHXcw",
+	"QI6c4bjC68/Eox5cu/hj0UKljETw2D30fgEjeXCWgbO9GuQSFYg5uXTeOItmPF+N416x9dynchSb4OH1",
+	"pGDW30Fl+nw07C4RpNURupX70MbK4Xcx+vTenPECXYOpW2pkitwaPvGB5ymkEeAN6yNCIOhbkJWx3Wph",
+	"xIxe2dyuf+GDnm2EezND/EUYowUbsp8awIfiBHXZLB1VquzEtyzLTByOD4mrQj7B4XUt9Nq9G10ksqjm",
+	"YFQyCP6zvi9wxOVBJI07Lr8Ls7NbnmVee0Wu1/Emz33EWsFyns68Rci9dnhw0Advv9IxJW5QBDxYiwy4",
+	"Y6BiArbhkKTafFKjBlO9atIvYA0m5N35ShplZdzxBo1oKCtJc92hz1vKSGjufa/2jOEr66vQaynK1boR",
+	"zmxjtKoXAwm4VF7uCVW5vF5vClIQa5YALMeVEyO8SKI0K0CEcfW/6uzAvDyZdlgEYFloBigkm1GvZ+Bn",
+	"7wcU6Cj62cRhyWg88MBC0xCfKOivJXPmDuuJdpHjzmBi8ltd4vLMxpuFhgcuKg7gY8va82lBKJAG+6iJ",
+	"YpqUBUlLWLHLl7CgdN5ySx2SJYzfQHw7bi1M+sJDnhJuffM2VND87Upr+CC5pt3D8nO3/QiI0IDkIF7N",
+	"hwuZt6t08ZzUVGVUF01FDxSfIodsQN0XFO8j4eO04SM43XuI5PYQYRvsYwGcwOirVhxHpLeCgDN2N7Dc",
+	"RVWSE6weB1TTLEY1WBfKrw+eq3ELC2Os25QHZnqv0dbXh0x9t6gX/REOYpGyjN9YR08fs7v6aE71xL1t",
+	"csoUk4uC90XNjLQpjAquaYCv5j+C+9ush5yf/UxoJvJVRZWuEh/iPUQL1THSAthWoGlLkXif+es89fd5",
+	"d5jQMqMrFdgx3UaMeJOH0bQENEw7sOFbVU7tCMkyLvftJzzuLjX+O0iLdXvXWI/bIXjcuuR1nivNaDon",
+	"fzyT2T1v8Pe2uj2K/4/if9tCkQwaz//Q+kC8uEq3wfe+afo+bMb3vKY9TG3zu9mdHw6o+5iu73k1/57W",
+	"70d1+FEdflSHH9XhR3X4UR2+kzp8Vz14uMLAGEW4K70SKiCGRVKiqotdTIdAH1xdlrdXDLagShHJMnZj",
+	"brswna/B4kVkcDj1yosI6syPV1fn5G+nV3BbwB+uyu3cTqvIhm4dCvrWDYFK4K4GUAsNAA1yAq0qc6GD",
+	"JqnXjEuyEdc882ukRRHlM79KvBtbYGt0xIhDr9YCw2jxtkgxXxLf4wJjmOsFQhTryLb+GI9BqB2Su04C",
+	"Jd+G3krJMivALUnOWNpR+8ExmGjXmJB+8RD/xnKGQYyvrs5JgTqgB8RwxmoUT6ftaKku8tmH+t6cu7JR",
+	"dZpJk1+rvIOhwG8f7h3yxJNazZqGwtgbke4udZCtHWKJnIUSL89DcXhOzpoZAInIlZaUu4QfydC5XBEw",
+	"NfkfszAF040WC2GIb+0HnrlGQ/1V8/o+7hz9rCPnqZTOL9cptziG8bqrjPdQmW5rN0KwBMf0pFlD/Omc",
+	"HHXVAueK0DStqpG/vjibEhe576R/pgjXPtwCs1rm5GWpNNlACmqQGuSWakZSeILVgbaPryEnRcyfL+zx",
+	"W80mFJcAIZkKk0hxcYFxDfjoj2h3MVZRu7U9U4ZilDk6B6LxcXip9hiGAxt0hOcfj2H5Ufn1AVj+2clw",
+	"ZGl0b/bj952A7uSgAFavjmNSY7e0gs8NWKBOwE3RjFLeodXGhilFV2wKOht3d6sfjSwpzzpuLYaNuFLW",
+	"NbY5vDsMbLlbTOmwj5B+Eoq67JrBoKXca9JOrTuwtoRgJpf1QHnk/l7CnToEneERz5ZMJ2vze8jlZlb7",
+	"Tadoq2GGhxlhzC6UnG4KvTU7yYVNFYYSK5IlQpp3twNYHM2vCSq+xL4ti9Ts4CjW6cOwXguMjCrt1oT8",
+	"dazhrhWlfXYycQdQI54uitiBVzWGuNSS0c1Axy94DCJseY12Zfgj3OoO1If21U5o4vWHfYyqPj9Gds4F",
+	"Mfodk7XeRgWzhg/YyXhbaUcVNARIp+LfPCrbT6jaVN95xYA9/uisOBuUaBysDBcrKxqqoF3J9o1ExK7Y",
+	"ZdWscd+gpHGuhPa+6hHirbLKvYaHt4HhoEvZrypGgMxV8Dyu/heSLarvF+bT/glD2EJR+t3MDa7SZzQx",
+	"F5Uq+0pXnu3YWqHFiFqhoy6AWNWswDbsnns3XAq4hkCZGXDMbMwpPKG3lBsz2Ay1JkzirIkWvsZo5Nks",
+	"FTmrlXRTOJAZOjHLyDL4f5mjh+/9eA03Vh6s6xCaVqN+nPEgNPdYzSB022cQaqf11PCnjQruQONo3SCr",
+	"yCamXWnDMcY0ViPupf0e7lYtJWRBY3heb7nXmrt1qJxy7eUxxZX7sjiO246jriG7igW0arXuVL63tfVY",
+	"on3/ee1w6IHDOF49uzpqbr1hfVnaHY1gLr1X1XrBUQ3CPMqIq64/g6IX4jwnv9yqJwjnp0RIYmoxZOkT",
+	"HOmpPRK1R9nFB82QevD0pBhi8zQ2IraVGCUvBOhjC+zU1fkIhu2HnK+qNd2trk+yNvw0jzXCPl5T0/ek",
+	"bbSBkrVdXCV+sZis5TS4n3EI42EUG641S4naKs02BGxGEFZjZekB7lVVbhpXormqQwQNWDY0Zsw9gd93",
+	"2DcKxGhTfglZzXEQGDubhUD7k6raYRxCmO7O0i+/+eb5d2G5RLEkJ2cn5ImVoURVcv3k7OTpEDS78dMh",
+	"2UgU9QWn24VpbnVPw2O+JFVHEsJ+LY0An9yaVCq+yo1f7u0VoaqqlGz2XFVL7ig+ufOMvwQz/rT7jFDc",
+	"pth1UvxqTl7w/ANLTRAOJQDEgekHIxOrqbqXNMfC2peR4so4tfl8To5LKbHUq27XHqheNOTyxS+3+ovh",
+	"6z1YXHCJe/wZW3Dzhe3a0axVqRcmuKGjCQcfCNhAS5XzlmOLdoywDBx3RusP6t2a9iGRiptnPjmvHxyN",
+	"rpawrXGtP6DmwrkveN8lroDj2SBR0Dwu9A0GJfONW7PkWWplViG7zLlPLn44/vYvX3/3FL2xyHrgIxsb",
+	"hJ5Qm8dnHQug1tbHg9CbeVcJkY4ib/apYolkutftsegoXBcESuxgl683JQ1nCEtWNNfn5grOuHlwI1ns",
+	"uWQFlcOFuysp1X4Ra7/5AM1K7WzVNKb8R29diLu1+XAK5kDL0w6w7QZ0CMQ2DPqow10ydAQwALL42hB7",
+	"ROw/XMGOnjIpgzFQb6qCPka1wQCHd5NEpOzdpD9Y6Z5oMFa6ZdTx3Q8qDEetjMCFzprgNWToLtOBrPgL",
+	"1WDGtc993H30VOozyQrD+0i/ydECo58ZD89loXUWi52A96oWLmYosAEzcnX1It5soijVmqWL6Fp3h875",
+	"0UU/TEYxLIPvLpiEkbJIxKYdOyf7iqa3ArucbXc0oaOE4vyZqQlnAz2z1zHqD3nahWbTljGvcarjKW63",
+	"4JTWlYIyXmYtFfvcRiPIc8Q9ea9XWAR6O95TUVjBhiP01niNfE9dfakY30k5yxM8zrha+868ZOqzmVdc",
+	"oHDqY8psBHEU4aOFJ06QlNDXZgPlA7NYFTkOPWl3Mkbu33RpTYHhdLjnfoSn1im4EwR87Mjibm2oLtw4",
+	"Q/2oOprpVV1KXQXeAQjteWfj9NMGXjXg20cPgNT7co8LpspsnLg2qlX9Q7Q8qnC0hfv/Ll2NpqCoL7p2",
+	"2HSI+K5HEerQsQq4VxevTwlfhqmOtnfXlmlCbygH84hbuLXVvzq3xRRtNgpYxlxIdJUjqgV+QJq9ycIQ",
+	"xKSNHuRJrLMNhsQNO8uSkOF7gIRgdNDoIw6L3+PJw4e4HMlkzW96ChHaF9JWoHskvIJosUJXItgeuHah",
+	"MO5EDLWbEA2e1w2igQ+Y4nyRG8kuBEM1xkVV9ER3nOZpFU2oDRhFTgomuUix3CagF/SlsRk4lik3QUEl",
+	"IynLWJVky/X4uA8DkUj4PlXs269N1iq25UjJ5Y9Hsy+/+RYh6NdtYBv3vNNtJmh6wldRNbV7/BS+cDPE",
+	"txyfMXjhsrzecBV3+YfIR5R/kVAVuUBbPudaIO7Nj1Hg/RiAyCc31fEQUNCFbznbO1ra+4Dqyj7Hw3Fr",
+	"haCjr2AqVCxf41woHnbw2ZdspkRpKsGgC0B8XsNEnutvv47qVdhxupSxBodvr6DEWxVga/4yM5ycnXSu",
+	"B3K3/dqHEbY3Iq4dy9P2xRQQyBSRcN6c27z2IRTbV7LB+LgKOVqoEKwuwAFL+01aDc9iGjK9jrCuPmY+",
+	"OprrfCiqvc6KoRCe2tGCE1twe64dltzqQN/VZKtu3N8wTYG3Y5Otut9jbAP6OjzQs2HJYrFL2ywjUlDF",
+	"iGK54tg+AyCoau12XaKCbwDlV9ndob5y5eyP2iP2VcPE1omMFUhKtY6ZKseYWUu1bhjT7MfdOvwfy8Da",
+	"VYd62rHOEOIDcNsB/Czd3aoJn422ZPZ1pbXNfvNycw3XBtXNlvS+O229+wg46oKGtZBuUAhLS/aCQ3ku",
+	"/MKPxhWxlJRylUgW3sHReuzXpUYFQm8LnlCTuQ9lVjJofmOcpGshNXli+qBMyTXTt4zl5BsQIr999swt",
+	"9GncvOksmVGHZXMTYHM00MaiAaInC6MQCtpZgP4DIFO+BeOsVGbcJZPMNixuNPOs5RC287qjMw5bwMKt",
+	"TkPkaOB3F2KOdRdfYIP7oHRDd+xLNKLPdsgnPmZmp3CaVy4jyr/TzqWC2vrXzElZSxHv8z8Qt7LfsE11",
+	"1O/EzxecRw8sR7Ka1ghD8Xbtg7h38HM1BPnw9QGNpm1Ae7BYvNZlHSxyGgCl7wB3CUa7YCuuNJPg88F+",
+	"DJBI1H1XVM0hfDUJM4SNUWXm4x4rW0di1CVwLXJ0eXx2ZseAQHYEVXfGUn+44o/lhuYzyWhKr/3oUC0j",
+	"eM+dLs7qA7dSdl2uVvHJG4eEe6qdyABQx/O51kCd4lP/uXTHCMDLHUGaDQDi/iFes+pvB3OhDd9iaxVm",
+	"x/J0BoEitixJ7VrpK5FVxlNbX7glQE2GW3ZNCrpi1vcX77c7YLIHDSLRfUZ0J7x74QXLcm0V+gjhe1Iw",
+	"UWQ+RZYbaHmxHaefBtIF21CeEZqmkinF1G7FNaq6Pn2rrtChXtGn3gGGV4m2WC6knWN3SNrVd6Zkn+I7",
+	"u23zl9sPqqtlzBcKZcu37Jr8nW3JJdMkFUkJpmxY9tS6nLyGWG36CxVEXKqoHcrMPYiDTrxygXZJdGlP",
+	"fnr796e1Be6ztApMJkBtcGlW2Mb1MUUoxrVVedid9FCIjCfbcROAO1lhGaJ1nVMUkt/QZEtwuOpsGpXj",
+	"1uIW5XRWZGILbwi5onlVnCbLWKIVNARUUyIZQGwKkrcR7jMBKVBMKsjhhuo1cd8D5pabjfVRjSMG9z7W",
+	"0DvzPKABwapmJV/a3yq1vU02ASnuRgu14JhxVF8rXtQm/IRC+phTlDpCSiLMYHdC7ihj1C51SIkqaMJm",
+	"VcMwV8ABhrBL6NzK7Zr5e25c/UsllvqWynhWwREpc25qefGqXyV+ioogef3ahGRTVbej2kWl7IZlosAY",
+	"bjcPErdaM+kLmdSFJwt3oKmamcjhlhsI79t0m9ONvVKkFRW6Uq3dVjvT9o5cpl5kw3W0r5bh34S9vAsB",
+	"2hEmBqfhY1Ew8mTTEWZ/UTUjxXlprOmYXxwM3Yu7ucjZlNQiOheFULr52zVVPJmTn0XOfNk2M4vlzfiy",
+	"Ik9sc1xaFGrqau2YP546Dg8ZxbYjLo6tfHGtw+ikcZipOzNkzeQGIgGUrZrrWXLjbBscGgvMSZrokmbW",
+	"JCJyteaFt4PUBD3nVwtHq78AjliF1OrYTv0K7c9s6JGJ7yRWDzZtA/dnRWaVzdmqtFgdqCGFD4RDR/vh",
+	"VfTX31APe8qkC9qZ566DJPekOThmgzajZALD1R9TNagixaPAw8fWKubbKYbFvSANsSqw7BZZb+ooYixl",
+	"cFW9HZA6jwS/RQskDkCEJM+MTMHtz7nQ9lHvUT2qTY9q06Pa9Kg2PapNj2rTo9r0qDY9qk1/erWp5ghs",
+	"5zXWtIhePKtLUO8HFLKdHR1jIt67YoHatXFZWpNpzWG58HsLs2qivkStbBWLnTs1YTtV6M4Ow7eQlOVJ",
+	"fAaWJ/cxQzNVKFtNcNLaAY4B/liHotBUs0sML/o72w5H6bvIQxOWK4Vup1bVzySaZuLzzqooxi6nL4SO",
+	"LsKaDZ2ctIpUlEyDvBJ+1tf0fsfhc3YbG9pXoDPbCpt5DB90CkQeW0Z48PGzGnnSWH/DFFmN+/vrz5ux",
+	"P9VuXJU/kFqw1wzLICuhffhLnmWLzAgJkfnWVILxwcsWLNeSMzXFwNBnBpLPA9BhZBJoB1zpjnSLamkd",
+	"KAXfKv6viKz2M0xglmRX4s472HFcn8cXFkE14Oi67HM3bGO8ep21UnXk+oZ7dDJ2pNpC1v9lcJ5dTYcM",
+	"Ni94nrKPTI0Blj9HLdrpMyoGuFY1BpOA4Q43PKrGYqYhYgUE0kDx0YQhJKsFOB2LdEwfYaWFt4XZQn4G",
+	"cXFoAraCtjnRFoPt7WryOyanBqGPvgGLh24fnO4I7EFz7uZOYPcpo92G6oGVjdxfozVGl7TvW37YlhtV",
+	"uW/TtOJJWFLQ7PCps3DavmJYr8z8gBloTEYKsvNaYUevxSviaun74n5txg2mu0jCTmjRq6+ZK6LQNBtP",
+	"SttwvTDn5BMsY7DZiBvWGNcnCUSyt8NCmWuRx3I6zM9VTGt0vUapu3x52e/GaJxrxJr2GmoCN+tddbKR",
+	"3td9vKfSskxQe4Gaw+bY3xyPqNLakSBz1/Jd95CC045v787ZqO0nsvqAO/WDfyT1vrGVR88rTsDSkbeB",
+	"65SP7ZxaNV6NymjLykYdTFQpJnHQWNDxTVXhw3U91LfClMtSU1KZkq63hJJ3k/82KeFrahRaF3qNmVUm",
+	"kYiHtlhCtWbKSvE/vb2awjOMkO5581ycm7f7XCx+Q11yjC3AiHM0JOi6dydYQ9BQMRptbUPEn7w5vnyK",
+	"G2+UzfI+pncdHclwpplfPhr6f7nVM/ekgsO7yZyc6aC3T9NqB/G/tc0g664smEfVaDOTEpgS6xk7ipmB",
+	"+j2MnV62sxNAUZ9n2yjHHdO7Wq3YYjG3pIHlO7Zued2utdzJJeK94SzxD9Lt3el/OBdjXwYwsjuwL2dX",
+	"synVPqq3b3C05O4SX/sW3IoJk8BFwiT0bcEIVbbbBsgRl9Y78M38+fw54Hqr45vQayZvuWLwmCtoH9ho",
+	"QTrtGPYv5p1/Xvxw/N1X3337PlYg/GHSf5qVO+FC3a1byZE32zcO236wY/+1GPHWWoOlgzQSiO5+Da0a",
+	"J8MYPpZUAlvFBUsYL3qCMmxxx5rNROJHbXrgA6Yd/1nE/wXPIvmnkYkNHprr0PY/2Tm91mU02cF8qYaa",
+	"Ecc9hmoJw2wOTtntIjy1HmCPztaEQbZh4ghLPnTZ+/DlaK2FwHxrm4mQxAxFLBeKJYew5EOszK35CvbY",
+	"nTIw0JWlK0lcLNUgGijomSiWhBmZPtwrDIDOEPgfbGB0/4ImoCEp0wI6Qv1jSti+GWXIbCUSGbA7OEYn",
+	"auJYB3rsjWM7pBaFqxsq9/J7FN0eWYy6CYGwGnVH0ZGeQ9it71TX3L21qm+alP7Qparvqfbzp26ojSmf",
+	"3Au4kGLHcBEUqTCXs8VHYsi8LbRQJY9JI8fVQzOszfjUbCW53vaAdRS3DMptgLjaM16H30YsuyzrcTXv",
+	"vJYEv1f97gjzq1czH+SEzYLHfee9N9KM0RH8JVqrY6SGmJ9hxeNrwfZx8mjYzeeUmnrktrNewS8qPELI",
+	"GV9GH2K7MZabyIBadfeOdfQwlH2FrTAtdYy4VcuJ/bcRuHrFjhbVdcHkDqAdkjJqYO0ntZ1u+XAN/p7f",
+	"KVX5d0lM7j2SfSSOGBzGyBzNglY7SR3w6A8gdsQ2fwf47XqL7IDbe10jXeT6eJGMv0ii5zsaR96yLPt7",
+	"Lm7zVwXLz06w3NBx2Jwp0k5s8JtmgAc2c2+8YdEMNDWqmI1nM2ZksLNDrZezk/P9S2ab2D57LK/OTW3o",
+	"yi4ejkBO+3I6rqlO1mEN11HztYpLfaHatfr9vK5syws0gJYK3RJrrQtFgGLQwvvy6L+8g6YQUk9JQfUa",
+	"HjU77FYkFzabmcYXR1LBsMymdWXAa93rHWjQVOvt1aiRVTVZPq+d6Tg/YQ2FVFWG6tO0cRRHubUON4Mx",
+	"XTFyFTmpEaXBQju3PTZRizuERAFru83phh0EvTmmtuMIo8kaHsK9EImRtkvzgGuX63UbSuf9TcT2x9bP",
+	"j6cDWFXBp7fs2vfotreuODxuKI17GzRB7DlgCQ2s6/21wrmDtbvyuVFvCTax9REm/qsp5g5qWTLn2jOT",
+	"2fnbxJpiqHPlWlzSrCtOIVwxbCvu7o0d91Ce4Z1qWPfFkjaIGAvt3gu/jVXtvSdUnj4Uz+1dc7zQuioy",
+	"GokFOoo5ygL+02RbdiBSXbVod2ov3FzYlYvPGOhKq7qNkvwC+6Nde3+CXh+xQ5oYbrOWBOM4MFz9/tb/",
+	"mxmUmAKGjTwYrqCGdFD+cbx7rVale29c/TkY5Q+PpPHFjnD146nSXOTbjQm8xvSiwQN2LD1gl7eNjrZB",
+	"VgT1rBc908BuTfs1NqucksR4JW2tP70WpTYY7ZKibXloy3j7WW6YfLSDKHqCaUcuHOMiGKUfovU0tvuj",
+	"jdq490ge6Cy+v3X+0/Ynex9NaOPKxejsuVpQFBcum78z4w6msyUWXWdBS61G7fRMtU1QbuiwORtV6MJV",
+	"I9K9dtFykA560ak7x+dOZ9aXbKYCuRY0cq5aeWcnFe29m+Qit42m9ihGPEpX3SU4wQzO86XAeGvIXA9i",
+	"WidrlmXif2lZKn2diWSespvJdIJlEyZX5ufvM5EQzSh47CGUfgIM/fDgoP5ZS6mpPgcl2XLkWANqw/hr",
+	"xgYMDHv71TF5czw7Oj8jNBP5CnkjQubrN9A3SYtEhI2QD7xpJGwDAN+99YW1M54wa1WyOz0qaLJmsy/n",
+	"z1qbvL29nVN4PBdydWC/VQcvzo5Pf748Nd/M9Uc9CQ4RTTyQhBdQ1KVNw4NwPPRAY1To5NncTAxuVZbT",
+	"gk8OJ1/Nn8FazMUIKHRg91eBTx0oH7ZaiO6wWtXOV5lDkzKGCHWWYr13Xa1V2ZBSXwD2e5FuHQYxpOog",
+	"+vDA2GnNbygzDUlU/dGpnz59Cu4N2N2Xz57tNHlDwfzUwsxXfweiU+VmQ+V2CFJtmpr641hJURbq4Df4",
+	"9+zkU+R8ZobLwD5WsdofF6DAQVGGjsQir/vjnATmsmrZmnFJTAIIgQSQ9sGaBJAmqF/AgqaTiqeDJT3W",
+	"ooz8DSZDLyc3vxuMrFiE3fcktLBjL9jWcXiL4Ps7Hu8Y3j4EvtHifyONps27h5ELwL3L2d4D0h38hv+e",
+	"nXzqwzzJmUsyGMEk/saiPOJ3QqNpPKXr7CQ+haqefj5U3Z0TjTiUPtQIbj11wD4ma5qvKp0GI6tdBHP8",
+	"0ji1HzViyeO5Rb51YRtZ3Dg9SVIPcbkMTnsP98ue8+OMo7Bgv0PYBTcKbNk0A0l+ZkR8wJJ/zYJGm3EE",
+	"sc2enOQebSIbqguVcFbvdhkRQnDkjtaoD4Eto7qyPjDGjOtTOQZrxrb43QtPajGHHfKmrXDiUyuqj7yy",
+	"FAbhB4+xrhb0ecNPvW5gsjs7UaXWnvIhEaSa5zNhQ7OV2k7nH0LlDic9A2fi/Z03DNfoWrfnwbebaD/g",
+	"6TcnuwcU2K+PeVe4wQ640fSS7oQhpVo3ZInB26KFI7aiTtjqGArRgQYWttWyltBwujDIq4EWHZ1nHgox",
+	"BhrddGPI0DF1tg/a5aCUFnI3qQ8SvtVdZb6hrPiHOIr+OR+YWw/kyY8hzH0gvwsu2Ew8Nqs7NwbwwaVG",
+	"qc70vTLIV6xjwYgExIdAhMFpHxgXhrPJxqDDeMAPIIENyVIHv/ms8k/4LA2u+GG7VMMnAFfzmist5LZ9",
+	"9NXL7t0f8dXJZ7D5BPb8an+VBwP3bu6iFb9heRiztqMjuLG3vQ1DTlkaAHEk4bDX5OIKSnVZQsIqAzuY",
+	"QoZw67d6xYK6VQo+BHYzwlhUbWB+nzuYDkxnF94/Z1WPYScrUpzL2gU0KgO1LfPwXoUlDyXVNKaxNXF+",
+	"B3M8LIQkY4XU3dHRtu7qZH6wAEUoEe1+Xb56YrsTGLmqytIYT6tqN/dq9+Ny42EkF0uDqaDmLYRkm4o1",
+	"yI+//vI7Z0CGK4EvwwhcsqaKaCHIhuZbQrFhnh8wbtPt7aL2YNdlz6xjUORvTPc2xduDb/7ZuM8Fm3HE",
+	"8wZGguJVFjMtZqCUWW9/iN1hGQ/lYlYGEL1RNTd4UGJwV0UJeJiucKkJGUAy8MVG23QZ55wtLHsg1hlv",
+	"ZPe7MM8LRwr3yz5rSgVAQLEZzdOZK8Q2c5abx2u+wwYS+Pm0IA5uYBY5i8ZFBDAnHHINXKGFejacqgbz",
+	"3xqiqWoSuwIl4bx6jUa2mqIZYGPktnA188IQd8AEJ7e23YExqqleOfjHxQ/YDf7TdMS7kH13LKQt1D7u",
+	"o0v+LzbmzRciodmoN48gdu+FDSWdfHr/MEzFQdsgyNfHZ59Jj23Mag84mHxYfAsxm9gBYlrO/TMmz4wE",
+	"T5NHRvQnYkSPHOg/lQPtZD9r8J7PwXMCn8WwOa3pJ/NkEo4SVHQw02L8LpWM5IxDHLkBWsY0S0kO6Yh5",
+	"wrKMpW06MbFHbudX4To/gz3u3rc2ykwX2e3eUVtEFCyP7+NRv9zfMNhJPQe/6Y8DEWtARNb+IZajyClq",
+	"9IihyUOytBhWjjRzxPb0iH69EzfB1b1bg3C/M7ofIJP7s0qrn/0Au6TjYzgGhUx/FGOBLK2Gj27aLMKr",
+	"qUZLLpjRsIaVvQTDDZo7kOfOWxu5ynF5/yZ8Cxc7mnXtTFOmWd4BdFx5NDdFSgCCVZZCb5awRY3BQpqm",
+	"inAdlHaBZZquM+TctpiBl7nyrWNM4qHSdGt+USK7MaktbQRttmR5UO9BvP3LKPsofBrCZQAjexFxfsuy",
+	"bPbBpGwdGMbBQ+/6rEpr9/JMIVlCdXXAcQnHDQWZX21Qv4LHdeXX5Yk9JNhHlF/ZxfFtgsLOTs4j9Vb+",
+	"OH7vadc0Fc0+gDxtzFcH/mbpFIi7SsRYALu+o9Y0oAzRY0NK3ymxmUgdNgxu4BxPkyO/ooGzeFOV6L9m",
+	"RDHgN++gjYrNjTRvQbJ3BbRaUu/dDukq1jW6a96wt9wd5jwKPLcpk/yGpVXHDrPzqm6TS8hVsMBIXQ53",
+	"ZFPb99F+mRK6ojxXmmRU92xIpGyR1Ly4d9iVrTIJazZ9t539DveIO6u54YaXVDXm2/FMow0OXOvWqlvK",
+	"jK5sP5Nap92wx6tDdOL6qpkmzUpTbNeZ+vy6+JS283c1er2tZyEF0JeQWCVqQz+416PH3E0RVRPb3YGF",
+	"KedW1rQUPzAhfLLbTKZ2UUF/LW1ye71fuW9Rbry3UPAB5eGwk6RdEsrINMuuafIBzctR0HOMFleYio1z",
+	"2kaw9nTzVRMRzJB1bMAJPA8jlz++ev3ixJunbaXEG9v7O5FCqZniulrtUsgVk9tOQPrq86MBeZobIkmr",
+	"Oijd1XoSkd+wrbIVd/C3oPl5EP5m/saOWeSW2lah4lpDSMnLMtO8yDonCcz1SA1bg04geizqIfz+CGsH",
+	"xnOolGa2snFTNWKdYqCLrmY3UGKut6kuAHKKkS1ylmhX1cB0eYPzt39Dn3pXriTlKhFQBN1RMfA6zeSG",
+	"5ywA6BcGRAW95hnX0PMtTz1XUXNycXr86uXL059PTk8MJHwJjbD3ZS8tukZnsMZ9adIQAVlDkH2FCaYA",
+	"i9muIcfyWpll5NrTHuJIofmG/4t5SvpCmS7+THKWJ+wedgedUMzCJjsmeZonluxdw2Ser4ISP/bYXKtp",
+	"9lG7ntcNfZ7JOTmyQ/nW/bW2IVX//oIqhf06aB76xcDbEHDy6savHGwV5G3RDdnMkgtblJiZ4BM7Ajay",
+	"sMusMbL2bq6qeaHbjqYfzCK1MOxflK49r+uOQThWy1mVVNJcM1yAkHzFc/PY7oUrO+iUJKLMUsMVaE6o",
+	"1tR2Aoicb7j4vY44KJ8Di3binLLVIWitbbXZRrMxd+z66OmDNNAEiacz2ATDn2eOTxhl2LZDejdxBfuY",
+	"qQTi5cp3k3YZNs8yDeMgP15dnV+Sa+h5ZBymiZAoDaewfzxwP2IpOXRbWvYIKK4SEs0ko+kWO1Lb7lIY",
+	"EO3CrYKW3jj+lHDssS5tMnLjO4MV+Ob//d//R5FKAyaZqOqt9kraCwTlZJfk66+efdmjyH6c3d7ezpZC",
+	"bmalzBjepXXNNt59Mt5ZKCaAYEN/ljPfX6wfyyJfg0bEPhZcMqLWQupsS+gS0AJQ2wapG4GJa75y3nHJ",
+	"1QdzjWaMflCdbVJ7tuPCRN/hizWENDK9Dd1zyBkUxGnLqrA39pEmrsoflIataztju3q73lVDUW4/iDJP",
+	"G1YEsBoMJbhWnbq9Wt0skdqdBXPVV1aU2OBJL9ocB2IXz4nIIx/7AouG7AuTz1Mh0mmezqALWFmI3J2I",
+	"D6aktknBEcrxWCzJ161FLsndoDSL6++fJ22yMctnihpozeqjBqb1UW91NMTSo+iw/QowryeTMoJ0Y9DN",
+	"Rp0ndTxyVRywkGGj2xmWooof9oOf82c/4s94umPPlafFPRuI79kc/ObLR4Pwf4pBOCze+dnYyFFikDdj",
+	"6Qoa2z8QLzkyxfR7mMjXEeP3ByP4fH2P2HyUfID4vr6IM3ghxjHCMqP9PKOgsvv0bIlNxfLUlViJimEE",
+	"jV3Z1rWIbakAxh6xYrpSN19fnBlMcJC1alVg5aHm3SWTDFy2qHTYnkuhocCN15q433lgcttZeqfqLjsL",
+	"+SP7ibZMb//hZrdd2uZ2ulLag9TdDod/DAfJwDKdK+LwHhwfram6+6z+mexYDgp/aBtWpHRx4Hs5/JM5",
+	"o/oLOU8Od/b3xnsJx+E64Lcaa/t4dEzF24+vo3WZ/2Aug9bS696Qw397j0+/YawZChGGKDSu2Zj5rC0/",
+	"P7/X2kctMa5bXsZotBRF9W8inbzwkv1ZaHKUZeLWvvr8q5g2jBh+mmuut+RKCPKCSpP+Mp18/eV3EWYi",
+	"BHlpEuHtlyomt+N+9jEkWttbKMu3ipWZF+KwejCZl6cLUOeifb/Qblh1rLKaYFD23PUkM8jtWZo3/lfi",
+	"7ptzHGwXlnyp/ZUcV2qgu5aQkmVej2oOfVN0bc+tqFq2yBkRkmyEZCSoyh32IFNxYhxBUveod8YQ5MLe",
+	"GjGiuiwNm5oT9w6qdqqqIWHN/bUi6LTKzqs6tym6YbOUQZ49Vtz7NJ18E6tV9gO222xWzrbSniqvN7zt",
+	"MXCapghFeynK1drYeprkdVOE5OWuze7oN0O+7i3Y0ZrmaWbuRT9zkFL4haoXIMV7XeSa5yUjorT1Sd0W",
+	"uioQGlXWn82ABcqMZYt5VVVQgypeXZFSdzNIOZ9rX1zK/jWQv3oWZc0eWVsMNgBWDzP1NN1r1PJxi4bM",
+	"zflhj1JQbagvHIGPHYp7y1dTr8eTCZ3La6qsmm40SfDLqRKmXJZZB3LHMQQY0cPx+B593bn8po4JVI5z",
+	"8AcH3N61VOh0Yxq8KbPMME2HKFF1eox+BMBuuwrvNO/CcZWosQGah68kLdZW+ZU0T8WGqHpXFKewunuH",
+	"datGTlTX1vvmpbnB1VYdokYrT3XzUI8q1Whb1O/YBrRwXwCLG7P8fmW4hXLvah+0vM32fk4HLDu22AuX",
+	"rm2OAxHaSxL0cg6uXX/cGSQ4NX4X848HIv2r5XIUwjYE/AAf3n9uaQMYGjCoodRqb16vP/qepqSy1rcY",
+	"fq2hVT/X73WdoRUDifsxw6d22yJgFElRx8T7Lw+aKVmm79m78ax1sdqYfIMToDPiwWom4SSwaJyp1wX0",
+	"/GFnHqnCPnvIVQx6nwYozw1pEcEfX5wC3eVZz1BtFniuWnDHlVxohP2o4j6quP/RKu71ttJgAyioegEd",
+	"tD3WQrfMjB06b9DZvJscbY44lcma34SZYO2SBtVnR/bth21U4Ddpp9upUMsgVFcMidDuPG1FxFmTQYgB",
+	"c3J0QzmIYVVhzOArO5bBo2YL+2ZP/Skpc80zeMaMorl0VTpZDmMVTHKRzuPnOVSLARvw15Ki7604QS8e",
+	"QRJ6Jxa57kMBKinoJrJzVaX9Sxm1xK1XrtZjUG0qrbwMQPVO3bC+sjm5sDAbZhO2eKUfXAYMZzBT0Rpr",
+	"PmuzMzjCsNBOu5TmnNhmxdWt1qrHqQL9CoZUhLsE74Au7LXFUgjuzlikg/t9UbtdhTfAWL9mjM7/zaiu",
+	"6tQ4gnkHzRcfrMBWY7KH4dxJKSXEMvlyPf8xR3kAHtjucl+XWjK68ZWK0ABZNfurxiXU9QCegYEBx52T",
+	"U5qs8Y+qtl7nsZlBDJHPCc5rCDnJhPJGzOakktFkDSUiljy3zeUYeQK2TmYMM0KSJeUZS5/OvfMMSpQE",
+	"1U68bqlwUm0FU6UZhfvSNG79wLaxPiHmgxjK9x76ZW0ezJRwbKpR7ynGtHVgkr2v9n8mKgdxYYZQuGvw",
+	"8SBxGe97Ivk1OFXa6PWfQ2EIz1nlF/hdVjvtSQ1xiGgzMxwqYnuJYC2YbgbKpgoCsCpDcrPpvWUT9nSR",
+	"JfhAg6mh9mspbhWT5NQ8uxSlTJydHwIIc6HBjux0zjWjKZMq2rCDataiRKS0yofxue6hYN77v5IoZlzN",
+	"MtAmEPL3SVA1xN69JwF8uh2L6RGN5WGNnm8as5Gbz2D2bBd0h2VsH74XRnOe+6rnvsucuzUOgo/jVd/v",
+	"hqR3KbD850VWX8SWp0nA5/645Yk/RwnhN+efg44aU97vTXLfxr5xNBjOcg9Xxe9CfL/HRRFaKR/0pggn",
+	"+nx3RTjrPrdFUQfPEK5av4k6+M3+r16st2XUQExI7PLgi8lDw6Q+3cMYNmyZCQuERkdbIWtgtaMl9M6q",
+	"2JrVxnLTGwUd/tMlriqs/VCNEtOV60Tpj3dH1c6AE4wZuI1SZpPDyVrr4vDgAMyHa6H04V+f/eUZ3DcW",
+	"FM39YpzcDINxUrIRKcsawdbNOiMRs7GD78hx3OuRkXBLRonKtGkxxZIP1Xf4K/746f2n/zcAKGz4aPZ3",
+	"AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -43,6 +43,8 @@ type Configuration struct {
 	Tracer            trace.Tracer
 	IsTraceEnabled    bool
 	StartupParameters *startupParameters
+	// shutdownHooks are called on graceful shutdown to stop background services.
+	shutdownHooks []func()
 }
 
 func prepareConfiguration(parameters *startupParameters, tracer trace.Tracer) (*Configuration, error) {
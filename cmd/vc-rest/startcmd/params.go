@@ -339,6 +339,93 @@ const (
 	cslStoreS3HostNameEnvKey    = "CSL_STORE_S3_HOSTNAME"
 	cslStoreS3HostNameFlagUsage = "CSL (Credential Status List) S3 Hostname"
 
+	cslBatchWindowFlagName  = "csl-batch-window"
+	cslBatchWindowEnvKey    = "CSL_BATCH_WINDOW"
+	cslBatchWindowFlagUsage = "Time window to coalesce CSL (Credential Status List) changes before the CSL is " +
+		"re-signed and published, e.g. 5s. Must be positive. Default: 5s if only csl-batch-size is set. " +
+		"Batching is disabled if neither window nor size is set. " +
+		commonEnvVarUsageText + cslBatchWindowEnvKey
+
+	cslCacheSizeFlagName  = "csl-cache-size"
+	cslCacheSizeEnvKey    = "CSL_CACHE_SIZE"
+	cslCacheSizeFlagUsage = "Max number of resolved CSL (Credential Status List) VCs cached for credential " +
+		"verification. Zero disables caching. Default: 0. " + commonEnvVarUsageText + cslCacheSizeEnvKey
+
+	cslCacheTTLFlagName  = "csl-cache-ttl"
+	cslCacheTTLEnvKey    = "CSL_CACHE_TTL"
+	cslCacheTTLFlagUsage = "Lifetime of cached CSL (Credential Status List) VC in case it is not defined by " +
+		"Cache-Control header or status list ttl, e.g. 30s. Default: 1m. " +
+		commonEnvVarUsageText + cslCacheTTLEnvKey
+
+	cslCacheStaleWhileRevalidateFlagName  = "csl-cache-stale-while-revalidate"
+	cslCacheStaleWhileRevalidateEnvKey    = "CSL_CACHE_STALE_WHILE_REVALIDATE"
+	cslCacheStaleWhileRevalidateFlagUsage = "Time window after cached CSL (Credential Status List) VC expiration " +
+		"during which it is still used while fresh CSL VC is fetched in the background, e.g. 30s. Default: 0. " +
+		commonEnvVarUsageText + cslCacheStaleWhileRevalidateEnvKey
+
+	vdrCacheSizeFlagName  = "vdr-cache-size"
+	vdrCacheSizeEnvKey    = "VDR_CACHE_SIZE"
+	vdrCacheSizeFlagUsage = "Max number of resolved DID documents cached in memory. Zero disables caching. " +
+		"Default: 1000. " + commonEnvVarUsageText + vdrCacheSizeEnvKey
+
+	vdrCacheTTLFlagName  = "vdr-cache-ttl"
+	vdrCacheTTLEnvKey    = "VDR_CACHE_TTL"
+	vdrCacheTTLFlagUsage = "Lifetime of cached DID document, e.g. 10m. Default: 5m. " +
+		commonEnvVarUsageText + vdrCacheTTLEnvKey
+
+	vdrCacheMethodTTLFlagName  = "vdr-cache-method-ttl"
+	vdrCacheMethodTTLEnvKey    = "VDR_CACHE_METHOD_TTL"
+	vdrCacheMethodTTLFlagUsage = "Comma-separated lifetimes of cached DID document per DID method, " +
+		"e.g. web=1m,key=24h. Zero lifetime disables caching for the DID method. " +
+		commonEnvVarUsageText + vdrCacheMethodTTLEnvKey
+
+	vdrCacheNotFoundTTLFlagName  = "vdr-cache-not-found-ttl"
+	vdrCacheNotFoundTTLEnvKey    = "VDR_CACHE_NOT_FOUND_TTL"
+	vdrCacheNotFoundTTLFlagUsage = "Lifetime of cached not found DID resolution result, e.g. 30s. " +
+		"Default: 0 (not found results are not cached). " + commonEnvVarUsageText + vdrCacheNotFoundTTLEnvKey
+
+	vdrCacheSharedFlagName  = "vdr-cache-shared"
+	vdrCacheSharedEnvKey    = "VDR_CACHE_SHARED"
+	vdrCacheSharedFlagUsage = "Share cached DID documents across VCS instances using Redis. Requires redis " +
+		"transient data store. Options: true\\false. Default: false. " + commonEnvVarUsageText + vdrCacheSharedEnvKey
+
+	cslBatchSizeFlagName  = "csl-batch-size"
+	cslBatchSizeEnvKey    = "CSL_BATCH_SIZE"
+	cslBatchSizeFlagUsage = "Number of pending CSL (Credential Status List) changes that triggers re-signing " +
+		"and publishing of the CSL. Default: 1000 if csl-batch-window is set. " +
+		commonEnvVarUsageText + cslBatchSizeEnvKey
+
+	presentationArchiveStoreTypeFlagName  = "presentation-archive-store-type"
+	presentationArchiveStoreTypeEnvKey    = "PRESENTATION_ARCHIVE_STORE_TYPE"
+	presentationArchiveStoreTypeFlagUsage = "Store type for archived presentations of verifier profiles with enabled " +
+		"presentation archive. Supported: mongodb,s3. Default: mongodb. " +
+		commonEnvVarUsageText + presentationArchiveStoreTypeEnvKey
+
+	presentationArchiveS3BucketFlagName  = "presentation-archive-s3-bucket"
+	presentationArchiveS3BucketEnvKey    = "PRESENTATION_ARCHIVE_S3_BUCKET"
+	presentationArchiveS3BucketFlagUsage = "Presentation archive S3 Bucket. " +
+		commonEnvVarUsageText + presentationArchiveS3BucketEnvKey
+
+	presentationArchiveS3RegionFlagName  = "presentation-archive-s3-region"
+	presentationArchiveS3RegionEnvKey    = "PRESENTATION_ARCHIVE_S3_REGION"
+	presentationArchiveS3RegionFlagUsage = "Presentation archive S3 Region. " +
+		commonEnvVarUsageText + presentationArchiveS3RegionEnvKey
+
+	presentationArchivePurgeIntervalFlagName  = "presentation-archive-purge-interval"
+	presentationArchivePurgeIntervalEnvKey    = "PRESENTATION_ARCHIVE_PURGE_INTERVAL"
+	presentationArchivePurgeIntervalFlagUsage = "Interval of deleting archived presentations with expired retention " +
+		"period, e.g. 30m. Default: 1h. " + commonEnvVarUsageText + presentationArchivePurgeIntervalEnvKey
+
+	x509TrustAnchorsFlagName  = "x509-trust-anchors"
+	x509TrustAnchorsEnvKey    = "VC_REST_X509_TRUST_ANCHORS"
+	x509TrustAnchorsFlagUsage = "Comma-Separated list of trust anchor certificate files used to validate X.509 " +
+		"certificate chains (x5c) of credential issuers. " + commonEnvVarUsageText + x509TrustAnchorsEnvKey
+
+	x509CRLsFlagName  = "x509-crls"
+	x509CRLsEnvKey    = "VC_REST_X509_CRLS"
+	x509CRLsFlagUsage = "Comma-Separated list of CRL files used to check revocation of X.509 certificates " +
+		"of credential issuers. " + commonEnvVarUsageText + x509CRLsEnvKey
+
 	issuerTopicFlagName  = "issuer-event-topic"
 	issuerTopicEnvKey    = "VC_REST_ISSUER_EVENT_TOPIC"
 	issuerTopicFlagUsage = "The name of the issuer event topic. " + commonEnvVarUsageText + issuerTopicEnvKey
@@ -374,6 +461,7 @@ const (
 
 	defaultHTTPDialTimeout   = 2 * time.Second
 	defaultHTTPTimeout       = 20 * time.Second
+	defaultVDRCacheSize      = 1000
 	defaultForceAttemptHTTP2 = true
 
 	redisStore = "redis"
@@ -423,6 +511,18 @@ type startupParameters struct {
 	cslStoreS3Bucket                    string
 	cslStoreS3Region                    string
 	cslStoreS3HostName                  string
+	cslCacheSize                        int
+	cslCacheTTL                         time.Duration
+	cslCacheStaleWhileRevalidate        time.Duration
+	cslBatchWindow                      time.Duration
+	cslBatchSize                        int
+	presentationArchiveStoreType        string
+	presentationArchiveS3Bucket         string
+	presentationArchiveS3Region         string
+	presentationArchivePurgeInterval    time.Duration
+	vdrCacheParams                      *vdrCacheParams
+	x509TrustAnchors                    []string
+	x509CRLs                            []string
 	issuerEventTopic                    string
 	verifierEventTopic                  string
 	credentialStatusEventTopic          string
@@ -450,6 +550,14 @@ type prometheusMetricsProviderParams struct {
 	url string
 }
 
+type vdrCacheParams struct {
+	size        int
+	ttl         time.Duration
+	methodTTL   map[string]time.Duration
+	notFoundTTL time.Duration
+	shared      bool
+}
+
 type tracingParams struct {
 	exporter    tracing.SpanExporterType
 	serviceName string
@@ -688,6 +796,68 @@ func getStartupParameters(cmd *cobra.Command) (*startupParameters, error) {
 		cslStoreS3HostNameEnvKey,
 	)
 
+	var cslCacheSize int
+
+	if cslCacheSizeStr := cmdutils.GetUserSetOptionalVarFromString(
+		cmd, cslCacheSizeFlagName, cslCacheSizeEnvKey); cslCacheSizeStr != "" {
+		cslCacheSize, err = strconv.Atoi(cslCacheSizeStr)
+		if err != nil || cslCacheSize < 0 {
+			return nil, fmt.Errorf("invalid value [%s] for %s", cslCacheSizeStr, cslCacheSizeFlagName)
+		}
+	}
+
+	cslCacheTTL, err := getDuration(cmd, cslCacheTTLFlagName, cslCacheTTLEnvKey, 0)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", cslCacheTTLFlagName, err)
+	}
+
+	cslCacheStaleWhileRevalidate, err := getDuration(cmd, cslCacheStaleWhileRevalidateFlagName,
+		cslCacheStaleWhileRevalidateEnvKey, 0)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", cslCacheStaleWhileRevalidateFlagName, err)
+	}
+
+	cslBatchWindow, err := getDuration(cmd, cslBatchWindowFlagName, cslBatchWindowEnvKey, 0)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", cslBatchWindowFlagName, err)
+	}
+
+	if cslBatchWindow < 0 {
+		return nil, fmt.Errorf("%v: window must be positive", cslBatchWindowFlagName)
+	}
+
+	var cslBatchSize int
+
+	if cslBatchSizeStr := cmdutils.GetUserSetOptionalVarFromString(
+		cmd, cslBatchSizeFlagName, cslBatchSizeEnvKey); cslBatchSizeStr != "" {
+		cslBatchSize, err = strconv.Atoi(cslBatchSizeStr)
+		if err != nil || cslBatchSize < 0 {
+			return nil, fmt.Errorf("invalid value [%s] for %s", cslBatchSizeStr, cslBatchSizeFlagName)
+		}
+	}
+
+	presentationArchiveStoreType := cmdutils.GetUserSetOptionalVarFromString(
+		cmd,
+		presentationArchiveStoreTypeFlagName,
+		presentationArchiveStoreTypeEnvKey,
+	)
+	presentationArchiveS3Bucket := cmdutils.GetUserSetOptionalVarFromString(
+		cmd,
+		presentationArchiveS3BucketFlagName,
+		presentationArchiveS3BucketEnvKey,
+	)
+	presentationArchiveS3Region := cmdutils.GetUserSetOptionalVarFromString(
+		cmd,
+		presentationArchiveS3RegionFlagName,
+		presentationArchiveS3RegionEnvKey,
+	)
+
+	presentationArchivePurgeInterval, err := getDuration(cmd, presentationArchivePurgeIntervalFlagName,
+		presentationArchivePurgeIntervalEnvKey, 0)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", presentationArchivePurgeIntervalFlagName, err)
+	}
+
 	issuerTopic := cmdutils.GetUserSetOptionalVarFromString(cmd, issuerTopicFlagName, issuerTopicEnvKey)
 	if issuerTopic == "" {
 		issuerTopic = spi.IssuerEventTopic
@@ -703,6 +873,15 @@ func getStartupParameters(cmd *cobra.Command) (*startupParameters, error) {
 		credentialStatusTopic = spi.CredentialStatusEventTopic
 	}
 
+	vdrCacheParams, err := getVDRCacheParams(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	x509TrustAnchors := cmdutils.GetUserSetOptionalVarFromArrayString(cmd, x509TrustAnchorsFlagName,
+		x509TrustAnchorsEnvKey)
+	x509CRLs := cmdutils.GetUserSetOptionalVarFromArrayString(cmd, x509CRLsFlagName, x509CRLsEnvKey)
+
 	tracingParams, err := getTracingParams(cmd)
 	if err != nil {
 		return nil, err
@@ -760,6 +939,18 @@ func getStartupParameters(cmd *cobra.Command) (*startupParameters, error) {
 		cslStoreS3Bucket:                    cslStoreS3Bucket,
 		cslStoreS3Region:                    cslStoreS3Region,
 		cslStoreS3HostName:                  cslStoreS3HostName,
+		cslCacheSize:                        cslCacheSize,
+		cslCacheTTL:                         cslCacheTTL,
+		cslCacheStaleWhileRevalidate:        cslCacheStaleWhileRevalidate,
+		cslBatchWindow:                      cslBatchWindow,
+		cslBatchSize:                        cslBatchSize,
+		presentationArchiveStoreType:        presentationArchiveStoreType,
+		presentationArchiveS3Bucket:         presentationArchiveS3Bucket,
+		presentationArchiveS3Region:         presentationArchiveS3Region,
+		presentationArchivePurgeInterval:    presentationArchivePurgeInterval,
+		vdrCacheParams:                      vdrCacheParams,
+		x509TrustAnchors:                    x509TrustAnchors,
+		x509CRLs:                            x509CRLs,
 		issuerEventTopic:                    issuerTopic,
 		verifierEventTopic:                  verifierTopic,
 		credentialStatusEventTopic:          credentialStatusTopic,
@@ -1090,6 +1281,57 @@ func getRequestTokens(cmd *cobra.Command) map[string]string {
 	return tokens
 }
 
+func getVDRCacheParams(cmd *cobra.Command) (*vdrCacheParams, error) {
+	var err error
+
+	size := defaultVDRCacheSize
+
+	if sizeStr := cmdutils.GetUserSetOptionalVarFromString(
+		cmd, vdrCacheSizeFlagName, vdrCacheSizeEnvKey); sizeStr != "" {
+		size, err = strconv.Atoi(sizeStr)
+		if err != nil || size < 0 {
+			return nil, fmt.Errorf("invalid value [%s] for %s", sizeStr, vdrCacheSizeFlagName)
+		}
+	}
+
+	ttl, err := getDuration(cmd, vdrCacheTTLFlagName, vdrCacheTTLEnvKey, 0)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", vdrCacheTTLFlagName, err)
+	}
+
+	methodTTL := map[string]time.Duration{}
+
+	for _, v := range cmdutils.GetUserSetOptionalCSVVar(cmd, vdrCacheMethodTTLFlagName, vdrCacheMethodTTLEnvKey) {
+		method, ttlStr, ok := strings.Cut(v, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid value [%s] for %s", v, vdrCacheMethodTTLFlagName)
+		}
+
+		methodTTL[method], err = time.ParseDuration(ttlStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value [%s] for %s: %w", v, vdrCacheMethodTTLFlagName, err)
+		}
+	}
+
+	notFoundTTL, err := getDuration(cmd, vdrCacheNotFoundTTLFlagName, vdrCacheNotFoundTTLEnvKey, 0)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", vdrCacheNotFoundTTLFlagName, err)
+	}
+
+	shared, err := getBoolean(cmd, vdrCacheSharedFlagName, vdrCacheSharedEnvKey, false)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", vdrCacheSharedFlagName, err)
+	}
+
+	return &vdrCacheParams{
+		size:        size,
+		ttl:         ttl,
+		methodTTL:   methodTTL,
+		notFoundTTL: notFoundTTL,
+		shared:      shared,
+	}, nil
+}
+
 func getTracingParams(cmd *cobra.Command) (*tracingParams, error) {
 	serviceName := cmdutils.GetOptionalString(cmd, otelServiceNameFlagName, otelServiceNameEnvKey)
 	if serviceName == "" {
@@ -1170,6 +1412,22 @@ func createFlags(startCmd *cobra.Command) {
 	startCmd.Flags().String(cslStoreS3BucketFlagName, "", cslStoreS3BucketFlagUsage)
 	startCmd.Flags().String(cslStoreS3RegionFlagName, "", cslStoreS3RegionFlagUsage)
 	startCmd.Flags().String(cslStoreS3HostNameFlagName, "", cslStoreS3HostNameFlagUsage)
+	startCmd.Flags().String(cslCacheSizeFlagName, "", cslCacheSizeFlagUsage)
+	startCmd.Flags().String(cslCacheTTLFlagName, "", cslCacheTTLFlagUsage)
+	startCmd.Flags().String(cslCacheStaleWhileRevalidateFlagName, "", cslCacheStaleWhileRevalidateFlagUsage)
+	startCmd.Flags().String(cslBatchWindowFlagName, "", cslBatchWindowFlagUsage)
+	startCmd.Flags().String(cslBatchSizeFlagName, "", cslBatchSizeFlagUsage)
+	startCmd.Flags().String(presentationArchiveStoreTypeFlagName, "", presentationArchiveStoreTypeFlagUsage)
+	startCmd.Flags().String(presentationArchiveS3BucketFlagName, "", presentationArchiveS3BucketFlagUsage)
+	startCmd.Flags().String(presentationArchiveS3RegionFlagName, "", presentationArchiveS3RegionFlagUsage)
+	startCmd.Flags().String(presentationArchivePurgeIntervalFlagName, "", presentationArchivePurgeIntervalFlagUsage)
+	startCmd.Flags().String(vdrCacheSizeFlagName, "", vdrCacheSizeFlagUsage)
+	startCmd.Flags().String(vdrCacheTTLFlagName, "", vdrCacheTTLFlagUsage)
+	startCmd.Flags().StringSlice(vdrCacheMethodTTLFlagName, []string{}, vdrCacheMethodTTLFlagUsage)
+	startCmd.Flags().String(vdrCacheNotFoundTTLFlagName, "", vdrCacheNotFoundTTLFlagUsage)
+	startCmd.Flags().String(vdrCacheSharedFlagName, "", vdrCacheSharedFlagUsage)
+	startCmd.Flags().StringSlice(x509TrustAnchorsFlagName, []string{}, x509TrustAnchorsFlagUsage)
+	startCmd.Flags().StringSlice(x509CRLsFlagName, []string{}, x509CRLsFlagUsage)
 
 	startCmd.Flags().StringP(issuerTopicFlagName, "", "", issuerTopicFlagUsage)
 	startCmd.Flags().StringP(verifierTopicFlagName, "", "", verifierTopicFlagUsage)
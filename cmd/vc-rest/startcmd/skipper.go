@@ -4,10 +4,12 @@ import (
 	"github.com/labstack/echo/v4"
 	echomw "github.com/labstack/echo/v4/middleware"
 	"strings"
+
+	"github.com/trustbloc/vcs/pkg/restapi/v1/didweb"
 )
 
 func OApiSkipper(c echo.Context) bool {
-	if c.Path() == devApiRequestObjectEndpoint || c.Path() == devApiDidConfigEndpoint {
+	if c.Path() == requestObjectEndpoint || c.Path() == devApiDidConfigEndpoint {
 		return true
 	}
 	if c.Path() == versionEndpoint || c.Path() == versionSystemEndpoint {
@@ -17,6 +19,9 @@ func OApiSkipper(c echo.Context) bool {
 	if c.Path() == logLevelsEndpoint {
 		return true
 	}
+	if didweb.IsRoute(c.Path()) {
+		return true
+	}
 	if strings.Contains(c.Path(), profilerEndpoints) {
 		return true
 	}
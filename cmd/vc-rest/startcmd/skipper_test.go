@@ -38,6 +38,26 @@ func TestOApiSkipper(t *testing.T) {
 			path:   "/debug/pprof/some/other/path",
 			result: true,
 		},
+		{
+			name:   "well-known did.json endpoint",
+			path:   "/.well-known/did.json",
+			result: true,
+		},
+		{
+			name:   "did.json endpoint",
+			path:   "/:p1/:p2/did.json",
+			result: true,
+		},
+		{
+			name:   "did configuration endpoint",
+			path:   "/.well-known/did-configuration.json",
+			result: true,
+		},
+		{
+			name:   "did.json path of other endpoint",
+			path:   "/verifier/receipts/did.json",
+			result: false,
+		},
 		{
 			name:   "other endpoint",
 			path:   "/some/other/path",
@@ -35,6 +35,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/trustbloc/did-go/doc/ld/context/remote"
 	"github.com/trustbloc/did-go/doc/ld/documentloader"
+	vdrapi "github.com/trustbloc/did-go/vdr/api"
 	"github.com/trustbloc/logutil-go/pkg/log"
 	"github.com/trustbloc/vc-go/proof/defaults"
 	"github.com/trustbloc/vc-go/vermethod"
@@ -58,6 +59,8 @@ import (
 	"github.com/trustbloc/vcs/pkg/doc/validator/jsonschema"
 	"github.com/trustbloc/vcs/pkg/doc/vc/crypto"
 	"github.com/trustbloc/vcs/pkg/doc/vc/statustype"
+	"github.com/trustbloc/vcs/pkg/doc/vc/x5c"
+	"github.com/trustbloc/vcs/pkg/event/spi"
 	"github.com/trustbloc/vcs/pkg/kms"
 	"github.com/trustbloc/vcs/pkg/ld"
 	"github.com/trustbloc/vcs/pkg/oauth2client"
@@ -76,22 +79,30 @@ import (
 	profilereader "github.com/trustbloc/vcs/pkg/profile/reader"
 	"github.com/trustbloc/vcs/pkg/restapi/resterr"
 	"github.com/trustbloc/vcs/pkg/restapi/v1/devapi"
+	didwebapi "github.com/trustbloc/vcs/pkg/restapi/v1/didweb"
 	issuerv1 "github.com/trustbloc/vcs/pkg/restapi/v1/issuer"
 	"github.com/trustbloc/vcs/pkg/restapi/v1/logapi"
 	"github.com/trustbloc/vcs/pkg/restapi/v1/mw"
 	oidc4civ1 "github.com/trustbloc/vcs/pkg/restapi/v1/oidc4ci"
 	oidc4vpv1 "github.com/trustbloc/vcs/pkg/restapi/v1/oidc4vp"
+	requestobjectapi "github.com/trustbloc/vcs/pkg/restapi/v1/requestobject"
 	verifierv1 "github.com/trustbloc/vcs/pkg/restapi/v1/verifier"
 	"github.com/trustbloc/vcs/pkg/restapi/v1/version"
 	"github.com/trustbloc/vcs/pkg/service/clientidscheme"
 	clientmanagersvc "github.com/trustbloc/vcs/pkg/service/clientmanager"
+	"github.com/trustbloc/vcs/pkg/service/credentialrefresh"
 	credentialstatustypes "github.com/trustbloc/vcs/pkg/service/credentialstatus"
 	"github.com/trustbloc/vcs/pkg/service/didconfiguration"
+	"github.com/trustbloc/vcs/pkg/service/didweb"
 	"github.com/trustbloc/vcs/pkg/service/issuecredential"
+	"github.com/trustbloc/vcs/pkg/service/keyrotation"
 	"github.com/trustbloc/vcs/pkg/service/oidc4ci"
 	"github.com/trustbloc/vcs/pkg/service/oidc4vp"
+	"github.com/trustbloc/vcs/pkg/service/presentationarchive"
 	"github.com/trustbloc/vcs/pkg/service/requestobject"
 	"github.com/trustbloc/vcs/pkg/service/trustregistry"
+	"github.com/trustbloc/vcs/pkg/service/txcodenotifier"
+	"github.com/trustbloc/vcs/pkg/service/verificationreceipt"
 	"github.com/trustbloc/vcs/pkg/service/verifycredential"
 	"github.com/trustbloc/vcs/pkg/service/verifypresentation"
 	wellknownfetcher "github.com/trustbloc/vcs/pkg/service/wellknown/fetcher"
@@ -99,16 +110,22 @@ import (
 	"github.com/trustbloc/vcs/pkg/storage/mongodb"
 	clientmanagerstore "github.com/trustbloc/vcs/pkg/storage/mongodb/clientmanager"
 	"github.com/trustbloc/vcs/pkg/storage/mongodb/cslindexstore"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb/cslpendingchangesstore"
 	"github.com/trustbloc/vcs/pkg/storage/mongodb/cslvcstore"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb/keyrotationstore"
 	claimdatastoremongo "github.com/trustbloc/vcs/pkg/storage/mongodb/oidc4ciclaimdatastore"
 	oidc4cinoncestoremongo "github.com/trustbloc/vcs/pkg/storage/mongodb/oidc4cinoncestore"
 	oidc4cistatestoremongo "github.com/trustbloc/vcs/pkg/storage/mongodb/oidc4cistatestore"
 	oidc4vpclaimsstoremongo "github.com/trustbloc/vcs/pkg/storage/mongodb/oidc4vpclaimsstore"
 	oidc4vpnoncestoremongo "github.com/trustbloc/vcs/pkg/storage/mongodb/oidc4vpnoncestore"
 	oidc4vptxstoremongo "github.com/trustbloc/vcs/pkg/storage/mongodb/oidc4vptxstore"
+	presentationarchivestoremongo "github.com/trustbloc/vcs/pkg/storage/mongodb/presentationarchivestore"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb/refreshchallengestore"
 	requestobjectstoremongo "github.com/trustbloc/vcs/pkg/storage/mongodb/requestobjectstore"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb/statusstreamtokenstore"
 	"github.com/trustbloc/vcs/pkg/storage/mongodb/vcissuancehistorystore"
 	"github.com/trustbloc/vcs/pkg/storage/mongodb/vcstatusstore"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb/verificationreceiptstore"
 	"github.com/trustbloc/vcs/pkg/storage/redis"
 	redisclient "github.com/trustbloc/vcs/pkg/storage/redis"
 	"github.com/trustbloc/vcs/pkg/storage/redis/ackstore"
@@ -118,9 +135,12 @@ import (
 	oidc4vpclaimsstoreredis "github.com/trustbloc/vcs/pkg/storage/redis/oidc4vpclaimsstore"
 	oidc4vpnoncestoreredis "github.com/trustbloc/vcs/pkg/storage/redis/oidc4vpnoncestore"
 	oidc4vptxstoreredis "github.com/trustbloc/vcs/pkg/storage/redis/oidc4vptxstore"
+	"github.com/trustbloc/vcs/pkg/storage/redis/vdrcachestore"
 	"github.com/trustbloc/vcs/pkg/storage/s3/credentialoffer"
 	cslstores3 "github.com/trustbloc/vcs/pkg/storage/s3/cslvcstore"
+	presentationarchivestores3 "github.com/trustbloc/vcs/pkg/storage/s3/presentationarchivestore"
 	requestobjectstores3 "github.com/trustbloc/vcs/pkg/storage/s3/requestobjectstore"
+	"github.com/trustbloc/vcs/pkg/vdrcache"
 )
 
 const (
@@ -130,7 +150,7 @@ const (
 	defaultGracefulShutdownDuration = 1 * time.Second
 	defaultHealthCheckTimeout       = 5 * time.Second
 	cslSize                         = 10000
-	devApiRequestObjectEndpoint     = "/request-object/:uuid"
+	requestObjectEndpoint           = "/request-object/:uuid"
 	devApiDidConfigEndpoint         = "/:profileType/profiles/:profileID/:profileVersion/well-known/did-config"
 	logLevelsEndpoint               = "/loglevels"
 	profilerEndpoints               = "/debug/pprof"
@@ -269,6 +289,11 @@ func createStartCmd(opts ...StartOpts) *cobra.Command {
 			logger.Info(fmt.Sprintf("[Graceful Shutdown] GOT SIGNAL %v", sg.String()))
 			logger.Info(fmt.Sprintf("[Graceful Shutdown] Sleeping for %v", shutdownDuration.String()))
 			time.Sleep(shutdownDuration)
+
+			for _, hook := range conf.shutdownHooks {
+				hook()
+			}
+
 			_ = internalEcho.Close()
 			logger.Info("[Graceful Shutdown] Exit")
 
@@ -488,6 +513,11 @@ func buildEchoHandler(
 		}
 	}
 
+	vdr, err := createCachingVDR(conf, redisClientNoTracing, metrics)
+	if err != nil {
+		return nil, err
+	}
+
 	mongodbClient, err := mongodb.New(
 		conf.StartupParameters.dbParameters.databaseURL,
 		conf.StartupParameters.dbParameters.databasePrefix+"vcs_db",
@@ -522,7 +552,7 @@ func buildEchoHandler(
 		return nil, err
 	}
 
-	vcCrypto := crypto.New(conf.VDR, documentLoader)
+	vcCrypto := crypto.New(vdr, documentLoader)
 
 	vcStatusStore := vcstatusstore.NewStore(mongodbClient)
 
@@ -552,7 +582,7 @@ func buildEchoHandler(
 	})
 
 	// Issuer Profile Management API
-	issuerProfileSvc, err := profilereader.NewIssuerReader(&profilereader.Config{
+	issuerProfileReader, err := profilereader.NewIssuerReader(&profilereader.Config{
 		TLSConfig:   tlsConfig,
 		KMSRegistry: kmsRegistry,
 		CMD:         cmd,
@@ -562,17 +592,47 @@ func buildEchoHandler(
 		return nil, err
 	}
 
+	keyRotationStore, err := keyrotationstore.NewStore(context.Background(), mongodbClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate key rotation store: %w", err)
+	}
+
+	// Signing key rotations are stored by any VCS instance and applied to the issuer profiles when they are read.
+	issuerProfileConfig := &keyrotation.ProfileServiceConfig{
+		ProfileService: issuerProfileReader,
+		Store:          keyRotationStore,
+	}
+
+	if cachingVDR, ok := vdr.(*vdrcache.Registry); ok {
+		issuerProfileConfig.DIDCache = cachingVDR
+	}
+
+	issuerProfileSvc := keyrotation.NewProfileService(issuerProfileConfig)
+
+	var cslPendingChangesStore credentialstatustypes.CSLPendingChangesStore
+
+	if conf.StartupParameters.cslBatchWindow > 0 || conf.StartupParameters.cslBatchSize > 0 {
+		cslPendingChangesStore, err = cslpendingchangesstore.New(context.Background(), mongodbClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create CSL pending changes store: %w", err)
+		}
+	}
+
 	// Create event service
 	eventSvc, err := event.Initialize(event.Config{
-		TLSConfig:      tlsConfig,
-		CMD:            cmd,
-		CSLVCStore:     cslVCStore,
-		ProfileService: issuerProfileSvc,
-		KMSRegistry:    kmsRegistry,
-		Crypto:         vcCrypto,
-		Tracer:         conf.Tracer,
-		IsTraceEnabled: conf.IsTraceEnabled,
-		DocumentLoader: documentLoader,
+		TLSConfig:              tlsConfig,
+		CMD:                    cmd,
+		CSLVCStore:             cslVCStore,
+		ProfileService:         issuerProfileSvc,
+		KMSRegistry:            kmsRegistry,
+		Crypto:                 vcCrypto,
+		Tracer:                 conf.Tracer,
+		IsTraceEnabled:         conf.IsTraceEnabled,
+		DocumentLoader:         documentLoader,
+		Metrics:                metrics,
+		CSLPendingChangesStore: cslPendingChangesStore,
+		CSLBatchWindow:         conf.StartupParameters.cslBatchWindow,
+		CSLBatchSize:           conf.StartupParameters.cslBatchSize,
 	})
 	if err != nil {
 		return nil, err
@@ -583,21 +643,25 @@ func buildEchoHandler(
 	var statusListVCSvc credentialstatustypes.ServiceInterface
 
 	statusListVCSvc, err = credentialstatus.New(&credentialstatus.Config{
-		VDR:                            conf.VDR,
-		HTTPClient:                     getHTTPClient(metricsProvider.ClientCredentialStatus),
-		RequestTokens:                  conf.StartupParameters.requestTokens,
-		DocumentLoader:                 documentLoader,
-		CSLVCStore:                     cslVCStore,
-		CSLManager:                     cslManager,
-		VCStatusStore:                  vcStatusStore,
-		ProfileService:                 issuerProfileSvc,
-		KMSRegistry:                    kmsRegistry,
-		Crypto:                         vcCrypto,
-		CMD:                            cmd,
-		CredentialIssuanceHistoryStore: vcIssuanceHistoryStore,
-		ExternalURL:                    conf.StartupParameters.hostURLExternal,
-		EventPublisher:                 eventSvc,
-		EventTopic:                     conf.StartupParameters.credentialStatusEventTopic,
+		VDR:                                 vdr,
+		HTTPClient:                          getHTTPClient(metricsProvider.ClientCredentialStatus),
+		RequestTokens:                       conf.StartupParameters.requestTokens,
+		DocumentLoader:                      documentLoader,
+		CSLVCStore:                          cslVCStore,
+		CSLManager:                          cslManager,
+		VCStatusStore:                       vcStatusStore,
+		ProfileService:                      issuerProfileSvc,
+		KMSRegistry:                         kmsRegistry,
+		Crypto:                              vcCrypto,
+		CMD:                                 cmd,
+		CredentialIssuanceHistoryStore:      vcIssuanceHistoryStore,
+		ExternalURL:                         conf.StartupParameters.hostURLExternal,
+		EventPublisher:                      eventSvc,
+		EventTopic:                          conf.StartupParameters.credentialStatusEventTopic,
+		Metrics:                             metrics,
+		StatusListCacheSize:                 conf.StartupParameters.cslCacheSize,
+		StatusListCacheTTL:                  conf.StartupParameters.cslCacheTTL,
+		StatusListCacheStaleWhileRevalidate: conf.StartupParameters.cslCacheStaleWhileRevalidate,
 	})
 	if err != nil {
 		return nil, err
@@ -619,6 +683,17 @@ func buildEchoHandler(
 		issueCredentialSvc = issuecredentialtracing.Wrap(issueCredentialSvc, conf.Tracer)
 	}
 
+	x509TrustStore, err := x5c.LoadTrustStore(conf.StartupParameters.x509TrustAnchors,
+		conf.StartupParameters.x509CRLs)
+	if err != nil {
+		return nil, fmt.Errorf("load x509 trust store: %w", err)
+	}
+
+	// credentialProofChecker checks proofs of credentials and presentations received by verifiers, including
+	// JWTs with "x5c" header issued under the trusted X.509 anchors.
+	credentialProofChecker := x5c.NewProofChecker(
+		defaults.NewDefaultProofChecker(vermethod.NewVDRResolver(vdr)), x509TrustStore)
+
 	var verifyCredentialSvc verifycredential.ServiceInterface
 
 	verifyCredentialSvc = verifycredential.New(&verifycredential.Config{
@@ -626,7 +701,8 @@ func buildEchoHandler(
 		VCStatusProcessorGetter: statustype.GetVCStatusProcessor,
 		StatusListVCResolver:    statusListVCSvc,
 		DocumentLoader:          documentLoader,
-		VDR:                     conf.VDR,
+		VDR:                     vdr,
+		X509TrustStore:          x509TrustStore,
 	})
 
 	if conf.IsTraceEnabled {
@@ -654,6 +730,15 @@ func buildEchoHandler(
 		return nil, fmt.Errorf("failed to instantiate claim data store: %w", err)
 	}
 
+	oidc4ciStateStore, err := getOIDC4CIAuthStateStore(
+		conf.StartupParameters.transientDataParams.storeType,
+		redisClient,
+		mongodbClient,
+		conf.StartupParameters.transientDataParams.oidc4ciAuthStateTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate new OIDC4CI state store: %w", err)
+	}
+
 	credentialOfferStore, err := createCredentialOfferStore( // credentialOfferStore is optional, so it can be nil
 		conf.StartupParameters.credentialOfferRepositoryS3Region,
 		conf.StartupParameters.credentialOfferRepositoryS3Bucket,
@@ -685,7 +770,7 @@ func buildEchoHandler(
 
 	jsonSchemaValidator := jsonschema.NewCachingValidator()
 
-	proofChecker := defaults.NewDefaultProofChecker(vermethod.NewVDRResolver(conf.VDR))
+	proofChecker := defaults.NewDefaultProofChecker(vermethod.NewVDRResolver(vdr))
 
 	trustRegistryService := trustregistry.NewService(
 		&trustregistry.Config{
@@ -705,10 +790,12 @@ func buildEchoHandler(
 	oidc4ciService, err = oidc4ci.NewService(&oidc4ci.Config{
 		TransactionStore:              oidc4ciTransactionStore,
 		ClaimDataStore:                oidc4ciClaimDataStore,
+		AuthStateStore:                oidc4ciStateStore,
 		WellKnownService:              wellknownfetcher.NewService(getHTTPClient(metricsProvider.ClientWellKnown)),
 		ProfileService:                issuerProfileSvc,
 		IssuerVCSPublicHost:           conf.StartupParameters.apiGatewayURL,
 		HTTPClient:                    getHTTPClient(metricsProvider.ClientOIDC4CI),
+		TLSConfig:                     tlsConfig,
 		EventService:                  eventSvc,
 		PinGenerator:                  otp.NewPinGenerator(),
 		EventTopic:                    conf.StartupParameters.issuerEventTopic,
@@ -722,6 +809,9 @@ func buildEchoHandler(
 		AckService:                    ackService,
 		Composer:                      oidc4ci.NewCredentialComposer(),
 		DocumentLoader:                documentLoader,
+		TxCodeNotifier: txcodenotifier.New(&txcodenotifier.Config{
+			HTTPClient: getHTTPClient(metricsProvider.ClientOIDC4CI),
+		}),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to instantiate new oidc4ci service: %w", err)
@@ -731,15 +821,6 @@ func buildEchoHandler(
 		oidc4ciService = oidc4citracing.Wrap(oidc4ciService, conf.Tracer)
 	}
 
-	oidc4ciStateStore, err := getOIDC4CIAuthStateStore(
-		conf.StartupParameters.transientDataParams.storeType,
-		redisClient,
-		mongodbClient,
-		conf.StartupParameters.transientDataParams.oidc4ciAuthStateTTL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to instantiate new OIDC4CI state store: %w", err)
-	}
-
 	apiKeySecurityProvider, err := securityprovider.NewSecurityProviderApiKey(
 		"header",
 		"X-API-Key",
@@ -840,7 +921,7 @@ func buildEchoHandler(
 		AckService:              ackService,
 		JWEEncrypterCreator:     jweEncrypterCreator,
 		DocumentLoader:          documentLoader,
-		Vdr:                     conf.VDR,
+		Vdr:                     vdr,
 		ProofChecker:            proofChecker,
 		LDPProofParser:          oidc4civ1.NewDefaultLDPProofParser(),
 	}))
@@ -851,6 +932,34 @@ func buildEchoHandler(
 		Tracer:            conf.Tracer,
 	}))
 
+	var verifyPresentationSvc verifypresentation.ServiceInterface
+
+	verifyPresentationSvc = verifypresentation.New(&verifypresentation.Config{
+		VcVerifier:     verifyCredentialSvc,
+		DocumentLoader: documentLoader,
+		VDR:            vdr,
+		ProofChecker:   credentialProofChecker,
+	})
+
+	if conf.IsTraceEnabled {
+		verifyPresentationSvc = verifypresentationtracing.Wrap(verifyPresentationSvc, conf.Tracer)
+	}
+
+	keyRotationSvc := keyrotation.New(&keyrotation.Config{
+		ProfileService: issuerProfileSvc,
+		KMSRegistry:    kmsRegistry,
+		VDR:            vdr,
+		EventSvc:       eventSvc,
+		EventTopic:     conf.StartupParameters.issuerEventTopic,
+		Store:          keyRotationStore,
+		DIDCache:       issuerProfileConfig.DIDCache,
+	})
+
+	refreshChallengeStore, err := refreshchallengestore.NewStore(context.Background(), mongodbClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate refresh challenge store: %w", err)
+	}
+
 	issuerv1.RegisterHandlers(e, issuerv1.NewController(&issuerv1.Config{
 		EventSvc:                       eventSvc,
 		EventTopic:                     conf.StartupParameters.issuerEventTopic,
@@ -864,6 +973,17 @@ func buildEchoHandler(
 		Tracer:                         conf.Tracer,
 		OpenidIssuerConfigProvider:     openidCredentialIssuerConfigProviderSvc,
 		JSONSchemaValidator:            jsonSchemaValidator,
+		KeyRotationService:             keyRotationSvc,
+		CredentialRefreshService: credentialrefresh.New(&credentialrefresh.Config{
+			PresentationVerifier: verifyPresentationSvc,
+			CredentialIssuer:     issueCredentialSvc,
+			ChallengeStore:       refreshChallengeStore,
+			SupersessionStore:    refreshChallengeStore,
+			HTTPClient:           getHTTPClient(metricsProvider.ClientOIDC4CI),
+			TLSConfig:            tlsConfig,
+			IssuerVCSPublicHost:  conf.StartupParameters.apiGatewayURL,
+		}),
+		ProofChecker: proofChecker,
 	}))
 
 	// Verifier Profile Management API
@@ -878,18 +998,6 @@ func buildEchoHandler(
 		return nil, err
 	}
 
-	var verifyPresentationSvc verifypresentation.ServiceInterface
-
-	verifyPresentationSvc = verifypresentation.New(&verifypresentation.Config{
-		VcVerifier:     verifyCredentialSvc,
-		DocumentLoader: documentLoader,
-		VDR:            conf.VDR,
-	})
-
-	if conf.IsTraceEnabled {
-		verifyPresentationSvc = verifypresentationtracing.Wrap(verifyPresentationSvc, conf.Tracer)
-	}
-
 	oidc4vpTxStore, err := getOIDC4VPTxStore(
 		conf.StartupParameters.transientDataParams.storeType,
 		redisClient,
@@ -900,6 +1008,16 @@ func buildEchoHandler(
 		return nil, fmt.Errorf("initiate OIDC4VPTxStore: %w", err)
 	}
 
+	interactionStatusHandler := oidc4vp.NewInteractionStatusHandler(oidc4vpTxStore)
+
+	interactionStatusSubscriber, err := event.NewEventSubscriber(eventSvc, conf.StartupParameters.verifierEventTopic,
+		func(e *spi.Event) error { return interactionStatusHandler.HandleEvent(context.Background(), e) })
+	if err != nil {
+		return nil, fmt.Errorf("subscribe interaction status handler: %w", err)
+	}
+
+	interactionStatusSubscriber.Start()
+
 	oidc4vpClaimsStore, err := getOIDC4VPClaimsStore(
 		conf.StartupParameters.transientDataParams.storeType,
 		redisClientNoTracing,
@@ -942,6 +1060,40 @@ func buildEchoHandler(
 
 	requestObjectStoreService := vp.NewRequestObjectStore(requestObjStore, requestObjStoreEndpoint)
 
+	verificationReceiptSvc := verificationreceipt.New(&verificationreceipt.Config{
+		KMSRegistry: kmsRegistry,
+		Store:       verificationreceiptstore.NewStore(mongodbClient),
+	})
+
+	presentationArchiveStore, err := presentationarchivestoremongo.NewStore(context.Background(), mongodbClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate presentation archive store: %w", err)
+	}
+
+	presentationArchivePayloadStore, err := createPresentationArchivePayloadStore(
+		conf.StartupParameters.presentationArchiveStoreType,
+		conf.StartupParameters.presentationArchiveS3Region,
+		conf.StartupParameters.presentationArchiveS3Bucket,
+		mongodbClient,
+		conf.IsTraceEnabled,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate presentation archive payload store: %w", err)
+	}
+
+	presentationArchiveSvc := presentationarchive.New(&presentationarchive.Config{
+		Store:          presentationArchiveStore,
+		PayloadStore:   presentationArchivePayloadStore,
+		DataProtector:  claimsDataProtector,
+		KMSRegistry:    kmsRegistry,
+		ProofChecker:   proofChecker,
+		ProfileService: verifierProfileSvc,
+		PurgeInterval:  conf.StartupParameters.presentationArchivePurgeInterval,
+	})
+
+	presentationArchiveSvc.Start()
+	conf.shutdownHooks = append(conf.shutdownHooks, presentationArchiveSvc.Stop)
+
 	var oidc4vpService oidc4vp.ServiceInterface
 
 	oidc4vpService = oidc4vp.NewService(&oidc4vp.Config{
@@ -950,31 +1102,42 @@ func buildEchoHandler(
 		TransactionManager:       oidc4vpTxManager,
 		RequestObjectPublicStore: requestObjectStoreService,
 		KMSRegistry:              kmsRegistry,
-		VDR:                      conf.VDR,
+		VDR:                      vdr,
 		DocumentLoader:           documentLoader,
 		ProfileService:           verifierProfileSvc,
 		PresentationVerifier:     verifyPresentationSvc,
 		TrustRegistry:            trustRegistryService,
+		ProofChecker:             credentialProofChecker,
 		RedirectURL:              conf.StartupParameters.apiGatewayURL + oidc4VPCheckEndpoint,
 		TokenLifetime:            15 * time.Minute,
 		Metrics:                  metrics,
+		ReceiptService:           verificationReceiptSvc,
+		PresentationArchive:      presentationArchiveSvc,
 	})
 
 	if conf.IsTraceEnabled {
 		oidc4vpService = oidc4vptracing.Wrap(oidc4vpService, conf.Tracer)
 	}
 
+	statusStreamTokenStore, err := statusstreamtokenstore.NewStore(context.Background(), mongodbClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate status stream token store: %w", err)
+	}
+
 	verifierController := verifierv1.NewController(&verifierv1.Config{
-		VerifyCredentialSvc: verifyCredentialSvc,
-		ProfileSvc:          verifierProfileSvc,
-		KMSRegistry:         kmsRegistry,
-		DocumentLoader:      documentLoader,
-		VDR:                 conf.VDR,
-		OIDCVPService:       oidc4vpService,
-		Metrics:             metrics,
-		Tracer:              conf.Tracer,
-		EventSvc:            eventSvc,
-		EventTopic:          conf.StartupParameters.verifierEventTopic,
+		VerifyCredentialSvc:    verifyCredentialSvc,
+		ProfileSvc:             verifierProfileSvc,
+		KMSRegistry:            kmsRegistry,
+		DocumentLoader:         documentLoader,
+		VDR:                    vdr,
+		OIDCVPService:          oidc4vpService,
+		Metrics:                metrics,
+		Tracer:                 conf.Tracer,
+		EventSvc:               eventSvc,
+		EventTopic:             conf.StartupParameters.verifierEventTopic,
+		ReceiptSvc:             verificationReceiptSvc,
+		PresentationArchive:    presentationArchiveSvc,
+		StatusStreamTokenStore: statusStreamTokenStore,
 	})
 
 	verifierv1.RegisterHandlers(e, verifierController)
@@ -989,11 +1152,23 @@ func buildEchoHandler(
 
 	if conf.StartupParameters.devMode {
 		_ = devapi.NewController(&devapi.Config{
-			DidConfigService:          didConfigSvc,
-			RequestObjectStoreService: requestObjectStoreService,
+			DidConfigService: didConfigSvc,
 		}, e)
 	}
 
+	_ = requestobjectapi.NewController(&requestobjectapi.Config{
+		RequestObjectStoreService: requestObjectStoreService,
+		OIDC4VPService:            oidc4vpService,
+	}, e)
+
+	_ = didwebapi.NewController(&didwebapi.Config{
+		DIDWebService: didweb.New(&didweb.Config{
+			IssuerProfileService:   issuerProfileSvc,
+			VerifierProfileService: verifierProfileSvc,
+			DIDConfigService:       didConfigSvc,
+		}),
+	}, e)
+
 	_ = logapi.NewController(e)
 
 	metricsProvider, err := NewMetricsProvider(conf.StartupParameters, internalEchoServer)
@@ -1018,6 +1193,12 @@ type requestObjectStore interface {
 	GetResourceURL(id string) string
 }
 
+type presentationArchivePayloadStore interface {
+	Put(ctx context.Context, txID string, data []byte) error
+	Get(ctx context.Context, txID string) ([]byte, error)
+	Delete(ctx context.Context, txID string) error
+}
+
 type credentialOfferReferenceStore interface {
 	Create(
 		ctx context.Context,
@@ -1029,6 +1210,41 @@ type credentialOfferReferenceStore interface {
 	) (string, error)
 }
 
+func createCachingVDR(
+	conf *Configuration,
+	redisClientNoTracing *redis.Client,
+	metrics metricsProvider.Metrics,
+) (vdrapi.Registry, error) {
+	params := conf.StartupParameters.vdrCacheParams
+	if params == nil || params.size == 0 {
+		return conf.VDR, nil
+	}
+
+	cacheConfig := &vdrcache.Config{
+		VDR:         conf.VDR,
+		Size:        params.size,
+		TTL:         params.ttl,
+		MethodTTL:   params.methodTTL,
+		NotFoundTTL: params.notFoundTTL,
+		Metrics:     metrics,
+	}
+
+	if params.shared {
+		if redisClientNoTracing == nil {
+			return nil, fmt.Errorf("%s requires %s transient data store", vdrCacheSharedFlagName, redisStore)
+		}
+
+		cacheConfig.SharedCache = vdrcachestore.New(redisClientNoTracing)
+	}
+
+	vdr, err := vdrcache.New(cacheConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create caching vdr: %w", err)
+	}
+
+	return vdr, nil
+}
+
 func getOIDC4VPClaimsStore(
 	transientDataStoreType string,
 	redisClientNoTracing *redis.Client,
@@ -1214,6 +1430,30 @@ func createRequestObjectStore(
 	}
 }
 
+func createPresentationArchivePayloadStore(
+	storeType string,
+	s3Region string,
+	s3Bucket string,
+	mongoDbClient *mongodb.Client,
+	isTraceEnabled bool,
+) (presentationArchivePayloadStore, error) {
+	switch strings.ToLower(storeType) {
+	case "s3":
+		cfg, err := awsconfig.LoadDefaultConfig(context.Background(), awsconfig.WithRegion(s3Region))
+		if err != nil {
+			return nil, err
+		}
+
+		if isTraceEnabled {
+			otelaws.AppendMiddlewares(&cfg.APIOptions, otelaws.WithTracerProvider(otel.GetTracerProvider()))
+		}
+
+		return presentationarchivestores3.NewPayloadStore(s3.NewFromConfig(cfg), s3Bucket), nil
+	default:
+		return presentationarchivestoremongo.NewPayloadStore(mongoDbClient), nil
+	}
+}
+
 func createCredentialOfferStore(
 	s3Region string,
 	s3Bucket string,
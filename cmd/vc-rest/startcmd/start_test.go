@@ -32,6 +32,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/trustbloc/vcs/cmd/common"
+	"github.com/trustbloc/vcs/pkg/vdrcache"
 )
 
 const (
@@ -411,6 +412,38 @@ func TestCreateVDRI(t *testing.T) {
 	})
 }
 
+func TestCreateCachingVDR(t *testing.T) {
+	vdr, err := createVDRI("localhost:8083", "", &tls.Config{MinVersion: tls.VersionTLS12})
+	require.NoError(t, err)
+
+	t.Run("caching disabled", func(t *testing.T) {
+		v, err := createCachingVDR(&Configuration{
+			VDR:               vdr,
+			StartupParameters: &startupParameters{vdrCacheParams: &vdrCacheParams{}},
+		}, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, vdr, v)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		v, err := createCachingVDR(&Configuration{
+			VDR:               vdr,
+			StartupParameters: &startupParameters{vdrCacheParams: &vdrCacheParams{size: 10}},
+		}, nil, nil)
+		require.NoError(t, err)
+		require.IsType(t, &vdrcache.Registry{}, v)
+	})
+
+	t.Run("shared cache requires redis", func(t *testing.T) {
+		v, err := createCachingVDR(&Configuration{
+			VDR:               vdr,
+			StartupParameters: &startupParameters{vdrCacheParams: &vdrCacheParams{size: 10, shared: true}},
+		}, nil, nil)
+		require.ErrorContains(t, err, "vdr-cache-shared requires redis transient data store")
+		require.Nil(t, v)
+	})
+}
+
 func TestAcceptedDIDs(t *testing.T) {
 	t.Run("Test accepted DID methods", func(t *testing.T) {
 		tests := []struct {
@@ -495,6 +528,163 @@ func TestHTTPDialTimeoutInvalidArgsEnvVar(t *testing.T) {
 	require.Contains(t, err.Error(), "http-dial-timeout: invalid value [wrongvalue]: time: invalid duration")
 }
 
+func TestCSLCacheInvalidArgsEnvVar(t *testing.T) {
+	tests := []struct {
+		envKey string
+		errMsg string
+	}{
+		{
+			envKey: cslCacheSizeEnvKey,
+			errMsg: "invalid value [wrongvalue] for csl-cache-size",
+		},
+		{
+			envKey: cslCacheTTLEnvKey,
+			errMsg: "csl-cache-ttl: invalid value [wrongvalue]: time: invalid duration",
+		},
+		{
+			envKey: cslCacheStaleWhileRevalidateEnvKey,
+			errMsg: "csl-cache-stale-while-revalidate: invalid value [wrongvalue]: time: invalid duration",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.envKey, func(t *testing.T) {
+			startCmd := GetStartCmd()
+
+			setEnvVars(t, databaseTypeMongoDBOption, "")
+
+			defer unsetEnvVars(t)
+			require.NoError(t, os.Setenv(tc.envKey, "wrongvalue"))
+
+			err := startCmd.Execute()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
+
+func TestVDRCacheInvalidArgsEnvVar(t *testing.T) {
+	tests := []struct {
+		envKey string
+		value  string
+		errMsg string
+	}{
+		{
+			envKey: vdrCacheSizeEnvKey,
+			value:  "wrongvalue",
+			errMsg: "invalid value [wrongvalue] for vdr-cache-size",
+		},
+		{
+			envKey: vdrCacheTTLEnvKey,
+			value:  "wrongvalue",
+			errMsg: "vdr-cache-ttl: invalid value [wrongvalue]: time: invalid duration",
+		},
+		{
+			envKey: vdrCacheMethodTTLEnvKey,
+			value:  "web",
+			errMsg: "invalid value [web] for vdr-cache-method-ttl",
+		},
+		{
+			envKey: vdrCacheMethodTTLEnvKey,
+			value:  "web=wrongvalue",
+			errMsg: "invalid value [web=wrongvalue] for vdr-cache-method-ttl: time: invalid duration",
+		},
+		{
+			envKey: vdrCacheNotFoundTTLEnvKey,
+			value:  "wrongvalue",
+			errMsg: "vdr-cache-not-found-ttl: invalid value [wrongvalue]: time: invalid duration",
+		},
+		{
+			envKey: vdrCacheSharedEnvKey,
+			value:  "wrongvalue",
+			errMsg: "vdr-cache-shared: invalid value [wrongvalue]",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.envKey, func(t *testing.T) {
+			startCmd := GetStartCmd()
+
+			setEnvVars(t, databaseTypeMongoDBOption, "")
+
+			defer unsetEnvVars(t)
+			require.NoError(t, os.Setenv(tc.envKey, tc.value))
+
+			err := startCmd.Execute()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
+
+func TestCSLBatchWindowInvalidArgsEnvVar(t *testing.T) {
+	startCmd := GetStartCmd()
+
+	setEnvVars(t, databaseTypeMongoDBOption, "")
+
+	defer unsetEnvVars(t)
+	require.NoError(t, os.Setenv(cslBatchWindowEnvKey, "wrongvalue"))
+
+	err := startCmd.Execute()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "csl-batch-window: invalid value [wrongvalue]: time: invalid duration")
+}
+
+func TestCSLBatchWindowNegativeEnvVar(t *testing.T) {
+	startCmd := GetStartCmd()
+
+	setEnvVars(t, databaseTypeMongoDBOption, "")
+
+	defer unsetEnvVars(t)
+	require.NoError(t, os.Setenv(cslBatchWindowEnvKey, "-5s"))
+
+	err := startCmd.Execute()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "csl-batch-window: window must be positive")
+}
+
+func TestCSLBatchWindowDefault(t *testing.T) {
+	startCmd := GetStartCmd()
+
+	setEnvVars(t, databaseTypeMongoDBOption, "")
+
+	defer unsetEnvVars(t)
+	require.NoError(t, os.Setenv(cslBatchSizeEnvKey, "10"))
+
+	params, err := getStartupParameters(startCmd)
+	require.NoError(t, err)
+	require.Equal(t, 10, params.cslBatchSize)
+	// Batching is enabled by the size, the default window is applied by the CSL event handler.
+	require.Zero(t, params.cslBatchWindow)
+}
+
+func TestCSLBatchSizeInvalidArgsEnvVar(t *testing.T) {
+	startCmd := GetStartCmd()
+
+	setEnvVars(t, databaseTypeMongoDBOption, "")
+
+	defer unsetEnvVars(t)
+	require.NoError(t, os.Setenv(cslBatchSizeEnvKey, "wrongvalue"))
+
+	err := startCmd.Execute()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid value [wrongvalue] for csl-batch-size")
+}
+
+func TestPresentationArchivePurgeIntervalInvalidArgsEnvVar(t *testing.T) {
+	startCmd := GetStartCmd()
+
+	setEnvVars(t, databaseTypeMongoDBOption, "")
+
+	defer unsetEnvVars(t)
+	require.NoError(t, os.Setenv(presentationArchivePurgeIntervalEnvKey, "wrongvalue"))
+
+	err := startCmd.Execute()
+	require.Error(t, err)
+	require.Contains(t, err.Error(),
+		"presentation-archive-purge-interval: invalid value [wrongvalue]: time: invalid duration")
+}
+
 func TestValidateAuthorizationBearerToken(t *testing.T) {
 	t.Run("test invalid token", func(t *testing.T) {
 		header := make(map[string][]string)
@@ -650,6 +840,51 @@ func unsetEnvVars(t *testing.T) {
 	err = os.Unsetenv(httpForceAttemptHTTP2EnvKey)
 	require.NoError(t, err)
 
+	err = os.Unsetenv(cslCacheSizeEnvKey)
+	require.NoError(t, err)
+
+	err = os.Unsetenv(cslCacheTTLEnvKey)
+	require.NoError(t, err)
+
+	err = os.Unsetenv(cslCacheStaleWhileRevalidateEnvKey)
+	require.NoError(t, err)
+
+	err = os.Unsetenv(cslBatchWindowEnvKey)
+	require.NoError(t, err)
+
+	err = os.Unsetenv(cslBatchSizeEnvKey)
+	require.NoError(t, err)
+
+	err = os.Unsetenv(cslBatchWindowEnvKey)
+	require.NoError(t, err)
+
+	err = os.Unsetenv(cslBatchSizeEnvKey)
+	require.NoError(t, err)
+
+	err = os.Unsetenv(presentationArchivePurgeIntervalEnvKey)
+	require.NoError(t, err)
+
+	err = os.Unsetenv(vdrCacheSizeEnvKey)
+	require.NoError(t, err)
+
+	err = os.Unsetenv(vdrCacheTTLEnvKey)
+	require.NoError(t, err)
+
+	err = os.Unsetenv(vdrCacheMethodTTLEnvKey)
+	require.NoError(t, err)
+
+	err = os.Unsetenv(vdrCacheNotFoundTTLEnvKey)
+	require.NoError(t, err)
+
+	err = os.Unsetenv(vdrCacheSharedEnvKey)
+	require.NoError(t, err)
+
+	err = os.Unsetenv(x509TrustAnchorsEnvKey)
+	require.NoError(t, err)
+
+	err = os.Unsetenv(x509CRLsEnvKey)
+	require.NoError(t, err)
+
 	err = os.Setenv(hostURLExternalEnvKey, "http://localhost:8080")
 	require.NoError(t, err)
 
@@ -16,6 +16,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/piprate/json-gold/ld"
@@ -75,6 +76,7 @@ type cslManager interface {
 		profile *profileapi.Issuer,
 		credentialID string,
 	) (*credentialstatus.StatusListEntry, error)
+	ListCSLs(ctx context.Context, profileGroupID profileapi.ID) ([]*credentialstatus.CSLInfo, error)
 }
 
 type credentialIssuanceHistoryStore interface {
@@ -101,6 +103,15 @@ type Config struct {
 	DocumentLoader                 ld.DocumentLoader
 	CMD                            *cobra.Command
 	ExternalURL                    string
+	Metrics                        metricsProvider
+	// StatusListCacheSize is a max number of resolved status list VCs to cache. Zero disables caching.
+	StatusListCacheSize int
+	// StatusListCacheTTL is a lifetime of cached status list VC in case it is not defined by
+	// Cache-Control header or status list ttl.
+	StatusListCacheTTL time.Duration
+	// StatusListCacheStaleWhileRevalidate is a time window after the cached status list VC expiration
+	// during which it is still used while fresh status list VC is fetched in the background.
+	StatusListCacheStaleWhileRevalidate time.Duration
 }
 
 type Service struct {
@@ -119,11 +130,12 @@ type Service struct {
 	documentLoader                 ld.DocumentLoader
 	cmd                            *cobra.Command
 	externalURL                    string
+	statusListCache                *statusListCache
 }
 
 // New returns new Credential Status service.
 func New(config *Config) (*Service, error) {
-	return &Service{
+	s := &Service{
 		httpClient:                     config.HTTPClient,
 		requestTokens:                  config.RequestTokens,
 		vdr:                            config.VDR,
@@ -139,7 +151,23 @@ func New(config *Config) (*Service, error) {
 		documentLoader:                 config.DocumentLoader,
 		cmd:                            config.CMD,
 		externalURL:                    config.ExternalURL,
-	}, nil
+	}
+
+	if config.StatusListCacheSize > 0 {
+		cache, err := newStatusListCache(&statusListCacheConfig{
+			Size:                 config.StatusListCacheSize,
+			TTL:                  config.StatusListCacheTTL,
+			StaleWhileRevalidate: config.StatusListCacheStaleWhileRevalidate,
+			Metrics:              config.Metrics,
+		}, s.fetchStatusList, s.parseAndVerifyVC)
+		if err != nil {
+			return nil, err
+		}
+
+		s.statusListCache = cache
+	}
+
+	return s, nil
 }
 
 // UpdateVCStatus fetches credential based on UpdateVCStatusParams.CredentialID
@@ -249,52 +277,142 @@ func (s *Service) GetStatusListVC(
 	return cslWrapper.VC, nil
 }
 
+// ListStatusLists returns fill levels of all StatusListVCs (credentialstatus.CSL) of the profile group.
+func (s *Service) ListStatusLists(
+	ctx context.Context, groupID profileapi.ID) ([]*credentialstatus.CSLInfo, error) {
+	logger.Debugc(ctx, "ListStatusLists begin", logfields.WithProfileID(groupID))
+
+	cslInfos, err := s.cslMgr.ListCSLs(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("list CSLs: %w", err)
+	}
+
+	logger.Debugc(ctx, "ListStatusLists success")
+
+	return cslInfos, nil
+}
+
 // Resolve resolves statusListVCURI and returns StatusListVC (credentialstatus.CSL).
 // Used for credential verification.
 // statusListVCURI might be either HTTP URL or DID URL.
+// In case status list cache is enabled, resolved StatusListVC is cached and shared between verifications.
 func (s *Service) Resolve(ctx context.Context, statusListVCURI string) (*credentialstatus.CSL, error) {
 	logger.Debugc(ctx, "ResolveStatusListVCURI begin", log.WithURL(statusListVCURI))
-	var vcBytes []byte
+
+	var csl *credentialstatus.CSL
+	var err error
+
+	if s.statusListCache != nil {
+		csl, err = s.statusListCache.Get(ctx, statusListVCURI)
+	} else {
+		csl, err = s.resolveStatusList(ctx, statusListVCURI)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debugc(ctx, "ResolveStatusListVCURI successful", log.WithURL(statusListVCURI))
+
+	return csl, nil
+}
+
+func (s *Service) resolveStatusList(ctx context.Context, statusListVCURI string) (*credentialstatus.CSL, error) {
+	res, err := s.fetchStatusList(ctx, statusListVCURI, "")
+	if err != nil {
+		return nil, err
+	}
+
+	csl, err := s.parseAndVerifyVC(res.vcBytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse and verify status vc: %w", err)
+	}
+
+	return csl, nil
+}
+
+// fetchStatusList fetches status list VC. In case etag is provided, HTTP status list VC is requested conditionally.
+func (s *Service) fetchStatusList(
+	ctx context.Context,
+	statusListVCURI, etag string,
+) (*statusListFetchResult, error) {
+	var res *statusListFetchResult
 	var err error
+
 	switch {
 	case strings.HasPrefix(statusListVCURI, "did:"):
 		logger.Debugc(ctx, "statusListVCURI is DID document", log.WithURL(statusListVCURI))
+
+		var vcBytes []byte
+
 		vcBytes, err = s.resolveDIDRelativeURL(ctx, statusListVCURI)
+		if err == nil {
+			res = &statusListFetchResult{vcBytes: vcBytes}
+		}
 	default:
 		logger.Debugc(ctx, "statusListVCURI is URL", log.WithURL(statusListVCURI))
-		vcBytes, err = s.resolveHTTPUrl(ctx, statusListVCURI)
+		res, err = s.resolveHTTPUrl(ctx, statusListVCURI, etag)
 	}
 
 	if err != nil {
 		return nil, fmt.Errorf("unable to resolve statusListVCURI: %w", err)
 	}
 
-	csl, err := s.parseAndVerifyVC(vcBytes)
+	return res, nil
+}
+
+func (s *Service) resolveHTTPUrl(ctx context.Context, url, etag string) (*statusListFetchResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return nil, fmt.Errorf("parse and verify status vc: %w", err)
+		return nil, err
 	}
 
-	logger.Debugc(ctx, "ResolveStatusListVCURI successful", log.WithURL(statusListVCURI))
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
 
-	return csl, nil
-}
+	if token := s.requestTokens[cslRequestTokenName]; token != "" {
+		req.Header.Add("Authorization", "Bearer "+token)
+	}
 
-func (s *Service) resolveHTTPUrl(ctx context.Context, url string) ([]byte, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	resp, err := s.httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := s.sendHTTPRequest(req, http.StatusOK, s.requestTokens[cslRequestTokenName])
+	defer func() {
+		if errClose := resp.Body.Close(); errClose != nil {
+			logger.Warn("failed to close response body")
+		}
+	}()
+
+	res := &statusListFetchResult{
+		etag:         resp.Header.Get("ETag"),
+		cacheControl: resp.Header.Get("Cache-Control"),
+	}
+
+	if etag != "" && resp.StatusCode == http.StatusNotModified {
+		res.notModified = true
+
+		return res, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		logger.Warn("Unable to read response", log.WithHTTPStatus(resp.StatusCode), log.WithError(err))
 	}
 
-	return resp, nil
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("read response body for status %d: %s", resp.StatusCode, string(body))
+	}
+
+	res.vcBytes = body
+
+	return res, nil
 }
 
 func (s *Service) parseAndVerifyVC(vcBytes []byte) (*verifiable.Credential, error) {
-	return verifiable.ParseCredential(
+	return vc.ParseCredential(
 		vcBytes,
 		verifiable.WithProofChecker(defaults.NewDefaultProofChecker(vermethod.NewVDRResolver(s.vdr))),
 		verifiable.WithJSONLDDocumentLoader(s.documentLoader),
@@ -307,7 +425,7 @@ func (s *Service) getCSLVCWrapper(ctx context.Context, cslURL string) (*credenti
 		return nil, fmt.Errorf("get CSL from store: %w", err)
 	}
 
-	cslVC, err := verifiable.ParseCredential(vcWrapper.VCByte,
+	cslVC, err := vc.ParseCredential(vcWrapper.VCByte,
 		verifiable.WithDisabledProofCheck(),
 		verifiable.WithJSONLDDocumentLoader(s.documentLoader))
 	if err != nil {
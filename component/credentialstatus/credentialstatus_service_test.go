@@ -100,14 +100,14 @@ func TestCredentialStatusList_CreateStatusListEntry(t *testing.T) {
 		mockProfileSrv := NewMockProfileService(gomock.NewController(t))
 		mockProfileSrv.EXPECT().GetProfile(profileID, profileVersion).AnyTimes().Return(getTestProfile(), nil)
 		mockKMSRegistry := NewMockKMSRegistry(gomock.NewController(t))
-		mockKMSRegistry.EXPECT().GetKeyManager(gomock.Any()).Times(5).Return(&vcskms.MockKMS{}, nil)
+		mockKMSRegistry.EXPECT().GetKeyManager(gomock.Any()).Times(3).Return(&vcskms.MockKMS{}, nil)
 		ctx := context.Background()
 
 		cslVCStore := newMockCSLVCStore()
 
 		cslIndexStore := newMockCSLIndexStore()
 
-		listID, err := cslIndexStore.GetLatestListID(ctx)
+		listID, err := cslIndexStore.GetLatestListID(ctx, getTestListSequence())
 		require.NoError(t, err)
 
 		vcStatusStore := newMockVCStatusStore()
@@ -147,7 +147,7 @@ func TestCredentialStatusList_CreateStatusListEntry(t *testing.T) {
 		validateVCStatus(t, s, statusID, listID)
 
 		// List size equals 2, so after 2 issuances CSL encodedBitString is full and listID must be updated.
-		updatedListID, err := cslIndexStore.GetLatestListID(ctx)
+		updatedListID, err := cslIndexStore.GetLatestListID(ctx, getTestListSequence())
 		require.NoError(t, err)
 		require.NotEqual(t, updatedListID, listID)
 
@@ -160,7 +160,7 @@ func TestCredentialStatusList_CreateStatusListEntry(t *testing.T) {
 		validateVCStatus(t, s, statusID, updatedListID)
 
 		// List size equals 2, so after 4 issuances CSL encodedBitString is full and listID must be updated.
-		updatedListIDSecond, err := cslIndexStore.GetLatestListID(ctx)
+		updatedListIDSecond, err := cslIndexStore.GetLatestListID(ctx, getTestListSequence())
 		require.NoError(t, err)
 		require.NotEqual(t, updatedListID, updatedListIDSecond)
 		require.NotEqual(t, listID, updatedListIDSecond)
@@ -230,6 +230,40 @@ func TestCredentialStatusList_GetStatusListVC(t *testing.T) {
 	})
 }
 
+func TestCredentialStatusList_ListStatusLists(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockCSLManager := NewMockcslManager(gomock.NewController(t))
+		mockCSLManager.EXPECT().ListCSLs(gomock.Any(), externalProfileID).Return([]*credentialstatus.CSLInfo{
+			{CSLURL: "https://example.com/1", ListID: "1", StatusPurpose: "revocation", ListSize: 10, UsedIndexes: 5},
+		}, nil)
+
+		s, err := New(&Config{
+			CSLManager: mockCSLManager,
+		})
+		require.NoError(t, err)
+
+		cslInfos, err := s.ListStatusLists(context.Background(), externalProfileID)
+		require.NoError(t, err)
+		require.Len(t, cslInfos, 1)
+		require.Equal(t, 5, cslInfos[0].UsedIndexes)
+		require.Equal(t, "revocation", cslInfos[0].StatusPurpose)
+	})
+
+	t.Run("list error", func(t *testing.T) {
+		mockCSLManager := NewMockcslManager(gomock.NewController(t))
+		mockCSLManager.EXPECT().ListCSLs(gomock.Any(), externalProfileID).Return(nil, errors.New("some error"))
+
+		s, err := New(&Config{
+			CSLManager: mockCSLManager,
+		})
+		require.NoError(t, err)
+
+		cslInfos, err := s.ListStatusLists(context.Background(), externalProfileID)
+		require.ErrorContains(t, err, "list CSLs")
+		require.Nil(t, cslInfos)
+	})
+}
+
 func TestCredentialStatusList_UpdateVCStatus(t *testing.T) {
 	t.Run("UpdateVCStatus success", func(t *testing.T) {
 		profile := getTestProfile()
@@ -296,7 +330,7 @@ func TestCredentialStatusList_UpdateVCStatus(t *testing.T) {
 
 		require.NoError(t, s.UpdateVCStatus(ctx, params))
 
-		listID, err := cslIndexStore.GetLatestListID(ctx)
+		listID, err := cslIndexStore.GetLatestListID(ctx, getTestListSequence())
 		require.NoError(t, err)
 
 		statusListVC, err := s.GetStatusListVC(ctx, externalProfileID, string(listID))
@@ -724,7 +758,7 @@ func TestCredentialStatusList_UpdateVCStatus(t *testing.T) {
 			vc.StatusList2021VCStatus,
 			true))
 
-		listID, err := cslIndexStore.GetLatestListID(context.Background())
+		listID, err := cslIndexStore.GetLatestListID(context.Background(), getTestListSequence())
 		require.NoError(t, err)
 
 		revocationListVC, err := s.GetStatusListVC(context.Background(), externalProfileID, string(listID))
@@ -895,20 +929,30 @@ func getTestProfile() *profileapi.Issuer {
 	}
 }
 
+func getTestListSequence() credentialstatus.ListSequence {
+	profile := getTestProfile()
+
+	return credentialstatus.ListSequence{
+		ProfileGroupID: profile.GroupID,
+		StatusPurpose:  "revocation",
+		StatusType:     profile.VCConfig.Status.Type,
+	}
+}
+
 type mockCSLIndexStore struct {
 	createErr             error
 	findErr               error
 	getLatestListIDErr    error
 	createLatestListIDErr error
 	updateLatestListIDErr error
-	latestListID          credentialstatus.ListID
+	latestListIDs         map[credentialstatus.ListSequence]credentialstatus.ListID
 	s                     map[string]*credentialstatus.CSLIndexWrapper
 }
 
 func newMockCSLIndexStore() *mockCSLIndexStore {
 	return &mockCSLIndexStore{
-		latestListID: "",
-		s:            map[string]*credentialstatus.CSLIndexWrapper{},
+		latestListIDs: map[credentialstatus.ListSequence]credentialstatus.ListID{},
+		s:             map[string]*credentialstatus.CSLIndexWrapper{},
 	}
 }
 
@@ -923,6 +967,39 @@ func (m *mockCSLIndexStore) Upsert(
 	return nil
 }
 
+func (m *mockCSLIndexStore) Create(
+	_ context.Context, cslURL string, cslWrapper *credentialstatus.CSLIndexWrapper) error {
+	if m.createErr != nil {
+		return m.createErr
+	}
+
+	if _, ok := m.s[cslURL]; ok {
+		return credentialstatus.ErrDataAlreadyExists
+	}
+
+	m.s[cslURL] = cslWrapper
+
+	return nil
+}
+
+func (m *mockCSLIndexStore) AssignIndex(
+	_ context.Context, cslURL string, index int) (*credentialstatus.CSLIndexWrapper, error) {
+	w, ok := m.s[cslURL]
+	if !ok {
+		return nil, credentialstatus.ErrDataNotFound
+	}
+
+	for _, i := range w.UsedIndexes {
+		if i == index {
+			return nil, credentialstatus.ErrIndexAlreadyUsed
+		}
+	}
+
+	w.UsedIndexes = append(w.UsedIndexes, index)
+
+	return w, nil
+}
+
 func (m *mockCSLIndexStore) Get(_ context.Context, cslURL string) (*credentialstatus.CSLIndexWrapper, error) {
 	if m.findErr != nil {
 		return nil, m.findErr
@@ -935,36 +1012,63 @@ func (m *mockCSLIndexStore) Get(_ context.Context, cslURL string) (*credentialst
 
 	return w, nil
 }
-func (m *mockCSLIndexStore) createLatestListID() error {
+
+func (m *mockCSLIndexStore) List(
+	_ context.Context, cslURLPrefix string) ([]*credentialstatus.CSLIndexWrapper, error) {
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
+
+	var wrappers []*credentialstatus.CSLIndexWrapper
+
+	for cslURL, w := range m.s {
+		if strings.HasPrefix(cslURL, cslURLPrefix) {
+			wrappers = append(wrappers, w)
+		}
+	}
+
+	return wrappers, nil
+}
+
+func (m *mockCSLIndexStore) createLatestListID(seq credentialstatus.ListSequence) error {
 	if m.createLatestListIDErr != nil {
 		return m.createLatestListIDErr
 	}
 
-	m.latestListID = credentialstatus.ListID(uuid.NewString())
+	m.latestListIDs[seq] = credentialstatus.ListID(uuid.NewString())
 
 	return nil
 }
 
-func (m *mockCSLIndexStore) UpdateLatestListID(_ context.Context, _ credentialstatus.ListID) error {
+func (m *mockCSLIndexStore) UpdateLatestListID(
+	_ context.Context, seq credentialstatus.ListSequence, prevID, id credentialstatus.ListID) error {
 	if m.updateLatestListIDErr != nil {
 		return m.updateLatestListIDErr
 	}
-	return m.createLatestListID()
+
+	if m.latestListIDs[seq] != prevID {
+		return credentialstatus.ErrLatestListIDChanged
+	}
+
+	m.latestListIDs[seq] = id
+
+	return nil
 }
 
-func (m *mockCSLIndexStore) GetLatestListID(_ context.Context) (credentialstatus.ListID, error) {
+func (m *mockCSLIndexStore) GetLatestListID(
+	_ context.Context, seq credentialstatus.ListSequence) (credentialstatus.ListID, error) {
 	if m.getLatestListIDErr != nil {
 		return "", m.getLatestListIDErr
 	}
 
-	if m.latestListID == "" {
-		err := m.createLatestListID()
+	if m.latestListIDs[seq] == "" {
+		err := m.createLatestListID(seq)
 		if err != nil {
 			return "", err
 		}
 	}
 
-	return m.latestListID, nil
+	return m.latestListIDs[seq], nil
 }
 
 type mockCSLVCStore struct {
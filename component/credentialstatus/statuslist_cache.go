@@ -0,0 +1,372 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package credentialstatus
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/trustbloc/logutil-go/pkg/log"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
+)
+
+const (
+	defaultStatusListCacheTTL          = time.Minute
+	defaultStatusListCacheFetchTimeout = 30 * time.Second
+
+	cacheControlMaxAge               = "max-age"
+	cacheControlNoCache              = "no-cache"
+	cacheControlNoStore              = "no-store"
+	cacheControlStaleWhileRevalidate = "stale-while-revalidate"
+)
+
+type metricsProvider interface {
+	CSLCacheHit()
+	CSLCacheMiss()
+}
+
+// statusListFetchResult is a result of status list VC fetch.
+type statusListFetchResult struct {
+	vcBytes      []byte
+	notModified  bool
+	etag         string
+	cacheControl string
+}
+
+type statusListFetcher func(ctx context.Context, statusListVCURI, etag string) (*statusListFetchResult, error)
+
+type statusListParser func(vcBytes []byte) (*credentialstatus.CSL, error)
+
+type statusListCacheEntry struct {
+	csl          *credentialstatus.CSL
+	etag         string
+	cacheControl string
+	fetchedAt    time.Time
+	expiresAt    time.Time
+	staleUntil   time.Time
+}
+
+// statusListCacheConfig configures statusListCache.
+type statusListCacheConfig struct {
+	// Size is a max number of status list VCs in the cache.
+	Size int
+	// TTL is a freshness lifetime of status list VC in case it is not defined by the response.
+	TTL time.Duration
+	// StaleWhileRevalidate is a time window after the status list VC expiration during which
+	// stale status list VC is used while it is revalidated in the background.
+	StaleWhileRevalidate time.Duration
+	// FetchTimeout is a timeout of status list VC fetch shared by concurrent callers.
+	FetchTimeout time.Duration
+	Metrics      metricsProvider
+}
+
+// statusListCache is a size-bounded cache of status list VCs keyed by status list URL.
+// It respects Cache-Control and ETag response headers as well as validUntil and ttl of the status list VC.
+type statusListCache struct {
+	entries              *lru.Cache[string, *statusListCacheEntry]
+	ttl                  time.Duration
+	staleWhileRevalidate time.Duration
+	fetchTimeout         time.Duration
+	metrics              metricsProvider
+	fetch                statusListFetcher
+	parse                statusListParser
+	now                  func() time.Time
+
+	group        singleflight.Group
+	revalidating sync.Map
+}
+
+func newStatusListCache(
+	config *statusListCacheConfig,
+	fetch statusListFetcher,
+	parse statusListParser,
+) (*statusListCache, error) {
+	entries, err := lru.New[string, *statusListCacheEntry](config.Size)
+	if err != nil {
+		return nil, fmt.Errorf("create status list cache: %w", err)
+	}
+
+	ttl := config.TTL
+	if ttl <= 0 {
+		ttl = defaultStatusListCacheTTL
+	}
+
+	fetchTimeout := config.FetchTimeout
+	if fetchTimeout <= 0 {
+		fetchTimeout = defaultStatusListCacheFetchTimeout
+	}
+
+	return &statusListCache{
+		entries:              entries,
+		ttl:                  ttl,
+		staleWhileRevalidate: config.StaleWhileRevalidate,
+		fetchTimeout:         fetchTimeout,
+		metrics:              config.Metrics,
+		fetch:                fetch,
+		parse:                parse,
+		now:                  time.Now,
+	}, nil
+}
+
+// Get returns status list VC from the cache or fetches it in case cached VC is missing or expired.
+func (c *statusListCache) Get(ctx context.Context, statusListVCURI string) (*credentialstatus.CSL, error) {
+	now := c.now()
+
+	if entry, ok := c.entries.Get(statusListVCURI); ok {
+		maxAge, hasMaxAge := credentialstatus.StatusListMaxAge(ctx)
+		withinMaxAge := !hasMaxAge || now.Sub(entry.fetchedAt) < maxAge
+
+		if withinMaxAge && now.Before(entry.expiresAt) {
+			c.hit()
+
+			return entry.csl, nil
+		}
+
+		if withinMaxAge && now.Before(entry.staleUntil) {
+			c.hit()
+			c.revalidate(ctx, statusListVCURI)
+
+			return entry.csl, nil
+		}
+	}
+
+	c.miss()
+
+	return c.loadShared(ctx, statusListVCURI)
+}
+
+// loadShared loads status list VC once for all concurrent callers. The fetch is detached from the context
+// of the caller which started it, so that cancellation of that caller does not fail the others.
+func (c *statusListCache) loadShared(ctx context.Context, statusListVCURI string) (*credentialstatus.CSL, error) {
+	ch := c.group.DoChan(statusListVCURI, func() (interface{}, error) {
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.fetchTimeout)
+		defer cancel()
+
+		return c.load(fetchCtx, statusListVCURI)
+	})
+
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+
+		return res.Val.(*credentialstatus.CSL), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *statusListCache) revalidate(ctx context.Context, statusListVCURI string) {
+	if _, loaded := c.revalidating.LoadOrStore(statusListVCURI, struct{}{}); loaded {
+		return
+	}
+
+	go func() {
+		defer c.revalidating.Delete(statusListVCURI)
+
+		if _, err := c.loadShared(context.WithoutCancel(ctx), statusListVCURI); err != nil {
+			logger.Warnc(ctx, "Failed to revalidate status list VC", log.WithURL(statusListVCURI), log.WithError(err))
+		}
+	}()
+}
+
+func (c *statusListCache) load(ctx context.Context, statusListVCURI string) (*credentialstatus.CSL, error) {
+	prev, _ := c.entries.Peek(statusListVCURI)
+
+	var etag string
+	if prev != nil {
+		etag = prev.etag
+	}
+
+	res, err := c.fetch(ctx, statusListVCURI, etag)
+	if err != nil {
+		return nil, err
+	}
+
+	var csl *credentialstatus.CSL
+
+	if res.notModified && prev != nil {
+		csl = prev.csl
+	} else {
+		csl, err = c.parse(res.vcBytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse and verify status vc: %w", err)
+		}
+	}
+
+	if res.notModified && prev != nil {
+		// Not modified response updates only headers it contains.
+		if res.etag == "" {
+			res.etag = prev.etag
+		}
+
+		if res.cacheControl == "" {
+			res.cacheControl = prev.cacheControl
+		}
+	}
+
+	if entry := c.newEntry(csl, res); entry != nil {
+		c.entries.Add(statusListVCURI, entry)
+	} else {
+		c.entries.Remove(statusListVCURI)
+	}
+
+	return csl, nil
+}
+
+func (c *statusListCache) newEntry(
+	csl *credentialstatus.CSL,
+	res *statusListFetchResult,
+) *statusListCacheEntry {
+	directives := parseCacheControl(res.cacheControl)
+
+	if _, noStore := directives[cacheControlNoStore]; noStore {
+		return nil
+	}
+
+	ttl := c.ttl
+	if maxAge, ok := directiveSeconds(directives, cacheControlMaxAge); ok {
+		ttl = maxAge
+	}
+
+	if statusListTTL, ok := getStatusListTTL(csl); ok && statusListTTL < ttl {
+		ttl = statusListTTL
+	}
+
+	staleWhileRevalidate := c.staleWhileRevalidate
+	if swr, ok := directiveSeconds(directives, cacheControlStaleWhileRevalidate); ok {
+		staleWhileRevalidate = swr
+	}
+
+	if _, noCache := directives[cacheControlNoCache]; noCache {
+		ttl, staleWhileRevalidate = 0, 0
+	}
+
+	now := c.now()
+	expiresAt := now.Add(ttl)
+	staleUntil := expiresAt.Add(staleWhileRevalidate)
+
+	// Never use status list VC after it is no longer valid.
+	if validUntil, ok := getStatusListValidUntil(csl); ok {
+		if validUntil.Before(expiresAt) {
+			expiresAt = validUntil
+		}
+
+		if validUntil.Before(staleUntil) {
+			staleUntil = validUntil
+		}
+	}
+
+	return &statusListCacheEntry{
+		csl:          csl,
+		etag:         res.etag,
+		cacheControl: res.cacheControl,
+		fetchedAt:    now,
+		expiresAt:    expiresAt,
+		staleUntil:   staleUntil,
+	}
+}
+
+func (c *statusListCache) hit() {
+	if c.metrics != nil {
+		c.metrics.CSLCacheHit()
+	}
+}
+
+func (c *statusListCache) miss() {
+	if c.metrics != nil {
+		c.metrics.CSLCacheMiss()
+	}
+}
+
+// parseCacheControl parses Cache-Control header value into directives.
+func parseCacheControl(value string) map[string]string {
+	directives := map[string]string{}
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		name, val, _ := strings.Cut(part, "=")
+
+		directives[strings.ToLower(strings.TrimSpace(name))] = strings.Trim(strings.TrimSpace(val), `"`)
+	}
+
+	return directives
+}
+
+func directiveSeconds(directives map[string]string, name string) (time.Duration, bool) {
+	val, ok := directives[name]
+	if !ok {
+		return 0, false
+	}
+
+	seconds, err := strconv.ParseInt(val, 10, 64)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+
+	return time.Duration(seconds) * time.Second, true
+}
+
+// getStatusListTTL returns ttl (in milliseconds) of the status list defined in credentialSubject.
+func getStatusListTTL(csl *credentialstatus.CSL) (time.Duration, bool) {
+	subject := csl.Contents().Subject
+	if len(subject) == 0 {
+		return 0, false
+	}
+
+	var ttl float64
+
+	switch v := subject[0].CustomFields["ttl"].(type) {
+	case float64:
+		ttl = v
+	case int:
+		ttl = float64(v)
+	case string:
+		parsed, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, false
+		}
+
+		ttl = parsed
+	default:
+		return 0, false
+	}
+
+	if ttl <= 0 {
+		return 0, false
+	}
+
+	return time.Duration(ttl) * time.Millisecond, true
+}
+
+// getStatusListValidUntil returns validUntil (or expirationDate) of the status list VC.
+func getStatusListValidUntil(csl *credentialstatus.CSL) (time.Time, bool) {
+	if validUntil, ok := csl.CustomField("validUntil").(string); ok {
+		t, err := time.Parse(time.RFC3339, validUntil)
+		if err == nil {
+			return t, true
+		}
+	}
+
+	if expired := csl.Contents().Expired; expired != nil {
+		return expired.Time, true
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,580 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package credentialstatus
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	utiltime "github.com/trustbloc/did-go/doc/util/time"
+	"github.com/trustbloc/vc-go/verifiable"
+
+	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
+)
+
+const (
+	statusListURL1 = "https://example.com/status/1"
+	statusListURL2 = "https://example.com/status/2"
+)
+
+func TestStatusListCache_Get(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("cached until TTL", func(t *testing.T) {
+		f := newMockStatusListFetcher(t, &statusListFetchResult{})
+		c, clock, m := newTestStatusListCache(t, &statusListCacheConfig{Size: 10, TTL: time.Minute}, f)
+
+		csl, err := c.Get(ctx, statusListURL1)
+		require.NoError(t, err)
+		require.Equal(t, f.csl, csl)
+
+		csl, err = c.Get(ctx, statusListURL1)
+		require.NoError(t, err)
+		require.Equal(t, f.csl, csl)
+
+		require.EqualValues(t, 1, f.calls.Load())
+		require.EqualValues(t, 1, m.hits.Load())
+		require.EqualValues(t, 1, m.misses.Load())
+
+		clock.add(time.Minute + time.Second)
+
+		_, err = c.Get(ctx, statusListURL1)
+		require.NoError(t, err)
+
+		require.EqualValues(t, 2, f.calls.Load())
+		require.EqualValues(t, 2, m.misses.Load())
+	})
+
+	t.Run("default TTL", func(t *testing.T) {
+		f := newMockStatusListFetcher(t, &statusListFetchResult{})
+		c, _, _ := newTestStatusListCache(t, &statusListCacheConfig{Size: 10}, f)
+
+		require.Equal(t, defaultStatusListCacheTTL, c.ttl)
+	})
+
+	t.Run("Cache-Control max-age and ETag revalidation", func(t *testing.T) {
+		f := newMockStatusListFetcher(t, &statusListFetchResult{cacheControl: "public, max-age=10", etag: `"v1"`})
+		c, clock, _ := newTestStatusListCache(t, &statusListCacheConfig{Size: 10, TTL: time.Hour}, f)
+
+		csl, err := c.Get(ctx, statusListURL1)
+		require.NoError(t, err)
+		require.Empty(t, f.lastETag())
+
+		clock.add(11 * time.Second)
+
+		f.setResult(&statusListFetchResult{notModified: true, cacheControl: "max-age=10"})
+
+		cached, err := c.Get(ctx, statusListURL1)
+		require.NoError(t, err)
+		require.Same(t, csl, cached)
+		require.Equal(t, `"v1"`, f.lastETag())
+		require.EqualValues(t, 1, f.parses.Load())
+
+		clock.add(11 * time.Second)
+
+		// ETag of the previous response is kept in case not modified response has no ETag.
+		_, err = c.Get(ctx, statusListURL1)
+		require.NoError(t, err)
+		require.Equal(t, `"v1"`, f.lastETag())
+		require.EqualValues(t, 3, f.calls.Load())
+	})
+
+	t.Run("Cache-Control no-store", func(t *testing.T) {
+		f := newMockStatusListFetcher(t, &statusListFetchResult{cacheControl: "no-store", etag: `"v1"`})
+		c, _, _ := newTestStatusListCache(t, &statusListCacheConfig{Size: 10, TTL: time.Hour}, f)
+
+		for i := 0; i < 3; i++ {
+			_, err := c.Get(ctx, statusListURL1)
+			require.NoError(t, err)
+			require.Empty(t, f.lastETag())
+		}
+
+		require.EqualValues(t, 3, f.calls.Load())
+	})
+
+	t.Run("Cache-Control no-cache", func(t *testing.T) {
+		f := newMockStatusListFetcher(t, &statusListFetchResult{cacheControl: "no-cache", etag: `"v1"`})
+		c, _, _ := newTestStatusListCache(t, &statusListCacheConfig{
+			Size: 10, TTL: time.Hour, StaleWhileRevalidate: time.Hour}, f)
+
+		_, err := c.Get(ctx, statusListURL1)
+		require.NoError(t, err)
+
+		f.setResult(&statusListFetchResult{notModified: true, cacheControl: "no-cache"})
+
+		_, err = c.Get(ctx, statusListURL1)
+		require.NoError(t, err)
+		require.Equal(t, `"v1"`, f.lastETag())
+
+		require.EqualValues(t, 2, f.calls.Load())
+		require.EqualValues(t, 1, f.parses.Load())
+	})
+
+	t.Run("status list ttl", func(t *testing.T) {
+		f := newMockStatusListFetcher(t, &statusListFetchResult{})
+		f.csl = newTestCSL(t, verifiable.CustomFields{"ttl": float64(500)}, nil)
+
+		c, clock, _ := newTestStatusListCache(t, &statusListCacheConfig{Size: 10, TTL: time.Hour}, f)
+
+		_, err := c.Get(ctx, statusListURL1)
+		require.NoError(t, err)
+
+		clock.add(time.Second)
+
+		_, err = c.Get(ctx, statusListURL1)
+		require.NoError(t, err)
+
+		require.EqualValues(t, 2, f.calls.Load())
+	})
+
+	t.Run("status list validUntil", func(t *testing.T) {
+		now := time.Now()
+
+		for _, csl := range []*credentialstatus.CSL{
+			newTestCSL(t, nil, verifiable.CustomFields{
+				"validUntil": now.Add(5 * time.Second).UTC().Format(time.RFC3339),
+			}),
+			newTestCSL(t, nil, nil, func(vcc *verifiable.CredentialContents) {
+				vcc.Expired = utiltime.NewTime(now.Add(5 * time.Second))
+			}),
+		} {
+			f := newMockStatusListFetcher(t, &statusListFetchResult{cacheControl: "max-age=60"})
+			f.csl = csl
+
+			c, clock, _ := newTestStatusListCache(t, &statusListCacheConfig{
+				Size: 10, TTL: time.Hour, StaleWhileRevalidate: time.Hour}, f)
+			clock.set(now)
+
+			_, err := c.Get(ctx, statusListURL1)
+			require.NoError(t, err)
+
+			clock.add(10 * time.Second)
+
+			_, err = c.Get(ctx, statusListURL1)
+			require.NoError(t, err)
+
+			require.EqualValues(t, 2, f.calls.Load())
+		}
+	})
+
+	t.Run("stale while revalidate", func(t *testing.T) {
+		f := newMockStatusListFetcher(t, &statusListFetchResult{cacheControl: "max-age=10"})
+		c, clock, m := newTestStatusListCache(t, &statusListCacheConfig{
+			Size: 10, TTL: time.Hour, StaleWhileRevalidate: 30 * time.Second}, f)
+
+		stale, err := c.Get(ctx, statusListURL1)
+		require.NoError(t, err)
+
+		clock.add(15 * time.Second)
+
+		fresh := newTestCSL(t, nil, nil)
+		f.setCSL(fresh)
+
+		csl, err := c.Get(ctx, statusListURL1)
+		require.NoError(t, err)
+		require.Same(t, stale, csl)
+		require.EqualValues(t, 1, m.hits.Load())
+
+		require.Eventually(t, func() bool {
+			csl, err = c.Get(ctx, statusListURL1)
+			require.NoError(t, err)
+
+			return csl == fresh
+		}, 5*time.Second, 10*time.Millisecond)
+
+		require.EqualValues(t, 2, f.calls.Load())
+		require.EqualValues(t, 1, m.misses.Load())
+	})
+
+	t.Run("stale-while-revalidate directive", func(t *testing.T) {
+		f := newMockStatusListFetcher(t, &statusListFetchResult{cacheControl: "max-age=10, stale-while-revalidate=5"})
+		c, clock, _ := newTestStatusListCache(t, &statusListCacheConfig{
+			Size: 10, TTL: time.Hour, StaleWhileRevalidate: time.Hour}, f)
+
+		_, err := c.Get(ctx, statusListURL1)
+		require.NoError(t, err)
+
+		clock.add(20 * time.Second)
+
+		_, err = c.Get(ctx, statusListURL1)
+		require.NoError(t, err)
+
+		require.EqualValues(t, 2, f.calls.Load())
+	})
+
+	t.Run("per-profile max age", func(t *testing.T) {
+		f := newMockStatusListFetcher(t, &statusListFetchResult{})
+		c, clock, _ := newTestStatusListCache(t, &statusListCacheConfig{
+			Size: 10, TTL: time.Hour, StaleWhileRevalidate: time.Hour}, f)
+
+		_, err := c.Get(ctx, statusListURL1)
+		require.NoError(t, err)
+
+		_, err = c.Get(credentialstatus.WithStatusListMaxAge(ctx, 0), statusListURL1)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, f.calls.Load())
+
+		clock.add(6 * time.Second)
+
+		_, err = c.Get(credentialstatus.WithStatusListMaxAge(ctx, 10*time.Second), statusListURL1)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, f.calls.Load())
+
+		_, err = c.Get(credentialstatus.WithStatusListMaxAge(ctx, 5*time.Second), statusListURL1)
+		require.NoError(t, err)
+		require.EqualValues(t, 3, f.calls.Load())
+	})
+
+	t.Run("concurrent misses are coalesced", func(t *testing.T) {
+		f := newMockStatusListFetcher(t, &statusListFetchResult{})
+		f.release = make(chan struct{})
+
+		c, _, _ := newTestStatusListCache(t, &statusListCacheConfig{Size: 10, TTL: time.Hour}, f)
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				csl, err := c.Get(ctx, statusListURL1)
+				require.NoError(t, err)
+				require.NotNil(t, csl)
+			}()
+		}
+
+		time.Sleep(50 * time.Millisecond)
+		close(f.release)
+
+		wg.Wait()
+
+		require.EqualValues(t, 1, f.calls.Load())
+	})
+
+	t.Run("cancelled caller does not fail shared fetch", func(t *testing.T) {
+		f := newMockStatusListFetcher(t, &statusListFetchResult{})
+		f.release = make(chan struct{})
+
+		c, _, _ := newTestStatusListCache(t, &statusListCacheConfig{Size: 10, TTL: time.Hour}, f)
+
+		cancelledCtx, cancel := context.WithCancel(ctx)
+
+		firstErr := make(chan error, 1)
+
+		go func() {
+			_, err := c.Get(cancelledCtx, statusListURL1)
+			firstErr <- err
+		}()
+
+		time.Sleep(50 * time.Millisecond)
+
+		waiterErr := make(chan error, 1)
+
+		go func() {
+			_, err := c.Get(ctx, statusListURL1)
+			waiterErr <- err
+		}()
+
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+
+		require.ErrorIs(t, <-firstErr, context.Canceled)
+
+		close(f.release)
+
+		require.NoError(t, <-waiterErr)
+		require.EqualValues(t, 1, f.calls.Load())
+		require.Equal(t, 1, c.entries.Len())
+	})
+
+	t.Run("shared fetch timeout", func(t *testing.T) {
+		f := newMockStatusListFetcher(t, &statusListFetchResult{})
+		f.release = make(chan struct{})
+
+		c, _, _ := newTestStatusListCache(t,
+			&statusListCacheConfig{Size: 10, FetchTimeout: 10 * time.Millisecond}, f)
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			close(f.release)
+		}()
+
+		_, err := c.Get(ctx, statusListURL1)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("size bounded", func(t *testing.T) {
+		f := newMockStatusListFetcher(t, &statusListFetchResult{})
+		c, _, _ := newTestStatusListCache(t, &statusListCacheConfig{Size: 1, TTL: time.Hour}, f)
+
+		for _, u := range []string{statusListURL1, statusListURL2, statusListURL1} {
+			_, err := c.Get(ctx, u)
+			require.NoError(t, err)
+		}
+
+		require.EqualValues(t, 3, f.calls.Load())
+	})
+
+	t.Run("error fetch", func(t *testing.T) {
+		f := newMockStatusListFetcher(t, &statusListFetchResult{})
+		f.fetchErr = errors.New("fetch error")
+
+		c, _, _ := newTestStatusListCache(t, &statusListCacheConfig{Size: 10}, f)
+
+		_, err := c.Get(ctx, statusListURL1)
+		require.ErrorContains(t, err, "fetch error")
+		require.Zero(t, c.entries.Len())
+	})
+
+	t.Run("error parse", func(t *testing.T) {
+		f := newMockStatusListFetcher(t, &statusListFetchResult{})
+		f.parseErr = errors.New("parse error")
+
+		c, _, _ := newTestStatusListCache(t, &statusListCacheConfig{Size: 10}, f)
+
+		_, err := c.Get(ctx, statusListURL1)
+		require.ErrorContains(t, err, "parse and verify status vc: parse error")
+		require.Zero(t, c.entries.Len())
+	})
+
+	t.Run("error invalid size", func(t *testing.T) {
+		_, err := newStatusListCache(&statusListCacheConfig{}, nil, nil)
+		require.ErrorContains(t, err, "create status list cache")
+	})
+}
+
+func TestService_Resolve_StatusListCache(t *testing.T) {
+	var requests atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		require.Equal(t, "Bearer abc", r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/status/1":
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+
+				return
+			}
+
+			w.Header().Set("ETag", `"v1"`)
+			w.Header().Set("Cache-Control", "no-cache")
+			_, _ = w.Write([]byte("vc"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	s, err := New(&Config{
+		HTTPClient:          http.DefaultClient,
+		RequestTokens:       map[string]string{cslRequestTokenName: "abc"},
+		StatusListCacheSize: 10,
+	})
+	require.NoError(t, err)
+
+	csl := newTestCSL(t, nil, nil)
+
+	var parses atomic.Int32
+
+	s.statusListCache.parse = func(vcBytes []byte) (*credentialstatus.CSL, error) {
+		parses.Add(1)
+
+		require.Equal(t, "vc", string(vcBytes))
+
+		return csl, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		resolved, resolveErr := s.Resolve(context.Background(), srv.URL+"/status/1")
+		require.NoError(t, resolveErr)
+		require.Same(t, csl, resolved)
+	}
+
+	require.EqualValues(t, 3, requests.Load())
+	require.EqualValues(t, 1, parses.Load())
+
+	_, err = s.Resolve(context.Background(), srv.URL+"/status/2")
+	require.ErrorContains(t, err, "unable to resolve statusListVCURI: read response body for status 404")
+}
+
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) get() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *testClock) set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+}
+
+func (c *testClock) add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+type mockCacheMetrics struct {
+	hits   atomic.Int32
+	misses atomic.Int32
+}
+
+func (m *mockCacheMetrics) CSLCacheHit() {
+	m.hits.Add(1)
+}
+
+func (m *mockCacheMetrics) CSLCacheMiss() {
+	m.misses.Add(1)
+}
+
+type mockStatusListFetcher struct {
+	mu       sync.Mutex
+	res      *statusListFetchResult
+	csl      *credentialstatus.CSL
+	etag     string
+	fetchErr error
+	parseErr error
+	release  chan struct{}
+	calls    atomic.Int32
+	parses   atomic.Int32
+}
+
+func newMockStatusListFetcher(t *testing.T, res *statusListFetchResult) *mockStatusListFetcher {
+	t.Helper()
+
+	return &mockStatusListFetcher{
+		res: res,
+		csl: newTestCSL(t, nil, nil),
+	}
+}
+
+func (f *mockStatusListFetcher) fetch(ctx context.Context, _, etag string) (*statusListFetchResult, error) {
+	f.calls.Add(1)
+
+	if f.release != nil {
+		<-f.release
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.etag = etag
+
+	if f.fetchErr != nil {
+		return nil, f.fetchErr
+	}
+
+	res := *f.res
+
+	return &res, nil
+}
+
+func (f *mockStatusListFetcher) parse(_ []byte) (*credentialstatus.CSL, error) {
+	f.parses.Add(1)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.parseErr != nil {
+		return nil, f.parseErr
+	}
+
+	return f.csl, nil
+}
+
+func (f *mockStatusListFetcher) setResult(res *statusListFetchResult) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.res = res
+}
+
+func (f *mockStatusListFetcher) setCSL(csl *credentialstatus.CSL) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.csl = csl
+}
+
+func (f *mockStatusListFetcher) lastETag() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.etag
+}
+
+func newTestStatusListCache(
+	t *testing.T,
+	config *statusListCacheConfig,
+	f *mockStatusListFetcher,
+) (*statusListCache, *testClock, *mockCacheMetrics) {
+	t.Helper()
+
+	m := &mockCacheMetrics{}
+	config.Metrics = m
+
+	c, err := newStatusListCache(config, f.fetch, f.parse)
+	require.NoError(t, err)
+
+	clock := &testClock{now: time.Now()}
+	c.now = clock.get
+
+	return c, clock, m
+}
+
+func newTestCSL(
+	t *testing.T,
+	subjectFields verifiable.CustomFields,
+	customFields verifiable.CustomFields,
+	opts ...func(vcc *verifiable.CredentialContents),
+) *credentialstatus.CSL {
+	t.Helper()
+
+	vcc := verifiable.CredentialContents{
+		ID:      "https://example.com/status/1",
+		Context: []string{"https://www.w3.org/2018/credentials/v1"},
+		Types:   []string{verifiable.VCType},
+		Subject: []verifiable.Subject{{
+			ID:           "https://example.com/status/1#list",
+			CustomFields: subjectFields,
+		}},
+	}
+
+	for _, opt := range opts {
+		opt(&vcc)
+	}
+
+	csl, err := verifiable.CreateCredential(vcc, customFields)
+	require.NoError(t, err)
+
+	return csl
+}
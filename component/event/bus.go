@@ -10,6 +10,7 @@ import (
 	"context"
 	"crypto/tls"
 	"sync"
+	"time"
 
 	"github.com/piprate/json-gold/ld"
 	"github.com/spf13/cobra"
@@ -41,6 +42,10 @@ type kmsRegistry interface {
 	GetKeyManager(config *vcskms.Config) (vcskms.VCSKeyManager, error)
 }
 
+type metricsProvider interface {
+	CSLPublishLag(value time.Duration)
+}
+
 type vcCrypto interface {
 	SignCredential(signerData *vc.Signer, vc *verifiable.Credential,
 		opts ...vccrypto.SigningOpts) (*verifiable.Credential, error)
@@ -57,6 +62,11 @@ type Config struct {
 	Tracer         trace.Tracer
 	IsTraceEnabled bool
 	DocumentLoader ld.DocumentLoader
+	Metrics        metricsProvider
+	// CSLPendingChangesStore enables batching of credential status list changes.
+	CSLPendingChangesStore credentialstatus.CSLPendingChangesStore
+	CSLBatchWindow         time.Duration
+	CSLBatchSize           int
 }
 
 // Bus implements a publisher/subscriber using Go channels. This implementation
@@ -64,13 +64,26 @@ func Initialize(cfg Config) (*Bus, error) {
 	}
 
 	service := credentialstatuseventhandler.New(&credentialstatuseventhandler.Config{
-		CSLVCStore:     cfg.CSLVCStore,
-		ProfileService: cfg.ProfileService,
-		KMSRegistry:    cfg.KMSRegistry,
-		Crypto:         cfg.Crypto,
-		DocumentLoader: cfg.DocumentLoader,
+		CSLVCStore:          cfg.CSLVCStore,
+		ProfileService:      cfg.ProfileService,
+		KMSRegistry:         cfg.KMSRegistry,
+		Crypto:              cfg.Crypto,
+		DocumentLoader:      cfg.DocumentLoader,
+		Metrics:             cfg.Metrics,
+		PendingChangesStore: cfg.CSLPendingChangesStore,
+		BatchWindow:         cfg.CSLBatchWindow,
+		BatchSize:           cfg.CSLBatchSize,
 	})
 
+	if cfg.CSLPendingChangesStore != nil {
+		// Publish changes left pending by the previous run.
+		go func() {
+			if flushErr := service.FlushPendingChanges(context.Background()); flushErr != nil {
+				logger.Error("Failed to publish pending CSL changes", log.WithError(flushErr))
+			}
+		}()
+	}
+
 	var credentialStatusEventHandler eventHandlerWithContext = service.HandleEvent
 	if cfg.IsTraceEnabled {
 		credentialStatusEventHandler = credentialstatustracing.Wrap(service, cfg.Tracer).HandleEvent
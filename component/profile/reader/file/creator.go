@@ -41,11 +41,12 @@ var signatureTypeToDidVerificationMethod = map[vcsverifiable.SignatureType]strin
 
 // createResult contains created did, update and recovery keys.
 type createResult struct {
-	didID          string
-	creator        string
-	kmsKeyID       string
-	updateKeyURL   string
-	recoveryKeyURL string
+	didID              string
+	creator            string
+	kmsKeyID           string
+	updateKeyURL       string
+	recoveryKeyURL     string
+	verificationMethod *did.VerificationMethod
 }
 
 // Creator service used to create public DID.
@@ -143,11 +144,12 @@ func (c *Creator) createDID(
 	}
 
 	return &createResult{
-		didID:          didResolution.DIDDocument.ID,
-		creator:        didResolution.DIDDocument.ID + "#" + authentication.ID,
-		kmsKeyID:       authentication.ID,
-		updateKeyURL:   updateURL,
-		recoveryKeyURL: recoveryURL,
+		didID:              didResolution.DIDDocument.ID,
+		creator:            didResolution.DIDDocument.ID + "#" + authentication.ID,
+		kmsKeyID:           authentication.ID,
+		updateKeyURL:       updateURL,
+		recoveryKeyURL:     recoveryURL,
+		verificationMethod: authentication,
 	}, nil
 }
 
@@ -222,11 +224,12 @@ func (c *Creator) webDID(verificationMethodType vcsverifiable.SignatureType, key
 	creator := strings.ReplaceAll(r.creator, r.didID, didWeb)
 
 	return &createResult{
-		didID:          didWeb,
-		creator:        creator,
-		kmsKeyID:       strings.Split(creator, "#")[1],
-		updateKeyURL:   r.updateKeyURL,
-		recoveryKeyURL: r.recoveryKeyURL,
+		didID:              didWeb,
+		creator:            creator,
+		kmsKeyID:           strings.Split(creator, "#")[1],
+		updateKeyURL:       r.updateKeyURL,
+		recoveryKeyURL:     r.recoveryKeyURL,
+		verificationMethod: r.verificationMethod,
 	}, nil
 }
 
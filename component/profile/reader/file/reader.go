@@ -7,25 +7,33 @@ SPDX-License-Identifier: Apache-2.0
 package file
 
 import (
+	"crypto/ed25519"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 
 	"github.com/hashicorp/go-version"
 	"github.com/spf13/cobra"
 	cmdutils "github.com/trustbloc/cmdutil-go/pkg/utils/cmd" //nolint:typecheck
+	"github.com/trustbloc/did-go/doc/did"
 	"github.com/trustbloc/did-go/method/jwk"
 	"github.com/trustbloc/did-go/method/key"
 	vdrpkg "github.com/trustbloc/did-go/vdr"
+	"github.com/trustbloc/kms-go/doc/jose/jwk/jwksupport"
 	"github.com/trustbloc/logutil-go/pkg/log" //nolint:typecheck
 	longform "github.com/trustbloc/sidetree-go/pkg/vdr/sidetreelongform"
 
 	"github.com/trustbloc/vcs/internal/logfields"
+	"github.com/trustbloc/vcs/pkg/doc/vc/statustype"
+	"github.com/trustbloc/vcs/pkg/doc/vc/x5c"
+	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
 	vcskms "github.com/trustbloc/vcs/pkg/kms"
 	profileapi "github.com/trustbloc/vcs/pkg/profile"
 	"github.com/trustbloc/vcs/pkg/restapi/resterr"
@@ -39,6 +47,8 @@ const (
 	profilesFilePathEnvKey    = "VC_REST_PROFILES_FILE_PATH"
 )
 
+const ed25519VerificationKey2018 = "Ed25519VerificationKey2018"
+
 var logger = log.New("vc-rest")
 
 type httpClient interface {
@@ -55,12 +65,14 @@ type Config struct {
 
 // IssuerReader read issuer profiles.
 type IssuerReader struct {
-	issuers map[string]*profileapi.Issuer
+	issuers  map[string]*profileapi.Issuer
+	profiles []*profileapi.Issuer
 }
 
 // VerifierReader read verifier profiles.
 type VerifierReader struct {
 	verifiers map[string]*profileapi.Verifier
+	profiles  []*profileapi.Verifier
 }
 
 type profileData struct {
@@ -69,10 +81,14 @@ type profileData struct {
 }
 
 type issuerProfile struct {
-	Data                *profileapi.Issuer `json:"issuer,omitempty"`
-	CreateDID           bool               `json:"createDID"`
-	DidDomain           string             `json:"didDomain"`
-	DidServiceAuthToken string             `json:"didServiceAuthToken"`
+	Data                 *profileapi.Issuer `json:"issuer,omitempty"`
+	CreateDID            bool               `json:"createDID"`
+	DidDomain            string             `json:"didDomain"`
+	DidServiceAuthToken  string             `json:"didServiceAuthToken"`
+	CertificateChainFile string             `json:"certificateChainFile"`
+	// DIDDocumentFile is a DID document of the existing signing DID. Its assertion methods are published
+	// in did:web document of the profile.
+	DIDDocumentFile string `json:"didDocumentFile"`
 }
 
 type verifierProfile struct {
@@ -80,6 +96,7 @@ type verifierProfile struct {
 	CreateDID           bool                 `json:"createDID"`
 	DidDomain           string               `json:"didDomain"`
 	DidServiceAuthToken string               `json:"didServiceAuthToken"`
+	DIDDocumentFile     string               `json:"didDocumentFile"`
 }
 
 // NewIssuerReader creates issuer Reader.
@@ -114,10 +131,54 @@ func NewIssuerReader(config *Config) (*IssuerReader, error) {
 			}
 		}
 
+		if v.DIDDocumentFile != "" {
+			if err = loadVerificationMethods(v.DIDDocumentFile, v.Data.SigningDID); err != nil {
+				return nil, fmt.Errorf("issuer profile service: %s: %w", v.Data.ID, err)
+			}
+		}
+
+		if v.CertificateChainFile != "" {
+			if v.Data.SigningDID == nil {
+				return nil, fmt.Errorf("issuer profile service: certificate chain requires signing did: %s", v.Data.ID)
+			}
+
+			v.Data.SigningDID.CertificateChain, err = x5c.LoadChain(v.CertificateChainFile)
+			if err != nil {
+				return nil, fmt.Errorf("issuer profile service: load certificate chain: %w", err)
+			}
+		}
+
+		if err = validateDataModelVersions(v.Data); err != nil {
+			return nil, fmt.Errorf("issuer profile service: %w", err)
+		}
+
+		if err = validateStatusPurpose(v.Data); err != nil {
+			return nil, fmt.Errorf("issuer profile service: %w", err)
+		}
+
+		if v.Data.OIDCConfig != nil && v.Data.OIDCConfig.WalletURL != nil {
+			if err = v.Data.OIDCConfig.WalletURL.Validate(); err != nil {
+				return nil, fmt.Errorf("issuer profile service: wallet url error: %w", err)
+			}
+		}
+
+		if v.Data.TxCodeNotifier != nil {
+			if err = v.Data.TxCodeNotifier.Validate(); err != nil {
+				return nil, fmt.Errorf("issuer profile service: tx code notifier error: %w", err)
+			}
+		}
+
+		if v.Data.Checks.Policy.Local != nil {
+			if err = v.Data.Checks.Policy.Local.Validate(); err != nil {
+				return nil, fmt.Errorf("issuer profile service: local policy error: %w", err)
+			}
+		}
+
 		logger.Info("create issuer profile successfully", log.WithID(v.Data.ID))
 
 		// Set version as it come.
 		r.issuers[fmt.Sprintf("%s_%s", v.Data.ID, v.Data.Version)] = v.Data
+		r.profiles = append(r.profiles, v.Data)
 
 		issuerVersion := version.Must(version.NewVersion(v.Data.Version))
 
@@ -132,6 +193,22 @@ func NewIssuerReader(config *Config) (*IssuerReader, error) {
 
 				return nil, fmt.Errorf("credential template schema error: %w", err)
 			}
+
+			if err := ct.ValidateClaimsMapping(); err != nil {
+				return nil, fmt.Errorf("credential template %s claims mapping error: %w", ct.ID, err)
+			}
+
+			if ct.ClaimsRequest != nil {
+				if err := ct.ClaimsRequest.Validate(); err != nil {
+					return nil, fmt.Errorf("credential template %s claims request error: %w", ct.ID, err)
+				}
+			}
+
+			if ct.RefreshService != nil {
+				if err := ct.RefreshService.Validate(); err != nil {
+					return nil, fmt.Errorf("credential template %s refresh service error: %w", ct.ID, err)
+				}
+			}
 		}
 	}
 
@@ -170,6 +247,11 @@ func (p *IssuerReader) GetAllProfiles(_ string) ([]*profileapi.Issuer, error) {
 	return nil, nil
 }
 
+// ListProfiles returns all versions of all profiles.
+func (p *IssuerReader) ListProfiles() ([]*profileapi.Issuer, error) {
+	return p.profiles, nil
+}
+
 // NewVerifierReader creates verifier Reader.
 func NewVerifierReader(config *Config) (*VerifierReader, error) {
 	profileJSONFile, err := cmdutils.GetUserSetVarFromString(config.CMD, profilesFilePathFlagName,
@@ -204,11 +286,54 @@ func NewVerifierReader(config *Config) (*VerifierReader, error) {
 			}
 		}
 
+		if v.DIDDocumentFile != "" {
+			if err = loadVerificationMethods(v.DIDDocumentFile, v.Data.SigningDID); err != nil {
+				return nil, fmt.Errorf("verifier profile service: %s: %w", v.Data.ID, err)
+			}
+		}
+
+		if v.Data.OIDCConfig != nil && v.Data.OIDCConfig.WalletURL != nil {
+			if err = v.Data.OIDCConfig.WalletURL.Validate(); err != nil {
+				return nil, fmt.Errorf("verifier profile service: wallet url error: %w", err)
+			}
+		}
+
+		if v.Data.OIDCConfig != nil && v.Data.OIDCConfig.ResponseRedirect != nil {
+			if err = v.Data.OIDCConfig.ResponseRedirect.Validate(); err != nil {
+				return nil, fmt.Errorf("verifier profile service: response redirect error: %w", err)
+			}
+		}
+
+		if v.Data.Checks != nil && v.Data.Checks.Policy.Local != nil {
+			if err = v.Data.Checks.Policy.Local.Validate(); err != nil {
+				return nil, fmt.Errorf("verifier profile service: local policy error: %w", err)
+			}
+		}
+
+		if v.Data.AdHocPresentationDefinition != nil {
+			if err = v.Data.AdHocPresentationDefinition.Validate(); err != nil {
+				return nil, fmt.Errorf("verifier profile service: ad-hoc presentation definition error: %w", err)
+			}
+		}
+
+		if v.Data.VerificationReceipt != nil {
+			if err = v.Data.VerificationReceipt.Validate(); err != nil {
+				return nil, fmt.Errorf("verifier profile service: verification receipt error: %w", err)
+			}
+		}
+
+		if v.Data.PresentationArchive != nil {
+			if err = v.Data.PresentationArchive.Validate(); err != nil {
+				return nil, fmt.Errorf("verifier profile service: presentation archive error: %w", err)
+			}
+		}
+
 		logger.Info("create verifier profile successfully", log.WithID(v.Data.ID))
 
 		r.setTrustList(v.Data)
 		// Set version as it come.
 		r.verifiers[fmt.Sprintf("%s_%s", v.Data.ID, v.Data.Version)] = v.Data
+		r.profiles = append(r.profiles, v.Data)
 
 		verifierVersion := version.Must(version.NewVersion(v.Data.Version))
 
@@ -272,6 +397,11 @@ func (p *verifierProfile) GetAllProfiles(_ string) ([]*profileapi.Verifier, erro
 	return nil, nil
 }
 
+// ListProfiles returns all versions of all profiles.
+func (p *VerifierReader) ListProfiles() ([]*profileapi.Verifier, error) {
+	return p.profiles, nil
+}
+
 // AddFlags add flags in cmd.
 func AddFlags(startCmd *cobra.Command) {
 	startCmd.Flags().StringP(profilesFilePathFlagName, "", "", profilesFilePathFlagUsage)
@@ -332,13 +462,119 @@ func createDid(didDomain string, _ string, kmsConfig *vcskms.Config, webHook str
 		}
 	}
 
-	return &profileapi.SigningDID{
+	signingDID := &profileapi.SigningDID{
 		DID:            createResult.didID,
 		Creator:        createResult.creator,
 		KMSKeyID:       createResult.kmsKeyID,
 		UpdateKeyURL:   createResult.updateKeyURL,
 		RecoveryKeyURL: createResult.recoveryKeyURL,
-	}, nil
+	}
+
+	if vm := createResult.verificationMethod; vm != nil {
+		signingDID.VerificationMethods = []*profileapi.VerificationMethod{{
+			ID:           createResult.creator,
+			Type:         vm.Type,
+			PublicKeyJWK: vm.JSONWebKey(),
+		}}
+	}
+
+	return signingDID, nil
+}
+
+// loadVerificationMethods sets verification methods of the signing DID created outside VCS from assertion
+// methods of its DID document.
+func loadVerificationMethods(didDocumentFile string, signingDID *profileapi.SigningDID) error {
+	if signingDID == nil {
+		return errors.New("did document requires signing did")
+	}
+
+	b, err := os.ReadFile(filepath.Clean(didDocumentFile))
+	if err != nil {
+		return fmt.Errorf("read did document: %w", err)
+	}
+
+	doc, err := did.ParseDocument(b)
+	if err != nil {
+		return fmt.Errorf("parse did document: %w", err)
+	}
+
+	if doc.ID != signingDID.DID {
+		return fmt.Errorf("did document %s doesn't match signing did %s", doc.ID, signingDID.DID)
+	}
+
+	var (
+		methods      []*profileapi.VerificationMethod
+		creatorFound bool
+	)
+
+	for _, v := range doc.VerificationMethods(did.AssertionMethod)[did.AssertionMethod] {
+		vm := v.VerificationMethod
+
+		id := vm.ID
+		if strings.HasPrefix(id, "#") {
+			id = doc.ID + id
+		}
+
+		publicKeyJWK := vm.JSONWebKey()
+		if publicKeyJWK == nil {
+			if vm.Type != ed25519VerificationKey2018 || len(vm.Value) != ed25519.PublicKeySize {
+				return fmt.Errorf("verification method %s: public key jwk is required for type %s", id, vm.Type)
+			}
+
+			publicKeyJWK, err = jwksupport.JWKFromKey(ed25519.PublicKey(vm.Value))
+			if err != nil {
+				return fmt.Errorf("verification method %s: %w", id, err)
+			}
+		}
+
+		methods = append(methods, &profileapi.VerificationMethod{
+			ID:           id,
+			Type:         vm.Type,
+			PublicKeyJWK: publicKeyJWK,
+		})
+
+		creatorFound = creatorFound || id == signingDID.Creator
+	}
+
+	if !creatorFound {
+		return fmt.Errorf("did document doesn't contain assertion method %s", signingDID.Creator)
+	}
+
+	signingDID.VerificationMethods = methods
+
+	return nil
+}
+
+func validateDataModelVersions(issuer *profileapi.Issuer) error {
+	if issuer.VCConfig != nil {
+		if err := vcsverifiable.ValidateDataModelVersion(issuer.VCConfig.DataModelVersion); err != nil {
+			return fmt.Errorf("profile %s: %w", issuer.ID, err)
+		}
+
+		if err := vcsverifiable.ValidateSecuringMechanism(issuer.VCConfig.SecuringMechanism); err != nil {
+			return fmt.Errorf("profile %s: %w", issuer.ID, err)
+		}
+	}
+
+	for _, ct := range issuer.CredentialTemplates {
+		if err := vcsverifiable.ValidateDataModelVersion(ct.DataModelVersion); err != nil {
+			return fmt.Errorf("credential template %s: %w", ct.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func validateStatusPurpose(issuer *profileapi.Issuer) error {
+	if issuer.VCConfig == nil {
+		return nil
+	}
+
+	if _, err := statustype.GetStatusPurpose(issuer.VCConfig.Status.Type, issuer.VCConfig.Status.Purpose); err != nil {
+		return fmt.Errorf("profile %s: %w", issuer.ID, err)
+	}
+
+	return nil
 }
 
 func populateJSONSchemaID(ct *profileapi.CredentialTemplate) error {
@@ -7,11 +7,19 @@ SPDX-License-Identifier: Apache-2.0
 package file
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
 	_ "embed"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/did-go/doc/did"
+	"github.com/trustbloc/kms-go/doc/jose/jwk/jwksupport"
 
+	"github.com/trustbloc/vcs/pkg/doc/vc"
+	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
 	profileapi "github.com/trustbloc/vcs/pkg/profile"
 )
 
@@ -65,6 +73,51 @@ func Test_populateCredentialTemplates(t *testing.T) {
 	})
 }
 
+func Test_validateDataModelVersions(t *testing.T) {
+	require.NoError(t, validateDataModelVersions(&profileapi.Issuer{
+		ID:                  "profile",
+		VCConfig:            &profileapi.VCConfig{DataModelVersion: vcsverifiable.DataModelV2},
+		CredentialTemplates: []*profileapi.CredentialTemplate{{ID: "template"}},
+	}))
+
+	require.EqualError(t, validateDataModelVersions(&profileapi.Issuer{
+		ID:       "profile",
+		VCConfig: &profileapi.VCConfig{DataModelVersion: "v3"},
+	}), `profile profile: unsupported data model version "v3"`)
+
+	require.EqualError(t, validateDataModelVersions(&profileapi.Issuer{
+		ID:       "profile",
+		VCConfig: &profileapi.VCConfig{DataModelVersion: vcsverifiable.DataModelV2, SecuringMechanism: "cbor"},
+	}), `profile profile: unsupported securing mechanism "cbor"`)
+
+	require.EqualError(t, validateDataModelVersions(&profileapi.Issuer{
+		ID: "profile",
+		CredentialTemplates: []*profileapi.CredentialTemplate{
+			{ID: "template", DataModelVersion: "2.0"},
+		},
+	}), `credential template template: unsupported data model version "2.0"`)
+}
+
+func Test_validateStatusPurpose(t *testing.T) {
+	require.NoError(t, validateStatusPurpose(&profileapi.Issuer{ID: "profile"}))
+
+	require.NoError(t, validateStatusPurpose(&profileapi.Issuer{
+		ID: "profile",
+		VCConfig: &profileapi.VCConfig{Status: profileapi.StatusConfig{
+			Type:    vc.BitstringStatusListVCStatus,
+			Purpose: "suspension",
+		}},
+	}))
+
+	require.EqualError(t, validateStatusPurpose(&profileapi.Issuer{
+		ID: "profile",
+		VCConfig: &profileapi.VCConfig{Status: profileapi.StatusConfig{
+			Type:    vc.RevocationList2021VCStatus,
+			Purpose: "suspension",
+		}},
+	}), "profile profile: status purpose suspension is not supported by RevocationList2021Status")
+}
+
 const jsonSchema = `{
   "$id": "https://trustbloc.com/universitydegree.schema.json",
   "$schema": "https://json-schema.org/draft/2020-12/schema",
@@ -76,3 +129,102 @@ const jsonSchema = `{
     }
   }
 }`
+
+func Test_loadVerificationMethods(t *testing.T) {
+	const (
+		didID   = "did:web:example.com"
+		creator = didID + "#key1"
+	)
+
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	j, err := jwksupport.JWKFromKey(pub)
+	require.NoError(t, err)
+
+	jwkVM, err := did.NewVerificationMethodFromJWK(creator, "JsonWebKey2020", didID, j)
+	require.NoError(t, err)
+
+	base58VM := did.NewVerificationMethodFromBytes("#key2", "Ed25519VerificationKey2018", didID, pub)
+	authVM := did.NewVerificationMethodFromBytes("#key3", "Ed25519VerificationKey2018", didID, pub)
+
+	writeDoc := func(t *testing.T, doc *did.Doc) string {
+		t.Helper()
+
+		b, marshalErr := doc.JSONBytes()
+		require.NoError(t, marshalErr)
+
+		file := filepath.Join(t.TempDir(), "did.json")
+		require.NoError(t, os.WriteFile(file, b, 0600))
+
+		return file
+	}
+
+	doc := &did.Doc{
+		Context:            []string{did.ContextV1},
+		ID:                 didID,
+		VerificationMethod: []did.VerificationMethod{*jwkVM, *base58VM, *authVM},
+		AssertionMethod: []did.Verification{
+			*did.NewReferencedVerification(jwkVM, did.AssertionMethod),
+			*did.NewReferencedVerification(base58VM, did.AssertionMethod),
+		},
+		Authentication: []did.Verification{*did.NewReferencedVerification(authVM, did.Authentication)},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		signingDID := &profileapi.SigningDID{DID: didID, Creator: creator}
+
+		require.NoError(t, loadVerificationMethods(writeDoc(t, doc), signingDID))
+		require.Len(t, signingDID.VerificationMethods, 2)
+
+		require.Equal(t, creator, signingDID.VerificationMethods[0].ID)
+		require.Equal(t, "JsonWebKey2020", signingDID.VerificationMethods[0].Type)
+		require.NotNil(t, signingDID.VerificationMethods[0].PublicKeyJWK)
+
+		require.Equal(t, didID+"#key2", signingDID.VerificationMethods[1].ID)
+		require.Equal(t, "Ed25519VerificationKey2018", signingDID.VerificationMethods[1].Type)
+		require.Equal(t, "OKP", signingDID.VerificationMethods[1].PublicKeyJWK.Kty)
+	})
+
+	t.Run("signing did is required", func(t *testing.T) {
+		require.EqualError(t, loadVerificationMethods(writeDoc(t, doc), nil), "did document requires signing did")
+	})
+
+	t.Run("file not found", func(t *testing.T) {
+		err = loadVerificationMethods(filepath.Join(t.TempDir(), "did.json"), &profileapi.SigningDID{DID: didID})
+		require.ErrorContains(t, err, "read did document")
+	})
+
+	t.Run("invalid did document", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "did.json")
+		require.NoError(t, os.WriteFile(file, []byte("{"), 0600))
+
+		require.ErrorContains(t, loadVerificationMethods(file, &profileapi.SigningDID{DID: didID}),
+			"parse did document")
+	})
+
+	t.Run("other did", func(t *testing.T) {
+		err = loadVerificationMethods(writeDoc(t, doc), &profileapi.SigningDID{DID: "did:web:other.com"})
+		require.EqualError(t, err, "did document did:web:example.com doesn't match signing did did:web:other.com")
+	})
+
+	t.Run("creator is not assertion method", func(t *testing.T) {
+		err = loadVerificationMethods(writeDoc(t, doc), &profileapi.SigningDID{DID: didID, Creator: didID + "#key3"})
+		require.EqualError(t, err, "did document doesn't contain assertion method did:web:example.com#key3")
+	})
+
+	t.Run("unsupported key", func(t *testing.T) {
+		blsVM := did.NewVerificationMethodFromBytes("#key4", "Bls12381G2Key2020", didID, []byte("key"))
+
+		blsDoc := &did.Doc{
+			Context:            []string{did.ContextV1},
+			ID:                 didID,
+			VerificationMethod: []did.VerificationMethod{*blsVM},
+			AssertionMethod:    []did.Verification{*did.NewReferencedVerification(blsVM, did.AssertionMethod)},
+		}
+
+		err = loadVerificationMethods(writeDoc(t, blsDoc), &profileapi.SigningDID{DID: didID})
+		require.EqualError(t, err,
+			"verification method did:web:example.com#key4: public key jwk is required for type Bls12381G2Key2020")
+	})
+}
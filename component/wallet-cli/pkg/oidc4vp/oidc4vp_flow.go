@@ -219,6 +219,10 @@ func (f *Flow) Run(ctx context.Context) error {
 		}
 	}
 
+	if err = f.deriveSelectiveDisclosure(vp, &pd, requestObject.Nonce); err != nil {
+		return fmt.Errorf("derive selective disclosure: %w", err)
+	}
+
 	if err = f.sendAuthorizationResponse(ctx, requestObject, vp, attestationRequired); err != nil {
 		return fmt.Errorf("send authorization response: %w", err)
 	}
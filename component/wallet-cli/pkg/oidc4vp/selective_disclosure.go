@@ -0,0 +1,160 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package oidc4vp
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/trustbloc/vc-go/presexch"
+	"github.com/trustbloc/vc-go/verifiable"
+
+	"github.com/trustbloc/vcs/pkg/doc/vc/dataintegrity/bbsplus2023"
+)
+
+// basePointers are disclosed along with the claims requested by the verifier, as they are
+// needed to validate the credential.
+var basePointers = []string{
+	"/issuer",
+	"/issuanceDate",
+	"/validFrom",
+	"/expirationDate",
+	"/validUntil",
+	"/credentialStatus",
+	"/credentialSubject/id",
+}
+
+// deriveSelectiveDisclosure replaces credentials secured with bbsplus-2023 base proof with the derived
+// credentials, which disclose only the claims requested in the presentation definition.
+func (f *Flow) deriveSelectiveDisclosure(
+	vp *verifiable.Presentation,
+	pd *presexch.PresentationDefinition,
+	nonce string,
+) error {
+	credentials := vp.Credentials()
+
+	for i, credential := range credentials {
+		if !hasProofWithCryptosuite(credential, bbsplus2023.SuiteType) {
+			continue
+		}
+
+		credentialBytes, err := credential.MarshalJSON()
+		if err != nil {
+			return fmt.Errorf("marshal credential: %w", err)
+		}
+
+		pointers, err := selectivePointers(credentialBytes, pd)
+		if err != nil {
+			return err
+		}
+
+		slog.Info("Deriving bbsplus-2023 proof", "credential_id", credential.Contents().ID, "pointers", pointers)
+
+		derived, err := bbsplus2023.DeriveProof(credentialBytes, &bbsplus2023.DeriveOptions{
+			LDDocumentLoader:  f.documentLoader,
+			SelectivePointers: pointers,
+			Nonce:             []byte(nonce),
+		})
+		if err != nil {
+			return fmt.Errorf("derive proof: %w", err)
+		}
+
+		credentials[i], err = verifiable.ParseCredential(derived,
+			verifiable.WithDisabledProofCheck(),
+			verifiable.WithJSONLDDocumentLoader(f.documentLoader),
+		)
+		if err != nil {
+			return fmt.Errorf("parse derived credential: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func hasProofWithCryptosuite(credential *verifiable.Credential, cryptosuite string) bool {
+	for _, proof := range credential.Proofs() {
+		if proof["cryptosuite"] == cryptosuite {
+			return true
+		}
+	}
+
+	return false
+}
+
+// selectivePointers converts field paths of the presentation definition to JSON pointers of the claims
+// present in the credential. Whole credential subject is disclosed if no field matches.
+func selectivePointers(credential []byte, pd *presexch.PresentationDefinition) ([]string, error) {
+	var doc map[string]interface{}
+
+	if err := json.Unmarshal(credential, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal credential: %w", err)
+	}
+
+	var pointers []string
+
+	for _, descriptor := range pd.InputDescriptors {
+		if descriptor.Constraints == nil {
+			continue
+		}
+
+		for _, field := range descriptor.Constraints.Fields {
+			for _, path := range field.Path {
+				if pointer, ok := jsonPathToPointer(path); ok && pointerExists(doc, pointer) {
+					pointers = append(pointers, pointer)
+
+					break
+				}
+			}
+		}
+	}
+
+	if len(pointers) == 0 {
+		pointers = append(pointers, "/credentialSubject")
+	}
+
+	for _, pointer := range basePointers {
+		if pointerExists(doc, pointer) {
+			pointers = append(pointers, pointer)
+		}
+	}
+
+	return pointers, nil
+}
+
+// jsonPathToPointer converts simple JSONPath in dot notation (e.g. $.credentialSubject.name) to JSON pointer.
+func jsonPathToPointer(path string) (string, bool) {
+	if !strings.HasPrefix(path, "$.") || strings.ContainsAny(path, "[]*") {
+		return "", false
+	}
+
+	tokens := strings.Split(strings.TrimPrefix(path, "$."), ".")
+
+	for i := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(tokens[i], "~", "~0"), "/", "~1")
+	}
+
+	return "/" + strings.Join(tokens, "/"), true
+}
+
+func pointerExists(doc map[string]interface{}, pointer string) bool {
+	var value interface{} = doc
+
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		if value, ok = m[strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
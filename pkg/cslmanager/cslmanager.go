@@ -12,9 +12,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
+	"path"
 	"strconv"
-	"sync"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/trustbloc/logutil-go/pkg/log"
@@ -31,6 +33,13 @@ import (
 
 var logger = log.New("csl-list-manager")
 
+const (
+	// defaultRolloverThreshold is the share of used indexes after which new CSL is created.
+	defaultRolloverThreshold = 0.9
+	// maxAssignIndexAttempts limits retries of index assignment in case of collisions with other VCS instances.
+	maxAssignIndexAttempts = 10
+)
+
 type vcCrypto interface {
 	SignCredential(signerData *vc.Signer, vc *verifiable.Credential,
 		opts ...vccrypto.SigningOpts) (*verifiable.Credential, error)
@@ -62,32 +71,41 @@ type Config struct {
 	VCStatusStore vcStatusStore
 	CSLIndexStore credentialstatus.CSLIndexStore
 	ListSize      int
-	Crypto        vcCrypto
-	KMSRegistry   kmsRegistry
-	ExternalURL   string
+	// RolloverThreshold is the share of used indexes (0, 1] after which new CSL is created.
+	// Rolling over before the list is full reduces index collisions between VCS instances.
+	RolloverThreshold float64
+	Crypto            vcCrypto
+	KMSRegistry       kmsRegistry
+	ExternalURL       string
 }
 
 type Manager struct {
-	cslIndexStore credentialstatus.CSLIndexStore
-	vcStatusStore vcStatusStore
-	cslVCStore    cslVCStore
-	listSize      int
-	crypto        vcCrypto
-	kmsRegistry   kmsRegistry
-	externalURL   string
-	mutex         sync.RWMutex
+	cslIndexStore    credentialstatus.CSLIndexStore
+	vcStatusStore    vcStatusStore
+	cslVCStore       cslVCStore
+	listSize         int
+	rolloverIndexNum int
+	crypto           vcCrypto
+	kmsRegistry      kmsRegistry
+	externalURL      string
 }
 
 // New returns new CSL list manager.
 func New(config *Config) (*Manager, error) {
+	threshold := config.RolloverThreshold
+	if threshold <= 0 || threshold > 1 {
+		threshold = defaultRolloverThreshold
+	}
+
 	return &Manager{
-		cslIndexStore: config.CSLIndexStore,
-		cslVCStore:    config.CSLVCStore,
-		vcStatusStore: config.VCStatusStore,
-		listSize:      config.ListSize,
-		crypto:        config.Crypto,
-		kmsRegistry:   config.KMSRegistry,
-		externalURL:   config.ExternalURL,
+		cslIndexStore:    config.CSLIndexStore,
+		cslVCStore:       config.CSLVCStore,
+		vcStatusStore:    config.VCStatusStore,
+		listSize:         config.ListSize,
+		rolloverIndexNum: int(math.Ceil(float64(config.ListSize) * threshold)),
+		crypto:           config.Crypto,
+		kmsRegistry:      config.KMSRegistry,
+		externalURL:      config.ExternalURL,
 	}, nil
 }
 
@@ -100,7 +118,12 @@ func (s *Manager) CreateCSLEntry(
 	logger.Debugc(ctx, "CSL Manager - CreateCSLEntry",
 		logfields.WithProfileID(profile.ID), logfields.WithProfileVersion(profile.Version))
 
-	cslURL, statusBitIndex, err := s.getProfileCSLAndAssignedIndex(ctx, profile)
+	seq, err := listSequence(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	cslURL, statusBitIndex, err := s.getProfileCSLAndAssignedIndex(ctx, profile, seq)
 	if err != nil {
 		return nil, err
 	}
@@ -111,7 +134,7 @@ func (s *Manager) CreateCSLEntry(
 	}
 
 	statusListEntry := &credentialstatus.StatusListEntry{
-		TypedID: vcStatusProcessor.CreateVCStatus(strconv.Itoa(statusBitIndex), cslURL),
+		TypedID: vcStatusProcessor.CreateVCStatus(strconv.Itoa(statusBitIndex), cslURL, seq.StatusPurpose),
 		Context: vcStatusProcessor.GetVCContext(),
 	}
 
@@ -124,41 +147,65 @@ func (s *Manager) CreateCSLEntry(
 	return statusListEntry, nil
 }
 
+// getProfileCSLAndAssignedIndex assigns unused index of the latest CSL.
+// Index assignment is atomic on the store level, so it is safe to run multiple VCS instances.
 func (s *Manager) getProfileCSLAndAssignedIndex(ctx context.Context,
-	profile *profileapi.Issuer) (string, int, error) {
+	profile *profileapi.Issuer, seq credentialstatus.ListSequence) (string, int, error) {
 	logger.Debugc(ctx, "CSL Manager - CreateCSLEntry",
 		logfields.WithProfileID(profile.ID), logfields.WithProfileVersion(profile.Version))
 
-	s.mutex.Lock()
-	defer s.mutex.Unlock()
+	for attempt := 0; attempt < maxAssignIndexAttempts; attempt++ {
+		indexWrapper, err := s.getCSLIndexWrapper(ctx, profile, seq)
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to get CSL Index Wrapper from store(s): %w", err)
+		}
 
-	indexWrapper, err := s.getCSLIndexWrapper(ctx, profile)
-	if err != nil {
-		return "", 0, fmt.Errorf("failed to get CSL Index Wrapper from store(s): %w", err)
-	}
+		// The list might be filled up by another instance that failed to create the next one.
+		if s.listSize > 0 && len(indexWrapper.UsedIndexes) >= s.listSize {
+			logger.Debugc(ctx, "CSL is full, creating new CSL ...", log.WithURL(indexWrapper.CSLURL))
 
-	unusedStatusBitIndex, err := s.getUnusedIndex(indexWrapper.UsedIndexes)
-	if err != nil {
-		return "", 0, fmt.Errorf("getUnusedIndex failed: %w", err)
-	}
+			if err = s.createNextCSL(ctx, profile, seq, indexWrapper.ListID); err != nil {
+				return "", 0, fmt.Errorf("failed to createCSLIndexWrapper new CSL: %w", err)
+			}
 
-	// append unusedStatusBitIndex to the cslWrapper.UsedIndexes so marking it as "used".
-	indexWrapper.UsedIndexes = append(indexWrapper.UsedIndexes, unusedStatusBitIndex)
+			continue
+		}
 
-	// TODO: Remove
-	logger.Debugc(ctx, "updating CSL Index Wrapper for URL", log.WithURL(indexWrapper.CSLURL))
+		unusedStatusBitIndex, err := s.getUnusedIndex(indexWrapper.UsedIndexes)
+		if err != nil {
+			return "", 0, fmt.Errorf("getUnusedIndex failed: %w", err)
+		}
+
+		updatedWrapper, err := s.cslIndexStore.AssignIndex(ctx, indexWrapper.CSLURL, unusedStatusBitIndex)
+		if err != nil {
+			if errors.Is(err, credentialstatus.ErrIndexAlreadyUsed) {
+				logger.Debugc(ctx, "CSL index is already used, retrying ...", log.WithURL(indexWrapper.CSLURL))
+
+				continue
+			}
 
-	if err = s.updateCSLIndexWrapper(ctx, indexWrapper, profile); err != nil {
-		return "", 0, fmt.Errorf("failed to store CSL Index Wrapper: %w", err)
+			return "", 0, fmt.Errorf("failed to store CSL Index Wrapper: %w", err)
+		}
+
+		// Exactly one assignment observes the threshold, so only one instance creates the next CSL.
+		if len(updatedWrapper.UsedIndexes) == s.rolloverIndexNum {
+			logger.Debugc(ctx, "reached size limit for CSL, creating new CSL ...")
+
+			if err = s.createNextCSL(ctx, profile, seq, indexWrapper.ListID); err != nil {
+				return "", 0, fmt.Errorf("failed to createCSLIndexWrapper new CSL: %w", err)
+			}
+		}
+
+		return indexWrapper.CSLURL, unusedStatusBitIndex, nil
 	}
 
-	return indexWrapper.CSLURL, unusedStatusBitIndex, nil
+	return "", 0, fmt.Errorf("failed to assign CSL index after %d attempts", maxAssignIndexAttempts)
 }
 
 func (s *Manager) getCSLIndexWrapper(ctx context.Context,
-	profile *profileapi.Issuer) (*credentialstatus.CSLIndexWrapper, error) {
-	// get latest ListID - global value
-	latestListID, err := s.cslIndexStore.GetLatestListID(ctx)
+	profile *profileapi.Issuer, seq credentialstatus.ListSequence) (*credentialstatus.CSLIndexWrapper, error) {
+	// get latest ListID - common value for the list sequence of the profile
+	latestListID, err := s.cslIndexStore.GetLatestListID(ctx, seq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get latestListID from store: %w", err)
 	}
@@ -171,7 +218,7 @@ func (s *Manager) getCSLIndexWrapper(ctx context.Context,
 	indexWrapper, err := s.cslIndexStore.Get(ctx, cslURL)
 	if err != nil {
 		if errors.Is(err, credentialstatus.ErrDataNotFound) {
-			indexWrapper, err = s.createNewVCAndCSLIndexWrapper(ctx, profile, latestListID)
+			indexWrapper, err = s.createNewVCAndCSLIndexWrapper(ctx, profile, seq, latestListID)
 			if err != nil {
 				return nil, err
 			}
@@ -180,51 +227,38 @@ func (s *Manager) getCSLIndexWrapper(ctx context.Context,
 		}
 	}
 
+	// Wrappers stored by previous versions do not contain list ID.
+	indexWrapper.ListID = latestListID
+
 	return indexWrapper, nil
 }
 
-func (s *Manager) updateCSLIndexWrapper(ctx context.Context,
-	wrapper *credentialstatus.CSLIndexWrapper,
-	profile *profileapi.Issuer) error {
-	// TODO: Remove
-	logger.Debugc(ctx, "updating CSL VC with URL", log.WithURL(wrapper.CSLURL))
+// createNextCSL creates new CSL and makes it the latest one in case the latest list is still currentListID.
+// The new CSL is completely stored before it becomes visible to other VCS instances.
+func (s *Manager) createNextCSL(ctx context.Context, profile *profileapi.Issuer,
+	seq credentialstatus.ListSequence, currentListID credentialstatus.ListID) error {
+	newListID := credentialstatus.ListID(uuid.NewString())
 
-	err := s.cslIndexStore.Upsert(ctx, wrapper.CSLURL, wrapper)
-	if err != nil {
+	if _, err := s.createNewVCAndCSLIndexWrapper(ctx, profile, seq, newListID); err != nil {
 		return fmt.Errorf("failed to store CSL Index Wrapper: %w", err)
 	}
 
-	// If amount of used indexes is the same as list size - createCSLIndexWrapper new CSL (ListID, VC and Index Wrapper).
-	// TODO: We should have used indexes > some percent of list size (e.g. 75-90%) in order to avoid collisions.
-	if len(wrapper.UsedIndexes) == s.listSize {
-		logger.Debugc(ctx, "reached size limit for CSL, creating new CSL ...")
-		_, createErr := s.createCSLIndexWrapper(ctx, profile)
-		if createErr != nil {
-			return fmt.Errorf("failed to createCSLIndexWrapper new CSL: %w", createErr)
-		}
-	}
+	if err := s.cslIndexStore.UpdateLatestListID(ctx, seq, currentListID, newListID); err != nil {
+		if errors.Is(err, credentialstatus.ErrLatestListIDChanged) {
+			logger.Debugc(ctx, "new CSL was already created by another instance")
 
-	return nil
-}
-
-func (s *Manager) createCSLIndexWrapper(ctx context.Context,
-	profile *profileapi.Issuer) (*credentialstatus.CSLIndexWrapper, error) {
-	newListID := credentialstatus.ListID(uuid.NewString())
-
-	if err := s.cslIndexStore.UpdateLatestListID(ctx, newListID); err != nil {
-		return nil, fmt.Errorf("failed to store new list ID: %w", err)
-	}
+			return nil
+		}
 
-	wrapper, err := s.createNewVCAndCSLIndexWrapper(ctx, profile, newListID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to store CSL Index Wrapper: %w", err)
+		return fmt.Errorf("failed to store new list ID: %w", err)
 	}
 
-	return wrapper, nil
+	return nil
 }
 
 func (s *Manager) createNewVCAndCSLIndexWrapper(ctx context.Context,
 	profile *profileapi.Issuer,
+	seq credentialstatus.ListSequence,
 	listID credentialstatus.ListID,
 ) (*credentialstatus.CSLIndexWrapper, error) {
 	kms, err := s.kmsRegistry.GetKeyManager(profile.KMSConfig)
@@ -242,6 +276,7 @@ func (s *Manager) createNewVCAndCSLIndexWrapper(ctx context.Context,
 		Format:                  profile.VCConfig.Format,
 		SignatureRepresentation: profile.VCConfig.SignatureRepresentation,
 		VCStatusListType:        profile.VCConfig.Status.Type,
+		VCStatusPurpose:         seq.StatusPurpose,
 		SDJWT:                   vc.SDJWT{Enable: false},
 	}
 
@@ -258,17 +293,60 @@ func (s *Manager) createNewVCAndCSLIndexWrapper(ctx context.Context,
 	}
 
 	indexWrapper := &credentialstatus.CSLIndexWrapper{
-		UsedIndexes: nil,
-		CSLURL:      cslURL,
+		UsedIndexes:    []int{},
+		CSLURL:         cslURL,
+		ListID:         listID,
+		ProfileGroupID: seq.ProfileGroupID,
+		StatusPurpose:  seq.StatusPurpose,
+		StatusType:     seq.StatusType,
 	}
 
-	if err = s.cslIndexStore.Upsert(ctx, cslURL, indexWrapper); err != nil {
-		return nil, fmt.Errorf("failed to store CSL Index Wrapper: %w", err)
+	if err = s.cslIndexStore.Create(ctx, cslURL, indexWrapper); err != nil {
+		if !errors.Is(err, credentialstatus.ErrDataAlreadyExists) {
+			return nil, fmt.Errorf("failed to store CSL Index Wrapper: %w", err)
+		}
+
+		// CSL was created concurrently by another instance, so use the stored one.
+		return s.cslIndexStore.Get(ctx, cslURL)
 	}
 
 	return indexWrapper, nil
 }
 
+// ListCSLs returns fill levels of all CSLs of the profile group.
+func (s *Manager) ListCSLs(ctx context.Context, profileGroupID profileapi.ID) ([]*credentialstatus.CSLInfo, error) {
+	groupURL, err := s.cslVCStore.GetCSLURL(s.externalURL, profileGroupID, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CSL URL: %w", err)
+	}
+
+	indexWrappers, err := s.cslIndexStore.List(ctx, strings.TrimSuffix(groupURL, "/")+"/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CSL Index Wrappers: %w", err)
+	}
+
+	cslInfos := make([]*credentialstatus.CSLInfo, 0, len(indexWrappers))
+
+	for _, w := range indexWrappers {
+		listID := w.ListID
+		if listID == "" {
+			// Wrappers stored by previous versions do not contain list ID.
+			listID = credentialstatus.ListID(path.Base(w.CSLURL))
+		}
+
+		cslInfos = append(cslInfos, &credentialstatus.CSLInfo{
+			CSLURL:        w.CSLURL,
+			ListID:        listID,
+			StatusPurpose: w.StatusPurpose,
+			StatusType:    w.StatusType,
+			ListSize:      s.listSize,
+			UsedIndexes:   len(w.UsedIndexes),
+		})
+	}
+
+	return cslInfos, nil
+}
+
 func (s *Manager) createAndStoreVC(ctx context.Context, signer *vc.Signer, cslURL string) error {
 	processor, err := statustype.GetVCStatusProcessor(signer.VCStatusListType)
 	if err != nil {
@@ -302,6 +380,21 @@ func (s *Manager) createAndStoreVC(ctx context.Context, signer *vc.Signer, cslUR
 	return nil
 }
 
+// listSequence returns the sequence of CSLs of the profile, lists are kept per profile group, status purpose
+// and status type.
+func listSequence(profile *profileapi.Issuer) (credentialstatus.ListSequence, error) {
+	statusPurpose, err := statustype.GetStatusPurpose(profile.VCConfig.Status.Type, profile.VCConfig.Status.Purpose)
+	if err != nil {
+		return credentialstatus.ListSequence{}, fmt.Errorf("failed to get status purpose: %w", err)
+	}
+
+	return credentialstatus.ListSequence{
+		ProfileGroupID: profile.GroupID,
+		StatusPurpose:  statusPurpose,
+		StatusType:     profile.VCConfig.Status.Type,
+	}, nil
+}
+
 func (s *Manager) getUnusedIndex(usedIndexes []int) (int, error) {
 	usedIndexesMap := make(map[int]struct{}, len(usedIndexes))
 
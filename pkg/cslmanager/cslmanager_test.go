@@ -15,6 +15,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -59,7 +60,7 @@ func TestCredentialStatusList_CreateCSLEntry(t *testing.T) {
 	t.Run("test success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mockKMSRegistry := NewMockKMSRegistry(ctrl)
-		mockKMSRegistry.EXPECT().GetKeyManager(gomock.Any()).Times(5).Return(&vcskms.MockKMS{}, nil)
+		mockKMSRegistry.EXPECT().GetKeyManager(gomock.Any()).Times(3).Return(&vcskms.MockKMS{}, nil)
 		ctx := context.Background()
 
 		cslIndexStore := newMockCSLIndexStore()
@@ -70,7 +71,7 @@ func TestCredentialStatusList_CreateCSLEntry(t *testing.T) {
 			Put(gomock.Any(), testProfileID, testProfileVersion, credID, gomock.Any()).
 			Times(5).Return(nil)
 
-		listID, err := cslIndexStore.GetLatestListID(context.Background())
+		listID, err := cslIndexStore.GetLatestListID(context.Background(), testListSequence(t, getTestProfile()))
 		require.NoError(t, err)
 
 		s, err := New(&Config{
@@ -94,7 +95,7 @@ func TestCredentialStatusList_CreateCSLEntry(t *testing.T) {
 		validateVCStatus(t, cslVCStore, statusID, listID)
 
 		// List size equals 2, so after 2 issuances CSL encodedBitString is full and listID must be updated.
-		updatedListID, err := cslIndexStore.GetLatestListID(ctx)
+		updatedListID, err := cslIndexStore.GetLatestListID(ctx, testListSequence(t, getTestProfile()))
 		require.NoError(t, err)
 		require.NotEqual(t, updatedListID, listID)
 
@@ -107,7 +108,7 @@ func TestCredentialStatusList_CreateCSLEntry(t *testing.T) {
 		validateVCStatus(t, cslVCStore, statusID, updatedListID)
 
 		// List size equals 2, so after 4 issuances CSL encodedBitString is full and listID must be updated.
-		updatedListIDSecond, err := cslIndexStore.GetLatestListID(ctx)
+		updatedListIDSecond, err := cslIndexStore.GetLatestListID(ctx, testListSequence(t, getTestProfile()))
 		require.NoError(t, err)
 		require.NotEqual(t, updatedListID, updatedListIDSecond)
 		require.NotEqual(t, listID, updatedListIDSecond)
@@ -154,7 +155,7 @@ func TestCredentialStatusList_CreateCSLEntry(t *testing.T) {
 		cslIndexStore := newMockCSLIndexStore()
 		cslVCStore := newMockCSLVCStore()
 
-		_, err := cslIndexStore.GetLatestListID(context.Background())
+		_, err := cslIndexStore.GetLatestListID(context.Background(), testListSequence(t, getTestProfile()))
 		require.NoError(t, err)
 
 		s, err := New(&Config{
@@ -253,7 +254,7 @@ func TestCredentialStatusList_CreateCSLEntry(t *testing.T) {
 
 		cslIndexStore := newMockCSLIndexStore()
 
-		_, err := cslIndexStore.GetLatestListID(context.Background())
+		_, err := cslIndexStore.GetLatestListID(context.Background(), testListSequence(t, getTestProfile()))
 		require.NoError(t, err)
 
 		s, err := New(&Config{
@@ -298,10 +299,17 @@ func TestCredentialStatusList_CreateCSLEntry(t *testing.T) {
 		require.Contains(t, err.Error(), "getUnusedIndex failed")
 	})
 
-	t.Run("test error put typedID to store - no available unused indexes", func(t *testing.T) {
+	t.Run("test success - full list is rolled over", func(t *testing.T) {
 		profile := getTestProfile()
 
-		mockKMSRegistry := NewMockKMSRegistry(gomock.NewController(t))
+		ctrl := gomock.NewController(t)
+		mockKMSRegistry := NewMockKMSRegistry(ctrl)
+		mockKMSRegistry.EXPECT().GetKeyManager(gomock.Any()).Times(1).Return(&vcskms.MockKMS{}, nil)
+
+		mockVCStatusStore := NewMockVCStatusStore(ctrl)
+		mockVCStatusStore.EXPECT().
+			Put(gomock.Any(), testProfileID, testProfileVersion, credID, gomock.Any()).
+			Times(1).Return(nil)
 
 		cslIndexStore := newMockCSLIndexStore()
 		cslVCStore := newMockCSLVCStore()
@@ -309,7 +317,7 @@ func TestCredentialStatusList_CreateCSLEntry(t *testing.T) {
 		statusProcessor, err := statustype.GetVCStatusProcessor(vc.StatusList2021VCStatus)
 		require.NoError(t, err)
 
-		listID, err := cslIndexStore.GetLatestListID(context.Background())
+		listID, err := cslIndexStore.GetLatestListID(context.Background(), testListSequence(t, getTestProfile()))
 		require.NoError(t, err)
 
 		cslURL, err := cslVCStore.GetCSLURL("https://localhost:8080", profile.GroupID, listID)
@@ -322,6 +330,7 @@ func TestCredentialStatusList_CreateCSLEntry(t *testing.T) {
 		require.NoError(t, err)
 
 		require.NoError(t, cslIndexStore.Upsert(context.Background(), cslURL, &credentialstatus.CSLIndexWrapper{
+			CSLURL:      cslURL,
 			UsedIndexes: []int{0, 1},
 		}))
 
@@ -332,7 +341,7 @@ func TestCredentialStatusList_CreateCSLEntry(t *testing.T) {
 		s, err := New(&Config{
 			CSLVCStore:    cslVCStore,
 			CSLIndexStore: cslIndexStore,
-			VCStatusStore: nil,
+			VCStatusStore: mockVCStatusStore,
 			ListSize:      2,
 			KMSRegistry:   mockKMSRegistry,
 			ExternalURL:   "https://localhost:8080",
@@ -341,10 +350,14 @@ func TestCredentialStatusList_CreateCSLEntry(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		status, err := s.CreateCSLEntry(context.Background(), testProfile, credID)
-		require.Error(t, err)
-		require.Nil(t, status)
-		require.Contains(t, err.Error(), "getUnusedIndex failed")
+		statusID, err := s.CreateCSLEntry(context.Background(), testProfile, credID)
+		require.NoError(t, err)
+
+		updatedListID, err := cslIndexStore.GetLatestListID(context.Background(), testListSequence(t, getTestProfile()))
+		require.NoError(t, err)
+		require.NotEqual(t, listID, updatedListID)
+
+		validateVCStatus(t, cslVCStore, statusID, updatedListID)
 	})
 
 	t.Run("test error from store csl list in store", func(t *testing.T) {
@@ -373,7 +386,7 @@ func TestCredentialStatusList_CreateCSLEntry(t *testing.T) {
 
 	t.Run("test error update latest list id", func(t *testing.T) {
 		mockKMSRegistry := NewMockKMSRegistry(gomock.NewController(t))
-		mockKMSRegistry.EXPECT().GetKeyManager(gomock.Any()).Times(1).Return(&vcskms.MockKMS{}, nil)
+		mockKMSRegistry.EXPECT().GetKeyManager(gomock.Any()).Times(2).Return(&vcskms.MockKMS{}, nil)
 
 		s, err := New(&Config{
 			CSLVCStore: newMockCSLVCStore(),
@@ -425,6 +438,83 @@ func TestCredentialStatusList_CreateCSLEntry(t *testing.T) {
 	})
 }
 
+func TestCredentialStatusList_CreateCSLEntry_MultipleInstances(t *testing.T) {
+	const (
+		instances          = 4
+		entriesPerInstance = 25
+		listSize           = 16
+	)
+
+	loader := testutil.DocumentLoader(t)
+
+	ctrl := gomock.NewController(t)
+	mockKMSRegistry := NewMockKMSRegistry(ctrl)
+	mockKMSRegistry.EXPECT().GetKeyManager(gomock.Any()).AnyTimes().Return(&vcskms.MockKMS{}, nil)
+
+	mockVCStatusStore := NewMockVCStatusStore(ctrl)
+	mockVCStatusStore.EXPECT().Put(gomock.Any(), testProfileID, testProfileVersion, credID, gomock.Any()).
+		AnyTimes().Return(nil)
+
+	cslIndexStore := newMockCSLIndexStore()
+	cslVCStore := newMockCSLVCStore()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		assigned = map[string]struct{}{}
+		errs     []error
+	)
+
+	for i := 0; i < instances; i++ {
+		// Every manager represents separate VCS instance that shares the stores with others.
+		s, err := New(&Config{
+			CSLIndexStore: cslIndexStore,
+			CSLVCStore:    cslVCStore,
+			VCStatusStore: mockVCStatusStore,
+			ListSize:      listSize,
+			KMSRegistry:   mockKMSRegistry,
+			ExternalURL:   "https://localhost:8080",
+			Crypto: vccrypto.New(
+				&vdrmock.VDRegistry{ResolveValue: createDIDDoc()}, loader),
+		})
+		require.NoError(t, err)
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < entriesPerInstance; j++ {
+				entry, err := s.CreateCSLEntry(context.Background(), getTestProfile(), credID)
+
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, err)
+				} else {
+					assigned[entry.TypedID.CustomFields[statustype.StatusListCredential].(string)+"#"+
+						entry.TypedID.CustomFields[statustype.StatusListIndex].(string)] = struct{}{}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	require.Empty(t, errs)
+	// Every credential must get its own bit in the status list.
+	require.Len(t, assigned, instances*entriesPerInstance)
+}
+
+func testListSequence(t *testing.T, profile *profileapi.Issuer) credentialstatus.ListSequence {
+	t.Helper()
+
+	seq, err := listSequence(profile)
+	require.NoError(t, err)
+
+	return seq
+}
+
 func getTestProfile() *profileapi.Issuer {
 	return &profileapi.Issuer{
 		ID:      testProfileID,
@@ -447,19 +537,21 @@ func getTestProfile() *profileapi.Issuer {
 }
 
 type mockCSLIndexStore struct {
+	mu                    sync.Mutex
 	createErr             error
 	findErr               error
+	assignIndexErr        error
 	getLatestListIDErr    error
 	createLatestListIDErr error
 	updateLatestListIDErr error
-	latestListID          credentialstatus.ListID
+	latestListIDs         map[credentialstatus.ListSequence]credentialstatus.ListID
 	s                     map[string]*credentialstatus.CSLIndexWrapper
 }
 
 func newMockCSLIndexStore(opts ...func(*mockCSLIndexStore)) *mockCSLIndexStore {
 	s := &mockCSLIndexStore{
-		latestListID: "",
-		s:            map[string]*credentialstatus.CSLIndexWrapper{},
+		latestListIDs: map[credentialstatus.ListSequence]credentialstatus.ListID{},
+		s:             map[string]*credentialstatus.CSLIndexWrapper{},
 	}
 	for _, f := range opts {
 		f(s)
@@ -469,6 +561,9 @@ func newMockCSLIndexStore(opts ...func(*mockCSLIndexStore)) *mockCSLIndexStore {
 
 func (m *mockCSLIndexStore) Upsert(_ context.Context, cslURL string,
 	cslWrapper *credentialstatus.CSLIndexWrapper) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	if m.createErr != nil {
 		return m.createErr
 	}
@@ -478,7 +573,28 @@ func (m *mockCSLIndexStore) Upsert(_ context.Context, cslURL string,
 	return nil
 }
 
+func (m *mockCSLIndexStore) Create(_ context.Context, cslURL string,
+	cslWrapper *credentialstatus.CSLIndexWrapper) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.createErr != nil {
+		return m.createErr
+	}
+
+	if _, ok := m.s[cslURL]; ok {
+		return credentialstatus.ErrDataAlreadyExists
+	}
+
+	m.s[cslURL] = copyIndexWrapper(cslWrapper)
+
+	return nil
+}
+
 func (m *mockCSLIndexStore) Get(_ context.Context, cslURL string) (*credentialstatus.CSLIndexWrapper, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	if m.findErr != nil {
 		return nil, m.findErr
 	}
@@ -488,41 +604,110 @@ func (m *mockCSLIndexStore) Get(_ context.Context, cslURL string) (*credentialst
 		return nil, credentialstatus.ErrDataNotFound
 	}
 
-	return w, nil
+	return copyIndexWrapper(w), nil
+}
+
+func (m *mockCSLIndexStore) AssignIndex(
+	_ context.Context, cslURL string, index int) (*credentialstatus.CSLIndexWrapper, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.assignIndexErr != nil {
+		return nil, m.assignIndexErr
+	}
+
+	w, ok := m.s[cslURL]
+	if !ok {
+		return nil, credentialstatus.ErrDataNotFound
+	}
+
+	for _, i := range w.UsedIndexes {
+		if i == index {
+			return nil, credentialstatus.ErrIndexAlreadyUsed
+		}
+	}
+
+	w.UsedIndexes = append(w.UsedIndexes, index)
+
+	return copyIndexWrapper(w), nil
 }
-func (m *mockCSLIndexStore) createLatestListID() error {
+
+func (m *mockCSLIndexStore) List(
+	_ context.Context, cslURLPrefix string) ([]*credentialstatus.CSLIndexWrapper, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
+
+	var wrappers []*credentialstatus.CSLIndexWrapper
+
+	for cslURL, w := range m.s {
+		if strings.HasPrefix(cslURL, cslURLPrefix) {
+			wrappers = append(wrappers, copyIndexWrapper(w))
+		}
+	}
+
+	return wrappers, nil
+}
+
+func (m *mockCSLIndexStore) createLatestListID(seq credentialstatus.ListSequence) error {
 	if m.createLatestListIDErr != nil {
 		return m.createLatestListIDErr
 	}
 
-	m.latestListID = credentialstatus.ListID(uuid.NewString())
+	m.latestListIDs[seq] = credentialstatus.ListID(uuid.NewString())
 
 	return nil
 }
 
-func (m *mockCSLIndexStore) UpdateLatestListID(_ context.Context, _ credentialstatus.ListID) error {
+func (m *mockCSLIndexStore) UpdateLatestListID(
+	_ context.Context, seq credentialstatus.ListSequence, prevID, id credentialstatus.ListID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	if m.updateLatestListIDErr != nil {
 		return m.updateLatestListIDErr
 	}
-	return m.createLatestListID()
+
+	if m.latestListIDs[seq] != prevID {
+		return credentialstatus.ErrLatestListIDChanged
+	}
+
+	m.latestListIDs[seq] = id
+
+	return nil
 }
 
-func (m *mockCSLIndexStore) GetLatestListID(_ context.Context) (credentialstatus.ListID, error) {
+func (m *mockCSLIndexStore) GetLatestListID(
+	_ context.Context, seq credentialstatus.ListSequence) (credentialstatus.ListID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	if m.getLatestListIDErr != nil {
 		return "", m.getLatestListIDErr
 	}
 
-	if m.latestListID == "" {
-		err := m.createLatestListID()
+	if m.latestListIDs[seq] == "" {
+		err := m.createLatestListID(seq)
 		if err != nil {
 			return "", err
 		}
 	}
 
-	return m.latestListID, nil
+	return m.latestListIDs[seq], nil
+}
+
+func copyIndexWrapper(w *credentialstatus.CSLIndexWrapper) *credentialstatus.CSLIndexWrapper {
+	c := *w
+	c.UsedIndexes = append([]int{}, w.UsedIndexes...)
+
+	return &c
 }
 
 type mockCSLVCStore struct {
+	mu        sync.Mutex
 	createErr error
 	getCSLErr error
 	findErr   error
@@ -548,6 +733,9 @@ func (m *mockCSLVCStore) GetCSLURL(issuerURL, issuerID string, listID credential
 }
 
 func (m *mockCSLVCStore) Upsert(_ context.Context, cslURL string, cslWrapper *credentialstatus.CSLVCWrapper) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	if m.createErr != nil {
 		return m.createErr
 	}
@@ -558,6 +746,9 @@ func (m *mockCSLVCStore) Upsert(_ context.Context, cslURL string, cslWrapper *cr
 }
 
 func (m *mockCSLVCStore) Get(_ context.Context, cslURL string) (*credentialstatus.CSLVCWrapper, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	if m.findErr != nil {
 		return nil, m.findErr
 	}
@@ -570,6 +761,216 @@ func (m *mockCSLVCStore) Get(_ context.Context, cslURL string) (*credentialstatu
 	return w, nil
 }
 
+func TestCredentialStatusList_CreateCSLEntry_ProfileGroups(t *testing.T) {
+	loader := testutil.DocumentLoader(t)
+
+	ctrl := gomock.NewController(t)
+	mockKMSRegistry := NewMockKMSRegistry(ctrl)
+	mockKMSRegistry.EXPECT().GetKeyManager(gomock.Any()).AnyTimes().Return(&vcskms.MockKMS{}, nil)
+
+	mockVCStatusStore := NewMockVCStatusStore(ctrl)
+	mockVCStatusStore.EXPECT().Put(gomock.Any(), testProfileID, testProfileVersion, credID, gomock.Any()).
+		AnyTimes().Return(nil)
+
+	cslIndexStore := newMockCSLIndexStore()
+	cslVCStore := newMockCSLVCStore()
+
+	s, err := New(&Config{
+		CSLIndexStore: cslIndexStore,
+		CSLVCStore:    cslVCStore,
+		VCStatusStore: mockVCStatusStore,
+		ListSize:      2,
+		KMSRegistry:   mockKMSRegistry,
+		ExternalURL:   "https://localhost:8080",
+		Crypto: vccrypto.New(
+			&vdrmock.VDRegistry{ResolveValue: createDIDDoc()}, loader),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	profileA := getTestProfile()
+	profileA.GroupID = "groupA"
+
+	profileB := getTestProfile()
+	profileB.GroupID = "groupB"
+
+	listIDB, err := cslIndexStore.GetLatestListID(ctx, testListSequence(t, profileB))
+	require.NoError(t, err)
+
+	// Fill up the list of profile group A, so it is rolled over.
+	for i := 0; i < 2; i++ {
+		_, err = s.CreateCSLEntry(ctx, profileA, credID)
+		require.NoError(t, err)
+	}
+
+	// The list of profile group B is not affected.
+	updatedListIDB, err := cslIndexStore.GetLatestListID(ctx, testListSequence(t, profileB))
+	require.NoError(t, err)
+	require.Equal(t, listIDB, updatedListIDB)
+
+	statusID, err := s.CreateCSLEntry(ctx, profileB, credID)
+	require.NoError(t, err)
+
+	expectedCSLURL, err := cslVCStore.GetCSLURL("https://localhost:8080", profileB.GroupID, listIDB)
+	require.NoError(t, err)
+	require.Equal(t, expectedCSLURL, statusID.TypedID.CustomFields[statustype.StatusListCredential])
+
+	cslInfos, err := s.ListCSLs(ctx, profileA.GroupID)
+	require.NoError(t, err)
+	require.Len(t, cslInfos, 2)
+
+	usedIndexes := 0
+
+	for _, info := range cslInfos {
+		require.Contains(t, info.CSLURL, "/groupA/")
+		require.True(t, strings.HasSuffix(info.CSLURL, string(info.ListID)))
+		require.Equal(t, "revocation", info.StatusPurpose)
+		require.Equal(t, vc.StatusList2021VCStatus, info.StatusType)
+		require.Equal(t, 2, info.ListSize)
+
+		usedIndexes += info.UsedIndexes
+	}
+
+	require.Equal(t, 2, usedIndexes)
+
+	cslInfos, err = s.ListCSLs(ctx, profileB.GroupID)
+	require.NoError(t, err)
+	require.Len(t, cslInfos, 1)
+	require.Equal(t, listIDB, cslInfos[0].ListID)
+	require.Equal(t, 1, cslInfos[0].UsedIndexes)
+}
+
+func TestCredentialStatusList_CreateCSLEntry_StatusPurposes(t *testing.T) {
+	loader := testutil.DocumentLoader(t)
+
+	ctrl := gomock.NewController(t)
+	mockKMSRegistry := NewMockKMSRegistry(ctrl)
+	mockKMSRegistry.EXPECT().GetKeyManager(gomock.Any()).AnyTimes().Return(&vcskms.MockKMS{}, nil)
+
+	mockVCStatusStore := NewMockVCStatusStore(ctrl)
+	mockVCStatusStore.EXPECT().Put(gomock.Any(), testProfileID, testProfileVersion, credID, gomock.Any()).
+		AnyTimes().Return(nil)
+
+	cslIndexStore := newMockCSLIndexStore()
+	cslVCStore := newMockCSLVCStore()
+
+	s, err := New(&Config{
+		CSLIndexStore: cslIndexStore,
+		CSLVCStore:    cslVCStore,
+		VCStatusStore: mockVCStatusStore,
+		ListSize:      2,
+		KMSRegistry:   mockKMSRegistry,
+		ExternalURL:   "https://localhost:8080",
+		Crypto: vccrypto.New(
+			&vdrmock.VDRegistry{ResolveValue: createDIDDoc()}, loader),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	revocationProfile := getTestProfile()
+
+	suspensionProfile := getTestProfile()
+	suspensionProfile.VCConfig.Status.Purpose = statustype.StatusPurposeSuspension
+
+	revocationEntry, err := s.CreateCSLEntry(ctx, revocationProfile, credID)
+	require.NoError(t, err)
+	require.Equal(t, "revocation", revocationEntry.TypedID.CustomFields[statustype.StatusPurpose])
+
+	suspensionEntry, err := s.CreateCSLEntry(ctx, suspensionProfile, credID)
+	require.NoError(t, err)
+	require.Equal(t, "suspension", suspensionEntry.TypedID.CustomFields[statustype.StatusPurpose])
+
+	// Lists of the profile group are kept per status purpose.
+	require.NotEqual(t, revocationEntry.TypedID.CustomFields[statustype.StatusListCredential],
+		suspensionEntry.TypedID.CustomFields[statustype.StatusListCredential])
+
+	suspensionCSLURL, ok := suspensionEntry.TypedID.CustomFields[statustype.StatusListCredential].(string)
+	require.True(t, ok)
+
+	csl, err := cslVCStore.Get(ctx, suspensionCSLURL)
+	require.NoError(t, err)
+	require.Equal(t, "suspension", csl.VC.Contents().Subject[0].CustomFields[statustype.StatusPurpose])
+
+	cslInfos, err := s.ListCSLs(ctx, revocationProfile.GroupID)
+	require.NoError(t, err)
+	purposes := make([]string, 0, len(cslInfos))
+	for _, info := range cslInfos {
+		purposes = append(purposes, info.StatusPurpose)
+	}
+
+	require.ElementsMatch(t, []string{"revocation", "suspension"}, purposes)
+
+	t.Run("unsupported status purpose", func(t *testing.T) {
+		profile := getTestProfile()
+		profile.VCConfig.Status.Purpose = "message"
+
+		_, err = s.CreateCSLEntry(ctx, profile, credID)
+		require.ErrorContains(t, err, "failed to get status purpose: unsupported status purpose message")
+	})
+}
+
+func TestCredentialStatusList_ListCSLs(t *testing.T) {
+	t.Run("legacy wrapper without list ID", func(t *testing.T) {
+		cslIndexStore := newMockCSLIndexStore()
+		cslVCStore := newMockCSLVCStore()
+
+		cslURL, err := cslVCStore.GetCSLURL("https://localhost:8080", "group", "legacy-list")
+		require.NoError(t, err)
+
+		require.NoError(t, cslIndexStore.Upsert(context.Background(), cslURL, &credentialstatus.CSLIndexWrapper{
+			CSLURL:      cslURL,
+			UsedIndexes: []int{1, 3},
+		}))
+
+		s, err := New(&Config{
+			CSLIndexStore: cslIndexStore,
+			CSLVCStore:    cslVCStore,
+			ListSize:      10,
+			ExternalURL:   "https://localhost:8080",
+		})
+		require.NoError(t, err)
+
+		cslInfos, err := s.ListCSLs(context.Background(), "group")
+		require.NoError(t, err)
+		require.Len(t, cslInfos, 1)
+		require.Equal(t, credentialstatus.ListID("legacy-list"), cslInfos[0].ListID)
+		require.Equal(t, 2, cslInfos[0].UsedIndexes)
+		require.Equal(t, 10, cslInfos[0].ListSize)
+
+		cslInfos, err = s.ListCSLs(context.Background(), "gro")
+		require.NoError(t, err)
+		require.Empty(t, cslInfos)
+	})
+
+	t.Run("get CSL URL error", func(t *testing.T) {
+		s, err := New(&Config{
+			CSLIndexStore: newMockCSLIndexStore(),
+			CSLVCStore: newMockCSLVCStore(func(store *mockCSLVCStore) {
+				store.getCSLErr = errors.New("some error")
+			}),
+		})
+		require.NoError(t, err)
+
+		_, err = s.ListCSLs(context.Background(), "group")
+		require.ErrorContains(t, err, "failed to get CSL URL")
+	})
+
+	t.Run("list error", func(t *testing.T) {
+		s, err := New(&Config{
+			CSLIndexStore: newMockCSLIndexStore(func(store *mockCSLIndexStore) {
+				store.findErr = errors.New("some error")
+			}),
+			CSLVCStore: newMockCSLVCStore(),
+		})
+		require.NoError(t, err)
+
+		_, err = s.ListCSLs(context.Background(), "group")
+		require.ErrorContains(t, err, "failed to list CSL Index Wrappers")
+	})
+}
+
 func TestService_getUnusedIndex(t *testing.T) {
 	type fields struct {
 		listSize int
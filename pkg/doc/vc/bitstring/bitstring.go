@@ -11,13 +11,38 @@ import (
 	"compress/gzip"
 	"encoding/base64"
 	"fmt"
+	"strings"
 )
 
 const (
 	bitsPerByte = 8
 	one         = 0x1
+
+	// MultibaseBase64URLPrefix is the multibase prefix of the base64url (no padding) encoding
+	// used by Bitstring Status List encodedList.
+	MultibaseBase64URLPrefix = "u"
 )
 
+type encodeOpts struct {
+	multibase bool
+}
+
+// Opt is an option of bits encoding.
+type Opt func(opts *encodeOpts)
+
+// WithMultibaseEncoding adds multibase base64url prefix to the encoded bits.
+func WithMultibaseEncoding() Opt {
+	return func(opts *encodeOpts) {
+		opts.multibase = true
+	}
+}
+
+// IsMultibaseEncoded checks whether the encoded bits have multibase base64url prefix.
+// Base64url encoded gzip data never starts with the prefix, so the check is unambiguous.
+func IsMultibaseEncoded(encodedBits string) bool {
+	return strings.HasPrefix(encodedBits, MultibaseBase64URLPrefix)
+}
+
 // BitString struct.
 type BitString struct {
 	bits    []byte
@@ -30,9 +55,9 @@ func NewBitString(length int) *BitString {
 	return &BitString{bits: make([]byte, size), numBits: length}
 }
 
-// DecodeBits decode bits.
+// DecodeBits decode bits. Both plain and multibase base64url encodings are supported.
 func DecodeBits(encodedBits string) (*BitString, error) {
-	decodedBits, err := base64.RawURLEncoding.DecodeString(encodedBits)
+	decodedBits, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(encodedBits, MultibaseBase64URLPrefix))
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +112,12 @@ func (b *BitString) Get(position int) (bool, error) {
 }
 
 // EncodeBits encode bits.
-func (b *BitString) EncodeBits() (string, error) {
+func (b *BitString) EncodeBits(opts ...Opt) (string, error) {
+	options := &encodeOpts{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
 	var buf bytes.Buffer
 
 	w := gzip.NewWriter(&buf)
@@ -99,5 +129,11 @@ func (b *BitString) EncodeBits() (string, error) {
 		return "", err
 	}
 
-	return base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+	encoded := base64.RawURLEncoding.EncodeToString(buf.Bytes())
+
+	if options.multibase {
+		return MultibaseBase64URLPrefix + encoded, nil
+	}
+
+	return encoded, nil
 }
@@ -66,4 +66,25 @@ func TestBitString(t *testing.T) {
 		require.NoError(t, err)
 		require.False(t, bitSet)
 	})
+
+	t.Run("test success multibase encoding", func(t *testing.T) {
+		bitString := NewBitString(17)
+
+		require.NoError(t, bitString.Set(3, true))
+
+		encodeBits, err := bitString.EncodeBits(WithMultibaseEncoding())
+		require.NoError(t, err)
+		require.True(t, IsMultibaseEncoded(encodeBits))
+
+		plainBits, err := bitString.EncodeBits()
+		require.NoError(t, err)
+		require.False(t, IsMultibaseEncoded(plainBits))
+
+		bitStr, err := DecodeBits(encodeBits)
+		require.NoError(t, err)
+
+		bitSet, err := bitStr.Get(3)
+		require.NoError(t, err)
+		require.True(t, bitSet)
+	})
 }
@@ -0,0 +1,139 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vc
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/trustbloc/vc-go/proof/checker"
+	"github.com/trustbloc/vc-go/verifiable"
+	vccwt "github.com/trustbloc/vc-go/verifiable/cwt"
+	"github.com/veraison/go-cose"
+
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
+	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
+)
+
+const (
+	// COSEMediaType is the "typ" header of VC Data Model v2.0 credentials secured with COSE.
+	COSEMediaType = "application/vc+cose"
+	// COSEContentType is the "cty" header of VC Data Model v2.0 credentials secured with COSE.
+	COSEContentType = "application/vc"
+	// COSEHeaderLabelType is the label of COSE "typ" header (RFC 9596).
+	COSEHeaderLabelType int64 = 16
+
+	coseDataURLPrefix = "data:" + COSEMediaType + ";base64,"
+)
+
+type coseProofChecker interface {
+	CheckCWTProof(
+		checkCWTRequest checker.CheckCWTProofRequest,
+		expectedProofIssuer string,
+		msg []byte,
+		signature []byte,
+	) error
+}
+
+// NewCOSEEnvelopedCredential returns EnvelopedVerifiableCredential with the COSE_Sign1 secured credential.
+// Spec: https://www.w3.org/TR/vc-jose-cose/#securing-with-cose
+func NewCOSEEnvelopedCredential(coseSign1 []byte) (*verifiable.Credential, error) {
+	return verifiable.CreateCredential(verifiable.CredentialContents{
+		Context: []string{vcutil.DefVCContextV2},
+		ID:      coseDataURLPrefix + base64.StdEncoding.EncodeToString(coseSign1),
+		Types:   []string{vcsverifiable.EnvelopedCredentialType},
+	}, nil)
+}
+
+// IsCOSEEnvelopedCredential checks whether the credential envelopes the COSE_Sign1 secured credential.
+func IsCOSEEnvelopedCredential(credential *verifiable.Credential) bool {
+	if credential == nil || credential.IsJWT() {
+		return false
+	}
+
+	vcc := credential.Contents()
+
+	return lo.Contains(vcc.Types, vcsverifiable.EnvelopedCredentialType) &&
+		strings.HasPrefix(vcc.ID, coseDataURLPrefix)
+}
+
+// ParseCOSECredential returns the credential secured with COSE which is enveloped by the given credential.
+// Signature of the COSE_Sign1 is checked by the proof checker, the check is skipped if proof checker is nil.
+func ParseCOSECredential(
+	envelope *verifiable.Credential,
+	proofChecker coseProofChecker,
+	opts ...verifiable.CredentialOpt,
+) (*verifiable.Credential, error) {
+	if !IsCOSEEnvelopedCredential(envelope) {
+		return nil, errors.New("credential is not a COSE enveloped credential")
+	}
+
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(envelope.Contents().ID, coseDataURLPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("decode COSE enveloped credential: %w", err)
+	}
+
+	msg := &cose.Sign1Message{}
+	if err = msg.UnmarshalCBOR(data); err != nil {
+		return nil, fmt.Errorf("unmarshal COSE_Sign1: %w", err)
+	}
+
+	if typ := msg.Headers.Protected[COSEHeaderLabelType]; typ != COSEMediaType {
+		return nil, fmt.Errorf("unsupported COSE typ header: %v", typ)
+	}
+
+	if cty := msg.Headers.Protected[cose.HeaderLabelContentType]; cty != COSEContentType {
+		return nil, fmt.Errorf("unsupported COSE cty header: %v", cty)
+	}
+
+	credential, err := ParseCredential(msg.Payload, append(opts, verifiable.WithDisabledProofCheck())...)
+	if err != nil {
+		return nil, fmt.Errorf("parse COSE secured credential: %w", err)
+	}
+
+	if credential.IsJWT() || len(credential.Proofs()) > 0 {
+		return nil, errors.New("COSE secured credential must not be secured with other mechanisms")
+	}
+
+	vcc := credential.Contents()
+	if vcc.Issuer == nil || vcc.Issuer.ID == "" {
+		return nil, errors.New("COSE secured credential requires issuer")
+	}
+
+	if proofChecker != nil {
+		if err = checkCOSEProof(msg, proofChecker, vcc.Issuer.ID); err != nil {
+			return nil, fmt.Errorf("check COSE proof: %w", err)
+		}
+	}
+
+	return credential, nil
+}
+
+func checkCOSEProof(msg *cose.Sign1Message, proofChecker coseProofChecker, issuer string) error {
+	alg, err := msg.Headers.Protected.Algorithm()
+	if err != nil {
+		return err
+	}
+
+	kid, ok := msg.Headers.Protected[cose.HeaderLabelKeyID].([]byte)
+	if !ok || len(kid) == 0 {
+		return errors.New("kid header is required")
+	}
+
+	sigStructure, err := vccwt.GetProofValue(msg)
+	if err != nil {
+		return err
+	}
+
+	return proofChecker.CheckCWTProof(checker.CheckCWTProofRequest{
+		KeyID: string(kid),
+		Algo:  alg,
+	}, issuer, sigStructure, msg.Signature)
+}
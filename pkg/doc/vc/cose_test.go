@@ -0,0 +1,231 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vc
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/vc-go/proof/checker"
+	"github.com/trustbloc/vc-go/verifiable"
+	"github.com/veraison/go-cose"
+
+	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
+	"github.com/trustbloc/vcs/pkg/internal/testutil"
+)
+
+const coseKeyID = "did:example:76e12ec712ebc6f1c221ebfeb1f#key1"
+
+type ed25519COSEChecker struct {
+	publicKey ed25519.PublicKey
+}
+
+func (c *ed25519COSEChecker) CheckCWTProof(
+	request checker.CheckCWTProofRequest,
+	expectedProofIssuer string,
+	msg []byte,
+	signature []byte,
+) error {
+	if request.KeyID != coseKeyID || request.Algo != cose.AlgorithmEd25519 ||
+		expectedProofIssuer != "did:example:76e12ec712ebc6f1c221ebfeb1f" {
+		return errors.New("unexpected request")
+	}
+
+	if !ed25519.Verify(c.publicKey, msg, signature) {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
+
+func newCOSEEnvelopedCredential(
+	t *testing.T,
+	privateKey ed25519.PrivateKey,
+	vc map[string]interface{},
+	modifyHeaders func(headers cose.ProtectedHeader),
+) *verifiable.Credential {
+	t.Helper()
+
+	payload, err := json.Marshal(vc)
+	require.NoError(t, err)
+
+	msg := &cose.Sign1Message{
+		Headers: cose.Headers{
+			Protected: cose.ProtectedHeader{
+				cose.HeaderLabelAlgorithm:   cose.AlgorithmEd25519,
+				cose.HeaderLabelKeyID:       []byte(coseKeyID),
+				cose.HeaderLabelContentType: COSEContentType,
+				COSEHeaderLabelType:         COSEMediaType,
+			},
+		},
+		Payload: payload,
+	}
+
+	if modifyHeaders != nil {
+		modifyHeaders(msg.Headers.Protected)
+	}
+
+	signer, err := cose.NewSigner(cose.AlgorithmEd25519, privateKey)
+	require.NoError(t, err)
+
+	require.NoError(t, msg.Sign(rand.Reader, nil, signer))
+
+	coseSign1, err := msg.MarshalCBOR()
+	require.NoError(t, err)
+
+	envelope, err := NewCOSEEnvelopedCredential(coseSign1)
+	require.NoError(t, err)
+
+	return envelope
+}
+
+func TestParseCOSECredential(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	proofChecker := &ed25519COSEChecker{publicKey: publicKey}
+
+	t.Run("success", func(t *testing.T) {
+		envelope := newCOSEEnvelopedCredential(t, privateKey, newDataModelV2Credential(nil), nil)
+		require.True(t, IsCOSEEnvelopedCredential(envelope))
+
+		// Envelope survives JSON round trip.
+		b, err := envelope.MarshalJSON()
+		require.NoError(t, err)
+
+		envelope, err = ParseCredential(b, verifiable.WithDisabledProofCheck())
+		require.NoError(t, err)
+
+		credential, err := ParseCOSECredential(envelope, proofChecker)
+		require.NoError(t, err)
+		require.Equal(t, "http://example.edu/credentials/1872", credential.Contents().ID)
+		require.Equal(t, "did:example:76e12ec712ebc6f1c221ebfeb1f", credential.Contents().Issuer.ID)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		envelope := newCOSEEnvelopedCredential(t, otherKey, newDataModelV2Credential(nil), nil)
+
+		_, err = ParseCOSECredential(envelope, proofChecker)
+		require.ErrorContains(t, err, "check COSE proof: invalid signature")
+
+		// Signature is not checked without proof checker.
+		_, err = ParseCOSECredential(envelope, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("not enveloped credential", func(t *testing.T) {
+		credential, err := verifiable.CreateCredential(verifiable.CredentialContents{
+			ID:    "data:application/vc+cose;base64,AAAA",
+			Types: []string{"VerifiableCredential"},
+		}, nil)
+		require.NoError(t, err)
+
+		require.False(t, IsCOSEEnvelopedCredential(credential))
+
+		_, err = ParseCOSECredential(credential, proofChecker)
+		require.EqualError(t, err, "credential is not a COSE enveloped credential")
+	})
+
+	t.Run("invalid COSE_Sign1", func(t *testing.T) {
+		envelope, err := NewCOSEEnvelopedCredential([]byte("invalid"))
+		require.NoError(t, err)
+
+		_, err = ParseCOSECredential(envelope, proofChecker)
+		require.ErrorContains(t, err, "unmarshal COSE_Sign1")
+	})
+
+	t.Run("invalid headers", func(t *testing.T) {
+		envelope := newCOSEEnvelopedCredential(t, privateKey, newDataModelV2Credential(nil),
+			func(headers cose.ProtectedHeader) {
+				headers[COSEHeaderLabelType] = "application/vp+cose"
+			})
+
+		_, err = ParseCOSECredential(envelope, proofChecker)
+		require.EqualError(t, err, "unsupported COSE typ header: application/vp+cose")
+
+		envelope = newCOSEEnvelopedCredential(t, privateKey, newDataModelV2Credential(nil),
+			func(headers cose.ProtectedHeader) {
+				delete(headers, cose.HeaderLabelContentType)
+			})
+
+		_, err = ParseCOSECredential(envelope, proofChecker)
+		require.EqualError(t, err, "unsupported COSE cty header: <nil>")
+
+		envelope = newCOSEEnvelopedCredential(t, privateKey, newDataModelV2Credential(nil),
+			func(headers cose.ProtectedHeader) {
+				delete(headers, cose.HeaderLabelKeyID)
+			})
+
+		_, err = ParseCOSECredential(envelope, proofChecker)
+		require.EqualError(t, err, "check COSE proof: kid header is required")
+	})
+
+	t.Run("missing issuer", func(t *testing.T) {
+		envelope := newCOSEEnvelopedCredential(t, privateKey,
+			newDataModelV2Credential(func(vc map[string]interface{}) {
+				delete(vc, "issuer")
+			}), nil)
+
+		_, err = ParseCOSECredential(envelope, proofChecker)
+		require.EqualError(t, err, "COSE secured credential requires issuer")
+	})
+}
+
+func TestValidateCredential_COSE(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	loader := testutil.DocumentLoader(t)
+
+	toMap := func(envelope *verifiable.Credential) map[string]interface{} {
+		b, err := envelope.MarshalJSON()
+		require.NoError(t, err)
+
+		var m map[string]interface{}
+		require.NoError(t, json.Unmarshal(b, &m))
+
+		return m
+	}
+
+	t.Run("success", func(t *testing.T) {
+		envelope := newCOSEEnvelopedCredential(t, privateKey, newDataModelV2Credential(nil), nil)
+
+		credential, err := ValidateCredential(context.Background(), toMap(envelope),
+			[]vcsverifiable.Format{vcsverifiable.Jwt}, true, true, loader, verifiable.WithDisabledProofCheck(),
+			verifiable.WithJSONLDDocumentLoader(loader))
+		require.NoError(t, err)
+		require.True(t, IsCOSEEnvelopedCredential(credential))
+	})
+
+	t.Run("enveloped credential is of jwt format", func(t *testing.T) {
+		envelope := newCOSEEnvelopedCredential(t, privateKey, newDataModelV2Credential(nil), nil)
+
+		_, err := ValidateCredential(context.Background(), toMap(envelope),
+			[]vcsverifiable.Format{vcsverifiable.Ldp}, true, true, loader, verifiable.WithDisabledProofCheck())
+		require.ErrorContains(t, err, "invalid format, should be jwt")
+	})
+
+	t.Run("expired secured credential", func(t *testing.T) {
+		envelope := newCOSEEnvelopedCredential(t, privateKey,
+			newDataModelV2Credential(func(vc map[string]interface{}) {
+				vc["validUntil"] = time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+			}), nil)
+
+		_, err := ValidateCredential(context.Background(), toMap(envelope),
+			[]vcsverifiable.Format{vcsverifiable.Jwt}, true, false, loader, verifiable.WithDisabledProofCheck())
+		require.ErrorContains(t, err, "expired")
+	})
+}
@@ -0,0 +1,84 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package crypto
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/trustbloc/vc-go/verifiable"
+	vccwt "github.com/trustbloc/vc-go/verifiable/cwt"
+	"github.com/veraison/go-cose"
+
+	"github.com/trustbloc/vcs/pkg/doc/vc"
+)
+
+// coseAlgorithms maps JWS algorithms of the signing keys to COSE algorithms supported by the proof checkers.
+var coseAlgorithms = map[verifiable.JWSAlgorithm]cose.Algorithm{
+	verifiable.EdDSA:          cose.AlgorithmEd25519,
+	verifiable.ECDSASecp256r1: cose.AlgorithmES256,
+	verifiable.ECDSASecp384r1: cose.AlgorithmES384,
+	verifiable.PS256:          cose.AlgorithmPS256,
+}
+
+// getVCCOSESignedCredential secures VC Data Model v2.0 credential with COSE: the whole credential is the
+// COSE_Sign1 payload. Secured credential is returned enveloped in EnvelopedVerifiableCredential.
+// Spec: https://www.w3.org/TR/vc-jose-cose/#securing-with-cose
+func (c *Crypto) getVCCOSESignedCredential(
+	credential *verifiable.Credential,
+	signer vc.SignerAlgorithm,
+	jwsAlgo verifiable.JWSAlgorithm,
+	signingKeyID string,
+	x5c []string) (*verifiable.Credential, error) {
+	if len(x5c) > 0 {
+		return nil, errors.New("X.509 certificate chain is not supported for vc+cose credential")
+	}
+
+	alg, ok := coseAlgorithms[jwsAlgo]
+	if !ok {
+		return nil, fmt.Errorf("unsupported vc+cose algorithm: %v", jwsAlgo)
+	}
+
+	vcc := credential.Contents()
+	if vcc.Issuer == nil || vcc.Issuer.ID == "" {
+		return nil, errors.New("vc+cose credential requires issuer")
+	}
+
+	payload, err := credential.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("marshal vc+cose credential: %w", err)
+	}
+
+	msg := &cose.Sign1Message{
+		Headers: cose.Headers{
+			Protected: cose.ProtectedHeader{
+				cose.HeaderLabelAlgorithm:   alg,
+				cose.HeaderLabelKeyID:       []byte(signingKeyID),
+				cose.HeaderLabelContentType: vc.COSEContentType,
+				vc.COSEHeaderLabelType:      vc.COSEMediaType,
+			},
+		},
+		Payload: payload,
+	}
+
+	sigStructure, err := vccwt.GetProofValue(msg)
+	if err != nil {
+		return nil, fmt.Errorf("get vc+cose signing input: %w", err)
+	}
+
+	msg.Signature, err = signer.Sign(sigStructure)
+	if err != nil {
+		return nil, fmt.Errorf("sign vc+cose credential: %w", err)
+	}
+
+	coseSign1, err := msg.MarshalCBOR()
+	if err != nil {
+		return nil, fmt.Errorf("marshal vc+cose credential: %w", err)
+	}
+
+	return vc.NewCOSEEnvelopedCredential(coseSign1)
+}
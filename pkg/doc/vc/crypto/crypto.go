@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package crypto
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/trustbloc/did-go/doc/did"
 	ldprocessor "github.com/trustbloc/did-go/doc/ld/processor"
 	vdrapi "github.com/trustbloc/did-go/vdr/api"
+	"github.com/trustbloc/kms-go/doc/jose"
 
 	"github.com/trustbloc/vc-go/jwt"
 	"github.com/trustbloc/vc-go/proof/creator"
@@ -34,6 +36,7 @@ import (
 
 	"github.com/trustbloc/vcs/pkg/doc/vc"
 	"github.com/trustbloc/vcs/pkg/doc/vc/jws"
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
 	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
 	"github.com/trustbloc/vcs/pkg/internal/common/diddoc"
 )
@@ -73,6 +76,16 @@ const (
 	CapabilityInvocation = "capabilityInvocation"
 )
 
+const (
+	// VCJWTMediaType is the "typ" header of VC Data Model v2.0 credentials secured with JOSE.
+	VCJWTMediaType = "vc+jwt"
+	// VCMediaType is the "cty" header of VC Data Model v2.0 credentials secured with JOSE.
+	VCMediaType = "vc"
+
+	jwtIssuerClaim = "iss"
+	jwtIDClaim     = "jti"
+)
+
 const (
 	// Purpose is the key of verifiable.Proof.
 	Purpose = "proofPurpose"
@@ -275,19 +288,22 @@ func (c *Crypto) signCredentialJWT(
 
 	method := signerData.Creator
 
-	didDoc, err := diddoc.GetDIDDocFromVerificationMethod(method, c.vdr)
-	if err != nil {
-		return nil, fmt.Errorf("unable to get did doc from verification method %w", err)
-	}
+	// Issuer with X.509 certificate chain is identified by the certificate, signing key is not a DID key.
+	if len(signerData.CertificateChain) == 0 {
+		didDoc, docErr := diddoc.GetDIDDocFromVerificationMethod(method, c.vdr)
+		if docErr != nil {
+			return nil, fmt.Errorf("unable to get did doc from verification method %w", docErr)
+		}
 
-	proofPurpose := Authentication
-	if signOpts.Purpose != "" {
-		proofPurpose = signOpts.Purpose
-	}
+		proofPurpose := Authentication
+		if signOpts.Purpose != "" {
+			proofPurpose = signOpts.Purpose
+		}
 
-	err = ValidateProofPurpose(proofPurpose, method, didDoc)
-	if err != nil {
-		return nil, fmt.Errorf("ValidateProofPurpose error: %w", err)
+		err = ValidateProofPurpose(proofPurpose, method, didDoc)
+		if err != nil {
+			return nil, fmt.Errorf("ValidateProofPurpose error: %w", err)
+		}
 	}
 
 	jwsAlgo, err := verifiable.KeyTypeToJWSAlgo(signerData.KeyType)
@@ -295,27 +311,104 @@ func (c *Crypto) signCredentialJWT(
 		return nil, fmt.Errorf("getting JWS algo based on signature type: %w", err)
 	}
 
+	isDataModelV2 := vcutil.IsDataModelV2(credential.Contents().Context)
+
 	if signerData.SDJWT.Enable {
+		if isDataModelV2 {
+			return nil, errors.New("SD-JWT is not supported for VC Data Model v2.0 credentials")
+		}
+
 		options := []verifiable.MakeSDJWTOption{
 			verifiable.MakeSDJWTWithHash(signerData.SDJWT.HashAlg),
 			verifiable.MakeSDJWTWithVersion(signerData.SDJWT.Version),
 			verifiable.MakeSDJWTWithNonSelectivelyDisclosableClaims([]string{"id", "type", "@type"}),
 		}
 
-		return c.getSDJWTSignedCredential(credential, s, jwsAlgo, method, options...)
+		return c.getSDJWTSignedCredential(credential, s, jwsAlgo, method, signerData.CertificateChain, options...)
+	}
+
+	if isDataModelV2 {
+		if signerData.SecuringMechanism == vcsverifiable.COSE {
+			return c.getVCCOSESignedCredential(credential, s, jwsAlgo, method, signerData.CertificateChain)
+		}
+
+		return c.getVCJWTSignedCredential(credential, s, jwsAlgo, method, signerData.CertificateChain)
+	}
+
+	return c.getJWTSignedCredential(credential, s, jwsAlgo, method, signerData.CertificateChain)
+}
+
+// getVCJWTSignedCredential secures VC Data Model v2.0 credential with JOSE: the whole credential is the JWT payload.
+// Spec: https://www.w3.org/TR/vc-jose-cose/#securing-with-jose
+func (c *Crypto) getVCJWTSignedCredential(
+	credential *verifiable.Credential,
+	signer vc.SignerAlgorithm,
+	jwsAlgo verifiable.JWSAlgorithm,
+	signingKeyID string,
+	x5c []string) (*verifiable.Credential, error) {
+	jwsAlgName, err := jwsAlgo.Name()
+	if err != nil {
+		return nil, fmt.Errorf("getting JWS algo name error: %w", err)
+	}
+
+	var proofCreator jwt.ProofCreator = newProofCreator(signer)
+	if len(x5c) > 0 {
+		proofCreator = &x5cProofCreator{ProofCreator: proofCreator, x5c: x5c}
 	}
 
-	return c.getJWTSignedCredential(credential, s, jwsAlgo, method)
+	vcc := credential.Contents()
+	if vcc.Issuer == nil || vcc.Issuer.ID == "" {
+		return nil, errors.New("vc+jwt credential requires issuer")
+	}
+
+	claims := credential.ToRawJSON()
+	// Registered JWT claims duplicate the credential properties, vc-go requires at least one of them on parsing.
+	claims[jwtIssuerClaim] = vcc.Issuer.ID
+
+	if vcc.ID != "" {
+		claims[jwtIDClaim] = vcc.ID
+	}
+
+	token, err := jwt.NewSigned(claims, jwt.SignParameters{
+		KeyID:  signingKeyID,
+		JWTAlg: jwsAlgName,
+		AdditionalHeaders: jose.Headers{
+			jose.HeaderType:        VCJWTMediaType,
+			jose.HeaderContentType: VCMediaType,
+		},
+	}, proofCreator)
+	if err != nil {
+		return nil, fmt.Errorf("sign vc+jwt credential: %w", err)
+	}
+
+	vcJWT, err := token.Serialize(false)
+	if err != nil {
+		return nil, fmt.Errorf("serialize vc+jwt credential: %w", err)
+	}
+
+	signedVC, err := verifiable.ParseCredential([]byte(vcJWT), verifiable.WithCredDisableValidation(),
+		verifiable.WithDisabledProofCheck())
+	if err != nil {
+		return nil, fmt.Errorf("reparse vc+jwt credential: %w", err)
+	}
+
+	return signedVC, nil
 }
 
 func (c *Crypto) getJWTSignedCredential(
 	credential *verifiable.Credential,
 	signer vc.SignerAlgorithm,
 	jwsAlgo verifiable.JWSAlgorithm,
-	signingKeyID string) (*verifiable.Credential, error) {
+	signingKeyID string,
+	x5c []string) (*verifiable.Credential, error) {
 	var err error
 
-	credential, err = credential.CreateSignedJWTVC(false, jwsAlgo, newProofCreator(signer), signingKeyID)
+	var proofCreator jwt.ProofCreator = newProofCreator(signer)
+	if len(x5c) > 0 {
+		proofCreator = &x5cProofCreator{ProofCreator: proofCreator, x5c: x5c}
+	}
+
+	credential, err = credential.CreateSignedJWTVC(false, jwsAlgo, proofCreator, signingKeyID)
 	if err != nil {
 		return nil, fmt.Errorf("MarshalJWS error: %w", err)
 	}
@@ -328,6 +421,7 @@ func (c *Crypto) getSDJWTSignedCredential(
 	signer vc.SignerAlgorithm,
 	jwsAlgo verifiable.JWSAlgorithm,
 	signingKeyID string,
+	x5c []string,
 	options ...verifiable.MakeSDJWTOption,
 ) (*verifiable.Credential, error) {
 	jwsAlgName, err := jwsAlgo.Name()
@@ -335,7 +429,10 @@ func (c *Crypto) getSDJWTSignedCredential(
 		return nil, fmt.Errorf("getting JWS algo name error: %w", err)
 	}
 
-	joseSigner := jws.NewSigner(signingKeyID, jwsAlgName, signer)
+	var joseSigner jose.Signer = jws.NewSigner(signingKeyID, jwsAlgName, signer)
+	if len(x5c) > 0 {
+		joseSigner = &x5cSigner{Signer: joseSigner, x5c: x5c}
+	}
 
 	//
 	sdjwt, err := credential.MakeSDJWT(joseSigner, signingKeyID, options...)
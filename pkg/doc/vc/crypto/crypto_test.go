@@ -10,6 +10,8 @@ import (
 	"crypto"
 	"crypto/ed25519"
 	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -24,6 +26,7 @@ import (
 	ariesmockstorage "github.com/trustbloc/did-go/legacy/mock/storage"
 	vdrapi "github.com/trustbloc/did-go/vdr/api"
 	vdrmock "github.com/trustbloc/did-go/vdr/mock"
+	"github.com/trustbloc/kms-go/doc/jose"
 	arieskms "github.com/trustbloc/kms-go/kms"
 	mockwrapper "github.com/trustbloc/kms-go/mock/wrapper"
 	"github.com/trustbloc/kms-go/secretlock/noop"
@@ -31,12 +34,15 @@ import (
 	"github.com/trustbloc/kms-go/wrapper/api"
 	"github.com/trustbloc/kms-go/wrapper/localsuite"
 	"github.com/trustbloc/vc-go/dataintegrity/suite/ecdsa2019"
+	"github.com/trustbloc/vc-go/proof/defaults"
 	"github.com/trustbloc/vc-go/sdjwt/common"
 	"github.com/trustbloc/vc-go/verifiable"
+	"github.com/trustbloc/vc-go/vermethod"
 
 	"github.com/trustbloc/vcs/internal/mock/vcskms"
 
 	"github.com/trustbloc/vcs/pkg/doc/vc"
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
 	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
 	"github.com/trustbloc/vcs/pkg/internal/testutil"
 )
@@ -568,6 +574,178 @@ func TestCrypto_SignCredentialJWT(t *testing.T) {
 	}
 }
 
+func TestCrypto_SignCredentialJWTWithX5C(t *testing.T) {
+	suite := createCryptoSuite(t)
+
+	customSigner, err := suite.KMSCryptoMultiSigner()
+	require.NoError(t, err)
+
+	keyCreator, err := suite.KeyCreator()
+	require.NoError(t, err)
+
+	pk, err := keyCreator.Create(kms.ED25519Type)
+	require.NoError(t, err)
+
+	x5c := []string{"bGVhZg==", "aW50ZXJtZWRpYXRl"}
+
+	unsignedVC := createVC(t, verifiable.CredentialContents{
+		ID:      "http://example.edu/credentials/1872",
+		Context: []string{verifiable.ContextURI},
+		Types:   []string{verifiable.VCType},
+		Subject: []verifiable.Subject{{ID: "did:example:ebfeb1f712ebc6f1c276e12ec21"}},
+		Issued:  &utiltime.TimeWrapper{Time: time.Now()},
+		Issuer:  &verifiable.Issuer{ID: "https://issuer.example.com"},
+	})
+
+	c := &Crypto{
+		// DID document is not resolved for X.509 certificate based issuer.
+		vdr:            &vdrmock.VDRegistry{ResolveErr: errors.New("not found")},
+		documentLoader: testutil.DocumentLoader(t),
+	}
+
+	t.Run("JWT", func(t *testing.T) {
+		signer := getJWTSigner(customSigner, pk.KeyID)
+		signer.CertificateChain = x5c
+
+		signed, err := c.signCredentialJWT(signer, unsignedVC)
+		require.NoError(t, err)
+		require.True(t, signed.IsJWT())
+
+		requireX5CHeader(t, signed, x5c)
+	})
+
+	t.Run("SD-JWT", func(t *testing.T) {
+		signer := getSDJWTSigner(customSigner, pk.KeyID)
+		signer.CertificateChain = x5c
+
+		signed, err := c.signCredentialJWT(signer, unsignedVC)
+		require.NoError(t, err)
+		require.True(t, signed.IsJWT())
+
+		requireX5CHeader(t, signed, x5c)
+	})
+}
+
+func TestCrypto_SignCredentialJWTDataModelV2(t *testing.T) {
+	suite := createCryptoSuite(t)
+
+	customSigner, err := suite.KMSCryptoMultiSigner()
+	require.NoError(t, err)
+
+	keyCreator, err := suite.KeyCreator()
+	require.NoError(t, err)
+
+	pk, err := keyCreator.Create(kms.ED25519Type)
+	require.NoError(t, err)
+
+	unsignedVC := createVCWithCF(t, verifiable.CredentialContents{
+		ID:      "http://example.edu/credentials/1872",
+		Context: []string{vcutil.DefVCContextV2},
+		Types:   []string{verifiable.VCType},
+		Subject: []verifiable.Subject{{ID: "did:example:ebfeb1f712ebc6f1c276e12ec21"}},
+		Issuer:  &verifiable.Issuer{ID: didID},
+	}, verifiable.CustomFields{
+		"validFrom": "2024-01-01T00:00:00Z",
+	})
+
+	vdr := &vdrmock.VDRegistry{ResolveValue: createDIDDoc(didID, func(vm *did.VerificationMethod) {
+		vm.ID = didID + "#" + pk.KeyID
+		vm.Value = pk.Key.(ed25519.PublicKey)
+	})}
+
+	c := New(vdr, testutil.DocumentLoader(t))
+
+	t.Run("vc+jwt", func(t *testing.T) {
+		signed, err := c.signCredentialJWT(getJWTSigner(customSigner, pk.KeyID), unsignedVC)
+		require.NoError(t, err)
+		require.True(t, signed.IsJWT())
+
+		require.Equal(t, VCJWTMediaType, signed.JWTHeaders()[jose.HeaderType])
+		require.Equal(t, VCMediaType, signed.JWTHeaders()[jose.HeaderContentType])
+
+		jwtVC, err := signed.ToJWTString()
+		require.NoError(t, err)
+
+		payload, err := base64.RawURLEncoding.DecodeString(strings.Split(jwtVC, ".")[1])
+		require.NoError(t, err)
+
+		var claims map[string]interface{}
+		require.NoError(t, json.Unmarshal(payload, &claims))
+
+		require.NotContains(t, claims, "vc")
+		require.Equal(t, didID, claims["iss"])
+		require.NotContains(t, claims, "issuanceDate")
+		require.Equal(t, "2024-01-01T00:00:00Z", claims["validFrom"])
+		require.Equal(t, []interface{}{vcutil.DefVCContextV2}, claims["@context"])
+
+		require.Equal(t, []string{vcutil.DefVCContextV2}, signed.Contents().Context)
+		require.Equal(t, "2024-01-01T00:00:00Z", signed.CustomField("validFrom"))
+	})
+
+	t.Run("vc+cose", func(t *testing.T) {
+		signer := getJWTSigner(customSigner, pk.KeyID)
+		signer.SecuringMechanism = vcsverifiable.COSE
+
+		signed, err := c.signCredentialJWT(signer, unsignedVC)
+		require.NoError(t, err)
+		require.False(t, signed.IsJWT())
+		require.True(t, vc.IsCOSEEnvelopedCredential(signed))
+		require.Equal(t, []string{vcsverifiable.EnvelopedCredentialType}, signed.Contents().Types)
+		require.True(t, strings.HasPrefix(signed.Contents().ID, "data:application/vc+cose;base64,"))
+
+		secured, err := vc.ParseCOSECredential(signed,
+			defaults.NewDefaultProofChecker(vermethod.NewVDRResolver(vdr)),
+			verifiable.WithJSONLDDocumentLoader(testutil.DocumentLoader(t)))
+		require.NoError(t, err)
+		require.Equal(t, unsignedVC.Contents().ID, secured.Contents().ID)
+		require.Equal(t, didID, secured.Contents().Issuer.ID)
+		require.Equal(t, "2024-01-01T00:00:00Z", secured.CustomField("validFrom"))
+
+		// Credential signed by another key is rejected.
+		otherPK, err := keyCreator.Create(kms.ED25519Type)
+		require.NoError(t, err)
+
+		otherSigner := getJWTSigner(customSigner, otherPK.KeyID)
+		otherSigner.Creator = didID + "#" + pk.KeyID
+		otherSigner.SecuringMechanism = vcsverifiable.COSE
+
+		forged, err := c.signCredentialJWT(otherSigner, unsignedVC)
+		require.NoError(t, err)
+
+		_, err = vc.ParseCOSECredential(forged, defaults.NewDefaultProofChecker(vermethod.NewVDRResolver(vdr)))
+		require.ErrorContains(t, err, "check COSE proof")
+	})
+
+	t.Run("vc+cose with certificate chain is not supported", func(t *testing.T) {
+		signer := getJWTSigner(customSigner, pk.KeyID)
+		signer.SecuringMechanism = vcsverifiable.COSE
+		signer.CertificateChain = []string{"cert"}
+
+		_, err := c.signCredentialJWT(signer, unsignedVC)
+		require.ErrorContains(t, err, "X.509 certificate chain is not supported for vc+cose credential")
+	})
+
+	t.Run("SD-JWT is not supported", func(t *testing.T) {
+		_, err := c.signCredentialJWT(getSDJWTSigner(customSigner, pk.KeyID), unsignedVC)
+		require.ErrorContains(t, err, "SD-JWT is not supported for VC Data Model v2.0 credentials")
+	})
+}
+
+func requireX5CHeader(t *testing.T, signed *verifiable.Credential, x5c []string) {
+	t.Helper()
+
+	x5cHeader, ok := signed.JWTHeaders()[jose.HeaderX509CertificateChain]
+	require.True(t, ok)
+
+	expected, err := json.Marshal(x5c)
+	require.NoError(t, err)
+
+	actual, err := json.Marshal(x5cHeader)
+	require.NoError(t, err)
+
+	require.JSONEq(t, string(expected), string(actual))
+}
+
 func TestCrypto_SignCredentialBBS(t *testing.T) {
 	t.Run("test success", func(t *testing.T) {
 		c := New(
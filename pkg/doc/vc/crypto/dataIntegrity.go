@@ -16,6 +16,9 @@ import (
 	"github.com/trustbloc/vc-go/verifiable"
 
 	"github.com/trustbloc/vcs/pkg/doc/vc"
+	"github.com/trustbloc/vcs/pkg/doc/vc/dataintegrity/bbsplus2023"
+	"github.com/trustbloc/vcs/pkg/doc/vc/dataintegrity/eddsa2022"
+	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
 )
 
 const (
@@ -40,12 +43,17 @@ func (c *Crypto) signCredentialLDPDataIntegrity(signerData *vc.Signer,
 		signatureType = signOpts.SignatureType
 	}
 
+	// bbsplus-2023 signs a set of messages, which requires BBS+ multi-message signer.
+	if signerData.DataIntegrityProof.SuiteType == bbsplus2023.SuiteType {
+		signatureType = vcsverifiable.BbsBlsSignature2020
+	}
+
 	ariesSigner, _, err := c.GetSigner(signerData.KMSKeyID, signerData.KMS, signatureType)
 	if err != nil {
 		return nil, err
 	}
 
-	signerSuite, err := c.getDataIntegritySignerInitializer(signerData.DataIntegrityProof.SuiteType, ariesSigner)
+	signerSuite, err := c.getDataIntegritySignerInitializer(&signerData.DataIntegrityProof, ariesSigner)
 	if err != nil {
 		return nil, fmt.Errorf("get data integrity signer initializer: %w", err)
 	}
@@ -80,14 +88,30 @@ func (c *Crypto) signCredentialLDPDataIntegrity(signerData *vc.Signer,
 }
 
 func (c *Crypto) getDataIntegritySignerInitializer(
-	dataIntegritySuiteType string, signer vc.SignerAlgorithm) (dataintegritysuite.SignerInitializer, error) {
-	switch dataIntegritySuiteType { //nolint: exhaustive
+	config *vc.DataIntegrityProofConfig, signer vc.SignerAlgorithm) (dataintegritysuite.SignerInitializer, error) {
+	switch config.SuiteType { //nolint: exhaustive
 	case ecdsa2019.SuiteType:
 		return ecdsa2019.NewSignerInitializer(&ecdsa2019.SignerInitializerOptions{
 			SignerGetter:     ecdsa2019.WithStaticSigner(signer),
 			LDDocumentLoader: c.documentLoader,
 		}), nil
+	case eddsa2022.SuiteType:
+		return eddsa2022.NewSignerInitializer(&eddsa2022.SignerInitializerOptions{
+			SignerGetter:     eddsa2022.WithStaticSigner(signer),
+			LDDocumentLoader: c.documentLoader,
+		}), nil
+	case bbsplus2023.SuiteType:
+		multiSigner, ok := signer.(bbsplus2023.Signer)
+		if !ok {
+			return nil, fmt.Errorf("data integrity suite \"%s\" requires BBS+ signer", config.SuiteType)
+		}
+
+		return bbsplus2023.NewSignerInitializer(&bbsplus2023.SignerInitializerOptions{
+			SignerGetter:      bbsplus2023.WithStaticSigner(multiSigner),
+			LDDocumentLoader:  c.documentLoader,
+			MandatoryPointers: config.MandatoryPointers,
+		}), nil
 	default:
-		return nil, fmt.Errorf("data integrity suite \"%s\" unsupported", dataIntegritySuiteType)
+		return nil, fmt.Errorf("data integrity suite \"%s\" unsupported", config.SuiteType)
 	}
 }
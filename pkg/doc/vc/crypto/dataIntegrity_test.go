@@ -13,9 +13,15 @@ import (
 	vdrmock "github.com/trustbloc/did-go/vdr/mock"
 	mockwrapper "github.com/trustbloc/kms-go/mock/wrapper"
 	kmsapi "github.com/trustbloc/kms-go/spi/kms"
+	"github.com/trustbloc/vc-go/dataintegrity"
+	"github.com/trustbloc/vc-go/dataintegrity/models"
+	dataintegritysuite "github.com/trustbloc/vc-go/dataintegrity/suite"
 	"github.com/trustbloc/vc-go/verifiable"
 	"github.com/trustbloc/vcs/internal/mock/vcskms"
 
+	"github.com/trustbloc/vcs/pkg/doc/vc"
+	"github.com/trustbloc/vcs/pkg/doc/vc/dataintegrity/bbsplus2023"
+	"github.com/trustbloc/vcs/pkg/doc/vc/dataintegrity/eddsa2022"
 	"github.com/trustbloc/vcs/pkg/internal/testutil"
 )
 
@@ -161,6 +167,123 @@ func TestCrypto_SignCredentialLDPDataIntegrity(t *testing.T) { //nolint:gocognit
 	})
 }
 
+func TestCrypto_SignCredentialLDPDataIntegrityCryptosuites(t *testing.T) {
+	suite := createCryptoSuite(t)
+
+	keyCreator, err := suite.KeyCreator()
+	require.NoError(t, err)
+
+	multiSigner, err := suite.KMSCryptoMultiSigner()
+	require.NoError(t, err)
+
+	loader := testutil.DocumentLoader(t)
+
+	tests := []struct {
+		name      string
+		suiteType string
+		keyType   kmsapi.KeyType
+		verifier  dataintegritysuite.VerifierInitializer
+	}{
+		{
+			name:      "eddsa-rdfc-2022",
+			suiteType: eddsa2022.SuiteType,
+			keyType:   kmsapi.ED25519Type,
+			verifier: eddsa2022.NewVerifierInitializer(&eddsa2022.VerifierInitializerOptions{
+				LDDocumentLoader: loader,
+			}),
+		},
+		{
+			name:      "bbsplus-2023",
+			suiteType: bbsplus2023.SuiteType,
+			keyType:   kmsapi.BLS12381G2Type,
+			verifier: bbsplus2023.NewVerifierInitializer(&bbsplus2023.VerifierInitializerOptions{
+				LDDocumentLoader: loader,
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const signingDID = "did:foo:bar"
+
+			key, err := keyCreator.Create(tt.keyType)
+			require.NoError(t, err)
+
+			verificationMethod, err := did.NewVerificationMethodFromJWK(signingDID+"#key1", "JsonWebKey2020",
+				signingDID, key)
+			require.NoError(t, err)
+
+			vdr := &vdrmock.VDRegistry{
+				ResolveFunc: func(didID string, opts ...vdrapi.DIDMethodOption) (*did.DocResolution, error) {
+					return makeMockDIDResolution(signingDID, verificationMethod, did.AssertionMethod), nil
+				}}
+
+			c := New(vdr, loader)
+
+			unsignedVc, err := verifiable.CreateCredential(verifiable.CredentialContents{
+				ID:      "http://example.edu/credentials/1872",
+				Context: []string{verifiable.ContextURI},
+				Types:   []string{verifiable.VCType},
+				Subject: []verifiable.Subject{{ID: "did:example:ebfeb1f712ebc6f1c276e12ec21"}},
+				Issued:  &utiltime.TimeWrapper{Time: time.Now()},
+				Issuer:  &verifiable.Issuer{ID: signingDID},
+			}, nil)
+			require.NoError(t, err)
+
+			signedVC, err := c.signCredentialLDPDataIntegrity(&vc.Signer{
+				DID:           signingDID,
+				Creator:       verificationMethod.ID,
+				KMSKeyID:      key.KeyID,
+				SignatureType: "JsonWebSignature2020",
+				KMS:           &vcskms.MockKMS{Signer: multiSigner},
+				DataIntegrityProof: vc.DataIntegrityProofConfig{
+					Enable:            true,
+					SuiteType:         tt.suiteType,
+					MandatoryPointers: []string{"/issuer"},
+				},
+			}, unsignedVc)
+			require.NoError(t, err)
+			require.Len(t, signedVC.Proofs(), 1)
+			require.Equal(t, tt.suiteType, signedVC.Proofs()[0]["cryptosuite"])
+
+			vcBytes, err := signedVC.MarshalJSON()
+			require.NoError(t, err)
+
+			diVerifier, err := dataintegrity.NewVerifier(&dataintegrity.Options{DIDResolver: vdr}, tt.verifier)
+			require.NoError(t, err)
+
+			require.NoError(t, diVerifier.VerifyProof(vcBytes, &models.ProofOptions{
+				Purpose:   AssertionMethod,
+				ProofType: models.DataIntegrityProof,
+			}))
+		})
+	}
+
+	t.Run("bbsplus-2023 requires BBS+ signer", func(t *testing.T) {
+		_, err := New(nil, loader).getDataIntegritySignerInitializer(&vc.DataIntegrityProofConfig{
+			SuiteType: bbsplus2023.SuiteType,
+		}, &singleMessageSigner{})
+		require.ErrorContains(t, err, "requires BBS+ signer")
+	})
+
+	t.Run("bbs-2023 unsupported", func(t *testing.T) {
+		_, err := New(nil, loader).getDataIntegritySignerInitializer(&vc.DataIntegrityProofConfig{
+			SuiteType: "bbs-2023",
+		}, &singleMessageSigner{})
+		require.ErrorContains(t, err, "data integrity suite \"bbs-2023\" unsupported")
+	})
+}
+
+type singleMessageSigner struct{}
+
+func (s *singleMessageSigner) Sign([]byte) ([]byte, error) {
+	return nil, nil
+}
+
+func (s *singleMessageSigner) Alg() string {
+	return ""
+}
+
 func makeMockDIDResolution(id string, vm *did.VerificationMethod, vr did.VerificationRelationship) *did.DocResolution {
 	ver := []did.Verification{{
 		VerificationMethod: *vm,
@@ -0,0 +1,51 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package crypto
+
+import (
+	"github.com/trustbloc/kms-go/doc/jose"
+	"github.com/trustbloc/vc-go/jwt"
+)
+
+// x5cProofCreator adds X.509 certificate chain to JWT headers.
+type x5cProofCreator struct {
+	jwt.ProofCreator
+
+	x5c []string
+}
+
+func (c *x5cProofCreator) CreateJWTHeaders(params jwt.SignParameters) (jose.Headers, error) {
+	headers, err := c.ProofCreator.CreateJWTHeaders(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return withX5C(headers, c.x5c), nil
+}
+
+// x5cSigner adds X.509 certificate chain to SD-JWT headers.
+type x5cSigner struct {
+	jose.Signer
+
+	x5c []string
+}
+
+func (s *x5cSigner) Headers() jose.Headers {
+	return withX5C(s.Signer.Headers(), s.x5c)
+}
+
+func withX5C(headers jose.Headers, x5c []string) jose.Headers {
+	res := make(jose.Headers, len(headers)+1)
+
+	for k, v := range headers {
+		res[k] = v
+	}
+
+	res[jose.HeaderX509CertificateChain] = x5c
+
+	return res
+}
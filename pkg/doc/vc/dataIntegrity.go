@@ -12,4 +12,7 @@ type DataIntegrityProofConfig struct {
 	Enable bool `json:"enable"`
 	// SuiteType is the data integrity Type identifier for the suite.
 	SuiteType string `json:"suiteType"`
+	// MandatoryPointers are JSON pointers to the claims, which holder must always disclose.
+	// Applicable to selective disclosure suites (bbsplus-2023) only.
+	MandatoryPointers []string `json:"mandatoryPointers,omitempty"`
 }
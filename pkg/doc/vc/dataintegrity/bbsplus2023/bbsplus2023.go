@@ -0,0 +1,488 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package bbsplus2023 implements the bbsplus-2023 data integrity cryptographic suite, which supports selective
+// disclosure of credential claims with BBS+ signatures over BLS12-381 (github.com/trustbloc/bbs-signature-go).
+//
+// Proof transformation, selection and the layout of proof values follow bbs-2023 (https://www.w3.org/TR/vc-di-bbs/),
+// and the bbs header is signed as the first message. The standard bbs-2023 suite requires IETF BBS signatures,
+// which the profile KMS does not provide, so proofs of this suite are not interoperable with bbs-2023 and
+// the suite is identified and advertised as "bbsplus-2023".
+package bbsplus2023
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/piprate/json-gold/ld"
+	"github.com/trustbloc/bbs-signature-go/bbs12381g2pub"
+	"github.com/trustbloc/did-go/doc/ld/processor"
+	"github.com/trustbloc/kms-go/doc/jose/jwk"
+	wrapperapi "github.com/trustbloc/kms-go/wrapper/api"
+	"github.com/trustbloc/vc-go/dataintegrity/models"
+	"github.com/trustbloc/vc-go/dataintegrity/suite"
+)
+
+const (
+	// SuiteType "bbsplus-2023" is the data integrity Type identifier for the suite
+	// implementing selective disclosure with BBS+ signatures. It is deliberately distinct from
+	// "bbs-2023" (https://www.w3.org/TR/vc-di-bbs/#bbs-2023), which requires IETF BBS signatures.
+	SuiteType = "bbsplus-2023"
+
+	ldCtxKey = "@context"
+
+	bls12381G2Crv     = "BLS12381_G2"
+	bls12381G2KeyType = "Bls12381G2Key2020"
+	hmacKeySize       = 32
+)
+
+// SignerGetter returns a Signer, which must sign with the private key matching
+// the public key provided in models.ProofOptions.VerificationMethod.
+type SignerGetter func(pub *jwk.JWK) (Signer, error)
+
+// WithStaticSigner sets the Suite to use a fixed Signer, with externally-chosen signing key.
+func WithStaticSigner(signer Signer) SignerGetter {
+	return func(*jwk.JWK) (Signer, error) {
+		return signer, nil
+	}
+}
+
+// WithKMSCryptoWrapper provides a SignerGetter using the kmscrypto wrapper.
+func WithKMSCryptoWrapper(kmsCrypto wrapperapi.KMSCryptoMultiSigner) SignerGetter {
+	return func(pub *jwk.JWK) (Signer, error) {
+		if pub == nil {
+			return nil, errors.New("verification method needs JWK")
+		}
+
+		return kmsCrypto.FixedKeyMultiSigner(pub)
+	}
+}
+
+// A Signer is able to sign a set of messages with a single BBS+ signature.
+type Signer interface {
+	// SignMulti will sign msgs using a private key internal to the Signer.
+	SignMulti(msgs [][]byte) ([]byte, error)
+}
+
+// Suite implements the bbsplus-2023 data integrity cryptographic suite.
+type Suite struct {
+	ldLoader          ld.DocumentLoader
+	signerGetter      SignerGetter
+	mandatoryPointers []string
+	bbs               *bbs12381g2pub.BBSG2Pub
+}
+
+// Options provides initialization options for Suite.
+type Options struct {
+	LDDocumentLoader ld.DocumentLoader
+	SignerGetter     SignerGetter
+	// MandatoryPointers are JSON pointers to the claims, which holder must always disclose.
+	MandatoryPointers []string
+}
+
+// SuiteInitializer is the initializer for Suite.
+type SuiteInitializer func() (suite.Suite, error)
+
+// New constructs an initializer for Suite.
+func New(options *Options) SuiteInitializer {
+	return func() (suite.Suite, error) {
+		return &Suite{
+			ldLoader:          options.LDDocumentLoader,
+			signerGetter:      options.SignerGetter,
+			mandatoryPointers: options.MandatoryPointers,
+			bbs:               bbs12381g2pub.New(),
+		}, nil
+	}
+}
+
+type initializer SuiteInitializer
+
+// Signer private, implements suite.SignerInitializer.
+func (i initializer) Signer() (suite.Signer, error) {
+	return i()
+}
+
+// Verifier private, implements suite.VerifierInitializer.
+func (i initializer) Verifier() (suite.Verifier, error) {
+	return i()
+}
+
+// Type private, implements suite.SignerInitializer and suite.VerifierInitializer.
+func (i initializer) Type() string {
+	return SuiteType
+}
+
+// SignerInitializerOptions provides options for a SignerInitializer.
+type SignerInitializerOptions struct {
+	LDDocumentLoader  ld.DocumentLoader
+	SignerGetter      SignerGetter
+	MandatoryPointers []string
+}
+
+// NewSignerInitializer returns a suite.SignerInitializer that initializes a bbsplus-2023
+// signing Suite with the given SignerInitializerOptions.
+func NewSignerInitializer(options *SignerInitializerOptions) suite.SignerInitializer {
+	return initializer(New(&Options{
+		LDDocumentLoader:  options.LDDocumentLoader,
+		SignerGetter:      options.SignerGetter,
+		MandatoryPointers: options.MandatoryPointers,
+	}))
+}
+
+// VerifierInitializerOptions provides options for a VerifierInitializer.
+type VerifierInitializerOptions struct {
+	LDDocumentLoader ld.DocumentLoader // required
+}
+
+// NewVerifierInitializer returns a suite.VerifierInitializer that initializes a
+// bbsplus-2023 verification Suite with the given VerifierInitializerOptions.
+func NewVerifierInitializer(options *VerifierInitializerOptions) suite.VerifierInitializer {
+	return initializer(New(&Options{
+		LDDocumentLoader: options.LDDocumentLoader,
+	}))
+}
+
+// CreateProof implements the bbsplus-2023 cryptographic suite for Add Base Proof:
+// https://www.w3.org/TR/vc-di-bbs/#create-base-proof-bbs-2023
+func (s *Suite) CreateProof(doc []byte, opts *models.ProofOptions) (*models.Proof, error) {
+	docData, pubKey, err := s.prepare(doc, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	hmacKey := make([]byte, hmacKeySize)
+	if _, err = rand.Read(hmacKey); err != nil {
+		return nil, fmt.Errorf("generate hmac key: %w", err)
+	}
+
+	t, err := transform(docData, hmacKey, s.mandatoryPointers, s.ldLoader)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := bbsHeader(docData, opts, t.mandatory, s.ldLoader)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := s.signerGetter(opts.VerificationMethod.JSONWebKey())
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := signer.SignMulti(messages(header, t.nonMandatory))
+	if err != nil {
+		return nil, err
+	}
+
+	proofValue, err := encodeProofValue(baseProofHeader, &baseProofValue{
+		Signature:         sig,
+		Header:            header,
+		PublicKey:         pubKey,
+		HMACKey:           hmacKey,
+		MandatoryPointers: s.mandatoryPointers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Proof{
+		Type:               models.DataIntegrityProof,
+		CryptoSuite:        SuiteType,
+		ProofPurpose:       opts.Purpose,
+		VerificationMethod: opts.VerificationMethod.ID,
+		ProofValue:         proofValue,
+		Created:            opts.Created.Format(models.DateTimeFormat),
+	}, nil
+}
+
+// VerifyProof implements the bbsplus-2023 cryptographic suite for Verify Derived Proof:
+// https://www.w3.org/TR/vc-di-bbs/#verify-derived-proof-bbs-2023
+//
+// Base proofs are verified as well, which allows the holder to check the credential before deriving
+// a proof from it.
+func (s *Suite) VerifyProof(doc []byte, proof *models.Proof, opts *models.ProofOptions) error {
+	docData, pubKey, err := s.prepare(doc, opts)
+	if err != nil {
+		return err
+	}
+
+	base, derived, err := decodeProofValue(proof.ProofValue)
+	if err != nil {
+		return err
+	}
+
+	if base != nil {
+		return s.verifyBaseProof(docData, base, pubKey, opts)
+	}
+
+	return s.verifyDerivedProof(docData, derived, pubKey, opts)
+}
+
+// RequiresCreated returns false, as the bbsplus-2023 cryptographic suite does not
+// require the use of the models.Proof.Created field.
+func (s *Suite) RequiresCreated() bool {
+	return false
+}
+
+func (s *Suite) verifyBaseProof(
+	docData map[string]interface{},
+	base *baseProofValue,
+	pubKey []byte,
+	opts *models.ProofOptions,
+) error {
+	t, err := transform(docData, base.HMACKey, base.MandatoryPointers, s.ldLoader)
+	if err != nil {
+		return err
+	}
+
+	header, err := bbsHeader(docData, opts, t.mandatory, s.ldLoader)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(header, base.Header) {
+		return errors.New("failed to verify bbsplus-2023 DI proof: proof header mismatch")
+	}
+
+	if err = s.bbs.Verify(messages(header, t.nonMandatory), base.Signature, pubKey); err != nil {
+		return fmt.Errorf("failed to verify bbsplus-2023 DI proof: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Suite) verifyDerivedProof(
+	docData map[string]interface{},
+	derived *derivedProofValue,
+	pubKey []byte,
+	opts *models.ProofOptions,
+) error {
+	nquads, err := toDeskolemizedNQuads(docData, s.ldLoader)
+	if err != nil {
+		return err
+	}
+
+	quads, err := canonicalize(nquads, func(_, canonical string) (string, error) {
+		label, ok := derived.LabelMap[canonical]
+		if !ok {
+			return "", fmt.Errorf("no label found for blank node %s", canonical)
+		}
+
+		return label, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	isMandatory := make(map[int]bool, len(derived.MandatoryIndexes))
+
+	for _, idx := range derived.MandatoryIndexes {
+		if idx < 0 || idx >= len(quads) {
+			return fmt.Errorf("mandatory index %d out of range", idx)
+		}
+
+		isMandatory[idx] = true
+	}
+
+	var mandatory, nonMandatory []string
+
+	for i, q := range quads {
+		if isMandatory[i] {
+			mandatory = append(mandatory, q)
+		} else {
+			nonMandatory = append(nonMandatory, q)
+		}
+	}
+
+	header, err := bbsHeader(docData, opts, mandatory, s.ldLoader)
+	if err != nil {
+		return err
+	}
+
+	if err = s.bbs.VerifyProof(messages(header, nonMandatory), derived.Proof, derived.Nonce, pubKey); err != nil {
+		return fmt.Errorf("failed to verify bbsplus-2023 DI proof: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Suite) prepare(doc []byte, opts *models.ProofOptions) (map[string]interface{}, []byte, error) {
+	docData := make(map[string]interface{})
+
+	if err := json.Unmarshal(doc, &docData); err != nil {
+		return nil, nil, fmt.Errorf("bbsplus-2023 suite expects JSON-LD payload: %w", err)
+	}
+
+	if opts.VerificationMethod == nil {
+		return nil, nil, errors.New("verification method is required")
+	}
+
+	pubKey, err := publicKeyBytes(opts.VerificationMethod)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts.ProofType != models.DataIntegrityProof || opts.SuiteType != SuiteType {
+		return nil, nil, suite.ErrProofTransformation
+	}
+
+	return docData, pubKey, nil
+}
+
+func publicKeyBytes(vm *models.VerificationMethod) ([]byte, error) {
+	if key := vm.JSONWebKey(); key != nil {
+		if key.Crv != bls12381G2Crv {
+			return nil, errors.New("unsupported BBS curve")
+		}
+
+		return key.PublicKeyBytes()
+	}
+
+	if vm.Type == bls12381G2KeyType && len(vm.Value) > 0 {
+		return vm.Value, nil
+	}
+
+	return nil, errors.New("verification method needs BLS12-381 G2 public key")
+}
+
+// transformed is the result of the base proof transformation.
+type transformed struct {
+	skolemized   map[string]interface{}
+	labels       map[string]string
+	mandatory    []string
+	nonMandatory []string
+}
+
+// transform canonicalizes the document, replacing blank node identifiers with HMAC based labels, and
+// groups the resulting N-Quads into mandatory and non-mandatory ones.
+func transform(
+	docData map[string]interface{},
+	hmacKey []byte,
+	mandatoryPointers []string,
+	loader ld.DocumentLoader,
+) (*transformed, error) {
+	skolemized := skolemize(docData)
+
+	nquads, err := toDeskolemizedNQuads(skolemized, loader)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make(map[string]string)
+
+	quads, err := canonicalize(nquads, func(input, canonical string) (string, error) {
+		mac := hmac.New(sha256.New, hmacKey)
+		mac.Write([]byte(canonical))
+
+		label := "u" + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+		labels[input] = label
+
+		return label, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mandatorySet, err := selectQuads(mandatoryPointers, skolemized, labels, loader)
+	if err != nil {
+		return nil, fmt.Errorf("select mandatory claims: %w", err)
+	}
+
+	t := &transformed{
+		skolemized: skolemized,
+		labels:     labels,
+	}
+
+	for _, q := range quads {
+		if mandatorySet[q] {
+			t.mandatory = append(t.mandatory, q)
+		} else {
+			t.nonMandatory = append(t.nonMandatory, q)
+		}
+	}
+
+	return t, nil
+}
+
+// selectQuads returns the set of labeled N-Quads of the skolemized document selected by the pointers.
+func selectQuads(
+	pointers []string,
+	skolemized map[string]interface{},
+	labels map[string]string,
+	loader ld.DocumentLoader,
+) (map[string]bool, error) {
+	selection, err := selectJSONLD(pointers, skolemized)
+	if err != nil || selection == nil {
+		return map[string]bool{}, err
+	}
+
+	nquads, err := toDeskolemizedNQuads(selection, loader)
+	if err != nil {
+		return nil, err
+	}
+
+	quads, err := relabel(nquads, labels)
+	if err != nil {
+		return nil, err
+	}
+
+	set := make(map[string]bool, len(quads))
+	for _, q := range quads {
+		set[q] = true
+	}
+
+	return set, nil
+}
+
+// bbsHeader returns concatenation of proof config hash and mandatory N-Quads hash.
+func bbsHeader(
+	docData map[string]interface{},
+	opts *models.ProofOptions,
+	mandatory []string,
+	loader ld.DocumentLoader,
+) ([]byte, error) {
+	conf := map[string]interface{}{
+		ldCtxKey:             docData[ldCtxKey],
+		"type":               models.DataIntegrityProof,
+		"cryptosuite":        SuiteType,
+		"verificationMethod": opts.VerificationMethodID,
+		"created":            opts.Created.Format(models.DateTimeFormat),
+		"proofPurpose":       opts.Purpose,
+	}
+
+	canonConf, err := processor.Default().GetCanonicalDocument(conf, processor.WithDocumentLoader(loader))
+	if err != nil {
+		return nil, fmt.Errorf("canonicalizing proof config: %w", err)
+	}
+
+	mandatoryHash := sha256.New()
+	for _, q := range mandatory {
+		mandatoryHash.Write([]byte(q))
+	}
+
+	proofHash := sha256.Sum256(canonConf)
+
+	return append(proofHash[:], mandatoryHash.Sum(nil)...), nil
+}
+
+// messages returns BBS messages: the bbs header followed by the non-mandatory N-Quads.
+func messages(header []byte, nonMandatory []string) [][]byte {
+	msgs := make([][]byte, 0, len(nonMandatory)+1)
+	msgs = append(msgs, header)
+
+	for _, q := range nonMandatory {
+		msgs = append(msgs, []byte(q))
+	}
+
+	return msgs
+}
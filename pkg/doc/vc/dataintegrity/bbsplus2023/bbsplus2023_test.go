@@ -0,0 +1,202 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bbsplus2023_test
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/bbs-signature-go/bbs12381g2pub"
+	"github.com/trustbloc/did-go/doc/did"
+	"github.com/trustbloc/kms-go/doc/jose/jwk/jwksupport"
+	"github.com/trustbloc/vc-go/dataintegrity/models"
+
+	"github.com/trustbloc/vcs/pkg/doc/vc/dataintegrity/bbsplus2023"
+	"github.com/trustbloc/vcs/pkg/internal/testutil"
+)
+
+const credential = `{
+  "@context": [
+    "https://www.w3.org/2018/credentials/v1",
+    "https://www.w3.org/2018/credentials/examples/v1",
+    "https://w3id.org/security/data-integrity/v1"
+  ],
+  "id": "http://example.edu/credentials/1872",
+  "type": ["VerifiableCredential", "UniversityDegreeCredential"],
+  "issuer": "did:example:76e12ec712ebc6f1c221ebfeb1f",
+  "issuanceDate": "2010-01-01T19:23:24Z",
+  "credentialSubject": {
+    "id": "did:example:ebfeb1f712ebc6f1c276e12ec21",
+    "degree": {
+      "type": "BachelorDegree",
+      "name": "Bachelor of Science and Arts"
+    },
+    "name": "Jayden Doe",
+    "spouse": "did:example:c276e12ec21ebfeb1f712ebc6f1"
+  }
+}`
+
+type staticSigner struct {
+	privKey *bbs12381g2pub.PrivateKey
+}
+
+func (s *staticSigner) SignMulti(msgs [][]byte) ([]byte, error) {
+	return bbs12381g2pub.New().SignWithKey(msgs, s.privKey)
+}
+
+func TestSuite(t *testing.T) {
+	loader := testutil.DocumentLoader(t)
+
+	pubKey, privKey, err := bbs12381g2pub.GenerateKeyPair(sha256.New, nil)
+	require.NoError(t, err)
+
+	pubJWK, err := jwksupport.JWKFromKey(pubKey)
+	require.NoError(t, err)
+
+	vm, err := did.NewVerificationMethodFromJWK("did:example:123#key-1", "JsonWebKey2020", "did:example:123",
+		pubJWK)
+	require.NoError(t, err)
+
+	signer, err := bbsplus2023.NewSignerInitializer(&bbsplus2023.SignerInitializerOptions{
+		LDDocumentLoader:  loader,
+		SignerGetter:      bbsplus2023.WithStaticSigner(&staticSigner{privKey: privKey}),
+		MandatoryPointers: []string{"/issuer"},
+	}).Signer()
+	require.NoError(t, err)
+
+	verifier, err := bbsplus2023.NewVerifierInitializer(&bbsplus2023.VerifierInitializerOptions{
+		LDDocumentLoader: loader,
+	}).Verifier()
+	require.NoError(t, err)
+
+	proofOpts := &models.ProofOptions{
+		VerificationMethod:   vm,
+		VerificationMethodID: vm.ID,
+		SuiteType:            bbsplus2023.SuiteType,
+		Purpose:              "assertionMethod",
+		ProofType:            models.DataIntegrityProof,
+		Created:              time.Now().UTC().Truncate(time.Second),
+	}
+
+	proof, err := signer.CreateProof([]byte(credential), proofOpts)
+	require.NoError(t, err)
+	require.Equal(t, bbsplus2023.SuiteType, proof.CryptoSuite)
+
+	securedDoc := withProof(t, credential, proof)
+
+	t.Run("verify base proof", func(t *testing.T) {
+		require.NoError(t, verifier.VerifyProof([]byte(credential), proof, proofOpts))
+	})
+
+	t.Run("verify base proof of tampered document", func(t *testing.T) {
+		var doc map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(credential), &doc))
+
+		doc["issuanceDate"] = "2011-01-01T19:23:24Z"
+
+		tampered, err := json.Marshal(doc)
+		require.NoError(t, err)
+
+		require.ErrorContains(t, verifier.VerifyProof(tampered, proof, proofOpts), "failed to verify bbsplus-2023 DI proof")
+	})
+
+	t.Run("derive and verify proof", func(t *testing.T) {
+		derivedDoc, err := bbsplus2023.DeriveProof(securedDoc, &bbsplus2023.DeriveOptions{
+			LDDocumentLoader:  loader,
+			SelectivePointers: []string{"/credentialSubject/degree/name", "/credentialSubject/id"},
+			Nonce:             []byte("nonce"),
+		})
+		require.NoError(t, err)
+
+		revealed, derivedProof := splitProof(t, derivedDoc)
+
+		subject, ok := revealed["credentialSubject"].(map[string]interface{})
+		require.True(t, ok)
+		require.NotContains(t, subject, "name")
+		require.NotContains(t, subject, "spouse")
+		require.Contains(t, subject, "degree")
+		require.Equal(t, "did:example:76e12ec712ebc6f1c221ebfeb1f", revealed["issuer"])
+		require.NotContains(t, revealed, "issuanceDate")
+
+		revealedBytes, err := json.Marshal(revealed)
+		require.NoError(t, err)
+
+		require.NoError(t, verifier.VerifyProof(revealedBytes, derivedProof, proofOpts))
+
+		t.Run("tampered", func(t *testing.T) {
+			subject["id"] = "did:example:other"
+
+			tampered, err := json.Marshal(revealed)
+			require.NoError(t, err)
+
+			require.Error(t, verifier.VerifyProof(tampered, derivedProof, proofOpts))
+		})
+	})
+
+	t.Run("derive with unknown pointer", func(t *testing.T) {
+		_, err := bbsplus2023.DeriveProof(securedDoc, &bbsplus2023.DeriveOptions{
+			LDDocumentLoader:  loader,
+			SelectivePointers: []string{"/credentialSubject/unknown"},
+		})
+		require.ErrorContains(t, err, "property unknown not found")
+	})
+
+	t.Run("derive without base proof", func(t *testing.T) {
+		_, err := bbsplus2023.DeriveProof([]byte(credential), &bbsplus2023.DeriveOptions{LDDocumentLoader: loader})
+		require.ErrorContains(t, err, "bbsplus-2023 proof not found")
+	})
+
+	t.Run("invalid proof value", func(t *testing.T) {
+		invalidProof := *proof
+		invalidProof.ProofValue = "z3yZe7d"
+
+		require.ErrorContains(t, verifier.VerifyProof([]byte(credential), &invalidProof, proofOpts),
+			"proof value must be base64url-no-pad encoded")
+	})
+
+	t.Run("unsupported suite type", func(t *testing.T) {
+		opts := *proofOpts
+		opts.SuiteType = "ecdsa-2019"
+
+		_, err := signer.CreateProof([]byte(credential), &opts)
+		require.Error(t, err)
+	})
+}
+
+func withProof(t *testing.T, doc string, proof *models.Proof) []byte {
+	t.Helper()
+
+	var docData map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(doc), &docData))
+
+	docData["proof"] = proof
+
+	data, err := json.Marshal(docData)
+	require.NoError(t, err)
+
+	return data
+}
+
+func splitProof(t *testing.T, doc []byte) (map[string]interface{}, *models.Proof) {
+	t.Helper()
+
+	var docData map[string]interface{}
+	require.NoError(t, json.Unmarshal(doc, &docData))
+
+	proofBytes, err := json.Marshal(docData["proof"])
+	require.NoError(t, err)
+
+	proof := &models.Proof{}
+	require.NoError(t, json.Unmarshal(proofBytes, proof))
+
+	delete(docData, "proof")
+
+	return docData, proof
+}
@@ -0,0 +1,187 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bbsplus2023
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/piprate/json-gold/ld"
+	"github.com/trustbloc/bbs-signature-go/bbs12381g2pub"
+)
+
+const (
+	proofKey      = "proof"
+	proofValueKey = "proofValue"
+	nonceSize     = 32
+)
+
+// DeriveOptions provides options for DeriveProof.
+type DeriveOptions struct {
+	LDDocumentLoader ld.DocumentLoader // required
+	// SelectivePointers are JSON pointers to the claims disclosed in addition to the mandatory ones.
+	SelectivePointers []string
+	// Nonce binds the derived proof to the presentation. Random nonce is used if empty.
+	Nonce []byte
+}
+
+// DeriveProof implements the bbsplus-2023 cryptographic suite for Add Derived Proof:
+// https://www.w3.org/TR/vc-di-bbs/#add-derived-proof-bbs-2023
+//
+// It takes the JSON-LD document secured with bbsplus-2023 base proof and returns the document, which
+// contains mandatory and selected claims only, secured with the derived proof.
+func DeriveProof(doc []byte, opts *DeriveOptions) ([]byte, error) {
+	docData := make(map[string]interface{})
+
+	if err := json.Unmarshal(doc, &docData); err != nil {
+		return nil, fmt.Errorf("bbsplus-2023 suite expects JSON-LD payload: %w", err)
+	}
+
+	proof, err := baseProof(docData[proofKey])
+	if err != nil {
+		return nil, err
+	}
+
+	delete(docData, proofKey)
+
+	proofValue, _ := proof[proofValueKey].(string) //nolint:errcheck // checked by decodeProofValue
+
+	base, _, err := decodeProofValue(proofValue)
+	if err != nil {
+		return nil, err
+	}
+
+	if base == nil {
+		return nil, errors.New("proof is not a bbsplus-2023 base proof")
+	}
+
+	t, err := transform(docData, base.HMACKey, base.MandatoryPointers, opts.LDDocumentLoader)
+	if err != nil {
+		return nil, err
+	}
+
+	combined, err := selectJSONLD(append(append([]string{}, base.MandatoryPointers...), opts.SelectivePointers...),
+		t.skolemized)
+	if err != nil {
+		return nil, fmt.Errorf("select claims: %w", err)
+	}
+
+	if combined == nil {
+		return nil, errors.New("no claims selected")
+	}
+
+	derived, err := deriveProofValue(base, t, combined, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedProofValue, err := encodeProofValue(derivedProofHeader, derived)
+	if err != nil {
+		return nil, err
+	}
+
+	proof[proofValueKey] = derivedProofValue
+
+	revealDoc := deskolemize(combined).(map[string]interface{}) //nolint:forcetypeassert
+	revealDoc[proofKey] = proof
+
+	return json.Marshal(revealDoc)
+}
+
+func deriveProofValue(
+	base *baseProofValue,
+	t *transformed,
+	combined map[string]interface{},
+	opts *DeriveOptions,
+) (*derivedProofValue, error) {
+	nquads, err := toDeskolemizedNQuads(combined, opts.LDDocumentLoader)
+	if err != nil {
+		return nil, err
+	}
+
+	labelMap := make(map[string]string)
+
+	revealQuads, err := canonicalize(nquads, func(input, canonical string) (string, error) {
+		label, ok := t.labels[input]
+		if !ok {
+			return "", fmt.Errorf("no label found for blank node %s", input)
+		}
+
+		labelMap[canonical] = label
+
+		return label, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	isMandatory := make(map[string]bool, len(t.mandatory))
+	for _, q := range t.mandatory {
+		isMandatory[q] = true
+	}
+
+	isRevealed := make(map[string]bool, len(revealQuads))
+
+	var mandatoryIndexes []int
+
+	for i, q := range revealQuads {
+		isRevealed[q] = true
+
+		if isMandatory[q] {
+			mandatoryIndexes = append(mandatoryIndexes, i)
+		}
+	}
+
+	// The bbs header is always disclosed.
+	revealedIndexes := []int{0}
+
+	for i, q := range t.nonMandatory {
+		if isRevealed[q] {
+			revealedIndexes = append(revealedIndexes, i+1)
+		}
+	}
+
+	nonce := opts.Nonce
+	if len(nonce) == 0 {
+		nonce = make([]byte, nonceSize)
+
+		if _, err = rand.Read(nonce); err != nil {
+			return nil, fmt.Errorf("generate nonce: %w", err)
+		}
+	}
+
+	bbsProof, err := bbs12381g2pub.New().DeriveProof(messages(base.Header, t.nonMandatory), base.Signature, nonce,
+		base.PublicKey, revealedIndexes)
+	if err != nil {
+		return nil, fmt.Errorf("derive bbs proof: %w", err)
+	}
+
+	return &derivedProofValue{
+		Proof:            bbsProof,
+		LabelMap:         labelMap,
+		MandatoryIndexes: mandatoryIndexes,
+		Nonce:            nonce,
+	}, nil
+}
+
+func baseProof(raw interface{}) (map[string]interface{}, error) {
+	proofs, ok := raw.([]interface{})
+	if !ok {
+		proofs = []interface{}{raw}
+	}
+
+	for _, p := range proofs {
+		proof, isMap := p.(map[string]interface{})
+		if isMap && proof["cryptosuite"] == SuiteType {
+			return proof, nil
+		}
+	}
+
+	return nil, errors.New("bbsplus-2023 proof not found")
+}
@@ -0,0 +1,80 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bbsplus2023
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/multiformats/go-multibase"
+)
+
+var (
+	baseProofHeader    = []byte{0xd9, 0x5d, 0x02}
+	derivedProofHeader = []byte{0xd9, 0x5d, 0x03}
+)
+
+// baseProofValue is the content of the proof created by the issuer.
+type baseProofValue struct {
+	_                 struct{} `cbor:",toarray"`
+	Signature         []byte
+	Header            []byte
+	PublicKey         []byte
+	HMACKey           []byte
+	MandatoryPointers []string
+}
+
+// derivedProofValue is the content of the proof derived by the holder.
+type derivedProofValue struct {
+	_                struct{} `cbor:",toarray"`
+	Proof            []byte
+	LabelMap         map[string]string
+	MandatoryIndexes []int
+	Nonce            []byte
+}
+
+func encodeProofValue(header []byte, v interface{}) (string, error) {
+	data, err := cbor.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("encode proof value: %w", err)
+	}
+
+	return multibase.Encode(multibase.Base64url, append(append([]byte{}, header...), data...))
+}
+
+// decodeProofValue decodes proofValue into either *baseProofValue or *derivedProofValue.
+func decodeProofValue(proofValue string) (*baseProofValue, *derivedProofValue, error) {
+	encoding, data, err := multibase.Decode(proofValue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decode proof value: %w", err)
+	}
+
+	if encoding != multibase.Base64url {
+		return nil, nil, errors.New("proof value must be base64url-no-pad encoded")
+	}
+
+	switch {
+	case bytes.HasPrefix(data, baseProofHeader):
+		base := &baseProofValue{}
+		if err = cbor.Unmarshal(data[len(baseProofHeader):], base); err != nil {
+			return nil, nil, fmt.Errorf("decode base proof value: %w", err)
+		}
+
+		return base, nil, nil
+	case bytes.HasPrefix(data, derivedProofHeader):
+		derived := &derivedProofValue{}
+		if err = cbor.Unmarshal(data[len(derivedProofHeader):], derived); err != nil {
+			return nil, nil, fmt.Errorf("decode derived proof value: %w", err)
+		}
+
+		return nil, derived, nil
+	default:
+		return nil, nil, errors.New("unknown bbsplus-2023 proof value header")
+	}
+}
@@ -0,0 +1,460 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bbsplus2023
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/piprate/json-gold/ld"
+)
+
+const (
+	skolemPrefix     = "urn:bnid:"
+	blankNodePrefix  = "_:"
+	skolemLabelStart = "sk"
+	nquadsFormat     = "application/n-quads"
+	canonicalAlgo    = "URDNA2015"
+	defaultGraphName = "@default"
+)
+
+var skolemIRIRegexp = regexp.MustCompile(`<` + skolemPrefix + `([^>]+)>`)
+
+// skolemize returns a deep copy of compact JSON-LD document in which every node object without
+// an identifier gets a "urn:bnid:" IRI, so that its blank node label survives document selection.
+func skolemize(doc map[string]interface{}) map[string]interface{} {
+	counter := 0
+
+	return skolemizeValue(doc, &counter).(map[string]interface{}) //nolint:forcetypeassert
+}
+
+func skolemizeValue(v interface{}, counter *int) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t)+1)
+
+		for k, val := range t {
+			if k == ldCtxKey {
+				out[k] = val
+
+				continue
+			}
+
+			out[k] = skolemizeValue(val, counter)
+		}
+
+		if isNodeObject(t) && !hasID(t) {
+			out["@id"] = skolemPrefix + skolemLabelStart + strconv.Itoa(*counter)
+			*counter++
+		}
+
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+
+		for i := range t {
+			out[i] = skolemizeValue(t[i], counter)
+		}
+
+		return out
+	default:
+		return v
+	}
+}
+
+// deskolemize removes identifiers added by skolemize from the compact JSON-LD document.
+func deskolemize(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+
+		for k, val := range t {
+			if k == "@id" {
+				if s, ok := val.(string); ok && strings.HasPrefix(s, skolemPrefix) {
+					continue
+				}
+			}
+
+			if k == ldCtxKey {
+				out[k] = val
+
+				continue
+			}
+
+			out[k] = deskolemize(val)
+		}
+
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+
+		for i := range t {
+			out[i] = deskolemize(t[i])
+		}
+
+		return out
+	default:
+		return v
+	}
+}
+
+func isNodeObject(m map[string]interface{}) bool {
+	for _, k := range []string{"@value", "@list", "@set"} {
+		if _, ok := m[k]; ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasID(m map[string]interface{}) bool {
+	_, hasAlias := m["id"]
+	_, hasKeyword := m["@id"]
+
+	return hasAlias || hasKeyword
+}
+
+// toDeskolemizedNQuads converts compact JSON-LD document to N-Quads, turning skolem IRIs back to blank nodes.
+func toDeskolemizedNQuads(doc map[string]interface{}, loader ld.DocumentLoader) (string, error) {
+	opts := ld.NewJsonLdOptions("")
+	opts.ProcessingMode = ld.JsonLd_1_1
+	opts.Format = nquadsFormat
+	opts.DocumentLoader = loader
+
+	out, err := ld.NewJsonLdProcessor().ToRDF(doc, opts)
+	if err != nil {
+		return "", fmt.Errorf("convert JSON-LD to RDF: %w", err)
+	}
+
+	nquads, ok := out.(string)
+	if !ok {
+		return "", errors.New("unexpected RDF conversion result")
+	}
+
+	return skolemIRIRegexp.ReplaceAllString(nquads, blankNodePrefix+"$1"), nil
+}
+
+// labelFunc maps input blank node label and its canonical label to the label used in the output.
+type labelFunc func(input, canonical string) (string, error)
+
+// canonicalize runs URDNA2015 over the N-Quads and relabels canonical blank node identifiers with labelFn.
+// Returned N-Quads are sorted.
+func canonicalize(nquads string, labelFn labelFunc) ([]string, error) {
+	dataset, err := parseNQuads(nquads)
+	if err != nil {
+		return nil, err
+	}
+
+	quads := dataset.Graphs[defaultGraphName]
+	inputLabels := blankNodes(quads)
+
+	ld.NewNormalisationAlgorithm(canonicalAlgo).Normalize(dataset)
+
+	for node, input := range inputLabels {
+		label, labelErr := labelFn(
+			strings.TrimPrefix(input, blankNodePrefix),
+			strings.TrimPrefix(node.Attribute, blankNodePrefix),
+		)
+		if labelErr != nil {
+			return nil, labelErr
+		}
+
+		node.Attribute = blankNodePrefix + label
+	}
+
+	return serialize(quads)
+}
+
+// relabel replaces blank node labels of the N-Quads using the given map. Returned N-Quads are sorted.
+func relabel(nquads string, labels map[string]string) ([]string, error) {
+	dataset, err := parseNQuads(nquads)
+	if err != nil {
+		return nil, err
+	}
+
+	quads := dataset.Graphs[defaultGraphName]
+
+	for node, input := range blankNodes(quads) {
+		label, ok := labels[strings.TrimPrefix(input, blankNodePrefix)]
+		if !ok {
+			return nil, fmt.Errorf("no label found for blank node %s", input)
+		}
+
+		node.Attribute = blankNodePrefix + label
+	}
+
+	return serialize(quads)
+}
+
+func parseNQuads(nquads string) (*ld.RDFDataset, error) {
+	dataset, err := ld.ParseNQuads(nquads)
+	if err != nil {
+		return nil, fmt.Errorf("parse n-quads: %w", err)
+	}
+
+	for name := range dataset.Graphs {
+		if name != defaultGraphName {
+			return nil, fmt.Errorf("named graphs are not supported: %s", name)
+		}
+	}
+
+	return dataset, nil
+}
+
+func blankNodes(quads []*ld.Quad) map[*ld.BlankNode]string {
+	nodes := make(map[*ld.BlankNode]string)
+
+	for _, q := range quads {
+		for _, n := range []ld.Node{q.Subject, q.Object} {
+			if bn, ok := n.(*ld.BlankNode); ok {
+				nodes[bn] = bn.Attribute
+			}
+		}
+	}
+
+	return nodes
+}
+
+func serialize(quads []*ld.Quad) ([]string, error) {
+	dataset := ld.NewRDFDataset()
+	dataset.Graphs[defaultGraphName] = quads
+
+	out, err := (&ld.NQuadRDFSerializer{}).Serialize(dataset)
+	if err != nil {
+		return nil, fmt.Errorf("serialize n-quads: %w", err)
+	}
+
+	nquads, ok := out.(string)
+	if !ok {
+		return nil, errors.New("unexpected n-quads serialization result")
+	}
+
+	lines := strings.SplitAfter(nquads, "\n")
+	sort.Strings(lines)
+
+	result := make([]string, 0, len(lines))
+
+	for _, line := range lines {
+		if line == "" || (len(result) > 0 && result[len(result)-1] == line) {
+			continue
+		}
+
+		result = append(result, line)
+	}
+
+	return result, nil
+}
+
+type sparseArray map[int]interface{}
+
+// selectJSONLD selects the parts of compact JSON-LD document addressed by JSON pointers (RFC 6901).
+// Identifiers and types of the nodes on the selected paths are kept, so that the selection stays
+// connected to the rest of the document. Returns nil if no pointers are given.
+func selectJSONLD(pointers []string, doc map[string]interface{}) (map[string]interface{}, error) {
+	if len(pointers) == 0 {
+		return nil, nil
+	}
+
+	selection := initialSelection(doc)
+	selection[ldCtxKey] = doc[ldCtxKey]
+
+	for _, pointer := range pointers {
+		path, err := parsePointer(pointer)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = selectPath(path, doc, selection); err != nil {
+			return nil, fmt.Errorf("select %s: %w", pointer, err)
+		}
+	}
+
+	return compactSelection(selection).(map[string]interface{}), nil //nolint:forcetypeassert
+}
+
+func parsePointer(pointer string) ([]string, error) {
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer: %s", pointer)
+	}
+
+	path := strings.Split(pointer[1:], "/")
+
+	for i := range path {
+		path[i] = strings.ReplaceAll(strings.ReplaceAll(path[i], "~1", "/"), "~0", "~")
+	}
+
+	return path, nil
+}
+
+func selectPath(path []string, doc map[string]interface{}, selection map[string]interface{}) error {
+	var (
+		value    interface{} = doc
+		selected interface{} = selection
+	)
+
+	for i, token := range path {
+		next, err := child(value, token)
+		if err != nil {
+			return err
+		}
+
+		if i == len(path)-1 {
+			if existing, ok := getSelected(selected, token).(map[string]interface{}); ok {
+				if nextMap, isMap := next.(map[string]interface{}); isMap {
+					for k, v := range nextMap {
+						existing[k] = deepCopy(v)
+					}
+
+					return nil
+				}
+			}
+
+			return setSelected(selected, token, deepCopy(next))
+		}
+
+		nextSelected := getSelected(selected, token)
+		if nextSelected == nil {
+			switch t := next.(type) {
+			case []interface{}:
+				nextSelected = sparseArray{}
+			case map[string]interface{}:
+				nextSelected = initialSelection(t)
+			default:
+				return fmt.Errorf("path element %s is not an object or array", token)
+			}
+
+			if err = setSelected(selected, token, nextSelected); err != nil {
+				return err
+			}
+		}
+
+		value, selected = next, nextSelected
+	}
+
+	return nil
+}
+
+func child(value interface{}, token string) (interface{}, error) {
+	switch t := value.(type) {
+	case map[string]interface{}:
+		v, ok := t[token]
+		if !ok {
+			return nil, fmt.Errorf("property %s not found", token)
+		}
+
+		return v, nil
+	case []interface{}:
+		idx, err := strconv.Atoi(token)
+		if err != nil || idx < 0 || idx >= len(t) {
+			return nil, fmt.Errorf("invalid array index %s", token)
+		}
+
+		return t[idx], nil
+	default:
+		return nil, fmt.Errorf("path element %s not found", token)
+	}
+}
+
+func getSelected(selected interface{}, token string) interface{} {
+	switch t := selected.(type) {
+	case map[string]interface{}:
+		return t[token]
+	case sparseArray:
+		idx, _ := strconv.Atoi(token) //nolint:errcheck // index was validated against the source document
+
+		return t[idx]
+	default:
+		return nil
+	}
+}
+
+func setSelected(selected interface{}, token string, v interface{}) error {
+	switch t := selected.(type) {
+	case map[string]interface{}:
+		t[token] = v
+	case sparseArray:
+		idx, _ := strconv.Atoi(token) //nolint:errcheck // index was validated against the source document
+		t[idx] = v
+	default:
+		return fmt.Errorf("path element %s is not an object or array", token)
+	}
+
+	return nil
+}
+
+func initialSelection(node map[string]interface{}) map[string]interface{} {
+	selection := map[string]interface{}{}
+
+	for _, k := range []string{"id", "@id"} {
+		if id, ok := node[k].(string); ok && !strings.HasPrefix(id, blankNodePrefix) {
+			selection[k] = id
+		}
+	}
+
+	for _, k := range []string{"type", "@type"} {
+		if t, ok := node[k]; ok {
+			selection[k] = deepCopy(t)
+		}
+	}
+
+	return selection
+}
+
+func compactSelection(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			if k != ldCtxKey {
+				t[k] = compactSelection(val)
+			}
+		}
+
+		return t
+	case sparseArray:
+		indexes := make([]int, 0, len(t))
+		for idx := range t {
+			indexes = append(indexes, idx)
+		}
+
+		sort.Ints(indexes)
+
+		out := make([]interface{}, 0, len(indexes))
+		for _, idx := range indexes {
+			out = append(out, compactSelection(t[idx]))
+		}
+
+		return out
+	default:
+		return v
+	}
+}
+
+func deepCopy(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			out[k] = deepCopy(val)
+		}
+
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i := range t {
+			out[i] = deepCopy(t[i])
+		}
+
+		return out
+	default:
+		return v
+	}
+}
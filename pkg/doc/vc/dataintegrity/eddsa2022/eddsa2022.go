@@ -0,0 +1,281 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package eddsa2022
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/multiformats/go-multibase"
+	"github.com/piprate/json-gold/ld"
+	"github.com/trustbloc/did-go/doc/ld/processor"
+	"github.com/trustbloc/kms-go/doc/jose/jwk"
+	"github.com/trustbloc/kms-go/spi/kms"
+	wrapperapi "github.com/trustbloc/kms-go/wrapper/api"
+	"github.com/trustbloc/vc-go/crypto-ext/pubkey"
+	"github.com/trustbloc/vc-go/crypto-ext/verifiers/ed25519"
+	"github.com/trustbloc/vc-go/dataintegrity/models"
+	"github.com/trustbloc/vc-go/dataintegrity/suite"
+)
+
+const (
+	// SuiteType "eddsa-rdfc-2022" is the data integrity Type identifier for the suite
+	// implementing EdDSA signatures with RDF canonicalization as per this
+	// spec: https://www.w3.org/TR/vc-di-eddsa/#eddsa-rdfc-2022
+	SuiteType = "eddsa-rdfc-2022"
+
+	ldCtxKey = "@context"
+)
+
+// SignerGetter returns a Signer, which must sign with the private key matching
+// the public key provided in models.ProofOptions.VerificationMethod.
+type SignerGetter func(pub *jwk.JWK) (Signer, error)
+
+// WithStaticSigner sets the Suite to use a fixed Signer, with externally-chosen signing key.
+func WithStaticSigner(signer Signer) SignerGetter {
+	return func(*jwk.JWK) (Signer, error) {
+		return signer, nil
+	}
+}
+
+// WithKMSCryptoWrapper provides a SignerGetter using the kmscrypto wrapper.
+func WithKMSCryptoWrapper(kmsCrypto wrapperapi.KMSCryptoSigner) SignerGetter {
+	return func(pub *jwk.JWK) (Signer, error) {
+		return kmsCrypto.FixedKeySigner(pub)
+	}
+}
+
+// A Signer is able to sign messages.
+type Signer interface {
+	// Sign will sign msg using a private key internal to the Signer.
+	Sign(msg []byte) ([]byte, error)
+}
+
+// A Verifier is able to verify messages.
+type Verifier interface {
+	// Verify will verify a signature for the given msg using the public key.
+	Verify(signature, msg []byte, pubKey *pubkey.PublicKey) error
+}
+
+// Suite implements the eddsa-rdfc-2022 data integrity cryptographic suite.
+type Suite struct {
+	ldLoader     ld.DocumentLoader
+	verifier     Verifier
+	signerGetter SignerGetter
+}
+
+// Options provides initialization options for Suite.
+type Options struct {
+	LDDocumentLoader ld.DocumentLoader
+	Verifier         Verifier
+	SignerGetter     SignerGetter
+}
+
+// SuiteInitializer is the initializer for Suite.
+type SuiteInitializer func() (suite.Suite, error)
+
+// New constructs an initializer for Suite.
+func New(options *Options) SuiteInitializer {
+	return func() (suite.Suite, error) {
+		return &Suite{
+			ldLoader:     options.LDDocumentLoader,
+			verifier:     options.Verifier,
+			signerGetter: options.SignerGetter,
+		}, nil
+	}
+}
+
+type initializer SuiteInitializer
+
+// Signer private, implements suite.SignerInitializer.
+func (i initializer) Signer() (suite.Signer, error) {
+	return i()
+}
+
+// Verifier private, implements suite.VerifierInitializer.
+func (i initializer) Verifier() (suite.Verifier, error) {
+	return i()
+}
+
+// Type private, implements suite.SignerInitializer and suite.VerifierInitializer.
+func (i initializer) Type() string {
+	return SuiteType
+}
+
+// SignerInitializerOptions provides options for a SignerInitializer.
+type SignerInitializerOptions struct {
+	LDDocumentLoader ld.DocumentLoader
+	SignerGetter     SignerGetter
+}
+
+// NewSignerInitializer returns a suite.SignerInitializer that initializes an eddsa-rdfc-2022
+// signing Suite with the given SignerInitializerOptions.
+func NewSignerInitializer(options *SignerInitializerOptions) suite.SignerInitializer {
+	return initializer(New(&Options{
+		LDDocumentLoader: options.LDDocumentLoader,
+		SignerGetter:     options.SignerGetter,
+	}))
+}
+
+// VerifierInitializerOptions provides options for a VerifierInitializer.
+type VerifierInitializerOptions struct {
+	LDDocumentLoader ld.DocumentLoader // required
+	Verifier         Verifier          // optional
+}
+
+// NewVerifierInitializer returns a suite.VerifierInitializer that initializes an
+// eddsa-rdfc-2022 verification Suite with the given VerifierInitializerOptions.
+func NewVerifierInitializer(options *VerifierInitializerOptions) suite.VerifierInitializer {
+	verifier := options.Verifier
+	if verifier == nil {
+		verifier = ed25519.New()
+	}
+
+	return initializer(New(&Options{
+		LDDocumentLoader: options.LDDocumentLoader,
+		Verifier:         verifier,
+	}))
+}
+
+// CreateProof implements the eddsa-rdfc-2022 cryptographic suite for Add Proof:
+// https://www.w3.org/TR/vc-di-eddsa/#create-proof-eddsa-rdfc-2022
+func (s *Suite) CreateProof(doc []byte, opts *models.ProofOptions) (*models.Proof, error) {
+	hashData, vmKey, err := s.transformAndHash(doc, opts, opts.Domain, opts.Challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := s.signerGetter(vmKey.JWK)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := signer.Sign(hashData)
+	if err != nil {
+		return nil, err
+	}
+
+	sigStr, err := multibase.Encode(multibase.Base58BTC, sig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Proof{
+		Type:               models.DataIntegrityProof,
+		CryptoSuite:        SuiteType,
+		ProofPurpose:       opts.Purpose,
+		Domain:             opts.Domain,
+		Challenge:          opts.Challenge,
+		VerificationMethod: opts.VerificationMethod.ID,
+		ProofValue:         sigStr,
+		Created:            opts.Created.Format(models.DateTimeFormat),
+	}, nil
+}
+
+// VerifyProof implements the eddsa-rdfc-2022 cryptographic suite for Verify Proof:
+// https://www.w3.org/TR/vc-di-eddsa/#verify-proof-eddsa-rdfc-2022
+func (s *Suite) VerifyProof(doc []byte, proof *models.Proof, opts *models.ProofOptions) error {
+	// Proof config is signed with domain and challenge of the proof, verifier options contain expected values.
+	hashData, vmKey, err := s.transformAndHash(doc, opts, proof.Domain, proof.Challenge)
+	if err != nil {
+		return err
+	}
+
+	_, signature, err := multibase.Decode(proof.ProofValue)
+	if err != nil {
+		return fmt.Errorf("decoding proofValue: %w", err)
+	}
+
+	if err = s.verifier.Verify(signature, hashData, vmKey); err != nil {
+		return fmt.Errorf("failed to verify eddsa-rdfc-2022 DI proof: %w", err)
+	}
+
+	return nil
+}
+
+// RequiresCreated returns false, as the eddsa-rdfc-2022 cryptographic suite does not
+// require the use of the models.Proof.Created field.
+func (s *Suite) RequiresCreated() bool {
+	return false
+}
+
+func (s *Suite) transformAndHash(
+	doc []byte,
+	opts *models.ProofOptions,
+	domain, challenge string,
+) ([]byte, *pubkey.PublicKey, error) {
+	docData := make(map[string]interface{})
+
+	if err := json.Unmarshal(doc, &docData); err != nil {
+		return nil, nil, fmt.Errorf("eddsa-rdfc-2022 suite expects JSON-LD payload: %w", err)
+	}
+
+	vmKey := opts.VerificationMethod.JSONWebKey()
+	if vmKey == nil {
+		return nil, nil, errors.New("verification method needs JWK")
+	}
+
+	if vmKey.Crv != "Ed25519" {
+		return nil, nil, errors.New("unsupported EdDSA curve")
+	}
+
+	if opts.ProofType != models.DataIntegrityProof || opts.SuiteType != SuiteType {
+		return nil, nil, suite.ErrProofTransformation
+	}
+
+	canonDoc, err := canonicalize(docData, s.ldLoader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	canonConf, err := canonicalize(proofConfig(docData[ldCtxKey], opts, domain, challenge), s.ldLoader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hashData(canonDoc, canonConf), &pubkey.PublicKey{Type: kms.ED25519Type, JWK: vmKey}, nil
+}
+
+func canonicalize(data map[string]interface{}, loader ld.DocumentLoader) ([]byte, error) {
+	out, err := processor.Default().GetCanonicalDocument(data, processor.WithDocumentLoader(loader))
+	if err != nil {
+		return nil, fmt.Errorf("canonicalizing signature base data: %w", err)
+	}
+
+	return out, nil
+}
+
+// hashData returns concatenation of proof config hash and document hash.
+func hashData(transformedDoc, confData []byte) []byte {
+	confHash := sha256.Sum256(confData)
+	docHash := sha256.Sum256(transformedDoc)
+
+	return append(confHash[:], docHash[:]...)
+}
+
+func proofConfig(docCtx interface{}, opts *models.ProofOptions, domain, challenge string) map[string]interface{} {
+	conf := map[string]interface{}{
+		ldCtxKey:             docCtx,
+		"type":               models.DataIntegrityProof,
+		"cryptosuite":        SuiteType,
+		"verificationMethod": opts.VerificationMethodID,
+		"created":            opts.Created.Format(models.DateTimeFormat),
+		"proofPurpose":       opts.Purpose,
+	}
+
+	if domain != "" {
+		conf["domain"] = domain
+	}
+
+	if challenge != "" {
+		conf["challenge"] = challenge
+	}
+
+	return conf
+}
@@ -0,0 +1,126 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package eddsa2022_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/did-go/doc/did"
+	"github.com/trustbloc/kms-go/doc/jose/jwk/jwksupport"
+	"github.com/trustbloc/vc-go/dataintegrity/models"
+
+	"github.com/trustbloc/vcs/pkg/doc/vc/dataintegrity/eddsa2022"
+	"github.com/trustbloc/vcs/pkg/internal/testutil"
+)
+
+const credential = `{
+  "@context": [
+    "https://www.w3.org/2018/credentials/v1",
+    "https://www.w3.org/2018/credentials/examples/v1",
+    "https://w3id.org/security/data-integrity/v1"
+  ],
+  "id": "http://example.edu/credentials/1872",
+  "type": ["VerifiableCredential", "UniversityDegreeCredential"],
+  "issuer": "did:example:76e12ec712ebc6f1c221ebfeb1f",
+  "issuanceDate": "2010-01-01T19:23:24Z",
+  "credentialSubject": {
+    "id": "did:example:ebfeb1f712ebc6f1c276e12ec21",
+    "degree": {
+      "type": "BachelorDegree",
+      "name": "Bachelor of Science and Arts"
+    }
+  }
+}`
+
+type staticSigner struct {
+	privKey ed25519.PrivateKey
+}
+
+func (s *staticSigner) Sign(msg []byte) ([]byte, error) {
+	return ed25519.Sign(s.privKey, msg), nil
+}
+
+func TestSuite(t *testing.T) {
+	loader := testutil.DocumentLoader(t)
+
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	pubJWK, err := jwksupport.JWKFromKey(pubKey)
+	require.NoError(t, err)
+
+	vm, err := did.NewVerificationMethodFromJWK("did:example:123#key-1", "JsonWebKey2020", "did:example:123",
+		pubJWK)
+	require.NoError(t, err)
+
+	signer, err := eddsa2022.NewSignerInitializer(&eddsa2022.SignerInitializerOptions{
+		LDDocumentLoader: loader,
+		SignerGetter:     eddsa2022.WithStaticSigner(&staticSigner{privKey: privKey}),
+	}).Signer()
+	require.NoError(t, err)
+
+	verifier, err := eddsa2022.NewVerifierInitializer(&eddsa2022.VerifierInitializerOptions{
+		LDDocumentLoader: loader,
+	}).Verifier()
+	require.NoError(t, err)
+
+	proofOpts := &models.ProofOptions{
+		VerificationMethod:   vm,
+		VerificationMethodID: vm.ID,
+		SuiteType:            eddsa2022.SuiteType,
+		Purpose:              "assertionMethod",
+		ProofType:            models.DataIntegrityProof,
+		Created:              time.Now(),
+		Domain:               "example.com",
+		Challenge:            "challenge",
+	}
+
+	proof, err := signer.CreateProof([]byte(credential), proofOpts)
+	require.NoError(t, err)
+	require.Equal(t, eddsa2022.SuiteType, proof.CryptoSuite)
+	require.Equal(t, "challenge", proof.Challenge)
+
+	t.Run("success", func(t *testing.T) {
+		require.NoError(t, verifier.VerifyProof([]byte(credential), proof, proofOpts))
+	})
+
+	t.Run("tampered document", func(t *testing.T) {
+		tampered := []byte(`{"@context": ["https://www.w3.org/2018/credentials/v1"], "type": "VerifiableCredential"}`)
+
+		require.ErrorContains(t, verifier.VerifyProof(tampered, proof, proofOpts),
+			"failed to verify eddsa-rdfc-2022 DI proof")
+	})
+
+	t.Run("tampered challenge", func(t *testing.T) {
+		tamperedProof := *proof
+		tamperedProof.Challenge = "other"
+
+		require.Error(t, verifier.VerifyProof([]byte(credential), &tamperedProof, proofOpts))
+	})
+
+	t.Run("verification method without JWK", func(t *testing.T) {
+		_, err := signer.CreateProof([]byte(credential), &models.ProofOptions{
+			VerificationMethod: did.NewVerificationMethodFromBytes(vm.ID, "Ed25519VerificationKey2018",
+				vm.Controller, pubKey),
+			SuiteType: eddsa2022.SuiteType,
+			ProofType: models.DataIntegrityProof,
+		})
+		require.ErrorContains(t, err, "verification method needs JWK")
+	})
+
+	t.Run("unsupported suite type", func(t *testing.T) {
+		opts := *proofOpts
+		opts.SuiteType = "ecdsa-2019"
+
+		_, err := signer.CreateProof([]byte(credential), &opts)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,72 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vc
+
+import (
+	"errors"
+	"fmt"
+
+	jsonld "github.com/piprate/json-gold/ld"
+	"github.com/samber/lo"
+	"github.com/trustbloc/did-go/doc/ld/validator"
+	"github.com/trustbloc/vc-go/verifiable"
+
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
+)
+
+const vcType = "VerifiableCredential"
+
+// ParseCredential parses credential of VC Data Model v1.1 or v2.0.
+// vc-go validates credentials against VC Data Model v1.1 only, so validation of v2.0 credentials is skipped
+// on parsing and has to be done by ValidateDataModelV2.
+func ParseCredential(vcData []byte, opts ...verifiable.CredentialOpt) (*verifiable.Credential, error) {
+	if contexts, err := vcutil.GetContextsFromJSONRaw(vcData); err == nil && vcutil.IsDataModelV2(contexts) {
+		opts = append(opts, verifiable.WithCredDisableValidation())
+	}
+
+	return verifiable.ParseCredential(vcData, opts...)
+}
+
+// ValidateDataModelV2 validates the credential against VC Data Model v2.0.
+func ValidateDataModelV2(
+	credential *verifiable.Credential,
+	documentLoader jsonld.DocumentLoader,
+	strictValidation bool,
+) error {
+	vcc := credential.Contents()
+
+	if !vcutil.IsDataModelV2(vcc.Context) {
+		return fmt.Errorf("violated @context constraint: %s must be the first @context", vcutil.DefVCContextV2)
+	}
+
+	if !lo.Contains(vcc.Types, vcType) {
+		return fmt.Errorf("violated type constraint: %s type must be defined", vcType)
+	}
+
+	if vcc.Issuer == nil || vcc.Issuer.ID == "" {
+		return errors.New("issuer is required")
+	}
+
+	if len(vcc.Subject) == 0 {
+		return errors.New("credentialSubject is required")
+	}
+
+	if _, _, err := vcutil.ValidityPeriod(credential); err != nil {
+		return err
+	}
+
+	// ToRawJSON returns a shallow copy, validator modifies @context of the document.
+	if err := validator.ValidateJSONLDMap(credential.ToRawJSON(),
+		validator.WithDocumentLoader(documentLoader),
+		validator.WithStrictValidation(strictValidation),
+		validator.WithStrictContextURIPosition(vcutil.DefVCContextV2),
+	); err != nil {
+		return fmt.Errorf("validate JSON-LD: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/vc-go/verifiable"
+
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
+	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
+	"github.com/trustbloc/vcs/pkg/internal/testutil"
+)
+
+func newDataModelV2Credential(modify func(vc map[string]interface{})) map[string]interface{} {
+	vc := map[string]interface{}{
+		"@context": []interface{}{vcutil.DefVCContextV2},
+		"id":       "http://example.edu/credentials/1872",
+		"type":     []interface{}{"VerifiableCredential", "UniversityDegreeCredential"},
+		"issuer":   "did:example:76e12ec712ebc6f1c221ebfeb1f",
+		"credentialSubject": map[string]interface{}{
+			"id":     "did:example:ebfeb1f712ebc6f1c276e12ec21",
+			"degree": "Bachelor of Science and Arts",
+		},
+		"validFrom":  time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+		"validUntil": time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+	}
+
+	if modify != nil {
+		modify(vc)
+	}
+
+	return vc
+}
+
+func TestValidateDataModelV2(t *testing.T) {
+	loader := testutil.DocumentLoader(t)
+
+	tests := []struct {
+		name    string
+		modify  func(vc map[string]interface{})
+		wantErr string
+	}{
+		{
+			name: "OK",
+		},
+		{
+			name: "v1.1 context",
+			modify: func(vc map[string]interface{}) {
+				vc["@context"] = []interface{}{vcutil.DefVCContext}
+			},
+			wantErr: "violated @context constraint",
+		},
+		{
+			name: "missing VerifiableCredential type",
+			modify: func(vc map[string]interface{}) {
+				vc["type"] = []interface{}{"UniversityDegreeCredential"}
+			},
+			wantErr: "violated type constraint",
+		},
+		{
+			name: "missing issuer",
+			modify: func(vc map[string]interface{}) {
+				delete(vc, "issuer")
+			},
+			wantErr: "issuer is required",
+		},
+		{
+			name: "invalid validFrom",
+			modify: func(vc map[string]interface{}) {
+				vc["validFrom"] = "yesterday"
+			},
+			wantErr: "parse validFrom",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vcBytes, err := json.Marshal(newDataModelV2Credential(tt.modify))
+			require.NoError(t, err)
+
+			cred, err := ParseCredential(vcBytes,
+				verifiable.WithDisabledProofCheck(),
+				verifiable.WithCredDisableValidation(),
+				verifiable.WithJSONLDDocumentLoader(loader))
+			require.NoError(t, err)
+
+			err = ValidateDataModelV2(cred, loader, true)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateCredential_DataModelV2(t *testing.T) {
+	loader := testutil.DocumentLoader(t)
+	opts := []verifiable.CredentialOpt{
+		verifiable.WithDisabledProofCheck(),
+		verifiable.WithJSONLDDocumentLoader(loader),
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		cred, err := ValidateCredential(context.Background(), newDataModelV2Credential(nil),
+			[]vcsverifiable.Format{vcsverifiable.Ldp}, true, true, loader, opts...)
+		require.NoError(t, err)
+		require.True(t, vcutil.IsDataModelV2(cred.Contents().Context))
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		_, err := ValidateCredential(context.Background(),
+			newDataModelV2Credential(func(vc map[string]interface{}) {
+				vc["validUntil"] = time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+			}),
+			[]vcsverifiable.Format{vcsverifiable.Ldp}, true, false, loader, opts...)
+		require.ErrorContains(t, err, "credential expired")
+	})
+
+	t.Run("not valid yet", func(t *testing.T) {
+		_, err := ValidateCredential(context.Background(),
+			newDataModelV2Credential(func(vc map[string]interface{}) {
+				vc["validFrom"] = time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+			}),
+			[]vcsverifiable.Format{vcsverifiable.Ldp}, true, false, loader, opts...)
+		require.ErrorContains(t, err, "credential is not valid yet")
+	})
+
+	t.Run("invalid data model", func(t *testing.T) {
+		_, err := ValidateCredential(context.Background(),
+			newDataModelV2Credential(func(vc map[string]interface{}) {
+				delete(vc, "issuer")
+			}),
+			[]vcsverifiable.Format{vcsverifiable.Ldp}, false, false, loader, opts...)
+		require.ErrorContains(t, err, "issuer is required")
+	})
+}
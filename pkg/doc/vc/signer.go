@@ -33,6 +33,10 @@ type Signer struct {
 	SignatureRepresentation verifiable.SignatureRepresentation // For LDP only - proof/JWS.
 	KMS                     keyManager
 	VCStatusListType        StatusType // Type of VC status list
+	VCStatusPurpose         string     // Status purpose of VC status list, "revocation" by default.
 	SDJWT                   SDJWT
 	DataIntegrityProof      DataIntegrityProofConfig
+	CertificateChain        []string // X.509 certificate chain of the signing key ("x5c"), JWT only.
+	// SecuringMechanism of VC Data Model v2.0 credentials of JWT format - JOSE (default) or COSE.
+	SecuringMechanism vcsverifiable.SecuringMechanism
 }
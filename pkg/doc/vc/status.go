@@ -29,6 +29,11 @@ const (
 	//  VC > Status > Type
 	// 	Doc: https://w3c-ccg.github.io/vc-status-rl-2020/
 	RevocationList2020VCStatus StatusType = "RevocationList2020Status"
+
+	// BitstringStatusListVCStatus represents the implementation of Bitstring Status List.
+	//  VC > Status > Type
+	// 	Doc: https://www.w3.org/TR/vc-bitstring-status-list/
+	BitstringStatusListVCStatus StatusType = "BitstringStatusListEntry"
 )
 
 // StatusProcessor holds the list of methods required for processing different versions of Status(Revocation) List VC.
@@ -37,7 +42,7 @@ type StatusProcessor interface {
 	GetStatusVCURI(vcStatus *verifiable.TypedID) (string, error)
 	GetStatusListIndex(vcStatus *verifiable.TypedID) (int, error)
 	CreateVC(vcID string, listSize int, profile *Signer) (*verifiable.Credential, error)
-	CreateVCStatus(statusListIndex string, vcID string) *verifiable.TypedID
+	CreateVCStatus(statusListIndex string, vcID string, statusPurpose string) *verifiable.TypedID
 	GetVCContext() string
 }
 
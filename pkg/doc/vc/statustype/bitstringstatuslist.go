@@ -0,0 +1,146 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statustype
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/trustbloc/vc-go/verifiable"
+
+	vcapi "github.com/trustbloc/vcs/pkg/doc/vc"
+	"github.com/trustbloc/vcs/pkg/doc/vc/bitstring"
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
+)
+
+const (
+	// bitstringStatusListVCType is the type for status list VC.
+	// 	status list VC > Type
+	bitstringStatusListVCType = "BitstringStatusListCredential"
+	// BitstringStatusListVCSubjectType is the subject type of status list VC.
+	// 	status list VC > Subject > Type
+	BitstringStatusListVCSubjectType = "BitstringStatusList"
+	// bitstringStatusListMinSize is the minimum size of the bitstring (16KB) required by the spec.
+	bitstringStatusListMinSize = 131072
+)
+
+// bitstringStatusListProcessor implements Bitstring Status List.
+// Spec: https://www.w3.org/TR/vc-bitstring-status-list/
+type bitstringStatusListProcessor struct{}
+
+// NewBitstringStatusListProcessor returns new bitstringStatusListProcessor.
+func NewBitstringStatusListProcessor() *bitstringStatusListProcessor { //nolint:revive
+	return &bitstringStatusListProcessor{}
+}
+
+// GetStatusVCURI returns the ID (URL) of status VC.
+func (s *bitstringStatusListProcessor) GetStatusVCURI(vcStatus *verifiable.TypedID) (string, error) {
+	statusListVC, ok := vcStatus.CustomFields[StatusListCredential].(string)
+	if !ok {
+		return "", fmt.Errorf("failed to cast URI of statusListCredential")
+	}
+
+	return statusListVC, nil
+}
+
+// GetStatusListIndex returns the bit position of the status value of the VC.
+func (s *bitstringStatusListProcessor) GetStatusListIndex(vcStatus *verifiable.TypedID) (int, error) {
+	index, ok := vcStatus.CustomFields[StatusListIndex].(string)
+	if !ok {
+		return -1, fmt.Errorf("failed to cast statusListIndex")
+	}
+
+	statusListIndex, err := strconv.Atoi(index)
+	if err != nil {
+		return -1, fmt.Errorf("unable to get statusListIndex: %w", err)
+	}
+
+	return statusListIndex, nil
+}
+
+// ValidateStatus validates the status of vc.
+func (s *bitstringStatusListProcessor) ValidateStatus(vcStatus *verifiable.TypedID) error {
+	if vcStatus == nil {
+		return fmt.Errorf("vc status not exist")
+	}
+
+	if vcStatus.Type != string(vcapi.BitstringStatusListVCStatus) {
+		return fmt.Errorf("vc status %s not supported", vcStatus.Type)
+	}
+
+	if vcStatus.CustomFields[StatusListIndex] == nil {
+		return fmt.Errorf("statusListIndex field not exist in vc status")
+	}
+
+	if vcStatus.CustomFields[StatusListCredential] == nil {
+		return fmt.Errorf("statusListCredential field not exist in vc status")
+	}
+
+	if vcStatus.CustomFields[StatusPurpose] == nil {
+		return fmt.Errorf("statusPurpose field not exist in vc status")
+	}
+
+	return nil
+}
+
+// CreateVCStatus creates verifiable.TypedID.
+func (s *bitstringStatusListProcessor) CreateVCStatus(
+	statusListIndex, vcID, statusPurpose string,
+) *verifiable.TypedID {
+	return &verifiable.TypedID{
+		ID:   uuid.New().URN(),
+		Type: string(vcapi.BitstringStatusListVCStatus),
+		CustomFields: verifiable.CustomFields{
+			StatusPurpose:        statusPurposeOrDefault(statusPurpose),
+			StatusListIndex:      statusListIndex,
+			StatusListCredential: vcID,
+		},
+	}
+}
+
+// GetVCContext returns VC.Context value appropriate for Bitstring Status List.
+// The terms are defined in the base context of VC Data Model v2.0.
+func (s *bitstringStatusListProcessor) GetVCContext() string {
+	return vcutil.DefVCContextV2
+}
+
+// CreateVC returns *verifiable.Credential appropriate for Bitstring Status List.
+func (s *bitstringStatusListProcessor) CreateVC(vcID string, listSize int,
+	profile *vcapi.Signer) (*verifiable.Credential, error) {
+	vcc := verifiable.CredentialContents{}
+	vcc.Context = vcutil.AppendSignatureTypeContext([]string{vcutil.DefVCContextV2}, profile.SignatureType)
+
+	vcc.ID = vcID
+	vcc.Types = []string{vcType, bitstringStatusListVCType}
+	vcc.Issuer = &verifiable.Issuer{ID: profile.DID}
+
+	customFields := verifiable.CustomFields{}
+
+	vcutil.SetValidityPeriod(&vcc, customFields, time.Now().UTC(), nil)
+
+	size := listSize
+
+	if size < bitstringStatusListMinSize {
+		size = bitstringStatusListMinSize
+	}
+
+	encodeBits, err := bitstring.NewBitString(size).EncodeBits(bitstring.WithMultibaseEncoding())
+	if err != nil {
+		return nil, err
+	}
+
+	vcc.Subject = toVerifiableSubject(credentialSubject{
+		ID:            vcc.ID + "#list",
+		Type:          BitstringStatusListVCSubjectType,
+		StatusPurpose: statusPurposeOrDefault(profile.VCStatusPurpose),
+		EncodedList:   encodeBits,
+	})
+
+	return verifiable.CreateCredential(vcc, customFields)
+}
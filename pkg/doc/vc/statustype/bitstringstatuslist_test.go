@@ -0,0 +1,185 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statustype
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/vc-go/verifiable"
+
+	vcapi "github.com/trustbloc/vcs/pkg/doc/vc"
+	"github.com/trustbloc/vcs/pkg/doc/vc/bitstring"
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
+	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
+)
+
+func Test_bitstringStatusListProcessor_ValidateStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		vcStatus *verifiable.TypedID
+		wantErr  string
+	}{
+		{
+			name: "OK",
+			vcStatus: &verifiable.TypedID{
+				Type: "BitstringStatusListEntry",
+				CustomFields: map[string]interface{}{
+					"statusListIndex":      "1",
+					"statusListCredential": "",
+					"statusPurpose":        "revocation",
+				},
+			},
+		},
+		{
+			name:    "Error not exist",
+			wantErr: "vc status not exist",
+		},
+		{
+			name: "Error status not supported",
+			vcStatus: &verifiable.TypedID{
+				Type: "StatusList2021Entry",
+			},
+			wantErr: "vc status StatusList2021Entry not supported",
+		},
+		{
+			name: "Error statusListIndex empty",
+			vcStatus: &verifiable.TypedID{
+				Type: "BitstringStatusListEntry",
+				CustomFields: map[string]interface{}{
+					"statusListCredential": "",
+					"statusPurpose":        "revocation",
+				},
+			},
+			wantErr: "statusListIndex field not exist in vc status",
+		},
+		{
+			name: "Error statusListCredential empty",
+			vcStatus: &verifiable.TypedID{
+				Type: "BitstringStatusListEntry",
+				CustomFields: map[string]interface{}{
+					"statusListIndex": "1",
+					"statusPurpose":   "revocation",
+				},
+			},
+			wantErr: "statusListCredential field not exist in vc status",
+		},
+		{
+			name: "Error statusPurpose empty",
+			vcStatus: &verifiable.TypedID{
+				Type: "BitstringStatusListEntry",
+				CustomFields: map[string]interface{}{
+					"statusListIndex":      "1",
+					"statusListCredential": "",
+				},
+			},
+			wantErr: "statusPurpose field not exist in vc status",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewBitstringStatusListProcessor().ValidateStatus(tt.vcStatus)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_bitstringStatusListProcessor_CreateVC(t *testing.T) {
+	s := NewBitstringStatusListProcessor()
+	vc, err := s.CreateVC("vcID1", 10, &vcapi.Signer{
+		DID:           "did:example:123",
+		SignatureType: vcsverifiable.JSONWebSignature2020,
+	})
+	require.NoError(t, err)
+
+	vcc := vc.Contents()
+
+	require.Equal(t, "vcID1", vcc.ID)
+	require.Equal(t, []string{
+		vcutil.DefVCContextV2,
+		"https://w3c-ccg.github.io/lds-jws2020/contexts/lds-jws2020-v1.json"}, vcc.Context)
+	require.Equal(t, []string{vcType, bitstringStatusListVCType}, vcc.Types)
+	require.Equal(t, &verifiable.Issuer{ID: "did:example:123"}, vcc.Issuer)
+	require.Nil(t, vcc.Issued)
+	require.NotNil(t, vc.CustomField("validFrom"))
+
+	encodeBits, err := bitstring.NewBitString(bitstringStatusListMinSize).EncodeBits(bitstring.WithMultibaseEncoding())
+	require.NoError(t, err)
+	require.Equal(t, []verifiable.Subject{{
+		ID: "vcID1#list",
+		CustomFields: map[string]interface{}{
+			"type":          "BitstringStatusList",
+			"statusPurpose": "revocation",
+			"encodedList":   encodeBits,
+		},
+	}}, vcc.Subject)
+}
+
+func Test_bitstringStatusListProcessor_CreateVCStatus(t *testing.T) {
+	s := NewBitstringStatusListProcessor()
+	statusID := s.CreateVCStatus("1", "vcID2", "")
+
+	require.Equal(t, string(vcapi.BitstringStatusListVCStatus), statusID.Type)
+	require.Equal(t, verifiable.CustomFields{
+		StatusPurpose:        "revocation",
+		StatusListIndex:      "1",
+		StatusListCredential: "vcID2",
+	}, statusID.CustomFields)
+
+	statusID = s.CreateVCStatus("1", "vcID2", StatusPurposeSuspension)
+	require.Equal(t, "suspension", statusID.CustomFields[StatusPurpose])
+}
+
+func Test_bitstringStatusListProcessor_GetStatusListIndex(t *testing.T) {
+	vcStatus := &verifiable.TypedID{
+		CustomFields: map[string]interface{}{
+			StatusListIndex: 1,
+		},
+	}
+
+	s := NewBitstringStatusListProcessor()
+	index, err := s.GetStatusListIndex(vcStatus)
+	require.ErrorContains(t, err, "failed to cast statusListIndex")
+	require.Equal(t, -1, index)
+
+	vcStatus.CustomFields[StatusListIndex] = "abc"
+	index, err = s.GetStatusListIndex(vcStatus)
+	require.ErrorContains(t, err, "unable to get statusListIndex")
+	require.Equal(t, -1, index)
+
+	vcStatus.CustomFields[StatusListIndex] = "1"
+	index, err = s.GetStatusListIndex(vcStatus)
+	require.NoError(t, err)
+	require.Equal(t, 1, index)
+}
+
+func Test_bitstringStatusListProcessor_GetStatusVCURI(t *testing.T) {
+	vcStatus := &verifiable.TypedID{
+		CustomFields: map[string]interface{}{
+			StatusListCredential: 1,
+		},
+	}
+
+	s := NewBitstringStatusListProcessor()
+	vcURI, err := s.GetStatusVCURI(vcStatus)
+	require.ErrorContains(t, err, "failed to cast URI of statusListCredential")
+	require.Empty(t, vcURI)
+
+	vcStatus.CustomFields[StatusListCredential] = "https://example.com/1"
+	vcURI, err = s.GetStatusVCURI(vcStatus)
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/1", vcURI)
+}
+
+func Test_bitstringStatusListProcessor_GetVCContext(t *testing.T) {
+	require.Equal(t, "https://www.w3.org/ns/credentials/v2", NewBitstringStatusListProcessor().GetVCContext())
+}
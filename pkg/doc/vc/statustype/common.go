@@ -29,3 +29,12 @@ func toVerifiableSubject(subject credentialSubject) []verifiable.Subject {
 
 	return []verifiable.Subject{vcSub}
 }
+
+// statusPurposeOrDefault returns the status purpose of the status list, lists are revocation lists by default.
+func statusPurposeOrDefault(purpose string) string {
+	if purpose == "" {
+		return StatusPurposeRevocation
+	}
+
+	return purpose
+}
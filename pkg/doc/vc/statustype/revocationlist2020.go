@@ -86,7 +86,7 @@ func (s *revocationList2020Processor) ValidateStatus(vcStatus *verifiable.TypedI
 }
 
 // CreateVCStatus creates verifiable.TypedID.
-func (s *revocationList2020Processor) CreateVCStatus(revocationListIndex, vcID string) *verifiable.TypedID {
+func (s *revocationList2020Processor) CreateVCStatus(revocationListIndex, vcID, _ string) *verifiable.TypedID {
 	return &verifiable.TypedID{
 		ID:   uuid.New().URN(),
 		Type: string(vcapi.RevocationList2020VCStatus),
@@ -90,7 +90,7 @@ func (s *revocationList2021Processor) ValidateStatus(vcStatus *verifiable.TypedI
 
 // CreateVCStatus creates verifiable.TypedID.
 // Doc: https://github.com/w3c-ccg/vc-status-list-2021/releases/tag/v0.0.1
-func (s *revocationList2021Processor) CreateVCStatus(statusListIndex, vcID string) *verifiable.TypedID {
+func (s *revocationList2021Processor) CreateVCStatus(statusListIndex, vcID, _ string) *verifiable.TypedID {
 	return &verifiable.TypedID{
 		ID:   uuid.New().URN(),
 		Type: string(vcapi.RevocationList2021VCStatus),
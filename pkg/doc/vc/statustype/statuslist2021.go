@@ -98,12 +98,14 @@ func (s *statusList2021Processor) ValidateStatus(vcStatus *verifiable.TypedID) e
 }
 
 // CreateVCStatus creates verifiable.TypedID.
-func (s *statusList2021Processor) CreateVCStatus(statusListIndex, vcID string) *verifiable.TypedID {
+func (s *statusList2021Processor) CreateVCStatus(
+	statusListIndex, vcID, statusPurpose string,
+) *verifiable.TypedID {
 	return &verifiable.TypedID{
 		ID:   uuid.New().URN(),
 		Type: string(vcapi.StatusList2021VCStatus),
 		CustomFields: verifiable.CustomFields{
-			StatusPurpose:        "revocation",
+			StatusPurpose:        statusPurposeOrDefault(statusPurpose),
 			StatusListIndex:      statusListIndex,
 			StatusListCredential: vcID,
 		},
@@ -142,7 +144,7 @@ func (s *statusList2021Processor) CreateVC(vcID string, listSize int,
 	vcc.Subject = toVerifiableSubject(credentialSubject{
 		ID:            vcc.ID + "#list",
 		Type:          StatusList2021VCSubjectType,
-		StatusPurpose: "revocation",
+		StatusPurpose: statusPurposeOrDefault(profile.VCStatusPurpose),
 		EncodedList:   encodeBits,
 	})
 
@@ -138,7 +138,7 @@ func Test_statusList2021Processor_CreateVC(t *testing.T) {
 
 func Test_statusList2021Processor_CreateVCStatus(t *testing.T) {
 	s := NewStatusList2021Processor()
-	statusID := s.CreateVCStatus("1", "vcID2")
+	statusID := s.CreateVCStatus("1", "vcID2", "")
 
 	require.Equal(t, string(vcapi.StatusList2021VCStatus), statusID.Type)
 	require.Equal(t, verifiable.CustomFields{
@@ -146,6 +146,9 @@ func Test_statusList2021Processor_CreateVCStatus(t *testing.T) {
 		StatusListIndex:      "1",
 		StatusListCredential: "vcID2",
 	}, statusID.CustomFields)
+
+	statusID = s.CreateVCStatus("1", "vcID2", StatusPurposeSuspension)
+	require.Equal(t, "suspension", statusID.CustomFields[StatusPurpose])
 }
 
 func Test_statusList2021Processor_GetStatusListIndex(t *testing.T) {
@@ -12,6 +12,35 @@ import (
 	vcapi "github.com/trustbloc/vcs/pkg/doc/vc"
 )
 
+const (
+	// StatusPurposeRevocation is the status purpose of the lists used to revoke credentials.
+	StatusPurposeRevocation = "revocation"
+	// StatusPurposeSuspension is the status purpose of the lists used to suspend credentials.
+	StatusPurposeSuspension = "suspension"
+)
+
+// GetStatusPurpose returns the status purpose of the lists of given type. Lists of RevocationList2020 and
+// RevocationList2021 types have no purpose. Lists of other types are revocation lists unless purpose is set.
+func GetStatusPurpose(vcStatusListType vcapi.StatusType, purpose string) (string, error) {
+	switch vcStatusListType {
+	case vcapi.RevocationList2021VCStatus, vcapi.RevocationList2020VCStatus:
+		if purpose != "" && purpose != StatusPurposeRevocation {
+			return "", fmt.Errorf("status purpose %s is not supported by %s", purpose, vcStatusListType)
+		}
+
+		return "", nil
+	}
+
+	switch purpose {
+	case "":
+		return StatusPurposeRevocation, nil
+	case StatusPurposeRevocation, StatusPurposeSuspension:
+		return purpose, nil
+	default:
+		return "", fmt.Errorf("unsupported status purpose %s", purpose)
+	}
+}
+
 // GetVCStatusProcessor returns statustype.StatusProcessor.
 func GetVCStatusProcessor(vcStatusListType vcapi.StatusType) (vcapi.StatusProcessor, error) {
 	switch vcStatusListType {
@@ -21,6 +50,8 @@ func GetVCStatusProcessor(vcStatusListType vcapi.StatusType) (vcapi.StatusProces
 		return NewRevocationList2021Processor(), nil
 	case vcapi.RevocationList2020VCStatus:
 		return NewRevocationList2020Processor(), nil
+	case vcapi.BitstringStatusListVCStatus:
+		return NewBitstringStatusListProcessor(), nil
 	default:
 		return nil, fmt.Errorf("unsupported VCStatusListType %s", vcStatusListType)
 	}
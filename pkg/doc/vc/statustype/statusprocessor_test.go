@@ -0,0 +1,68 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statustype
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	vcapi "github.com/trustbloc/vcs/pkg/doc/vc"
+)
+
+func TestGetStatusPurpose(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusType vcapi.StatusType
+		purpose    string
+		expected   string
+		err        string
+	}{
+		{
+			name:       "default purpose",
+			statusType: vcapi.StatusList2021VCStatus,
+			expected:   StatusPurposeRevocation,
+		},
+		{
+			name:       "suspension purpose",
+			statusType: vcapi.BitstringStatusListVCStatus,
+			purpose:    StatusPurposeSuspension,
+			expected:   StatusPurposeSuspension,
+		},
+		{
+			name:       "revocation list has no purpose",
+			statusType: vcapi.RevocationList2021VCStatus,
+			purpose:    StatusPurposeRevocation,
+		},
+		{
+			name:       "unsupported purpose of revocation list",
+			statusType: vcapi.RevocationList2020VCStatus,
+			purpose:    StatusPurposeSuspension,
+			err:        "status purpose suspension is not supported by RevocationList2020Status",
+		},
+		{
+			name:       "unsupported purpose",
+			statusType: vcapi.StatusList2021VCStatus,
+			purpose:    "message",
+			err:        "unsupported status purpose message",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purpose, err := GetStatusPurpose(tt.statusType, tt.purpose)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, purpose)
+		})
+	}
+}
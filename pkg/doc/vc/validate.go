@@ -8,14 +8,13 @@ package vc
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"time"
 
 	jsonld "github.com/piprate/json-gold/ld"
 	"github.com/trustbloc/vc-go/jwt"
 	"github.com/trustbloc/vc-go/verifiable"
 
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
 	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
 	"github.com/trustbloc/vcs/pkg/restapi/resterr"
 )
@@ -35,16 +34,33 @@ func ValidateCredential(
 	}
 
 	// validate the VC (ignore the proof and issuanceDate)
-	credential, err := verifiable.ParseCredential(vcBytes, opts...)
+	credential, err := ParseCredential(vcBytes, opts...)
 	if err != nil {
 		return nil, resterr.NewValidationError(resterr.InvalidValue, "credential", err)
 	}
 
-	credentialContents := credential.Contents()
+	// Credential secured with COSE is enveloped, the checks apply to the secured credential.
+	securedCredential := credential
+	if IsCOSEEnvelopedCredential(credential) {
+		securedCredential, err = ParseCOSECredential(credential, nil, opts...)
+		if err != nil {
+			return nil, resterr.NewValidationError(resterr.InvalidValue, "credential", err)
+		}
+	}
 
-	if checkExpiration && credentialContents.Expired != nil && time.Now().UTC().After(credentialContents.Expired.Time) {
-		return nil, resterr.NewValidationError(resterr.InvalidValue, "credential",
-			errors.New("credential expired"))
+	credentialContents := securedCredential.Contents()
+	isDataModelV2 := vcutil.IsDataModelV2(credentialContents.Context)
+
+	if isDataModelV2 && !isJWT(cred) {
+		if err = ValidateDataModelV2(securedCredential, documentLoader, enforceStrictValidation); err != nil {
+			return nil, resterr.NewValidationError(resterr.InvalidValue, "credential", err)
+		}
+	}
+
+	if checkExpiration {
+		if err = vcutil.CheckValidityPeriod(securedCredential); err != nil {
+			return nil, resterr.NewValidationError(resterr.InvalidValue, "credential", err)
+		}
 	}
 
 	// Due to the current implementation in AFGO (func verifiable.ParseCredential()),
@@ -57,6 +73,14 @@ func ValidateCredential(
 			return validateSDJWTCredential(credential, documentLoader)
 		}
 
+		if isDataModelV2 {
+			if err = ValidateDataModelV2(credential, documentLoader, true); err != nil {
+				return nil, fmt.Errorf("failed to validate JWT credential claims: %w", err)
+			}
+
+			return credential, nil
+		}
+
 		// TODO: should it be only json ld validation as was originally, or also schema validation.
 		// By default for json-ld we will have both.
 		err = credential.ValidateCredential(
@@ -0,0 +1,157 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vcutil
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	utiltime "github.com/trustbloc/did-go/doc/util/time"
+	"github.com/trustbloc/vc-go/verifiable"
+
+	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
+)
+
+const (
+	// DefVCContextV2 is the base context of VC Data Model v2.0.
+	DefVCContextV2 = "https://www.w3.org/ns/credentials/v2"
+
+	validFromField  = "validFrom"
+	validUntilField = "validUntil"
+)
+
+// IsDataModelV2 checks whether the credential with the given contexts conforms to VC Data Model v2.0.
+func IsDataModelV2(contexts []string) bool {
+	return len(contexts) > 0 && contexts[0] == DefVCContextV2
+}
+
+// IsDataModelV2JSON checks whether the credential in JSON object form conforms to VC Data Model v2.0.
+func IsDataModelV2JSON(credential map[string]interface{}) bool {
+	contexts, err := decodeContext(credential["@context"])
+	if err != nil {
+		return false
+	}
+
+	return IsDataModelV2(contexts)
+}
+
+// ContextsForDataModel returns contexts with the base context of the given data model version in the first position.
+func ContextsForDataModel(contexts []string, version vcsverifiable.DataModelVersion) []string {
+	baseContext := DefVCContext
+	if version == vcsverifiable.DataModelV2 {
+		baseContext = DefVCContextV2
+	}
+
+	result := []string{baseContext}
+
+	for _, c := range contexts {
+		if c != DefVCContext && c != DefVCContextV2 {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+// SetValidityPeriod sets validity period of the credential according to its data model version:
+// issuanceDate and expirationDate for v1.1, validFrom and validUntil for v2.0.
+// vc-go supports v1.1 properties only, so v2.0 properties are set as custom fields.
+func SetValidityPeriod(
+	vcc *verifiable.CredentialContents,
+	customFields verifiable.CustomFields,
+	validFrom time.Time,
+	validUntil *time.Time,
+) {
+	if !IsDataModelV2(vcc.Context) {
+		vcc.Issued = utiltime.NewTime(validFrom)
+
+		if validUntil != nil {
+			vcc.Expired = utiltime.NewTime(*validUntil)
+		}
+
+		return
+	}
+
+	customFields[validFromField] = utiltime.NewTime(validFrom).FormatToString()
+
+	if validUntil != nil {
+		customFields[validUntilField] = utiltime.NewTime(*validUntil).FormatToString()
+	}
+}
+
+// SetValidUntil sets the end of the validity period of the credential according to its data model version.
+func SetValidUntil(credential *verifiable.Credential, validUntil time.Time) {
+	if IsDataModelV2(credential.Contents().Context) {
+		credential.SetCustomField(validUntilField, utiltime.NewTime(validUntil).FormatToString())
+
+		return
+	}
+
+	credential.SetCustomField("expirationDate", utiltime.NewTime(validUntil))
+}
+
+// ValidityPeriod returns validity period of the credential: validFrom and validUntil for VC Data Model v2.0,
+// issuanceDate and expirationDate for v1.1.
+func ValidityPeriod(credential *verifiable.Credential) (*utiltime.TimeWrapper, *utiltime.TimeWrapper, error) {
+	vcc := credential.Contents()
+
+	if !IsDataModelV2(vcc.Context) {
+		return vcc.Issued, vcc.Expired, nil
+	}
+
+	validFrom, err := timeField(credential, validFromField)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validUntil, err := timeField(credential, validUntilField)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return validFrom, validUntil, nil
+}
+
+// CheckValidityPeriod checks that the credential is not expired.
+// For VC Data Model v2.0 it also checks that the validity period has started.
+func CheckValidityPeriod(credential *verifiable.Credential) error {
+	validFrom, validUntil, err := ValidityPeriod(credential)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	if validUntil != nil && now.After(validUntil.Time) {
+		return errors.New("credential expired")
+	}
+
+	if IsDataModelV2(credential.Contents().Context) && validFrom != nil && now.Before(validFrom.Time) {
+		return errors.New("credential is not valid yet")
+	}
+
+	return nil
+}
+
+func timeField(credential *verifiable.Credential, name string) (*utiltime.TimeWrapper, error) {
+	switch v := credential.CustomField(name).(type) {
+	case nil:
+		return nil, nil
+	case string:
+		t, err := utiltime.ParseTimeWrapper(v)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", name, err)
+		}
+
+		return t, nil
+	case *utiltime.TimeWrapper:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("%s must be a date-time string", name)
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vcutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/vc-go/verifiable"
+
+	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
+)
+
+func TestContextsForDataModel(t *testing.T) {
+	require.Equal(t, []string{DefVCContext}, ContextsForDataModel(nil, vcsverifiable.DataModelV1))
+	require.Equal(t, []string{DefVCContextV2}, ContextsForDataModel(nil, vcsverifiable.DataModelV2))
+	require.Equal(t, []string{DefVCContextV2, "https://example.com/ctx"},
+		ContextsForDataModel([]string{DefVCContext, "https://example.com/ctx"}, vcsverifiable.DataModelV2))
+	require.Equal(t, []string{DefVCContext, "https://example.com/ctx"},
+		ContextsForDataModel([]string{"https://example.com/ctx"}, ""))
+}
+
+func TestIsDataModelV2JSON(t *testing.T) {
+	require.True(t, IsDataModelV2JSON(map[string]interface{}{"@context": []interface{}{DefVCContextV2}}))
+	require.True(t, IsDataModelV2JSON(map[string]interface{}{"@context": DefVCContextV2}))
+	require.False(t, IsDataModelV2JSON(map[string]interface{}{"@context": []interface{}{DefVCContext}}))
+	require.False(t, IsDataModelV2JSON(map[string]interface{}{}))
+}
+
+func TestValidityPeriod(t *testing.T) {
+	validFrom := time.Now().UTC().Truncate(time.Second)
+	validUntil := validFrom.Add(time.Hour)
+
+	newCredential := func(t *testing.T, contexts []string) *verifiable.Credential {
+		t.Helper()
+
+		vcc := verifiable.CredentialContents{
+			Context: contexts,
+			Types:   []string{"VerifiableCredential"},
+		}
+		customFields := verifiable.CustomFields{}
+
+		SetValidityPeriod(&vcc, customFields, validFrom, &validUntil)
+
+		cred, err := verifiable.CreateCredential(vcc, customFields)
+		require.NoError(t, err)
+
+		return cred
+	}
+
+	t.Run("v1.1", func(t *testing.T) {
+		cred := newCredential(t, []string{DefVCContext})
+
+		require.NotNil(t, cred.Contents().Issued)
+		require.Nil(t, cred.CustomField(validFromField))
+
+		from, until, err := ValidityPeriod(cred)
+		require.NoError(t, err)
+		require.True(t, validFrom.Equal(from.Time))
+		require.True(t, validUntil.Equal(until.Time))
+		require.NoError(t, CheckValidityPeriod(cred))
+	})
+
+	t.Run("v2.0", func(t *testing.T) {
+		cred := newCredential(t, []string{DefVCContextV2})
+
+		require.Nil(t, cred.Contents().Issued)
+		require.Nil(t, cred.Contents().Expired)
+
+		from, until, err := ValidityPeriod(cred)
+		require.NoError(t, err)
+		require.True(t, validFrom.Equal(from.Time))
+		require.True(t, validUntil.Equal(until.Time))
+		require.NoError(t, CheckValidityPeriod(cred))
+	})
+
+	t.Run("v2.0 expired", func(t *testing.T) {
+		cred := newCredential(t, []string{DefVCContextV2})
+		SetValidUntil(cred, time.Now().Add(-time.Minute))
+
+		require.EqualError(t, CheckValidityPeriod(cred), "credential expired")
+	})
+
+	t.Run("v2.0 not valid yet", func(t *testing.T) {
+		cred := newCredential(t, []string{DefVCContextV2})
+		cred.SetCustomField(validFromField, time.Now().Add(time.Hour).Format(time.RFC3339))
+
+		require.EqualError(t, CheckValidityPeriod(cred), "credential is not valid yet")
+	})
+
+	t.Run("v2.0 invalid validFrom", func(t *testing.T) {
+		cred := newCredential(t, []string{DefVCContextV2})
+
+		cred.SetCustomField(validFromField, "yesterday")
+		require.ErrorContains(t, CheckValidityPeriod(cred), "parse validFrom")
+
+		cred.SetCustomField(validFromField, 42)
+		require.ErrorContains(t, CheckValidityPeriod(cred), "validFrom must be a date-time string")
+	})
+
+	t.Run("v1.1 set valid until", func(t *testing.T) {
+		cred := newCredential(t, []string{DefVCContext})
+		SetValidUntil(cred, validUntil)
+
+		require.NotNil(t, cred.CustomField("expirationDate"))
+	})
+}
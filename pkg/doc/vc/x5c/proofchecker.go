@@ -0,0 +1,127 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package x5c
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/trustbloc/kms-go/doc/jose"
+	"github.com/trustbloc/kms-go/doc/jose/jwk/jwksupport"
+	"github.com/trustbloc/vc-go/crypto-ext/verifiers/ecdsa"
+	"github.com/trustbloc/vc-go/crypto-ext/verifiers/ed25519"
+	"github.com/trustbloc/vc-go/crypto-ext/verifiers/rsa"
+	"github.com/trustbloc/vc-go/proof/checker"
+	"github.com/trustbloc/vc-go/proof/jwtproofs/eddsa"
+	"github.com/trustbloc/vc-go/proof/jwtproofs/es256"
+	"github.com/trustbloc/vc-go/proof/jwtproofs/es256k"
+	"github.com/trustbloc/vc-go/proof/jwtproofs/es384"
+	"github.com/trustbloc/vc-go/proof/jwtproofs/es521"
+	"github.com/trustbloc/vc-go/proof/jwtproofs/ps256"
+	"github.com/trustbloc/vc-go/proof/jwtproofs/rs256"
+	"github.com/trustbloc/vc-go/verifiable"
+)
+
+// ProofChecker checks JWT proofs with "x5c" header against the trust store. Other proofs are checked
+// with the wrapped proof checker.
+type ProofChecker struct {
+	verifiable.CombinedProofChecker
+
+	trustStore *TrustStore
+}
+
+// NewProofChecker returns a new instance of ProofChecker.
+func NewProofChecker(proofChecker verifiable.CombinedProofChecker, trustStore *TrustStore) *ProofChecker {
+	return &ProofChecker{
+		CombinedProofChecker: proofChecker,
+		trustStore:           trustStore,
+	}
+}
+
+// CheckJWTProof checks JWT proof. If JWT has "x5c" header, the certificate chain is validated against
+// the trust store, the leaf certificate must be issued to the expected proof issuer, and the signature
+// is checked with the leaf certificate public key.
+func (c *ProofChecker) CheckJWTProof(
+	headers jose.Headers,
+	expectedProofIssuer string,
+	msg, signature []byte,
+) error {
+	x5cHeader, ok := headers[jose.HeaderX509CertificateChain]
+	if !ok {
+		return c.CombinedProofChecker.CheckJWTProof(headers, expectedProofIssuer, msg, signature)
+	}
+
+	x5c, err := toStringSlice(x5cHeader)
+	if err != nil {
+		return err
+	}
+
+	if c.trustStore == nil {
+		return errors.New("x5c header is not supported: no trust anchors configured")
+	}
+
+	leaf, err := c.trustStore.Verify(x5c)
+	if err != nil {
+		return err
+	}
+
+	if err = checkIssuer(leaf, expectedProofIssuer); err != nil {
+		return err
+	}
+
+	pubKey, err := jwksupport.JWKFromKey(leaf.PublicKey)
+	if err != nil {
+		return fmt.Errorf("convert certificate public key: %w", err)
+	}
+
+	return checker.NewEmbeddedJWKProofChecker(pubKey,
+		checker.WithSignatureVerifiers(ed25519.New(), rsa.NewPS256(), rsa.NewRS256(),
+			ecdsa.NewSecp256k1(), ecdsa.NewES256(), ecdsa.NewES384(), ecdsa.NewES521()),
+		checker.WithJWTAlg(eddsa.New(), es256.New(), es256k.New(), es384.New(), es521.New(), rs256.New(), ps256.New()),
+	).CheckJWTProof(headers, expectedProofIssuer, msg, signature)
+}
+
+// checkIssuer checks that the certificate is issued to the credential issuer. Issuer matches either
+// URI subject alternative name of the certificate, or DNS name for HTTPS URL issuer.
+func checkIssuer(cert *x509.Certificate, issuer string) error {
+	for _, uri := range cert.URIs {
+		if uri.String() == issuer {
+			return nil
+		}
+	}
+
+	u, err := url.Parse(issuer)
+	if err == nil && u.Scheme == "https" && u.Host != "" && cert.VerifyHostname(u.Hostname()) == nil {
+		return nil
+	}
+
+	return fmt.Errorf("certificate %s is not issued to %s", cert.Subject, issuer)
+}
+
+func toStringSlice(v interface{}) ([]string, error) {
+	switch val := v.(type) {
+	case []string:
+		return val, nil
+	case []interface{}:
+		res := make([]string, 0, len(val))
+
+		for _, item := range val {
+			s, ok := item.(string)
+			if !ok {
+				return nil, errors.New("invalid x5c header")
+			}
+
+			res = append(res, s)
+		}
+
+		return res, nil
+	default:
+		return nil, errors.New("invalid x5c header")
+	}
+}
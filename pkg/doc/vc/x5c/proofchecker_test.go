@@ -0,0 +1,110 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package x5c_test
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/kms-go/doc/jose"
+	"github.com/trustbloc/vc-go/verifiable"
+
+	"github.com/trustbloc/vcs/pkg/doc/vc/x5c"
+)
+
+type mockProofChecker struct {
+	verifiable.CombinedProofChecker
+
+	err error
+}
+
+func (m *mockProofChecker) CheckJWTProof(jose.Headers, string, []byte, []byte) error {
+	return m.err
+}
+
+func TestProofChecker_CheckJWTProof(t *testing.T) {
+	pki := newTestPKI(t)
+	ts := x5c.NewTrustStore([]*x509.Certificate{pki.root.cert}, nil)
+
+	msg := []byte("header.payload")
+	signature := ed25519.Sign(pki.leaf.key, msg)
+
+	headers := func(x5cHeader interface{}) jose.Headers {
+		return jose.Headers{
+			jose.HeaderAlgorithm:            "EdDSA",
+			jose.HeaderX509CertificateChain: x5cHeader,
+		}
+	}
+
+	t.Run("success HTTPS issuer", func(t *testing.T) {
+		c := x5c.NewProofChecker(&mockProofChecker{err: errors.New("unexpected")}, ts)
+
+		require.NoError(t, c.CheckJWTProof(headers(pki.chain()), "https://"+issuerHost+"/issuer", msg, signature))
+	})
+
+	t.Run("success URI issuer", func(t *testing.T) {
+		c := x5c.NewProofChecker(&mockProofChecker{err: errors.New("unexpected")}, ts)
+
+		x5cHeader := make([]interface{}, 0)
+		for _, cert := range pki.chain() {
+			x5cHeader = append(x5cHeader, cert)
+		}
+
+		require.NoError(t, c.CheckJWTProof(headers(x5cHeader), "did:web:"+issuerHost, msg, signature))
+	})
+
+	t.Run("no x5c header", func(t *testing.T) {
+		c := x5c.NewProofChecker(&mockProofChecker{err: errors.New("base checker")}, ts)
+
+		err := c.CheckJWTProof(jose.Headers{jose.HeaderAlgorithm: "EdDSA"}, "did:example:123", msg, signature)
+		require.EqualError(t, err, "base checker")
+	})
+
+	t.Run("no trust store", func(t *testing.T) {
+		c := x5c.NewProofChecker(&mockProofChecker{}, nil)
+
+		err := c.CheckJWTProof(headers(pki.chain()), "https://"+issuerHost, msg, signature)
+		require.ErrorContains(t, err, "no trust anchors configured")
+	})
+
+	t.Run("invalid x5c header", func(t *testing.T) {
+		c := x5c.NewProofChecker(&mockProofChecker{}, ts)
+
+		err := c.CheckJWTProof(headers("cert"), "https://"+issuerHost, msg, signature)
+		require.ErrorContains(t, err, "invalid x5c header")
+
+		err = c.CheckJWTProof(headers([]interface{}{1}), "https://"+issuerHost, msg, signature)
+		require.ErrorContains(t, err, "invalid x5c header")
+	})
+
+	t.Run("untrusted chain", func(t *testing.T) {
+		c := x5c.NewProofChecker(&mockProofChecker{}, x5c.NewTrustStore(nil, nil))
+
+		err := c.CheckJWTProof(headers(pki.chain()), "https://"+issuerHost, msg, signature)
+		require.ErrorContains(t, err, "verify certificate chain")
+	})
+
+	t.Run("issuer mismatch", func(t *testing.T) {
+		c := x5c.NewProofChecker(&mockProofChecker{}, ts)
+
+		err := c.CheckJWTProof(headers(pki.chain()), "https://other.example.com", msg, signature)
+		require.ErrorContains(t, err, "is not issued to https://other.example.com")
+
+		err = c.CheckJWTProof(headers(pki.chain()), "http://"+issuerHost, msg, signature)
+		require.ErrorContains(t, err, "is not issued to")
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		c := x5c.NewProofChecker(&mockProofChecker{}, ts)
+
+		err := c.CheckJWTProof(headers(pki.chain()), "https://"+issuerHost, msg, ed25519.Sign(pki.root.key, msg))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,225 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package x5c
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	pemTypeCertificate = "CERTIFICATE"
+	pemTypeCRL         = "X509 CRL"
+)
+
+// ErrCertificateRevoked is returned when a certificate of the chain is listed in a CRL.
+var ErrCertificateRevoked = errors.New("certificate revoked")
+
+// TrustStore validates X.509 certificate chains of credential issuers against trust anchors and CRLs.
+type TrustStore struct {
+	roots *x509.CertPool
+	crls  []*x509.RevocationList
+	now   func() time.Time
+}
+
+// NewTrustStore returns a new instance of TrustStore.
+func NewTrustStore(trustAnchors []*x509.Certificate, crls []*x509.RevocationList) *TrustStore {
+	roots := x509.NewCertPool()
+
+	for _, cert := range trustAnchors {
+		roots.AddCert(cert)
+	}
+
+	return &TrustStore{
+		roots: roots,
+		crls:  crls,
+		now:   time.Now,
+	}
+}
+
+// LoadTrustStore loads trust anchors and CRLs from PEM or DER encoded files.
+func LoadTrustStore(trustAnchorFiles, crlFiles []string) (*TrustStore, error) {
+	var trustAnchors []*x509.Certificate
+
+	for _, f := range trustAnchorFiles {
+		blocks, err := readBlocks(f, pemTypeCertificate)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, b := range blocks {
+			cert, err := x509.ParseCertificate(b)
+			if err != nil {
+				return nil, fmt.Errorf("parse trust anchor %s: %w", f, err)
+			}
+
+			trustAnchors = append(trustAnchors, cert)
+		}
+	}
+
+	var crls []*x509.RevocationList
+
+	for _, f := range crlFiles {
+		blocks, err := readBlocks(f, pemTypeCRL)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, b := range blocks {
+			crl, err := x509.ParseRevocationList(b)
+			if err != nil {
+				return nil, fmt.Errorf("parse crl %s: %w", f, err)
+			}
+
+			crls = append(crls, crl)
+		}
+	}
+
+	return NewTrustStore(trustAnchors, crls), nil
+}
+
+// Verify validates the certificate chain in "x5c" format (base64 encoded DER, leaf certificate first)
+// and returns the leaf certificate.
+func (s *TrustStore) Verify(x5c []string) (*x509.Certificate, error) {
+	certs, err := ParseChain(x5c)
+	if err != nil {
+		return nil, err
+	}
+
+	intermediates := x509.NewCertPool()
+
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	chains, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         s.roots,
+		Intermediates: intermediates,
+		CurrentTime:   s.now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("verify certificate chain: %w", err)
+	}
+
+	for _, chain := range chains {
+		if err = s.checkRevocation(chain); err != nil {
+			return nil, err
+		}
+	}
+
+	return certs[0], nil
+}
+
+// checkRevocation checks every certificate of the verified chain, except the trust anchor,
+// against CRLs signed by its issuer.
+func (s *TrustStore) checkRevocation(chain []*x509.Certificate) error {
+	for i := 0; i < len(chain)-1; i++ {
+		cert, issuer := chain[i], chain[i+1]
+
+		for _, crl := range s.crls {
+			if !bytes.Equal(crl.RawIssuer, cert.RawIssuer) || crl.CheckSignatureFrom(issuer) != nil {
+				continue
+			}
+
+			for _, revoked := range crl.RevokedCertificateEntries {
+				if revoked.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+					return fmt.Errorf("%w: %s", ErrCertificateRevoked, cert.Subject)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// ParseChain parses certificate chain in "x5c" format.
+func ParseChain(x5c []string) ([]*x509.Certificate, error) {
+	if len(x5c) == 0 {
+		return nil, errors.New("empty certificate chain")
+	}
+
+	certs := make([]*x509.Certificate, 0, len(x5c))
+
+	for _, c := range x5c {
+		der, err := base64.StdEncoding.DecodeString(c)
+		if err != nil {
+			return nil, fmt.Errorf("decode certificate: %w", err)
+		}
+
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("parse certificate: %w", err)
+		}
+
+		certs = append(certs, cert)
+	}
+
+	return certs, nil
+}
+
+// LoadChain reads PEM encoded certificate chain (leaf certificate first) and returns it in "x5c" format.
+func LoadChain(file string) ([]string, error) {
+	blocks, err := readBlocks(file, pemTypeCertificate)
+	if err != nil {
+		return nil, err
+	}
+
+	x5c := make([]string, 0, len(blocks))
+
+	for _, b := range blocks {
+		if _, err = x509.ParseCertificate(b); err != nil {
+			return nil, fmt.Errorf("parse certificate %s: %w", file, err)
+		}
+
+		x5c = append(x5c, base64.StdEncoding.EncodeToString(b))
+	}
+
+	return x5c, nil
+}
+
+// readBlocks returns DER bytes of PEM blocks of the given type. Files without PEM blocks are treated as DER.
+func readBlocks(file, blockType string) ([][]byte, error) {
+	data, err := os.ReadFile(filepath.Clean(file))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", file, err)
+	}
+
+	var blocks [][]byte
+
+	rest := data
+
+	for {
+		var block *pem.Block
+
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type == blockType {
+			blocks = append(blocks, block.Bytes)
+		}
+	}
+
+	if len(blocks) == 0 && !bytes.Contains(data, []byte("-----BEGIN")) {
+		blocks = append(blocks, data)
+	}
+
+	if len(blocks) == 0 {
+		return nil, fmt.Errorf("no %s found in %s", blockType, file)
+	}
+
+	return blocks, nil
+}
@@ -0,0 +1,294 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package x5c_test
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/vcs/pkg/doc/vc/x5c"
+)
+
+const issuerHost = "issuer.example.com"
+
+type testCert struct {
+	cert *x509.Certificate
+	key  ed25519.PrivateKey
+}
+
+type testPKI struct {
+	root         *testCert
+	intermediate *testCert
+	leaf         *testCert
+}
+
+func (p *testPKI) chain() []string {
+	return []string{
+		base64.StdEncoding.EncodeToString(p.leaf.cert.Raw),
+		base64.StdEncoding.EncodeToString(p.intermediate.cert.Raw),
+	}
+}
+
+func TestTrustStore_Verify(t *testing.T) {
+	pki := newTestPKI(t)
+
+	t.Run("success", func(t *testing.T) {
+		ts := x5c.NewTrustStore([]*x509.Certificate{pki.root.cert}, nil)
+
+		leaf, err := ts.Verify(pki.chain())
+		require.NoError(t, err)
+		require.Equal(t, pki.leaf.cert.SerialNumber, leaf.SerialNumber)
+	})
+
+	t.Run("unknown trust anchor", func(t *testing.T) {
+		other := newTestPKI(t)
+
+		ts := x5c.NewTrustStore([]*x509.Certificate{other.root.cert}, nil)
+
+		_, err := ts.Verify(pki.chain())
+		require.ErrorContains(t, err, "verify certificate chain")
+	})
+
+	t.Run("revoked leaf", func(t *testing.T) {
+		crl := newCRL(t, pki.intermediate, pki.leaf.cert.SerialNumber)
+
+		ts := x5c.NewTrustStore([]*x509.Certificate{pki.root.cert}, []*x509.RevocationList{crl})
+
+		_, err := ts.Verify(pki.chain())
+		require.ErrorIs(t, err, x5c.ErrCertificateRevoked)
+	})
+
+	t.Run("revoked intermediate", func(t *testing.T) {
+		crl := newCRL(t, pki.root, pki.intermediate.cert.SerialNumber)
+
+		ts := x5c.NewTrustStore([]*x509.Certificate{pki.root.cert}, []*x509.RevocationList{crl})
+
+		_, err := ts.Verify(pki.chain())
+		require.ErrorIs(t, err, x5c.ErrCertificateRevoked)
+	})
+
+	t.Run("crl of another issuer is ignored", func(t *testing.T) {
+		other := newTestPKI(t)
+		crl := newCRL(t, other.intermediate, pki.leaf.cert.SerialNumber)
+
+		ts := x5c.NewTrustStore([]*x509.Certificate{pki.root.cert}, []*x509.RevocationList{crl})
+
+		_, err := ts.Verify(pki.chain())
+		require.NoError(t, err)
+	})
+
+	t.Run("empty chain", func(t *testing.T) {
+		ts := x5c.NewTrustStore([]*x509.Certificate{pki.root.cert}, nil)
+
+		_, err := ts.Verify(nil)
+		require.ErrorContains(t, err, "empty certificate chain")
+	})
+
+	t.Run("invalid certificate", func(t *testing.T) {
+		ts := x5c.NewTrustStore([]*x509.Certificate{pki.root.cert}, nil)
+
+		_, err := ts.Verify([]string{"!"})
+		require.ErrorContains(t, err, "decode certificate")
+
+		_, err = ts.Verify([]string{base64.StdEncoding.EncodeToString([]byte("invalid"))})
+		require.ErrorContains(t, err, "parse certificate")
+	})
+}
+
+func TestLoadTrustStore(t *testing.T) {
+	pki := newTestPKI(t)
+	dir := t.TempDir()
+
+	rootFile := writePEM(t, dir, "root.pem", "CERTIFICATE", pki.root.cert.Raw)
+	crlFile := writePEM(t, dir, "crl.pem", "X509 CRL", newCRL(t, pki.intermediate, pki.leaf.cert.SerialNumber).Raw)
+
+	derRootFile := filepath.Join(dir, "root.der")
+	require.NoError(t, os.WriteFile(derRootFile, pki.root.cert.Raw, 0o600))
+
+	t.Run("success", func(t *testing.T) {
+		ts, err := x5c.LoadTrustStore([]string{rootFile}, nil)
+		require.NoError(t, err)
+
+		_, err = ts.Verify(pki.chain())
+		require.NoError(t, err)
+	})
+
+	t.Run("success DER", func(t *testing.T) {
+		ts, err := x5c.LoadTrustStore([]string{derRootFile}, nil)
+		require.NoError(t, err)
+
+		_, err = ts.Verify(pki.chain())
+		require.NoError(t, err)
+	})
+
+	t.Run("with crl", func(t *testing.T) {
+		ts, err := x5c.LoadTrustStore([]string{rootFile}, []string{crlFile})
+		require.NoError(t, err)
+
+		_, err = ts.Verify(pki.chain())
+		require.ErrorIs(t, err, x5c.ErrCertificateRevoked)
+	})
+
+	t.Run("file not found", func(t *testing.T) {
+		_, err := x5c.LoadTrustStore([]string{filepath.Join(dir, "missing.pem")}, nil)
+		require.ErrorContains(t, err, "read")
+
+		_, err = x5c.LoadTrustStore(nil, []string{filepath.Join(dir, "missing.pem")})
+		require.ErrorContains(t, err, "read")
+	})
+
+	t.Run("no certificate in file", func(t *testing.T) {
+		_, err := x5c.LoadTrustStore([]string{crlFile}, nil)
+		require.ErrorContains(t, err, "no CERTIFICATE found")
+	})
+
+	t.Run("invalid trust anchor", func(t *testing.T) {
+		f := writePEM(t, dir, "invalid.pem", "CERTIFICATE", []byte("invalid"))
+
+		_, err := x5c.LoadTrustStore([]string{f}, nil)
+		require.ErrorContains(t, err, "parse trust anchor")
+	})
+
+	t.Run("invalid crl", func(t *testing.T) {
+		f := writePEM(t, dir, "invalid-crl.pem", "X509 CRL", []byte("invalid"))
+
+		_, err := x5c.LoadTrustStore(nil, []string{f})
+		require.ErrorContains(t, err, "parse crl")
+	})
+}
+
+func TestLoadChain(t *testing.T) {
+	pki := newTestPKI(t)
+	dir := t.TempDir()
+
+	f := filepath.Join(dir, "chain.pem")
+	data := append(
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: pki.leaf.cert.Raw}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: pki.intermediate.cert.Raw})...,
+	)
+	require.NoError(t, os.WriteFile(f, data, 0o600))
+
+	t.Run("success", func(t *testing.T) {
+		chain, err := x5c.LoadChain(f)
+		require.NoError(t, err)
+		require.Equal(t, pki.chain(), chain)
+	})
+
+	t.Run("invalid certificate", func(t *testing.T) {
+		invalid := writePEM(t, dir, "invalid.pem", "CERTIFICATE", []byte("invalid"))
+
+		_, err := x5c.LoadChain(invalid)
+		require.ErrorContains(t, err, "parse certificate")
+	})
+
+	t.Run("file not found", func(t *testing.T) {
+		_, err := x5c.LoadChain(filepath.Join(dir, "missing.pem"))
+		require.Error(t, err)
+	})
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	t.Helper()
+
+	root := newCert(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "Test Root CA"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}, nil)
+
+	intermediate := newCert(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "Test Intermediate CA"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}, root)
+
+	leaf := newCert(t, &x509.Certificate{
+		Subject:  pkix.Name{CommonName: "Test Issuer"},
+		DNSNames: []string{issuerHost},
+		URIs:     []*url.URL{{Scheme: "did", Opaque: "web:" + issuerHost}},
+		KeyUsage: x509.KeyUsageDigitalSignature,
+	}, intermediate)
+
+	return &testPKI{
+		root:         root,
+		intermediate: intermediate,
+		leaf:         leaf,
+	}
+}
+
+func newCert(t *testing.T, template *x509.Certificate, parent *testCert) *testCert {
+	t.Helper()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+
+	template.SerialNumber = serial
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+
+	parentCert, parentKey := template, priv
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, pub, crypto.Signer(parentKey))
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCert{cert: cert, key: priv}
+}
+
+func newCRL(t *testing.T, issuer *testCert, revoked ...*big.Int) *x509.RevocationList {
+	t.Helper()
+
+	entries := make([]x509.RevocationListEntry, 0, len(revoked))
+	for _, serial := range revoked {
+		entries = append(entries, x509.RevocationListEntry{SerialNumber: serial, RevocationTime: time.Now()})
+	}
+
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    big.NewInt(1),
+		ThisUpdate:                time.Now().Add(-time.Hour),
+		NextUpdate:                time.Now().Add(time.Hour),
+		RevokedCertificateEntries: entries,
+	}, issuer.cert, issuer.key)
+	require.NoError(t, err)
+
+	crl, err := x509.ParseRevocationList(der)
+	require.NoError(t, err)
+
+	return crl
+}
+
+func writePEM(t *testing.T, dir, name, blockType string, der []byte) string {
+	t.Helper()
+
+	f := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(f, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+
+	return f
+}
@@ -0,0 +1,66 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import "fmt"
+
+// DataModelVersion is the version of W3C Verifiable Credentials Data Model the credentials are issued in.
+type DataModelVersion string
+
+const (
+	// DataModelV1 is VC Data Model v1.1: https://www.w3.org/TR/vc-data-model/
+	DataModelV1 DataModelVersion = "v1.1"
+	// DataModelV2 is VC Data Model v2.0: https://www.w3.org/TR/vc-data-model-2.0/
+	DataModelV2 DataModelVersion = "v2.0"
+)
+
+// ResolveDataModelVersion returns the first defined version in the order of precedence
+// (e.g. credential template, then profile). VC Data Model v1.1 is used by default.
+func ResolveDataModelVersion(versions ...DataModelVersion) DataModelVersion {
+	for _, v := range versions {
+		if v != "" {
+			return v
+		}
+	}
+
+	return DataModelV1
+}
+
+// ValidateDataModelVersion checks that the version is supported. Empty version stands for the default one.
+func ValidateDataModelVersion(version DataModelVersion) error {
+	switch version {
+	case "", DataModelV1, DataModelV2:
+		return nil
+	default:
+		return fmt.Errorf("unsupported data model version %q", version)
+	}
+}
+
+// SecuringMechanism is the mechanism VC Data Model v2.0 credentials of jwt format are secured with.
+type SecuringMechanism string
+
+const (
+	// JOSE secures credentials as vc+jwt: https://www.w3.org/TR/vc-jose-cose/#securing-with-jose
+	JOSE SecuringMechanism = "jose"
+	// COSE secures credentials as vc+cose: https://www.w3.org/TR/vc-jose-cose/#securing-with-cose
+	COSE SecuringMechanism = "cose"
+)
+
+// EnvelopedCredentialType is the type of the credential which envelopes a credential secured with
+// JOSE or COSE: https://www.w3.org/TR/vc-data-model-2.0/#enveloped-verifiable-credentials
+const EnvelopedCredentialType = "EnvelopedVerifiableCredential"
+
+// ValidateSecuringMechanism checks that the securing mechanism is supported.
+// Empty mechanism stands for the default one (JOSE).
+func ValidateSecuringMechanism(mechanism SecuringMechanism) error {
+	switch mechanism {
+	case "", JOSE, COSE:
+		return nil
+	default:
+		return fmt.Errorf("unsupported securing mechanism %q", mechanism)
+	}
+}
@@ -0,0 +1,34 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveDataModelVersion(t *testing.T) {
+	require.Equal(t, DataModelV1, ResolveDataModelVersion())
+	require.Equal(t, DataModelV1, ResolveDataModelVersion("", ""))
+	require.Equal(t, DataModelV2, ResolveDataModelVersion("", DataModelV2))
+	require.Equal(t, DataModelV1, ResolveDataModelVersion(DataModelV1, DataModelV2))
+}
+
+func TestValidateDataModelVersion(t *testing.T) {
+	require.NoError(t, ValidateDataModelVersion(""))
+	require.NoError(t, ValidateDataModelVersion(DataModelV1))
+	require.NoError(t, ValidateDataModelVersion(DataModelV2))
+	require.ErrorContains(t, ValidateDataModelVersion("v3"), "unsupported data model version")
+}
+
+func TestValidateSecuringMechanism(t *testing.T) {
+	require.NoError(t, ValidateSecuringMechanism(""))
+	require.NoError(t, ValidateSecuringMechanism(JOSE))
+	require.NoError(t, ValidateSecuringMechanism(COSE))
+	require.ErrorContains(t, ValidateSecuringMechanism("sd-jwt"), "unsupported securing mechanism")
+}
@@ -41,8 +41,14 @@ func ValidateFormat(data interface{}, formats []Format) ([]byte, error) {
 	}
 
 	if !isStr {
-		if !isFormatSupported(Ldp, formats) {
-			return nil, fmt.Errorf("invalid format, should be %s", Ldp)
+		// Enveloped credentials are secured as a whole, like JWT ones (see SecuringMechanism).
+		format := Ldp
+		if isEnvelopedCredential(data) {
+			format = Jwt
+		}
+
+		if !isFormatSupported(format, formats) {
+			return nil, fmt.Errorf("invalid format, should be %s", format)
 		}
 
 		var err error
@@ -56,6 +62,26 @@ func ValidateFormat(data interface{}, formats []Format) ([]byte, error) {
 	return dataBytes, nil
 }
 
+func isEnvelopedCredential(data interface{}) bool {
+	m, ok := data.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	switch t := m["type"].(type) {
+	case string:
+		return t == EnvelopedCredentialType
+	case []interface{}:
+		for _, v := range t {
+			if v == EnvelopedCredentialType {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func isFormatSupported(format Format, supportedFormats []Format) bool {
 	for _, supported := range supportedFormats {
 		if format == supported {
@@ -58,6 +58,24 @@ func TestValidateFormat(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "OK enveloped credential",
+			args: args{
+				data:    map[string]interface{}{"type": []interface{}{EnvelopedCredentialType}},
+				formats: []Format{Jwt},
+			},
+			want:    []byte(`{"type":["EnvelopedVerifiableCredential"]}`),
+			wantErr: false,
+		},
+		{
+			name: "Error enveloped credential",
+			args: args{
+				data:    map[string]interface{}{"type": EnvelopedCredentialType},
+				formats: []Format{Ldp},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "Error invalid data",
 			args: args{
@@ -31,6 +31,8 @@ type EventType string
 const (
 	// VerifierOIDCInteractionInitiated verifier oidc event.
 	VerifierOIDCInteractionInitiated EventType = "verifier.oidc-interaction-initiated.v1"
+	// VerifierOIDCInteractionRequestObjectFetched verifier oidc event.
+	VerifierOIDCInteractionRequestObjectFetched EventType = "verifier.oidc-interaction-request-object-fetched.v1"
 	// VerifierOIDCInteractionQRScanned verifier oidc event.
 	VerifierOIDCInteractionQRScanned EventType = "verifier.oidc-interaction-qr-scanned.v1"
 	// VerifierOIDCInteractionSucceeded verifier oidc event.
@@ -53,8 +55,12 @@ const (
 	IssuerOIDCInteractionAckFailed                    EventType = "issuer.oidc-interaction-ack-failed.v1"
 	IssuerOIDCInteractionAckRejected                  EventType = "issuer.oidc-interaction-ack-rejected.v1"
 	IssuerOIDCInteractionAckExpired                   EventType = "issuer.oidc-interaction-ack-expired.v1"
+	IssuerOIDCInteractionCancelled                    EventType = "issuer.oidc-interaction-cancelled.v1"
 
 	CredentialStatusStatusUpdated EventType = "issuer.credential-status-updated.v1" //nolint:gosec
+
+	// IssuerSigningKeyRotated Issuer profile signing key rotated event.
+	IssuerSigningKeyRotated EventType = "issuer.signing-key-rotated.v1"
 )
 
 // Payload defines payload.
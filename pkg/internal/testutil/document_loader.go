@@ -35,6 +35,8 @@ var (
 	vcDataIntegrity []byte
 	//go:embed contexts/wallet_attestation_vc_v1.jsonld
 	walletAttestationVC []byte
+	//go:embed contexts/credentials-v2.jsonld
+	credentialsV2 []byte
 )
 
 type mockLDStoreProvider struct {
@@ -93,6 +95,10 @@ func DocumentLoader(t *testing.T, extraContexts ...ldcontext.Document) *ld.Docum
 			URL:     "https://www.w3.org/2022/credentials/walletAttestation/v1",
 			Content: walletAttestationVC,
 		},
+		ldcontext.Document{
+			URL:     "https://www.w3.org/ns/credentials/v2",
+			Content: credentialsV2,
+		},
 	}
 
 	loader, err := ld.NewDocumentLoader(ldStore,
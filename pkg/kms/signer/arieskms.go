@@ -83,6 +83,21 @@ func (s *KMSSigner) Sign(data []byte) ([]byte, error) {
 	return v, nil
 }
 
+// SignMulti signs each of the messages with a single BBS+ signature.
+func (s *KMSSigner) SignMulti(msgs [][]byte) ([]byte, error) {
+	startTime := time.Now()
+
+	defer func() {
+		s.metrics.SignTime(time.Since(startTime))
+	}()
+
+	if s.multiSigner == nil {
+		return nil, errors.New("signer was not initialized with BBS support")
+	}
+
+	return s.multiSigner.SignMulti(msgs)
+}
+
 func (s *KMSSigner) Alg() string {
 	return s.signatureType.Name()
 }
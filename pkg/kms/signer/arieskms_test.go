@@ -139,6 +139,52 @@ func TestKMSSigner_Sign(t *testing.T) {
 	}
 }
 
+func TestKMSSigner_SignMulti(t *testing.T) {
+	tests := []struct {
+		name        string
+		multiSigner *mockwrapper.MockFixedKeyCrypto
+		want        []byte
+		wantErr     bool
+	}{
+		{
+			name:        "OK",
+			multiSigner: &mockwrapper.MockFixedKeyCrypto{SignVal: []byte("signed")},
+			want:        []byte("signed"),
+		},
+		{
+			name:        "Error",
+			multiSigner: &mockwrapper.MockFixedKeyCrypto{SignErr: errors.New("some error")},
+			wantErr:     true,
+		},
+		{
+			name:    "No BBS support",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &KMSSigner{
+				metrics: &noopMetricsProvider.NoMetrics{},
+			}
+
+			if tt.multiSigner != nil {
+				s.multiSigner = tt.multiSigner
+			}
+
+			got, err := s.SignMulti([][]byte{[]byte("msg1"), []byte("msg2")})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SignMulti() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SignMulti() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestKMSSigner_textToLines(t *testing.T) {
 	type args struct {
 		txt string
@@ -20,6 +20,8 @@ import (
 var (
 	//go:embed contexts/lds-jws2020-v1.jsonld
 	jws2020V1Vocab []byte
+	//go:embed contexts/credentials-v2.jsonld
+	credentialsV2Vocab []byte
 )
 
 var embedContexts = []ldcontext.Document{ //nolint:gochecknoglobals
@@ -27,6 +29,10 @@ var embedContexts = []ldcontext.Document{ //nolint:gochecknoglobals
 		URL:     "https://w3c-ccg.github.io/lds-jws2020/contexts/lds-jws2020-v1.json",
 		Content: jws2020V1Vocab,
 	},
+	{
+		URL:     "https://www.w3.org/ns/credentials/v2",
+		Content: credentialsV2Vocab,
+	},
 }
 
 // provider contains dependencies for the JSON-LD document loader.
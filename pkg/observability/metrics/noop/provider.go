@@ -24,6 +24,11 @@ func GetMetrics() metrics.Metrics {
 func (n *NoMetrics) SignTime(_ time.Duration)                             {}
 func (n *NoMetrics) CheckAuthorizationResponseTime(_ time.Duration)       {}
 func (n *NoMetrics) VerifyOIDCVerifiablePresentationTime(_ time.Duration) {}
+func (n *NoMetrics) CSLPublishLag(_ time.Duration)                        {}
+func (n *NoMetrics) CSLCacheHit()                                         {}
+func (n *NoMetrics) CSLCacheMiss()                                        {}
+func (n *NoMetrics) VDRCacheHit(_ string)                                 {}
+func (n *NoMetrics) VDRCacheMiss(_ string)                                {}
 
 // InstrumentHTTPTransport simply returns the provided transport.
 func (n *NoMetrics) InstrumentHTTPTransport(_ metrics.ClientID, transport http.RoundTripper) http.RoundTripper {
@@ -24,6 +24,11 @@ func TestMetrics(t *testing.T) {
 		require.NotPanics(t, func() { m.SignTime(time.Second) })
 		require.NotPanics(t, func() { m.CheckAuthorizationResponseTime(time.Second) })
 		require.NotPanics(t, func() { m.VerifyOIDCVerifiablePresentationTime(time.Second) })
+		require.NotPanics(t, func() { m.CSLPublishLag(time.Second) })
+		require.NotPanics(t, func() { m.CSLCacheHit() })
+		require.NotPanics(t, func() { m.CSLCacheMiss() })
+		require.NotPanics(t, func() { m.VDRCacheHit("web") })
+		require.NotPanics(t, func() { m.VDRCacheMiss("web") })
 	})
 }
 
@@ -24,12 +24,13 @@ import (
 var logger = metrics.Logger
 
 const (
-	clientIDLabel = "clientID"
-	codeLabel     = "code"
-	methodLabel   = "method"
-	versionLabel  = "version"
-	scopeLabel    = "scope"
-	domainLabel   = "domain"
+	clientIDLabel  = "clientID"
+	codeLabel      = "code"
+	methodLabel    = "method"
+	didMethodLabel = "didMethod"
+	versionLabel   = "version"
+	scopeLabel     = "scope"
+	domainLabel    = "domain"
 )
 
 var (
@@ -105,6 +106,11 @@ type PromMetrics struct {
 	signTime          prometheus.Histogram
 	checkAuthRespTime prometheus.Histogram
 	verifyOIDCVPTime  prometheus.Histogram
+	cslPublishLag     prometheus.Histogram
+	cslCacheHits      prometheus.Counter
+	cslCacheMisses    prometheus.Counter
+	vdrCacheHits      *prometheus.CounterVec
+	vdrCacheMisses    *prometheus.CounterVec
 }
 
 // NewMetrics creates instance of prometheus metrics.
@@ -132,6 +138,11 @@ func NewMetrics(
 		signTime:          newSignTime(version, domain, scope),
 		checkAuthRespTime: newCheckAuthRespTime(version, domain, scope),
 		verifyOIDCVPTime:  newVerifyOIDCVPTime(version, domain, scope),
+		cslPublishLag:     newCSLPublishLag(version, domain, scope),
+		cslCacheHits:      newCSLCacheHits(version, domain, scope),
+		cslCacheMisses:    newCSLCacheMisses(version, domain, scope),
+		vdrCacheHits:      newVDRCacheHits(version, domain, scope),
+		vdrCacheMisses:    newVDRCacheMisses(version, domain, scope),
 	}
 
 	pm.register()
@@ -159,6 +170,33 @@ func (pm *PromMetrics) VerifyOIDCVerifiablePresentationTime(value time.Duration)
 	logger.Debug("VerifyOIDCVerifiablePresentation service call time", log.WithDuration(value))
 }
 
+// CSLPublishLag records the time between the credential status change and the publishing of updated CSL.
+func (pm *PromMetrics) CSLPublishLag(value time.Duration) {
+	pm.cslPublishLag.Observe(value.Seconds())
+
+	logger.Debug("CSL publish lag", log.WithDuration(value))
+}
+
+// CSLCacheHit increments the number of status list cache hits.
+func (pm *PromMetrics) CSLCacheHit() {
+	pm.cslCacheHits.Inc()
+}
+
+// CSLCacheMiss increments the number of status list cache misses.
+func (pm *PromMetrics) CSLCacheMiss() {
+	pm.cslCacheMisses.Inc()
+}
+
+// VDRCacheHit increments the number of DID resolutions served from the cache.
+func (pm *PromMetrics) VDRCacheHit(method string) {
+	pm.vdrCacheHits.WithLabelValues(method).Inc()
+}
+
+// VDRCacheMiss increments the number of DID resolutions not found in the cache.
+func (pm *PromMetrics) VDRCacheMiss(method string) {
+	pm.vdrCacheMisses.WithLabelValues(method).Inc()
+}
+
 // InstrumentHTTPTransport instruments the given HTTP transport with metrics such as
 // request duration, number of in-flight requests, etc.
 func (pm *PromMetrics) InstrumentHTTPTransport(id metrics.ClientID, transport http.RoundTripper) http.RoundTripper {
@@ -179,7 +217,9 @@ func (pm *PromMetrics) InstrumentHTTPTransport(id metrics.ClientID, transport ht
 
 func (pm *PromMetrics) register() {
 	prometheus.MustRegister(
-		pm.signTime, pm.checkAuthRespTime, pm.verifyOIDCVPTime,
+		pm.signTime, pm.checkAuthRespTime, pm.verifyOIDCVPTime, pm.cslPublishLag,
+		pm.cslCacheHits, pm.cslCacheMisses,
+		pm.vdrCacheHits, pm.vdrCacheMisses,
 	)
 
 	for _, m := range pm.httpInFlight {
@@ -294,6 +334,86 @@ func newVerifyOIDCVPTime(
 	)
 }
 
+func newCSLPublishLag(
+	version string,
+	domain string,
+	scope string,
+) prometheus.Histogram {
+	return newHistogram(
+		metrics.CredentialStatus, metrics.CredentialStatusPublishLagMetric,
+		"The time (in seconds) between the credential status change and the publishing of updated CSL.",
+		prometheus.Labels{
+			versionLabel: version,
+			domainLabel:  domain,
+			scopeLabel:   scope,
+		},
+	)
+}
+
+func newCSLCacheHits(
+	version string,
+	domain string,
+	scope string,
+) prometheus.Counter {
+	return newCounter(
+		metrics.CredentialStatus, metrics.CredentialStatusCacheHitsMetric,
+		"The number of status list VCs resolved from the cache.",
+		prometheus.Labels{
+			versionLabel: version,
+			domainLabel:  domain,
+			scopeLabel:   scope,
+		},
+	)
+}
+
+func newCSLCacheMisses(
+	version string,
+	domain string,
+	scope string,
+) prometheus.Counter {
+	return newCounter(
+		metrics.CredentialStatus, metrics.CredentialStatusCacheMissMetric,
+		"The number of status list VCs fetched from the remote source.",
+		prometheus.Labels{
+			versionLabel: version,
+			domainLabel:  domain,
+			scopeLabel:   scope,
+		},
+	)
+}
+
+func newVDRCacheHits(
+	version string,
+	domain string,
+	scope string,
+) *prometheus.CounterVec {
+	return newCounterVec(
+		metrics.VDR, metrics.VDRCacheHitsMetric,
+		"The number of DID resolutions served from the cache.",
+		prometheus.Labels{
+			versionLabel: version,
+			domainLabel:  domain,
+			scopeLabel:   scope,
+		}, didMethodLabel,
+	)
+}
+
+func newVDRCacheMisses(
+	version string,
+	domain string,
+	scope string,
+) *prometheus.CounterVec {
+	return newCounterVec(
+		metrics.VDR, metrics.VDRCacheMissesMetric,
+		"The number of DID resolutions not found in the cache.",
+		prometheus.Labels{
+			versionLabel: version,
+			domainLabel:  domain,
+			scopeLabel:   scope,
+		}, didMethodLabel,
+	)
+}
+
 func newHTTPClientInFlightRequests(
 	clients []metrics.ClientID,
 	version string,
@@ -33,6 +33,17 @@ const (
 	Service      = "service"
 	VerifyOIDCVP = "service_verifyOIDCVerifiablePresentation_seconds"
 
+	// CredentialStatus credential status list operations.
+	CredentialStatus                 = "credentialstatus"
+	CredentialStatusPublishLagMetric = "csl_publish_lag_seconds"
+	CredentialStatusCacheHitsMetric  = "csl_cache_hits_total"
+	CredentialStatusCacheMissMetric  = "csl_cache_misses_total"
+
+	// VDR DID resolution operations.
+	VDR                  = "vdr"
+	VDRCacheHitsMetric   = "cache_hits_total"
+	VDRCacheMissesMetric = "cache_misses_total"
+
 	// HTTPServer HTTP server subsystem.
 	HTTPServer = "httpserver"
 
@@ -83,6 +94,11 @@ type Metrics interface {
 	SignTime(value time.Duration)
 	CheckAuthorizationResponseTime(value time.Duration)
 	VerifyOIDCVerifiablePresentationTime(value time.Duration)
+	CSLPublishLag(value time.Duration)
+	CSLCacheHit()
+	CSLCacheMiss()
+	VDRCacheHit(method string)
+	VDRCacheMiss(method string)
 
 	InstrumentHTTPTransport(ClientID, http.RoundTripper) http.RoundTripper
 }
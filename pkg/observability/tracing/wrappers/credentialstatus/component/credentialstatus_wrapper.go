@@ -119,3 +119,20 @@ func (w *Wrapper) Resolve(ctx context.Context, statusListVCURI string) (*verifia
 
 	return vc, nil
 }
+
+func (w *Wrapper) ListStatusLists(
+	ctx context.Context,
+	profileGroupID profileapi.ID,
+) ([]*credentialstatus.CSLInfo, error) {
+	ctx, span := w.tracer.Start(ctx, "credentialstatus.ListStatusLists")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("profile_group_id", profileGroupID))
+
+	cslInfos, err := w.svc.ListStatusLists(ctx, profileGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cslInfos, nil
+}
@@ -85,3 +85,15 @@ func TestWrapper_Resolve(t *testing.T) {
 	_, err := w.Resolve(context.Background(), "statusListVCURI")
 	require.NoError(t, err)
 }
+
+func TestWrapper_ListStatusLists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := NewMockService(ctrl)
+	svc.EXPECT().ListStatusLists(gomock.Any(), profileID).Times(1)
+
+	w := Wrap(svc, trace.NewNoopTracerProvider().Tracer(""))
+
+	_, err := w.ListStatusLists(context.Background(), profileID)
+	require.NoError(t, err)
+}
@@ -42,7 +42,12 @@ func (w *Wrapper) InitiateIssuance(
 	defer span.End()
 
 	span.SetAttributes(attribute.String("profile_id", profile.ID))
-	span.SetAttributes(attributeutil.JSON("initiate_issuance_request", req, attributeutil.WithRedacted("ClaimData")))
+	span.SetAttributes(attributeutil.JSON("initiate_issuance_request", req,
+		attributeutil.WithRedacted("ClaimData"),
+		attributeutil.WithRedacted("CredentialConfiguration.#.claim_data"),
+		attributeutil.WithRedacted("TxCodeDelivery.Email"),
+		attributeutil.WithRedacted("TxCodeDelivery.Phone"),
+	))
 
 	for _, credConfig := range req.CredentialConfiguration {
 		if len(credConfig.ClaimData) > 0 { //nolint:staticcheck
@@ -111,3 +116,25 @@ func (w *Wrapper) PrepareCredential(
 
 	return res, nil
 }
+
+func (w *Wrapper) GetTransaction(ctx context.Context, profile *profileapi.Issuer, txID oidc4ci.TxID) (*oidc4ci.Transaction, error) {
+	return w.svc.GetTransaction(ctx, profile, txID)
+}
+
+func (w *Wrapper) ListTransactions(ctx context.Context, profile *profileapi.Issuer) ([]*oidc4ci.Transaction, error) {
+	return w.svc.ListTransactions(ctx, profile)
+}
+
+func (w *Wrapper) CancelTransaction(
+	ctx context.Context,
+	profile *profileapi.Issuer,
+	txID oidc4ci.TxID,
+) (*oidc4ci.Transaction, error) {
+	ctx, span := w.tracer.Start(ctx, "oidc4ci.CancelTransaction")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("profile_id", profile.ID))
+	span.SetAttributes(attribute.String("tx_id", string(txID)))
+
+	return w.svc.CancelTransaction(ctx, profile, txID)
+}
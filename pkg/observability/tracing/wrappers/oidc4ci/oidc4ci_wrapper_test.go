@@ -13,6 +13,8 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/trustbloc/vcs/pkg/profile"
@@ -45,6 +47,46 @@ func TestWrapper_InitiateIssuance(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestWrapper_InitiateIssuance_RedactsPersonalData(t *testing.T) {
+	req := &oidc4ci.InitiateIssuanceRequest{
+		CredentialConfiguration: []oidc4ci.InitiateIssuanceCredentialConfiguration{
+			{
+				ClaimData: map[string]interface{}{"name": "Jane"},
+			},
+		},
+		TxCodeDelivery: &oidc4ci.TxCodeDelivery{
+			Email: "john@example.com",
+			Phone: "+15551234567",
+		},
+	}
+
+	svc := NewMockService(gomock.NewController(t))
+	svc.EXPECT().InitiateIssuance(gomock.Any(), req, gomock.Any()).Return(&oidc4ci.InitiateIssuanceResponse{}, nil)
+
+	recorder := tracetest.NewSpanRecorder()
+
+	w := Wrap(svc, sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer(""))
+
+	_, err := w.InitiateIssuance(context.Background(), req, &profile.Issuer{})
+	require.NoError(t, err)
+
+	require.Len(t, recorder.Ended(), 1)
+
+	var attr string
+
+	for _, kv := range recorder.Ended()[0].Attributes() {
+		if kv.Key == "initiate_issuance_request" {
+			attr = kv.Value.AsString()
+		}
+	}
+
+	require.NotEmpty(t, attr)
+
+	for _, personalData := range []string{"Jane", "john@example.com", "+15551234567"} {
+		require.NotContains(t, attr, personalData)
+	}
+}
+
 func TestWrapper_PushAuthorizationDetails(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -116,3 +158,39 @@ func TestWrapper_PrepareCredential(t *testing.T) {
 	_, err := w.PrepareCredential(context.Background(), &oidc4ci.PrepareCredential{})
 	require.NoError(t, err)
 }
+
+func TestWrapper_GetTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := NewMockService(ctrl)
+	svc.EXPECT().GetTransaction(gomock.Any(), &profile.Issuer{}, oidc4ci.TxID("id")).Times(1)
+
+	w := Wrap(svc, trace.NewNoopTracerProvider().Tracer(""))
+
+	_, err := w.GetTransaction(context.Background(), &profile.Issuer{}, "id")
+	require.NoError(t, err)
+}
+
+func TestWrapper_ListTransactions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := NewMockService(ctrl)
+	svc.EXPECT().ListTransactions(gomock.Any(), &profile.Issuer{}).Times(1)
+
+	w := Wrap(svc, trace.NewNoopTracerProvider().Tracer(""))
+
+	_, err := w.ListTransactions(context.Background(), &profile.Issuer{})
+	require.NoError(t, err)
+}
+
+func TestWrapper_CancelTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := NewMockService(ctrl)
+	svc.EXPECT().CancelTransaction(gomock.Any(), &profile.Issuer{}, oidc4ci.TxID("id")).Times(1)
+
+	w := Wrap(svc, trace.NewNoopTracerProvider().Tracer(""))
+
+	_, err := w.CancelTransaction(context.Background(), &profile.Issuer{}, "id")
+	require.NoError(t, err)
+}
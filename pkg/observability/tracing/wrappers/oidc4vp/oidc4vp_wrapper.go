@@ -38,6 +38,7 @@ func (w *Wrapper) InitiateOidcInteraction(
 	presentationDefinition *presexch.PresentationDefinition,
 	purpose string,
 	customScopes []string,
+	responseRedirectURI string,
 	profile *profileapi.Verifier) (*oidc4vp.InteractionInfo, error) {
 	ctx, span := w.tracer.Start(ctx, "oidc4vp.InitiateOidcInteraction")
 	defer span.End()
@@ -45,9 +46,11 @@ func (w *Wrapper) InitiateOidcInteraction(
 	span.SetAttributes(attribute.String("profile_id", profile.ID))
 	span.SetAttributes(attribute.String("purpose", purpose))
 	span.SetAttributes(attribute.StringSlice("custom_copes", customScopes))
+	span.SetAttributes(attribute.String("response_redirect_uri", responseRedirectURI))
 	span.SetAttributes(attributeutil.JSON("presentation_definition", presentationDefinition))
 
-	resp, err := w.svc.InitiateOidcInteraction(ctx, presentationDefinition, purpose, customScopes, profile)
+	resp, err := w.svc.InitiateOidcInteraction(
+		ctx, presentationDefinition, purpose, customScopes, responseRedirectURI, profile)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +58,7 @@ func (w *Wrapper) InitiateOidcInteraction(
 	return resp, nil
 }
 
-func (w *Wrapper) VerifyOIDCVerifiablePresentation(ctx context.Context, txID oidc4vp.TxID, authResponse *oidc4vp.AuthorizationResponseParsed) error {
+func (w *Wrapper) VerifyOIDCVerifiablePresentation(ctx context.Context, txID oidc4vp.TxID, authResponse *oidc4vp.AuthorizationResponseParsed) (*oidc4vp.AuthorizationResponseResult, error) {
 	ctx, span := w.tracer.Start(ctx, "oidc4vp.VerifyOIDCVerifiablePresentation")
 	defer span.End()
 
@@ -79,18 +82,28 @@ func (w *Wrapper) GetTx(ctx context.Context, id oidc4vp.TxID) (*oidc4vp.Transact
 	return tx, nil
 }
 
-func (w *Wrapper) RetrieveClaims(ctx context.Context, tx *oidc4vp.Transaction, profile *profileapi.Verifier) map[string]oidc4vp.CredentialMetadata {
+func (w *Wrapper) RetrieveClaims(ctx context.Context, tx *oidc4vp.Transaction, profile *profileapi.Verifier, locales []string) map[string]oidc4vp.CredentialMetadata {
 	ctx, span := w.tracer.Start(ctx, "oidc4vp.RetrieveClaims")
 	defer span.End()
 
 	span.SetAttributes(attribute.String("tx_id", string(tx.ID)))
 	span.SetAttributes(attributeutil.JSON("tx", tx, attributeutil.WithRedacted("ReceivedClaims.credentials")))
+	span.SetAttributes(attribute.StringSlice("locales", locales))
 
-	cm := w.svc.RetrieveClaims(ctx, tx, profile)
+	cm := w.svc.RetrieveClaims(ctx, tx, profile, locales)
 
 	return cm
 }
 
+func (w *Wrapper) RequestObjectFetched(ctx context.Context, txID oidc4vp.TxID) error {
+	ctx, span := w.tracer.Start(ctx, "oidc4vp.RequestObjectFetched")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("tx_id", string(txID)))
+
+	return w.svc.RequestObjectFetched(ctx, txID)
+}
+
 func (w *Wrapper) DeleteClaims(ctx context.Context, claimsID string) error {
 	ctx, span := w.tracer.Start(ctx, "oidc4vp.DeleteClaims")
 	defer span.End()
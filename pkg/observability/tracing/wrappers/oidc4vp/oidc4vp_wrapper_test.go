@@ -24,11 +24,11 @@ func TestWrapper_InitiateOidcInteraction(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	svc := NewMockService(ctrl)
-	svc.EXPECT().InitiateOidcInteraction(gomock.Any(), &presexch.PresentationDefinition{}, "purpose", []string{"additionalScope"}, &profileapi.Verifier{}).Times(1)
+	svc.EXPECT().InitiateOidcInteraction(gomock.Any(), &presexch.PresentationDefinition{}, "purpose", []string{"additionalScope"}, "https://verifier.example.com/cb", &profileapi.Verifier{}).Times(1)
 
 	w := Wrap(svc, trace.NewNoopTracerProvider().Tracer(""))
 
-	_, err := w.InitiateOidcInteraction(context.Background(), &presexch.PresentationDefinition{}, "purpose", []string{"additionalScope"}, &profileapi.Verifier{})
+	_, err := w.InitiateOidcInteraction(context.Background(), &presexch.PresentationDefinition{}, "purpose", []string{"additionalScope"}, "https://verifier.example.com/cb", &profileapi.Verifier{})
 	require.NoError(t, err)
 }
 
@@ -40,7 +40,7 @@ func TestWrapper_VerifyOIDCVerifiablePresentation(t *testing.T) {
 
 	w := Wrap(svc, trace.NewNoopTracerProvider().Tracer(""))
 
-	err := w.VerifyOIDCVerifiablePresentation(context.Background(), "txID", &oidc4vp.AuthorizationResponseParsed{VPTokens: []*oidc4vp.ProcessedVPToken{}})
+	_, err := w.VerifyOIDCVerifiablePresentation(context.Background(), "txID", &oidc4vp.AuthorizationResponseParsed{VPTokens: []*oidc4vp.ProcessedVPToken{}})
 	require.NoError(t, err)
 }
 
@@ -60,11 +60,11 @@ func TestWrapper_RetrieveClaims(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	svc := NewMockService(ctrl)
-	svc.EXPECT().RetrieveClaims(gomock.Any(), &oidc4vp.Transaction{}, &profileapi.Verifier{}).Times(1)
+	svc.EXPECT().RetrieveClaims(gomock.Any(), &oidc4vp.Transaction{}, &profileapi.Verifier{}, []string{"en"}).Times(1)
 
 	w := Wrap(svc, trace.NewNoopTracerProvider().Tracer(""))
 
-	_ = w.RetrieveClaims(context.Background(), &oidc4vp.Transaction{}, &profileapi.Verifier{})
+	_ = w.RetrieveClaims(context.Background(), &oidc4vp.Transaction{}, &profileapi.Verifier{}, []string{"en"})
 }
 
 func TestWrapper_DeleteClaims(t *testing.T) {
@@ -77,3 +77,14 @@ func TestWrapper_DeleteClaims(t *testing.T) {
 
 	_ = w.DeleteClaims(context.Background(), "claimsID")
 }
+
+func TestWrapper_RequestObjectFetched(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := NewMockService(ctrl)
+	svc.EXPECT().RequestObjectFetched(gomock.Any(), oidc4vp.TxID("txID")).Times(1)
+
+	w := Wrap(svc, trace.NewNoopTracerProvider().Tracer(""))
+
+	_ = w.RequestObjectFetched(context.Background(), "txID")
+}
@@ -0,0 +1,109 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/trustbloc/vc-go/presexch"
+)
+
+const fieldPathWildcard = "*"
+
+// AdHocPresentationDefinitionConfig restricts presentation definitions provided in initiate OIDC interaction
+// requests.
+type AdHocPresentationDefinitionConfig struct {
+	// AllowedFieldPaths are JSONPath expressions allowed in constraints fields of input descriptors.
+	// The "*" wildcard matches any sequence of characters, e.g. "$.credentialSubject.*".
+	AllowedFieldPaths []string `json:"allowedFieldPaths"`
+	// MaxInputDescriptors limits the number of input descriptors. No limit if zero.
+	MaxInputDescriptors int `json:"maxInputDescriptors,omitempty"`
+}
+
+// Validate checks that the allow-list is not empty and all allowed field paths are valid patterns.
+func (c *AdHocPresentationDefinitionConfig) Validate() error {
+	if len(c.AllowedFieldPaths) == 0 {
+		return errors.New("allowed field paths are required")
+	}
+
+	if c.MaxInputDescriptors < 0 {
+		return errors.New("max input descriptors must not be negative")
+	}
+
+	for _, p := range c.AllowedFieldPaths {
+		if _, err := fieldPathPattern(p); err != nil {
+			return fmt.Errorf("allowed field path %q: %w", p, err)
+		}
+	}
+
+	return nil
+}
+
+// Check checks that every input descriptor of the presentation definition has constraints and all paths
+// of the constraints fields are allowed.
+func (c *AdHocPresentationDefinitionConfig) Check(pd *presexch.PresentationDefinition) error {
+	if len(pd.InputDescriptors) == 0 {
+		return errors.New("at least one input descriptor is required")
+	}
+
+	if c.MaxInputDescriptors > 0 && len(pd.InputDescriptors) > c.MaxInputDescriptors {
+		return fmt.Errorf("number of input descriptors exceeds %d", c.MaxInputDescriptors)
+	}
+
+	patterns := make([]*regexp.Regexp, 0, len(c.AllowedFieldPaths))
+
+	for _, p := range c.AllowedFieldPaths {
+		pattern, err := fieldPathPattern(p)
+		if err != nil {
+			return fmt.Errorf("allowed field path %q: %w", p, err)
+		}
+
+		patterns = append(patterns, pattern)
+	}
+
+	for _, desc := range pd.InputDescriptors {
+		if desc.Constraints == nil || len(desc.Constraints.Fields) == 0 {
+			return fmt.Errorf("input descriptor %q: constraints fields are required", desc.ID)
+		}
+
+		for _, field := range desc.Constraints.Fields {
+			for _, path := range field.Path {
+				if !matchesAny(patterns, path) {
+					return fmt.Errorf("input descriptor %q: field path %q is not allowed", desc.ID, path)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func fieldPathPattern(path string) (*regexp.Regexp, error) {
+	if path == "" {
+		return nil, errors.New("path is empty")
+	}
+
+	parts := strings.Split(path, fieldPathWildcard)
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+
+	return regexp.Compile("^" + strings.Join(parts, ".*") + "$")
+}
+
+func matchesAny(patterns []*regexp.Regexp, path string) bool {
+	for _, p := range patterns {
+		if p.MatchString(path) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,67 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/vc-go/presexch"
+
+	"github.com/trustbloc/vcs/pkg/profile"
+)
+
+func TestAdHocPresentationDefinitionConfig_Validate(t *testing.T) {
+	require.NoError(t, (&profile.AdHocPresentationDefinitionConfig{
+		AllowedFieldPaths: []string{"$.type", "$.credentialSubject.*"},
+	}).Validate())
+
+	require.ErrorContains(t, (&profile.AdHocPresentationDefinitionConfig{}).Validate(),
+		"allowed field paths are required")
+
+	require.ErrorContains(t, (&profile.AdHocPresentationDefinitionConfig{
+		AllowedFieldPaths: []string{""},
+	}).Validate(), "path is empty")
+
+	require.ErrorContains(t, (&profile.AdHocPresentationDefinitionConfig{
+		AllowedFieldPaths:   []string{"$.type"},
+		MaxInputDescriptors: -1,
+	}).Validate(), "max input descriptors must not be negative")
+}
+
+func TestAdHocPresentationDefinitionConfig_Check(t *testing.T) {
+	config := &profile.AdHocPresentationDefinitionConfig{
+		AllowedFieldPaths:   []string{"$.type", "$.credentialSubject.*"},
+		MaxInputDescriptors: 1,
+	}
+
+	descriptor := func(paths ...string) *presexch.InputDescriptor {
+		return &presexch.InputDescriptor{
+			ID:          "descriptor",
+			Constraints: &presexch.Constraints{Fields: []*presexch.Field{{Path: paths}}},
+		}
+	}
+
+	require.NoError(t, config.Check(&presexch.PresentationDefinition{
+		InputDescriptors: []*presexch.InputDescriptor{descriptor("$.type", "$.credentialSubject.address.city")},
+	}))
+
+	require.ErrorContains(t, config.Check(&presexch.PresentationDefinition{}),
+		"at least one input descriptor is required")
+
+	require.ErrorContains(t, config.Check(&presexch.PresentationDefinition{
+		InputDescriptors: []*presexch.InputDescriptor{descriptor("$.type"), descriptor("$.type")},
+	}), "number of input descriptors exceeds 1")
+
+	require.ErrorContains(t, config.Check(&presexch.PresentationDefinition{
+		InputDescriptors: []*presexch.InputDescriptor{{ID: "descriptor"}},
+	}), `input descriptor "descriptor": constraints fields are required`)
+
+	require.ErrorContains(t, config.Check(&presexch.PresentationDefinition{
+		InputDescriptors: []*presexch.InputDescriptor{descriptor("$.type", "$.vc.credentialSubject.name")},
+	}), `input descriptor "descriptor": field path "$.vc.credentialSubject.name" is not allowed`)
+}
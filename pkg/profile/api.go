@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/trustbloc/did-go/method/key"
+	"github.com/trustbloc/kms-go/doc/jose/jwk"
 	"github.com/trustbloc/kms-go/spi/kms"
 	"github.com/trustbloc/vc-go/presexch"
 	"github.com/trustbloc/vc-go/sdjwt/common"
@@ -52,6 +53,8 @@ type Issuer struct {
 	CredentialMetaData  *CredentialMetaData   `json:"credentialMetadata"`
 	Checks              IssuanceChecks        `json:"checks"`
 	DataConfig          IssuerDataConfig      `json:"dataConfig"`
+	// TxCodeNotifier configures delivery of transaction codes to holders by VCS.
+	TxCodeNotifier *TxCodeNotifierConfig `json:"txCodeNotifier,omitempty"`
 }
 
 // IssuerDataConfig stores profile specific transient data configuration.
@@ -143,6 +146,79 @@ type CredentialTemplate struct {
 	SdJWT                               *SelectiveDisclosureTemplate `json:"sdJWT"`
 	JSONSchema                          string                       `json:"jsonSchema,omitempty"`
 	JSONSchemaID                        string                       `json:"jsonSchemaID,omitempty"`
+	// DataModelVersion overrides VC data model version of the profile for credentials issued from the template.
+	DataModelVersion vcsverifiable.DataModelVersion `json:"dataModelVersion,omitempty"`
+	// ClaimsMapping maps claim data to credential subject claims. Keys are paths of the subject claims,
+	// nested claims are joined with dots, e.g. "address.street". If empty, claim data is used as is.
+	ClaimsMapping map[string]*ClaimMapping `json:"claimsMapping,omitempty"`
+	// ClaimsRequest configures requests to the claim endpoint in the authorization code flow.
+	// If empty, the claim endpoint is called with the issuer access token and an empty body.
+	ClaimsRequest *ClaimsRequestConfig `json:"claimsRequest,omitempty"`
+	// RefreshService adds refreshService to credentials issued from the template, so holders can refresh
+	// the credentials when the claims change.
+	RefreshService *RefreshServiceConfig `json:"refreshService,omitempty"`
+}
+
+// RefreshServiceConfig configures refreshService of the credentials.
+type RefreshServiceConfig struct {
+	// Type is the type of the refresh service. Defaults to "VerifiableCredentialRefreshService2021".
+	Type string `json:"type,omitempty"`
+	// URL is the URL of the refresh service. Defaults to the credential refresh endpoint of the profile.
+	URL string `json:"url,omitempty"`
+	// ClaimEndpoint is the issuer endpoint called to fetch up-to-date claims of the refreshed credential.
+	ClaimEndpoint string `json:"claimEndpoint"`
+	// ClaimsRequest authenticates requests to the claim endpoint. There is no issuer access token in the refresh
+	// flow, so "client_credentials" or "tls_client_auth" auth method is required. Body template is not supported.
+	ClaimsRequest *ClaimsRequestConfig `json:"claimsRequest"`
+}
+
+// ClaimsRequestAuthMethod is a method to authenticate requests to the claim endpoint.
+type ClaimsRequestAuthMethod string
+
+// ClaimsRequestConfig configures requests to the claim endpoint.
+type ClaimsRequestConfig struct {
+	// AuthMethod is a method to authenticate the request. Defaults to "issuer_token".
+	AuthMethod ClaimsRequestAuthMethod `json:"authMethod,omitempty"`
+	// TokenEndpoint is the token endpoint of "client_credentials" auth method.
+	// OIDC client of the profile is used to obtain the token.
+	TokenEndpoint string `json:"tokenEndpoint,omitempty"`
+	// Scopes are scopes of the token of "client_credentials" auth method.
+	Scopes []string `json:"scopes,omitempty"`
+	// TLSCertFile and TLSKeyFile are paths to the client certificate and key of "tls_client_auth" auth method.
+	TLSCertFile string `json:"tlsCertFile,omitempty"`
+	TLSKeyFile  string `json:"tlsKeyFile,omitempty"`
+	// BodyTemplate is Go template of the request body. The request body is empty if not set.
+	BodyTemplate string `json:"bodyTemplate,omitempty"`
+	// Headers are additional headers of the request.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Timeout limits the duration of a single request attempt.
+	Timeout *time.Duration `json:"timeout,omitempty"`
+	// MaxRetries is the number of retries of requests failed with network errors or 5xx and 429 status codes.
+	MaxRetries int `json:"maxRetries,omitempty"`
+	// RetryInterval is the interval between retries. Defaults to 500ms.
+	RetryInterval *time.Duration `json:"retryInterval,omitempty"`
+	// CacheResponse allows to reuse the claim endpoint response for batch credential requests
+	// of the transaction with the same claims request.
+	CacheResponse bool `json:"cacheResponse,omitempty"`
+}
+
+// ClaimMapping defines how a credential subject claim is derived from the claim data.
+type ClaimMapping struct {
+	// Path is a JSONPath expression selecting the value from the claim data, e.g. "$.person.given_name".
+	Path string `json:"path,omitempty"`
+	// Paths are JSONPath expressions of the values joined by "concat" transform.
+	Paths []string `json:"paths,omitempty"`
+	// Transform is an optional transformation of the selected value: "date" or "concat".
+	Transform string `json:"transform,omitempty"`
+	// InputLayout is Go time layout of the source value of "date" transform. RFC 3339 and "2006-01-02" dates
+	// and unix timestamps are accepted if empty.
+	InputLayout string `json:"inputLayout,omitempty"`
+	// Layout is Go time layout of the result of "date" transform. Defaults to "2006-01-02".
+	Layout string `json:"layout,omitempty"`
+	// Separator joins values of "concat" transform. Defaults to a single space.
+	Separator *string `json:"separator,omitempty"`
+	// Default is the value of the claim if the claim data has no value at the path.
+	Default interface{} `json:"default,omitempty"`
 }
 
 type SelectiveDisclosureTemplate struct {
@@ -180,6 +256,8 @@ type OIDCConfig struct {
 	CredentialResponseEncValuesSupported       []string `json:"credential_response_enc_values_supported"`
 	CredentialResponseEncryptionRequired       bool     `json:"credential_response_encryption_required"`
 	ClaimsEndpoint                             string   `json:"claims_endpoint"`
+	// WalletURL configures the URL of credential offers. Defaults to "openid-credential-offer://".
+	WalletURL *WalletURLConfig `json:"wallet_url,omitempty"`
 }
 
 // VCConfig describes how to sign verifiable credentials.
@@ -193,12 +271,20 @@ type VCConfig struct {
 	Context                 []string                           `json:"context,omitempty"`
 	SDJWT                   vc.SDJWT                           `json:"sdjwt,omitempty"`
 	DataIntegrityProof      vc.DataIntegrityProofConfig        `json:"dataIntegrityProof,omitempty"`
+	// DataModelVersion is W3C VC data model version of issued credentials. Defaults to v1.1.
+	DataModelVersion vcsverifiable.DataModelVersion `json:"dataModelVersion,omitempty"`
+	// SecuringMechanism is the mechanism v2.0 credentials of jwt format are secured with:
+	// jose (vc+jwt, default) or cose (vc+cose).
+	SecuringMechanism vcsverifiable.SecuringMechanism `json:"securingMechanism,omitempty"`
 }
 
 // StatusConfig represents the VC status configuration.
 type StatusConfig struct {
 	Type    vc.StatusType `json:"type"`
 	Disable bool          `json:"disable"`
+	// Purpose is the status purpose of the status lists, "revocation" (default) or "suspension".
+	// Status lists of RevocationList2020 and RevocationList2021 types have no purpose.
+	Purpose string `json:"purpose,omitempty"`
 }
 
 // Verifier profile.
@@ -217,6 +303,18 @@ type Verifier struct {
 	PresentationDefinitions []*presexch.PresentationDefinition `json:"presentationDefinitions,omitempty"`
 	WebHook                 string                             `json:"webHook,omitempty"`
 	DataConfig              VerifierDataConfig                 `json:"dataConfig"`
+	// CredentialMetaData is metadata of the credentials accepted by the verifier, matched by credential type.
+	// Masks, display names and order of the claims returned by the verifier are resolved from it.
+	CredentialMetaData *CredentialMetaData `json:"credentialMetadata,omitempty"`
+	// AdHocPresentationDefinition allows presentation definitions and DCQL queries provided in initiate
+	// OIDC interaction requests. Ad-hoc presentation definitions are rejected if not set.
+	AdHocPresentationDefinition *AdHocPresentationDefinitionConfig `json:"adHocPresentationDefinition,omitempty"`
+	// VerificationReceipt enables signed receipts of credential and presentation verifications.
+	// Receipts are not issued if not set.
+	VerificationReceipt *VerificationReceiptConfig `json:"verificationReceipt,omitempty"`
+	// PresentationArchive enables the archive of presentations received in OIDC4VP interactions.
+	// Presentations are not archived if not set.
+	PresentationArchive *PresentationArchiveConfig `json:"presentationArchive,omitempty"`
 }
 
 // VerifierDataConfig stores profile specific transient data configuration.
@@ -231,6 +329,10 @@ type OIDC4VPConfig struct {
 	ROSigningAlgorithm vcsverifiable.SignatureType `json:"roSigningAlgorithm,omitempty"`
 	DIDMethod          Method                      `json:"didMethod,omitempty"`
 	KeyType            kms.KeyType                 `json:"keyType,omitempty"`
+	// WalletURL configures the URL of authorization requests. Defaults to "openid-vc://".
+	WalletURL *WalletURLConfig `json:"walletURL,omitempty"`
+	// ResponseRedirect enables the same-device flow. Disabled if not set.
+	ResponseRedirect *ResponseRedirectConfig `json:"responseRedirect,omitempty"`
 }
 
 // VerificationChecks are checks to be performed for verifying credentials and presentations.
@@ -248,6 +350,8 @@ type IssuanceChecks struct {
 // PolicyCheck stores policy check configuration.
 type PolicyCheck struct {
 	PolicyURL string `json:"policyUrl"`
+	// Local is a policy evaluated in-process. If both are set, the local policy is evaluated first.
+	Local *LocalPolicy `json:"local,omitempty"`
 }
 
 // ClientAttestationCheck stores Client Attestation check configuration.
@@ -271,6 +375,31 @@ type CredentialChecks struct {
 	Strict           bool                   `json:"strict,omitempty"`
 	LinkedDomain     bool                   `json:"linkedDomain,omitempty"`
 	IssuerTrustList  map[string]TrustList   `json:"issuerTrustList,omitempty"`
+	// StatusListMaxAge limits the age of a cached status list VC used for status check.
+	StatusListMaxAge *time.Duration `json:"statusListMaxAge,omitempty"`
+	// ProofPolicy defines which proofs of a credential with multiple proofs must be valid.
+	// All proofs must be valid if not set.
+	ProofPolicy *ProofPolicy `json:"proofPolicy,omitempty"`
+}
+
+// ProofPolicyType defines how proofs of a credential with multiple proofs are verified.
+type ProofPolicyType string
+
+const (
+	// ProofPolicyAll requires all proofs of the credential to be valid.
+	ProofPolicyAll ProofPolicyType = "all"
+	// ProofPolicyAny requires at least one proof of the credential to be valid.
+	ProofPolicyAny ProofPolicyType = "any"
+	// ProofPolicyCryptosuites requires a valid proof for each of the configured cryptosuites.
+	ProofPolicyCryptosuites ProofPolicyType = "cryptosuites"
+)
+
+// ProofPolicy contains verification policy for credentials with multiple proofs (proof sets and proof chains).
+type ProofPolicy struct {
+	Type ProofPolicyType `json:"type,omitempty"`
+	// Cryptosuites required by ProofPolicyCryptosuites policy. Cryptosuite of DataIntegrityProof
+	// (e.g. "ecdsa-2019") or proof type for other proofs (e.g. "Ed25519Signature2020").
+	Cryptosuites []string `json:"cryptosuites,omitempty"`
 }
 
 // TrustList contains list of configuration that verifier is trusted to accept.
@@ -285,4 +414,18 @@ type SigningDID struct {
 	KMSKeyID       string `json:"kmsKeyID,omitempty"`
 	UpdateKeyURL   string `json:"updateKeyURL,omitempty"`
 	RecoveryKeyURL string `json:"recoveryKeyURL,omitempty"`
+	// VerificationMethods are public keys of the signing DID. Used to publish did:web document.
+	VerificationMethods []*VerificationMethod `json:"verificationMethods,omitempty"`
+	// CertificateChain is X.509 certificate chain of the signing key in "x5c" format (base64 DER, leaf first).
+	// When set, JWT and SD-JWT credentials carry it in "x5c" header and DID may be an HTTPS URL of the issuer.
+	CertificateChain []string `json:"certificateChain,omitempty"`
+}
+
+// VerificationMethod contains public key of the profile signing DID.
+type VerificationMethod struct {
+	ID           string   `json:"id"`
+	Type         string   `json:"type"`
+	PublicKeyJWK *jwk.JWK `json:"publicKeyJwk"`
+	// RetiredAt is set when the key is rotated. Retired keys are still published to verify issued credentials.
+	RetiredAt *time.Time `json:"retiredAt,omitempty"`
 }
@@ -0,0 +1,359 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Claim value types supported by claim metadata. Claims of other value types are not type checked.
+const (
+	ClaimValueTypeString  = "string"
+	ClaimValueTypeNumber  = "number"
+	ClaimValueTypeInteger = "integer"
+	ClaimValueTypeBoolean = "boolean"
+	ClaimValueTypeDate    = "date"
+	ClaimValueTypeImage   = "image"
+	ClaimValueTypeObject  = "object"
+	ClaimValueTypeArray   = "array"
+)
+
+const (
+	claimPathSeparator = "."
+	regexMaskPrefix    = "regex("
+	regexMaskSuffix    = ")"
+	maskChar           = "•"
+)
+
+var claimMetadataFields = []string{"mandatory", "value_type", "pattern", "mask", "display"} //nolint:gochecknoglobals
+
+// compiledExpressions caches compiled pattern and mask expressions, as claims are validated and masked
+// with the same metadata on every request.
+var compiledExpressions sync.Map //nolint:gochecknoglobals
+
+// ClaimsMetadata returns metadata of the claims of the credential configuration keyed by claim path.
+// Paths of nested claims are joined with dots, e.g. "address.street".
+func (c *CredentialsConfigurationSupported) ClaimsMetadata() (map[string]*Claim, error) {
+	result := map[string]*Claim{}
+
+	if c.CredentialDefinition != nil {
+		for name, claim := range c.CredentialDefinition.CredentialSubject {
+			claim := claim
+			result[name] = &claim
+		}
+	}
+
+	if err := collectClaimsMetadata("", c.Claims, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func collectClaimsMetadata(prefix string, claims map[string]interface{}, result map[string]*Claim) error {
+	for name, v := range claims {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		path := prefix + name
+
+		if isClaimMetadata(obj) {
+			b, err := json.Marshal(obj)
+			if err != nil {
+				return fmt.Errorf("marshal claim %q metadata: %w", path, err)
+			}
+
+			claim := &Claim{}
+			if err = json.Unmarshal(b, claim); err != nil {
+				return fmt.Errorf("unmarshal claim %q metadata: %w", path, err)
+			}
+
+			result[path] = claim
+		}
+
+		if err := collectClaimsMetadata(path+claimPathSeparator, obj, result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isClaimMetadata(obj map[string]interface{}) bool {
+	for _, field := range claimMetadataFields {
+		if _, ok := obj[field]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateClaims validates claims against the claims metadata: mandatory claims must be present,
+// values must be of the declared value_type and match the pattern. All violations are reported.
+func ValidateClaims(claims map[string]interface{}, metadata map[string]*Claim) error {
+	var errs []error
+
+	for _, path := range sortedPaths(metadata) {
+		claim := metadata[path]
+
+		value, ok := lookupClaim(claims, path)
+		if !ok || value == nil {
+			if claim.Mandatory {
+				errs = append(errs, fmt.Errorf("claim %q: mandatory claim is missing", path))
+			}
+
+			continue
+		}
+
+		if err := claim.validateValue(value); err != nil {
+			errs = append(errs, fmt.Errorf("claim %q: %w", path, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// MaskClaims returns a copy of claims with masks of the claims metadata applied. The claims are not modified.
+func MaskClaims(claims map[string]interface{}, metadata map[string]*Claim) map[string]interface{} {
+	result := claims
+
+	for _, path := range sortedPaths(metadata) {
+		claim := metadata[path]
+		if claim.Mask == "" {
+			continue
+		}
+
+		value, ok := lookupClaim(result, path)
+		if !ok || value == nil {
+			continue
+		}
+
+		result = setClaim(result, strings.Split(path, claimPathSeparator), claim.maskValue(value))
+	}
+
+	return result
+}
+
+func (c *Claim) validateValue(value interface{}) error {
+	if arr, ok := value.([]interface{}); ok && c.ValueType != ClaimValueTypeArray {
+		for i, v := range arr {
+			if err := c.validateValue(v); err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+
+		return nil
+	}
+
+	if !isValueOfType(value, c.ValueType) {
+		return fmt.Errorf("value is not of type %s", c.ValueType)
+	}
+
+	if c.Pattern == "" {
+		return nil
+	}
+
+	re, err := compileExpression(c.Pattern)
+	if err != nil {
+		return fmt.Errorf("invalid pattern %q: %w", c.Pattern, err)
+	}
+
+	if !re.MatchString(fmt.Sprint(value)) {
+		return fmt.Errorf("value does not match pattern %q", c.Pattern)
+	}
+
+	return nil
+}
+
+// maskValue masks the value. Mask in form "regex(<expression>)" replaces characters of the capturing groups
+// (or of the whole match if the expression has no groups) with mask characters. Any other mask replaces
+// the whole value.
+func (c *Claim) maskValue(value interface{}) interface{} {
+	if arr, ok := value.([]interface{}); ok {
+		masked := make([]interface{}, len(arr))
+		for i, v := range arr {
+			masked[i] = c.maskValue(v)
+		}
+
+		return masked
+	}
+
+	if !strings.HasPrefix(c.Mask, regexMaskPrefix) || !strings.HasSuffix(c.Mask, regexMaskSuffix) {
+		return c.Mask
+	}
+
+	re, err := compileExpression(strings.TrimSuffix(strings.TrimPrefix(c.Mask, regexMaskPrefix), regexMaskSuffix))
+	if err != nil {
+		// Never disclose the value if the mask can't be applied.
+		return strings.Repeat(maskChar, utf8.RuneCountInString(fmt.Sprint(value)))
+	}
+
+	s := fmt.Sprint(value)
+
+	var sb strings.Builder
+
+	pos := 0
+
+	for _, match := range re.FindAllStringSubmatchIndex(s, -1) {
+		ranges := match[2:]
+		if len(ranges) == 0 {
+			ranges = match[:2]
+		}
+
+		for i := 0; i+1 < len(ranges); i += 2 {
+			start, end := ranges[i], ranges[i+1]
+			if start < pos {
+				continue
+			}
+
+			sb.WriteString(s[pos:start])
+			sb.WriteString(strings.Repeat(maskChar, utf8.RuneCountInString(s[start:end])))
+
+			pos = end
+		}
+	}
+
+	sb.WriteString(s[pos:])
+
+	return sb.String()
+}
+
+func compileExpression(expr string) (*regexp.Regexp, error) {
+	if v, ok := compiledExpressions.Load(expr); ok {
+		if re, isRegexp := v.(*regexp.Regexp); isRegexp {
+			return re, nil
+		}
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	compiledExpressions.Store(expr, re)
+
+	return re, nil
+}
+
+func isValueOfType(value interface{}, valueType string) bool {
+	switch valueType {
+	case ClaimValueTypeString, ClaimValueTypeImage:
+		_, ok := value.(string)
+		return ok
+	case ClaimValueTypeNumber:
+		switch value.(type) {
+		case float64, float32, int, int32, int64, json.Number:
+			return true
+		}
+
+		return false
+	case ClaimValueTypeInteger:
+		switch v := value.(type) {
+		case int, int32, int64:
+			return true
+		case float64:
+			return v == float64(int64(v))
+		case json.Number:
+			_, err := v.Int64()
+			return err == nil
+		}
+
+		return false
+	case ClaimValueTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case ClaimValueTypeDate:
+		s, ok := value.(string)
+		if !ok {
+			return false
+		}
+
+		if _, err := time.Parse(time.DateOnly, s); err == nil {
+			return true
+		}
+
+		_, err := time.Parse(time.RFC3339, s)
+
+		return err == nil
+	case ClaimValueTypeObject:
+		_, ok := value.(map[string]interface{})
+		return ok
+	case ClaimValueTypeArray:
+		_, ok := value.([]interface{})
+		return ok
+	default:
+		return true
+	}
+}
+
+// lookupClaim finds the claim by its path. A top-level claim name containing dots takes precedence
+// over the nested claim.
+func lookupClaim(claims map[string]interface{}, path string) (interface{}, bool) {
+	if v, ok := claims[path]; ok {
+		return v, true
+	}
+
+	var current interface{} = claims
+
+	for _, name := range strings.Split(path, claimPathSeparator) {
+		obj, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		if current, ok = obj[name]; !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+// setClaim sets the claim value by its path copying the maps along the path.
+func setClaim(claims map[string]interface{}, path []string, value interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(claims))
+	for k, v := range claims {
+		result[k] = v
+	}
+
+	if _, ok := claims[strings.Join(path, claimPathSeparator)]; ok || len(path) == 1 {
+		result[strings.Join(path, claimPathSeparator)] = value
+
+		return result
+	}
+
+	nested, ok := claims[path[0]].(map[string]interface{})
+	if !ok {
+		return result
+	}
+
+	result[path[0]] = setClaim(nested, path[1:], value)
+
+	return result
+}
+
+func sortedPaths(metadata map[string]*Claim) []string {
+	paths := make([]string, 0, len(metadata))
+	for path := range metadata {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
@@ -0,0 +1,284 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/samber/lo"
+)
+
+// Claim mapping transforms.
+const (
+	// ClaimTransformDate parses the selected value as a date and formats it with the layout of the mapping.
+	ClaimTransformDate = "date"
+	// ClaimTransformConcat joins values selected by paths of the mapping with the separator.
+	ClaimTransformConcat = "concat"
+)
+
+const defaultConcatSeparator = " "
+
+// ValidateClaimsMapping checks JSONPath expressions and transforms of the claims mapping.
+func (t *CredentialTemplate) ValidateClaimsMapping() error {
+	var errs []error
+
+	for _, target := range sortedMappingPaths(t.ClaimsMapping) {
+		if err := t.ClaimsMapping[target].validate(); err != nil {
+			errs = append(errs, fmt.Errorf("claim mapping %q: %w", target, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// MapClaims returns credential subject claims derived from the claim data using the claims mapping
+// of the template. Subject claims with no value in the claim data and no default value are omitted.
+// The claim data is returned as is if the template has no claims mapping.
+func (t *CredentialTemplate) MapClaims(claims map[string]interface{}) (map[string]interface{}, error) {
+	if len(t.ClaimsMapping) == 0 {
+		return claims, nil
+	}
+
+	result := map[string]interface{}{}
+
+	for _, target := range sortedMappingPaths(t.ClaimsMapping) {
+		value, ok, err := t.ClaimsMapping[target].apply(claims)
+		if err != nil {
+			return nil, fmt.Errorf("claim mapping %q: %w", target, err)
+		}
+
+		if !ok {
+			continue
+		}
+
+		if err = setMappedClaim(result, strings.Split(target, claimPathSeparator), value); err != nil {
+			return nil, fmt.Errorf("claim mapping %q: %w", target, err)
+		}
+	}
+
+	return result, nil
+}
+
+func (m *ClaimMapping) validate() error {
+	if m == nil {
+		return errors.New("mapping is empty")
+	}
+
+	switch m.Transform {
+	case "", ClaimTransformDate:
+		if m.Path == "" && m.Default == nil {
+			return errors.New("path or default value is required")
+		}
+	case ClaimTransformConcat:
+		if len(m.Paths) == 0 {
+			return errors.New("paths are required by concat transform")
+		}
+	default:
+		return fmt.Errorf("unsupported transform %q", m.Transform)
+	}
+
+	for _, path := range m.sourcePaths() {
+		if _, err := jsonpath.New(path); err != nil {
+			return fmt.Errorf("invalid path %q: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *ClaimMapping) sourcePaths() []string {
+	if m.Transform == ClaimTransformConcat {
+		return m.Paths
+	}
+
+	if m.Path == "" {
+		return nil
+	}
+
+	return []string{m.Path}
+}
+
+func (m *ClaimMapping) apply(claims map[string]interface{}) (interface{}, bool, error) {
+	if err := m.validate(); err != nil {
+		return nil, false, err
+	}
+
+	var (
+		value interface{}
+		found bool
+		err   error
+	)
+
+	if m.Transform == ClaimTransformConcat {
+		value, found, err = m.concat(claims)
+	} else if m.Path != "" {
+		value, found, err = selectValue(m.Path, claims)
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		// Default value is used as is, no transform is applied.
+		return m.Default, m.Default != nil, nil
+	}
+
+	if m.Transform == ClaimTransformDate {
+		if value, err = m.formatDate(value); err != nil {
+			return nil, false, err
+		}
+	}
+
+	return value, true, nil
+}
+
+func (m *ClaimMapping) concat(claims map[string]interface{}) (interface{}, bool, error) {
+	var parts []string
+
+	for _, path := range m.Paths {
+		value, found, err := selectValue(path, claims)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if !found {
+			continue
+		}
+
+		if arr, ok := value.([]interface{}); ok {
+			for _, v := range arr {
+				parts = append(parts, fmt.Sprint(v))
+			}
+
+			continue
+		}
+
+		parts = append(parts, fmt.Sprint(value))
+	}
+
+	if len(parts) == 0 {
+		return nil, false, nil
+	}
+
+	separator := defaultConcatSeparator
+	if m.Separator != nil {
+		separator = *m.Separator
+	}
+
+	return strings.Join(parts, separator), true, nil
+}
+
+func (m *ClaimMapping) formatDate(value interface{}) (string, error) {
+	var (
+		date time.Time
+		err  error
+	)
+
+	switch v := value.(type) {
+	case string:
+		if date, err = parseDate(v, m.InputLayout); err != nil {
+			return "", err
+		}
+	case float64:
+		date = time.Unix(int64(v), 0).UTC()
+	case int64:
+		date = time.Unix(v, 0).UTC()
+	case int:
+		date = time.Unix(int64(v), 0).UTC()
+	default:
+		return "", fmt.Errorf("value of type %T is not a date", value)
+	}
+
+	layout := m.Layout
+	if layout == "" {
+		layout = time.DateOnly
+	}
+
+	return date.Format(layout), nil
+}
+
+func parseDate(value, layout string) (time.Time, error) {
+	if layout != "" {
+		date, err := time.Parse(layout, value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parse date: %w", err)
+		}
+
+		return date, nil
+	}
+
+	for _, l := range []string{time.RFC3339, time.DateOnly} {
+		if date, err := time.Parse(l, value); err == nil {
+			return date, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("value %q is not a date", value)
+}
+
+// selectValue evaluates JSONPath expression on the claims. The value is not found if the expression
+// selects nothing.
+func selectValue(path string, claims map[string]interface{}) (interface{}, bool, error) {
+	eval, err := jsonpath.New(path)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid path %q: %w", path, err)
+	}
+
+	// Evaluation fails if a key of the expression is not present in the claims.
+	value, err := eval(context.Background(), claims)
+	if err != nil || value == nil {
+		return nil, false, nil //nolint:nilerr
+	}
+
+	if arr, ok := value.([]interface{}); ok && len(arr) == 0 {
+		return nil, false, nil
+	}
+
+	return value, true, nil
+}
+
+// setMappedClaim sets the claim value by its path creating nested objects. Existing nested objects are copied,
+// so values selected from the claim data are never modified.
+func setMappedClaim(claims map[string]interface{}, path []string, value interface{}) error {
+	current := claims
+
+	for _, name := range path[:len(path)-1] {
+		nested := map[string]interface{}{}
+
+		if v, ok := current[name]; ok {
+			obj, isObj := v.(map[string]interface{})
+			if !isObj {
+				return fmt.Errorf("claim %q is not an object", name)
+			}
+
+			for k, val := range obj {
+				nested[k] = val
+			}
+		}
+
+		current[name] = nested
+		current = nested
+	}
+
+	current[path[len(path)-1]] = value
+
+	return nil
+}
+
+func sortedMappingPaths(mapping map[string]*ClaimMapping) []string {
+	paths := lo.Keys(mapping)
+	sort.Strings(paths)
+
+	return paths
+}
@@ -0,0 +1,158 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile_test
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/vcs/pkg/profile"
+)
+
+func TestCredentialTemplate_MapClaims(t *testing.T) {
+	claims := map[string]interface{}{
+		"given_name":  "Pat",
+		"family_name": "Smith",
+		"birthdate":   "1982-03-14T00:00:00Z",
+		"hired":       "14.03.2010",
+		"updated_at":  float64(1700000000),
+		"address": map[string]interface{}{
+			"street_address": "Main St 1",
+			"locality":       "Toronto",
+		},
+		"emails": []interface{}{"pat@example.com", "smith@example.com"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		template := &profile.CredentialTemplate{
+			ClaimsMapping: map[string]*profile.ClaimMapping{
+				"givenName": {Path: "$.given_name"},
+				"fullName": {
+					Transform: profile.ClaimTransformConcat,
+					Paths:     []string{"$.given_name", "$.middle_name", "$.family_name"},
+				},
+				"birthDate": {Path: "$.birthdate", Transform: profile.ClaimTransformDate},
+				"hireDate": {
+					Path:        "$.hired",
+					Transform:   profile.ClaimTransformDate,
+					InputLayout: "02.01.2006",
+					Layout:      "January 2, 2006",
+				},
+				"updatedAt":               {Path: "$.updated_at", Transform: profile.ClaimTransformDate},
+				"address.streetAddress":   {Path: "$.address.street_address"},
+				"address.addressLocality": {Path: "$.address.locality"},
+				"address.addressCountry":  {Path: "$.address.country", Default: "CA"},
+				"email":                   {Path: "$.emails[0]"},
+				"allEmails": {
+					Transform: profile.ClaimTransformConcat,
+					Paths:     []string{"$.emails[*]"},
+					Separator: lo.ToPtr(", "),
+				},
+				"jobTitle":    {Path: "$.job_title"},
+				"nationality": {Default: "Canadian"},
+			},
+		}
+
+		require.NoError(t, template.ValidateClaimsMapping())
+
+		mapped, err := template.MapClaims(claims)
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]interface{}{
+			"givenName": "Pat",
+			"fullName":  "Pat Smith",
+			"birthDate": "1982-03-14",
+			"hireDate":  "March 14, 2010",
+			"updatedAt": "2023-11-14",
+			"address": map[string]interface{}{
+				"streetAddress":   "Main St 1",
+				"addressLocality": "Toronto",
+				"addressCountry":  "CA",
+			},
+			"email":       "pat@example.com",
+			"allEmails":   "pat@example.com, smith@example.com",
+			"nationality": "Canadian",
+		}, mapped)
+	})
+
+	t.Run("nested object is copied", func(t *testing.T) {
+		template := &profile.CredentialTemplate{
+			ClaimsMapping: map[string]*profile.ClaimMapping{
+				"address":         {Path: "$.address"},
+				"address.country": {Default: "CA"},
+			},
+		}
+
+		mapped, err := template.MapClaims(claims)
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{
+			"street_address": "Main St 1",
+			"locality":       "Toronto",
+			"country":        "CA",
+		}, mapped["address"])
+
+		require.NotContains(t, claims["address"], "country")
+	})
+
+	t.Run("no mapping", func(t *testing.T) {
+		mapped, err := (&profile.CredentialTemplate{}).MapClaims(claims)
+		require.NoError(t, err)
+		require.Equal(t, claims, mapped)
+	})
+
+	t.Run("not a date", func(t *testing.T) {
+		template := &profile.CredentialTemplate{
+			ClaimsMapping: map[string]*profile.ClaimMapping{
+				"birthDate": {Path: "$.given_name", Transform: profile.ClaimTransformDate},
+			},
+		}
+
+		_, err := template.MapClaims(claims)
+		require.ErrorContains(t, err, `claim mapping "birthDate": value "Pat" is not a date`)
+
+		template.ClaimsMapping["birthDate"] = &profile.ClaimMapping{Path: "$.address", Transform: profile.ClaimTransformDate}
+
+		_, err = template.MapClaims(claims)
+		require.ErrorContains(t, err, "value of type map[string]interface {} is not a date")
+	})
+
+	t.Run("parent claim is not an object", func(t *testing.T) {
+		template := &profile.CredentialTemplate{
+			ClaimsMapping: map[string]*profile.ClaimMapping{
+				"name":       {Path: "$.given_name"},
+				"name.given": {Path: "$.given_name"},
+			},
+		}
+
+		_, err := template.MapClaims(claims)
+		require.ErrorContains(t, err, `claim mapping "name.given": claim "name" is not an object`)
+	})
+}
+
+func TestCredentialTemplate_ValidateClaimsMapping(t *testing.T) {
+	template := &profile.CredentialTemplate{
+		ClaimsMapping: map[string]*profile.ClaimMapping{
+			"empty":     nil,
+			"noPath":    {},
+			"badPath":   {Path: "$.["},
+			"noPaths":   {Transform: profile.ClaimTransformConcat},
+			"transform": {Path: "$.name", Transform: "uppercase"},
+		},
+	}
+
+	err := template.ValidateClaimsMapping()
+	require.ErrorContains(t, err, `claim mapping "empty": mapping is empty`)
+	require.ErrorContains(t, err, `claim mapping "noPath": path or default value is required`)
+	require.ErrorContains(t, err, `claim mapping "badPath": invalid path "$.["`)
+	require.ErrorContains(t, err, `claim mapping "noPaths": paths are required by concat transform`)
+	require.ErrorContains(t, err, `claim mapping "transform": unsupported transform "uppercase"`)
+
+	_, err = template.MapClaims(map[string]interface{}{})
+	require.ErrorContains(t, err, `claim mapping "badPath": invalid path "$.["`)
+}
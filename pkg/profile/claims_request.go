@@ -0,0 +1,83 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"text/template"
+)
+
+// Claims request auth methods.
+const (
+	// ClaimsRequestAuthIssuerToken sends the access token issued by the issuer OIDC provider as a bearer token.
+	ClaimsRequestAuthIssuerToken ClaimsRequestAuthMethod = "issuer_token"
+	// ClaimsRequestAuthClientCredentials sends the access token obtained with client_credentials grant
+	// as a bearer token.
+	ClaimsRequestAuthClientCredentials ClaimsRequestAuthMethod = "client_credentials"
+	// ClaimsRequestAuthTLSClient authenticates with the TLS client certificate. The issuer access token
+	// is sent as a bearer token.
+	ClaimsRequestAuthTLSClient ClaimsRequestAuthMethod = "tls_client_auth"
+	// ClaimsRequestAuthSignedJWT sends the request body as a JWT signed with the signing key of the profile.
+	// The issuer access token is sent as a bearer token.
+	ClaimsRequestAuthSignedJWT ClaimsRequestAuthMethod = "signed_request_jwt"
+)
+
+// Validate checks auth method options and the body template of the claims request.
+func (c *ClaimsRequestConfig) Validate() error {
+	switch c.AuthMethod {
+	case "", ClaimsRequestAuthIssuerToken, ClaimsRequestAuthSignedJWT:
+	case ClaimsRequestAuthClientCredentials:
+		if c.TokenEndpoint == "" {
+			return errors.New("token endpoint is required by client_credentials auth method")
+		}
+	case ClaimsRequestAuthTLSClient:
+		if c.TLSCertFile == "" || c.TLSKeyFile == "" {
+			return errors.New("tls cert and key files are required by tls_client_auth auth method")
+		}
+	default:
+		return fmt.Errorf("unsupported auth method %q", c.AuthMethod)
+	}
+
+	if c.MaxRetries < 0 {
+		return errors.New("max retries must not be negative")
+	}
+
+	if _, err := c.ParseBodyTemplate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ParseBodyTemplate parses the body template of the claims request. Besides Go template builtins,
+// "json" function is available to encode values as JSON. Returns nil if the body template is not set.
+func (c *ClaimsRequestConfig) ParseBodyTemplate() (*template.Template, error) {
+	if c.BodyTemplate == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	tmpl, err := template.New("body").
+		Option("missingkey=error").
+		Funcs(template.FuncMap{"json": marshalTemplateValue}).
+		Parse(c.BodyTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("parse body template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+func marshalTemplateValue(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/vcs/pkg/profile"
+)
+
+func TestClaimsRequestConfig_Validate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		require.NoError(t, (&profile.ClaimsRequestConfig{}).Validate())
+		require.NoError(t, (&profile.ClaimsRequestConfig{
+			AuthMethod:    profile.ClaimsRequestAuthClientCredentials,
+			TokenEndpoint: "https://issuer.example.com/token",
+			BodyTemplate:  `{"credential_type":{{json .CredentialType}}}`,
+		}).Validate())
+		require.NoError(t, (&profile.ClaimsRequestConfig{
+			AuthMethod:  profile.ClaimsRequestAuthTLSClient,
+			TLSCertFile: "cert.pem",
+			TLSKeyFile:  "key.pem",
+		}).Validate())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		require.ErrorContains(t, (&profile.ClaimsRequestConfig{AuthMethod: "basic"}).Validate(),
+			`unsupported auth method "basic"`)
+		require.ErrorContains(t, (&profile.ClaimsRequestConfig{
+			AuthMethod: profile.ClaimsRequestAuthClientCredentials,
+		}).Validate(), "token endpoint is required")
+		require.ErrorContains(t, (&profile.ClaimsRequestConfig{
+			AuthMethod:  profile.ClaimsRequestAuthTLSClient,
+			TLSCertFile: "cert.pem",
+		}).Validate(), "tls cert and key files are required")
+		require.ErrorContains(t, (&profile.ClaimsRequestConfig{MaxRetries: -1}).Validate(),
+			"max retries must not be negative")
+		require.ErrorContains(t, (&profile.ClaimsRequestConfig{BodyTemplate: "{{.TxID"}).Validate(),
+			"parse body template")
+	})
+}
+
+func TestClaimsRequestConfig_ParseBodyTemplate(t *testing.T) {
+	tmpl, err := (&profile.ClaimsRequestConfig{}).ParseBodyTemplate()
+	require.NoError(t, err)
+	require.Nil(t, tmpl)
+
+	tmpl, err = (&profile.ClaimsRequestConfig{
+		BodyTemplate: `{"tx_id":{{json .TxID}},"types":{{json .Types}}}`,
+	}).ParseBodyTemplate()
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	require.NoError(t, tmpl.Execute(&buf, map[string]interface{}{
+		"TxID":  "tx\"1",
+		"Types": []string{"VerifiedEmployee"},
+	}))
+	require.Equal(t, `{"tx_id":"tx\"1","types":["VerifiedEmployee"]}`, buf.String())
+
+	require.Error(t, tmpl.Execute(&buf, map[string]interface{}{"TxID": "1"}))
+}
@@ -0,0 +1,132 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/vcs/pkg/profile"
+)
+
+func TestCredentialsConfigurationSupported_ClaimsMetadata(t *testing.T) {
+	conf := &profile.CredentialsConfigurationSupported{
+		CredentialDefinition: &profile.CredentialDefinition{
+			CredentialSubject: map[string]profile.Claim{
+				"givenName": {Mandatory: true, ValueType: "string"},
+			},
+		},
+		Claims: map[string]interface{}{
+			"email": map[string]interface{}{
+				"mandatory": true,
+				"pattern":   "^.+@.+$",
+			},
+			"address": map[string]interface{}{
+				"display": []interface{}{map[string]interface{}{"name": "Address"}},
+				"street": map[string]interface{}{
+					"value_type": "string",
+					"mask":       "regex(^(.*).{4}$)",
+				},
+			},
+		},
+	}
+
+	metadata, err := conf.ClaimsMetadata()
+	require.NoError(t, err)
+	require.Len(t, metadata, 4)
+
+	require.Equal(t, &profile.Claim{Mandatory: true, ValueType: "string"}, metadata["givenName"])
+	require.Equal(t, &profile.Claim{Mandatory: true, Pattern: "^.+@.+$"}, metadata["email"])
+	require.Equal(t, &profile.Claim{ValueType: "string", Mask: "regex(^(.*).{4}$)"}, metadata["address.street"])
+	require.Equal(t, []profile.L10n{{Name: "Address"}}, metadata["address"].Display)
+}
+
+func TestValidateClaims(t *testing.T) {
+	metadata := map[string]*profile.Claim{
+		"givenName":      {Mandatory: true, ValueType: profile.ClaimValueTypeString},
+		"age":            {ValueType: profile.ClaimValueTypeInteger},
+		"birthDate":      {ValueType: profile.ClaimValueTypeDate},
+		"email":          {Pattern: "^[^@]+@example\\.com$"},
+		"address.street": {Mandatory: true},
+		"phones":         {ValueType: profile.ClaimValueTypeString, Pattern: "^\\+[0-9]+$"},
+		"employed":       {ValueType: profile.ClaimValueTypeBoolean},
+		"custom":         {ValueType: "custom"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		require.NoError(t, profile.ValidateClaims(map[string]interface{}{
+			"givenName": "Pat",
+			"age":       float64(42),
+			"birthDate": "1982-03-14",
+			"email":     "pat@example.com",
+			"address":   map[string]interface{}{"street": "Main St 1"},
+			"phones":    []interface{}{"+123", "+456"},
+			"employed":  true,
+			"custom":    42,
+		}, metadata))
+	})
+
+	t.Run("violations", func(t *testing.T) {
+		err := profile.ValidateClaims(map[string]interface{}{
+			"age":       42.5,
+			"birthDate": "14.03.1982",
+			"email":     "pat@example.org",
+			"phones":    []interface{}{"+123", "456"},
+			"employed":  "yes",
+		}, metadata)
+		require.Error(t, err)
+
+		require.ErrorContains(t, err, `claim "address.street": mandatory claim is missing`)
+		require.ErrorContains(t, err, `claim "age": value is not of type integer`)
+		require.ErrorContains(t, err, `claim "birthDate": value is not of type date`)
+		require.ErrorContains(t, err, `claim "email": value does not match pattern "^[^@]+@example\\.com$"`)
+		require.ErrorContains(t, err, `claim "employed": value is not of type boolean`)
+		require.ErrorContains(t, err, `claim "givenName": mandatory claim is missing`)
+		require.ErrorContains(t, err, `claim "phones": item 1: value does not match pattern`)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		err := profile.ValidateClaims(map[string]interface{}{"id": "123"},
+			map[string]*profile.Claim{"id": {Pattern: "[0-9"}})
+		require.ErrorContains(t, err, `claim "id": invalid pattern "[0-9"`)
+	})
+}
+
+func TestMaskClaims(t *testing.T) {
+	claims := map[string]interface{}{
+		"ssn":     "123456789",
+		"card":    "4111-1111-1111-1234",
+		"pin":     1234,
+		"address": map[string]interface{}{"street": "Main St 1", "city": "Toronto"},
+		"phones":  []interface{}{"+1234567", "+7654321"},
+		"name":    "Pat",
+	}
+
+	masked := profile.MaskClaims(claims, map[string]*profile.Claim{
+		"ssn":            {Mask: "regex(^(.*).{4}$)"},
+		"card":           {Mask: "regex([0-9]{4}-)"},
+		"pin":            {Mask: "****"},
+		"address.street": {Mask: "regex(.*)"},
+		"phones":         {Mask: "regex(^\\+([0-9]{3}))"},
+		"name":           {Mandatory: true},
+		"missing":        {Mask: "***"},
+	})
+
+	require.Equal(t, map[string]interface{}{
+		"ssn":     "•••••6789",
+		"card":    "•••••••••••••••1234",
+		"pin":     "****",
+		"address": map[string]interface{}{"street": "•••••••••", "city": "Toronto"},
+		"phones":  []interface{}{"+•••4567", "+•••4321"},
+		"name":    "Pat",
+	}, masked)
+
+	// source claims are not modified
+	require.Equal(t, "123456789", claims["ssn"])
+	require.Equal(t, "Main St 1", claims["address"].(map[string]interface{})["street"])
+}
@@ -0,0 +1,200 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+	"golang.org/x/text/language"
+)
+
+const verifiableCredentialType = "VerifiableCredential"
+
+// LocalizedCredentialDisplay is display metadata of the credential resolved for the requested locales.
+type LocalizedCredentialDisplay struct {
+	Name            string `json:"name,omitempty"`
+	Locale          string `json:"locale,omitempty"`
+	URL             string `json:"url,omitempty"`
+	BackgroundColor string `json:"background_color,omitempty"`
+	TextColor       string `json:"text_color,omitempty"`
+	Logo            *Logo  `json:"logo,omitempty"`
+	// Claims are display names of the credential subject claims in the order they should be displayed.
+	Claims []*LocalizedClaimDisplay `json:"claims,omitempty"`
+}
+
+// LocalizedClaimDisplay is a display name of the claim resolved for the requested locales.
+type LocalizedClaimDisplay struct {
+	// Path is a path of the claim, nested claims are joined with dots, e.g. "address.street".
+	Path   string `json:"path"`
+	Name   string `json:"name,omitempty"`
+	Locale string `json:"locale,omitempty"`
+}
+
+// ParseLocales returns locales requested by the client ordered by preference. The locale parameter takes
+// precedence over the Accept-Language header. Invalid header is ignored.
+func ParseLocales(locale, acceptLanguage string) []string {
+	if locale != "" {
+		return []string{locale}
+	}
+
+	if acceptLanguage == "" {
+		return nil
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return nil
+	}
+
+	locales := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		locales = append(locales, tag.String())
+	}
+
+	return locales
+}
+
+// FindCredentialConfiguration returns the credential configuration of the credential with the given types.
+// The most specific type, i.e. the last one, is matched first. Returns nil if no configuration is found.
+func (m *CredentialMetaData) FindCredentialConfiguration(types []string) *CredentialsConfigurationSupported {
+	if m == nil {
+		return nil
+	}
+
+	ids := make([]string, 0, len(m.CredentialsConfigurationSupported))
+	for id := range m.CredentialsConfigurationSupported {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	for i := len(types) - 1; i >= 0; i-- {
+		if types[i] == verifiableCredentialType {
+			continue
+		}
+
+		for _, id := range ids {
+			conf := m.CredentialsConfigurationSupported[id]
+			if conf == nil {
+				continue
+			}
+
+			if conf.Vct == types[i] ||
+				conf.CredentialDefinition != nil && lo.Contains(conf.CredentialDefinition.Type, types[i]) {
+				return conf
+			}
+		}
+	}
+
+	return nil
+}
+
+// LocalizeDisplay resolves the credential display and display names of the claims for the locales.
+// For every entry, the first locale with an exact match wins, then the first locale with the same base
+// language, then the entry without a locale, then the first entry. Claims listed in order come first,
+// the rest are sorted by path. Returns nil if there is nothing to display.
+func LocalizeDisplay(
+	display []*CredentialDisplay,
+	claimsMetadata map[string]*Claim,
+	order []string,
+	locales []string,
+) *LocalizedCredentialDisplay {
+	result := &LocalizedCredentialDisplay{}
+
+	if i := matchLocale(locales, len(display), func(i int) string { return display[i].Locale }); i >= 0 {
+		d := display[i]
+
+		result.Name = d.Name
+		result.Locale = d.Locale
+		result.URL = d.URL
+		result.BackgroundColor = d.BackgroundColor
+		result.TextColor = d.TextColor
+		result.Logo = d.Logo
+	}
+
+	for _, path := range orderedClaimPaths(claimsMetadata, order) {
+		claimDisplay := &LocalizedClaimDisplay{Path: path}
+
+		if claim, ok := claimsMetadata[path]; ok && claim != nil {
+			l10n := claim.Display
+
+			if i := matchLocale(locales, len(l10n), func(i int) string { return l10n[i].Locale }); i >= 0 {
+				claimDisplay.Name = l10n[i].Name
+				claimDisplay.Locale = l10n[i].Locale
+			}
+		}
+
+		result.Claims = append(result.Claims, claimDisplay)
+	}
+
+	if result.Name == "" && result.Locale == "" && len(result.Claims) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+// matchLocale returns an index of the entry matching the locales best or -1 if there are no entries.
+func matchLocale(locales []string, n int, localeAt func(i int) string) int {
+	if n == 0 {
+		return -1
+	}
+
+	for _, locale := range locales {
+		for i := 0; i < n; i++ {
+			if strings.EqualFold(localeAt(i), locale) {
+				return i
+			}
+		}
+
+		for i := 0; i < n; i++ {
+			if localeAt(i) != "" && strings.EqualFold(baseLanguage(localeAt(i)), baseLanguage(locale)) {
+				return i
+			}
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		if localeAt(i) == "" {
+			return i
+		}
+	}
+
+	return 0
+}
+
+func baseLanguage(locale string) string {
+	base, _, _ := strings.Cut(locale, "-")
+
+	return base
+}
+
+func orderedClaimPaths(claimsMetadata map[string]*Claim, order []string) []string {
+	paths := make([]string, 0, len(order)+len(claimsMetadata))
+	seen := make(map[string]struct{}, len(order)+len(claimsMetadata))
+
+	for _, path := range order {
+		if _, ok := seen[path]; ok {
+			continue
+		}
+
+		seen[path] = struct{}{}
+		paths = append(paths, path)
+	}
+
+	for _, path := range sortedPaths(claimsMetadata) {
+		if _, ok := seen[path]; ok {
+			continue
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths
+}
@@ -0,0 +1,111 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/vcs/pkg/profile"
+)
+
+func TestParseLocales(t *testing.T) {
+	require.Nil(t, profile.ParseLocales("", ""))
+	require.Equal(t, []string{"fr-CA"}, profile.ParseLocales("fr-CA", "en-US"))
+	require.Equal(t, []string{"fr-CA", "en", "de"}, profile.ParseLocales("", "de;q=0.5, fr-CA, en;q=0.8"))
+	require.Nil(t, profile.ParseLocales("", "en;q=invalid"))
+}
+
+func TestCredentialMetaData_FindCredentialConfiguration(t *testing.T) {
+	meta := &profile.CredentialMetaData{
+		CredentialsConfigurationSupported: map[string]*profile.CredentialsConfigurationSupported{
+			"PermanentResidentCard": {
+				CredentialDefinition: &profile.CredentialDefinition{
+					Type: []string{"VerifiableCredential", "PermanentResidentCard"},
+				},
+			},
+			"VerifiedEmployee": {
+				Vct: "VerifiedEmployee",
+			},
+			"Empty": nil,
+		},
+	}
+
+	require.Equal(t, meta.CredentialsConfigurationSupported["PermanentResidentCard"],
+		meta.FindCredentialConfiguration([]string{"VerifiableCredential", "PermanentResidentCard"}))
+	require.Equal(t, meta.CredentialsConfigurationSupported["VerifiedEmployee"],
+		meta.FindCredentialConfiguration([]string{"VerifiableCredential", "VerifiedEmployee"}))
+	require.Nil(t, meta.FindCredentialConfiguration([]string{"VerifiableCredential"}))
+	require.Nil(t, meta.FindCredentialConfiguration([]string{"VerifiableCredential", "UniversityDegree"}))
+
+	var nilMeta *profile.CredentialMetaData
+	require.Nil(t, nilMeta.FindCredentialConfiguration([]string{"PermanentResidentCard"}))
+}
+
+func TestLocalizeDisplay(t *testing.T) {
+	conf := &profile.CredentialsConfigurationSupported{
+		Display: []*profile.CredentialDisplay{
+			{Name: "Permanent Resident Card", Locale: "en-US", BackgroundColor: "#fff"},
+			{Name: "Carte de résident permanent", Locale: "fr-CA", BackgroundColor: "#fff"},
+		},
+		CredentialDefinition: &profile.CredentialDefinition{
+			CredentialSubject: map[string]profile.Claim{
+				"givenName": {
+					Display: []profile.L10n{
+						{Name: "Given Name", Locale: "en-US"},
+						{Name: "Prénom", Locale: "fr-CA"},
+					},
+				},
+				"familyName": {
+					Display: []profile.L10n{
+						{Name: "Surname"},
+						{Name: "Nom de famille", Locale: "fr-CA"},
+					},
+				},
+				"birthDate": {ValueType: "date"},
+			},
+		},
+		Order: []string{"givenName", "familyName", "givenName", "residentSince"},
+	}
+
+	claimsMetadata, err := conf.ClaimsMetadata()
+	require.NoError(t, err)
+
+	t.Run("exact locale", func(t *testing.T) {
+		display := profile.LocalizeDisplay(conf.Display, claimsMetadata, conf.Order, []string{"de", "fr-CA"})
+
+		require.Equal(t, "Carte de résident permanent", display.Name)
+		require.Equal(t, "fr-CA", display.Locale)
+		require.Equal(t, []*profile.LocalizedClaimDisplay{
+			{Path: "givenName", Name: "Prénom", Locale: "fr-CA"},
+			{Path: "familyName", Name: "Nom de famille", Locale: "fr-CA"},
+			{Path: "residentSince"},
+			{Path: "birthDate"},
+		}, display.Claims)
+	})
+
+	t.Run("base language", func(t *testing.T) {
+		display := profile.LocalizeDisplay(conf.Display, claimsMetadata, conf.Order, []string{"fr"})
+
+		require.Equal(t, "fr-CA", display.Locale)
+		require.Equal(t, "Prénom", display.Claims[0].Name)
+	})
+
+	t.Run("no matching locale", func(t *testing.T) {
+		display := profile.LocalizeDisplay(conf.Display, claimsMetadata, conf.Order, []string{"de"})
+
+		require.Equal(t, "Permanent Resident Card", display.Name)
+		require.Equal(t, &profile.LocalizedClaimDisplay{Path: "givenName", Name: "Given Name", Locale: "en-US"},
+			display.Claims[0])
+		require.Equal(t, &profile.LocalizedClaimDisplay{Path: "familyName", Name: "Surname"}, display.Claims[1])
+	})
+
+	t.Run("nothing to display", func(t *testing.T) {
+		require.Nil(t, profile.LocalizeDisplay(nil, nil, nil, []string{"en"}))
+	})
+}
@@ -0,0 +1,105 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/PaesslerAG/gval"
+	"github.com/PaesslerAG/jsonpath"
+)
+
+var policyLanguage = gval.Full(jsonpath.Language())
+
+// LocalPolicy is a policy evaluated in-process against the policy evaluation request. The interaction
+// is allowed if all rules of the policy are satisfied.
+type LocalPolicy struct {
+	Rules []*PolicyRule `json:"rules"`
+}
+
+// PolicyRule is a rule of the local policy.
+type PolicyRule struct {
+	// Name identifies the rule in decision logs.
+	Name string `json:"name"`
+	// Condition is a boolean expression with JSONPath support evaluated against the policy evaluation
+	// request, e.g. `!("VerifiedEmployee" in $.credential_types) || $.issuer_did == "did:example:123"`.
+	Condition string `json:"condition"`
+	// DenyReason is reported when the condition is not satisfied. Defaults to the rule name.
+	DenyReason string `json:"denyReason,omitempty"`
+}
+
+// PolicyDecision is a result of the local policy evaluation.
+type PolicyDecision struct {
+	Allowed     bool
+	DenyReasons []string
+}
+
+// IsEnabled returns true if either remote or local policy is configured.
+func (c PolicyCheck) IsEnabled() bool {
+	return c.PolicyURL != "" || c.Local != nil
+}
+
+// Validate checks that all rules of the policy have a name and a valid condition.
+func (p *LocalPolicy) Validate() error {
+	if len(p.Rules) == 0 {
+		return errors.New("at least one rule is required")
+	}
+
+	var errs []error
+
+	for i, rule := range p.Rules {
+		if rule == nil || rule.Name == "" {
+			errs = append(errs, fmt.Errorf("rule %d: name is required", i))
+
+			continue
+		}
+
+		if _, err := policyLanguage.NewEvaluable(rule.Condition); err != nil {
+			errs = append(errs, fmt.Errorf("rule %q: parse condition: %w", rule.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Evaluate evaluates all rules of the policy against the input. The input is expected to be a JSON
+// object, i.e. a decoded policy evaluation request.
+func (p *LocalPolicy) Evaluate(ctx context.Context, input map[string]interface{}) (*PolicyDecision, error) {
+	decision := &PolicyDecision{Allowed: true}
+
+	for _, rule := range p.Rules {
+		if rule == nil {
+			continue
+		}
+
+		satisfied, err := policyLanguage.EvaluateWithContext(ctx, rule.Condition, input)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: evaluate condition: %w", rule.Name, err)
+		}
+
+		allowed, ok := satisfied.(bool)
+		if !ok {
+			return nil, fmt.Errorf("rule %q: condition evaluated to %T, bool expected", rule.Name, satisfied)
+		}
+
+		if allowed {
+			continue
+		}
+
+		decision.Allowed = false
+
+		if rule.DenyReason != "" {
+			decision.DenyReasons = append(decision.DenyReasons, rule.DenyReason)
+		} else {
+			decision.DenyReasons = append(decision.DenyReasons, rule.Name)
+		}
+	}
+
+	return decision, nil
+}
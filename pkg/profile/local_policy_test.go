@@ -0,0 +1,95 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/vcs/pkg/profile"
+)
+
+func TestLocalPolicy_Validate(t *testing.T) {
+	require.NoError(t, (&profile.LocalPolicy{
+		Rules: []*profile.PolicyRule{{Name: "issuer", Condition: `$.issuer_did == "did:example:issuer"`}},
+	}).Validate())
+
+	require.ErrorContains(t, (&profile.LocalPolicy{}).Validate(), "at least one rule is required")
+
+	err := (&profile.LocalPolicy{
+		Rules: []*profile.PolicyRule{
+			{Condition: "true"},
+			{Name: "invalid", Condition: "$.credential_types in"},
+		},
+	}).Validate()
+	require.ErrorContains(t, err, "rule 0: name is required")
+	require.ErrorContains(t, err, `rule "invalid": parse condition`)
+}
+
+func TestLocalPolicy_Evaluate(t *testing.T) {
+	policy := &profile.LocalPolicy{
+		Rules: []*profile.PolicyRule{
+			{
+				Name: "employee-attestation",
+				Condition: `!("VerifiedEmployee" in $.credential_types) || ` +
+					`"EmployeeWallet" in $.attestation_credentials[*].type[*]`,
+				DenyReason: "VerifiedEmployee requires an employee wallet",
+			},
+			{
+				Name:      "issuer",
+				Condition: `$.issuer_did == "did:example:issuer"`,
+			},
+		},
+	}
+
+	input := func(credentialType, attestationType, issuerDID string) map[string]interface{} {
+		return map[string]interface{}{
+			"credential_types": []interface{}{credentialType},
+			"issuer_did":       issuerDID,
+			"attestation_credentials": []interface{}{
+				map[string]interface{}{
+					"type": []interface{}{"VerifiableCredential", attestationType},
+				},
+			},
+		}
+	}
+
+	t.Run("allowed", func(t *testing.T) {
+		decision, err := policy.Evaluate(context.Background(),
+			input("VerifiedEmployee", "EmployeeWallet", "did:example:issuer"))
+		require.NoError(t, err)
+		require.True(t, decision.Allowed)
+		require.Empty(t, decision.DenyReasons)
+
+		decision, err = policy.Evaluate(context.Background(),
+			input("UniversityDegree", "WalletAttestationCredential", "did:example:issuer"))
+		require.NoError(t, err)
+		require.True(t, decision.Allowed)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		decision, err := policy.Evaluate(context.Background(),
+			input("VerifiedEmployee", "WalletAttestationCredential", "did:example:other"))
+		require.NoError(t, err)
+		require.False(t, decision.Allowed)
+		require.Equal(t, []string{"VerifiedEmployee requires an employee wallet", "issuer"}, decision.DenyReasons)
+	})
+
+	t.Run("evaluation error", func(t *testing.T) {
+		_, err := (&profile.LocalPolicy{
+			Rules: []*profile.PolicyRule{{Name: "unknown", Condition: "$.unknown_field"}},
+		}).Evaluate(context.Background(), map[string]interface{}{})
+		require.ErrorContains(t, err, `rule "unknown": evaluate condition`)
+
+		_, err = (&profile.LocalPolicy{
+			Rules: []*profile.PolicyRule{{Name: "not-bool", Condition: "$.issuer_did"}},
+		}).Evaluate(context.Background(), map[string]interface{}{"issuer_did": "did:example:issuer"})
+		require.ErrorContains(t, err, `rule "not-bool": condition evaluated to string, bool expected`)
+	})
+}
@@ -0,0 +1,34 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile
+
+import (
+	"errors"
+	"time"
+)
+
+// PresentationArchiveConfig enables the archive of presentations received in OIDC4VP interactions. Raw VP tokens
+// and the presentation submission are kept encrypted for the retention period, even after the received claims
+// were retrieved and deleted. Archived presentations of the profile are linked in a hash chain.
+type PresentationArchiveConfig struct {
+	// RetentionDays is a number of days the archived presentation is kept for.
+	RetentionDays int32 `json:"retentionDays"`
+}
+
+// Validate checks that the retention period is set.
+func (c *PresentationArchiveConfig) Validate() error {
+	if c.RetentionDays <= 0 {
+		return errors.New("retention days must be greater than zero")
+	}
+
+	return nil
+}
+
+// RetentionPeriod returns the retention period of archived presentations.
+func (c *PresentationArchiveConfig) RetentionPeriod() time.Duration {
+	return time.Duration(c.RetentionDays) * 24 * time.Hour
+}
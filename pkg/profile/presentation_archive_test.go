@@ -0,0 +1,29 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/vcs/pkg/profile"
+)
+
+func TestPresentationArchiveConfig_Validate(t *testing.T) {
+	require.NoError(t, (&profile.PresentationArchiveConfig{RetentionDays: 365}).Validate())
+
+	require.ErrorContains(t, (&profile.PresentationArchiveConfig{}).Validate(),
+		"retention days must be greater than zero")
+	require.ErrorContains(t, (&profile.PresentationArchiveConfig{RetentionDays: -1}).Validate(),
+		"retention days must be greater than zero")
+}
+
+func TestPresentationArchiveConfig_RetentionPeriod(t *testing.T) {
+	require.Equal(t, 48*time.Hour, (&profile.PresentationArchiveConfig{RetentionDays: 2}).RetentionPeriod())
+}
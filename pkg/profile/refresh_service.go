@@ -0,0 +1,70 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/trustbloc/vc-go/verifiable"
+)
+
+// DefaultRefreshServiceType is the default type of the credential refresh service.
+const DefaultRefreshServiceType = "VerifiableCredentialRefreshService2021"
+
+// RefreshServiceURL returns URL of the credential refresh endpoint of the issuer profile.
+func RefreshServiceURL(host string, profileID ID, profileVersion Version) string {
+	return fmt.Sprintf("%s/issuer/profiles/%s/%s/credentials/refresh",
+		strings.TrimSuffix(host, "/"), profileID, profileVersion)
+}
+
+// TypedID returns refreshService entry of the credential. defaultURL is used if URL of the refresh service
+// is not configured.
+func (c *RefreshServiceConfig) TypedID(defaultURL string) verifiable.TypedID {
+	refreshService := verifiable.TypedID{
+		ID:   c.URL,
+		Type: c.Type,
+	}
+
+	if refreshService.ID == "" {
+		refreshService.ID = defaultURL
+	}
+
+	if refreshService.Type == "" {
+		refreshService.Type = DefaultRefreshServiceType
+	}
+
+	return refreshService
+}
+
+// Validate checks the claim endpoint and the claims request of the refresh service.
+func (c *RefreshServiceConfig) Validate() error {
+	if c.ClaimEndpoint == "" {
+		return errors.New("claim endpoint is required")
+	}
+
+	if c.ClaimsRequest == nil {
+		return errors.New("claims request is required")
+	}
+
+	if c.ClaimsRequest.AuthMethod != ClaimsRequestAuthClientCredentials &&
+		c.ClaimsRequest.AuthMethod != ClaimsRequestAuthTLSClient {
+		return fmt.Errorf("claims request auth method %q is not supported, use %q or %q",
+			c.ClaimsRequest.AuthMethod, ClaimsRequestAuthClientCredentials, ClaimsRequestAuthTLSClient)
+	}
+
+	if c.ClaimsRequest.BodyTemplate != "" {
+		return errors.New("claims request body template is not supported")
+	}
+
+	if err := c.ClaimsRequest.Validate(); err != nil {
+		return fmt.Errorf("claims request: %w", err)
+	}
+
+	return nil
+}
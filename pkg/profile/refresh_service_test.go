@@ -0,0 +1,74 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/vc-go/verifiable"
+
+	"github.com/trustbloc/vcs/pkg/profile"
+)
+
+func TestRefreshServiceConfig_TypedID(t *testing.T) {
+	url := profile.RefreshServiceURL("https://vcs.example.com/", "profileID", "v1.0")
+	require.Equal(t, "https://vcs.example.com/issuer/profiles/profileID/v1.0/credentials/refresh", url)
+
+	require.Equal(t, verifiable.TypedID{
+		ID:   url,
+		Type: profile.DefaultRefreshServiceType,
+	}, (&profile.RefreshServiceConfig{}).TypedID(url))
+
+	require.Equal(t, verifiable.TypedID{
+		ID:   "https://issuer.example.com/refresh",
+		Type: "ManualRefreshService2018",
+	}, (&profile.RefreshServiceConfig{
+		URL:  "https://issuer.example.com/refresh",
+		Type: "ManualRefreshService2018",
+	}).TypedID(url))
+}
+
+func TestRefreshServiceConfig_Validate(t *testing.T) {
+	require.NoError(t, (&profile.RefreshServiceConfig{
+		ClaimEndpoint: "https://issuer.example.com/claims",
+		ClaimsRequest: &profile.ClaimsRequestConfig{
+			AuthMethod:    profile.ClaimsRequestAuthClientCredentials,
+			TokenEndpoint: "https://issuer.example.com/token",
+		},
+	}).Validate())
+
+	require.NoError(t, (&profile.RefreshServiceConfig{
+		ClaimEndpoint: "https://issuer.example.com/claims",
+		ClaimsRequest: &profile.ClaimsRequestConfig{
+			AuthMethod:  profile.ClaimsRequestAuthTLSClient,
+			TLSCertFile: "cert.pem",
+			TLSKeyFile:  "key.pem",
+		},
+	}).Validate())
+
+	require.ErrorContains(t, (&profile.RefreshServiceConfig{}).Validate(), "claim endpoint is required")
+	require.ErrorContains(t, (&profile.RefreshServiceConfig{
+		ClaimEndpoint: "https://issuer.example.com/claims",
+	}).Validate(), "claims request is required")
+	require.ErrorContains(t, (&profile.RefreshServiceConfig{
+		ClaimEndpoint: "https://issuer.example.com/claims",
+		ClaimsRequest: &profile.ClaimsRequestConfig{},
+	}).Validate(), `claims request auth method "" is not supported`)
+	require.ErrorContains(t, (&profile.RefreshServiceConfig{
+		ClaimEndpoint: "https://issuer.example.com/claims",
+		ClaimsRequest: &profile.ClaimsRequestConfig{
+			AuthMethod:    profile.ClaimsRequestAuthClientCredentials,
+			TokenEndpoint: "https://issuer.example.com/token",
+			BodyTemplate:  "{}",
+		},
+	}).Validate(), "claims request body template is not supported")
+	require.ErrorContains(t, (&profile.RefreshServiceConfig{
+		ClaimEndpoint: "https://issuer.example.com/claims",
+		ClaimsRequest: &profile.ClaimsRequestConfig{AuthMethod: profile.ClaimsRequestAuthClientCredentials},
+	}).Validate(), "claims request: token endpoint is required")
+}
@@ -0,0 +1,97 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// ResponseRedirectConfig configures the same-device OIDC4VP flow, where the user is redirected back
+// to the verifier after the wallet submitted the presentation.
+type ResponseRedirectConfig struct {
+	// AllowedURIs are the redirect URIs verifier can request on initiating the interaction. Requested URI
+	// is allowed if it has the same scheme and host as one of the allowed URIs and its cleaned path is
+	// either equal to the path of the allowed URI or is a sub-path of it (e.g. "/callback/session" for
+	// "/callback", but not "/callback-evil" or "/callback/../admin").
+	AllowedURIs []string `json:"allowedUris"`
+}
+
+// Validate checks that allowed URIs are absolute http(s) URLs.
+func (c *ResponseRedirectConfig) Validate() error {
+	if len(c.AllowedURIs) == 0 {
+		return errors.New("at least one allowed uri must be set")
+	}
+
+	for _, uri := range c.AllowedURIs {
+		if _, err := parseRedirectURI(uri); err != nil {
+			return fmt.Errorf("allowed uri %q: %w", uri, err)
+		}
+	}
+
+	return nil
+}
+
+// IsAllowed returns true if the given redirect URI matches one of the allowed URIs.
+func (c *ResponseRedirectConfig) IsAllowed(uri string) bool {
+	if c == nil {
+		return false
+	}
+
+	u, err := parseRedirectURI(uri)
+	if err != nil {
+		return false
+	}
+
+	for _, allowedURI := range c.AllowedURIs {
+		allowed, err := parseRedirectURI(allowedURI)
+		if err != nil {
+			continue
+		}
+
+		if u.Scheme == allowed.Scheme && strings.EqualFold(u.Host, allowed.Host) &&
+			isSubPath(cleanPath(u.Path), cleanPath(allowed.Path)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSubPath returns true if p is equal to the parent path or is a path under it.
+func isSubPath(p, parent string) bool {
+	if p == parent || parent == "/" {
+		return true
+	}
+
+	return strings.HasPrefix(p, parent+"/")
+}
+
+// cleanPath resolves dot segments of the path, so they can't be used to escape the allowed path.
+func cleanPath(p string) string {
+	return path.Clean("/" + p)
+}
+
+func parseRedirectURI(uri string) (*url.URL, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("parse uri: %w", err)
+	}
+
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return nil, errors.New("uri must be an absolute http(s) url")
+	}
+
+	if u.Fragment != "" || u.User != nil {
+		return nil, errors.New("uri must not contain a fragment or user info")
+	}
+
+	return u, nil
+}
@@ -0,0 +1,70 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/vcs/pkg/profile"
+)
+
+func TestResponseRedirectConfig_Validate(t *testing.T) {
+	require.NoError(t, (&profile.ResponseRedirectConfig{
+		AllowedURIs: []string{"https://verifier.example.com/callback", "http://localhost:8080"},
+	}).Validate())
+
+	require.ErrorContains(t, (&profile.ResponseRedirectConfig{}).Validate(), "at least one allowed uri must be set")
+	require.ErrorContains(t, (&profile.ResponseRedirectConfig{
+		AllowedURIs: []string{"verifier://callback"},
+	}).Validate(), `allowed uri "verifier://callback": uri must be an absolute http(s) url`)
+	require.ErrorContains(t, (&profile.ResponseRedirectConfig{
+		AllowedURIs: []string{"https://verifier.example.com/%zz"},
+	}).Validate(), "parse uri")
+	require.ErrorContains(t, (&profile.ResponseRedirectConfig{
+		AllowedURIs: []string{"https://verifier.example.com/callback#fragment"},
+	}).Validate(), "uri must not contain a fragment or user info")
+}
+
+func TestResponseRedirectConfig_IsAllowed(t *testing.T) {
+	var nilConfig *profile.ResponseRedirectConfig
+
+	require.False(t, nilConfig.IsAllowed("https://verifier.example.com/callback"))
+
+	config := &profile.ResponseRedirectConfig{
+		AllowedURIs: []string{"https://verifier.example.com/callback", "%zz", "https://other.example.com/cb/"},
+	}
+
+	require.True(t, config.IsAllowed("https://verifier.example.com/callback"))
+	require.True(t, config.IsAllowed("https://verifier.example.com/callback/"))
+	require.True(t, config.IsAllowed("https://VERIFIER.example.com/callback/session?id=1"))
+	require.True(t, config.IsAllowed("https://verifier.example.com/callback/./session"))
+	require.True(t, config.IsAllowed("https://other.example.com/cb"))
+	require.True(t, config.IsAllowed("https://other.example.com/cb/session"))
+
+	require.False(t, config.IsAllowed("https://verifier.example.com/callback-evil"))
+	require.False(t, config.IsAllowed("https://verifier.example.com/callbackevil/session"))
+	require.False(t, config.IsAllowed("https://verifier.example.com/callback/../admin"))
+	require.False(t, config.IsAllowed("https://verifier.example.com/callback/%2e%2e/admin"))
+	require.False(t, config.IsAllowed("https://verifier.example.com"))
+	require.False(t, config.IsAllowed("https://other.example.com/cbx"))
+
+	require.False(t, config.IsAllowed("http://verifier.example.com/callback"))
+	require.False(t, config.IsAllowed("https://verifier.example.com.evil.com/callback"))
+	require.False(t, config.IsAllowed("https://verifier.example.com/other"))
+	require.False(t, config.IsAllowed("https://user@verifier.example.com/callback"))
+	require.False(t, config.IsAllowed("/callback"))
+
+	rootConfig := &profile.ResponseRedirectConfig{
+		AllowedURIs: []string{"https://verifier.example.com"},
+	}
+
+	require.True(t, rootConfig.IsAllowed("https://verifier.example.com"))
+	require.True(t, rootConfig.IsAllowed("https://verifier.example.com/any/path"))
+	require.False(t, rootConfig.IsAllowed("https://other.example.com/any/path"))
+}
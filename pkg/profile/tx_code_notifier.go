@@ -0,0 +1,105 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"text/template"
+)
+
+// TxCodeNotifierConfig configures delivery of transaction codes of the pre-authorized code flow by email
+// and SMS. Message templates are Go templates executed with TxCode, IssuerName and InitiateIssuanceURL fields.
+type TxCodeNotifierConfig struct {
+	// SMTP configures delivery of the transaction code by email.
+	SMTP *SMTPConfig `json:"smtp,omitempty"`
+	// SMSGateway configures delivery of the transaction code by SMS.
+	SMSGateway *SMSGatewayConfig `json:"smsGateway,omitempty"`
+	// EmailSubjectTemplate is a template of the email subject.
+	EmailSubjectTemplate string `json:"emailSubjectTemplate,omitempty"`
+	// EmailBodyTemplate is a template of the email body.
+	EmailBodyTemplate string `json:"emailBodyTemplate,omitempty"`
+	// SMSTemplate is a template of the SMS text.
+	SMSTemplate string `json:"smsTemplate,omitempty"`
+}
+
+// SMTPConfig configures the SMTP server sending emails.
+type SMTPConfig struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+	// Username and Password authenticate the client with PLAIN auth if Username is set.
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	// From is the sender address.
+	From string `json:"from"`
+	// AllowPlaintext allows sending emails over an unencrypted connection if the server doesn't support
+	// STARTTLS. Transaction codes are sent in plaintext, so it is intended for local development only.
+	AllowPlaintext bool `json:"allowPlaintext,omitempty"`
+}
+
+// SMSGatewayConfig configures the HTTP gateway sending SMS.
+type SMSGatewayConfig struct {
+	// URL is the endpoint of the gateway. Messages are sent with POST requests.
+	URL string `json:"url"`
+	// Headers are additional headers of the request, e.g. Authorization.
+	Headers map[string]string `json:"headers,omitempty"`
+	// BodyTemplate is Go template of the request body executed with To and Message fields. Defaults to
+	// {"to": "<phone>", "message": "<text>"}. "json" function is available to encode values as JSON.
+	BodyTemplate string `json:"bodyTemplate,omitempty"`
+}
+
+// Validate checks the notifier configuration and parses its templates.
+func (c *TxCodeNotifierConfig) Validate() error {
+	if c.SMTP == nil && c.SMSGateway == nil {
+		return errors.New("smtp or sms gateway must be configured")
+	}
+
+	if c.SMTP != nil && (c.SMTP.Host == "" || c.SMTP.Port <= 0 || c.SMTP.From == "") {
+		return errors.New("smtp host, port and from address are required")
+	}
+
+	if c.SMSGateway != nil {
+		u, err := url.Parse(c.SMSGateway.URL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return fmt.Errorf("invalid sms gateway url %q", c.SMSGateway.URL)
+		}
+
+		if _, err = c.SMSGateway.ParseBodyTemplate(); err != nil {
+			return err
+		}
+	}
+
+	for name, text := range map[string]string{
+		"email subject": c.EmailSubjectTemplate,
+		"email body":    c.EmailBodyTemplate,
+		"sms":           c.SMSTemplate,
+	} {
+		if _, err := template.New(name).Parse(text); err != nil {
+			return fmt.Errorf("parse %s template: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// ParseBodyTemplate parses the body template of the gateway request. Returns nil if the body template is not set.
+func (c *SMSGatewayConfig) ParseBodyTemplate() (*template.Template, error) {
+	if c.BodyTemplate == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	tmpl, err := template.New("body").
+		Option("missingkey=error").
+		Funcs(template.FuncMap{"json": marshalTemplateValue}).
+		Parse(c.BodyTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("parse sms gateway body template: %w", err)
+	}
+
+	return tmpl, nil
+}
@@ -0,0 +1,44 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/vcs/pkg/profile"
+)
+
+func TestTxCodeNotifierConfig_Validate(t *testing.T) {
+	smtp := &profile.SMTPConfig{Host: "smtp.example.com", Port: 587, From: "noreply@example.com"}
+
+	require.NoError(t, (&profile.TxCodeNotifierConfig{SMTP: smtp}).Validate())
+	require.NoError(t, (&profile.TxCodeNotifierConfig{
+		SMSGateway: &profile.SMSGatewayConfig{
+			URL:          "https://sms.example.com/messages",
+			BodyTemplate: `{"to":{{json .To}},"text":{{json .Message}}}`,
+		},
+		SMSTemplate: "Code: {{.TxCode}}",
+	}).Validate())
+
+	require.ErrorContains(t, (&profile.TxCodeNotifierConfig{}).Validate(),
+		"smtp or sms gateway must be configured")
+	require.ErrorContains(t, (&profile.TxCodeNotifierConfig{
+		SMTP: &profile.SMTPConfig{Host: "smtp.example.com"},
+	}).Validate(), "smtp host, port and from address are required")
+	require.ErrorContains(t, (&profile.TxCodeNotifierConfig{
+		SMSGateway: &profile.SMSGatewayConfig{URL: "sms.example.com"},
+	}).Validate(), `invalid sms gateway url "sms.example.com"`)
+	require.ErrorContains(t, (&profile.TxCodeNotifierConfig{
+		SMSGateway: &profile.SMSGatewayConfig{URL: "https://sms.example.com", BodyTemplate: "{{"},
+	}).Validate(), "parse sms gateway body template")
+	require.ErrorContains(t, (&profile.TxCodeNotifierConfig{
+		SMTP:              smtp,
+		EmailBodyTemplate: "{{.TxCode",
+	}).Validate(), "parse email body template")
+}
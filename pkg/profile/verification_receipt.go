@@ -0,0 +1,47 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile
+
+import (
+	"fmt"
+
+	"github.com/trustbloc/kms-go/spi/kms"
+
+	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
+)
+
+// VerificationReceiptConfig enables signed verification receipts. A receipt is a JWT signed with the signing DID
+// of the verifier profile. It records digests of the verified credentials and presentations, the checks
+// performed and versions of the status lists used for the status check.
+type VerificationReceiptConfig struct {
+	// KeyType is a type of the key used to sign receipts. Defaults to the key type of the OIDC config.
+	KeyType kms.KeyType `json:"keyType,omitempty"`
+	// AttachToEvent adds the receipt to the verifier interaction succeeded event.
+	AttachToEvent bool `json:"attachToEvent,omitempty"`
+}
+
+// Validate checks that the key type, if set, can be used to sign JWTs.
+func (c *VerificationReceiptConfig) Validate() error {
+	if c.KeyType == "" {
+		return nil
+	}
+
+	if len(vcsverifiable.GetSignatureTypesByKeyTypeFormat(c.KeyType, vcsverifiable.Jwt)) == 0 {
+		return fmt.Errorf("unsupported jwt key type %s", c.KeyType)
+	}
+
+	return nil
+}
+
+// SigningKeyType returns the type of the key used to sign receipts of the given profile.
+func (c *VerificationReceiptConfig) SigningKeyType(profile *Verifier) kms.KeyType {
+	if c.KeyType != "" || profile.OIDCConfig == nil {
+		return c.KeyType
+	}
+
+	return profile.OIDCConfig.KeyType
+}
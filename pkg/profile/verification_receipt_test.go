@@ -0,0 +1,36 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/kms-go/spi/kms"
+
+	"github.com/trustbloc/vcs/pkg/profile"
+)
+
+func TestVerificationReceiptConfig_Validate(t *testing.T) {
+	require.NoError(t, (&profile.VerificationReceiptConfig{}).Validate())
+	require.NoError(t, (&profile.VerificationReceiptConfig{KeyType: kms.ED25519Type}).Validate())
+
+	require.ErrorContains(t, (&profile.VerificationReceiptConfig{KeyType: kms.BLS12381G2Type}).Validate(),
+		"unsupported jwt key type BLS12381G2")
+}
+
+func TestVerificationReceiptConfig_SigningKeyType(t *testing.T) {
+	oidcProfile := &profile.Verifier{
+		OIDCConfig: &profile.OIDC4VPConfig{KeyType: kms.ECDSASecp256k1TypeIEEEP1363},
+	}
+
+	require.Equal(t, kms.ED25519Type,
+		(&profile.VerificationReceiptConfig{KeyType: kms.ED25519Type}).SigningKeyType(oidcProfile))
+	require.Equal(t, kms.ECDSASecp256k1TypeIEEEP1363,
+		(&profile.VerificationReceiptConfig{}).SigningKeyType(oidcProfile))
+	require.Empty(t, (&profile.VerificationReceiptConfig{}).SigningKeyType(&profile.Verifier{}))
+}
@@ -0,0 +1,79 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const walletURLQueryPlaceholder = "{query}"
+
+var urlSchemeRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*$`)
+
+// WalletURLConfig configures the URL passing OpenID requests to wallets, e.g. credential offers
+// and authorization requests.
+type WalletURLConfig struct {
+	// Scheme replaces the scheme of the default URL, e.g. "haip".
+	Scheme string `json:"scheme,omitempty"`
+	// UniversalLinkTemplate is an https URL of the wallet universal link, e.g.
+	// "https://wallet.example.com/openid?{query}". "{query}" placeholder is replaced with the query of the request.
+	// The query is appended to the URL if the placeholder is absent. Takes precedence over Scheme.
+	UniversalLinkTemplate string `json:"universalLinkTemplate,omitempty"`
+}
+
+// Validate checks the scheme and the universal link template.
+func (c *WalletURLConfig) Validate() error {
+	if c.Scheme != "" && !urlSchemeRegexp.MatchString(c.Scheme) {
+		return fmt.Errorf("invalid scheme %q", c.Scheme)
+	}
+
+	if c.UniversalLinkTemplate == "" {
+		return nil
+	}
+
+	u, err := url.Parse(strings.ReplaceAll(c.UniversalLinkTemplate, walletURLQueryPlaceholder, ""))
+	if err != nil {
+		return fmt.Errorf("parse universal link template: %w", err)
+	}
+
+	if u.Scheme != "https" || u.Host == "" {
+		return errors.New("universal link template must be an absolute https url")
+	}
+
+	return nil
+}
+
+// BuildURL returns the wallet URL with the given query. defaultURL, e.g. "openid-credential-offer://",
+// is used if neither the universal link template nor the scheme is configured.
+func (c *WalletURLConfig) BuildURL(defaultURL, query string) string {
+	switch {
+	case c != nil && c.UniversalLinkTemplate != "":
+		if strings.Contains(c.UniversalLinkTemplate, walletURLQueryPlaceholder) {
+			return strings.ReplaceAll(c.UniversalLinkTemplate, walletURLQueryPlaceholder, query)
+		}
+
+		if strings.Contains(c.UniversalLinkTemplate, "?") {
+			return c.UniversalLinkTemplate + "&" + query
+		}
+
+		return c.UniversalLinkTemplate + "?" + query
+	case c != nil && c.Scheme != "":
+		if i := strings.Index(defaultURL, "://"); i >= 0 {
+			defaultURL = defaultURL[i:]
+		} else {
+			defaultURL = "://"
+		}
+
+		return c.Scheme + defaultURL + "?" + query
+	default:
+		return defaultURL + "?" + query
+	}
+}
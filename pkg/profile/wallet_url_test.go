@@ -0,0 +1,54 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/vcs/pkg/profile"
+)
+
+func TestWalletURLConfig_Validate(t *testing.T) {
+	require.NoError(t, (&profile.WalletURLConfig{}).Validate())
+	require.NoError(t, (&profile.WalletURLConfig{Scheme: "haip"}).Validate())
+	require.NoError(t, (&profile.WalletURLConfig{
+		UniversalLinkTemplate: "https://wallet.example.com/offer?{query}",
+	}).Validate())
+
+	require.ErrorContains(t, (&profile.WalletURLConfig{Scheme: "haip://"}).Validate(), `invalid scheme "haip://"`)
+	require.ErrorContains(t, (&profile.WalletURLConfig{
+		UniversalLinkTemplate: "http://wallet.example.com/offer",
+	}).Validate(), "universal link template must be an absolute https url")
+	require.ErrorContains(t, (&profile.WalletURLConfig{
+		UniversalLinkTemplate: "https://wallet.example.com/%zz",
+	}).Validate(), "parse universal link template")
+}
+
+func TestWalletURLConfig_BuildURL(t *testing.T) {
+	const (
+		defaultURL = "openid-credential-offer://"
+		query      = "credential_offer_uri=https%3A%2F%2Fvcs.example.com%2Foffer"
+	)
+
+	var nilConfig *profile.WalletURLConfig
+
+	require.Equal(t, defaultURL+"?"+query, nilConfig.BuildURL(defaultURL, query))
+	require.Equal(t, defaultURL+"?"+query, (&profile.WalletURLConfig{}).BuildURL(defaultURL, query))
+	require.Equal(t, "haip://?"+query, (&profile.WalletURLConfig{Scheme: "haip"}).BuildURL(defaultURL, query))
+	require.Equal(t, "https://wallet.example.com/offer?"+query, (&profile.WalletURLConfig{
+		Scheme:                "haip",
+		UniversalLinkTemplate: "https://wallet.example.com/offer",
+	}).BuildURL(defaultURL, query))
+	require.Equal(t, "https://wallet.example.com/offer?lang=en&"+query, (&profile.WalletURLConfig{
+		UniversalLinkTemplate: "https://wallet.example.com/offer?lang=en",
+	}).BuildURL(defaultURL, query))
+	require.Equal(t, "https://wallet.example.com/offer/cb?"+query+"&lang=en", (&profile.WalletURLConfig{
+		UniversalLinkTemplate: "https://wallet.example.com/offer/cb?{query}&lang=en",
+	}).BuildURL(defaultURL, query))
+}
@@ -0,0 +1,156 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package qrcode
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+)
+
+// Format is an image format of the QR code.
+type Format string
+
+// Supported image formats.
+const (
+	FormatPNG Format = "png"
+	FormatSVG Format = "svg"
+)
+
+// ErrorCorrection is an error correction level of the QR code.
+type ErrorCorrection string
+
+// Error correction levels: L recovers 7% of data, M - 15%, Q - 25%, H - 30%.
+const (
+	ErrorCorrectionL ErrorCorrection = "L"
+	ErrorCorrectionM ErrorCorrection = "M"
+	ErrorCorrectionQ ErrorCorrection = "Q"
+	ErrorCorrectionH ErrorCorrection = "H"
+)
+
+const (
+	// DefaultSize is the default width and height of the QR code image in pixels.
+	DefaultSize = 256
+	// MaxSize is the max width and height of the QR code image in pixels.
+	MaxSize = 2048
+)
+
+// Options defines QR code rendering options. Zero values are replaced with defaults:
+// png format, M error correction level and DefaultSize.
+type Options struct {
+	Format          Format
+	ErrorCorrection ErrorCorrection
+	Size            int
+}
+
+// Validate checks the format, the error correction level and the size of the QR code.
+func (o *Options) Validate() error {
+	if o.Format != "" && o.Format != FormatPNG && o.Format != FormatSVG {
+		return fmt.Errorf("unsupported qr code format %q", o.Format)
+	}
+
+	if _, err := recoveryLevel(o.ErrorCorrection); err != nil {
+		return err
+	}
+
+	if o.Size < 0 || o.Size > MaxSize {
+		return fmt.Errorf("qr code size must be between 1 and %d", MaxSize)
+	}
+
+	return nil
+}
+
+// Encode renders the content as QR code image. Returns the image and its content type.
+func Encode(content string, opts *Options) ([]byte, string, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, "", err
+	}
+
+	level, _ := recoveryLevel(opts.ErrorCorrection) //nolint:errcheck // validated above
+
+	size := opts.Size
+	if size == 0 {
+		size = DefaultSize
+	}
+
+	code, err := qrcode.New(content, level)
+	if err != nil {
+		return nil, "", fmt.Errorf("encode qr code: %w", err)
+	}
+
+	if opts.Format == FormatSVG {
+		return renderSVG(code.Bitmap(), size), "image/svg+xml", nil
+	}
+
+	b, err := code.PNG(size)
+	if err != nil {
+		return nil, "", fmt.Errorf("render png: %w", err)
+	}
+
+	return b, "image/png", nil
+}
+
+// DataURI renders the content as QR code image and returns it as base64 encoded data URI.
+func DataURI(content string, opts *Options) (string, error) {
+	b, contentType, err := Encode(content, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return "data:" + contentType + ";base64," + base64.StdEncoding.EncodeToString(b), nil
+}
+
+func recoveryLevel(errorCorrection ErrorCorrection) (qrcode.RecoveryLevel, error) {
+	switch ErrorCorrection(strings.ToUpper(string(errorCorrection))) {
+	case ErrorCorrectionL:
+		return qrcode.Low, nil
+	case "", ErrorCorrectionM:
+		return qrcode.Medium, nil
+	case ErrorCorrectionQ:
+		return qrcode.High, nil
+	case ErrorCorrectionH:
+		return qrcode.Highest, nil
+	default:
+		return 0, fmt.Errorf("unsupported qr code error correction level %q", errorCorrection)
+	}
+}
+
+// renderSVG renders QR code modules as a single path. Adjacent dark modules of a row are merged
+// into one rectangle to keep the image small.
+func renderSVG(bitmap [][]bool, size int) []byte {
+	var path strings.Builder
+
+	for y, row := range bitmap {
+		for x := 0; x < len(row); x++ {
+			if !row[x] {
+				continue
+			}
+
+			start := x
+			for x < len(row) && row[x] {
+				x++
+			}
+
+			fmt.Fprintf(&path, "M%d %dh%dv1h-%dz", start, y, x-start, x-start)
+		}
+	}
+
+	var svg strings.Builder
+
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" `+
+		`shape-rendering="crispEdges">`, size, size, len(bitmap), len(bitmap))
+	fmt.Fprintf(&svg, `<rect width="100%%" height="100%%" fill="#fff"/><path fill="#000" d="%s"/></svg>`,
+		path.String())
+
+	return []byte(svg.String())
+}
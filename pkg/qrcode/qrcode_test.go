@@ -0,0 +1,86 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package qrcode_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/vcs/pkg/qrcode"
+)
+
+const content = "openid-credential-offer://?credential_offer_uri=https%3A%2F%2Fvcs.example.com%2Foffer"
+
+func TestEncode(t *testing.T) {
+	t.Run("png", func(t *testing.T) {
+		b, contentType, err := qrcode.Encode(content, &qrcode.Options{
+			ErrorCorrection: qrcode.ErrorCorrectionH,
+			Size:            300,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "image/png", contentType)
+
+		img, err := png.Decode(bytes.NewReader(b))
+		require.NoError(t, err)
+		require.Equal(t, 300, img.Bounds().Dx())
+		require.Equal(t, 300, img.Bounds().Dy())
+	})
+
+	t.Run("default options", func(t *testing.T) {
+		b, contentType, err := qrcode.Encode(content, nil)
+		require.NoError(t, err)
+		require.Equal(t, "image/png", contentType)
+
+		img, err := png.Decode(bytes.NewReader(b))
+		require.NoError(t, err)
+		require.Equal(t, qrcode.DefaultSize, img.Bounds().Dx())
+	})
+
+	t.Run("svg", func(t *testing.T) {
+		b, contentType, err := qrcode.Encode(content, &qrcode.Options{
+			Format:          qrcode.FormatSVG,
+			ErrorCorrection: "q",
+			Size:            200,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "image/svg+xml", contentType)
+		require.True(t, strings.HasPrefix(string(b), `<svg xmlns="http://www.w3.org/2000/svg" width="200" height="200"`))
+		require.Contains(t, string(b), `<path fill="#000" d="M`)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, _, err := qrcode.Encode(content, &qrcode.Options{Format: "gif"})
+		require.ErrorContains(t, err, `unsupported qr code format "gif"`)
+
+		_, _, err = qrcode.Encode(content, &qrcode.Options{ErrorCorrection: "X"})
+		require.ErrorContains(t, err, `unsupported qr code error correction level "X"`)
+
+		_, _, err = qrcode.Encode(content, &qrcode.Options{Size: qrcode.MaxSize + 1})
+		require.ErrorContains(t, err, "qr code size must be between 1 and 2048")
+
+		_, _, err = qrcode.Encode(strings.Repeat("a", 8000), nil)
+		require.ErrorContains(t, err, "encode qr code")
+	})
+}
+
+func TestDataURI(t *testing.T) {
+	uri, err := qrcode.DataURI(content, &qrcode.Options{Format: qrcode.FormatSVG})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(uri, "data:image/svg+xml;base64,"))
+
+	b, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(uri, "data:image/svg+xml;base64,"))
+	require.NoError(t, err)
+	require.Contains(t, string(b), "<svg")
+
+	_, err = qrcode.DataURI(content, &qrcode.Options{Format: "gif"})
+	require.Error(t, err)
+}
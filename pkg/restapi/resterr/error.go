@@ -58,6 +58,7 @@ const (
 	VCOptionsNotConfigured           ErrorCode = "vc-options-not-configured"
 	InvalidIssuerURL                 ErrorCode = "invalid-issuer-url"
 	InvalidStateTransition           ErrorCode = "invalid-state-transition"
+	TooManyRequests                  ErrorCode = "too-many-requests"
 )
 
 type Component = string
@@ -77,6 +78,8 @@ const (
 	VerifierKMSRegistryComponent          Component = "verifier.kms-registry"
 	VerifierPresentationVerifierComponent Component = "verifier.presentation-verifier"
 	VerifierDataIntegrityVerifier         Component = "verifier.data-integrity-verifier"
+	VerifierReceiptSvcComponent           Component = "verifier.receipt-service"
+	VerifierPresentationArchiveComponent  Component = "verifier.presentation-archive"
 
 	ClientIDSchemeSvcComponent             Component = "client-id-scheme-service"
 	ClientManagerComponent                 Component = "client-manager"
@@ -87,6 +90,8 @@ const (
 	CryptoJWTSignerComponent               Component = "crypto-jwt-signer"
 	CredentialOfferReferenceStoreComponent Component = "credential-offer-reference-store"
 	RedisComponent                         Component = "redis-service"
+	QRCodeComponent                        Component = "qr-code"
+	TxCodeNotifierComponent                Component = "tx-code-notifier"
 )
 
 var (
@@ -217,6 +222,9 @@ func (e *CustomError) HTTPCodeMsg() (int, interface{}) {
 	case ConditionNotMet:
 		code = http.StatusPreconditionFailed
 
+	case TooManyRequests:
+		code = http.StatusTooManyRequests
+
 	case InvalidValue:
 		fallthrough
 
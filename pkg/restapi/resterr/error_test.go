@@ -82,6 +82,17 @@ func TestNewValidationError(t *testing.T) {
 		requireMessage(t, resp, "some error")
 	})
 
+	t.Run("too many requests error", func(t *testing.T) {
+		err := NewCustomError(TooManyRequests, errors.New("some error"))
+		require.Equal(t, "too-many-requests: some error", err.Error())
+
+		httpCode, resp := err.HTTPCodeMsg()
+
+		require.Equal(t, http.StatusTooManyRequests, httpCode)
+		requireCode(t, resp, TooManyRequests.Name())
+		requireMessage(t, resp, "some error")
+	})
+
 	t.Run("profile not found error", func(t *testing.T) {
 		err := NewCustomError(ProfileNotFound, errors.New("some error"))
 		require.Equal(t, "profile-not-found: some error", err.Error())
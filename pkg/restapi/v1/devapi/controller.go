@@ -8,22 +8,16 @@ package devapi
 
 import (
 	"context"
-	"errors"
-	"net/http"
 	"strings"
 
 	"github.com/labstack/echo/v4"
-	"github.com/trustbloc/logutil-go/pkg/log"
 
 	apiUtil "github.com/trustbloc/vcs/pkg/restapi/v1/util"
 	"github.com/trustbloc/vcs/pkg/service/didconfiguration"
-	"github.com/trustbloc/vcs/pkg/service/requestobject"
 )
 
 //go:generate mockgen -destination controller_mocks_test.go -package devapi_test -source=controller.go
 
-var logger = log.New("oidc4vp")
-
 type didConfigService interface {
 	DidConfig(
 		ctx context.Context,
@@ -33,18 +27,12 @@ type didConfigService interface {
 	) (*didconfiguration.DidConfiguration, error)
 }
 
-type requestObjectStoreService interface {
-	Get(ctx context.Context, id string) (*requestobject.RequestObject, error)
-}
-
 type Config struct {
-	DidConfigService          didConfigService
-	RequestObjectStoreService requestObjectStoreService
+	DidConfigService didConfigService
 }
 
 type Controller struct {
-	didConfigService          didConfigService
-	requestObjectStoreService requestObjectStoreService
+	didConfigService didConfigService
 }
 
 type router interface {
@@ -56,8 +44,7 @@ func NewController(
 	router router,
 ) *Controller {
 	c := &Controller{
-		didConfigService:          config.DidConfigService,
-		requestObjectStoreService: config.RequestObjectStoreService,
+		didConfigService: config.DidConfigService,
 	}
 
 	router.GET("/:profileType/profiles/:profileID/:profileVersion/well-known/did-config",
@@ -66,10 +53,6 @@ func NewController(
 				ctx.Param("profileType"), ctx.Param("profileID"), ctx.Param("profileVersion"))
 		})
 
-	router.GET("/request-object/:uuid", func(ctx echo.Context) error {
-		return c.RequestObjectByUuid(ctx, ctx.Param("uuid"))
-	})
-
 	return c
 }
 
@@ -80,21 +63,3 @@ func (c *Controller) DidConfig(ctx echo.Context, profileType string, profileID,
 		didconfiguration.ProfileType(strings.ToLower(profileType)),
 		profileID, profileVersion))
 }
-
-// RequestObjectByUuid Receive request object by uuid.
-// GET /request-object/{uuid}.
-func (c *Controller) RequestObjectByUuid(ctx echo.Context, uuid string) error { //nolint:stylecheck,revive
-	logger.Infoc(ctx.Request().Context(), "RequestObjectByUuid begin")
-	record, err := c.requestObjectStoreService.Get(ctx.Request().Context(), uuid)
-
-	if errors.Is(err, requestobject.ErrDataNotFound) {
-		ctx.Response().Status = http.StatusNotFound
-	}
-
-	if err != nil {
-		return err
-	}
-
-	logger.Infoc(ctx.Request().Context(), "RequestObjectByUuid end")
-	return ctx.String(http.StatusOK, record.Content)
-}
@@ -18,7 +18,6 @@ import (
 
 	"github.com/trustbloc/vcs/pkg/restapi/v1/devapi"
 	"github.com/trustbloc/vcs/pkg/service/didconfiguration"
-	"github.com/trustbloc/vcs/pkg/service/requestobject"
 )
 
 const (
@@ -30,7 +29,6 @@ func TestController(t *testing.T) {
 	route := NewMockrouter(gomock.NewController(t))
 
 	route.EXPECT().GET("/:profileType/profiles/:profileID/:profileVersion/well-known/did-config", gomock.Any()).Return(nil)
-	route.EXPECT().GET("/request-object/:uuid", gomock.Any()).Return(nil)
 	assert.NotNil(t, devapi.NewController(&devapi.Config{}, route))
 }
 
@@ -47,37 +45,6 @@ func TestDidConfig(t *testing.T) {
 	assert.NoError(t, c.DidConfig(echoContext(), "issuer", profileID, profileVersion))
 }
 
-func TestRequestObjectByUUID(t *testing.T) {
-	t.Run("found", func(t *testing.T) {
-		route := NewMockrouter(gomock.NewController(t))
-		route.EXPECT().GET(gomock.Any(), gomock.Any()).AnyTimes()
-		store := NewMockrequestObjectStoreService(gomock.NewController(t))
-
-		c := devapi.NewController(&devapi.Config{
-			RequestObjectStoreService: store,
-		}, route)
-
-		store.EXPECT().Get(gomock.Any(), "123").Return(&requestobject.RequestObject{}, nil)
-		assert.NoError(t, c.RequestObjectByUuid(echoContext(), "123"))
-	})
-
-	t.Run("not found", func(t *testing.T) {
-		route := NewMockrouter(gomock.NewController(t))
-		route.EXPECT().GET(gomock.Any(), gomock.Any()).AnyTimes()
-		store := NewMockrequestObjectStoreService(gomock.NewController(t))
-
-		c := devapi.NewController(&devapi.Config{
-			RequestObjectStoreService: store,
-		}, route)
-
-		ct := echoContext()
-		store.EXPECT().Get(gomock.Any(), "123").Return(nil, requestobject.ErrDataNotFound)
-		assert.ErrorContains(t, c.RequestObjectByUuid(ct, "123"), "data not found")
-
-		assert.Equal(t, http.StatusNotFound, ct.Response().Status)
-	})
-}
-
 func echoContext() echo.Context {
 	e := echo.New()
 
@@ -0,0 +1,110 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package didweb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+	"github.com/trustbloc/did-go/doc/did"
+
+	apiUtil "github.com/trustbloc/vcs/pkg/restapi/v1/util"
+	"github.com/trustbloc/vcs/pkg/service/didconfiguration"
+)
+
+//go:generate mockgen -destination controller_mocks_test.go -package didweb_test -source=controller.go
+
+const (
+	wellKnownDIDDocumentPath      = "/.well-known/did.json"
+	wellKnownDIDConfigurationPath = "/.well-known/did-configuration.json"
+	didDocumentFile               = "did.json"
+
+	// maxPathSegments is a max number of path segments of the did:web DID, e.g. 2 for did:web:example.com:issuer:1.
+	maxPathSegments = 5
+)
+
+type didWebService interface {
+	DIDDocument(ctx context.Context, host, path string) (*did.Doc, error)
+	DIDConfiguration(ctx context.Context, host string) (*didconfiguration.DidConfiguration, error)
+}
+
+type Config struct {
+	DIDWebService didWebService
+}
+
+type Controller struct {
+	didWebService didWebService
+}
+
+type router interface {
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+func NewController(
+	config *Config,
+	router router,
+) *Controller {
+	c := &Controller{
+		didWebService: config.DIDWebService,
+	}
+
+	router.GET(wellKnownDIDDocumentPath, c.DIDDocument)
+	router.GET(wellKnownDIDConfigurationPath, c.DIDConfiguration)
+
+	for _, path := range Paths() {
+		router.GET(path, c.DIDDocument)
+	}
+
+	return c
+}
+
+// Paths returns routes of did.json documents published for DIDs with path, e.g. /:p1/:p2/did.json.
+func Paths() []string {
+	paths := make([]string, 0, maxPathSegments)
+
+	var prefix string
+
+	for i := 1; i <= maxPathSegments; i++ {
+		prefix += fmt.Sprintf("/:p%d", i)
+
+		paths = append(paths, prefix+"/"+didDocumentFile)
+	}
+
+	return paths
+}
+
+// IsRoute checks whether the route path (not the request path) is one of the public routes of the controller.
+func IsRoute(routePath string) bool {
+	if routePath == wellKnownDIDDocumentPath || routePath == wellKnownDIDConfigurationPath {
+		return true
+	}
+
+	for _, path := range Paths() {
+		if routePath == path {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DIDDocument returns did:web document of the profile signing DID.
+// GET /.well-known/did.json and GET /{path}/did.json.
+func (c *Controller) DIDDocument(ctx echo.Context) error {
+	req := ctx.Request()
+
+	return apiUtil.WriteOutput(ctx)(c.didWebService.DIDDocument(req.Context(), req.Host, req.URL.Path))
+}
+
+// DIDConfiguration returns DID configuration linking profile did:web DIDs to the requested host.
+// GET /.well-known/did-configuration.json.
+func (c *Controller) DIDConfiguration(ctx echo.Context) error {
+	req := ctx.Request()
+
+	return apiUtil.WriteOutput(ctx)(c.didWebService.DIDConfiguration(req.Context(), req.Host))
+}
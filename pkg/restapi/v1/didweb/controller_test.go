@@ -0,0 +1,119 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package didweb_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustbloc/did-go/doc/did"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/trustbloc/vcs/pkg/restapi/resterr"
+	"github.com/trustbloc/vcs/pkg/restapi/v1/didweb"
+	"github.com/trustbloc/vcs/pkg/service/didconfiguration"
+	didwebsvc "github.com/trustbloc/vcs/pkg/service/didweb"
+)
+
+func TestController(t *testing.T) {
+	route := NewMockrouter(gomock.NewController(t))
+
+	route.EXPECT().GET("/.well-known/did.json", gomock.Any()).Return(nil)
+	route.EXPECT().GET("/.well-known/did-configuration.json", gomock.Any()).Return(nil)
+	route.EXPECT().GET("/:p1/did.json", gomock.Any()).Return(nil)
+	route.EXPECT().GET("/:p1/:p2/did.json", gomock.Any()).Return(nil)
+	route.EXPECT().GET("/:p1/:p2/:p3/did.json", gomock.Any()).Return(nil)
+	route.EXPECT().GET("/:p1/:p2/:p3/:p4/did.json", gomock.Any()).Return(nil)
+	route.EXPECT().GET("/:p1/:p2/:p3/:p4/:p5/did.json", gomock.Any()).Return(nil)
+
+	assert.NotNil(t, didweb.NewController(&didweb.Config{}, route))
+}
+
+func TestIsRoute(t *testing.T) {
+	assert.True(t, didweb.IsRoute("/.well-known/did.json"))
+	assert.True(t, didweb.IsRoute("/.well-known/did-configuration.json"))
+	assert.True(t, didweb.IsRoute("/:p1/:p2/:p3/:p4/:p5/did.json"))
+
+	assert.False(t, didweb.IsRoute("/:p1/:p2/:p3/:p4/:p5/:p6/did.json"))
+	assert.False(t, didweb.IsRoute("/verifier/receipts/did.json"))
+	assert.False(t, didweb.IsRoute("/issuer/profiles/:profileID/:profileVersion/interactions/:txID/did.json"))
+}
+
+func TestDIDDocument(t *testing.T) {
+	svc := NewMockdidWebService(gomock.NewController(t))
+
+	e := echo.New()
+	e.HTTPErrorHandler = resterr.HTTPErrorHandler(trace.NewNoopTracerProvider().Tracer(""))
+
+	// Route of other API with path params at the same positions.
+	e.GET("/:profileType/profiles/:profileID/:profileVersion/well-known/did-config", func(ctx echo.Context) error {
+		return ctx.String(http.StatusOK, "did-config")
+	})
+
+	didweb.NewController(&didweb.Config{DIDWebService: svc}, e)
+
+	t.Run("well-known", func(t *testing.T) {
+		svc.EXPECT().DIDDocument(gomock.Any(), "example.com", "/.well-known/did.json").
+			Return(&did.Doc{Context: []string{did.ContextV1}, ID: "did:web:example.com"}, nil)
+
+		rec := serve(e, "/.well-known/did.json")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"id":"did:web:example.com"`)
+	})
+
+	t.Run("did configuration", func(t *testing.T) {
+		svc.EXPECT().DIDConfiguration(gomock.Any(), "example.com").
+			Return(&didconfiguration.DidConfiguration{Context: "ctx", LinkedDiDs: []interface{}{"jwt"}}, nil)
+
+		rec := serve(e, "/.well-known/did-configuration.json")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"linked_dids":["jwt"]`)
+	})
+
+	t.Run("path", func(t *testing.T) {
+		svc.EXPECT().DIDDocument(gomock.Any(), "example.com", "/issuer/profiles/1/did.json").
+			Return(&did.Doc{Context: []string{did.ContextV1}, ID: "did:web:example.com:issuer:profiles:1"}, nil)
+
+		rec := serve(e, "/issuer/profiles/1/did.json")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"id":"did:web:example.com:issuer:profiles:1"`)
+	})
+
+	t.Run("other route", func(t *testing.T) {
+		rec := serve(e, "/issuer/profiles/1/v1.0/well-known/did-config")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "did-config", rec.Body.String())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc.EXPECT().DIDDocument(gomock.Any(), "example.com", "/unknown/did.json").
+			Return(nil, didwebsvc.ErrDocumentNotFound)
+
+		rec := serve(e, "/unknown/did.json")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func serve(e *echo.Echo, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, http.NoBody)
+	req.Host = "example.com"
+
+	rec := httptest.NewRecorder()
+
+	e.ServeHTTP(rec, req)
+
+	return rec
+}
@@ -5,7 +5,7 @@ SPDX-License-Identifier: Apache-2.0
 */
 
 //go:generate oapi-codegen --config=openapi.cfg.yaml ../../../../docs/v1/openapi.yaml
-//go:generate mockgen -destination controller_mocks_test.go -self_package github.com/trustbloc/vcs/pkg/restapi/v1/issuer -package issuer -source=controller.go -mock_names profileService=MockProfileService,issueCredentialService=MockIssueCredentialService,oidc4ciService=MockOIDC4CIService,vcStatusManager=MockVCStatusManager,openidCredentialIssuerConfigProvider=MockOpenIDCredentialIssuerConfigProvider,eventService=MockEventService,jsonSchemaValidator=MockJSONSchemaValidator,credentialIssuanceHistoryStore=MockCredentialIssuanceHistoryStore
+//go:generate mockgen -destination controller_mocks_test.go -self_package github.com/trustbloc/vcs/pkg/restapi/v1/issuer -package issuer -source=controller.go -mock_names profileService=MockProfileService,issueCredentialService=MockIssueCredentialService,oidc4ciService=MockOIDC4CIService,vcStatusManager=MockVCStatusManager,openidCredentialIssuerConfigProvider=MockOpenIDCredentialIssuerConfigProvider,eventService=MockEventService,jsonSchemaValidator=MockJSONSchemaValidator,credentialIssuanceHistoryStore=MockCredentialIssuanceHistoryStore,keyRotationService=MockKeyRotationService,credentialRefreshService=MockCredentialRefreshService
 
 package issuer
 
@@ -33,15 +33,20 @@ import (
 	"github.com/trustbloc/vcs/internal/logfields"
 	"github.com/trustbloc/vcs/pkg/doc/vc"
 	"github.com/trustbloc/vcs/pkg/doc/vc/crypto"
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
 	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
+	"github.com/trustbloc/vcs/pkg/doc/vp"
 	"github.com/trustbloc/vcs/pkg/event/spi"
 	"github.com/trustbloc/vcs/pkg/observability/tracing/attributeutil"
 	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/qrcode"
 	"github.com/trustbloc/vcs/pkg/restapi/resterr"
 	"github.com/trustbloc/vcs/pkg/restapi/v1/common"
 	"github.com/trustbloc/vcs/pkg/restapi/v1/util"
+	"github.com/trustbloc/vcs/pkg/service/credentialrefresh"
 	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
 	"github.com/trustbloc/vcs/pkg/service/issuecredential"
+	"github.com/trustbloc/vcs/pkg/service/keyrotation"
 	"github.com/trustbloc/vcs/pkg/service/oidc4ci"
 )
 
@@ -55,6 +60,7 @@ var _ ServerInterface = (*Controller)(nil) // make sure Controller implements Se
 
 type profileService interface {
 	GetProfile(profileID profileapi.ID, profileVersion profileapi.Version) (*profileapi.Issuer, error)
+	ListProfiles() ([]*profileapi.Issuer, error)
 }
 
 type eventService interface {
@@ -88,6 +94,27 @@ type jsonSchemaValidator interface {
 	Validate(data interface{}, schemaID string, schema []byte) error
 }
 
+type keyRotationService interface {
+	RotateKey(
+		ctx context.Context,
+		profileID profileapi.ID,
+		profileVersion profileapi.Version,
+	) (*keyrotation.RotationResult, error)
+}
+
+type credentialRefreshService interface {
+	CreateChallenge(
+		ctx context.Context,
+		profile *profileapi.Issuer,
+	) (*credentialrefresh.PresentationRequest, error)
+	RefreshCredential(
+		ctx context.Context,
+		profile *profileapi.Issuer,
+		presentation *verifiable.Presentation,
+		challenge string,
+	) (*verifiable.Credential, error)
+}
+
 type Config struct {
 	EventSvc                       eventService
 	EventTopic                     string
@@ -101,6 +128,9 @@ type Config struct {
 	ExternalHostURL                string
 	Tracer                         trace.Tracer
 	JSONSchemaValidator            jsonSchemaValidator
+	KeyRotationService             keyRotationService
+	CredentialRefreshService       credentialRefreshService
+	ProofChecker                   verifiable.CombinedProofChecker
 }
 
 // Controller for Issuer Profile Management API.
@@ -117,6 +147,9 @@ type Controller struct {
 	schemaValidator                jsonSchemaValidator
 	eventSvc                       eventService
 	eventTopic                     string
+	keyRotationService             keyRotationService
+	credentialRefreshService       credentialRefreshService
+	proofChecker                   verifiable.CombinedProofChecker
 	marshal                        func(any) ([]byte, error)
 }
 
@@ -135,6 +168,9 @@ func NewController(config *Config) *Controller {
 		schemaValidator:                config.JSONSchemaValidator,
 		eventSvc:                       config.EventSvc,
 		eventTopic:                     config.EventTopic,
+		keyRotationService:             config.KeyRotationService,
+		credentialRefreshService:       config.CredentialRefreshService,
+		proofChecker:                   config.ProofChecker,
 		marshal:                        json.Marshal,
 	}
 }
@@ -255,6 +291,12 @@ func (c *Controller) buildCredentialsFromTemplate(
 		contexts = []string{defaultCtx}
 	}
 
+	dataModelVersion := vcsverifiable.ResolveDataModelVersion(
+		credentialTemplate.DataModelVersion, profile.VCConfig.DataModelVersion)
+	if dataModelVersion == vcsverifiable.DataModelV2 {
+		contexts = vcutil.ContextsForDataModel(contexts, dataModelVersion)
+	}
+
 	vcc := verifiable.CredentialContents{
 		Context: contexts,
 		ID:      uuid.New().URN(),
@@ -264,7 +306,6 @@ func (c *Controller) buildCredentialsFromTemplate(
 			ID:           profile.SigningDID.DID,
 			CustomFields: *body.Claims,
 		}},
-		Issued: utiltime.NewTime(time.Now()),
 	}
 
 	customFields := map[string]interface{}{}
@@ -276,10 +317,19 @@ func (c *Controller) buildCredentialsFromTemplate(
 		customFields["name"] = *body.CredentialName
 	}
 
+	var validUntil time.Time
+
 	if credentialTemplate.CredentialDefaultExpirationDuration != nil {
-		vcc.Expired = utiltime.NewTime(time.Now().UTC().Add(*credentialTemplate.CredentialDefaultExpirationDuration))
+		validUntil = time.Now().UTC().Add(*credentialTemplate.CredentialDefaultExpirationDuration)
 	} else {
-		vcc.Expired = utiltime.NewTime(time.Now().Add(365 * 24 * time.Hour))
+		validUntil = time.Now().Add(365 * 24 * time.Hour)
+	}
+
+	vcutil.SetValidityPeriod(&vcc, customFields, time.Now(), &validUntil)
+
+	if credentialTemplate.RefreshService != nil {
+		vcc.RefreshService = []verifiable.TypedID{credentialTemplate.RefreshService.TypedID(
+			profileapi.RefreshServiceURL(c.externalHostURL, profile.ID, profile.Version))}
 	}
 
 	return verifiable.CreateCredential(vcc, customFields)
@@ -365,9 +415,50 @@ func validateIssueCredOptions(
 // GetCredentialsStatus retrieves the credentialstatus.CSL.
 // GET /issuer/groups/{groupID}/credentials/status/{statusID}.
 func (c *Controller) GetCredentialsStatus(ctx echo.Context, groupID string, statusID string) error {
+	// Status list changes on every status update, so verifiers caching it have to refetch it on each use.
+	ctx.Response().Header().Set(echo.HeaderCacheControl, "no-cache")
+
 	return util.WriteOutput(ctx)(c.vcStatusManager.GetStatusListVC(ctx.Request().Context(), groupID, statusID))
 }
 
+// ListCredentialStatusLists returns credentialstatus.CSL list of the issuer group with their fill level.
+// GET /issuer/groups/{groupID}/credentials/status-lists.
+func (c *Controller) ListCredentialStatusLists(e echo.Context, groupID string) error {
+	tenantID, err := util.GetTenantIDFromRequest(e)
+	if err != nil {
+		return err
+	}
+
+	if err = c.accessProfileGroup(groupID, tenantID); err != nil {
+		return err
+	}
+
+	cslInfos, err := c.vcStatusManager.ListStatusLists(e.Request().Context(), groupID)
+	if err != nil {
+		return err
+	}
+
+	statusLists := make([]StatusListData, 0, len(cslInfos))
+	for _, info := range cslInfos {
+		var fillLevel float32
+		if info.ListSize > 0 {
+			fillLevel = float32(info.UsedIndexes) / float32(info.ListSize)
+		}
+
+		statusLists = append(statusLists, StatusListData{
+			Url:           info.CSLURL,
+			ListId:        string(info.ListID),
+			StatusPurpose: lo.EmptyableToPtr(info.StatusPurpose),
+			StatusType:    lo.EmptyableToPtr(string(info.StatusType)),
+			ListSize:      info.ListSize,
+			UsedIndexes:   info.UsedIndexes,
+			FillLevel:     fillLevel,
+		})
+	}
+
+	return util.WriteOutput(e)(statusLists, nil)
+}
+
 // PostCredentialsStatus updates credentialstatus.CSL.
 // POST /issuer/credentials/status.
 func (c *Controller) PostCredentialsStatus(ctx echo.Context) error {
@@ -395,7 +486,12 @@ func (c *Controller) PostCredentialsStatus(ctx echo.Context) error {
 
 // InitiateCredentialComposeIssuance initiates OIDC credential issuance flow.
 // POST /issuer/profiles/{profileID}/{profileVersion}/interactions/compose-and-initiate-issuance.
-func (c *Controller) InitiateCredentialComposeIssuance(e echo.Context, profileID string, profileVersion string) error {
+func (c *Controller) InitiateCredentialComposeIssuance(
+	e echo.Context,
+	profileID string,
+	profileVersion string,
+	params InitiateCredentialComposeIssuanceParams,
+) error {
 	ctx, span := c.tracer.Start(e.Request().Context(), "InitiateCredentialComposeIssuance")
 	defer span.End()
 
@@ -420,6 +516,13 @@ func (c *Controller) InitiateCredentialComposeIssuance(e echo.Context, profileID
 		return err
 	}
 
+	qrOpts, err := util.NewQRCodeOptions((*string)(params.QrFormat), (*string)(params.QrErrorCorrection), params.QrSize)
+	if err != nil {
+		c.sendFailedEvent(ctx, tenantID, profileID, profileVersion, err)
+
+		return err
+	}
+
 	var configs []InitiateIssuanceCredentialConfiguration
 
 	for _, compose := range lo.FromPtr(body.Compose) {
@@ -445,13 +548,16 @@ func (c *Controller) InitiateCredentialComposeIssuance(e echo.Context, profileID
 		Scope:                     body.Scope,
 		UserPinRequired:           body.UserPinRequired,
 		WalletInitiatedIssuance:   body.WalletInitiatedIssuance,
+		TxCodeDelivery:            body.TxCodeDelivery,
 	}
 
 	if body.GrantType != nil {
 		mapped.GrantType = lo.ToPtr(InitiateOIDC4CIRequestGrantType(*body.GrantType))
 	}
 
-	resp, ct, err := c.initiateIssuance(ctx, &mapped, profile)
+	locales := profileapi.ParseLocales(string(lo.FromPtr(params.Locale)), string(lo.FromPtr(params.AcceptLanguage)))
+
+	resp, ct, err := c.initiateIssuance(ctx, &mapped, profile, qrOpts, locales)
 	if err != nil {
 		return err
 	}
@@ -461,7 +567,11 @@ func (c *Controller) InitiateCredentialComposeIssuance(e echo.Context, profileID
 
 // InitiateCredentialIssuance initiates OIDC credential issuance flow.
 // POST /issuer/profiles/{profileID}/{profileVersion}/interactions/initiate-oidc.
-func (c *Controller) InitiateCredentialIssuance(e echo.Context, profileID, profileVersion string) error {
+func (c *Controller) InitiateCredentialIssuance(
+	e echo.Context,
+	profileID, profileVersion string,
+	params InitiateCredentialIssuanceParams,
+) error {
 	ctx, span := c.tracer.Start(e.Request().Context(), "InitiateCredentialIssuance")
 	defer span.End()
 
@@ -486,9 +596,23 @@ func (c *Controller) InitiateCredentialIssuance(e echo.Context, profileID, profi
 		return err
 	}
 
-	span.SetAttributes(attributeutil.JSON("initiate_issuance_request", body, attributeutil.WithRedacted("claim_data")))
+	qrOpts, err := util.NewQRCodeOptions((*string)(params.QrFormat), (*string)(params.QrErrorCorrection), params.QrSize)
+	if err != nil {
+		c.sendFailedEvent(ctx, tenantID, profileID, profileVersion, err)
 
-	resp, ct, err := c.initiateIssuance(ctx, &body, profile)
+		return err
+	}
+
+	span.SetAttributes(attributeutil.JSON("initiate_issuance_request", body,
+		attributeutil.WithRedacted("claim_data"),
+		attributeutil.WithRedacted("credential_configuration.#.claim_data"),
+		attributeutil.WithRedacted("tx_code_delivery.email"),
+		attributeutil.WithRedacted("tx_code_delivery.phone"),
+	))
+
+	locales := profileapi.ParseLocales(string(lo.FromPtr(params.Locale)), string(lo.FromPtr(params.AcceptLanguage)))
+
+	resp, ct, err := c.initiateIssuance(ctx, &body, profile, qrOpts, locales)
 	if err != nil {
 		return err
 	}
@@ -500,6 +624,8 @@ func (c *Controller) initiateIssuance(
 	ctx context.Context,
 	req *InitiateOIDC4CIRequest,
 	profile *profileapi.Issuer,
+	qrOpts *qrcode.Options,
+	locales []string,
 ) (*InitiateOIDC4CIResponse, string, error) {
 	issuanceReq := &oidc4ci.InitiateIssuanceRequest{
 		ClientInitiateIssuanceURL: lo.FromPtr(req.ClientInitiateIssuanceUrl),
@@ -511,6 +637,15 @@ func (c *Controller) initiateIssuance(
 		UserPinRequired:           lo.FromPtr(req.UserPinRequired),
 		WalletInitiatedIssuance:   lo.FromPtr(req.WalletInitiatedIssuance),
 		CredentialConfiguration:   []oidc4ci.InitiateIssuanceCredentialConfiguration{},
+		Locales:                   locales,
+	}
+
+	if req.TxCodeDelivery != nil {
+		issuanceReq.TxCodeDelivery = &oidc4ci.TxCodeDelivery{
+			Email:            lo.FromPtr(req.TxCodeDelivery.Email),
+			Phone:            lo.FromPtr(req.TxCodeDelivery.Phone),
+			OmitFromResponse: lo.FromPtr(req.TxCodeDelivery.OmitFromResponse),
+		}
 	}
 
 	for _, multiCredentialIssuance := range lo.FromPtr(req.CredentialConfiguration) {
@@ -571,10 +706,21 @@ func (c *Controller) initiateIssuance(
 		return nil, "", e
 	}
 
+	qrCode, err := util.QRCodeDataURI(resp.InitiateIssuanceURL, qrOpts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	userPin := lo.ToPtr(resp.UserPin)
+	if issuanceReq.TxCodeDelivery != nil && issuanceReq.TxCodeDelivery.OmitFromResponse {
+		userPin = nil
+	}
+
 	return &InitiateOIDC4CIResponse{
 		OfferCredentialUrl: resp.InitiateIssuanceURL,
 		TxId:               string(resp.TxID),
-		UserPin:            lo.ToPtr(resp.UserPin),
+		UserPin:            userPin,
+		QrCode:             qrCode,
 	}, resp.ContentType, nil
 }
 
@@ -690,6 +836,24 @@ func (c *Controller) accessProfile(profileID, profileVersion string) (*profileap
 	return profile, nil
 }
 
+// accessProfileGroup checks that the profile group exists and belongs to the organization.
+// Profile groups of other organization are not visible.
+func (c *Controller) accessProfileGroup(groupID, tenantID string) error {
+	profiles, err := c.profileSvc.ListProfiles()
+	if err != nil {
+		return resterr.NewSystemError(resterr.IssuerProfileSvcComponent, "ListProfiles", err)
+	}
+
+	for _, profile := range profiles {
+		if profile.GroupID == groupID && profile.OrganizationID == tenantID {
+			return nil
+		}
+	}
+
+	return resterr.NewCustomError(resterr.ProfileNotFound,
+		fmt.Errorf("profile group with given id %s, doesn't exist", groupID))
+}
+
 func (c *Controller) accessOIDCProfile(profileID, profileVersion, tenantID string) (*profileapi.Issuer, error) {
 	profile, err := c.accessProfile(profileID, profileVersion)
 	if err != nil {
@@ -1035,19 +1199,228 @@ func (c *Controller) CredentialIssuanceHistory(e echo.Context, profileID string)
 	historyData := make([]CredentialIssuanceHistoryData, 0, len(credentialMetadata))
 	for _, meta := range credentialMetadata {
 		historyData = append(historyData, CredentialIssuanceHistoryData{
-			CredentialId:    meta.CredentialID,
-			CredentialTypes: meta.CredentialType,
-			Issuer:          meta.Issuer,
-			ProfileVersion:  lo.ToPtr(meta.ProfileVersion),
-			ExpirationDate:  c.parseTime(meta.ExpirationDate),
-			IssuanceDate:    c.parseTime(meta.IssuanceDate),
-			TransactionId:   lo.ToPtr(meta.TransactionID),
+			CredentialId:         meta.CredentialID,
+			CredentialTypes:      meta.CredentialType,
+			Issuer:               meta.Issuer,
+			ProfileVersion:       lo.ToPtr(meta.ProfileVersion),
+			ExpirationDate:       c.parseTime(meta.ExpirationDate),
+			IssuanceDate:         c.parseTime(meta.IssuanceDate),
+			TransactionId:        lo.ToPtr(meta.TransactionID),
+			PreviousCredentialId: lo.EmptyableToPtr(meta.PreviousCredentialID),
 		})
 	}
 
 	return util.WriteOutput(e)(historyData, nil)
 }
 
+// GetRefreshCredentialChallenge issues a one-time challenge for the credential refresh.
+// GET /issuer/profiles/{profileID}/{profileVersion}/credentials/refresh.
+func (c *Controller) GetRefreshCredentialChallenge(e echo.Context, profileID, profileVersion string) error {
+	ctx, span := c.tracer.Start(e.Request().Context(), "GetRefreshCredentialChallenge")
+	defer span.End()
+
+	profile, err := c.accessProfile(profileID, profileVersion)
+	if err != nil {
+		return err
+	}
+
+	req, err := c.credentialRefreshService.CreateChallenge(ctx, profile)
+	if err != nil {
+		return err
+	}
+
+	return util.WriteOutput(e)(&RefreshCredentialChallenge{
+		Challenge: req.Challenge,
+		Domain:    req.Domain,
+	}, nil)
+}
+
+// PostRefreshCredential re-issues the credential enclosed into the holder presentation with up-to-date claims.
+// POST /issuer/profiles/{profileID}/{profileVersion}/credentials/refresh.
+func (c *Controller) PostRefreshCredential(e echo.Context, profileID, profileVersion string) error {
+	ctx, span := c.tracer.Start(e.Request().Context(), "PostRefreshCredential")
+	defer span.End()
+
+	var body RefreshCredentialData
+
+	if err := util.ReadBody(e, &body); err != nil {
+		return err
+	}
+
+	profile, err := c.accessProfile(profileID, profileVersion)
+	if err != nil {
+		return err
+	}
+
+	presentation, err := vp.ValidatePresentation(body.Presentation,
+		[]vcsverifiable.Format{vcsverifiable.Jwt, vcsverifiable.Ldp},
+		verifiable.WithPresProofChecker(c.proofChecker),
+		verifiable.WithPresJSONLDDocumentLoader(c.documentLoader))
+	if err != nil {
+		return resterr.NewValidationError(resterr.InvalidValue, "presentation", err)
+	}
+
+	credential, err := c.credentialRefreshService.RefreshCredential(ctx, profile, presentation, body.Challenge)
+	if err != nil {
+		return err
+	}
+
+	return util.WriteOutput(e)(credential, nil)
+}
+
+// RotateSigningKey rotates signing key of the issuer profile.
+// POST /issuer/profiles/{profileID}/{profileVersion}/keys/rotate.
+func (c *Controller) RotateSigningKey(e echo.Context, profileID, profileVersion string) error {
+	tenantID, err := util.GetTenantIDFromRequest(e)
+	if err != nil {
+		return err
+	}
+
+	if _, err = c.accessOIDCProfile(profileID, profileVersion, tenantID); err != nil {
+		return err
+	}
+
+	result, err := c.keyRotationService.RotateKey(e.Request().Context(), profileID, profileVersion)
+	if err != nil {
+		return err
+	}
+
+	resp := &RotateSigningKeyResult{
+		Did:                result.SigningDID.DID,
+		VerificationMethod: result.VerificationMethod.ID,
+	}
+
+	if result.PreviousVerificationMethod != nil {
+		resp.PreviousVerificationMethod = lo.ToPtr(result.PreviousVerificationMethod.ID)
+	}
+
+	return util.WriteOutput(e)(resp, nil)
+}
+
+// ListIssuanceTransactions returns open issuance transactions of the issuer profile.
+// GET /issuer/profiles/{profileID}/{profileVersion}/interactions/transactions.
+func (c *Controller) ListIssuanceTransactions(e echo.Context, profileID, profileVersion string) error {
+	ctx, span := c.tracer.Start(e.Request().Context(), "ListIssuanceTransactions")
+	defer span.End()
+
+	tenantID, err := util.GetTenantIDFromRequest(e)
+	if err != nil {
+		return err
+	}
+
+	profile, err := c.accessOIDCProfile(profileID, profileVersion, tenantID)
+	if err != nil {
+		return err
+	}
+
+	txs, err := c.oidc4ciService.ListTransactions(ctx, profile)
+	if err != nil {
+		return err
+	}
+
+	resp := make([]IssuanceTransaction, 0, len(txs))
+	for _, tx := range txs {
+		resp = append(resp, *toIssuanceTransaction(tx))
+	}
+
+	return util.WriteOutput(e)(resp, nil)
+}
+
+// GetIssuanceTransaction returns issuance transaction of the issuer profile.
+// GET /issuer/profiles/{profileID}/{profileVersion}/interactions/transactions/{txID}.
+func (c *Controller) GetIssuanceTransaction(e echo.Context, profileID, profileVersion, txID string) error {
+	ctx, span := c.tracer.Start(e.Request().Context(), "GetIssuanceTransaction")
+	defer span.End()
+
+	tenantID, err := util.GetTenantIDFromRequest(e)
+	if err != nil {
+		return err
+	}
+
+	profile, err := c.accessOIDCProfile(profileID, profileVersion, tenantID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.oidc4ciService.GetTransaction(ctx, profile, oidc4ci.TxID(txID))
+	if err != nil {
+		return err
+	}
+
+	return util.WriteOutput(e)(toIssuanceTransaction(tx), nil)
+}
+
+// CancelIssuanceTransaction cancels open issuance transaction of the issuer profile.
+// POST /issuer/profiles/{profileID}/{profileVersion}/interactions/transactions/{txID}/cancel.
+func (c *Controller) CancelIssuanceTransaction(e echo.Context, profileID, profileVersion, txID string) error {
+	ctx, span := c.tracer.Start(e.Request().Context(), "CancelIssuanceTransaction")
+	defer span.End()
+
+	tenantID, err := util.GetTenantIDFromRequest(e)
+	if err != nil {
+		return err
+	}
+
+	profile, err := c.accessOIDCProfile(profileID, profileVersion, tenantID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.oidc4ciService.CancelTransaction(ctx, profile, oidc4ci.TxID(txID))
+	if err != nil {
+		return err
+	}
+
+	return util.WriteOutput(e)(toIssuanceTransaction(tx), nil)
+}
+
+func toIssuanceTransaction(tx *oidc4ci.Transaction) *IssuanceTransaction {
+	credentials := make([]IssuanceTransactionCredential, 0, len(tx.CredentialConfiguration))
+
+	for _, credentialConfiguration := range tx.CredentialConfiguration {
+		credential := IssuanceTransactionCredential{
+			CredentialConfigurationId: credentialConfiguration.CredentialConfigurationID,
+			Format:                    string(credentialConfiguration.OIDCCredentialFormat),
+		}
+
+		if credentialConfiguration.CredentialTemplate != nil {
+			credential.CredentialTemplateId = lo.ToPtr(credentialConfiguration.CredentialTemplate.ID)
+		}
+
+		credentials = append(credentials, credential)
+	}
+
+	return &IssuanceTransaction{
+		TxId:              string(tx.ID),
+		ProfileId:         tx.ProfileID,
+		ProfileVersion:    tx.ProfileVersion,
+		State:             toIssuanceTransactionState(tx.State),
+		PreAuthorizedFlow: tx.IsPreAuthFlow,
+		PinRequired:       tx.UserPin != "",
+		WalletInitiated:   tx.WalletInitiatedIssuance,
+		Credentials:       credentials,
+	}
+}
+
+func toIssuanceTransactionState(state oidc4ci.TransactionState) IssuanceTransactionState {
+	switch state {
+	case oidc4ci.TransactionStateIssuanceInitiated:
+		return IssuanceInitiated
+	case oidc4ci.TransactionStatePreAuthCodeValidated:
+		return PreAuthCodeValidated
+	case oidc4ci.TransactionStateAwaitingIssuerOIDCAuthorization:
+		return AwaitingIssuerOidcAuthorization
+	case oidc4ci.TransactionStateIssuerOIDCAuthorizationDone:
+		return IssuerOidcAuthorizationDone
+	case oidc4ci.TransactionStateCredentialsIssued:
+		return CredentialsIssued
+	case oidc4ci.TransactionStateCancelled:
+		return Cancelled
+	default:
+		return Unknown
+	}
+}
+
 func (c *Controller) parseTime(t *utiltime.TimeWrapper) *string {
 	if t == nil {
 		return nil
@@ -16,6 +16,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -37,7 +38,9 @@ import (
 	"github.com/trustbloc/vcs/pkg/restapi/resterr"
 	"github.com/trustbloc/vcs/pkg/restapi/v1/common"
 	"github.com/trustbloc/vcs/pkg/restapi/v1/util"
+	"github.com/trustbloc/vcs/pkg/service/credentialrefresh"
 	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
+	"github.com/trustbloc/vcs/pkg/service/keyrotation"
 	"github.com/trustbloc/vcs/pkg/service/oidc4ci"
 )
 
@@ -812,9 +815,58 @@ func TestController_initiateCredentialIssuance_CompatibilityV1(t *testing.T) {
 
 		c = echoContext(withRequestBody(req))
 
-		err = controller.InitiateCredentialIssuance(c, profileID, profileVersion)
+		err = controller.InitiateCredentialIssuance(c, profileID, profileVersion, InitiateCredentialIssuanceParams{})
 		require.NoError(t, err)
 	})
+	t.Run("Success with QR code", func(t *testing.T) {
+		mockProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Times(1).Return(issuerProfile, nil)
+		mockOIDC4CISvc.EXPECT().InitiateIssuance(gomock.Any(), gomock.Any(), issuerProfile).
+			Times(1).Return(resp, nil)
+
+		controller := NewController(&Config{
+			ProfileSvc:     mockProfileSvc,
+			OIDC4CIService: mockOIDC4CISvc,
+			EventSvc:       mockEventSvc,
+			EventTopic:     spi.IssuerEventTopic,
+			Tracer:         trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		recorder := httptest.NewRecorder()
+
+		c = echoContext(withRequestBody(req), withRecorder(recorder))
+
+		err = controller.InitiateCredentialIssuance(c, profileID, profileVersion, InitiateCredentialIssuanceParams{
+			QrFormat:          lo.ToPtr(InitiateCredentialIssuanceParamsQrFormat("png")),
+			QrErrorCorrection: lo.ToPtr(InitiateCredentialIssuanceParamsQrErrorCorrection("H")),
+		})
+		require.NoError(t, err)
+
+		var body InitiateOIDC4CIResponse
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&body))
+		require.Equal(t, resp.InitiateIssuanceURL, body.OfferCredentialUrl)
+		require.True(t, strings.HasPrefix(lo.FromPtr(body.QrCode), "data:image/png;base64,"))
+	})
+
+	t.Run("Invalid QR code options", func(t *testing.T) {
+		mockProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Times(1).Return(issuerProfile, nil)
+		mockEventSvc.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).Times(1).Return(nil)
+
+		controller := NewController(&Config{
+			ProfileSvc:     mockProfileSvc,
+			OIDC4CIService: mockOIDC4CISvc,
+			EventSvc:       mockEventSvc,
+			EventTopic:     spi.IssuerEventTopic,
+			Tracer:         trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		c = echoContext(withRequestBody(req))
+
+		err = controller.InitiateCredentialIssuance(c, profileID, profileVersion, InitiateCredentialIssuanceParams{
+			QrFormat:          lo.ToPtr(InitiateCredentialIssuanceParamsQrFormat("png")),
+			QrErrorCorrection: lo.ToPtr(InitiateCredentialIssuanceParamsQrErrorCorrection("X")),
+		})
+		requireValidationError(t, resterr.InvalidValue, "qr_code", err)
+	})
 }
 
 func TestController_ComposeIssuance(t *testing.T) {
@@ -885,7 +937,8 @@ func TestController_ComposeIssuance(t *testing.T) {
 
 		c = echoContext(withRequestBody(req))
 
-		err = controller.InitiateCredentialComposeIssuance(c, profileID, profileVersion)
+		err = controller.InitiateCredentialComposeIssuance(c, profileID, profileVersion,
+			InitiateCredentialComposeIssuanceParams{})
 		require.NoError(t, err)
 	})
 }
@@ -1013,10 +1066,89 @@ func TestController_InitiateCredentialIssuance(t *testing.T) {
 
 		c = echoContext(withRequestBody(req))
 
-		err = controller.InitiateCredentialIssuance(c, profileID, profileVersion)
+		err = controller.InitiateCredentialIssuance(c, profileID, profileVersion, InitiateCredentialIssuanceParams{})
 		require.NoError(t, err)
 	})
 
+	t.Run("Success with locale", func(t *testing.T) {
+		mockProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Times(1).Return(issuerProfile, nil)
+		mockOIDC4CISvc.EXPECT().InitiateIssuance(gomock.Any(), gomock.Any(), issuerProfile).DoAndReturn(
+			func(
+				_ context.Context,
+				req *oidc4ci.InitiateIssuanceRequest,
+				_ *profileapi.Issuer,
+			) (*oidc4ci.InitiateIssuanceResponse, error) {
+				assert.Equal(t, []string{"fr-CA"}, req.Locales)
+
+				return resp, nil
+			})
+
+		controller := NewController(&Config{
+			ProfileSvc:     mockProfileSvc,
+			OIDC4CIService: mockOIDC4CISvc,
+			EventSvc:       mockEventSvc,
+			EventTopic:     spi.IssuerEventTopic,
+			Tracer:         trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		c = echoContext(withRequestBody(req))
+
+		err = controller.InitiateCredentialIssuance(c, profileID, profileVersion, InitiateCredentialIssuanceParams{
+			Locale:         lo.ToPtr(Locale("fr-CA")),
+			AcceptLanguage: lo.ToPtr(AcceptLanguage("en-US")),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Success with tx code delivery", func(t *testing.T) {
+		deliveryReq, marshalErr := json.Marshal(&InitiateOIDC4CIRequest{
+			CredentialTemplateId: lo.ToPtr("templateID"),
+			UserPinRequired:      lo.ToPtr(true),
+			TxCodeDelivery: &TxCodeDelivery{
+				Email:            lo.ToPtr("holder@example.com"),
+				OmitFromResponse: lo.ToPtr(true),
+			},
+		})
+		require.NoError(t, marshalErr)
+
+		mockProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Times(1).Return(issuerProfile, nil)
+		mockOIDC4CISvc.EXPECT().InitiateIssuance(gomock.Any(), gomock.Any(), issuerProfile).DoAndReturn(
+			func(
+				_ context.Context,
+				req *oidc4ci.InitiateIssuanceRequest,
+				_ *profileapi.Issuer,
+			) (*oidc4ci.InitiateIssuanceResponse, error) {
+				assert.Equal(t, &oidc4ci.TxCodeDelivery{
+					Email:            "holder@example.com",
+					OmitFromResponse: true,
+				}, req.TxCodeDelivery)
+
+				return &oidc4ci.InitiateIssuanceResponse{
+					InitiateIssuanceURL: "https://wallet.example.com/initiate_issuance",
+					TxID:                "txID",
+					UserPin:             "123456",
+				}, nil
+			})
+
+		controller := NewController(&Config{
+			ProfileSvc:     mockProfileSvc,
+			OIDC4CIService: mockOIDC4CISvc,
+			EventSvc:       mockEventSvc,
+			EventTopic:     spi.IssuerEventTopic,
+			Tracer:         trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		rec := httptest.NewRecorder()
+		c = echoContext(withRequestBody(deliveryReq), withRecorder(rec))
+
+		err = controller.InitiateCredentialIssuance(c, profileID, profileVersion, InitiateCredentialIssuanceParams{})
+		require.NoError(t, err)
+
+		var initiateResp InitiateOIDC4CIResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &initiateResp))
+		require.Nil(t, initiateResp.UserPin)
+	})
+
 	t.Run("Failed", func(t *testing.T) {
 		tests := []struct {
 			name  string
@@ -1214,7 +1346,7 @@ func TestController_InitiateCredentialIssuance(t *testing.T) {
 					EventTopic:     spi.IssuerEventTopic,
 				})
 
-				err = controller.InitiateCredentialIssuance(c, profileID, profileVersion)
+				err = controller.InitiateCredentialIssuance(c, profileID, profileVersion, InitiateCredentialIssuanceParams{})
 				tt.check(t, err)
 			})
 		}
@@ -2893,6 +3025,550 @@ func TestCredentialIssuanceHistory(t *testing.T) {
 	})
 }
 
+func TestController_RotateSigningKey(t *testing.T) {
+	profile := &profileapi.Issuer{
+		ID:             profileID,
+		Version:        profileVersion,
+		OrganizationID: orgID,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockProfileSvc := NewMockProfileService(gomock.NewController(t))
+		mockProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Return(profile, nil)
+
+		keyRotationSvc := NewMockKeyRotationService(gomock.NewController(t))
+		keyRotationSvc.EXPECT().RotateKey(gomock.Any(), profileID, profileVersion).Return(
+			&keyrotation.RotationResult{
+				SigningDID:                 &profileapi.SigningDID{DID: "did:web:example.com"},
+				VerificationMethod:         &profileapi.VerificationMethod{ID: "did:web:example.com#key2"},
+				PreviousVerificationMethod: &profileapi.VerificationMethod{ID: "did:web:example.com#key1"},
+			}, nil)
+
+		c := NewController(&Config{
+			ProfileSvc:         mockProfileSvc,
+			KeyRotationService: keyRotationSvc,
+		})
+
+		recorder := httptest.NewRecorder()
+
+		err := c.RotateSigningKey(echoContext(withTenantID(orgID), withRecorder(recorder)), profileID, profileVersion)
+		require.NoError(t, err)
+
+		var resp RotateSigningKeyResult
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&resp))
+		require.Equal(t, RotateSigningKeyResult{
+			Did:                        "did:web:example.com",
+			VerificationMethod:         "did:web:example.com#key2",
+			PreviousVerificationMethod: lo.ToPtr("did:web:example.com#key1"),
+		}, resp)
+	})
+
+	t.Run("missing tenant id", func(t *testing.T) {
+		c := NewController(&Config{})
+
+		err := c.RotateSigningKey(echoContext(withTenantID("")), profileID, profileVersion)
+		requireAuthError(t, err)
+	})
+
+	t.Run("profile of other organization", func(t *testing.T) {
+		mockProfileSvc := NewMockProfileService(gomock.NewController(t))
+		mockProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Return(profile, nil)
+
+		c := NewController(&Config{ProfileSvc: mockProfileSvc})
+
+		err := c.RotateSigningKey(echoContext(withTenantID("other-org")), profileID, profileVersion)
+		requireCustomError(t, resterr.ProfileNotFound, err)
+	})
+
+	t.Run("rotate key error", func(t *testing.T) {
+		mockProfileSvc := NewMockProfileService(gomock.NewController(t))
+		mockProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Return(profile, nil)
+
+		keyRotationSvc := NewMockKeyRotationService(gomock.NewController(t))
+		keyRotationSvc.EXPECT().RotateKey(gomock.Any(), profileID, profileVersion).
+			Return(nil, errors.New("rotate error"))
+
+		c := NewController(&Config{
+			ProfileSvc:         mockProfileSvc,
+			KeyRotationService: keyRotationSvc,
+		})
+
+		err := c.RotateSigningKey(echoContext(withTenantID(orgID)), profileID, profileVersion)
+		require.ErrorContains(t, err, "rotate error")
+	})
+}
+
+func TestController_PostRefreshCredential(t *testing.T) {
+	profile := &profileapi.Issuer{
+		ID:             profileID,
+		Version:        profileVersion,
+		OrganizationID: orgID,
+	}
+
+	presentation := `{
+		"@context": ["https://www.w3.org/2018/credentials/v1"],
+		"type": ["VerifiablePresentation"],
+		"holder": "did:example:holder"
+	}`
+
+	t.Run("success", func(t *testing.T) {
+		mockProfileSvc := NewMockProfileService(gomock.NewController(t))
+		mockProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Return(profile, nil)
+
+		cred, err := verifiable.CreateCredential(verifiable.CredentialContents{
+			Context: []string{defaultCtx},
+			ID:      "urn:uuid:refreshed",
+			Types:   []string{"VerifiableCredential"},
+			Issuer:  &verifiable.Issuer{ID: "did:example:issuer"},
+		}, nil)
+		require.NoError(t, err)
+
+		refreshSvc := NewMockCredentialRefreshService(gomock.NewController(t))
+		refreshSvc.EXPECT().RefreshCredential(gomock.Any(), profile, gomock.Any(), "challenge").DoAndReturn(
+			func(
+				_ context.Context,
+				_ *profileapi.Issuer,
+				pres *verifiable.Presentation,
+				_ string,
+			) (*verifiable.Credential, error) {
+				require.Equal(t, "did:example:holder", pres.Holder)
+
+				return cred, nil
+			})
+
+		c := NewController(&Config{
+			ProfileSvc:               mockProfileSvc,
+			DocumentLoader:           testutil.DocumentLoader(t),
+			CredentialRefreshService: refreshSvc,
+			Tracer:                   trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		recorder := httptest.NewRecorder()
+
+		err = c.PostRefreshCredential(echoContext(
+			withRequestBody([]byte(`{"challenge":"challenge","presentation":`+presentation+`}`)),
+			withRecorder(recorder),
+		), profileID, profileVersion)
+		require.NoError(t, err)
+
+		var resp map[string]interface{}
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&resp))
+		require.Equal(t, "urn:uuid:refreshed", resp["id"])
+	})
+
+	t.Run("profile not found", func(t *testing.T) {
+		mockProfileSvc := NewMockProfileService(gomock.NewController(t))
+		mockProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Return(nil, errors.New("not found"))
+
+		c := NewController(&Config{
+			ProfileSvc: mockProfileSvc,
+			Tracer:     trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.PostRefreshCredential(echoContext(
+			withRequestBody([]byte(`{"challenge":"challenge","presentation":`+presentation+`}`)),
+		), profileID, profileVersion)
+		requireCustomError(t, resterr.ProfileNotFound, err)
+	})
+
+	t.Run("invalid presentation", func(t *testing.T) {
+		mockProfileSvc := NewMockProfileService(gomock.NewController(t))
+		mockProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Return(profile, nil)
+
+		c := NewController(&Config{
+			ProfileSvc: mockProfileSvc,
+			Tracer:     trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.PostRefreshCredential(echoContext(
+			withRequestBody([]byte(`{"presentation":"invalid"}`)),
+		), profileID, profileVersion)
+		requireValidationError(t, resterr.InvalidValue, "presentation", err)
+	})
+
+	t.Run("refresh error", func(t *testing.T) {
+		mockProfileSvc := NewMockProfileService(gomock.NewController(t))
+		mockProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Return(profile, nil)
+
+		refreshSvc := NewMockCredentialRefreshService(gomock.NewController(t))
+		refreshSvc.EXPECT().RefreshCredential(gomock.Any(), profile, gomock.Any(), "challenge").
+			Return(nil, errors.New("refresh error"))
+
+		c := NewController(&Config{
+			ProfileSvc:               mockProfileSvc,
+			DocumentLoader:           testutil.DocumentLoader(t),
+			CredentialRefreshService: refreshSvc,
+			Tracer:                   trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.PostRefreshCredential(echoContext(
+			withRequestBody([]byte(`{"challenge":"challenge","presentation":`+presentation+`}`)),
+		), profileID, profileVersion)
+		require.ErrorContains(t, err, "refresh error")
+	})
+}
+
+func TestController_GetRefreshCredentialChallenge(t *testing.T) {
+	profile := &profileapi.Issuer{
+		ID:             profileID,
+		Version:        profileVersion,
+		OrganizationID: orgID,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockProfileSvc := NewMockProfileService(gomock.NewController(t))
+		mockProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Return(profile, nil)
+
+		refreshSvc := NewMockCredentialRefreshService(gomock.NewController(t))
+		refreshSvc.EXPECT().CreateChallenge(gomock.Any(), profile).Return(&credentialrefresh.PresentationRequest{
+			Challenge: "challenge",
+			Domain:    "https://vcs.example.com/issuer/profiles/profileID/v1.0/credentials/refresh",
+		}, nil)
+
+		c := NewController(&Config{
+			ProfileSvc:               mockProfileSvc,
+			CredentialRefreshService: refreshSvc,
+			Tracer:                   trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		recorder := httptest.NewRecorder()
+
+		err := c.GetRefreshCredentialChallenge(echoContext(withRecorder(recorder)), profileID, profileVersion)
+		require.NoError(t, err)
+
+		var resp RefreshCredentialChallenge
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&resp))
+		require.Equal(t, "challenge", resp.Challenge)
+		require.Equal(t, "https://vcs.example.com/issuer/profiles/profileID/v1.0/credentials/refresh", resp.Domain)
+	})
+
+	t.Run("profile not found", func(t *testing.T) {
+		mockProfileSvc := NewMockProfileService(gomock.NewController(t))
+		mockProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Return(nil, errors.New("not found"))
+
+		c := NewController(&Config{
+			ProfileSvc: mockProfileSvc,
+			Tracer:     trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.GetRefreshCredentialChallenge(echoContext(), profileID, profileVersion)
+		requireCustomError(t, resterr.ProfileNotFound, err)
+	})
+
+	t.Run("create challenge error", func(t *testing.T) {
+		mockProfileSvc := NewMockProfileService(gomock.NewController(t))
+		mockProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Return(profile, nil)
+
+		refreshSvc := NewMockCredentialRefreshService(gomock.NewController(t))
+		refreshSvc.EXPECT().CreateChallenge(gomock.Any(), profile).Return(nil, errors.New("store error"))
+
+		c := NewController(&Config{
+			ProfileSvc:               mockProfileSvc,
+			CredentialRefreshService: refreshSvc,
+			Tracer:                   trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.GetRefreshCredentialChallenge(echoContext(), profileID, profileVersion)
+		require.ErrorContains(t, err, "store error")
+	})
+}
+
+func TestController_IssuanceTransactions(t *testing.T) {
+	profile := &profileapi.Issuer{
+		ID:             profileID,
+		Version:        profileVersion,
+		OrganizationID: orgID,
+	}
+
+	tx := &oidc4ci.Transaction{
+		ID: "txID",
+		TransactionData: oidc4ci.TransactionData{
+			ProfileID:      profileID,
+			ProfileVersion: profileVersion,
+			IsPreAuthFlow:  true,
+			UserPin:        "1234",
+			State:          oidc4ci.TransactionStatePreAuthCodeValidated,
+			CredentialConfiguration: []*oidc4ci.TxCredentialConfiguration{
+				{
+					CredentialConfigurationID: "PermanentResidentCardIdentifier",
+					OIDCCredentialFormat:      vcsverifiable.JwtVCJsonLD,
+					CredentialTemplate:        &profileapi.CredentialTemplate{ID: "templateID"},
+				},
+			},
+		},
+	}
+
+	expected := IssuanceTransaction{
+		TxId:              "txID",
+		ProfileId:         profileID,
+		ProfileVersion:    profileVersion,
+		State:             PreAuthCodeValidated,
+		PreAuthorizedFlow: true,
+		PinRequired:       true,
+		Credentials: []IssuanceTransactionCredential{
+			{
+				CredentialConfigurationId: "PermanentResidentCardIdentifier",
+				CredentialTemplateId:      lo.ToPtr("templateID"),
+				Format:                    string(vcsverifiable.JwtVCJsonLD),
+			},
+		},
+	}
+
+	newController := func(t *testing.T, oidc4ciSvc oidc4ciService) *Controller {
+		t.Helper()
+
+		mockProfileSvc := NewMockProfileService(gomock.NewController(t))
+		mockProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Return(profile, nil).AnyTimes()
+
+		return NewController(&Config{
+			ProfileSvc:     mockProfileSvc,
+			OIDC4CIService: oidc4ciSvc,
+			Tracer:         trace.NewNoopTracerProvider().Tracer(""),
+		})
+	}
+
+	t.Run("list", func(t *testing.T) {
+		mockOIDC4CISvc := NewMockOIDC4CIService(gomock.NewController(t))
+		mockOIDC4CISvc.EXPECT().ListTransactions(gomock.Any(), profile).Return([]*oidc4ci.Transaction{tx}, nil)
+
+		recorder := httptest.NewRecorder()
+
+		err := newController(t, mockOIDC4CISvc).ListIssuanceTransactions(
+			echoContext(withTenantID(orgID), withRecorder(recorder)), profileID, profileVersion)
+		require.NoError(t, err)
+
+		var resp []IssuanceTransaction
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&resp))
+		require.Equal(t, []IssuanceTransaction{expected}, resp)
+	})
+
+	t.Run("list error", func(t *testing.T) {
+		mockOIDC4CISvc := NewMockOIDC4CIService(gomock.NewController(t))
+		mockOIDC4CISvc.EXPECT().ListTransactions(gomock.Any(), profile).Return(nil, errors.New("list error"))
+
+		err := newController(t, mockOIDC4CISvc).ListIssuanceTransactions(
+			echoContext(withTenantID(orgID)), profileID, profileVersion)
+		require.ErrorContains(t, err, "list error")
+	})
+
+	t.Run("get", func(t *testing.T) {
+		mockOIDC4CISvc := NewMockOIDC4CIService(gomock.NewController(t))
+		mockOIDC4CISvc.EXPECT().GetTransaction(gomock.Any(), profile, oidc4ci.TxID("txID")).Return(tx, nil)
+
+		recorder := httptest.NewRecorder()
+
+		err := newController(t, mockOIDC4CISvc).GetIssuanceTransaction(
+			echoContext(withTenantID(orgID), withRecorder(recorder)), profileID, profileVersion, "txID")
+		require.NoError(t, err)
+
+		var resp IssuanceTransaction
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&resp))
+		require.Equal(t, expected, resp)
+	})
+
+	t.Run("get error", func(t *testing.T) {
+		mockOIDC4CISvc := NewMockOIDC4CIService(gomock.NewController(t))
+		mockOIDC4CISvc.EXPECT().GetTransaction(gomock.Any(), profile, oidc4ci.TxID("txID")).
+			Return(nil, resterr.NewCustomError(resterr.OIDCTxNotFound, resterr.ErrDataNotFound))
+
+		err := newController(t, mockOIDC4CISvc).GetIssuanceTransaction(
+			echoContext(withTenantID(orgID)), profileID, profileVersion, "txID")
+		requireCustomError(t, resterr.OIDCTxNotFound, err)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		cancelled := *tx
+		cancelled.State = oidc4ci.TransactionStateCancelled
+		cancelled.UserPin = ""
+
+		mockOIDC4CISvc := NewMockOIDC4CIService(gomock.NewController(t))
+		mockOIDC4CISvc.EXPECT().CancelTransaction(gomock.Any(), profile, oidc4ci.TxID("txID")).Return(&cancelled, nil)
+
+		recorder := httptest.NewRecorder()
+
+		err := newController(t, mockOIDC4CISvc).CancelIssuanceTransaction(
+			echoContext(withTenantID(orgID), withRecorder(recorder)), profileID, profileVersion, "txID")
+		require.NoError(t, err)
+
+		var resp IssuanceTransaction
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&resp))
+		require.Equal(t, Cancelled, resp.State)
+		require.False(t, resp.PinRequired)
+	})
+
+	t.Run("cancel error", func(t *testing.T) {
+		mockOIDC4CISvc := NewMockOIDC4CIService(gomock.NewController(t))
+		mockOIDC4CISvc.EXPECT().CancelTransaction(gomock.Any(), profile, oidc4ci.TxID("txID")).
+			Return(nil, errors.New("cancel error"))
+
+		err := newController(t, mockOIDC4CISvc).CancelIssuanceTransaction(
+			echoContext(withTenantID(orgID)), profileID, profileVersion, "txID")
+		require.ErrorContains(t, err, "cancel error")
+	})
+
+	t.Run("missing tenant id", func(t *testing.T) {
+		c := NewController(&Config{Tracer: trace.NewNoopTracerProvider().Tracer("")})
+
+		requireAuthError(t, c.ListIssuanceTransactions(echoContext(withTenantID("")), profileID, profileVersion))
+		requireAuthError(t, c.GetIssuanceTransaction(echoContext(withTenantID("")), profileID, profileVersion, "txID"))
+		requireAuthError(t, c.CancelIssuanceTransaction(echoContext(withTenantID("")), profileID, profileVersion, "txID"))
+	})
+
+	t.Run("profile of other organization", func(t *testing.T) {
+		c := newController(t, NewMockOIDC4CIService(gomock.NewController(t)))
+
+		requireCustomError(t, resterr.ProfileNotFound,
+			c.ListIssuanceTransactions(echoContext(withTenantID("other-org")), profileID, profileVersion))
+		requireCustomError(t, resterr.ProfileNotFound,
+			c.GetIssuanceTransaction(echoContext(withTenantID("other-org")), profileID, profileVersion, "txID"))
+		requireCustomError(t, resterr.ProfileNotFound,
+			c.CancelIssuanceTransaction(echoContext(withTenantID("other-org")), profileID, profileVersion, "txID"))
+	})
+
+	t.Run("state", func(t *testing.T) {
+		require.Equal(t, IssuanceInitiated, toIssuanceTransactionState(oidc4ci.TransactionStateIssuanceInitiated))
+		require.Equal(t, AwaitingIssuerOidcAuthorization,
+			toIssuanceTransactionState(oidc4ci.TransactionStateAwaitingIssuerOIDCAuthorization))
+		require.Equal(t, IssuerOidcAuthorizationDone,
+			toIssuanceTransactionState(oidc4ci.TransactionStateIssuerOIDCAuthorizationDone))
+		require.Equal(t, CredentialsIssued, toIssuanceTransactionState(oidc4ci.TransactionStateCredentialsIssued))
+		require.Equal(t, Unknown, toIssuanceTransactionState(oidc4ci.TransactionStateUnknown))
+	})
+}
+
+func TestController_GetCredentialsStatus(t *testing.T) {
+	mockVCStatusManager := NewMockVCStatusManager(gomock.NewController(t))
+	mockVCStatusManager.EXPECT().GetStatusListVC(gomock.Any(), profileapi.ID("groupID"), "1").
+		Return(&credentialstatus.CSL{}, nil)
+
+	c := &Controller{
+		vcStatusManager: mockVCStatusManager,
+	}
+
+	recorder := httptest.NewRecorder()
+
+	err := c.GetCredentialsStatus(echoContext(withRecorder(recorder)), "groupID", "1")
+	require.NoError(t, err)
+	require.Equal(t, "no-cache", recorder.Header().Get(echo.HeaderCacheControl))
+}
+
+func TestController_ListCredentialStatusLists(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockVCStatusManager := NewMockVCStatusManager(gomock.NewController(t))
+		mockVCStatusManager.EXPECT().ListStatusLists(gomock.Any(), "groupID").Return([]*credentialstatus.CSLInfo{
+			{
+				CSLURL:        "https://example.com/issuer/groups/groupID/credentials/status/1",
+				ListID:        "1",
+				StatusPurpose: "revocation",
+				StatusType:    vc.StatusList2021VCStatus,
+				ListSize:      4,
+				UsedIndexes:   1,
+			},
+			{
+				CSLURL:      "https://example.com/issuer/groups/groupID/credentials/status/2",
+				ListID:      "2",
+				ListSize:    4,
+				UsedIndexes: 4,
+			},
+		}, nil)
+
+		c := &Controller{
+			vcStatusManager: mockVCStatusManager,
+			profileSvc:      groupProfileService(t, "groupID", orgID),
+		}
+
+		recorder := httptest.NewRecorder()
+
+		err := c.ListCredentialStatusLists(echoContext(withRecorder(recorder)), "groupID")
+		assert.NoError(t, err)
+
+		var gotResponse []StatusListData
+		err = json.NewDecoder(recorder.Body).Decode(&gotResponse)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []StatusListData{
+			{
+				Url:           "https://example.com/issuer/groups/groupID/credentials/status/1",
+				ListId:        "1",
+				StatusPurpose: lo.ToPtr("revocation"),
+				StatusType:    lo.ToPtr(string(vc.StatusList2021VCStatus)),
+				ListSize:      4,
+				UsedIndexes:   1,
+				FillLevel:     0.25,
+			},
+			{
+				Url:         "https://example.com/issuer/groups/groupID/credentials/status/2",
+				ListId:      "2",
+				ListSize:    4,
+				UsedIndexes: 4,
+				FillLevel:   1,
+			},
+		}, gotResponse)
+	})
+
+	t.Run("vcStatusManager error", func(t *testing.T) {
+		mockVCStatusManager := NewMockVCStatusManager(gomock.NewController(t))
+		mockVCStatusManager.EXPECT().ListStatusLists(gomock.Any(), "groupID").Return(nil, errors.New("some error"))
+
+		c := &Controller{
+			vcStatusManager: mockVCStatusManager,
+			profileSvc:      groupProfileService(t, "groupID", orgID),
+		}
+
+		err := c.ListCredentialStatusLists(echoContext(), "groupID")
+		assert.ErrorContains(t, err, "some error")
+	})
+
+	t.Run("missing tenant ID", func(t *testing.T) {
+		c := &Controller{}
+
+		err := c.ListCredentialStatusLists(echoContext(withTenantID("")), "groupID")
+		assert.ErrorContains(t, err, "missing authorization")
+	})
+
+	t.Run("profile group of other organization", func(t *testing.T) {
+		c := &Controller{
+			profileSvc: groupProfileService(t, "groupID", "orgID2"),
+		}
+
+		err := c.ListCredentialStatusLists(echoContext(), "groupID")
+		requireCustomError(t, resterr.ProfileNotFound, err)
+	})
+
+	t.Run("unknown profile group", func(t *testing.T) {
+		c := &Controller{
+			profileSvc: groupProfileService(t, "otherGroupID", orgID),
+		}
+
+		err := c.ListCredentialStatusLists(echoContext(), "groupID")
+		requireCustomError(t, resterr.ProfileNotFound, err)
+	})
+
+	t.Run("list profiles error", func(t *testing.T) {
+		profileSvc := NewMockProfileService(gomock.NewController(t))
+		profileSvc.EXPECT().ListProfiles().Return(nil, errors.New("list error"))
+
+		c := &Controller{
+			profileSvc: profileSvc,
+		}
+
+		err := c.ListCredentialStatusLists(echoContext(), "groupID")
+		assert.ErrorContains(t, err, "list error")
+	})
+}
+
+func groupProfileService(t *testing.T, groupID, organizationID string) *MockProfileService {
+	t.Helper()
+
+	profileSvc := NewMockProfileService(gomock.NewController(t))
+	profileSvc.EXPECT().ListProfiles().Return([]*profileapi.Issuer{
+		{ID: "otherProfile", GroupID: "otherGroup", OrganizationID: organizationID},
+		{ID: "profile", GroupID: groupID, OrganizationID: organizationID},
+	}, nil)
+
+	return profileSvc
+}
+
 func Test_getCredentialSubjects(t *testing.T) {
 	t.Run("subject", func(t *testing.T) {
 		subjects, err := getCredentialSubjects(verifiable.Subject{ID: "id1"})
@@ -32,6 +32,31 @@ const (
 	InitiateOIDC4CIRequestGrantTypeUrnIetfParamsOauthGrantTypePreAuthorizedCode InitiateOIDC4CIRequestGrantType = "urn:ietf:params:oauth:grant-type:pre-authorized_code"
 )
 
+// Defines values for IssuanceTransactionState.
+const (
+	AwaitingIssuerOidcAuthorization IssuanceTransactionState = "awaiting-issuer-oidc-authorization"
+	Cancelled                       IssuanceTransactionState = "cancelled"
+	CredentialsIssued               IssuanceTransactionState = "credentials-issued"
+	IssuanceInitiated               IssuanceTransactionState = "issuance-initiated"
+	IssuerOidcAuthorizationDone     IssuanceTransactionState = "issuer-oidc-authorization-done"
+	PreAuthCodeValidated            IssuanceTransactionState = "pre-auth-code-validated"
+	Unknown                         IssuanceTransactionState = "unknown"
+)
+
+// Defines values for QRErrorCorrection.
+const (
+	H QRErrorCorrection = "H"
+	L QRErrorCorrection = "L"
+	M QRErrorCorrection = "M"
+	Q QRErrorCorrection = "Q"
+)
+
+// Defines values for QRFormat.
+const (
+	Png QRFormat = "png"
+	Svg QRFormat = "svg"
+)
+
 // An object that describes specifics of the Credential that the Credential Issuer supports issuance of.
 type CredentialConfigurationsSupported struct {
 	// For mso_mdoc and vc+sd-jwt vc only. Object containing a list of name/value pairs, where each name identifies a claim about the subject offered in the Credential. The value can be another such object (nested data structures), or an array of such objects.
@@ -100,6 +125,9 @@ type CredentialIssuanceHistoryData struct {
 	// Issuer identifier.
 	Issuer string `json:"issuer"`
 
+	// ID of the credential refreshed by this credential.
+	PreviousCredentialId *string `json:"previous_credential_id,omitempty"`
+
 	// Issuer Profile version.
 	ProfileVersion *string `json:"profile_version,omitempty"`
 
@@ -238,6 +266,9 @@ type InitiateOIDC4CIComposeRequest struct {
 	// Contains scopes that issuer expects VCS to use while requesting authorization code for claim data. Defaults to openid.
 	Scope *[]string `json:"scope,omitempty"`
 
+	// Requests VCS to deliver the user PIN (transaction code) to the holder using the notifier configured in the issuer profile. Requires user_pin_required.
+	TxCodeDelivery *TxCodeDelivery `json:"tx_code_delivery,omitempty"`
+
 	// Required for Pre-Authorized Code Flow. Boolean value specifying whether the issuer expects presentation of a user PIN along with the Token Request in a pre-authorized code flow.
 	UserPinRequired *bool `json:"user_pin_required,omitempty"`
 
@@ -292,6 +323,9 @@ type InitiateOIDC4CIRequest struct {
 	// Contains scopes that issuer expects VCS to use while requesting authorization code for claim data. Defaults to openid.
 	Scope *[]string `json:"scope,omitempty"`
 
+	// Requests VCS to deliver the user PIN (transaction code) to the holder using the notifier configured in the issuer profile. Requires user_pin_required.
+	TxCodeDelivery *TxCodeDelivery `json:"tx_code_delivery,omitempty"`
+
 	// Required for Pre-Authorized Code Flow. Boolean value specifying whether the issuer expects presentation of a user PIN along with the Token Request in a pre-authorized code flow.
 	UserPinRequired *bool `json:"user_pin_required,omitempty"`
 
@@ -307,6 +341,9 @@ type InitiateOIDC4CIResponse struct {
 	// OIDC4CI initiate issuance URL to be used by the Issuer to pass relevant information to the Wallet to initiate issuance flow. Supports both HTTP GET and HTTP Redirect. Issuers may present QR code containing request data for users to scan from their mobile Wallet app.
 	OfferCredentialUrl string `json:"offer_credential_url"`
 
+	// QR code of the initiate issuance URL as a data URI. Returned if qr_format query parameter is set.
+	QrCode *string `json:"qr_code,omitempty"`
+
 	// To be used by Issuer applications for correlation if needed.
 	TxId string `json:"tx_id"`
 
@@ -314,6 +351,48 @@ type InitiateOIDC4CIResponse struct {
 	UserPin *string `json:"user_pin,omitempty"`
 }
 
+// Model for OIDC credential issuance transaction status.
+type IssuanceTransaction struct {
+	// Credentials offered in the transaction.
+	Credentials []IssuanceTransactionCredential `json:"credentials"`
+
+	// Whether the pre-authorized code flow requires user pin.
+	PinRequired bool `json:"pin_required"`
+
+	// Whether the transaction uses pre-authorized code flow.
+	PreAuthorizedFlow bool `json:"pre_authorized_flow"`
+
+	// Issuer profile ID.
+	ProfileId string `json:"profile_id"`
+
+	// Issuer profile version.
+	ProfileVersion string `json:"profile_version"`
+
+	// State of the issuance transaction.
+	State IssuanceTransactionState `json:"state"`
+
+	// Issuance transaction ID.
+	TxId string `json:"tx_id"`
+
+	// Whether the issuance was initiated by wallet.
+	WalletInitiated bool `json:"wallet_initiated"`
+}
+
+// State of the issuance transaction.
+type IssuanceTransactionState string
+
+// Model for credential offered in OIDC credential issuance transaction.
+type IssuanceTransactionCredential struct {
+	// Credential configuration ID.
+	CredentialConfigurationId string `json:"credential_configuration_id"`
+
+	// Credential template ID.
+	CredentialTemplateId *string `json:"credential_template_id,omitempty"`
+
+	// Credential format.
+	Format string `json:"format"`
+}
+
 // Model for issuer credential.
 type IssueCredentialData struct {
 	// Should be specified if using credential template
@@ -480,6 +559,24 @@ type PushAuthorizationDetailsRequest struct {
 	OpState              string                              `json:"op_state"`
 }
 
+// Model for credential refresh challenge.
+type RefreshCredentialChallenge struct {
+	// One-time challenge the presentation must be signed for.
+	Challenge string `json:"challenge"`
+
+	// Domain the presentation must be signed for.
+	Domain string `json:"domain"`
+}
+
+// Model for credential refresh.
+type RefreshCredentialData struct {
+	// One-time challenge the presentation is signed for.
+	Challenge string `json:"challenge"`
+
+	// Presentation of the credential in jws(string) or jsonld(object) formats.
+	Presentation interface{} `json:"presentation"`
+}
+
 // Object containing requested information for encrypting the Credential Response.
 type RequestedCredentialResponseEncryption struct {
 	// JWE alg algorithm for encrypting the Credential Response.
@@ -489,6 +586,42 @@ type RequestedCredentialResponseEncryption struct {
 	Enc string `json:"enc"`
 }
 
+// Model for signing key rotation response.
+type RotateSigningKeyResult struct {
+	// Profile signing DID.
+	Did string `json:"did"`
+
+	// ID of the retired verification method.
+	PreviousVerificationMethod *string `json:"previous_verification_method,omitempty"`
+
+	// ID of the new verification method used to sign credentials.
+	VerificationMethod string `json:"verification_method"`
+}
+
+// StatusListData represents the credential status list array element.
+type StatusListData struct {
+	// Share of assigned entries, from 0 to 1.
+	FillLevel float32 `json:"fill_level"`
+
+	// Status list ID.
+	ListId string `json:"list_id"`
+
+	// Number of entries of the status list.
+	ListSize int `json:"list_size"`
+
+	// Status purpose of the list.
+	StatusPurpose *string `json:"status_purpose,omitempty"`
+
+	// Status list type.
+	StatusType *string `json:"status_type,omitempty"`
+
+	// Status list credential URL.
+	Url string `json:"url"`
+
+	// Number of entries assigned to issued credentials.
+	UsedIndexes int `json:"used_indexes"`
+}
+
 // Model for storing auth code from issuer oauth
 type StoreAuthorizationCodeRequest struct {
 	Code                string                                `json:"code"`
@@ -501,6 +634,18 @@ type StoreAuthorizationCodeResponse struct {
 	TxId *string `json:"tx_id,omitempty"`
 }
 
+// Requests VCS to deliver the user PIN (transaction code) to the holder using the notifier configured in the issuer profile. Requires user_pin_required.
+type TxCodeDelivery struct {
+	// Email address the user PIN is sent to.
+	Email *string `json:"email,omitempty"`
+
+	// Removes the user PIN from the response.
+	OmitFromResponse *bool `json:"omit_from_response,omitempty"`
+
+	// Phone number the user PIN is sent to by SMS.
+	Phone *string `json:"phone,omitempty"`
+}
+
 // UpdateCredentialStatusRequest request struct for updating VC status.
 type UpdateCredentialStatusRequest struct {
 	CredentialID string `json:"credentialID"`
@@ -606,6 +751,21 @@ type WellKnownOpenIDIssuerConfiguration_CredentialConfigurationsSupported struct
 	AdditionalProperties map[string]CredentialConfigurationsSupported `json:"-"`
 }
 
+// AcceptLanguage defines model for AcceptLanguage.
+type AcceptLanguage = string
+
+// Locale defines model for Locale.
+type Locale = string
+
+// QRErrorCorrection defines model for QRErrorCorrection.
+type QRErrorCorrection string
+
+// QRFormat defines model for QRFormat.
+type QRFormat string
+
+// QRSize defines model for QRSize.
+type QRSize = int
+
 // PostCredentialsStatusJSONBody defines parameters for PostCredentialsStatus.
 type PostCredentialsStatusJSONBody = UpdateCredentialStatusRequest
 
@@ -633,12 +793,63 @@ type ValidatePreAuthorizedCodeRequestJSONBody = ValidatePreAuthorizedCodeRequest
 // PostIssueCredentialsJSONBody defines parameters for PostIssueCredentials.
 type PostIssueCredentialsJSONBody = IssueCredentialData
 
+// PostRefreshCredentialJSONBody defines parameters for PostRefreshCredential.
+type PostRefreshCredentialJSONBody = RefreshCredentialData
+
 // InitiateCredentialComposeIssuanceJSONBody defines parameters for InitiateCredentialComposeIssuance.
 type InitiateCredentialComposeIssuanceJSONBody = InitiateOIDC4CIRequest
 
+// InitiateCredentialComposeIssuanceParams defines parameters for InitiateCredentialComposeIssuance.
+type InitiateCredentialComposeIssuanceParams struct {
+	// Image format of the QR code of the request URL. The QR code is returned as a data URI if the format is set.
+	QrFormat *InitiateCredentialComposeIssuanceParamsQrFormat `form:"qr_format,omitempty" json:"qr_format,omitempty"`
+
+	// Error correction level of the QR code.
+	QrErrorCorrection *InitiateCredentialComposeIssuanceParamsQrErrorCorrection `form:"qr_error_correction,omitempty" json:"qr_error_correction,omitempty"`
+
+	// Width and height of the QR code image in pixels.
+	QrSize *QRSize `form:"qr_size,omitempty" json:"qr_size,omitempty"`
+
+	// Locale of the display metadata of the credentials, e.g. "en-US". Takes precedence over Accept-Language header.
+	Locale *Locale `form:"locale,omitempty" json:"locale,omitempty"`
+
+	// Preferred locales of the display metadata of the credentials.
+	AcceptLanguage *AcceptLanguage `json:"Accept-Language,omitempty"`
+}
+
+// InitiateCredentialComposeIssuanceParamsQrFormat defines parameters for InitiateCredentialComposeIssuance.
+type InitiateCredentialComposeIssuanceParamsQrFormat string
+
+// InitiateCredentialComposeIssuanceParamsQrErrorCorrection defines parameters for InitiateCredentialComposeIssuance.
+type InitiateCredentialComposeIssuanceParamsQrErrorCorrection string
+
 // InitiateCredentialIssuanceJSONBody defines parameters for InitiateCredentialIssuance.
 type InitiateCredentialIssuanceJSONBody = InitiateOIDC4CIRequest
 
+// InitiateCredentialIssuanceParams defines parameters for InitiateCredentialIssuance.
+type InitiateCredentialIssuanceParams struct {
+	// Image format of the QR code of the request URL. The QR code is returned as a data URI if the format is set.
+	QrFormat *InitiateCredentialIssuanceParamsQrFormat `form:"qr_format,omitempty" json:"qr_format,omitempty"`
+
+	// Error correction level of the QR code.
+	QrErrorCorrection *InitiateCredentialIssuanceParamsQrErrorCorrection `form:"qr_error_correction,omitempty" json:"qr_error_correction,omitempty"`
+
+	// Width and height of the QR code image in pixels.
+	QrSize *QRSize `form:"qr_size,omitempty" json:"qr_size,omitempty"`
+
+	// Locale of the display metadata of the credentials, e.g. "en-US". Takes precedence over Accept-Language header.
+	Locale *Locale `form:"locale,omitempty" json:"locale,omitempty"`
+
+	// Preferred locales of the display metadata of the credentials.
+	AcceptLanguage *AcceptLanguage `json:"Accept-Language,omitempty"`
+}
+
+// InitiateCredentialIssuanceParamsQrFormat defines parameters for InitiateCredentialIssuance.
+type InitiateCredentialIssuanceParamsQrFormat string
+
+// InitiateCredentialIssuanceParamsQrErrorCorrection defines parameters for InitiateCredentialIssuance.
+type InitiateCredentialIssuanceParamsQrErrorCorrection string
+
 // PostCredentialsStatusJSONRequestBody defines body for PostCredentialsStatus for application/json ContentType.
 type PostCredentialsStatusJSONRequestBody = PostCredentialsStatusJSONBody
 
@@ -666,6 +877,9 @@ type ValidatePreAuthorizedCodeRequestJSONRequestBody = ValidatePreAuthorizedCode
 // PostIssueCredentialsJSONRequestBody defines body for PostIssueCredentials for application/json ContentType.
 type PostIssueCredentialsJSONRequestBody = PostIssueCredentialsJSONBody
 
+// PostRefreshCredentialJSONRequestBody defines body for PostRefreshCredential for application/json ContentType.
+type PostRefreshCredentialJSONRequestBody = PostRefreshCredentialJSONBody
+
 // InitiateCredentialComposeIssuanceJSONRequestBody defines body for InitiateCredentialComposeIssuance for application/json ContentType.
 type InitiateCredentialComposeIssuanceJSONRequestBody = InitiateCredentialComposeIssuanceJSONBody
 
@@ -856,6 +1070,9 @@ type ClientInterface interface {
 
 	PostCredentialsStatus(ctx context.Context, body PostCredentialsStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListCredentialStatusLists request
+	ListCredentialStatusLists(ctx context.Context, groupID string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetCredentialsStatus request
 	GetCredentialsStatus(ctx context.Context, groupID string, statusID string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -902,15 +1119,35 @@ type ClientInterface interface {
 
 	PostIssueCredentials(ctx context.Context, profileID string, profileVersion string, body PostIssueCredentialsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetRefreshCredentialChallenge request
+	GetRefreshCredentialChallenge(ctx context.Context, profileID string, profileVersion string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRefreshCredential request with any body
+	PostRefreshCredentialWithBody(ctx context.Context, profileID string, profileVersion string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRefreshCredential(ctx context.Context, profileID string, profileVersion string, body PostRefreshCredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// InitiateCredentialComposeIssuance request with any body
-	InitiateCredentialComposeIssuanceWithBody(ctx context.Context, profileID string, profileVersion string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	InitiateCredentialComposeIssuanceWithBody(ctx context.Context, profileID string, profileVersion string, params *InitiateCredentialComposeIssuanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	InitiateCredentialComposeIssuance(ctx context.Context, profileID string, profileVersion string, body InitiateCredentialComposeIssuanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	InitiateCredentialComposeIssuance(ctx context.Context, profileID string, profileVersion string, params *InitiateCredentialComposeIssuanceParams, body InitiateCredentialComposeIssuanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// InitiateCredentialIssuance request with any body
-	InitiateCredentialIssuanceWithBody(ctx context.Context, profileID string, profileVersion string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	InitiateCredentialIssuanceWithBody(ctx context.Context, profileID string, profileVersion string, params *InitiateCredentialIssuanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	InitiateCredentialIssuance(ctx context.Context, profileID string, profileVersion string, params *InitiateCredentialIssuanceParams, body InitiateCredentialIssuanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	InitiateCredentialIssuance(ctx context.Context, profileID string, profileVersion string, body InitiateCredentialIssuanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ListIssuanceTransactions request
+	ListIssuanceTransactions(ctx context.Context, profileID string, profileVersion string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIssuanceTransaction request
+	GetIssuanceTransaction(ctx context.Context, profileID string, profileVersion string, txID string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelIssuanceTransaction request
+	CancelIssuanceTransaction(ctx context.Context, profileID string, profileVersion string, txID string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RotateSigningKey request
+	RotateSigningKey(ctx context.Context, profileID string, profileVersion string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// OpenidCredentialIssuerConfig request
 	OpenidCredentialIssuerConfig(ctx context.Context, profileID string, profileVersion string, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -943,6 +1180,18 @@ func (c *Client) PostCredentialsStatus(ctx context.Context, body PostCredentials
 	return c.Client.Do(req)
 }
 
+func (c *Client) ListCredentialStatusLists(ctx context.Context, groupID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCredentialStatusListsRequest(c.Server, groupID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetCredentialsStatus(ctx context.Context, groupID string, statusID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetCredentialsStatusRequest(c.Server, groupID, statusID)
 	if err != nil {
@@ -1159,8 +1408,92 @@ func (c *Client) PostIssueCredentials(ctx context.Context, profileID string, pro
 	return c.Client.Do(req)
 }
 
-func (c *Client) InitiateCredentialComposeIssuanceWithBody(ctx context.Context, profileID string, profileVersion string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewInitiateCredentialComposeIssuanceRequestWithBody(c.Server, profileID, profileVersion, contentType, body)
+func (c *Client) GetRefreshCredentialChallenge(ctx context.Context, profileID string, profileVersion string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRefreshCredentialChallengeRequest(c.Server, profileID, profileVersion)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRefreshCredentialWithBody(ctx context.Context, profileID string, profileVersion string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRefreshCredentialRequestWithBody(c.Server, profileID, profileVersion, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRefreshCredential(ctx context.Context, profileID string, profileVersion string, body PostRefreshCredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRefreshCredentialRequest(c.Server, profileID, profileVersion, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InitiateCredentialComposeIssuanceWithBody(ctx context.Context, profileID string, profileVersion string, params *InitiateCredentialComposeIssuanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInitiateCredentialComposeIssuanceRequestWithBody(c.Server, profileID, profileVersion, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InitiateCredentialComposeIssuance(ctx context.Context, profileID string, profileVersion string, params *InitiateCredentialComposeIssuanceParams, body InitiateCredentialComposeIssuanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInitiateCredentialComposeIssuanceRequest(c.Server, profileID, profileVersion, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InitiateCredentialIssuanceWithBody(ctx context.Context, profileID string, profileVersion string, params *InitiateCredentialIssuanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInitiateCredentialIssuanceRequestWithBody(c.Server, profileID, profileVersion, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InitiateCredentialIssuance(ctx context.Context, profileID string, profileVersion string, params *InitiateCredentialIssuanceParams, body InitiateCredentialIssuanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInitiateCredentialIssuanceRequest(c.Server, profileID, profileVersion, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListIssuanceTransactions(ctx context.Context, profileID string, profileVersion string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListIssuanceTransactionsRequest(c.Server, profileID, profileVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -1171,8 +1504,8 @@ func (c *Client) InitiateCredentialComposeIssuanceWithBody(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-func (c *Client) InitiateCredentialComposeIssuance(ctx context.Context, profileID string, profileVersion string, body InitiateCredentialComposeIssuanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewInitiateCredentialComposeIssuanceRequest(c.Server, profileID, profileVersion, body)
+func (c *Client) GetIssuanceTransaction(ctx context.Context, profileID string, profileVersion string, txID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIssuanceTransactionRequest(c.Server, profileID, profileVersion, txID)
 	if err != nil {
 		return nil, err
 	}
@@ -1183,8 +1516,8 @@ func (c *Client) InitiateCredentialComposeIssuance(ctx context.Context, profileI
 	return c.Client.Do(req)
 }
 
-func (c *Client) InitiateCredentialIssuanceWithBody(ctx context.Context, profileID string, profileVersion string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewInitiateCredentialIssuanceRequestWithBody(c.Server, profileID, profileVersion, contentType, body)
+func (c *Client) CancelIssuanceTransaction(ctx context.Context, profileID string, profileVersion string, txID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelIssuanceTransactionRequest(c.Server, profileID, profileVersion, txID)
 	if err != nil {
 		return nil, err
 	}
@@ -1195,8 +1528,8 @@ func (c *Client) InitiateCredentialIssuanceWithBody(ctx context.Context, profile
 	return c.Client.Do(req)
 }
 
-func (c *Client) InitiateCredentialIssuance(ctx context.Context, profileID string, profileVersion string, body InitiateCredentialIssuanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewInitiateCredentialIssuanceRequest(c.Server, profileID, profileVersion, body)
+func (c *Client) RotateSigningKey(ctx context.Context, profileID string, profileVersion string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRotateSigningKeyRequest(c.Server, profileID, profileVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -1271,6 +1604,40 @@ func NewPostCredentialsStatusRequestWithBody(server string, contentType string,
 	return req, nil
 }
 
+// NewListCredentialStatusListsRequest generates requests for ListCredentialStatusLists
+func NewListCredentialStatusListsRequest(server string, groupID string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupID", runtime.ParamLocationPath, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/issuer/groups/%s/credentials/status-lists", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetCredentialsStatusRequest generates requests for GetCredentialsStatus
 func NewGetCredentialsStatusRequest(server string, groupID string, statusID string) (*http.Request, error) {
 	var err error
@@ -1680,19 +2047,8 @@ func NewPostIssueCredentialsRequestWithBody(server string, profileID string, pro
 	return req, nil
 }
 
-// NewInitiateCredentialComposeIssuanceRequest calls the generic InitiateCredentialComposeIssuance builder with application/json body
-func NewInitiateCredentialComposeIssuanceRequest(server string, profileID string, profileVersion string, body InitiateCredentialComposeIssuanceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewInitiateCredentialComposeIssuanceRequestWithBody(server, profileID, profileVersion, "application/json", bodyReader)
-}
-
-// NewInitiateCredentialComposeIssuanceRequestWithBody generates requests for InitiateCredentialComposeIssuance with any type of body
-func NewInitiateCredentialComposeIssuanceRequestWithBody(server string, profileID string, profileVersion string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetRefreshCredentialChallengeRequest generates requests for GetRefreshCredentialChallenge
+func NewGetRefreshCredentialChallengeRequest(server string, profileID string, profileVersion string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1714,7 +2070,7 @@ func NewInitiateCredentialComposeIssuanceRequestWithBody(server string, profileI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/issuer/profiles/%s/%s/interactions/compose-and-initiate-issuance", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/issuer/profiles/%s/%s/credentials/refresh", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1724,29 +2080,27 @@ func NewInitiateCredentialComposeIssuanceRequestWithBody(server string, profileI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewInitiateCredentialIssuanceRequest calls the generic InitiateCredentialIssuance builder with application/json body
-func NewInitiateCredentialIssuanceRequest(server string, profileID string, profileVersion string, body InitiateCredentialIssuanceJSONRequestBody) (*http.Request, error) {
+// NewPostRefreshCredentialRequest calls the generic PostRefreshCredential builder with application/json body
+func NewPostRefreshCredentialRequest(server string, profileID string, profileVersion string, body PostRefreshCredentialJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewInitiateCredentialIssuanceRequestWithBody(server, profileID, profileVersion, "application/json", bodyReader)
+	return NewPostRefreshCredentialRequestWithBody(server, profileID, profileVersion, "application/json", bodyReader)
 }
 
-// NewInitiateCredentialIssuanceRequestWithBody generates requests for InitiateCredentialIssuance with any type of body
-func NewInitiateCredentialIssuanceRequestWithBody(server string, profileID string, profileVersion string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostRefreshCredentialRequestWithBody generates requests for PostRefreshCredential with any type of body
+func NewPostRefreshCredentialRequestWithBody(server string, profileID string, profileVersion string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1768,7 +2122,7 @@ func NewInitiateCredentialIssuanceRequestWithBody(server string, profileID strin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/issuer/profiles/%s/%s/interactions/initiate-oidc", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/issuer/profiles/%s/%s/credentials/refresh", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1788,8 +2142,19 @@ func NewInitiateCredentialIssuanceRequestWithBody(server string, profileID strin
 	return req, nil
 }
 
-// NewOpenidCredentialIssuerConfigRequest generates requests for OpenidCredentialIssuerConfig
-func NewOpenidCredentialIssuerConfigRequest(server string, profileID string, profileVersion string) (*http.Request, error) {
+// NewInitiateCredentialComposeIssuanceRequest calls the generic InitiateCredentialComposeIssuance builder with application/json body
+func NewInitiateCredentialComposeIssuanceRequest(server string, profileID string, profileVersion string, params *InitiateCredentialComposeIssuanceParams, body InitiateCredentialComposeIssuanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewInitiateCredentialComposeIssuanceRequestWithBody(server, profileID, profileVersion, params, "application/json", bodyReader)
+}
+
+// NewInitiateCredentialComposeIssuanceRequestWithBody generates requests for InitiateCredentialComposeIssuance with any type of body
+func NewInitiateCredentialComposeIssuanceRequestWithBody(server string, profileID string, profileVersion string, params *InitiateCredentialComposeIssuanceParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1811,7 +2176,7 @@ func NewOpenidCredentialIssuerConfigRequest(server string, profileID string, pro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/issuer/%s/%s/.well-known/openid-credential-issuer", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/issuer/profiles/%s/%s/interactions/compose-and-initiate-issuance", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1821,35 +2186,468 @@ func NewOpenidCredentialIssuerConfigRequest(server string, profileID string, pro
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
+	queryValues := queryURL.Query()
 
-// NewOpenidCredentialIssuerConfigV2Request generates requests for OpenidCredentialIssuerConfigV2
-func NewOpenidCredentialIssuerConfigV2Request(server string, profileID string, profileVersion string) (*http.Request, error) {
-	var err error
+	if params.QrFormat != nil {
 
-	var pathParam0 string
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "qr_format", runtime.ParamLocationQuery, *params.QrFormat); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileID", runtime.ParamLocationPath, profileID)
-	if err != nil {
-		return nil, err
 	}
 
-	var pathParam1 string
+	if params.QrErrorCorrection != nil {
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "profileVersion", runtime.ParamLocationPath, profileVersion)
-	if err != nil {
-		return nil, err
-	}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "qr_error_correction", runtime.ParamLocationQuery, *params.QrErrorCorrection); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	}
+
+	if params.QrSize != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "qr_size", runtime.ParamLocationQuery, *params.QrSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Locale != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "locale", runtime.ParamLocationQuery, *params.Locale); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.AcceptLanguage != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Accept-Language", runtime.ParamLocationHeader, *params.AcceptLanguage)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Accept-Language", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewInitiateCredentialIssuanceRequest calls the generic InitiateCredentialIssuance builder with application/json body
+func NewInitiateCredentialIssuanceRequest(server string, profileID string, profileVersion string, params *InitiateCredentialIssuanceParams, body InitiateCredentialIssuanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewInitiateCredentialIssuanceRequestWithBody(server, profileID, profileVersion, params, "application/json", bodyReader)
+}
+
+// NewInitiateCredentialIssuanceRequestWithBody generates requests for InitiateCredentialIssuance with any type of body
+func NewInitiateCredentialIssuanceRequestWithBody(server string, profileID string, profileVersion string, params *InitiateCredentialIssuanceParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileID", runtime.ParamLocationPath, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "profileVersion", runtime.ParamLocationPath, profileVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/issuer/profiles/%s/%s/interactions/initiate-oidc", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.QrFormat != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "qr_format", runtime.ParamLocationQuery, *params.QrFormat); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.QrErrorCorrection != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "qr_error_correction", runtime.ParamLocationQuery, *params.QrErrorCorrection); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.QrSize != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "qr_size", runtime.ParamLocationQuery, *params.QrSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Locale != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "locale", runtime.ParamLocationQuery, *params.Locale); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.AcceptLanguage != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Accept-Language", runtime.ParamLocationHeader, *params.AcceptLanguage)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Accept-Language", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewListIssuanceTransactionsRequest generates requests for ListIssuanceTransactions
+func NewListIssuanceTransactionsRequest(server string, profileID string, profileVersion string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileID", runtime.ParamLocationPath, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "profileVersion", runtime.ParamLocationPath, profileVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/issuer/profiles/%s/%s/interactions/transactions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetIssuanceTransactionRequest generates requests for GetIssuanceTransaction
+func NewGetIssuanceTransactionRequest(server string, profileID string, profileVersion string, txID string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileID", runtime.ParamLocationPath, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "profileVersion", runtime.ParamLocationPath, profileVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "txID", runtime.ParamLocationPath, txID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/issuer/profiles/%s/%s/interactions/transactions/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCancelIssuanceTransactionRequest generates requests for CancelIssuanceTransaction
+func NewCancelIssuanceTransactionRequest(server string, profileID string, profileVersion string, txID string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileID", runtime.ParamLocationPath, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "profileVersion", runtime.ParamLocationPath, profileVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "txID", runtime.ParamLocationPath, txID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/issuer/profiles/%s/%s/interactions/transactions/%s/cancel", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRotateSigningKeyRequest generates requests for RotateSigningKey
+func NewRotateSigningKeyRequest(server string, profileID string, profileVersion string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileID", runtime.ParamLocationPath, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "profileVersion", runtime.ParamLocationPath, profileVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/issuer/profiles/%s/%s/keys/rotate", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewOpenidCredentialIssuerConfigRequest generates requests for OpenidCredentialIssuerConfig
+func NewOpenidCredentialIssuerConfigRequest(server string, profileID string, profileVersion string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileID", runtime.ParamLocationPath, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "profileVersion", runtime.ParamLocationPath, profileVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/issuer/%s/%s/.well-known/openid-credential-issuer", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewOpenidCredentialIssuerConfigV2Request generates requests for OpenidCredentialIssuerConfigV2
+func NewOpenidCredentialIssuerConfigV2Request(server string, profileID string, profileVersion string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileID", runtime.ParamLocationPath, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "profileVersion", runtime.ParamLocationPath, profileVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
 	operationPath := fmt.Sprintf("/oidc/idp/%s/%s/.well-known/openid-credential-issuer", pathParam0, pathParam1)
@@ -1918,6 +2716,9 @@ type ClientWithResponsesInterface interface {
 
 	PostCredentialsStatusWithResponse(ctx context.Context, body PostCredentialsStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCredentialsStatusResponse, error)
 
+	// ListCredentialStatusLists request
+	ListCredentialStatusListsWithResponse(ctx context.Context, groupID string, reqEditors ...RequestEditorFn) (*ListCredentialStatusListsResponse, error)
+
 	// GetCredentialsStatus request
 	GetCredentialsStatusWithResponse(ctx context.Context, groupID string, statusID string, reqEditors ...RequestEditorFn) (*GetCredentialsStatusResponse, error)
 
@@ -1964,15 +2765,35 @@ type ClientWithResponsesInterface interface {
 
 	PostIssueCredentialsWithResponse(ctx context.Context, profileID string, profileVersion string, body PostIssueCredentialsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostIssueCredentialsResponse, error)
 
+	// GetRefreshCredentialChallenge request
+	GetRefreshCredentialChallengeWithResponse(ctx context.Context, profileID string, profileVersion string, reqEditors ...RequestEditorFn) (*GetRefreshCredentialChallengeResponse, error)
+
+	// PostRefreshCredential request with any body
+	PostRefreshCredentialWithBodyWithResponse(ctx context.Context, profileID string, profileVersion string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRefreshCredentialResponse, error)
+
+	PostRefreshCredentialWithResponse(ctx context.Context, profileID string, profileVersion string, body PostRefreshCredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRefreshCredentialResponse, error)
+
 	// InitiateCredentialComposeIssuance request with any body
-	InitiateCredentialComposeIssuanceWithBodyWithResponse(ctx context.Context, profileID string, profileVersion string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InitiateCredentialComposeIssuanceResponse, error)
+	InitiateCredentialComposeIssuanceWithBodyWithResponse(ctx context.Context, profileID string, profileVersion string, params *InitiateCredentialComposeIssuanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InitiateCredentialComposeIssuanceResponse, error)
 
-	InitiateCredentialComposeIssuanceWithResponse(ctx context.Context, profileID string, profileVersion string, body InitiateCredentialComposeIssuanceJSONRequestBody, reqEditors ...RequestEditorFn) (*InitiateCredentialComposeIssuanceResponse, error)
+	InitiateCredentialComposeIssuanceWithResponse(ctx context.Context, profileID string, profileVersion string, params *InitiateCredentialComposeIssuanceParams, body InitiateCredentialComposeIssuanceJSONRequestBody, reqEditors ...RequestEditorFn) (*InitiateCredentialComposeIssuanceResponse, error)
 
 	// InitiateCredentialIssuance request with any body
-	InitiateCredentialIssuanceWithBodyWithResponse(ctx context.Context, profileID string, profileVersion string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InitiateCredentialIssuanceResponse, error)
+	InitiateCredentialIssuanceWithBodyWithResponse(ctx context.Context, profileID string, profileVersion string, params *InitiateCredentialIssuanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InitiateCredentialIssuanceResponse, error)
+
+	InitiateCredentialIssuanceWithResponse(ctx context.Context, profileID string, profileVersion string, params *InitiateCredentialIssuanceParams, body InitiateCredentialIssuanceJSONRequestBody, reqEditors ...RequestEditorFn) (*InitiateCredentialIssuanceResponse, error)
+
+	// ListIssuanceTransactions request
+	ListIssuanceTransactionsWithResponse(ctx context.Context, profileID string, profileVersion string, reqEditors ...RequestEditorFn) (*ListIssuanceTransactionsResponse, error)
+
+	// GetIssuanceTransaction request
+	GetIssuanceTransactionWithResponse(ctx context.Context, profileID string, profileVersion string, txID string, reqEditors ...RequestEditorFn) (*GetIssuanceTransactionResponse, error)
+
+	// CancelIssuanceTransaction request
+	CancelIssuanceTransactionWithResponse(ctx context.Context, profileID string, profileVersion string, txID string, reqEditors ...RequestEditorFn) (*CancelIssuanceTransactionResponse, error)
 
-	InitiateCredentialIssuanceWithResponse(ctx context.Context, profileID string, profileVersion string, body InitiateCredentialIssuanceJSONRequestBody, reqEditors ...RequestEditorFn) (*InitiateCredentialIssuanceResponse, error)
+	// RotateSigningKey request
+	RotateSigningKeyWithResponse(ctx context.Context, profileID string, profileVersion string, reqEditors ...RequestEditorFn) (*RotateSigningKeyResponse, error)
 
 	// OpenidCredentialIssuerConfig request
 	OpenidCredentialIssuerConfigWithResponse(ctx context.Context, profileID string, profileVersion string, reqEditors ...RequestEditorFn) (*OpenidCredentialIssuerConfigResponse, error)
@@ -2003,6 +2824,28 @@ func (r PostCredentialsStatusResponse) StatusCode() int {
 	return 0
 }
 
+type ListCredentialStatusListsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]StatusListData
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCredentialStatusListsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCredentialStatusListsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetCredentialsStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2062,21 +2905,152 @@ func (r PrepareAuthorizationRequestResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PrepareAuthorizationRequestResponse) StatusCode() int {
+func (r PrepareAuthorizationRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PrepareCredentialResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PrepareCredentialResult
+}
+
+// Status returns HTTPResponse.Status
+func (r PrepareCredentialResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PrepareCredentialResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PrepareBatchCredentialResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PrepareCredentialResult
+}
+
+// Status returns HTTPResponse.Status
+func (r PrepareBatchCredentialResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PrepareBatchCredentialResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PushAuthorizationDetailsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PushAuthorizationDetailsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PushAuthorizationDetailsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StoreAuthorizationCodeRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StoreAuthorizationCodeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StoreAuthorizationCodeRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StoreAuthorizationCodeRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidatePreAuthorizedCodeRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ValidatePreAuthorizedCodeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidatePreAuthorizedCodeRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidatePreAuthorizedCodeRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CredentialIssuanceHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CredentialIssuanceHistoryData
+}
+
+// Status returns HTTPResponse.Status
+func (r CredentialIssuanceHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CredentialIssuanceHistoryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PrepareCredentialResponse struct {
+type PostIssueCredentialsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PrepareCredentialResult
+	JSON200      *map[string]interface{}
 }
 
 // Status returns HTTPResponse.Status
-func (r PrepareCredentialResponse) Status() string {
+func (r PostIssueCredentialsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2084,21 +3058,21 @@ func (r PrepareCredentialResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PrepareCredentialResponse) StatusCode() int {
+func (r PostIssueCredentialsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PrepareBatchCredentialResponse struct {
+type GetRefreshCredentialChallengeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]PrepareCredentialResult
+	JSON200      *RefreshCredentialChallenge
 }
 
 // Status returns HTTPResponse.Status
-func (r PrepareBatchCredentialResponse) Status() string {
+func (r GetRefreshCredentialChallengeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2106,20 +3080,21 @@ func (r PrepareBatchCredentialResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PrepareBatchCredentialResponse) StatusCode() int {
+func (r GetRefreshCredentialChallengeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PushAuthorizationDetailsResponse struct {
+type PostRefreshCredentialResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
 }
 
 // Status returns HTTPResponse.Status
-func (r PushAuthorizationDetailsResponse) Status() string {
+func (r PostRefreshCredentialResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2127,21 +3102,21 @@ func (r PushAuthorizationDetailsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PushAuthorizationDetailsResponse) StatusCode() int {
+func (r PostRefreshCredentialResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StoreAuthorizationCodeRequestResponse struct {
+type InitiateCredentialComposeIssuanceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *StoreAuthorizationCodeResponse
+	JSON200      *InitiateOIDC4CIComposeRequest
 }
 
 // Status returns HTTPResponse.Status
-func (r StoreAuthorizationCodeRequestResponse) Status() string {
+func (r InitiateCredentialComposeIssuanceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2149,21 +3124,21 @@ func (r StoreAuthorizationCodeRequestResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StoreAuthorizationCodeRequestResponse) StatusCode() int {
+func (r InitiateCredentialComposeIssuanceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ValidatePreAuthorizedCodeRequestResponse struct {
+type InitiateCredentialIssuanceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ValidatePreAuthorizedCodeResponse
+	JSON200      *InitiateOIDC4CIResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r ValidatePreAuthorizedCodeRequestResponse) Status() string {
+func (r InitiateCredentialIssuanceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2171,21 +3146,21 @@ func (r ValidatePreAuthorizedCodeRequestResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ValidatePreAuthorizedCodeRequestResponse) StatusCode() int {
+func (r InitiateCredentialIssuanceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CredentialIssuanceHistoryResponse struct {
+type ListIssuanceTransactionsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]CredentialIssuanceHistoryData
+	JSON200      *[]IssuanceTransaction
 }
 
 // Status returns HTTPResponse.Status
-func (r CredentialIssuanceHistoryResponse) Status() string {
+func (r ListIssuanceTransactionsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2193,21 +3168,21 @@ func (r CredentialIssuanceHistoryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CredentialIssuanceHistoryResponse) StatusCode() int {
+func (r ListIssuanceTransactionsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PostIssueCredentialsResponse struct {
+type GetIssuanceTransactionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *map[string]interface{}
+	JSON200      *IssuanceTransaction
 }
 
 // Status returns HTTPResponse.Status
-func (r PostIssueCredentialsResponse) Status() string {
+func (r GetIssuanceTransactionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2215,21 +3190,21 @@ func (r PostIssueCredentialsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostIssueCredentialsResponse) StatusCode() int {
+func (r GetIssuanceTransactionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type InitiateCredentialComposeIssuanceResponse struct {
+type CancelIssuanceTransactionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InitiateOIDC4CIComposeRequest
+	JSON200      *IssuanceTransaction
 }
 
 // Status returns HTTPResponse.Status
-func (r InitiateCredentialComposeIssuanceResponse) Status() string {
+func (r CancelIssuanceTransactionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2237,21 +3212,21 @@ func (r InitiateCredentialComposeIssuanceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r InitiateCredentialComposeIssuanceResponse) StatusCode() int {
+func (r CancelIssuanceTransactionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type InitiateCredentialIssuanceResponse struct {
+type RotateSigningKeyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InitiateOIDC4CIResponse
+	JSON200      *RotateSigningKeyResult
 }
 
 // Status returns HTTPResponse.Status
-func (r InitiateCredentialIssuanceResponse) Status() string {
+func (r RotateSigningKeyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2259,7 +3234,7 @@ func (r InitiateCredentialIssuanceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r InitiateCredentialIssuanceResponse) StatusCode() int {
+func (r RotateSigningKeyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -2327,6 +3302,15 @@ func (c *ClientWithResponses) PostCredentialsStatusWithResponse(ctx context.Cont
 	return ParsePostCredentialsStatusResponse(rsp)
 }
 
+// ListCredentialStatusListsWithResponse request returning *ListCredentialStatusListsResponse
+func (c *ClientWithResponses) ListCredentialStatusListsWithResponse(ctx context.Context, groupID string, reqEditors ...RequestEditorFn) (*ListCredentialStatusListsResponse, error) {
+	rsp, err := c.ListCredentialStatusLists(ctx, groupID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCredentialStatusListsResponse(rsp)
+}
+
 // GetCredentialsStatusWithResponse request returning *GetCredentialsStatusResponse
 func (c *ClientWithResponses) GetCredentialsStatusWithResponse(ctx context.Context, groupID string, statusID string, reqEditors ...RequestEditorFn) (*GetCredentialsStatusResponse, error) {
 	rsp, err := c.GetCredentialsStatus(ctx, groupID, statusID, reqEditors...)
@@ -2481,17 +3465,43 @@ func (c *ClientWithResponses) PostIssueCredentialsWithResponse(ctx context.Conte
 	return ParsePostIssueCredentialsResponse(rsp)
 }
 
+// GetRefreshCredentialChallengeWithResponse request returning *GetRefreshCredentialChallengeResponse
+func (c *ClientWithResponses) GetRefreshCredentialChallengeWithResponse(ctx context.Context, profileID string, profileVersion string, reqEditors ...RequestEditorFn) (*GetRefreshCredentialChallengeResponse, error) {
+	rsp, err := c.GetRefreshCredentialChallenge(ctx, profileID, profileVersion, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRefreshCredentialChallengeResponse(rsp)
+}
+
+// PostRefreshCredentialWithBodyWithResponse request with arbitrary body returning *PostRefreshCredentialResponse
+func (c *ClientWithResponses) PostRefreshCredentialWithBodyWithResponse(ctx context.Context, profileID string, profileVersion string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRefreshCredentialResponse, error) {
+	rsp, err := c.PostRefreshCredentialWithBody(ctx, profileID, profileVersion, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRefreshCredentialResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRefreshCredentialWithResponse(ctx context.Context, profileID string, profileVersion string, body PostRefreshCredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRefreshCredentialResponse, error) {
+	rsp, err := c.PostRefreshCredential(ctx, profileID, profileVersion, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRefreshCredentialResponse(rsp)
+}
+
 // InitiateCredentialComposeIssuanceWithBodyWithResponse request with arbitrary body returning *InitiateCredentialComposeIssuanceResponse
-func (c *ClientWithResponses) InitiateCredentialComposeIssuanceWithBodyWithResponse(ctx context.Context, profileID string, profileVersion string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InitiateCredentialComposeIssuanceResponse, error) {
-	rsp, err := c.InitiateCredentialComposeIssuanceWithBody(ctx, profileID, profileVersion, contentType, body, reqEditors...)
+func (c *ClientWithResponses) InitiateCredentialComposeIssuanceWithBodyWithResponse(ctx context.Context, profileID string, profileVersion string, params *InitiateCredentialComposeIssuanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InitiateCredentialComposeIssuanceResponse, error) {
+	rsp, err := c.InitiateCredentialComposeIssuanceWithBody(ctx, profileID, profileVersion, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseInitiateCredentialComposeIssuanceResponse(rsp)
 }
 
-func (c *ClientWithResponses) InitiateCredentialComposeIssuanceWithResponse(ctx context.Context, profileID string, profileVersion string, body InitiateCredentialComposeIssuanceJSONRequestBody, reqEditors ...RequestEditorFn) (*InitiateCredentialComposeIssuanceResponse, error) {
-	rsp, err := c.InitiateCredentialComposeIssuance(ctx, profileID, profileVersion, body, reqEditors...)
+func (c *ClientWithResponses) InitiateCredentialComposeIssuanceWithResponse(ctx context.Context, profileID string, profileVersion string, params *InitiateCredentialComposeIssuanceParams, body InitiateCredentialComposeIssuanceJSONRequestBody, reqEditors ...RequestEditorFn) (*InitiateCredentialComposeIssuanceResponse, error) {
+	rsp, err := c.InitiateCredentialComposeIssuance(ctx, profileID, profileVersion, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -2499,22 +3509,58 @@ func (c *ClientWithResponses) InitiateCredentialComposeIssuanceWithResponse(ctx
 }
 
 // InitiateCredentialIssuanceWithBodyWithResponse request with arbitrary body returning *InitiateCredentialIssuanceResponse
-func (c *ClientWithResponses) InitiateCredentialIssuanceWithBodyWithResponse(ctx context.Context, profileID string, profileVersion string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InitiateCredentialIssuanceResponse, error) {
-	rsp, err := c.InitiateCredentialIssuanceWithBody(ctx, profileID, profileVersion, contentType, body, reqEditors...)
+func (c *ClientWithResponses) InitiateCredentialIssuanceWithBodyWithResponse(ctx context.Context, profileID string, profileVersion string, params *InitiateCredentialIssuanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InitiateCredentialIssuanceResponse, error) {
+	rsp, err := c.InitiateCredentialIssuanceWithBody(ctx, profileID, profileVersion, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseInitiateCredentialIssuanceResponse(rsp)
 }
 
-func (c *ClientWithResponses) InitiateCredentialIssuanceWithResponse(ctx context.Context, profileID string, profileVersion string, body InitiateCredentialIssuanceJSONRequestBody, reqEditors ...RequestEditorFn) (*InitiateCredentialIssuanceResponse, error) {
-	rsp, err := c.InitiateCredentialIssuance(ctx, profileID, profileVersion, body, reqEditors...)
+func (c *ClientWithResponses) InitiateCredentialIssuanceWithResponse(ctx context.Context, profileID string, profileVersion string, params *InitiateCredentialIssuanceParams, body InitiateCredentialIssuanceJSONRequestBody, reqEditors ...RequestEditorFn) (*InitiateCredentialIssuanceResponse, error) {
+	rsp, err := c.InitiateCredentialIssuance(ctx, profileID, profileVersion, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseInitiateCredentialIssuanceResponse(rsp)
 }
 
+// ListIssuanceTransactionsWithResponse request returning *ListIssuanceTransactionsResponse
+func (c *ClientWithResponses) ListIssuanceTransactionsWithResponse(ctx context.Context, profileID string, profileVersion string, reqEditors ...RequestEditorFn) (*ListIssuanceTransactionsResponse, error) {
+	rsp, err := c.ListIssuanceTransactions(ctx, profileID, profileVersion, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListIssuanceTransactionsResponse(rsp)
+}
+
+// GetIssuanceTransactionWithResponse request returning *GetIssuanceTransactionResponse
+func (c *ClientWithResponses) GetIssuanceTransactionWithResponse(ctx context.Context, profileID string, profileVersion string, txID string, reqEditors ...RequestEditorFn) (*GetIssuanceTransactionResponse, error) {
+	rsp, err := c.GetIssuanceTransaction(ctx, profileID, profileVersion, txID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIssuanceTransactionResponse(rsp)
+}
+
+// CancelIssuanceTransactionWithResponse request returning *CancelIssuanceTransactionResponse
+func (c *ClientWithResponses) CancelIssuanceTransactionWithResponse(ctx context.Context, profileID string, profileVersion string, txID string, reqEditors ...RequestEditorFn) (*CancelIssuanceTransactionResponse, error) {
+	rsp, err := c.CancelIssuanceTransaction(ctx, profileID, profileVersion, txID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelIssuanceTransactionResponse(rsp)
+}
+
+// RotateSigningKeyWithResponse request returning *RotateSigningKeyResponse
+func (c *ClientWithResponses) RotateSigningKeyWithResponse(ctx context.Context, profileID string, profileVersion string, reqEditors ...RequestEditorFn) (*RotateSigningKeyResponse, error) {
+	rsp, err := c.RotateSigningKey(ctx, profileID, profileVersion, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRotateSigningKeyResponse(rsp)
+}
+
 // OpenidCredentialIssuerConfigWithResponse request returning *OpenidCredentialIssuerConfigResponse
 func (c *ClientWithResponses) OpenidCredentialIssuerConfigWithResponse(ctx context.Context, profileID string, profileVersion string, reqEditors ...RequestEditorFn) (*OpenidCredentialIssuerConfigResponse, error) {
 	rsp, err := c.OpenidCredentialIssuerConfig(ctx, profileID, profileVersion, reqEditors...)
@@ -2559,6 +3605,32 @@ func ParsePostCredentialsStatusResponse(rsp *http.Response) (*PostCredentialsSta
 	return response, nil
 }
 
+// ParseListCredentialStatusListsResponse parses an HTTP response from a ListCredentialStatusListsWithResponse call
+func ParseListCredentialStatusListsResponse(rsp *http.Response) (*ListCredentialStatusListsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCredentialStatusListsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []StatusListData
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetCredentialsStatusResponse parses an HTTP response from a GetCredentialsStatusWithResponse call
 func ParseGetCredentialsStatusResponse(rsp *http.Response) (*GetCredentialsStatusResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -2619,14 +3691,160 @@ func ParsePrepareAuthorizationRequestResponse(rsp *http.Response) (*PrepareAutho
 		return nil, err
 	}
 
-	response := &PrepareAuthorizationRequestResponse{
+	response := &PrepareAuthorizationRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PrepareClaimDataAuthorizationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePrepareCredentialResponse parses an HTTP response from a PrepareCredentialWithResponse call
+func ParsePrepareCredentialResponse(rsp *http.Response) (*PrepareCredentialResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PrepareCredentialResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PrepareCredentialResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePrepareBatchCredentialResponse parses an HTTP response from a PrepareBatchCredentialWithResponse call
+func ParsePrepareBatchCredentialResponse(rsp *http.Response) (*PrepareBatchCredentialResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PrepareBatchCredentialResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PrepareCredentialResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePushAuthorizationDetailsResponse parses an HTTP response from a PushAuthorizationDetailsWithResponse call
+func ParsePushAuthorizationDetailsResponse(rsp *http.Response) (*PushAuthorizationDetailsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PushAuthorizationDetailsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseStoreAuthorizationCodeRequestResponse parses an HTTP response from a StoreAuthorizationCodeRequestWithResponse call
+func ParseStoreAuthorizationCodeRequestResponse(rsp *http.Response) (*StoreAuthorizationCodeRequestResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StoreAuthorizationCodeRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StoreAuthorizationCodeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseValidatePreAuthorizedCodeRequestResponse parses an HTTP response from a ValidatePreAuthorizedCodeRequestWithResponse call
+func ParseValidatePreAuthorizedCodeRequestResponse(rsp *http.Response) (*ValidatePreAuthorizedCodeRequestResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ValidatePreAuthorizedCodeRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ValidatePreAuthorizedCodeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCredentialIssuanceHistoryResponse parses an HTTP response from a CredentialIssuanceHistoryWithResponse call
+func ParseCredentialIssuanceHistoryResponse(rsp *http.Response) (*CredentialIssuanceHistoryResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CredentialIssuanceHistoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PrepareClaimDataAuthorizationResponse
+		var dest []CredentialIssuanceHistoryData
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2637,22 +3855,22 @@ func ParsePrepareAuthorizationRequestResponse(rsp *http.Response) (*PrepareAutho
 	return response, nil
 }
 
-// ParsePrepareCredentialResponse parses an HTTP response from a PrepareCredentialWithResponse call
-func ParsePrepareCredentialResponse(rsp *http.Response) (*PrepareCredentialResponse, error) {
+// ParsePostIssueCredentialsResponse parses an HTTP response from a PostIssueCredentialsWithResponse call
+func ParsePostIssueCredentialsResponse(rsp *http.Response) (*PostIssueCredentialsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PrepareCredentialResponse{
+	response := &PostIssueCredentialsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PrepareCredentialResult
+		var dest map[string]interface{}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2663,22 +3881,22 @@ func ParsePrepareCredentialResponse(rsp *http.Response) (*PrepareCredentialRespo
 	return response, nil
 }
 
-// ParsePrepareBatchCredentialResponse parses an HTTP response from a PrepareBatchCredentialWithResponse call
-func ParsePrepareBatchCredentialResponse(rsp *http.Response) (*PrepareBatchCredentialResponse, error) {
+// ParseGetRefreshCredentialChallengeResponse parses an HTTP response from a GetRefreshCredentialChallengeWithResponse call
+func ParseGetRefreshCredentialChallengeResponse(rsp *http.Response) (*GetRefreshCredentialChallengeResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PrepareBatchCredentialResponse{
+	response := &GetRefreshCredentialChallengeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []PrepareCredentialResult
+		var dest RefreshCredentialChallenge
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2689,38 +3907,48 @@ func ParsePrepareBatchCredentialResponse(rsp *http.Response) (*PrepareBatchCrede
 	return response, nil
 }
 
-// ParsePushAuthorizationDetailsResponse parses an HTTP response from a PushAuthorizationDetailsWithResponse call
-func ParsePushAuthorizationDetailsResponse(rsp *http.Response) (*PushAuthorizationDetailsResponse, error) {
+// ParsePostRefreshCredentialResponse parses an HTTP response from a PostRefreshCredentialWithResponse call
+func ParsePostRefreshCredentialResponse(rsp *http.Response) (*PostRefreshCredentialResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PushAuthorizationDetailsResponse{
+	response := &PostRefreshCredentialResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseStoreAuthorizationCodeRequestResponse parses an HTTP response from a StoreAuthorizationCodeRequestWithResponse call
-func ParseStoreAuthorizationCodeRequestResponse(rsp *http.Response) (*StoreAuthorizationCodeRequestResponse, error) {
+// ParseInitiateCredentialComposeIssuanceResponse parses an HTTP response from a InitiateCredentialComposeIssuanceWithResponse call
+func ParseInitiateCredentialComposeIssuanceResponse(rsp *http.Response) (*InitiateCredentialComposeIssuanceResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StoreAuthorizationCodeRequestResponse{
+	response := &InitiateCredentialComposeIssuanceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StoreAuthorizationCodeResponse
+		var dest InitiateOIDC4CIComposeRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2731,22 +3959,22 @@ func ParseStoreAuthorizationCodeRequestResponse(rsp *http.Response) (*StoreAutho
 	return response, nil
 }
 
-// ParseValidatePreAuthorizedCodeRequestResponse parses an HTTP response from a ValidatePreAuthorizedCodeRequestWithResponse call
-func ParseValidatePreAuthorizedCodeRequestResponse(rsp *http.Response) (*ValidatePreAuthorizedCodeRequestResponse, error) {
+// ParseInitiateCredentialIssuanceResponse parses an HTTP response from a InitiateCredentialIssuanceWithResponse call
+func ParseInitiateCredentialIssuanceResponse(rsp *http.Response) (*InitiateCredentialIssuanceResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ValidatePreAuthorizedCodeRequestResponse{
+	response := &InitiateCredentialIssuanceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ValidatePreAuthorizedCodeResponse
+		var dest InitiateOIDC4CIResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2757,22 +3985,22 @@ func ParseValidatePreAuthorizedCodeRequestResponse(rsp *http.Response) (*Validat
 	return response, nil
 }
 
-// ParseCredentialIssuanceHistoryResponse parses an HTTP response from a CredentialIssuanceHistoryWithResponse call
-func ParseCredentialIssuanceHistoryResponse(rsp *http.Response) (*CredentialIssuanceHistoryResponse, error) {
+// ParseListIssuanceTransactionsResponse parses an HTTP response from a ListIssuanceTransactionsWithResponse call
+func ParseListIssuanceTransactionsResponse(rsp *http.Response) (*ListIssuanceTransactionsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CredentialIssuanceHistoryResponse{
+	response := &ListIssuanceTransactionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []CredentialIssuanceHistoryData
+		var dest []IssuanceTransaction
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2783,22 +4011,22 @@ func ParseCredentialIssuanceHistoryResponse(rsp *http.Response) (*CredentialIssu
 	return response, nil
 }
 
-// ParsePostIssueCredentialsResponse parses an HTTP response from a PostIssueCredentialsWithResponse call
-func ParsePostIssueCredentialsResponse(rsp *http.Response) (*PostIssueCredentialsResponse, error) {
+// ParseGetIssuanceTransactionResponse parses an HTTP response from a GetIssuanceTransactionWithResponse call
+func ParseGetIssuanceTransactionResponse(rsp *http.Response) (*GetIssuanceTransactionResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostIssueCredentialsResponse{
+	response := &GetIssuanceTransactionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest map[string]interface{}
+		var dest IssuanceTransaction
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2809,22 +4037,22 @@ func ParsePostIssueCredentialsResponse(rsp *http.Response) (*PostIssueCredential
 	return response, nil
 }
 
-// ParseInitiateCredentialComposeIssuanceResponse parses an HTTP response from a InitiateCredentialComposeIssuanceWithResponse call
-func ParseInitiateCredentialComposeIssuanceResponse(rsp *http.Response) (*InitiateCredentialComposeIssuanceResponse, error) {
+// ParseCancelIssuanceTransactionResponse parses an HTTP response from a CancelIssuanceTransactionWithResponse call
+func ParseCancelIssuanceTransactionResponse(rsp *http.Response) (*CancelIssuanceTransactionResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &InitiateCredentialComposeIssuanceResponse{
+	response := &CancelIssuanceTransactionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InitiateOIDC4CIComposeRequest
+		var dest IssuanceTransaction
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2835,22 +4063,22 @@ func ParseInitiateCredentialComposeIssuanceResponse(rsp *http.Response) (*Initia
 	return response, nil
 }
 
-// ParseInitiateCredentialIssuanceResponse parses an HTTP response from a InitiateCredentialIssuanceWithResponse call
-func ParseInitiateCredentialIssuanceResponse(rsp *http.Response) (*InitiateCredentialIssuanceResponse, error) {
+// ParseRotateSigningKeyResponse parses an HTTP response from a RotateSigningKeyWithResponse call
+func ParseRotateSigningKeyResponse(rsp *http.Response) (*RotateSigningKeyResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &InitiateCredentialIssuanceResponse{
+	response := &RotateSigningKeyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InitiateOIDC4CIResponse
+		var dest RotateSigningKeyResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2918,6 +4146,9 @@ type ServerInterface interface {
 	// Updates credential status.
 	// (POST /issuer/credentials/status)
 	PostCredentialsStatus(ctx echo.Context) error
+	// Lists credential status lists of the issuer group.
+	// (GET /issuer/groups/{groupID}/credentials/status-lists)
+	ListCredentialStatusLists(ctx echo.Context, groupID string) error
 	// Retrieves the credential status.
 	// (GET /issuer/groups/{groupID}/credentials/status/{statusID})
 	GetCredentialsStatus(ctx echo.Context, groupID string, statusID string) error
@@ -2948,12 +4179,30 @@ type ServerInterface interface {
 	// Issue credential
 	// (POST /issuer/profiles/{profileID}/{profileVersion}/credentials/issue)
 	PostIssueCredentials(ctx echo.Context, profileID string, profileVersion string) error
+	// Get credential refresh challenge
+	// (GET /issuer/profiles/{profileID}/{profileVersion}/credentials/refresh)
+	GetRefreshCredentialChallenge(ctx echo.Context, profileID string, profileVersion string) error
+	// Refresh credential
+	// (POST /issuer/profiles/{profileID}/{profileVersion}/credentials/refresh)
+	PostRefreshCredential(ctx echo.Context, profileID string, profileVersion string) error
 	// Initiate OIDC Compose Credential Issuance
 	// (POST /issuer/profiles/{profileID}/{profileVersion}/interactions/compose-and-initiate-issuance)
-	InitiateCredentialComposeIssuance(ctx echo.Context, profileID string, profileVersion string) error
+	InitiateCredentialComposeIssuance(ctx echo.Context, profileID string, profileVersion string, params InitiateCredentialComposeIssuanceParams) error
 	// Initiate OIDC Credential Issuance
 	// (POST /issuer/profiles/{profileID}/{profileVersion}/interactions/initiate-oidc)
-	InitiateCredentialIssuance(ctx echo.Context, profileID string, profileVersion string) error
+	InitiateCredentialIssuance(ctx echo.Context, profileID string, profileVersion string, params InitiateCredentialIssuanceParams) error
+	// List open issuance transactions
+	// (GET /issuer/profiles/{profileID}/{profileVersion}/interactions/transactions)
+	ListIssuanceTransactions(ctx echo.Context, profileID string, profileVersion string) error
+	// Get issuance transaction
+	// (GET /issuer/profiles/{profileID}/{profileVersion}/interactions/transactions/{txID})
+	GetIssuanceTransaction(ctx echo.Context, profileID string, profileVersion string, txID string) error
+	// Cancel issuance transaction
+	// (POST /issuer/profiles/{profileID}/{profileVersion}/interactions/transactions/{txID}/cancel)
+	CancelIssuanceTransaction(ctx echo.Context, profileID string, profileVersion string, txID string) error
+	// Rotate signing key
+	// (POST /issuer/profiles/{profileID}/{profileVersion}/keys/rotate)
+	RotateSigningKey(ctx echo.Context, profileID string, profileVersion string) error
 	// Request VCS IDP OIDC Configuration.
 	// (GET /issuer/{profileID}/{profileVersion}/.well-known/openid-credential-issuer)
 	OpenidCredentialIssuerConfig(ctx echo.Context, profileID string, profileVersion string) error
@@ -2976,6 +4225,22 @@ func (w *ServerInterfaceWrapper) PostCredentialsStatus(ctx echo.Context) error {
 	return err
 }
 
+// ListCredentialStatusLists converts echo context to params.
+func (w *ServerInterfaceWrapper) ListCredentialStatusLists(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "groupID" -------------
+	var groupID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "groupID", runtime.ParamLocationPath, ctx.Param("groupID"), &groupID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListCredentialStatusLists(ctx, groupID)
+	return err
+}
+
 // GetCredentialsStatus converts echo context to params.
 func (w *ServerInterfaceWrapper) GetCredentialsStatus(ctx echo.Context) error {
 	var err error
@@ -3103,6 +4368,54 @@ func (w *ServerInterfaceWrapper) PostIssueCredentials(ctx echo.Context) error {
 	return err
 }
 
+// GetRefreshCredentialChallenge converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRefreshCredentialChallenge(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "profileID" -------------
+	var profileID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "profileID", runtime.ParamLocationPath, ctx.Param("profileID"), &profileID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profileID: %s", err))
+	}
+
+	// ------------- Path parameter "profileVersion" -------------
+	var profileVersion string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "profileVersion", runtime.ParamLocationPath, ctx.Param("profileVersion"), &profileVersion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profileVersion: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetRefreshCredentialChallenge(ctx, profileID, profileVersion)
+	return err
+}
+
+// PostRefreshCredential converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRefreshCredential(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "profileID" -------------
+	var profileID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "profileID", runtime.ParamLocationPath, ctx.Param("profileID"), &profileID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profileID: %s", err))
+	}
+
+	// ------------- Path parameter "profileVersion" -------------
+	var profileVersion string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "profileVersion", runtime.ParamLocationPath, ctx.Param("profileVersion"), &profileVersion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profileVersion: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostRefreshCredential(ctx, profileID, profileVersion)
+	return err
+}
+
 // InitiateCredentialComposeIssuance converts echo context to params.
 func (w *ServerInterfaceWrapper) InitiateCredentialComposeIssuance(ctx echo.Context) error {
 	var err error
@@ -3122,8 +4435,55 @@ func (w *ServerInterfaceWrapper) InitiateCredentialComposeIssuance(ctx echo.Cont
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profileVersion: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params InitiateCredentialComposeIssuanceParams
+	// ------------- Optional query parameter "qr_format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "qr_format", ctx.QueryParams(), &params.QrFormat)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter qr_format: %s", err))
+	}
+
+	// ------------- Optional query parameter "qr_error_correction" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "qr_error_correction", ctx.QueryParams(), &params.QrErrorCorrection)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter qr_error_correction: %s", err))
+	}
+
+	// ------------- Optional query parameter "qr_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "qr_size", ctx.QueryParams(), &params.QrSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter qr_size: %s", err))
+	}
+
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", ctx.QueryParams(), &params.Locale)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter locale: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Accept-Language" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept-Language")]; found {
+		var AcceptLanguage AcceptLanguage
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Accept-Language, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Accept-Language", runtime.ParamLocationHeader, valueList[0], &AcceptLanguage)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Accept-Language: %s", err))
+		}
+
+		params.AcceptLanguage = &AcceptLanguage
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.InitiateCredentialComposeIssuance(ctx, profileID, profileVersion)
+	err = w.Handler.InitiateCredentialComposeIssuance(ctx, profileID, profileVersion, params)
 	return err
 }
 
@@ -3146,8 +4506,167 @@ func (w *ServerInterfaceWrapper) InitiateCredentialIssuance(ctx echo.Context) er
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profileVersion: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params InitiateCredentialIssuanceParams
+	// ------------- Optional query parameter "qr_format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "qr_format", ctx.QueryParams(), &params.QrFormat)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter qr_format: %s", err))
+	}
+
+	// ------------- Optional query parameter "qr_error_correction" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "qr_error_correction", ctx.QueryParams(), &params.QrErrorCorrection)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter qr_error_correction: %s", err))
+	}
+
+	// ------------- Optional query parameter "qr_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "qr_size", ctx.QueryParams(), &params.QrSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter qr_size: %s", err))
+	}
+
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", ctx.QueryParams(), &params.Locale)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter locale: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Accept-Language" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept-Language")]; found {
+		var AcceptLanguage AcceptLanguage
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Accept-Language, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Accept-Language", runtime.ParamLocationHeader, valueList[0], &AcceptLanguage)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Accept-Language: %s", err))
+		}
+
+		params.AcceptLanguage = &AcceptLanguage
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.InitiateCredentialIssuance(ctx, profileID, profileVersion, params)
+	return err
+}
+
+// ListIssuanceTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListIssuanceTransactions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "profileID" -------------
+	var profileID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "profileID", runtime.ParamLocationPath, ctx.Param("profileID"), &profileID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profileID: %s", err))
+	}
+
+	// ------------- Path parameter "profileVersion" -------------
+	var profileVersion string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "profileVersion", runtime.ParamLocationPath, ctx.Param("profileVersion"), &profileVersion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profileVersion: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListIssuanceTransactions(ctx, profileID, profileVersion)
+	return err
+}
+
+// GetIssuanceTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) GetIssuanceTransaction(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "profileID" -------------
+	var profileID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "profileID", runtime.ParamLocationPath, ctx.Param("profileID"), &profileID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profileID: %s", err))
+	}
+
+	// ------------- Path parameter "profileVersion" -------------
+	var profileVersion string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "profileVersion", runtime.ParamLocationPath, ctx.Param("profileVersion"), &profileVersion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profileVersion: %s", err))
+	}
+
+	// ------------- Path parameter "txID" -------------
+	var txID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "txID", runtime.ParamLocationPath, ctx.Param("txID"), &txID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetIssuanceTransaction(ctx, profileID, profileVersion, txID)
+	return err
+}
+
+// CancelIssuanceTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelIssuanceTransaction(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "profileID" -------------
+	var profileID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "profileID", runtime.ParamLocationPath, ctx.Param("profileID"), &profileID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profileID: %s", err))
+	}
+
+	// ------------- Path parameter "profileVersion" -------------
+	var profileVersion string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "profileVersion", runtime.ParamLocationPath, ctx.Param("profileVersion"), &profileVersion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profileVersion: %s", err))
+	}
+
+	// ------------- Path parameter "txID" -------------
+	var txID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "txID", runtime.ParamLocationPath, ctx.Param("txID"), &txID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CancelIssuanceTransaction(ctx, profileID, profileVersion, txID)
+	return err
+}
+
+// RotateSigningKey converts echo context to params.
+func (w *ServerInterfaceWrapper) RotateSigningKey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "profileID" -------------
+	var profileID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "profileID", runtime.ParamLocationPath, ctx.Param("profileID"), &profileID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profileID: %s", err))
+	}
+
+	// ------------- Path parameter "profileVersion" -------------
+	var profileVersion string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "profileVersion", runtime.ParamLocationPath, ctx.Param("profileVersion"), &profileVersion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profileVersion: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.InitiateCredentialIssuance(ctx, profileID, profileVersion)
+	err = w.Handler.RotateSigningKey(ctx, profileID, profileVersion)
 	return err
 }
 
@@ -3228,6 +4747,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.POST(baseURL+"/issuer/credentials/status", wrapper.PostCredentialsStatus)
+	router.GET(baseURL+"/issuer/groups/:groupID/credentials/status-lists", wrapper.ListCredentialStatusLists)
 	router.GET(baseURL+"/issuer/groups/:groupID/credentials/status/:statusID", wrapper.GetCredentialsStatus)
 	router.POST(baseURL+"/issuer/interactions/exchange-authorization-code", wrapper.ExchangeAuthorizationCodeRequest)
 	router.POST(baseURL+"/issuer/interactions/prepare-claim-data-authz-request", wrapper.PrepareAuthorizationRequest)
@@ -3238,8 +4758,14 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/issuer/interactions/validate-pre-authorized-code", wrapper.ValidatePreAuthorizedCodeRequest)
 	router.GET(baseURL+"/issuer/profiles/:profileID/issued-credentials", wrapper.CredentialIssuanceHistory)
 	router.POST(baseURL+"/issuer/profiles/:profileID/:profileVersion/credentials/issue", wrapper.PostIssueCredentials)
+	router.GET(baseURL+"/issuer/profiles/:profileID/:profileVersion/credentials/refresh", wrapper.GetRefreshCredentialChallenge)
+	router.POST(baseURL+"/issuer/profiles/:profileID/:profileVersion/credentials/refresh", wrapper.PostRefreshCredential)
 	router.POST(baseURL+"/issuer/profiles/:profileID/:profileVersion/interactions/compose-and-initiate-issuance", wrapper.InitiateCredentialComposeIssuance)
 	router.POST(baseURL+"/issuer/profiles/:profileID/:profileVersion/interactions/initiate-oidc", wrapper.InitiateCredentialIssuance)
+	router.GET(baseURL+"/issuer/profiles/:profileID/:profileVersion/interactions/transactions", wrapper.ListIssuanceTransactions)
+	router.GET(baseURL+"/issuer/profiles/:profileID/:profileVersion/interactions/transactions/:txID", wrapper.GetIssuanceTransaction)
+	router.POST(baseURL+"/issuer/profiles/:profileID/:profileVersion/interactions/transactions/:txID/cancel", wrapper.CancelIssuanceTransaction)
+	router.POST(baseURL+"/issuer/profiles/:profileID/:profileVersion/keys/rotate", wrapper.RotateSigningKey)
 	router.GET(baseURL+"/issuer/:profileID/:profileVersion/.well-known/openid-credential-issuer", wrapper.OpenidCredentialIssuerConfig)
 	router.GET(baseURL+"/oidc/idp/:profileID/:profileVersion/.well-known/openid-credential-issuer", wrapper.OpenidCredentialIssuerConfigV2)
 
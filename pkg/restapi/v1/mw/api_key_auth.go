@@ -13,6 +13,8 @@ import (
 	"strings"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/trustbloc/vcs/pkg/restapi/v1/didweb"
 )
 
 //nolint:gosec
@@ -23,6 +25,8 @@ const (
 	statusCheckPath            = "/credentials/status/"
 	requestObjectPath          = "/request-object/"
 	checkAuthorizationResponse = "/verifier/interactions/authorization-response"
+	verifierInteractions       = "/verifier/interactions/"
+	interactionStatusEvents    = "/status/events"
 	oidcAuthorize              = "/oidc/authorize"
 	oidcRedirect               = "/oidc/redirect"
 	oidcPresent                = "/oidc/present"
@@ -32,6 +36,7 @@ const (
 	oidcBatchCredential        = "/oidc/batch_credential"
 	oidcCredentialWellKnown    = "/.well-known/openid-credential-issuer"
 	issuedCredentialsHistory   = "/issued-credentials"
+	credentialsRefresh         = "/credentials/refresh"
 	version                    = "/version"
 	versionSystem              = "/version/system"
 	profiler                   = "/debug/pprof"
@@ -65,6 +70,12 @@ func APIKeyAuth(apiKey string) echo.MiddlewareFunc { //nolint:gocognit
 				return next(c)
 			}
 
+			// Status stream is authorized with the stream token issued for the interaction.
+			if strings.HasPrefix(currentPath, verifierInteractions) &&
+				strings.HasSuffix(currentPath, interactionStatusEvents) {
+				return next(c)
+			}
+
 			if currentPath == version || currentPath == versionSystem {
 				return next(c)
 			}
@@ -73,6 +84,11 @@ func APIKeyAuth(apiKey string) echo.MiddlewareFunc { //nolint:gocognit
 				return next(c)
 			}
 
+			// Route path is matched, as did.json documents of DIDs with path are served by parametrized routes.
+			if didweb.IsRoute(c.Path()) {
+				return next(c)
+			}
+
 			if strings.HasPrefix(currentPath, oidcAuthorize) ||
 				strings.HasPrefix(currentPath, oidcRedirect) ||
 				strings.HasPrefix(currentPath, oidcPresent) ||
@@ -81,6 +97,7 @@ func APIKeyAuth(apiKey string) echo.MiddlewareFunc { //nolint:gocognit
 				strings.HasPrefix(currentPath, oidcCredential) ||
 				strings.HasPrefix(currentPath, oidcBatchCredential) ||
 				strings.HasSuffix(currentPath, issuedCredentialsHistory) ||
+				strings.HasSuffix(currentPath, credentialsRefresh) ||
 				strings.HasSuffix(currentPath, oidcCredentialWellKnown) ||
 				(strings.HasPrefix(currentPath, "/oidc/") && strings.HasSuffix(currentPath, "/register")) {
 				return next(c)
@@ -101,6 +101,90 @@ func TestApiKeyAuth(t *testing.T) {
 		require.True(t, handlerCalled)
 	})
 
+	t.Run("skip did:web endpoints", func(t *testing.T) {
+		for path, route := range map[string]string{
+			"/.well-known/did.json":               "/.well-known/did.json",
+			"/issuer/1/did.json":                  "/:p1/:p2/did.json",
+			"/.well-known/did-configuration.json": "/.well-known/did-configuration.json",
+		} {
+			handlerCalled := false
+			handler := func(c echo.Context) error {
+				handlerCalled = true
+				return c.String(http.StatusOK, "test")
+			}
+
+			middlewareChain := mw.APIKeyAuth("test-api-key")(handler)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath(route)
+
+			err := middlewareChain(c)
+
+			require.NoError(t, err)
+			require.True(t, handlerCalled)
+		}
+	})
+
+	t.Run("did.json path of other endpoint", func(t *testing.T) {
+		handlerCalled := false
+		handler := func(c echo.Context) error {
+			handlerCalled = true
+			return c.String(http.StatusOK, "test")
+		}
+
+		middlewareChain := mw.APIKeyAuth("test-api-key")(handler)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/verifier/receipts/did.json", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/verifier/receipts/:receiptID")
+
+		err := middlewareChain(c)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Unauthorized")
+		require.False(t, handlerCalled)
+	})
+
+	t.Run("skip interaction status events endpoint", func(t *testing.T) {
+		handlerCalled := false
+		handler := func(c echo.Context) error {
+			handlerCalled = true
+			return c.String(http.StatusOK, "test")
+		}
+
+		middlewareChain := mw.APIKeyAuth("test-api-key")(handler)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/verifier/interactions/txID/status/events?token=abc", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := middlewareChain(c)
+
+		require.NoError(t, err)
+		require.True(t, handlerCalled)
+	})
+
+	t.Run("interaction status stream token endpoint requires api key", func(t *testing.T) {
+		handler := func(c echo.Context) error {
+			return c.String(http.StatusOK, "test")
+		}
+
+		middlewareChain := mw.APIKeyAuth("test-api-key")(handler)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/verifier/interactions/txID/status/stream-token", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		require.ErrorContains(t, middlewareChain(c), "Unauthorized")
+	})
+
 	t.Run("skip version endpoint", func(t *testing.T) {
 		handlerCalled := false
 		handler := func(c echo.Context) error {
@@ -180,4 +264,45 @@ func TestApiKeyAuth(t *testing.T) {
 		require.NoError(t, err)
 		require.True(t, handlerCalled)
 	})
+
+	t.Run("skip credential refresh endpoint", func(t *testing.T) {
+		handlerCalled := false
+		handler := func(c echo.Context) error {
+			handlerCalled = true
+			return c.String(http.StatusOK, "test")
+		}
+
+		middlewareChain := mw.APIKeyAuth("test-api-key")(handler)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/issuer/profiles/profile/v1.0/credentials/refresh", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := middlewareChain(c)
+
+		require.NoError(t, err)
+		require.True(t, handlerCalled)
+	})
+
+	t.Run("status lists endpoint requires API key", func(t *testing.T) {
+		handlerCalled := false
+		handler := func(c echo.Context) error {
+			handlerCalled = true
+			return c.String(http.StatusOK, "test")
+		}
+
+		middlewareChain := mw.APIKeyAuth("test-api-key")(handler)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/issuer/groups/groupID/credentials/status-lists", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := middlewareChain(c)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Unauthorized")
+		require.False(t, handlerCalled)
+	})
 }
@@ -44,6 +44,8 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/oauth2"
 
+	"github.com/trustbloc/vcs/pkg/doc/vc/dataintegrity/bbsplus2023"
+	"github.com/trustbloc/vcs/pkg/doc/vc/dataintegrity/eddsa2022"
 	"github.com/trustbloc/vcs/pkg/observability/tracing/attributeutil"
 	profileapi "github.com/trustbloc/vcs/pkg/profile"
 	"github.com/trustbloc/vcs/pkg/restapi/resterr"
@@ -93,6 +95,8 @@ type StateStore interface {
 	) error
 
 	GetAuthorizeState(ctx context.Context, opState string) (*oidc4ci.AuthorizeState, error)
+
+	DeleteAuthorizeState(ctx context.Context, opState string) error
 }
 
 // HTTPClient defines HTTP client interface.
@@ -800,13 +804,19 @@ func (c *Controller) HandleProof(
 }
 
 func (c *Controller) getDataIntegrityVerifier() (*dataintegrity.Verifier, error) {
-	verifySuite := ecdsa2019.NewVerifierInitializer(&ecdsa2019.VerifierInitializerOptions{
-		LDDocumentLoader: c.documentLoader,
-	})
-
 	verifier, err := dataintegrity.NewVerifier(&dataintegrity.Options{
 		DIDResolver: c.vdr,
-	}, verifySuite)
+	},
+		ecdsa2019.NewVerifierInitializer(&ecdsa2019.VerifierInitializerOptions{
+			LDDocumentLoader: c.documentLoader,
+		}),
+		eddsa2022.NewVerifierInitializer(&eddsa2022.VerifierInitializerOptions{
+			LDDocumentLoader: c.documentLoader,
+		}),
+		bbsplus2023.NewVerifierInitializer(&bbsplus2023.VerifierInitializerOptions{
+			LDDocumentLoader: c.documentLoader,
+		}),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("new verifier: %w", err)
 	}
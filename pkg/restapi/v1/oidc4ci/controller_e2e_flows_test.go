@@ -552,6 +552,18 @@ func (s *memoryStateStore) SaveAuthorizeState(
 
 	return nil
 }
+
+func (s *memoryStateStore) DeleteAuthorizeState(
+	_ context.Context,
+	opState string,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.kv, opState)
+
+	return nil
+}
 func makeMockDIDResolution(id string, vm *did.VerificationMethod, vr did.VerificationRelationship) *did.DocResolution {
 	ver := []did.Verification{{
 		VerificationMethod: *vm,
@@ -11,6 +11,7 @@ package oidc4vp
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -84,7 +85,18 @@ func (c *Controller) PresentAuthorizationResponse(e echo.Context) error {
 		return fmt.Errorf("%s", respBytes)
 	}
 
-	return nil
+	if len(respBytes) == 0 {
+		return nil
+	}
+
+	// Same-device flow, redirect URI is returned to the wallet.
+	var redirect AuthorizationResponseRedirect
+
+	if err = json.Unmarshal(respBytes, &redirect); err != nil {
+		return fmt.Errorf("decode authorization response redirect: %w", err)
+	}
+
+	return e.JSON(http.StatusOK, redirect)
 }
 
 // closeResponseBody closes the response body.
@@ -41,6 +41,28 @@ func TestController_OidcPresent(t *testing.T) {
 				require.Equal(t, http.StatusOK, rec.Code)
 			},
 		},
+		{
+			name: "success with redirect uri",
+			setup: func() {
+				mockHTTPClient.EXPECT().Do(gomock.Any()).Return(&http.Response{StatusCode: http.StatusOK,
+					Body: io.NopCloser(bytes.NewBufferString(`{"redirect_uri":"https://verifier.example.com/cb?response_code=code"}`))}, nil)
+			},
+			check: func(t *testing.T, rec *httptest.ResponseRecorder, err error) {
+				require.NoError(t, err)
+				require.Equal(t, http.StatusOK, rec.Code)
+				require.JSONEq(t, `{"redirect_uri":"https://verifier.example.com/cb?response_code=code"}`, rec.Body.String())
+			},
+		},
+		{
+			name: "invalid redirect response",
+			setup: func() {
+				mockHTTPClient.EXPECT().Do(gomock.Any()).Return(&http.Response{StatusCode: http.StatusOK,
+					Body: io.NopCloser(bytes.NewBufferString("invalid"))}, nil)
+			},
+			check: func(t *testing.T, rec *httptest.ResponseRecorder, err error) {
+				require.ErrorContains(t, err, "decode authorization response redirect")
+			},
+		},
 		{
 			name: "fail to present",
 			setup: func() {
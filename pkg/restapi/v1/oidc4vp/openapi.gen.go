@@ -7,6 +7,12 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+// Returned to the wallet in the same-device flow.
+type AuthorizationResponseRedirect struct {
+	// URI with the one-time response_code the wallet redirects the user to.
+	RedirectUri string `json:"redirect_uri"`
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Used to submit authorization response to verifier through VCS
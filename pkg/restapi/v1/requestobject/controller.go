@@ -0,0 +1,118 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package requestobject
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/trustbloc/logutil-go/pkg/log"
+	"github.com/trustbloc/vc-go/jwt"
+
+	"github.com/trustbloc/vcs/pkg/service/oidc4vp"
+	"github.com/trustbloc/vcs/pkg/service/requestobject"
+)
+
+//go:generate mockgen -destination controller_mocks_test.go -package requestobject_test -source=controller.go
+
+const requestObjectPath = "/request-object/:uuid"
+
+var logger = log.New("request-object-rest")
+
+type requestObjectStoreService interface {
+	Get(ctx context.Context, id string) (*requestobject.RequestObject, error)
+}
+
+type oidc4vpService interface {
+	RequestObjectFetched(ctx context.Context, txID oidc4vp.TxID) error
+}
+
+type Config struct {
+	RequestObjectStoreService requestObjectStoreService
+	// OIDC4VPService is notified when the wallet fetches the request object. Optional.
+	OIDC4VPService oidc4vpService
+}
+
+// Controller serves request objects of oidc4vp interactions published to the request object store.
+// Request objects published to the external store (e.g. S3 bucket) are served by the store itself,
+// so fetching of them is not reported to the OIDC4VP service.
+type Controller struct {
+	requestObjectStoreService requestObjectStoreService
+	oidc4vpService            oidc4vpService
+}
+
+type router interface {
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+func NewController(
+	config *Config,
+	router router,
+) *Controller {
+	c := &Controller{
+		requestObjectStoreService: config.RequestObjectStoreService,
+		oidc4vpService:            config.OIDC4VPService,
+	}
+
+	router.GET(requestObjectPath, func(ctx echo.Context) error {
+		return c.RequestObjectByUuid(ctx, ctx.Param("uuid"))
+	})
+
+	return c
+}
+
+// RequestObjectByUuid Receive request object by uuid.
+// GET /request-object/{uuid}.
+func (c *Controller) RequestObjectByUuid(ctx echo.Context, uuid string) error { //nolint:stylecheck,revive
+	logger.Infoc(ctx.Request().Context(), "RequestObjectByUuid begin")
+	record, err := c.requestObjectStoreService.Get(ctx.Request().Context(), uuid)
+
+	if errors.Is(err, requestobject.ErrDataNotFound) {
+		ctx.Response().Status = http.StatusNotFound
+	}
+
+	if err != nil {
+		return err
+	}
+
+	c.notifyRequestObjectFetched(ctx.Request().Context(), record.Content)
+
+	logger.Infoc(ctx.Request().Context(), "RequestObjectByUuid end")
+	return ctx.String(http.StatusOK, record.Content)
+}
+
+// notifyRequestObjectFetched notifies OIDC4VP service that the request object of the transaction
+// was fetched. Transaction ID is taken from the state claim of the request object.
+func (c *Controller) notifyRequestObjectFetched(ctx context.Context, content string) {
+	if c.oidc4vpService == nil {
+		return
+	}
+
+	token, _, err := jwt.Parse(content)
+	if err != nil {
+		logger.Warnc(ctx, "Failed to parse request object", log.WithError(err))
+
+		return
+	}
+
+	var claims struct {
+		State string `json:"state"`
+	}
+
+	if err = token.DecodeClaims(&claims); err != nil || claims.State == "" {
+		logger.Warnc(ctx, "Failed to get state from request object", log.WithError(err))
+
+		return
+	}
+
+	if err = c.oidc4vpService.RequestObjectFetched(ctx, oidc4vp.TxID(claims.State)); err != nil {
+		logger.Warnc(ctx, "Failed to notify that request object was fetched",
+			log.WithTxID(claims.State), log.WithError(err))
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package requestobject_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	requestobjectapi "github.com/trustbloc/vcs/pkg/restapi/v1/requestobject"
+	"github.com/trustbloc/vcs/pkg/service/oidc4vp"
+	"github.com/trustbloc/vcs/pkg/service/requestobject"
+)
+
+func TestController(t *testing.T) {
+	route := NewMockrouter(gomock.NewController(t))
+
+	route.EXPECT().GET("/request-object/:uuid", gomock.Any()).Return(nil)
+	assert.NotNil(t, requestobjectapi.NewController(&requestobjectapi.Config{}, route))
+}
+
+func TestRequestObjectByUUID(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		route := NewMockrouter(gomock.NewController(t))
+		route.EXPECT().GET(gomock.Any(), gomock.Any()).AnyTimes()
+		store := NewMockrequestObjectStoreService(gomock.NewController(t))
+
+		c := requestobjectapi.NewController(&requestobjectapi.Config{
+			RequestObjectStoreService: store,
+		}, route)
+
+		store.EXPECT().Get(gomock.Any(), "123").Return(&requestobject.RequestObject{}, nil)
+		assert.NoError(t, c.RequestObjectByUuid(echoContext(), "123"))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		route := NewMockrouter(gomock.NewController(t))
+		route.EXPECT().GET(gomock.Any(), gomock.Any()).AnyTimes()
+		store := NewMockrequestObjectStoreService(gomock.NewController(t))
+
+		c := requestobjectapi.NewController(&requestobjectapi.Config{
+			RequestObjectStoreService: store,
+		}, route)
+
+		ct := echoContext()
+		store.EXPECT().Get(gomock.Any(), "123").Return(nil, requestobject.ErrDataNotFound)
+		assert.ErrorContains(t, c.RequestObjectByUuid(ct, "123"), "data not found")
+
+		assert.Equal(t, http.StatusNotFound, ct.Response().Status)
+	})
+}
+
+func TestRequestObjectByUUIDNotifiesOIDC4VPService(t *testing.T) {
+	requestObject := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"ES256","typ":"JWT"}`)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(`{"state":"tx-id"}`)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte("signature"))
+
+	t.Run("notified", func(t *testing.T) {
+		route := NewMockrouter(gomock.NewController(t))
+		route.EXPECT().GET(gomock.Any(), gomock.Any()).AnyTimes()
+		store := NewMockrequestObjectStoreService(gomock.NewController(t))
+		svc := NewMockoidc4vpService(gomock.NewController(t))
+
+		c := requestobjectapi.NewController(&requestobjectapi.Config{
+			RequestObjectStoreService: store,
+			OIDC4VPService:            svc,
+		}, route)
+
+		store.EXPECT().Get(gomock.Any(), "123").Return(&requestobject.RequestObject{Content: requestObject}, nil)
+		svc.EXPECT().RequestObjectFetched(gomock.Any(), oidc4vp.TxID("tx-id")).Return(nil)
+
+		assert.NoError(t, c.RequestObjectByUuid(echoContext(), "123"))
+	})
+
+	t.Run("notification error is ignored", func(t *testing.T) {
+		route := NewMockrouter(gomock.NewController(t))
+		route.EXPECT().GET(gomock.Any(), gomock.Any()).AnyTimes()
+		store := NewMockrequestObjectStoreService(gomock.NewController(t))
+		svc := NewMockoidc4vpService(gomock.NewController(t))
+
+		c := requestobjectapi.NewController(&requestobjectapi.Config{
+			RequestObjectStoreService: store,
+			OIDC4VPService:            svc,
+		}, route)
+
+		store.EXPECT().Get(gomock.Any(), "123").Return(&requestobject.RequestObject{Content: requestObject}, nil)
+		svc.EXPECT().RequestObjectFetched(gomock.Any(), oidc4vp.TxID("tx-id")).Return(errors.New("some error"))
+
+		assert.NoError(t, c.RequestObjectByUuid(echoContext(), "123"))
+	})
+
+	t.Run("invalid request object", func(t *testing.T) {
+		route := NewMockrouter(gomock.NewController(t))
+		route.EXPECT().GET(gomock.Any(), gomock.Any()).AnyTimes()
+		store := NewMockrequestObjectStoreService(gomock.NewController(t))
+		svc := NewMockoidc4vpService(gomock.NewController(t))
+
+		c := requestobjectapi.NewController(&requestobjectapi.Config{
+			RequestObjectStoreService: store,
+			OIDC4VPService:            svc,
+		}, route)
+
+		store.EXPECT().Get(gomock.Any(), "123").Return(&requestobject.RequestObject{Content: "invalid"}, nil)
+
+		assert.NoError(t, c.RequestObjectByUuid(echoContext(), "123"))
+	})
+}
+
+func echoContext() echo.Context {
+	e := echo.New()
+
+	var body io.Reader = http.NoBody
+
+	req := httptest.NewRequest(http.MethodPost, "/", body)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec)
+}
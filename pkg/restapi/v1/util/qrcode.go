@@ -0,0 +1,48 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package util
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/trustbloc/vcs/pkg/qrcode"
+	"github.com/trustbloc/vcs/pkg/restapi/resterr"
+)
+
+// NewQRCodeOptions returns QR code options from the query parameters of the request.
+// Returns nil if the QR code is not requested, i.e. the format is not set.
+func NewQRCodeOptions(format, errorCorrection *string, size *int) (*qrcode.Options, error) {
+	if lo.FromPtr(format) == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	opts := &qrcode.Options{
+		Format:          qrcode.Format(*format),
+		ErrorCorrection: qrcode.ErrorCorrection(lo.FromPtr(errorCorrection)),
+		Size:            lo.FromPtr(size),
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, resterr.NewValidationError(resterr.InvalidValue, "qr_code", err)
+	}
+
+	return opts, nil
+}
+
+// QRCodeDataURI renders the content as QR code data URI. Returns nil if opts is nil.
+func QRCodeDataURI(content string, opts *qrcode.Options) (*string, error) {
+	if opts == nil {
+		return nil, nil
+	}
+
+	uri, err := qrcode.DataURI(content, opts)
+	if err != nil {
+		return nil, resterr.NewSystemError(resterr.QRCodeComponent, "encode", err)
+	}
+
+	return &uri, nil
+}
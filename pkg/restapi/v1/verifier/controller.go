@@ -5,12 +5,13 @@ SPDX-License-Identifier: Apache-2.0
 */
 
 //go:generate oapi-codegen --config=openapi.cfg.yaml ../../../../docs/v1/openapi.yaml
-//go:generate mockgen -destination controller_mocks_test.go -self_package mocks -package verifier -source=controller.go -mock_names profileService=MockProfileService,verifyCredentialSvc=MockVerifyCredentialService,kmsRegistry=MockKMSRegistry,oidc4VPService=MockOIDC4VPService
+//go:generate mockgen -destination controller_mocks_test.go -self_package mocks -package verifier -source=controller.go -mock_names profileService=MockProfileService,verifyCredentialSvc=MockVerifyCredentialService,kmsRegistry=MockKMSRegistry,oidc4VPService=MockOIDC4VPService,receiptService=MockReceiptService,presentationArchive=MockPresentationArchive,statusStreamTokenStore=MockStatusStreamTokenStore
 
 package verifier
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -19,6 +20,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/piprate/json-gold/ld"
 	"github.com/samber/lo"
@@ -46,7 +48,10 @@ import (
 	profileapi "github.com/trustbloc/vcs/pkg/profile"
 	"github.com/trustbloc/vcs/pkg/restapi/resterr"
 	"github.com/trustbloc/vcs/pkg/restapi/v1/util"
+	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
 	"github.com/trustbloc/vcs/pkg/service/oidc4vp"
+	"github.com/trustbloc/vcs/pkg/service/presentationarchive"
+	"github.com/trustbloc/vcs/pkg/service/verificationreceipt"
 	"github.com/trustbloc/vcs/pkg/service/verifycredential"
 	"github.com/trustbloc/vcs/pkg/service/verifypresentation"
 )
@@ -119,6 +124,24 @@ type eventService interface {
 	Publish(ctx context.Context, topic string, messages ...*spi.Event) error
 }
 
+type receiptService interface {
+	Issue(ctx context.Context, profile *profileapi.Verifier, receipt *verificationreceipt.Receipt) (string, error)
+	Get(ctx context.Context, id string) (*verificationreceipt.Record, error)
+}
+
+type presentationArchive interface {
+	GetRecord(ctx context.Context, txID string) (*presentationarchive.Record, error)
+	GetPresentation(
+		ctx context.Context,
+		record *presentationarchive.Record,
+	) (*presentationarchive.ArchivedPresentation, error)
+}
+
+type statusStreamTokenStore interface {
+	Create(ctx context.Context, token *oidc4vp.StatusStreamToken) error
+	Get(ctx context.Context, id string) (*oidc4vp.StatusStreamToken, error)
+}
+
 type Config struct {
 	VerifyCredentialSvc   verifyCredentialSvc
 	VerifyPresentationSvc verifyPresentationSvc
@@ -132,6 +155,16 @@ type Config struct {
 	Tracer                trace.Tracer
 	EventSvc              eventService
 	EventTopic            string
+	ReceiptSvc            receiptService
+	PresentationArchive   presentationArchive
+	// StatusPollInterval is an interval of interaction status checks for the status stream. Default is 1s.
+	StatusPollInterval time.Duration
+	// StatusStreamTimeout is a maximum duration of the interaction status stream. Default is 5m.
+	StatusStreamTimeout time.Duration
+	// StatusStreamTokenStore stores tokens, which authorize subscription to the interaction status stream.
+	StatusStreamTokenStore statusStreamTokenStore
+	// StatusStreamTokenTTL is a time during which the stream token can be used to open the stream. Default is 1m.
+	StatusStreamTokenTTL time.Duration
 }
 
 type metricsProvider interface {
@@ -151,6 +184,12 @@ type Controller struct {
 	tracer                trace.Tracer
 	eventSvc              eventService
 	eventTopic            string
+	receiptSvc            receiptService
+	presentationArchive   presentationArchive
+	statusPollInterval    time.Duration
+	statusStreamTimeout   time.Duration
+	streamTokenStore      statusStreamTokenStore
+	streamTokenTTL        time.Duration
 }
 
 // NewController creates a new controller for Verifier Profile Management API.
@@ -177,6 +216,14 @@ func NewController(config *Config) *Controller {
 		tracer:                config.Tracer,
 		eventSvc:              config.EventSvc,
 		eventTopic:            config.EventTopic,
+		receiptSvc:            config.ReceiptSvc,
+		presentationArchive:   config.PresentationArchive,
+		statusPollInterval:    lo.Ternary(config.StatusPollInterval > 0, config.StatusPollInterval, defaultStatusPollInterval),
+		statusStreamTimeout: lo.Ternary(config.StatusStreamTimeout > 0, config.StatusStreamTimeout,
+			defaultStatusStreamTimeout),
+		streamTokenStore: config.StatusStreamTokenStore,
+		streamTokenTTL: lo.Ternary(config.StatusStreamTokenTTL > 0, config.StatusStreamTokenTTL,
+			defaultStatusStreamTokenTTL),
 	}
 }
 
@@ -236,14 +283,36 @@ func (c *Controller) verifyCredential(
 		return nil, resterr.NewValidationError(resterr.InvalidValue, "credential", err)
 	}
 
-	verRes, err := c.verifyCredentialSvc.VerifyCredential(ctx, credential,
+	statusLists := &credentialstatus.StatusListRecorder{}
+
+	verifyCtx := ctx
+	if profile.VerificationReceipt != nil {
+		verifyCtx = credentialstatus.WithStatusListRecorder(ctx, statusLists)
+	}
+
+	verRes, err := c.verifyCredentialSvc.VerifyCredential(verifyCtx, credential,
 		getVerifyCredentialOptions(body.Options), profile)
 	if err != nil {
 		return nil, resterr.NewSystemError(resterr.VerifierVerifyCredentialSvcComponent, "VerifyCredential", err)
 	}
 
+	resp := mapVerifyCredentialChecks(verRes)
+
+	if profile.VerificationReceipt != nil {
+		receipt, receiptErr := verificationreceipt.NewCredentialReceipt(uuid.NewString(), profile, credential, verRes,
+			statusLists.Versions())
+		if receiptErr != nil {
+			return nil, resterr.NewSystemError(resterr.VerifierReceiptSvcComponent, "create-receipt", receiptErr)
+		}
+
+		resp.ReceiptId, resp.Receipt, err = c.issueReceipt(ctx, profile, receipt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	logger.Debugc(ctx, "PostVerifyCredentials success")
-	return mapVerifyCredentialChecks(verRes), nil
+	return resp, nil
 }
 
 // PostVerifyPresentation Verify presentation.
@@ -291,19 +360,91 @@ func (c *Controller) verifyPresentation(ctx context.Context, body *VerifyPresent
 		return nil, resterr.NewValidationError(resterr.InvalidValue, "presentation", err)
 	}
 
-	verRes, _, err := c.verifyPresentationSvc.VerifyPresentation(ctx, presentation,
+	statusLists := &credentialstatus.StatusListRecorder{}
+
+	verifyCtx := ctx
+	if profile.VerificationReceipt != nil {
+		verifyCtx = credentialstatus.WithStatusListRecorder(ctx, statusLists)
+	}
+
+	verRes, _, err := c.verifyPresentationSvc.VerifyPresentation(verifyCtx, presentation,
 		getVerifyPresentationOptions(body.Options), profile)
 	if err != nil {
 		return nil, resterr.NewSystemError(resterr.VerifierVerifyCredentialSvcComponent, "VerifyCredential", err)
 	}
 
+	resp := mapVerifyPresentationChecks(verRes)
+
+	if profile.VerificationReceipt != nil {
+		receipt, receiptErr := verificationreceipt.NewPresentationReceipt(uuid.NewString(), profile,
+			[]*verifiable.Presentation{presentation}, verRes, statusLists.Versions())
+		if receiptErr != nil {
+			return nil, resterr.NewSystemError(resterr.VerifierReceiptSvcComponent, "create-receipt", receiptErr)
+		}
+
+		resp.ReceiptId, resp.Receipt, err = c.issueReceipt(ctx, profile, receipt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	logger.Debugc(ctx, "PostVerifyPresentation success")
-	return mapVerifyPresentationChecks(verRes), nil
+	return resp, nil
+}
+
+func (c *Controller) issueReceipt(
+	ctx context.Context,
+	profile *profileapi.Verifier,
+	receipt *verificationreceipt.Receipt,
+) (*string, *string, error) {
+	signed, err := c.receiptSvc.Issue(ctx, profile, receipt)
+	if err != nil {
+		return nil, nil, resterr.NewSystemError(resterr.VerifierReceiptSvcComponent, "issue-receipt", err)
+	}
+
+	return &receipt.ID, &signed, nil
+}
+
+// GetVerificationReceipt returns the signed receipt of credential or presentation verification.
+// GET /verifier/receipts/{receiptID}.
+func (c *Controller) GetVerificationReceipt(e echo.Context, receiptID string) error {
+	ctx, span := c.tracer.Start(e.Request().Context(), "GetVerificationReceipt")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("receipt_id", receiptID))
+
+	tenantID, err := util.GetTenantIDFromRequest(e)
+	if err != nil {
+		return err
+	}
+
+	record, err := c.receiptSvc.Get(ctx, receiptID)
+	if err != nil {
+		if errors.Is(err, verificationreceipt.ErrDataNotFound) {
+			return resterr.NewCustomError(resterr.DataNotFound,
+				fmt.Errorf("verification receipt '%s' not found", receiptID))
+		}
+
+		return resterr.NewSystemError(resterr.VerifierReceiptSvcComponent, "get-receipt", err)
+	}
+
+	if _, err = c.accessProfile(record.ProfileID, record.ProfileVersion, tenantID); err != nil {
+		return err
+	}
+
+	return util.WriteOutput(e)(&VerificationReceiptResponse{
+		Id:      record.ID,
+		Receipt: record.Receipt,
+	}, nil)
 }
 
 // InitiateOidcInteraction initiates OpenID presentation flow through VCS.
 // POST /verifier/profiles/{profileID}/{profileVersion}/interactions/initiate-oidc.
-func (c *Controller) InitiateOidcInteraction(e echo.Context, profileID, profileVersion string) error {
+func (c *Controller) InitiateOidcInteraction(
+	e echo.Context,
+	profileID, profileVersion string,
+	params InitiateOidcInteractionParams,
+) error {
 	logger.Debugc(e.Request().Context(), "InitiateOidcInteraction begin")
 
 	ctx, span := c.tracer.Start(e.Request().Context(), "InitiateOidcInteraction")
@@ -327,11 +468,20 @@ func (c *Controller) InitiateOidcInteraction(e echo.Context, profileID, profileV
 
 	span.SetAttributes(attributeutil.JSON("initiate_oidc_request", body))
 
+	qrOpts, err := util.NewQRCodeOptions((*string)(params.QrFormat), (*string)(params.QrErrorCorrection), params.QrSize)
+	if err != nil {
+		return err
+	}
+
 	resp, err := c.initiateOidcInteraction(ctx, &body, profile)
 	if err != nil {
 		return err
 	}
 
+	if resp.QrCode, err = util.QRCodeDataURI(resp.AuthorizationRequest, qrOpts); err != nil {
+		return err
+	}
+
 	return util.WriteOutput(e)(resp, nil)
 }
 
@@ -345,9 +495,9 @@ func (c *Controller) initiateOidcInteraction(
 			errors.New("OIDC not configured"))
 	}
 
-	pd, err := findPresentationDefinition(profile, lo.FromPtr(data.PresentationDefinitionId))
+	pd, err := resolvePresentationDefinition(data, profile)
 	if err != nil {
-		return nil, resterr.NewValidationError(resterr.InvalidValue, "presentationDefinitionID", err)
+		return nil, err
 	}
 
 	logger.Debugc(ctx, "InitiateOidcInteraction pd find", logfields.WithPresDefID(pd.ID))
@@ -361,8 +511,15 @@ func (c *Controller) initiateOidcInteraction(
 		logger.Debugc(ctx, "InitiateOidcInteraction applied filters to pd", logfields.WithPresDefID(pd.ID))
 	}
 
+	responseRedirectURI := lo.FromPtr(data.ResponseRedirectUri)
+
+	if responseRedirectURI != "" && !profile.OIDCConfig.ResponseRedirect.IsAllowed(responseRedirectURI) {
+		return nil, resterr.NewValidationError(resterr.InvalidValue, "responseRedirectUri",
+			errors.New("response redirect uri is not allowed by the profile"))
+	}
+
 	result, err := c.oidc4VPService.InitiateOidcInteraction(
-		ctx, pd, lo.FromPtr(data.Purpose), lo.FromPtr(data.Scopes), profile)
+		ctx, pd, lo.FromPtr(data.Purpose), lo.FromPtr(data.Scopes), responseRedirectURI, profile)
 	if err != nil {
 		return nil, resterr.NewSystemError(resterr.VerifierOIDC4vpSvcComponent, "InitiateOidcInteraction", err)
 	}
@@ -374,6 +531,79 @@ func (c *Controller) initiateOidcInteraction(
 	}, err
 }
 
+// resolvePresentationDefinition returns the ad-hoc presentation definition or the DCQL query converted to
+// a presentation definition if any of them is set in the request. Otherwise, the presentation definition
+// is looked up in the profile.
+func resolvePresentationDefinition(
+	data *InitiateOIDC4VPData,
+	profile *profileapi.Verifier,
+) (*presexch.PresentationDefinition, error) {
+	if data.PresentationDefinition == nil && data.DcqlQuery == nil {
+		pd, err := findPresentationDefinition(profile, lo.FromPtr(data.PresentationDefinitionId))
+		if err != nil {
+			return nil, resterr.NewValidationError(resterr.InvalidValue, "presentationDefinitionID", err)
+		}
+
+		return pd, nil
+	}
+
+	if data.PresentationDefinition != nil && data.DcqlQuery != nil || data.PresentationDefinitionId != nil {
+		return nil, resterr.NewValidationError(resterr.InvalidValue, "presentationDefinition",
+			errors.New("only one of presentationDefinitionId, presentationDefinition and dcqlQuery can be set"))
+	}
+
+	var (
+		pd    *presexch.PresentationDefinition
+		field string
+		err   error
+	)
+
+	if data.PresentationDefinition != nil {
+		field = "presentationDefinition"
+		pd, err = parsePresentationDefinition(*data.PresentationDefinition)
+	} else {
+		field = "dcqlQuery"
+		pd, err = presentationDefinitionFromDCQL(data.DcqlQuery)
+	}
+
+	if err != nil {
+		return nil, resterr.NewValidationError(resterr.InvalidValue, field, err)
+	}
+
+	if profile.AdHocPresentationDefinition == nil {
+		return nil, resterr.NewValidationError(resterr.ConditionNotMet, field,
+			errors.New("ad-hoc presentation definitions are not allowed for the profile"))
+	}
+
+	if err = pd.ValidateSchema(); err != nil {
+		return nil, resterr.NewValidationError(resterr.InvalidValue, field, err)
+	}
+
+	if err = profile.AdHocPresentationDefinition.Check(pd); err != nil {
+		return nil, resterr.NewValidationError(resterr.InvalidValue, field, err)
+	}
+
+	return pd, nil
+}
+
+func parsePresentationDefinition(raw map[string]interface{}) (*presexch.PresentationDefinition, error) {
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("marshal pd: %w", err)
+	}
+
+	var pd *presexch.PresentationDefinition
+	if err = json.Unmarshal(b, &pd); err != nil {
+		return nil, fmt.Errorf("unmarshal pd: %w", err)
+	}
+
+	if pd.ID == "" {
+		pd.ID = uuid.NewString()
+	}
+
+	return pd, nil
+}
+
 func applyPresentationDefinitionFilters(
 	pd *presexch.PresentationDefinition,
 	filters *PresentationDefinitionFilters,
@@ -474,7 +704,7 @@ func (c *Controller) CheckAuthorizationResponse(e echo.Context) error {
 		return err
 	}
 
-	err = c.oidc4VPService.VerifyOIDCVerifiablePresentation(
+	result, err := c.oidc4VPService.VerifyOIDCVerifiablePresentation(
 		ctx, oidc4vp.TxID(rawAuthResp.State), authorisationResponseParsed)
 	if err != nil {
 		return err
@@ -482,12 +712,20 @@ func (c *Controller) CheckAuthorizationResponse(e echo.Context) error {
 
 	logger.Debugc(ctx, "CheckAuthorizationResponse succeed")
 
-	return nil
+	if result.RedirectURI == "" {
+		return nil
+	}
+
+	return util.WriteOutput(e)(&AuthorizationResponseRedirect{RedirectUri: result.RedirectURI}, nil)
 }
 
 // RetrieveInteractionsClaim is used by verifier applications to get claims obtained during oidc4vp interaction.
 // (GET /verifier/interactions/{txID}/claim).
-func (c *Controller) RetrieveInteractionsClaim(e echo.Context, txID string) error {
+func (c *Controller) RetrieveInteractionsClaim(
+	e echo.Context,
+	txID string,
+	params RetrieveInteractionsClaimParams,
+) error {
 	logger.Debugc(e.Request().Context(), "RetrieveInteractionsClaim begin")
 
 	ctx, span := c.tracer.Start(e.Request().Context(), "RetrieveInteractionsClaim")
@@ -514,6 +752,12 @@ func (c *Controller) RetrieveInteractionsClaim(e echo.Context, txID string) erro
 		return err
 	}
 
+	if err = checkResponseCode(tx, lo.FromPtr(params.ResponseCode)); err != nil {
+		c.sendFailedTxnEvent(ctx, tenantID, tx, err)
+
+		return err
+	}
+
 	if tx.ReceivedClaimsID == "" {
 		err = resterr.NewCustomError(resterr.ClaimsNotReceived,
 			fmt.Errorf("claims were not received for transaction '%s'", txID))
@@ -532,7 +776,9 @@ func (c *Controller) RetrieveInteractionsClaim(e echo.Context, txID string) erro
 		return err
 	}
 
-	claims := c.oidc4VPService.RetrieveClaims(ctx, tx, profile)
+	locales := profileapi.ParseLocales(string(lo.FromPtr(params.Locale)), string(lo.FromPtr(params.AcceptLanguage)))
+
+	claims := c.oidc4VPService.RetrieveClaims(ctx, tx, profile, locales)
 
 	err = c.oidc4VPService.DeleteClaims(ctx, tx.ReceivedClaimsID)
 	if err != nil {
@@ -544,6 +790,85 @@ func (c *Controller) RetrieveInteractionsClaim(e echo.Context, txID string) erro
 	return util.WriteOutput(e)(claims, nil)
 }
 
+// GetInteractionArchive is used by verifier applications to get the archived presentation of oidc4vp interaction.
+// (GET /verifier/interactions/{txID}/archive).
+func (c *Controller) GetInteractionArchive(e echo.Context, txID string) error {
+	ctx, span := c.tracer.Start(e.Request().Context(), "GetInteractionArchive")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("tx_id", txID))
+
+	tenantID, err := util.GetTenantIDFromRequest(e)
+	if err != nil {
+		return err
+	}
+
+	record, err := c.presentationArchive.GetRecord(ctx, txID)
+	if err != nil {
+		if errors.Is(err, presentationarchive.ErrDataNotFound) {
+			return resterr.NewCustomError(resterr.DataNotFound,
+				fmt.Errorf("archived presentation of transaction '%s' not found", txID))
+		}
+
+		return resterr.NewSystemError(resterr.VerifierPresentationArchiveComponent, "get-archive", err)
+	}
+
+	// Presentation is decrypted only for the organization of the archived profile.
+	if _, err = c.accessProfile(record.ProfileID, record.ProfileVersion, tenantID); err != nil {
+		return err
+	}
+
+	archived, err := c.presentationArchive.GetPresentation(ctx, record)
+	if err != nil {
+		return resterr.NewSystemError(resterr.VerifierPresentationArchiveComponent, "get-archive", err)
+	}
+
+	var submission *map[string]interface{}
+	if archived.PresentationSubmission != nil {
+		submission = &archived.PresentationSubmission
+	}
+
+	return util.WriteOutput(e)(&PresentationArchiveResponse{
+		TxID:                   archived.TxID,
+		ProfileID:              archived.ProfileID,
+		ProfileVersion:         archived.ProfileVersion,
+		VpTokens:               archived.VPTokens,
+		PresentationSubmission: submission,
+		Sequence:               archived.Sequence,
+		PrevHash:               lo.EmptyableToPtr(archived.PrevHash),
+		Hash:                   archived.Hash,
+		PayloadDigest:          archived.PayloadDigest,
+		Signature:              archived.Signature,
+		ArchivedAt:             archived.CreatedAt,
+		ExpiresAt:              archived.ExpireAt,
+	}, nil)
+}
+
+// checkResponseCode checks the response code of the same-device flow. Claims of such transaction can be retrieved
+// only with the response code the user was redirected with.
+func checkResponseCode(tx *oidc4vp.Transaction, responseCode string) error {
+	if tx.ResponseRedirectURI == "" {
+		if responseCode != "" {
+			return resterr.NewValidationError(resterr.InvalidValue, "response_code",
+				errors.New("transaction was not initiated for the same-device flow"))
+		}
+
+		return nil
+	}
+
+	if tx.ResponseCode == "" {
+		// Claims were not received yet.
+		return nil
+	}
+
+	if subtle.ConstantTimeCompare([]byte(tx.ResponseCode), []byte(responseCode)) != 1 {
+		return resterr.NewValidationError(resterr.InvalidValue, "response_code",
+			errors.New("invalid response code"))
+	}
+
+	return nil
+}
+
 func (c *Controller) accessOIDC4VPTx(ctx context.Context, txID string) (*oidc4vp.Transaction, error) {
 	tx, err := c.oidc4VPService.GetTx(ctx, oidc4vp.TxID(txID))
 
@@ -616,13 +941,15 @@ func (c *Controller) verifyAuthorizationResponseTokens(
 			VpTokenFormat: vpTokenClaims.VpTokenFormat,
 			Presentation:  vpTokenClaims.VP,
 			SignerDIDID:   vpTokenClaims.SignerDIDID,
+			RawToken:      vpToken,
 		})
 	}
 
 	return &oidc4vp.AuthorizationResponseParsed{
-		CustomScopeClaims: idTokenClaims.CustomScopeClaims,
-		VPTokens:          processedVPTokens,
-		AttestationVP:     idTokenClaims.AttestationVP,
+		CustomScopeClaims:      idTokenClaims.CustomScopeClaims,
+		VPTokens:               processedVPTokens,
+		AttestationVP:          idTokenClaims.AttestationVP,
+		PresentationSubmission: idTokenClaims.VPToken.PresentationSubmission,
 	}, nil
 }
 
@@ -956,6 +1283,7 @@ func mapVerifyCredentialChecks(checks []verifycredential.CredentialsVerification
 			Check:              check.Check,
 			Error:              check.Error,
 			VerificationMethod: check.VerificationMethod,
+			Proofs:             mapVerifyCredentialProofs(check.Proofs),
 		})
 	}
 
@@ -964,6 +1292,25 @@ func mapVerifyCredentialChecks(checks []verifycredential.CredentialsVerification
 	}
 }
 
+func mapVerifyCredentialProofs(proofs []verifycredential.ProofVerificationResult) *[]VerifyCredentialProofResult {
+	if len(proofs) == 0 {
+		return nil
+	}
+
+	proofList := make([]VerifyCredentialProofResult, 0, len(proofs))
+	for _, proof := range proofs {
+		proofList = append(proofList, VerifyCredentialProofResult{
+			Id:                 lo.EmptyableToPtr(proof.ID),
+			Type:               proof.Type,
+			Cryptosuite:        lo.EmptyableToPtr(proof.Cryptosuite),
+			VerificationMethod: lo.EmptyableToPtr(proof.VerificationMethod),
+			Error:              lo.EmptyableToPtr(proof.Error),
+		})
+	}
+
+	return &proofList
+}
+
 func mapVerifyPresentationChecks(
 	checks []verifypresentation.PresentationVerificationCheckResult) *VerifyPresentationResponse {
 	if len(checks) == 0 {
@@ -16,6 +16,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -39,6 +40,8 @@ import (
 	"github.com/trustbloc/vcs/pkg/restapi/v1/util"
 	"github.com/trustbloc/vcs/pkg/service/oidc4ci"
 	"github.com/trustbloc/vcs/pkg/service/oidc4vp"
+	"github.com/trustbloc/vcs/pkg/service/presentationarchive"
+	"github.com/trustbloc/vcs/pkg/service/verificationreceipt"
 	"github.com/trustbloc/vcs/pkg/service/verifycredential"
 	"github.com/trustbloc/vcs/pkg/service/verifypresentation"
 )
@@ -218,6 +221,88 @@ func TestController_VerifyCredentials(t *testing.T) {
 		require.Equal(t, &VerifyCredentialResponse{Checks: &[]VerifyCredentialCheckResult{{}}}, rsp)
 	})
 
+	t.Run("Success with verification receipt", func(t *testing.T) {
+		receiptProfileSvc := NewMockProfileService(gomock.NewController(t))
+		receiptProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Return(&profileapi.Verifier{
+			ID:                  profileID,
+			Version:             profileVersion,
+			OrganizationID:      tenantID,
+			Checks:              verificationChecks,
+			VerificationReceipt: &profileapi.VerificationReceiptConfig{},
+		}, nil)
+
+		receiptSvc := NewMockReceiptService(gomock.NewController(t))
+		receiptSvc.EXPECT().Issue(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *profileapi.Verifier, receipt *verificationreceipt.Receipt) (string, error) {
+				require.NotEmpty(t, receipt.ID)
+				require.Len(t, receipt.Credentials, 1)
+				require.Equal(t, []*verificationreceipt.CheckResult{
+					{Check: "proof", Passed: false, Error: "proof error"},
+					{Check: "credentialStatus", Passed: true},
+				}, receipt.Checks)
+
+				return "receipt-jwt", nil
+			})
+
+		verifyCredentialSvc := NewMockVerifyCredentialService(gomock.NewController(t))
+		verifyCredentialSvc.EXPECT().VerifyCredential(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]verifycredential.CredentialsVerificationCheckResult{{Check: "proof", Error: "proof error"}}, nil)
+
+		receiptController := NewController(&Config{
+			VerifyCredentialSvc: verifyCredentialSvc,
+			ProfileSvc:          receiptProfileSvc,
+			ReceiptSvc:          receiptSvc,
+			DocumentLoader:      testutil.DocumentLoader(t),
+			VDR:                 &vdrmock.VDRegistry{},
+		})
+
+		c := createContextWithBody([]byte(sampleVCJWT))
+
+		var body VerifyCredentialData
+
+		require.NoError(t, util.ReadBody(c, &body))
+
+		rsp, err := receiptController.verifyCredential(c.Request().Context(), &body, profileID, profileVersion, tenantID)
+		require.NoError(t, err)
+		require.NotEmpty(t, *rsp.ReceiptId)
+		require.Equal(t, "receipt-jwt", *rsp.Receipt)
+	})
+
+	t.Run("Issue verification receipt error", func(t *testing.T) {
+		receiptProfileSvc := NewMockProfileService(gomock.NewController(t))
+		receiptProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Return(&profileapi.Verifier{
+			ID:                  profileID,
+			Version:             profileVersion,
+			OrganizationID:      tenantID,
+			Checks:              verificationChecks,
+			VerificationReceipt: &profileapi.VerificationReceiptConfig{},
+		}, nil)
+
+		receiptSvc := NewMockReceiptService(gomock.NewController(t))
+		receiptSvc.EXPECT().Issue(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("issue error"))
+
+		verifyCredentialSvc := NewMockVerifyCredentialService(gomock.NewController(t))
+		verifyCredentialSvc.EXPECT().VerifyCredential(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, nil)
+
+		receiptController := NewController(&Config{
+			VerifyCredentialSvc: verifyCredentialSvc,
+			ProfileSvc:          receiptProfileSvc,
+			ReceiptSvc:          receiptSvc,
+			DocumentLoader:      testutil.DocumentLoader(t),
+			VDR:                 &vdrmock.VDRegistry{},
+		})
+
+		c := createContextWithBody([]byte(sampleVCJWT))
+
+		var body VerifyCredentialData
+
+		require.NoError(t, util.ReadBody(c, &body))
+
+		_, err := receiptController.verifyCredential(c.Request().Context(), &body, profileID, profileVersion, tenantID)
+		require.ErrorContains(t, err, "issue error")
+	})
+
 	t.Run("Failed", func(t *testing.T) {
 		tests := []struct {
 			name                   string
@@ -390,6 +475,47 @@ func TestController_VerifyPresentation(t *testing.T) {
 		require.Equal(t, &VerifyPresentationResponse{Checks: &[]VerifyPresentationCheckResult{{}}}, rsp)
 	})
 
+	t.Run("Success with verification receipt", func(t *testing.T) {
+		receiptProfileSvc := NewMockProfileService(gomock.NewController(t))
+		receiptProfileSvc.EXPECT().GetProfile(profileID, profileVersion).Return(&profileapi.Verifier{
+			ID:                  profileID,
+			Version:             profileVersion,
+			OrganizationID:      tenantID,
+			Checks:              verificationChecks,
+			VerificationReceipt: &profileapi.VerificationReceiptConfig{},
+		}, nil)
+
+		receiptSvc := NewMockReceiptService(gomock.NewController(t))
+		receiptSvc.EXPECT().Issue(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *profileapi.Verifier, receipt *verificationreceipt.Receipt) (string, error) {
+				require.NotEmpty(t, receipt.ID)
+				require.Len(t, receipt.PresentationDigests, 1)
+				require.NotEmpty(t, receipt.Checks)
+
+				return "receipt-jwt", nil
+			})
+
+		receiptController := NewController(&Config{
+			VerifyPresentationSvc: mockVerifyPresentationSvc,
+			ProfileSvc:            receiptProfileSvc,
+			ReceiptSvc:            receiptSvc,
+			DocumentLoader:        testutil.DocumentLoader(t),
+			VDR:                   &vdrmock.VDRegistry{},
+		})
+
+		c := createContextWithBody([]byte(sampleVPJWT))
+
+		var body VerifyPresentationData
+
+		require.NoError(t, util.ReadBody(c, &body))
+
+		rsp, err := receiptController.verifyPresentation(c.Request().Context(), &body, profileID, profileVersion,
+			tenantID)
+		require.NoError(t, err)
+		require.NotEmpty(t, *rsp.ReceiptId)
+		require.Equal(t, "receipt-jwt", *rsp.Receipt)
+	})
+
 	t.Run("Failed", func(t *testing.T) {
 		tests := []struct {
 			name                     string
@@ -465,10 +591,195 @@ func TestController_VerifyPresentation(t *testing.T) {
 	})
 }
 
+func TestController_GetVerificationReceipt(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		receiptSvc := NewMockReceiptService(gomock.NewController(t))
+		receiptSvc.EXPECT().Get(gomock.Any(), "txid").Return(&verificationreceipt.Record{
+			ID:             "txid",
+			ProfileID:      "p1",
+			ProfileVersion: "v1.0",
+			Receipt:        "receipt-jwt",
+		}, nil)
+
+		c := NewController(&Config{
+			ReceiptSvc: receiptSvc,
+			ProfileSvc: profileServiceForOrg(t, "orgID1"),
+			Tracer:     trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		ctx := createContext("orgID1")
+
+		require.NoError(t, c.GetVerificationReceipt(ctx, "txid"))
+
+		var resp VerificationReceiptResponse
+
+		require.NoError(t, json.Unmarshal(ctx.Response().Writer.(*httptest.ResponseRecorder).Body.Bytes(), &resp))
+		require.Equal(t, VerificationReceiptResponse{Id: "txid", Receipt: "receipt-jwt"}, resp)
+	})
+
+	t.Run("Missing tenant ID", func(t *testing.T) {
+		c := NewController(&Config{
+			Tracer: trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		require.ErrorContains(t, c.GetVerificationReceipt(createContext(""), "txid"), "missing authorization")
+	})
+
+	t.Run("Receipt not found", func(t *testing.T) {
+		receiptSvc := NewMockReceiptService(gomock.NewController(t))
+		receiptSvc.EXPECT().Get(gomock.Any(), "txid").Return(nil, verificationreceipt.ErrDataNotFound)
+
+		c := NewController(&Config{
+			ReceiptSvc: receiptSvc,
+			Tracer:     trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.GetVerificationReceipt(createContext("orgID1"), "txid")
+		requireCustomError(t, resterr.DataNotFound, err)
+	})
+
+	t.Run("Get receipt error", func(t *testing.T) {
+		receiptSvc := NewMockReceiptService(gomock.NewController(t))
+		receiptSvc.EXPECT().Get(gomock.Any(), "txid").Return(nil, errors.New("get error"))
+
+		c := NewController(&Config{
+			ReceiptSvc: receiptSvc,
+			Tracer:     trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		require.ErrorContains(t, c.GetVerificationReceipt(createContext("orgID1"), "txid"), "get error")
+	})
+
+	t.Run("Receipt of other organization", func(t *testing.T) {
+		receiptSvc := NewMockReceiptService(gomock.NewController(t))
+		receiptSvc.EXPECT().Get(gomock.Any(), "txid").Return(&verificationreceipt.Record{
+			ID:             "txid",
+			ProfileID:      "p1",
+			ProfileVersion: "v1.0",
+		}, nil)
+
+		c := NewController(&Config{
+			ReceiptSvc: receiptSvc,
+			ProfileSvc: profileServiceForOrg(t, "orgID2"),
+			Tracer:     trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.GetVerificationReceipt(createContext("orgID1"), "txid")
+		requireCustomError(t, resterr.ProfileNotFound, err)
+	})
+}
+
+func TestController_GetInteractionArchive(t *testing.T) {
+	archivedAt := time.Now().UTC().Truncate(time.Second)
+
+	archived := &presentationarchive.ArchivedPresentation{
+		Record: &presentationarchive.Record{
+			TxID:           "txid",
+			ProfileID:      "p1",
+			ProfileVersion: "v1.0",
+			Sequence:       2,
+			PrevHash:       "hash1",
+			Hash:           "hash2",
+			PayloadDigest:  "digest",
+			Signature:      "signature",
+			CreatedAt:      archivedAt,
+			ExpireAt:       archivedAt.Add(24 * time.Hour),
+		},
+		Presentation: &presentationarchive.Presentation{
+			VPTokens:               []string{"vp-token"},
+			PresentationSubmission: map[string]interface{}{"id": "submission1"},
+		},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		archive := NewMockPresentationArchive(gomock.NewController(t))
+		archive.EXPECT().GetRecord(gomock.Any(), "txid").Return(archived.Record, nil)
+		archive.EXPECT().GetPresentation(gomock.Any(), archived.Record).Return(archived, nil)
+
+		c := NewController(&Config{
+			PresentationArchive: archive,
+			ProfileSvc:          profileServiceForOrg(t, "orgID1"),
+			Tracer:              trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		ctx := createContext("orgID1")
+
+		require.NoError(t, c.GetInteractionArchive(ctx, "txid"))
+
+		var resp PresentationArchiveResponse
+
+		require.NoError(t, json.Unmarshal(ctx.Response().Writer.(*httptest.ResponseRecorder).Body.Bytes(), &resp))
+		require.Equal(t, "txid", resp.TxID)
+		require.Equal(t, "p1", resp.ProfileID)
+		require.Equal(t, "v1.0", resp.ProfileVersion)
+		require.Equal(t, []string{"vp-token"}, resp.VpTokens)
+		require.Equal(t, map[string]interface{}{"id": "submission1"}, *resp.PresentationSubmission)
+		require.EqualValues(t, 2, resp.Sequence)
+		require.Equal(t, "hash1", *resp.PrevHash)
+		require.Equal(t, "hash2", resp.Hash)
+		require.Equal(t, "digest", resp.PayloadDigest)
+		require.Equal(t, "signature", resp.Signature)
+		require.True(t, archivedAt.Equal(resp.ArchivedAt))
+		require.True(t, archivedAt.Add(24*time.Hour).Equal(resp.ExpiresAt))
+	})
+
+	t.Run("Missing tenant ID", func(t *testing.T) {
+		c := NewController(&Config{
+			Tracer: trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		require.ErrorContains(t, c.GetInteractionArchive(createContext(""), "txid"), "missing authorization")
+	})
+
+	t.Run("Archive not found", func(t *testing.T) {
+		archive := NewMockPresentationArchive(gomock.NewController(t))
+		archive.EXPECT().GetRecord(gomock.Any(), "txid").Return(nil, presentationarchive.ErrDataNotFound)
+
+		c := NewController(&Config{
+			PresentationArchive: archive,
+			Tracer:              trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.GetInteractionArchive(createContext("orgID1"), "txid")
+		requireCustomError(t, resterr.DataNotFound, err)
+	})
+
+	t.Run("Integrity check failed", func(t *testing.T) {
+		archive := NewMockPresentationArchive(gomock.NewController(t))
+		archive.EXPECT().GetRecord(gomock.Any(), "txid").Return(archived.Record, nil)
+		archive.EXPECT().GetPresentation(gomock.Any(), archived.Record).Return(nil,
+			fmt.Errorf("%w: record hash mismatch", presentationarchive.ErrIntegrity))
+
+		c := NewController(&Config{
+			PresentationArchive: archive,
+			ProfileSvc:          profileServiceForOrg(t, "orgID1"),
+			Tracer:              trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		require.ErrorContains(t, c.GetInteractionArchive(createContext("orgID1"), "txid"),
+			"archive integrity check failed: record hash mismatch")
+	})
+
+	t.Run("Archive of other organization", func(t *testing.T) {
+		// Presentation of other organization is not decrypted.
+		archive := NewMockPresentationArchive(gomock.NewController(t))
+		archive.EXPECT().GetRecord(gomock.Any(), "txid").Return(archived.Record, nil)
+
+		c := NewController(&Config{
+			PresentationArchive: archive,
+			ProfileSvc:          profileServiceForOrg(t, "orgID2"),
+			Tracer:              trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.GetInteractionArchive(createContext("orgID1"), "txid")
+		requireCustomError(t, resterr.ProfileNotFound, err)
+	})
+}
+
 func TestController_CheckAuthorizationResponse(t *testing.T) {
 	oidc4VPService := NewMockOIDC4VPService(gomock.NewController(t))
 	oidc4VPService.EXPECT().VerifyOIDCVerifiablePresentation(gomock.Any(), oidc4vp.TxID("txid"), gomock.Any()).
-		AnyTimes().Return(nil)
+		AnyTimes().Return(&oidc4vp.AuthorizationResponseResult{}, nil)
 
 	t.Run("Success Controller JWT", func(t *testing.T) {
 		signedClaimsJWTResult := testutil.SignedClaimsJWT(t, &IDTokenClaims{
@@ -521,6 +832,61 @@ func TestController_CheckAuthorizationResponse(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("Success same-device flow", func(t *testing.T) {
+		signedClaimsJWTResult := testutil.SignedClaimsJWT(t, &IDTokenClaims{
+			VPToken: IDTokenVPToken{
+				PresentationSubmission: map[string]interface{}{}},
+			Nonce: validNonce,
+			Aud:   validAud,
+			Exp:   time.Now().Unix() + 1000,
+		})
+
+		vpToken := testutil.SignedClaimsJWTWithExistingPrivateKey(t,
+			signedClaimsJWTResult.VerMethodDIDKeyID,
+			signedClaimsJWTResult.Signer,
+			&vpTokenClaims{
+				Nonce: validNonce,
+				Aud:   validAud,
+				Iss:   signedClaimsJWTResult.VerMethodDID,
+				Exp:   time.Now().Unix() + 1000,
+				VP: &verifiable.Presentation{
+					Context: []string{
+						"https://www.w3.org/2018/credentials/v1",
+						"https://identity.foundation/presentation-exchange/submission/v1",
+					},
+					Type: []string{
+						"VerifiablePresentation",
+						"PresentationSubmission",
+					},
+				},
+			})
+
+		body := "vp_token=" + vpToken +
+			"&id_token=" + signedClaimsJWTResult.JWT +
+			"&state=txid"
+
+		ctx := createContextApplicationForm([]byte(body))
+
+		sameDeviceSvc := NewMockOIDC4VPService(gomock.NewController(t))
+		sameDeviceSvc.EXPECT().VerifyOIDCVerifiablePresentation(gomock.Any(), oidc4vp.TxID("txid"), gomock.Any()).
+			Return(&oidc4vp.AuthorizationResponseResult{
+				RedirectURI:  "https://verifier.example.com/cb?response_code=code",
+				ResponseCode: "code",
+			}, nil)
+
+		c := NewController(&Config{
+			VDR:            signedClaimsJWTResult.VDR,
+			OIDCVPService:  sameDeviceSvc,
+			DocumentLoader: testutil.DocumentLoader(t),
+			Tracer:         trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		require.NoError(t, c.CheckAuthorizationResponse(ctx))
+
+		rec := ctx.Response().Writer.(*httptest.ResponseRecorder) //nolint:errcheck
+		require.JSONEq(t, `{"redirect_uri":"https://verifier.example.com/cb?response_code=code"}`, rec.Body.String())
+	})
+
 	t.Run("Success JWT", func(t *testing.T) {
 		customScopeClaims := map[string]oidc4vp.Claims{
 			"customScope": {
@@ -576,6 +942,8 @@ func TestController_CheckAuthorizationResponse(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, customScopeClaims, authorisationResponseParsed.CustomScopeClaims)
 		require.Contains(t, authorisationResponseParsed.VPTokens[0].Presentation.Type, "PresentationSubmission")
+		require.Equal(t, vpToken, authorisationResponseParsed.VPTokens[0].RawToken)
+		require.Equal(t, map[string]interface{}{}, authorisationResponseParsed.PresentationSubmission)
 	})
 
 	t.Run("Success LDP", func(t *testing.T) {
@@ -632,6 +1000,7 @@ func TestController_CheckAuthorizationResponse(t *testing.T) {
 
 		require.Nil(t, authorisationResponseParsed.CustomScopeClaims)
 		require.Contains(t, authorisationResponseParsed.VPTokens[0].Presentation.Type, "PresentationSubmission")
+		require.Equal(t, string(vpToken), authorisationResponseParsed.VPTokens[0].RawToken)
 	})
 
 	t.Run("Presentation submission missed", func(t *testing.T) {
@@ -1241,7 +1610,8 @@ func TestController_RetrieveInteractionsClaim(t *testing.T) {
 			ReceivedClaims:   &oidc4vp.ReceivedClaims{},
 		}, nil)
 
-		oidc4VPService.EXPECT().RetrieveClaims(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(map[string]oidc4vp.CredentialMetadata{}) //nolint:lll
+		oidc4VPService.EXPECT().RetrieveClaims(gomock.Any(), gomock.Any(), gomock.Any(), []string{"fr-CA", "en"}).
+			Times(1).Return(map[string]oidc4vp.CredentialMetadata{})
 		oidc4VPService.EXPECT().DeleteClaims(gomock.Any(), gomock.Any()).Times(1).Return(nil)
 
 		mockProfileSvc := NewMockProfileService(gomock.NewController(t))
@@ -1266,7 +1636,9 @@ func TestController_RetrieveInteractionsClaim(t *testing.T) {
 			Tracer:         trace.NewNoopTracerProvider().Tracer(""),
 		})
 
-		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid")
+		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid", RetrieveInteractionsClaimParams{
+			AcceptLanguage: lo.ToPtr(AcceptLanguage("fr-CA,en;q=0.8")),
+		})
 		require.NoError(t, err)
 	})
 
@@ -1280,8 +1652,51 @@ func TestController_RetrieveInteractionsClaim(t *testing.T) {
 			ReceivedClaims:   &oidc4vp.ReceivedClaims{},
 		}, nil)
 
-		oidc4VPService.EXPECT().RetrieveClaims(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(map[string]oidc4vp.CredentialMetadata{}) //nolint:lll
-		oidc4VPService.EXPECT().DeleteClaims(gomock.Any(), gomock.Any()).Times(1).Return(fmt.Errorf("delete claims error"))                       //nolint:lll
+		oidc4VPService.EXPECT().RetrieveClaims(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(map[string]oidc4vp.CredentialMetadata{}) //nolint:lll
+		oidc4VPService.EXPECT().DeleteClaims(gomock.Any(), gomock.Any()).Times(1).Return(fmt.Errorf("delete claims error"))                                     //nolint:lll
+
+		mockProfileSvc := NewMockProfileService(gomock.NewController(t))
+
+		mockProfileSvc.EXPECT().GetProfile("p1", "v1.0").AnyTimes().
+			Return(&profileapi.Verifier{
+				ID:             "p1",
+				Version:        "v1.0",
+				OrganizationID: "orgID1",
+				Checks:         verificationChecks,
+			}, nil)
+
+		mockEventSvc := NewMockeventService(gomock.NewController(t))
+		mockEventSvc.EXPECT().Publish(gomock.Any(), spi.VerifierEventTopic, gomock.Any()).Times(0)
+
+		c := NewController(&Config{
+			OIDCVPService:  oidc4VPService,
+			EventSvc:       mockEventSvc,
+			EventTopic:     spi.VerifierEventTopic,
+			ProfileSvc:     mockProfileSvc,
+			DocumentLoader: testutil.DocumentLoader(t),
+			Tracer:         trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid", RetrieveInteractionsClaimParams{})
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - same-device flow with response code", func(t *testing.T) {
+		oidc4VPService := NewMockOIDC4VPService(gomock.NewController(t))
+		oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).
+			Times(1).Return(&oidc4vp.Transaction{
+			ProfileID:              "p1",
+			ProfileVersion:         "v1.0",
+			ResponseRedirectURI:    "https://verifier.example.com/cb",
+			ResponseCode:           "code",
+			ReceivedClaimsID:       "claims-id",
+			ReceivedClaims:         &oidc4vp.ReceivedClaims{},
+			PresentationDefinition: &presexch.PresentationDefinition{ID: "pd1"},
+		}, nil)
+
+		oidc4VPService.EXPECT().RetrieveClaims(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).Return(map[string]oidc4vp.CredentialMetadata{})
+		oidc4VPService.EXPECT().DeleteClaims(gomock.Any(), gomock.Any()).Times(1).Return(nil)
 
 		mockProfileSvc := NewMockProfileService(gomock.NewController(t))
 
@@ -1305,10 +1720,95 @@ func TestController_RetrieveInteractionsClaim(t *testing.T) {
 			Tracer:         trace.NewNoopTracerProvider().Tracer(""),
 		})
 
-		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid")
+		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid", RetrieveInteractionsClaimParams{
+			ResponseCode: lo.ToPtr("code"),
+		})
 		require.NoError(t, err)
 	})
 
+	t.Run("Error - invalid response code", func(t *testing.T) {
+		oidc4VPService := NewMockOIDC4VPService(gomock.NewController(t))
+		oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).
+			Times(1).Return(&oidc4vp.Transaction{
+			ProfileID:              "p1",
+			ProfileVersion:         "v1.0",
+			ResponseRedirectURI:    "https://verifier.example.com/cb",
+			ResponseCode:           "code",
+			ReceivedClaimsID:       "claims-id",
+			ReceivedClaims:         &oidc4vp.ReceivedClaims{},
+			PresentationDefinition: &presexch.PresentationDefinition{ID: "pd1"},
+		}, nil)
+
+		mockProfileSvc := NewMockProfileService(gomock.NewController(t))
+
+		mockProfileSvc.EXPECT().GetProfile("p1", "v1.0").AnyTimes().
+			Return(&profileapi.Verifier{
+				ID:             "p1",
+				Version:        "v1.0",
+				OrganizationID: "orgID1",
+				Checks:         verificationChecks,
+			}, nil)
+
+		mockEventSvc := NewMockeventService(gomock.NewController(t))
+		mockEventSvc.EXPECT().Publish(gomock.Any(), spi.VerifierEventTopic, gomock.Any()).Times(1)
+
+		c := NewController(&Config{
+			OIDCVPService:  oidc4VPService,
+			EventSvc:       mockEventSvc,
+			EventTopic:     spi.VerifierEventTopic,
+			ProfileSvc:     mockProfileSvc,
+			DocumentLoader: testutil.DocumentLoader(t),
+			Tracer:         trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid", RetrieveInteractionsClaimParams{
+			ResponseCode: lo.ToPtr("other-code"),
+		})
+		requireValidationError(t, resterr.InvalidValue, "response_code", err)
+		require.ErrorContains(t, err, "invalid response code")
+	})
+
+	t.Run("Error - response code for cross-device flow", func(t *testing.T) {
+		oidc4VPService := NewMockOIDC4VPService(gomock.NewController(t))
+		oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).
+			Times(1).Return(&oidc4vp.Transaction{
+			ProfileID:              "p1",
+			ProfileVersion:         "v1.0",
+			ResponseRedirectURI:    "",
+			ResponseCode:           "",
+			ReceivedClaimsID:       "claims-id",
+			ReceivedClaims:         &oidc4vp.ReceivedClaims{},
+			PresentationDefinition: &presexch.PresentationDefinition{ID: "pd1"},
+		}, nil)
+
+		mockProfileSvc := NewMockProfileService(gomock.NewController(t))
+
+		mockProfileSvc.EXPECT().GetProfile("p1", "v1.0").AnyTimes().
+			Return(&profileapi.Verifier{
+				ID:             "p1",
+				Version:        "v1.0",
+				OrganizationID: "orgID1",
+				Checks:         verificationChecks,
+			}, nil)
+
+		mockEventSvc := NewMockeventService(gomock.NewController(t))
+		mockEventSvc.EXPECT().Publish(gomock.Any(), spi.VerifierEventTopic, gomock.Any()).Times(1)
+
+		c := NewController(&Config{
+			OIDCVPService:  oidc4VPService,
+			EventSvc:       mockEventSvc,
+			EventTopic:     spi.VerifierEventTopic,
+			ProfileSvc:     mockProfileSvc,
+			DocumentLoader: testutil.DocumentLoader(t),
+			Tracer:         trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid", RetrieveInteractionsClaimParams{
+			ResponseCode: lo.ToPtr("code"),
+		})
+		requireValidationError(t, resterr.InvalidValue, "response_code", err)
+	})
+
 	t.Run("Error - claims expired", func(t *testing.T) {
 		oidc4VPService := NewMockOIDC4VPService(gomock.NewController(t))
 		oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).
@@ -1341,7 +1841,7 @@ func TestController_RetrieveInteractionsClaim(t *testing.T) {
 			Tracer:         trace.NewNoopTracerProvider().Tracer(""),
 		})
 
-		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid")
+		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid", RetrieveInteractionsClaimParams{})
 		require.Error(t, err)
 		require.Contains(t, err.Error(),
 			"claims are either retrieved or expired for transaction 'txid'")
@@ -1378,7 +1878,7 @@ func TestController_RetrieveInteractionsClaim(t *testing.T) {
 			Tracer:         trace.NewNoopTracerProvider().Tracer(""),
 		})
 
-		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid")
+		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid", RetrieveInteractionsClaimParams{})
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "claims were not received for transaction 'txid'")
 	})
@@ -1402,7 +1902,7 @@ func TestController_RetrieveInteractionsClaim(t *testing.T) {
 			Tracer:         trace.NewNoopTracerProvider().Tracer(""),
 		})
 
-		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid")
+		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid", RetrieveInteractionsClaimParams{})
 		requireCustomError(t, resterr.TransactionNotFound, err)
 	})
 
@@ -1425,7 +1925,7 @@ func TestController_RetrieveInteractionsClaim(t *testing.T) {
 			Tracer:         trace.NewNoopTracerProvider().Tracer(""),
 		})
 
-		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid")
+		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid", RetrieveInteractionsClaimParams{})
 		requireSystemError(t, "verifier.oidc4vp-service", "GetTx", err)
 	})
 
@@ -1455,7 +1955,7 @@ func TestController_RetrieveInteractionsClaim(t *testing.T) {
 			Tracer:         trace.NewNoopTracerProvider().Tracer(""),
 		})
 
-		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid")
+		err := c.RetrieveInteractionsClaim(createContext("orgID1"), "txid", RetrieveInteractionsClaimParams{})
 		requireCustomError(t, resterr.ProfileNotFound, err)
 	})
 }
@@ -1654,6 +2154,51 @@ func Test_mapVerifyCredentialChecks(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "OK with proofs",
+			args: args{
+				checks: []verifycredential.CredentialsVerificationCheckResult{
+					{
+						Check: "proof",
+						Error: "proof 1: error",
+						Proofs: []verifycredential.ProofVerificationResult{
+							{
+								Type:               "Ed25519Signature2020",
+								VerificationMethod: "verificationMethod1",
+							},
+							{
+								ID:                 "urn:uuid:1",
+								Type:               "DataIntegrityProof",
+								Cryptosuite:        "ecdsa-2019",
+								VerificationMethod: "verificationMethod2",
+								Error:              "error",
+							},
+						},
+					},
+				},
+			},
+			want: &VerifyCredentialResponse{
+				Checks: &[]VerifyCredentialCheckResult{
+					{
+						Check: "proof",
+						Error: "proof 1: error",
+						Proofs: &[]VerifyCredentialProofResult{
+							{
+								Type:               "Ed25519Signature2020",
+								VerificationMethod: lo.ToPtr("verificationMethod1"),
+							},
+							{
+								Id:                 lo.ToPtr("urn:uuid:1"),
+								Type:               "DataIntegrityProof",
+								Cryptosuite:        lo.ToPtr("ecdsa-2019"),
+								VerificationMethod: lo.ToPtr("verificationMethod2"),
+								Error:              lo.ToPtr("error"),
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "OK Empty",
 			args: args{
@@ -1845,10 +2390,10 @@ func TestController_AuthFailed(t *testing.T) {
 		controller := NewController(&Config{ProfileSvc: mockProfileSvc, KMSRegistry: kmsRegistry,
 			Tracer: trace.NewNoopTracerProvider().Tracer("")})
 
-		err := controller.InitiateOidcInteraction(c, profileID, profileVersion)
+		err := controller.InitiateOidcInteraction(c, profileID, profileVersion, InitiateOidcInteractionParams{})
 		requireAuthError(t, err)
 
-		err = controller.RetrieveInteractionsClaim(c, "txid")
+		err = controller.RetrieveInteractionsClaim(c, "txid", RetrieveInteractionsClaimParams{})
 		requireAuthError(t, err)
 	})
 
@@ -1858,7 +2403,7 @@ func TestController_AuthFailed(t *testing.T) {
 		controller := NewController(&Config{ProfileSvc: mockProfileSvc, KMSRegistry: kmsRegistry,
 			Tracer: trace.NewNoopTracerProvider().Tracer("")})
 
-		err := controller.InitiateOidcInteraction(c, profileID, profileVersion)
+		err := controller.InitiateOidcInteraction(c, profileID, profileVersion, InitiateOidcInteractionParams{})
 		requireCustomError(t, resterr.ProfileNotFound, err)
 	})
 }
@@ -1873,7 +2418,7 @@ func TestController_InitiateOidcInteraction(t *testing.T) {
 	mockProfileSvc := NewMockProfileService(gomock.NewController(t))
 
 	oidc4VPSvc := NewMockOIDC4VPService(gomock.NewController(t))
-	oidc4VPSvc.EXPECT().InitiateOidcInteraction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+	oidc4VPSvc.EXPECT().InitiateOidcInteraction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), "", gomock.Any()).
 		AnyTimes().Return(&oidc4vp.InteractionInfo{}, nil)
 
 	t.Run("Success", func(t *testing.T) {
@@ -1894,7 +2439,7 @@ func TestController_InitiateOidcInteraction(t *testing.T) {
 			Tracer:        trace.NewNoopTracerProvider().Tracer(""),
 		})
 		c := createContext(tenantID)
-		err := controller.InitiateOidcInteraction(c, profileID, profileVersion)
+		err := controller.InitiateOidcInteraction(c, profileID, profileVersion, InitiateOidcInteractionParams{})
 		require.NoError(t, err)
 	})
 
@@ -1908,9 +2453,66 @@ func TestController_InitiateOidcInteraction(t *testing.T) {
 			Tracer:        trace.NewNoopTracerProvider().Tracer(""),
 		})
 		c := createContext(tenantID)
-		err := controller.InitiateOidcInteraction(c, profileID, profileVersion)
+		err := controller.InitiateOidcInteraction(c, profileID, profileVersion, InitiateOidcInteractionParams{})
 		requireCustomError(t, resterr.ProfileNotFound, err)
 	})
+	t.Run("Success with QR code", func(t *testing.T) {
+		mockProfileSvc.EXPECT().GetProfile(gomock.Any(), gomock.Any()).Times(1).Return(&profileapi.Verifier{
+			OrganizationID: tenantID,
+			Active:         true,
+			OIDCConfig:     &profileapi.OIDC4VPConfig{},
+			SigningDID:     &profileapi.SigningDID{},
+			PresentationDefinitions: []*presexch.PresentationDefinition{
+				{},
+			},
+		}, nil)
+
+		svc := NewMockOIDC4VPService(gomock.NewController(t))
+		svc.EXPECT().InitiateOidcInteraction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), "", gomock.Any()).
+			Return(&oidc4vp.InteractionInfo{
+				AuthorizationRequest: "openid-vc://?request_uri=https://vcs.example.com/request-object/1",
+				TxID:                 "txID",
+			}, nil)
+
+		controller := NewController(&Config{
+			ProfileSvc:    mockProfileSvc,
+			KMSRegistry:   kmsRegistry,
+			OIDCVPService: svc,
+			Tracer:        trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		c := createContext(tenantID)
+		err := controller.InitiateOidcInteraction(c, profileID, profileVersion, InitiateOidcInteractionParams{
+			QrFormat: lo.ToPtr(InitiateOidcInteractionParamsQrFormat("svg")),
+			QrSize:   lo.ToPtr(128),
+		})
+		require.NoError(t, err)
+
+		var resp InitiateOIDC4VPResponse
+		require.NoError(t, json.Unmarshal(c.Response().Writer.(*httptest.ResponseRecorder).Body.Bytes(), &resp))
+		require.True(t, strings.HasPrefix(lo.FromPtr(resp.QrCode), "data:image/svg+xml;base64,"))
+	})
+
+	t.Run("Invalid QR code options", func(t *testing.T) {
+		mockProfileSvc.EXPECT().GetProfile(gomock.Any(), gomock.Any()).Times(1).Return(&profileapi.Verifier{
+			OrganizationID: tenantID,
+			Active:         true,
+			OIDCConfig:     &profileapi.OIDC4VPConfig{},
+		}, nil)
+
+		controller := NewController(&Config{
+			ProfileSvc:  mockProfileSvc,
+			KMSRegistry: kmsRegistry,
+			Tracer:      trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		c := createContext(tenantID)
+		err := controller.InitiateOidcInteraction(c, profileID, profileVersion, InitiateOidcInteractionParams{
+			QrFormat: lo.ToPtr(InitiateOidcInteractionParamsQrFormat("png")),
+			QrSize:   lo.ToPtr(5000),
+		})
+		requireValidationError(t, resterr.InvalidValue, "qr_code", err)
+	})
 }
 
 func TestController_initiateOidcInteraction(t *testing.T) {
@@ -1924,7 +2526,7 @@ func TestController_initiateOidcInteraction(t *testing.T) {
 
 	oidc4VPSvc := NewMockOIDC4VPService(gomock.NewController(t))
 	oidc4VPSvc.EXPECT().InitiateOidcInteraction(
-		gomock.Any(), gomock.Any(), gomock.Any(), []string{"test_scope"}, gomock.Any()).
+		gomock.Any(), gomock.Any(), gomock.Any(), []string{"test_scope"}, "", gomock.Any()).
 		AnyTimes().Return(&oidc4vp.InteractionInfo{}, nil)
 
 	t.Run("Success", func(t *testing.T) {
@@ -2132,6 +2734,64 @@ func TestController_initiateOidcInteraction(t *testing.T) {
 		requireValidationError(t, resterr.ConditionNotMet, "profile.OIDCConfig", err)
 	})
 
+	t.Run("Success - response redirect uri", func(t *testing.T) {
+		oidc4VPSvc := NewMockOIDC4VPService(gomock.NewController(t))
+		oidc4VPSvc.EXPECT().InitiateOidcInteraction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+			"https://verifier.example.com/cb?session=1", gomock.Any()).
+			Return(&oidc4vp.InteractionInfo{TxID: "txID"}, nil)
+
+		controller := NewController(&Config{
+			ProfileSvc:    mockProfileSvc,
+			KMSRegistry:   kmsRegistry,
+			OIDCVPService: oidc4VPSvc,
+		})
+
+		result, err := controller.initiateOidcInteraction(context.TODO(), &InitiateOIDC4VPData{
+			ResponseRedirectUri: lo.ToPtr("https://verifier.example.com/cb?session=1"),
+		}, &profileapi.Verifier{
+			OrganizationID: tenantID,
+			Active:         true,
+			OIDCConfig: &profileapi.OIDC4VPConfig{
+				ResponseRedirect: &profileapi.ResponseRedirectConfig{
+					AllowedURIs: []string{"https://verifier.example.com/cb"},
+				},
+			},
+			SigningDID: &profileapi.SigningDID{},
+			PresentationDefinitions: []*presexch.PresentationDefinition{
+				{},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "txID", result.TxID)
+	})
+
+	t.Run("Response redirect uri is not allowed", func(t *testing.T) {
+		controller := NewController(&Config{
+			ProfileSvc:    mockProfileSvc,
+			KMSRegistry:   kmsRegistry,
+			OIDCVPService: oidc4VPSvc,
+		})
+
+		_, err := controller.initiateOidcInteraction(context.TODO(), &InitiateOIDC4VPData{
+			ResponseRedirectUri: lo.ToPtr("https://evil.example.com/cb"),
+		}, &profileapi.Verifier{
+			OrganizationID: tenantID,
+			Active:         true,
+			OIDCConfig: &profileapi.OIDC4VPConfig{
+				ResponseRedirect: &profileapi.ResponseRedirectConfig{
+					AllowedURIs: []string{"https://verifier.example.com/cb"},
+				},
+			},
+			SigningDID: &profileapi.SigningDID{},
+			PresentationDefinitions: []*presexch.PresentationDefinition{
+				{},
+			},
+		})
+
+		requireValidationError(t, resterr.InvalidValue, "responseRedirectUri", err)
+	})
+
 	t.Run("Invalid pd id", func(t *testing.T) {
 		mockProfileSvcErr := NewMockProfileService(gomock.NewController(t))
 
@@ -2155,7 +2815,7 @@ func TestController_initiateOidcInteraction(t *testing.T) {
 	t.Run("oidc4VPService.InitiateOidcInteraction failed", func(t *testing.T) {
 		oidc4VPSvc := NewMockOIDC4VPService(gomock.NewController(t))
 		oidc4VPSvc.EXPECT().
-			InitiateOidcInteraction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			InitiateOidcInteraction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), "", gomock.Any()).
 			AnyTimes().Return(nil, errors.New("fail"))
 
 		controller := NewController(&Config{
@@ -2177,6 +2837,246 @@ func TestController_initiateOidcInteraction(t *testing.T) {
 
 		requireSystemError(t, "verifier.oidc4vp-service", "InitiateOidcInteraction", err)
 	})
+
+	adHocProfile := &profileapi.Verifier{
+		OrganizationID: tenantID,
+		Active:         true,
+		OIDCConfig:     &profileapi.OIDC4VPConfig{},
+		SigningDID:     &profileapi.SigningDID{},
+		AdHocPresentationDefinition: &profileapi.AdHocPresentationDefinitionConfig{
+			AllowedFieldPaths: []string{
+				"$.type", "$.vc.type", "$.id", "$.vc.jti", "$.credentialSubject.*", "$.vc.credentialSubject.*",
+			},
+			MaxInputDescriptors: 2,
+		},
+	}
+
+	adHocPD := map[string]interface{}{
+		"id": "ad-hoc",
+		"input_descriptors": []interface{}{
+			map[string]interface{}{
+				"id": "employee",
+				"constraints": map[string]interface{}{
+					"fields": []interface{}{
+						map[string]interface{}{
+							"path":   []interface{}{"$.id", "$.vc.jti"},
+							"filter": map[string]interface{}{"type": "string", "const": "urn:uuid:1234"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("Success - ad-hoc presentation definition", func(t *testing.T) {
+		oidc4VPSvc := NewMockOIDC4VPService(gomock.NewController(t))
+		oidc4VPSvc.EXPECT().InitiateOidcInteraction(gomock.Any(), gomock.Any(), "", nil, "", adHocProfile).
+			DoAndReturn(func(
+				_ context.Context,
+				pd *presexch.PresentationDefinition,
+				_ string,
+				_ []string,
+				_ string,
+				_ *profileapi.Verifier,
+			) (*oidc4vp.InteractionInfo, error) {
+				require.Equal(t, "ad-hoc", pd.ID)
+				require.Equal(t, []string{"$.id", "$.vc.jti"}, pd.InputDescriptors[0].Constraints.Fields[0].Path)
+
+				return &oidc4vp.InteractionInfo{TxID: "txID"}, nil
+			})
+
+		controller := NewController(&Config{
+			ProfileSvc:    mockProfileSvc,
+			KMSRegistry:   kmsRegistry,
+			OIDCVPService: oidc4VPSvc,
+		})
+
+		result, err := controller.initiateOidcInteraction(context.TODO(), &InitiateOIDC4VPData{
+			PresentationDefinition: &adHocPD,
+		}, adHocProfile)
+
+		require.NoError(t, err)
+		require.Equal(t, "txID", result.TxID)
+	})
+
+	t.Run("Success - DCQL query", func(t *testing.T) {
+		oidc4VPSvc := NewMockOIDC4VPService(gomock.NewController(t))
+		oidc4VPSvc.EXPECT().InitiateOidcInteraction(gomock.Any(), gomock.Any(), "", nil, "", adHocProfile).
+			DoAndReturn(func(
+				_ context.Context,
+				pd *presexch.PresentationDefinition,
+				_ string,
+				_ []string,
+				_ string,
+				_ *profileapi.Verifier,
+			) (*oidc4vp.InteractionInfo, error) {
+				require.Len(t, pd.InputDescriptors, 1)
+				require.Equal(t, "employee", pd.InputDescriptors[0].ID)
+				require.Len(t, pd.InputDescriptors[0].Constraints.Fields, 2)
+
+				return &oidc4vp.InteractionInfo{TxID: "txID"}, nil
+			})
+
+		controller := NewController(&Config{
+			ProfileSvc:    mockProfileSvc,
+			KMSRegistry:   kmsRegistry,
+			OIDCVPService: oidc4VPSvc,
+		})
+
+		result, err := controller.initiateOidcInteraction(context.TODO(), &InitiateOIDC4VPData{
+			DcqlQuery: &DCQLQuery{
+				Credentials: []DCQLCredentialQuery{
+					{
+						Id:     "employee",
+						Format: "jwt_vc_json",
+						Meta:   &DCQLCredentialMeta{TypeValues: &[][]string{{"VerifiedEmployee"}}},
+						Claims: &[]DCQLClaimsQuery{
+							{Path: []interface{}{"credentialSubject", "jobTitle"}},
+						},
+					},
+				},
+			},
+		}, adHocProfile)
+
+		require.NoError(t, err)
+		require.Equal(t, "txID", result.TxID)
+	})
+
+	t.Run("Error - ad-hoc presentation definition", func(t *testing.T) {
+		controller := NewController(&Config{
+			ProfileSvc:    mockProfileSvc,
+			KMSRegistry:   kmsRegistry,
+			OIDCVPService: oidc4VPSvc,
+		})
+
+		_, err := controller.initiateOidcInteraction(context.TODO(), &InitiateOIDC4VPData{
+			PresentationDefinition:   &adHocPD,
+			PresentationDefinitionId: lo.ToPtr("ad-hoc"),
+		}, adHocProfile)
+		requireValidationError(t, resterr.InvalidValue, "presentationDefinition", err)
+
+		_, err = controller.initiateOidcInteraction(context.TODO(), &InitiateOIDC4VPData{
+			PresentationDefinition: &adHocPD,
+		}, &profileapi.Verifier{
+			OIDCConfig: &profileapi.OIDC4VPConfig{},
+		})
+		requireValidationError(t, resterr.ConditionNotMet, "presentationDefinition", err)
+
+		_, err = controller.initiateOidcInteraction(context.TODO(), &InitiateOIDC4VPData{
+			PresentationDefinition: &map[string]interface{}{"id": "ad-hoc", "input_descriptors": "invalid"},
+		}, adHocProfile)
+		requireValidationError(t, resterr.InvalidValue, "presentationDefinition", err)
+
+		_, err = controller.initiateOidcInteraction(context.TODO(), &InitiateOIDC4VPData{
+			PresentationDefinition: &map[string]interface{}{"id": "ad-hoc"},
+		}, adHocProfile)
+		requireValidationError(t, resterr.InvalidValue, "presentationDefinition", err)
+
+		_, err = controller.initiateOidcInteraction(context.TODO(), &InitiateOIDC4VPData{
+			DcqlQuery: &DCQLQuery{
+				Credentials: []DCQLCredentialQuery{
+					{
+						Id:     "employee",
+						Format: "jwt_vc_json",
+						Claims: &[]DCQLClaimsQuery{
+							{Path: []interface{}{"evidence", "verifier"}},
+						},
+					},
+				},
+			},
+		}, adHocProfile)
+		requireValidationError(t, resterr.InvalidValue, "dcqlQuery", err)
+		require.ErrorContains(t, err, `field path "$.evidence.verifier" is not allowed`)
+
+		_, err = controller.initiateOidcInteraction(context.TODO(), &InitiateOIDC4VPData{
+			DcqlQuery: &DCQLQuery{
+				Credentials: []DCQLCredentialQuery{{Id: "employee", Format: "mso_mdoc"}},
+			},
+		}, adHocProfile)
+		requireValidationError(t, resterr.InvalidValue, "dcqlQuery", err)
+	})
+}
+
+func TestPresentationDefinitionFromDCQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		pd, err := presentationDefinitionFromDCQL(&DCQLQuery{
+			Credentials: []DCQLCredentialQuery{
+				{
+					Id:     "pid",
+					Format: "vc+sd-jwt",
+					Meta:   &DCQLCredentialMeta{VctValues: &[]string{"https://credentials.example.com/identity"}},
+					Claims: &[]DCQLClaimsQuery{
+						{Id: lo.ToPtr("name"), Path: []interface{}{"given_name"}, Values: &[]interface{}{"John"}},
+						{Path: []interface{}{"addresses", nil, "street address"}},
+						{Path: []interface{}{"nationalities", float64(0)}},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, pd.ID)
+		require.Len(t, pd.InputDescriptors, 1)
+
+		fields := pd.InputDescriptors[0].Constraints.Fields
+		require.Len(t, fields, 4)
+		require.Equal(t, []string{"$.vct"}, fields[0].Path)
+		require.Equal(t, []presexch.StrOrInt{"https://credentials.example.com/identity"}, fields[0].Filter.Enum)
+		require.Equal(t, "name", fields[1].ID)
+		require.Equal(t, []string{"$.given_name"}, fields[1].Path)
+		require.Equal(t, []presexch.StrOrInt{"John"}, fields[1].Filter.Enum)
+		require.Equal(t, []string{"$.addresses[*]['street address']"}, fields[2].Path)
+		require.Equal(t, []string{"$.nationalities[0]"}, fields[3].Path)
+		require.NoError(t, pd.ValidateSchema())
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			query *DCQLQuery
+			err   string
+		}{
+			{
+				name:  "no credentials",
+				query: &DCQLQuery{},
+				err:   "at least one credential query is required",
+			},
+			{
+				name: "duplicate id",
+				query: &DCQLQuery{Credentials: []DCQLCredentialQuery{
+					{Id: "a", Format: "ldp_vc"}, {Id: "a", Format: "ldp_vc"},
+				}},
+				err: `duplicate credential query id "a"`,
+			},
+			{
+				name: "multiple type alternatives",
+				query: &DCQLQuery{Credentials: []DCQLCredentialQuery{{
+					Id: "a", Format: "ldp_vc", Meta: &DCQLCredentialMeta{TypeValues: &[][]string{{"A"}, {"B"}}},
+				}}},
+				err: "multiple type_values alternatives are not supported",
+			},
+			{
+				name: "vct for w3c credential",
+				query: &DCQLQuery{Credentials: []DCQLCredentialQuery{{
+					Id: "a", Format: "jwt_vc_json", Meta: &DCQLCredentialMeta{VctValues: &[]string{"A"}},
+				}}},
+				err: `vct_values are not supported for format "jwt_vc_json"`,
+			},
+			{
+				name: "invalid path",
+				query: &DCQLQuery{Credentials: []DCQLCredentialQuery{{
+					Id: "a", Format: "ldp_vc", Claims: &[]DCQLClaimsQuery{{Path: []interface{}{"a", -1.0}}},
+				}}},
+				err: "invalid array index -1",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := presentationDefinitionFromDCQL(tt.query)
+				require.ErrorContains(t, err, tt.err)
+			})
+		}
+	})
 }
 
 func TestMatchField(t *testing.T) {
@@ -0,0 +1,200 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifier
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/trustbloc/vc-go/presexch"
+)
+
+// DCQL credential formats.
+const (
+	dcqlFormatJWTVCJSON   = "jwt_vc_json"
+	dcqlFormatJWTVCJSONLD = "jwt_vc_json-ld"
+	dcqlFormatLDPVC       = "ldp_vc"
+	dcqlFormatVCSDJWT     = "vc+sd-jwt"
+	dcqlFormatDCSDJWT     = "dc+sd-jwt"
+)
+
+var claimNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// presentationDefinitionFromDCQL converts the DCQL query to a presentation definition. Each credential query
+// becomes an input descriptor with constraints fields derived from the meta and the claims of the query.
+// Credential sets and claim sets are not supported.
+func presentationDefinitionFromDCQL(query *DCQLQuery) (*presexch.PresentationDefinition, error) {
+	if len(query.Credentials) == 0 {
+		return nil, errors.New("at least one credential query is required")
+	}
+
+	pd := &presexch.PresentationDefinition{
+		ID: uuid.NewString(),
+	}
+
+	ids := map[string]struct{}{}
+
+	for i := range query.Credentials {
+		cq := &query.Credentials[i]
+
+		if cq.Id == "" {
+			return nil, errors.New("credential query id is required")
+		}
+
+		if _, ok := ids[cq.Id]; ok {
+			return nil, fmt.Errorf("duplicate credential query id %q", cq.Id)
+		}
+
+		ids[cq.Id] = struct{}{}
+
+		fields, err := dcqlConstraintsFields(cq)
+		if err != nil {
+			return nil, fmt.Errorf("credential query %q: %w", cq.Id, err)
+		}
+
+		pd.InputDescriptors = append(pd.InputDescriptors, &presexch.InputDescriptor{
+			ID:          cq.Id,
+			Constraints: &presexch.Constraints{Fields: fields},
+		})
+	}
+
+	return pd, nil
+}
+
+func dcqlConstraintsFields(cq *DCQLCredentialQuery) ([]*presexch.Field, error) {
+	var prefixes []string
+
+	switch cq.Format {
+	case dcqlFormatJWTVCJSON, dcqlFormatJWTVCJSONLD:
+		prefixes = []string{"$", "$.vc"}
+	case dcqlFormatLDPVC, dcqlFormatVCSDJWT, dcqlFormatDCSDJWT:
+		prefixes = []string{"$"}
+	default:
+		return nil, fmt.Errorf("unsupported format %q", cq.Format)
+	}
+
+	var fields []*presexch.Field
+
+	if cq.Meta != nil {
+		typeFields, err := dcqlMetaFields(cq.Format, cq.Meta, prefixes)
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, typeFields...)
+	}
+
+	for _, claim := range lo.FromPtr(cq.Claims) {
+		path, err := claimsPathToJSONPath(claim.Path)
+		if err != nil {
+			return nil, fmt.Errorf("claim %q: %w", lo.FromPtr(claim.Id), err)
+		}
+
+		field := &presexch.Field{
+			ID: lo.FromPtr(claim.Id),
+			Path: lo.Map(prefixes, func(prefix string, _ int) string {
+				return prefix + path
+			}),
+		}
+
+		if claim.Values != nil {
+			field.Filter = &presexch.Filter{
+				Enum: lo.Map(*claim.Values, func(v interface{}, _ int) presexch.StrOrInt {
+					return v
+				}),
+			}
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+func dcqlMetaFields(format string, meta *DCQLCredentialMeta, prefixes []string) ([]*presexch.Field, error) {
+	var fields []*presexch.Field
+
+	if vctValues := lo.FromPtr(meta.VctValues); len(vctValues) > 0 {
+		if format != dcqlFormatVCSDJWT && format != dcqlFormatDCSDJWT {
+			return nil, fmt.Errorf("vct_values are not supported for format %q", format)
+		}
+
+		fields = append(fields, &presexch.Field{
+			Path: []string{"$.vct"},
+			Filter: &presexch.Filter{
+				Type: lo.ToPtr("string"),
+				Enum: lo.Map(vctValues, func(v string, _ int) presexch.StrOrInt {
+					return v
+				}),
+			},
+		})
+	}
+
+	typeValues := lo.FromPtr(meta.TypeValues)
+
+	switch {
+	case len(typeValues) == 0:
+		return fields, nil
+	case len(typeValues) > 1:
+		return nil, errors.New("multiple type_values alternatives are not supported")
+	}
+
+	for _, t := range typeValues[0] {
+		fields = append(fields, &presexch.Field{
+			Path: lo.Map(prefixes, func(prefix string, _ int) string {
+				return prefix + ".type"
+			}),
+			Filter: &presexch.Filter{
+				Type: lo.ToPtr("array"),
+				Contains: map[string]interface{}{
+					"type":  "string",
+					"const": t,
+				},
+			},
+		})
+	}
+
+	return fields, nil
+}
+
+// claimsPathToJSONPath converts the DCQL claims path pointer to a JSONPath expression without the root.
+func claimsPathToJSONPath(path []interface{}) (string, error) {
+	if len(path) == 0 {
+		return "", errors.New("path is empty")
+	}
+
+	var sb strings.Builder
+
+	for _, element := range path {
+		switch v := element.(type) {
+		case nil:
+			sb.WriteString("[*]")
+		case string:
+			if claimNameRegex.MatchString(v) {
+				sb.WriteString("." + v)
+			} else {
+				sb.WriteString("['" + strings.ReplaceAll(v, "'", `\'`) + "']")
+			}
+		case float64:
+			if v < 0 || v != math.Trunc(v) {
+				return "", fmt.Errorf("invalid array index %v", v)
+			}
+
+			sb.WriteString("[" + strconv.FormatInt(int64(v), 10) + "]")
+		default:
+			return "", fmt.Errorf("invalid path element %v", v)
+		}
+	}
+
+	return sb.String(), nil
+}
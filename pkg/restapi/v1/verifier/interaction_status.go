@@ -0,0 +1,248 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifier
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/trustbloc/logutil-go/pkg/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/trustbloc/vcs/pkg/restapi/resterr"
+	"github.com/trustbloc/vcs/pkg/restapi/v1/util"
+	"github.com/trustbloc/vcs/pkg/service/oidc4vp"
+)
+
+const (
+	defaultStatusPollInterval   = time.Second
+	defaultStatusStreamTimeout  = 5 * time.Minute
+	defaultStatusStreamTokenTTL = time.Minute
+
+	streamTokenSize = 32
+
+	statusEventName = "status"
+)
+
+// GetInteractionStatus returns the current status of oidc4vp interaction.
+// GET /verifier/interactions/{txID}/status.
+func (c *Controller) GetInteractionStatus(e echo.Context, txID string) error {
+	ctx, span := c.tracer.Start(e.Request().Context(), "GetInteractionStatus")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("tx_id", txID))
+
+	tx, err := c.accessInteraction(ctx, e, txID)
+	if err != nil {
+		return err
+	}
+
+	return util.WriteOutput(e)(interactionStatusResponse(tx), nil)
+}
+
+// CreateInteractionStatusStreamToken issues a short-lived token, which authorizes subscription to
+// status changes of oidc4vp interaction. Browser EventSource clients cannot set API key and tenant
+// headers, so the token is passed in the query of the stream request instead.
+// POST /verifier/interactions/{txID}/status/stream-token.
+func (c *Controller) CreateInteractionStatusStreamToken(e echo.Context, txID string) error {
+	ctx, span := c.tracer.Start(e.Request().Context(), "CreateInteractionStatusStreamToken")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("tx_id", txID))
+
+	tx, err := c.accessInteraction(ctx, e, txID)
+	if err != nil {
+		return err
+	}
+
+	tokenBytes := make([]byte, streamTokenSize)
+
+	if _, err = rand.Read(tokenBytes); err != nil {
+		return resterr.NewSystemError(resterr.VerifierOIDC4vpSvcComponent, "CreateStreamToken",
+			fmt.Errorf("generate stream token: %w", err))
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(tokenBytes)
+	expireAt := time.Now().UTC().Add(c.streamTokenTTL)
+
+	if err = c.streamTokenStore.Create(ctx, &oidc4vp.StatusStreamToken{
+		ID:       hashStreamToken(token),
+		TxID:     tx.ID,
+		ExpireAt: expireAt,
+	}); err != nil {
+		return resterr.NewSystemError(resterr.VerifierOIDC4vpSvcComponent, "CreateStreamToken", err)
+	}
+
+	return util.WriteOutput(e)(&InteractionStatusStreamTokenResponse{
+		Token:     token,
+		ExpiresAt: expireAt,
+	}, nil)
+}
+
+// StreamInteractionStatus streams status changes of oidc4vp interaction as server-sent events.
+// The request is authorized with the stream token issued for the interaction. The stream is closed
+// when the interaction reaches a final state, the stream timeout is exceeded or the client disconnects.
+// GET /verifier/interactions/{txID}/status/events.
+func (c *Controller) StreamInteractionStatus(e echo.Context, txID string, params StreamInteractionStatusParams) error {
+	ctx, span := c.tracer.Start(e.Request().Context(), "StreamInteractionStatus")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("tx_id", txID))
+
+	if err := c.checkStreamToken(ctx, txID, params.Token); err != nil {
+		return err
+	}
+
+	tx, err := c.accessOIDC4VPTx(ctx, txID)
+	if err != nil {
+		return err
+	}
+
+	resp := e.Response()
+	resp.Header().Set(echo.HeaderContentType, "text/event-stream")
+	resp.Header().Set(echo.HeaderCacheControl, "no-cache")
+	resp.Header().Set(echo.HeaderConnection, "keep-alive")
+	resp.WriteHeader(http.StatusOK)
+
+	ctx, cancel := context.WithTimeout(ctx, c.statusStreamTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(c.statusPollInterval)
+	defer ticker.Stop()
+
+	var last *InteractionStatusResponse
+
+	for {
+		status := interactionStatusResponse(tx)
+
+		if last == nil || !sameInteractionStatus(last, status) {
+			if err = writeStatusEvent(resp, status); err != nil {
+				logger.Debugc(ctx, "StreamInteractionStatus failed to write event",
+					log.WithTxID(txID), log.WithError(err))
+
+				return nil
+			}
+
+			last = status
+		}
+
+		if tx.Status.IsFinal() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		tx, err = c.oidc4VPService.GetTx(ctx, oidc4vp.TxID(txID))
+		if err != nil {
+			if !errors.Is(err, oidc4vp.ErrDataNotFound) {
+				logger.Warnc(ctx, "StreamInteractionStatus failed to get tx", log.WithTxID(txID), log.WithError(err))
+			}
+
+			return nil
+		}
+	}
+}
+
+func (c *Controller) accessInteraction(
+	ctx context.Context,
+	e echo.Context,
+	txID string,
+) (*oidc4vp.Transaction, error) {
+	tenantID, err := util.GetTenantIDFromRequest(e)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := c.accessOIDC4VPTx(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = c.accessProfile(tx.ProfileID, tx.ProfileVersion, tenantID); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// checkStreamToken checks that the stream token is issued for the given transaction and is not expired.
+func (c *Controller) checkStreamToken(ctx context.Context, txID, token string) error {
+	if token == "" {
+		return resterr.NewUnauthorizedError(errors.New("missing stream token"))
+	}
+
+	streamToken, err := c.streamTokenStore.Get(ctx, hashStreamToken(token))
+	if err != nil {
+		if errors.Is(err, oidc4vp.ErrDataNotFound) {
+			return resterr.NewUnauthorizedError(errors.New("invalid or expired stream token"))
+		}
+
+		return resterr.NewSystemError(resterr.VerifierOIDC4vpSvcComponent, "GetStreamToken", err)
+	}
+
+	if string(streamToken.TxID) != txID {
+		return resterr.NewUnauthorizedError(errors.New("stream token is issued for another transaction"))
+	}
+
+	return nil
+}
+
+func hashStreamToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+
+	return base64.RawURLEncoding.EncodeToString(h[:])
+}
+
+func interactionStatusResponse(tx *oidc4vp.Transaction) *InteractionStatusResponse {
+	resp := &InteractionStatusResponse{
+		TxID: string(tx.ID),
+	}
+
+	if tx.Status == nil {
+		return resp
+	}
+
+	resp.State = string(tx.Status.State)
+	resp.ErrorCode = lo.EmptyableToPtr(tx.Status.ErrorCode)
+	resp.ErrorComponent = lo.EmptyableToPtr(tx.Status.ErrorComponent)
+	resp.Error = lo.EmptyableToPtr(tx.Status.Error)
+	resp.UpdatedAt = lo.EmptyableToPtr(tx.Status.UpdatedAt)
+
+	return resp
+}
+
+func sameInteractionStatus(a, b *InteractionStatusResponse) bool {
+	return a.State == b.State && lo.FromPtr(a.UpdatedAt).Equal(lo.FromPtr(b.UpdatedAt))
+}
+
+func writeStatusEvent(resp *echo.Response, status *InteractionStatusResponse) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("marshal status: %w", err)
+	}
+
+	if _, err = fmt.Fprintf(resp, "event: %s\ndata: %s\n\n", statusEventName, data); err != nil {
+		return fmt.Errorf("write event: %w", err)
+	}
+
+	resp.Flush()
+
+	return nil
+}
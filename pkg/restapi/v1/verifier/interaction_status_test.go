@@ -0,0 +1,427 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifier
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/restapi/resterr"
+	"github.com/trustbloc/vcs/pkg/service/oidc4vp"
+)
+
+func TestController_GetInteractionStatus(t *testing.T) {
+	updatedAt := time.Now().UTC()
+
+	t.Run("Success", func(t *testing.T) {
+		oidc4VPService := NewMockOIDC4VPService(gomock.NewController(t))
+		oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).Return(&oidc4vp.Transaction{
+			ID:             "txid",
+			ProfileID:      "p1",
+			ProfileVersion: "v1.0",
+			Status: &oidc4vp.InteractionStatus{
+				State:          oidc4vp.InteractionStateFailed,
+				ErrorCode:      "invalid-value",
+				ErrorComponent: "verifier.oidc4vp-service",
+				Error:          "some error",
+				UpdatedAt:      updatedAt,
+			},
+		}, nil)
+
+		c := NewController(&Config{
+			OIDCVPService: oidc4VPService,
+			ProfileSvc:    profileServiceForOrg(t, "orgID1"),
+			Tracer:        trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		ctx := createContext("orgID1")
+
+		require.NoError(t, c.GetInteractionStatus(ctx, "txid"))
+
+		var resp InteractionStatusResponse
+
+		require.NoError(t, json.Unmarshal(ctx.Response().Writer.(*httptest.ResponseRecorder).Body.Bytes(), &resp))
+		require.Equal(t, "txid", resp.TxID)
+		require.Equal(t, "failed", resp.State)
+		require.Equal(t, "invalid-value", *resp.ErrorCode)
+		require.Equal(t, "verifier.oidc4vp-service", *resp.ErrorComponent)
+		require.Equal(t, "some error", *resp.Error)
+		require.True(t, updatedAt.Equal(*resp.UpdatedAt))
+	})
+
+	t.Run("No status yet", func(t *testing.T) {
+		oidc4VPService := NewMockOIDC4VPService(gomock.NewController(t))
+		oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).Return(&oidc4vp.Transaction{
+			ID:             "txid",
+			ProfileID:      "p1",
+			ProfileVersion: "v1.0",
+		}, nil)
+
+		c := NewController(&Config{
+			OIDCVPService: oidc4VPService,
+			ProfileSvc:    profileServiceForOrg(t, "orgID1"),
+			Tracer:        trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		ctx := createContext("orgID1")
+
+		require.NoError(t, c.GetInteractionStatus(ctx, "txid"))
+
+		var resp InteractionStatusResponse
+
+		require.NoError(t, json.Unmarshal(ctx.Response().Writer.(*httptest.ResponseRecorder).Body.Bytes(), &resp))
+		require.Equal(t, "txid", resp.TxID)
+		require.Empty(t, resp.State)
+		require.Nil(t, resp.UpdatedAt)
+	})
+
+	t.Run("Missing tenant ID", func(t *testing.T) {
+		c := NewController(&Config{
+			Tracer: trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		require.ErrorContains(t, c.GetInteractionStatus(createContext(""), "txid"), "missing authorization")
+	})
+
+	t.Run("Tx not found", func(t *testing.T) {
+		oidc4VPService := NewMockOIDC4VPService(gomock.NewController(t))
+		oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).Return(nil, oidc4vp.ErrDataNotFound)
+
+		c := NewController(&Config{
+			OIDCVPService: oidc4VPService,
+			Tracer:        trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.GetInteractionStatus(createContext("orgID1"), "txid")
+		requireCustomError(t, resterr.TransactionNotFound, err)
+	})
+
+	t.Run("Profile of other organization", func(t *testing.T) {
+		oidc4VPService := NewMockOIDC4VPService(gomock.NewController(t))
+		oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).Return(&oidc4vp.Transaction{
+			ID:             "txid",
+			ProfileID:      "p1",
+			ProfileVersion: "v1.0",
+		}, nil)
+
+		c := NewController(&Config{
+			OIDCVPService: oidc4VPService,
+			ProfileSvc:    profileServiceForOrg(t, "orgID2"),
+			Tracer:        trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.GetInteractionStatus(createContext("orgID1"), "txid")
+		requireCustomError(t, resterr.ProfileNotFound, err)
+	})
+}
+
+func TestController_StreamInteractionStatus(t *testing.T) {
+	t.Run("Stream until final state", func(t *testing.T) {
+		tx := func(state oidc4vp.InteractionState, updatedAt time.Time) *oidc4vp.Transaction {
+			return &oidc4vp.Transaction{
+				ID:             "txid",
+				ProfileID:      "p1",
+				ProfileVersion: "v1.0",
+				Status: &oidc4vp.InteractionStatus{
+					State:     state,
+					UpdatedAt: updatedAt,
+				},
+			}
+		}
+
+		now := time.Now().UTC()
+
+		oidc4VPService := NewMockOIDC4VPService(gomock.NewController(t))
+		gomock.InOrder(
+			oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).
+				Return(tx(oidc4vp.InteractionStateInitiated, now), nil),
+			oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).
+				Return(tx(oidc4vp.InteractionStateInitiated, now), nil),
+			oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).
+				Return(tx(oidc4vp.InteractionStateRequestObjectFetched, now.Add(time.Second)), nil),
+			oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).
+				Return(tx(oidc4vp.InteractionStateSucceeded, now.Add(2*time.Second)), nil),
+		)
+
+		c := NewController(&Config{
+			OIDCVPService:          oidc4VPService,
+			StatusStreamTokenStore: streamTokenStoreForTx(t, "txid"),
+			Tracer:                 trace.NewNoopTracerProvider().Tracer(""),
+			StatusPollInterval:     time.Millisecond,
+		})
+
+		ctx := createContext("orgID1")
+
+		require.NoError(t, c.StreamInteractionStatus(ctx, "txid", StreamInteractionStatusParams{Token: "token"}))
+
+		rec := ctx.Response().Writer.(*httptest.ResponseRecorder) //nolint:errcheck
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+
+		events := readStatusEvents(t, rec.Body.String())
+		require.Len(t, events, 3)
+		require.Equal(t, "initiated", events[0].State)
+		require.Equal(t, "request-object-fetched", events[1].State)
+		require.Equal(t, "succeeded", events[2].State)
+	})
+
+	t.Run("Tx expired while streaming", func(t *testing.T) {
+		oidc4VPService := NewMockOIDC4VPService(gomock.NewController(t))
+		gomock.InOrder(
+			oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).Return(&oidc4vp.Transaction{
+				ID:             "txid",
+				ProfileID:      "p1",
+				ProfileVersion: "v1.0",
+			}, nil),
+			oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).Return(nil, oidc4vp.ErrDataNotFound),
+		)
+
+		c := NewController(&Config{
+			OIDCVPService:          oidc4VPService,
+			StatusStreamTokenStore: streamTokenStoreForTx(t, "txid"),
+			Tracer:                 trace.NewNoopTracerProvider().Tracer(""),
+			StatusPollInterval:     time.Millisecond,
+		})
+
+		ctx := createContext("orgID1")
+
+		require.NoError(t, c.StreamInteractionStatus(ctx, "txid", StreamInteractionStatusParams{Token: "token"}))
+
+		events := readStatusEvents(t, ctx.Response().Writer.(*httptest.ResponseRecorder).Body.String())
+		require.Len(t, events, 1)
+		require.Empty(t, events[0].State)
+	})
+
+	t.Run("Stream timeout", func(t *testing.T) {
+		oidc4VPService := NewMockOIDC4VPService(gomock.NewController(t))
+		oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).Return(&oidc4vp.Transaction{
+			ID:             "txid",
+			ProfileID:      "p1",
+			ProfileVersion: "v1.0",
+		}, nil)
+
+		c := NewController(&Config{
+			OIDCVPService:          oidc4VPService,
+			StatusStreamTokenStore: streamTokenStoreForTx(t, "txid"),
+			Tracer:                 trace.NewNoopTracerProvider().Tracer(""),
+			StatusPollInterval:     time.Hour,
+			StatusStreamTimeout:    time.Millisecond,
+		})
+
+		require.NoError(t, c.StreamInteractionStatus(createContext("orgID1"), "txid", StreamInteractionStatusParams{Token: "token"}))
+	})
+
+	t.Run("Get tx error", func(t *testing.T) {
+		oidc4VPService := NewMockOIDC4VPService(gomock.NewController(t))
+		oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).Return(nil, errors.New("get tx error"))
+
+		c := NewController(&Config{
+			OIDCVPService:          oidc4VPService,
+			Tracer:                 trace.NewNoopTracerProvider().Tracer(""),
+			StatusStreamTokenStore: streamTokenStoreForTx(t, "txid"),
+		})
+
+		err := c.StreamInteractionStatus(createContext("orgID1"), "txid", StreamInteractionStatusParams{Token: "token"})
+		require.ErrorContains(t, err, "get tx error")
+	})
+
+	t.Run("Missing stream token", func(t *testing.T) {
+		c := NewController(&Config{
+			Tracer: trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.StreamInteractionStatus(createContext(""), "txid", StreamInteractionStatusParams{})
+		requireCustomError(t, resterr.Unauthorized, err)
+		require.ErrorContains(t, err, "missing stream token")
+	})
+
+	t.Run("Invalid stream token", func(t *testing.T) {
+		store := NewMockStatusStreamTokenStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), hashStreamToken("token")).Return(nil, oidc4vp.ErrDataNotFound)
+
+		c := NewController(&Config{
+			Tracer:                 trace.NewNoopTracerProvider().Tracer(""),
+			StatusStreamTokenStore: store,
+		})
+
+		err := c.StreamInteractionStatus(createContext(""), "txid", StreamInteractionStatusParams{Token: "token"})
+		requireCustomError(t, resterr.Unauthorized, err)
+		require.ErrorContains(t, err, "invalid or expired stream token")
+	})
+
+	t.Run("Stream token of other transaction", func(t *testing.T) {
+		c := NewController(&Config{
+			Tracer:                 trace.NewNoopTracerProvider().Tracer(""),
+			StatusStreamTokenStore: streamTokenStoreForTx(t, "other-txid"),
+		})
+
+		err := c.StreamInteractionStatus(createContext(""), "txid", StreamInteractionStatusParams{Token: "token"})
+		requireCustomError(t, resterr.Unauthorized, err)
+		require.ErrorContains(t, err, "stream token is issued for another transaction")
+	})
+
+	t.Run("Get stream token error", func(t *testing.T) {
+		store := NewMockStatusStreamTokenStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("store error"))
+
+		c := NewController(&Config{
+			Tracer:                 trace.NewNoopTracerProvider().Tracer(""),
+			StatusStreamTokenStore: store,
+		})
+
+		err := c.StreamInteractionStatus(createContext(""), "txid", StreamInteractionStatusParams{Token: "token"})
+		require.ErrorContains(t, err, "store error")
+	})
+}
+
+func TestController_CreateInteractionStatusStreamToken(t *testing.T) {
+	tx := &oidc4vp.Transaction{
+		ID:             "txid",
+		ProfileID:      "p1",
+		ProfileVersion: "v1.0",
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		oidc4VPService := NewMockOIDC4VPService(gomock.NewController(t))
+		oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).Return(tx, nil)
+
+		var stored *oidc4vp.StatusStreamToken
+
+		store := NewMockStatusStreamTokenStore(gomock.NewController(t))
+		store.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, token *oidc4vp.StatusStreamToken) error {
+				stored = token
+
+				return nil
+			})
+
+		c := NewController(&Config{
+			OIDCVPService:          oidc4VPService,
+			ProfileSvc:             profileServiceForOrg(t, "orgID1"),
+			Tracer:                 trace.NewNoopTracerProvider().Tracer(""),
+			StatusStreamTokenStore: store,
+			StatusStreamTokenTTL:   2 * time.Minute,
+		})
+
+		ctx := createContext("orgID1")
+
+		require.NoError(t, c.CreateInteractionStatusStreamToken(ctx, "txid"))
+
+		var resp InteractionStatusStreamTokenResponse
+
+		require.NoError(t, json.Unmarshal(ctx.Response().Writer.(*httptest.ResponseRecorder).Body.Bytes(), &resp))
+		require.NotEmpty(t, resp.Token)
+		require.WithinDuration(t, time.Now().Add(2*time.Minute), resp.ExpiresAt, time.Minute)
+
+		require.Equal(t, hashStreamToken(resp.Token), stored.ID)
+		require.NotEqual(t, resp.Token, stored.ID)
+		require.Equal(t, oidc4vp.TxID("txid"), stored.TxID)
+		require.True(t, resp.ExpiresAt.Equal(stored.ExpireAt))
+	})
+
+	t.Run("Missing tenant ID", func(t *testing.T) {
+		c := NewController(&Config{
+			Tracer: trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.CreateInteractionStatusStreamToken(createContext(""), "txid")
+		require.ErrorContains(t, err, "missing authorization")
+	})
+
+	t.Run("Profile of other organization", func(t *testing.T) {
+		oidc4VPService := NewMockOIDC4VPService(gomock.NewController(t))
+		oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).Return(tx, nil)
+
+		c := NewController(&Config{
+			OIDCVPService: oidc4VPService,
+			ProfileSvc:    profileServiceForOrg(t, "orgID2"),
+			Tracer:        trace.NewNoopTracerProvider().Tracer(""),
+		})
+
+		err := c.CreateInteractionStatusStreamToken(createContext("orgID1"), "txid")
+		requireCustomError(t, resterr.ProfileNotFound, err)
+	})
+
+	t.Run("Store error", func(t *testing.T) {
+		oidc4VPService := NewMockOIDC4VPService(gomock.NewController(t))
+		oidc4VPService.EXPECT().GetTx(gomock.Any(), oidc4vp.TxID("txid")).Return(tx, nil)
+
+		store := NewMockStatusStreamTokenStore(gomock.NewController(t))
+		store.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("store error"))
+
+		c := NewController(&Config{
+			OIDCVPService:          oidc4VPService,
+			ProfileSvc:             profileServiceForOrg(t, "orgID1"),
+			Tracer:                 trace.NewNoopTracerProvider().Tracer(""),
+			StatusStreamTokenStore: store,
+		})
+
+		err := c.CreateInteractionStatusStreamToken(createContext("orgID1"), "txid")
+		require.ErrorContains(t, err, "store error")
+	})
+}
+
+func streamTokenStoreForTx(t *testing.T, txID string) *MockStatusStreamTokenStore {
+	t.Helper()
+
+	store := NewMockStatusStreamTokenStore(gomock.NewController(t))
+	store.EXPECT().Get(gomock.Any(), hashStreamToken("token")).AnyTimes().Return(&oidc4vp.StatusStreamToken{
+		ID:       hashStreamToken("token"),
+		TxID:     oidc4vp.TxID(txID),
+		ExpireAt: time.Now().Add(time.Minute),
+	}, nil)
+
+	return store
+}
+
+func profileServiceForOrg(t *testing.T, orgID string) *MockProfileService {
+	t.Helper()
+
+	profileSvc := NewMockProfileService(gomock.NewController(t))
+	profileSvc.EXPECT().GetProfile("p1", "v1.0").AnyTimes().Return(&profileapi.Verifier{
+		ID:             "p1",
+		Version:        "v1.0",
+		OrganizationID: orgID,
+	}, nil)
+
+	return profileSvc
+}
+
+func readStatusEvents(t *testing.T, body string) []*InteractionStatusResponse {
+	t.Helper()
+
+	var events []*InteractionStatusResponse
+
+	scanner := bufio.NewScanner(strings.NewReader(body))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+
+		event := &InteractionStatusResponse{}
+		require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), event))
+
+		events = append(events, event)
+	}
+
+	return events
+}
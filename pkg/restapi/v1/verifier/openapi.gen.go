@@ -6,17 +6,83 @@ package verifier
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/labstack/echo/v4"
 )
 
+// Defines values for QRErrorCorrection.
+const (
+	H QRErrorCorrection = "H"
+	L QRErrorCorrection = "L"
+	M QRErrorCorrection = "M"
+	Q QRErrorCorrection = "Q"
+)
+
+// Defines values for QRFormat.
+const (
+	Png QRFormat = "png"
+	Svg QRFormat = "svg"
+)
+
+// Returned to the wallet in the same-device flow.
+type AuthorizationResponseRedirect struct {
+	// URI with the one-time response_code the wallet redirects the user to.
+	RedirectUri string `json:"redirect_uri"`
+}
+
+// DCQLClaimsQuery defines model for DCQLClaimsQuery.
+type DCQLClaimsQuery struct {
+	Id *string `json:"id,omitempty"`
+
+	// Claims path pointer. Elements are claim names, array indices or null to select all array elements.
+	Path []interface{} `json:"path"`
+
+	// Allowed values of the claim.
+	Values *[]interface{} `json:"values,omitempty"`
+}
+
+// DCQLCredentialMeta defines model for DCQLCredentialMeta.
+type DCQLCredentialMeta struct {
+	// Alternative sets of credential types, a credential must have all types of one of the sets.
+	TypeValues *[][]string `json:"type_values,omitempty"`
+
+	// Allowed vct values of SD-JWT credentials.
+	VctValues *[]string `json:"vct_values,omitempty"`
+}
+
+// DCQLCredentialQuery defines model for DCQLCredentialQuery.
+type DCQLCredentialQuery struct {
+	Claims *[]DCQLClaimsQuery `json:"claims,omitempty"`
+
+	// Format of the requested credential, e.g. jwt_vc_json, ldp_vc or vc+sd-jwt.
+	Format string `json:"format"`
+
+	// Identifier of the credential query, used as an input descriptor ID.
+	Id   string              `json:"id"`
+	Meta *DCQLCredentialMeta `json:"meta,omitempty"`
+}
+
+// Digital Credentials Query Language query. It is converted to an ad-hoc presentation definition.
+type DCQLQuery struct {
+	Credentials []DCQLCredentialQuery `json:"credentials"`
+}
+
 // InitiateOIDC4VPData defines model for InitiateOIDC4VPData.
 type InitiateOIDC4VPData struct {
+	// Digital Credentials Query Language query. It is converted to an ad-hoc presentation definition.
+	DcqlQuery *DCQLQuery `json:"dcqlQuery,omitempty"`
+
+	// Ad-hoc presentation definition used instead of the one configured in the profile. Input descriptor constraints are restricted by the allow-list of the profile.
+	PresentationDefinition        *map[string]interface{}        `json:"presentationDefinition,omitempty"`
 	PresentationDefinitionFilters *PresentationDefinitionFilters `json:"presentationDefinitionFilters,omitempty"`
 	PresentationDefinitionId      *string                        `json:"presentationDefinitionId,omitempty"`
 	Purpose                       *string                        `json:"purpose,omitempty"`
 
+	// URI the wallet redirects the user to after the presentation (same-device flow). A one-time response_code is added to the URI, verifier exchanges it for the claims. Must match one of the redirect URIs allowed by the profile.
+	ResponseRedirectUri *string `json:"responseRedirectUri,omitempty"`
+
 	// List of custom scopes that defines additional claims requested from Holder to Verifier.
 	Scopes *[]string `json:"scopes,omitempty"`
 }
@@ -24,7 +90,74 @@ type InitiateOIDC4VPData struct {
 // InitiateOIDC4VPResponse defines model for InitiateOIDC4VPResponse.
 type InitiateOIDC4VPResponse struct {
 	AuthorizationRequest string `json:"authorizationRequest"`
-	TxID                 string `json:"txID"`
+
+	// QR code of the authorization request as a data URI. Returned if qr_format query parameter is set.
+	QrCode *string `json:"qrCode,omitempty"`
+	TxID   string  `json:"txID"`
+}
+
+// Status of oidc4vp interaction.
+type InteractionStatusResponse struct {
+	// Error message, set if the interaction failed.
+	Error *string `json:"error,omitempty"`
+
+	// Error code, set if the interaction failed.
+	ErrorCode *string `json:"errorCode,omitempty"`
+
+	// Component that caused the failure, set if the interaction failed.
+	ErrorComponent *string `json:"errorComponent,omitempty"`
+
+	// State of the interaction. Supported values are initiated, request-object-fetched, presentation-received, succeeded and failed. Empty if no status was recorded yet.
+	State string `json:"state"`
+
+	// ID of transaction.
+	TxID string `json:"txID"`
+
+	// Time of the last status change.
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// Token to subscribe to status changes of oidc4vp interaction.
+type InteractionStatusStreamTokenResponse struct {
+	// Time after which the token can no longer be used to open the stream.
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Stream token.
+	Token string `json:"token"`
+}
+
+// Archived presentation of oidc4vp interaction together with its record in the hash chain of the verifier profile archive.
+type PresentationArchiveResponse struct {
+	ArchivedAt time.Time `json:"archivedAt"`
+
+	// End of the retention period. The data key and the encrypted presentation are deleted after it.
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Base64url encoded SHA-256 hash of the record.
+	Hash string `json:"hash"`
+
+	// Base64url encoded SHA-256 digest of the encrypted presentation.
+	PayloadDigest string `json:"payloadDigest"`
+
+	// Presentation submission as received from the wallet.
+	PresentationSubmission *map[string]interface{} `json:"presentationSubmission,omitempty"`
+
+	// Hash of the previous record in the chain. Empty for the first record.
+	PrevHash       *string `json:"prevHash,omitempty"`
+	ProfileID      string  `json:"profileID"`
+	ProfileVersion string  `json:"profileVersion"`
+
+	// Position of the record in the hash chain of the verifier profile archive, starting from 1.
+	Sequence int64 `json:"sequence"`
+
+	// JWT signed by the signing DID of the verifier profile over the hash of the record.
+	Signature string `json:"signature"`
+
+	// ID of the transaction.
+	TxID string `json:"txID"`
+
+	// VP tokens as received from the wallet.
+	VpTokens []string `json:"vpTokens"`
 }
 
 // PresentationDefinitionFilters defines model for PresentationDefinitionFilters.
@@ -32,6 +165,15 @@ type PresentationDefinitionFilters struct {
 	Fields *[]string `json:"fields,omitempty"`
 }
 
+// Signed verification receipt.
+type VerificationReceiptResponse struct {
+	// ID of the receipt.
+	Id string `json:"id"`
+
+	// Verification receipt in JWT format signed by the signing DID of the verifier profile. The receipt is in the verification_receipt claim.
+	Receipt string `json:"receipt"`
+}
+
 // Verify credential response containing failure check details.
 type VerifyCredentialCheckResult struct {
 	// Check title.
@@ -40,6 +182,9 @@ type VerifyCredentialCheckResult struct {
 	// Error message.
 	Error string `json:"error"`
 
+	// Verification results of every credential proof for proof check.
+	Proofs *[]VerifyCredentialProofResult `json:"proofs,omitempty"`
+
 	// Verification method.
 	VerificationMethod string `json:"verificationMethod"`
 }
@@ -62,9 +207,33 @@ type VerifyCredentialOptions struct {
 	Domain *string `json:"domain,omitempty"`
 }
 
+// Verification result of a single credential proof.
+type VerifyCredentialProofResult struct {
+	// Cryptosuite of data integrity proof.
+	Cryptosuite *string `json:"cryptosuite,omitempty"`
+
+	// Error message. Empty for valid proof.
+	Error *string `json:"error,omitempty"`
+
+	// Proof ID.
+	Id *string `json:"id,omitempty"`
+
+	// Proof type.
+	Type string `json:"type"`
+
+	// Verification method of the proof.
+	VerificationMethod *string `json:"verificationMethod,omitempty"`
+}
+
 // Model for response of credentials verification.
 type VerifyCredentialResponse struct {
 	Checks *[]VerifyCredentialCheckResult `json:"checks,omitempty"`
+
+	// Verification receipt in JWT format signed by the signing DID of the verifier profile.
+	Receipt *string `json:"receipt,omitempty"`
+
+	// ID of the verification receipt. Set if verification receipts are enabled for the profile.
+	ReceiptId *string `json:"receiptId,omitempty"`
 }
 
 // Verify presentation response containing failure check details.
@@ -97,6 +266,45 @@ type VerifyPresentationOptions struct {
 // Model for response of presentation verification.
 type VerifyPresentationResponse struct {
 	Checks *[]VerifyPresentationCheckResult `json:"checks,omitempty"`
+
+	// Verification receipt in JWT format signed by the signing DID of the verifier profile.
+	Receipt *string `json:"receipt,omitempty"`
+
+	// ID of the verification receipt. Set if verification receipts are enabled for the profile.
+	ReceiptId *string `json:"receiptId,omitempty"`
+}
+
+// AcceptLanguage defines model for AcceptLanguage.
+type AcceptLanguage = string
+
+// Locale defines model for Locale.
+type Locale = string
+
+// QRErrorCorrection defines model for QRErrorCorrection.
+type QRErrorCorrection string
+
+// QRFormat defines model for QRFormat.
+type QRFormat string
+
+// QRSize defines model for QRSize.
+type QRSize = int
+
+// RetrieveInteractionsClaimParams defines parameters for RetrieveInteractionsClaim.
+type RetrieveInteractionsClaimParams struct {
+	// Locale of the display metadata of the credentials, e.g. "en-US". Takes precedence over Accept-Language header.
+	Locale *Locale `form:"locale,omitempty" json:"locale,omitempty"`
+
+	// One-time response code the user was redirected with. Required if the interaction was initiated with a response redirect URI.
+	ResponseCode *string `form:"response_code,omitempty" json:"response_code,omitempty"`
+
+	// Preferred locales of the display metadata of the credentials.
+	AcceptLanguage *AcceptLanguage `json:"Accept-Language,omitempty"`
+}
+
+// StreamInteractionStatusParams defines parameters for StreamInteractionStatus.
+type StreamInteractionStatusParams struct {
+	// Stream token issued for the transaction.
+	Token string `form:"token" json:"token"`
 }
 
 // PostVerifyCredentialsJSONBody defines parameters for PostVerifyCredentials.
@@ -105,6 +313,24 @@ type PostVerifyCredentialsJSONBody = VerifyCredentialData
 // InitiateOidcInteractionJSONBody defines parameters for InitiateOidcInteraction.
 type InitiateOidcInteractionJSONBody = InitiateOIDC4VPData
 
+// InitiateOidcInteractionParams defines parameters for InitiateOidcInteraction.
+type InitiateOidcInteractionParams struct {
+	// Image format of the QR code of the request URL. The QR code is returned as a data URI if the format is set.
+	QrFormat *InitiateOidcInteractionParamsQrFormat `form:"qr_format,omitempty" json:"qr_format,omitempty"`
+
+	// Error correction level of the QR code.
+	QrErrorCorrection *InitiateOidcInteractionParamsQrErrorCorrection `form:"qr_error_correction,omitempty" json:"qr_error_correction,omitempty"`
+
+	// Width and height of the QR code image in pixels.
+	QrSize *QRSize `form:"qr_size,omitempty" json:"qr_size,omitempty"`
+}
+
+// InitiateOidcInteractionParamsQrFormat defines parameters for InitiateOidcInteraction.
+type InitiateOidcInteractionParamsQrFormat string
+
+// InitiateOidcInteractionParamsQrErrorCorrection defines parameters for InitiateOidcInteraction.
+type InitiateOidcInteractionParamsQrErrorCorrection string
+
 // PostVerifyPresentationJSONBody defines parameters for PostVerifyPresentation.
 type PostVerifyPresentationJSONBody = VerifyPresentationData
 
@@ -122,18 +348,33 @@ type ServerInterface interface {
 	// Used by verifier applications to initiate OpenID presentation flow through VCS
 	// (POST /verifier/interactions/authorization-response)
 	CheckAuthorizationResponse(ctx echo.Context) error
+	// Used by verifier applications to get the archived presentation of oidc4vp interaction. Available if the presentation archive is enabled for the verifier profile, until the end of the retention period.
+	// (GET /verifier/interactions/{txID}/archive)
+	GetInteractionArchive(ctx echo.Context, txID string) error
 	// Used by verifier applications to get claims obtained during oidc4vp interaction.
 	// (GET /verifier/interactions/{txID}/claim)
-	RetrieveInteractionsClaim(ctx echo.Context, txID string) error
+	RetrieveInteractionsClaim(ctx echo.Context, txID string, params RetrieveInteractionsClaimParams) error
+	// Used by verifier applications to get the current status of oidc4vp interaction.
+	// (GET /verifier/interactions/{txID}/status)
+	GetInteractionStatus(ctx echo.Context, txID string) error
+	// Used by verifier applications to subscribe to status changes of oidc4vp interaction.
+	// (GET /verifier/interactions/{txID}/status/events)
+	StreamInteractionStatus(ctx echo.Context, txID string, params StreamInteractionStatusParams) error
+	// Used by verifier applications to get a short-lived token to subscribe to status changes of oidc4vp interaction.
+	// (POST /verifier/interactions/{txID}/status/stream-token)
+	CreateInteractionStatusStreamToken(ctx echo.Context, txID string) error
 	// Verify credential
 	// (POST /verifier/profiles/{profileID}/{profileVersion}/credentials/verify)
 	PostVerifyCredentials(ctx echo.Context, profileID string, profileVersion string) error
 	// Used by verifier applications to initiate OpenID presentation flow through VCS
 	// (POST /verifier/profiles/{profileID}/{profileVersion}/interactions/initiate-oidc)
-	InitiateOidcInteraction(ctx echo.Context, profileID string, profileVersion string) error
+	InitiateOidcInteraction(ctx echo.Context, profileID string, profileVersion string, params InitiateOidcInteractionParams) error
 	// Verify presentation
 	// (POST /verifier/profiles/{profileID}/{profileVersion}/presentations/verify)
 	PostVerifyPresentation(ctx echo.Context, profileID string, profileVersion string) error
+	// Used by verifier applications to get the signed receipt of credential or presentation verification.
+	// (GET /verifier/receipts/{receiptID})
+	GetVerificationReceipt(ctx echo.Context, receiptID string) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -150,6 +391,22 @@ func (w *ServerInterfaceWrapper) CheckAuthorizationResponse(ctx echo.Context) er
 	return err
 }
 
+// GetInteractionArchive converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInteractionArchive(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "txID" -------------
+	var txID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "txID", runtime.ParamLocationPath, ctx.Param("txID"), &txID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetInteractionArchive(ctx, txID)
+	return err
+}
+
 // RetrieveInteractionsClaim converts echo context to params.
 func (w *ServerInterfaceWrapper) RetrieveInteractionsClaim(ctx echo.Context) error {
 	var err error
@@ -161,8 +418,98 @@ func (w *ServerInterfaceWrapper) RetrieveInteractionsClaim(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txID: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RetrieveInteractionsClaimParams
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", ctx.QueryParams(), &params.Locale)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter locale: %s", err))
+	}
+
+	// ------------- Optional query parameter "response_code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "response_code", ctx.QueryParams(), &params.ResponseCode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter response_code: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Accept-Language" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept-Language")]; found {
+		var AcceptLanguage AcceptLanguage
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Accept-Language, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Accept-Language", runtime.ParamLocationHeader, valueList[0], &AcceptLanguage)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Accept-Language: %s", err))
+		}
+
+		params.AcceptLanguage = &AcceptLanguage
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RetrieveInteractionsClaim(ctx, txID, params)
+	return err
+}
+
+// GetInteractionStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInteractionStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "txID" -------------
+	var txID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "txID", runtime.ParamLocationPath, ctx.Param("txID"), &txID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetInteractionStatus(ctx, txID)
+	return err
+}
+
+// StreamInteractionStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamInteractionStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "txID" -------------
+	var txID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "txID", runtime.ParamLocationPath, ctx.Param("txID"), &txID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamInteractionStatusParams
+	// ------------- Required query parameter "token" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "token", ctx.QueryParams(), &params.Token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StreamInteractionStatus(ctx, txID, params)
+	return err
+}
+
+// CreateInteractionStatusStreamToken converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateInteractionStatusStreamToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "txID" -------------
+	var txID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "txID", runtime.ParamLocationPath, ctx.Param("txID"), &txID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txID: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.RetrieveInteractionsClaim(ctx, txID)
+	err = w.Handler.CreateInteractionStatusStreamToken(ctx, txID)
 	return err
 }
 
@@ -209,8 +556,31 @@ func (w *ServerInterfaceWrapper) InitiateOidcInteraction(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profileVersion: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params InitiateOidcInteractionParams
+	// ------------- Optional query parameter "qr_format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "qr_format", ctx.QueryParams(), &params.QrFormat)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter qr_format: %s", err))
+	}
+
+	// ------------- Optional query parameter "qr_error_correction" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "qr_error_correction", ctx.QueryParams(), &params.QrErrorCorrection)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter qr_error_correction: %s", err))
+	}
+
+	// ------------- Optional query parameter "qr_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "qr_size", ctx.QueryParams(), &params.QrSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter qr_size: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.InitiateOidcInteraction(ctx, profileID, profileVersion)
+	err = w.Handler.InitiateOidcInteraction(ctx, profileID, profileVersion, params)
 	return err
 }
 
@@ -238,6 +608,22 @@ func (w *ServerInterfaceWrapper) PostVerifyPresentation(ctx echo.Context) error
 	return err
 }
 
+// GetVerificationReceipt converts echo context to params.
+func (w *ServerInterfaceWrapper) GetVerificationReceipt(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "receiptID" -------------
+	var receiptID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "receiptID", runtime.ParamLocationPath, ctx.Param("receiptID"), &receiptID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter receiptID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetVerificationReceipt(ctx, receiptID)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -267,9 +653,14 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.POST(baseURL+"/verifier/interactions/authorization-response", wrapper.CheckAuthorizationResponse)
+	router.GET(baseURL+"/verifier/interactions/:txID/archive", wrapper.GetInteractionArchive)
 	router.GET(baseURL+"/verifier/interactions/:txID/claim", wrapper.RetrieveInteractionsClaim)
+	router.GET(baseURL+"/verifier/interactions/:txID/status", wrapper.GetInteractionStatus)
+	router.GET(baseURL+"/verifier/interactions/:txID/status/events", wrapper.StreamInteractionStatus)
+	router.POST(baseURL+"/verifier/interactions/:txID/status/stream-token", wrapper.CreateInteractionStatusStreamToken)
 	router.POST(baseURL+"/verifier/profiles/:profileID/:profileVersion/credentials/verify", wrapper.PostVerifyCredentials)
 	router.POST(baseURL+"/verifier/profiles/:profileID/:profileVersion/interactions/initiate-oidc", wrapper.InitiateOidcInteraction)
 	router.POST(baseURL+"/verifier/profiles/:profileID/:profileVersion/presentations/verify", wrapper.PostVerifyPresentation)
+	router.GET(baseURL+"/verifier/receipts/:receiptID", wrapper.GetVerificationReceipt)
 
 }
@@ -0,0 +1,33 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package credentialrefresh
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrDataNotFound = errors.New("data not found")
+	// ErrAlreadySuperseded is returned when the credential is already superseded by a refreshed credential.
+	ErrAlreadySuperseded = errors.New("credential is already superseded")
+)
+
+// Challenge is a one-time challenge issued to the holder for the credential refresh.
+type Challenge struct {
+	ID             string
+	ProfileID      string
+	ProfileVersion string
+	ExpireAt       time.Time
+}
+
+// PresentationRequest is returned to the holder to request a presentation for the credential refresh.
+// The presentation must be signed for the domain and the challenge.
+type PresentationRequest struct {
+	Challenge string
+	Domain    string
+}
@@ -0,0 +1,606 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+//go:generate mockgen -destination credentialrefresh_service_mocks_test.go -self_package mocks -package credentialrefresh_test -source=credentialrefresh_service.go -mock_names presentationVerifier=MockPresentationVerifier,credentialIssuer=MockCredentialIssuer,challengeStore=MockChallengeStore,supersessionStore=MockSupersessionStore
+
+package credentialrefresh
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/trustbloc/logutil-go/pkg/log"
+	"github.com/trustbloc/vc-go/verifiable"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/restapi/resterr"
+	"github.com/trustbloc/vcs/pkg/service/issuecredential"
+	"github.com/trustbloc/vcs/pkg/service/verifypresentation"
+)
+
+const (
+	defaultCredentialExpiration       = 365 * 24 * time.Hour
+	defaultChallengeTTL               = 5 * time.Minute
+	defaultClaimsRequestRetryInterval = 500 * time.Millisecond
+	defaultMaxActiveChallenges        = 10000
+)
+
+var logger = log.New("credential-refresh")
+
+type presentationVerifier interface {
+	VerifyPresentation(
+		ctx context.Context,
+		presentation *verifiable.Presentation,
+		opts *verifypresentation.Options,
+		profile *profileapi.Verifier,
+	) ([]verifypresentation.PresentationVerificationCheckResult, map[string][]string, error)
+}
+
+type credentialIssuer interface {
+	IssueCredential(
+		ctx context.Context,
+		credential *verifiable.Credential,
+		profile *profileapi.Issuer,
+		opts ...issuecredential.Opts,
+	) (*verifiable.Credential, error)
+}
+
+type challengeStore interface {
+	Create(ctx context.Context, challenge *Challenge) error
+	// CountActive returns the number of unexpired challenges of the profile.
+	CountActive(ctx context.Context, profileID, profileVersion string) (int64, error)
+	// GetAndDelete returns and deletes the challenge. ErrDataNotFound is returned if the challenge
+	// was not issued, is already used or expired.
+	GetAndDelete(ctx context.Context, id string) (*Challenge, error)
+}
+
+type supersessionStore interface {
+	// MarkSuperseded records that the credential is superseded by the refreshed credential.
+	// ErrAlreadySuperseded is returned if the credential is already superseded.
+	MarkSuperseded(ctx context.Context, credentialID, supersededBy string) error
+	// UnmarkSuperseded removes the record made by MarkSuperseded for the refreshed credential.
+	UnmarkSuperseded(ctx context.Context, credentialID, supersededBy string) error
+}
+
+// Config defines dependencies for Service.
+type Config struct {
+	PresentationVerifier presentationVerifier
+	CredentialIssuer     credentialIssuer
+	ChallengeStore       challengeStore
+	SupersessionStore    supersessionStore
+	HTTPClient           *http.Client
+	// TLSConfig is a base TLS config of requests to the claim endpoint with "tls_client_auth" auth method.
+	TLSConfig           *tls.Config
+	IssuerVCSPublicHost string
+	// ChallengeTTL is a lifetime of the refresh challenge. Defaults to 5m.
+	ChallengeTTL time.Duration
+	// MaxActiveChallenges bounds the number of unexpired challenges of the profile, as challenges are issued
+	// to unauthenticated holders. Defaults to 10000.
+	MaxActiveChallenges int64
+}
+
+// Service refreshes credentials issued from credential templates with refresh service.
+type Service struct {
+	presentationVerifier presentationVerifier
+	credentialIssuer     credentialIssuer
+	challengeStore       challengeStore
+	supersessionStore    supersessionStore
+	httpClient           *http.Client
+	tlsConfig            *tls.Config
+	issuerVCSPublicHost  string
+	challengeTTL         time.Duration
+	maxActiveChallenges  int64
+}
+
+// claimsRequest is the body of the request to the claim endpoint of the refresh service.
+type claimsRequest struct {
+	CredentialID   string   `json:"credential_id"`
+	CredentialType []string `json:"credential_type"`
+	SubjectID      string   `json:"subject_id"`
+	ProfileID      string   `json:"profile_id"`
+	ProfileVersion string   `json:"profile_version"`
+}
+
+// New returns a new instance of Service.
+func New(config *Config) *Service {
+	challengeTTL := config.ChallengeTTL
+	if challengeTTL <= 0 {
+		challengeTTL = defaultChallengeTTL
+	}
+
+	maxActiveChallenges := config.MaxActiveChallenges
+	if maxActiveChallenges <= 0 {
+		maxActiveChallenges = defaultMaxActiveChallenges
+	}
+
+	return &Service{
+		presentationVerifier: config.PresentationVerifier,
+		credentialIssuer:     config.CredentialIssuer,
+		challengeStore:       config.ChallengeStore,
+		supersessionStore:    config.SupersessionStore,
+		httpClient:           config.HTTPClient,
+		tlsConfig:            config.TLSConfig,
+		issuerVCSPublicHost:  config.IssuerVCSPublicHost,
+		challengeTTL:         challengeTTL,
+		maxActiveChallenges:  maxActiveChallenges,
+	}
+}
+
+// CreateChallenge issues a one-time challenge for the credential refresh. The holder signs the presentation
+// of the credential for the returned challenge and domain. Challenges are issued only by profiles with refresh
+// service configured and the number of unexpired challenges of the profile is bounded.
+func (s *Service) CreateChallenge(
+	ctx context.Context,
+	profile *profileapi.Issuer,
+) (*PresentationRequest, error) {
+	if !lo.ContainsBy(profile.CredentialTemplates, func(t *profileapi.CredentialTemplate) bool {
+		return t.RefreshService != nil
+	}) {
+		return nil, resterr.NewCustomError(resterr.CredentialTemplateNotFound,
+			errors.New("refresh service is not configured for the profile"))
+	}
+
+	active, err := s.challengeStore.CountActive(ctx, profile.ID, profile.Version)
+	if err != nil {
+		return nil, fmt.Errorf("count refresh challenges: %w", err)
+	}
+
+	if active >= s.maxActiveChallenges {
+		return nil, resterr.NewCustomError(resterr.TooManyRequests,
+			errors.New("too many active refresh challenges, retry later"))
+	}
+
+	challenge := &Challenge{
+		ID:             uuid.NewString(),
+		ProfileID:      profile.ID,
+		ProfileVersion: profile.Version,
+		ExpireAt:       time.Now().UTC().Add(s.challengeTTL),
+	}
+
+	if err = s.challengeStore.Create(ctx, challenge); err != nil {
+		return nil, fmt.Errorf("store refresh challenge: %w", err)
+	}
+
+	return &PresentationRequest{
+		Challenge: challenge.ID,
+		Domain:    profileapi.RefreshServiceURL(s.issuerVCSPublicHost, profile.ID, profile.Version),
+	}, nil
+}
+
+// RefreshCredential re-issues the credential enclosed into the presentation with up-to-date claims.
+// The presentation must be signed by the subject of the credential for the refresh service URL domain
+// and the challenge issued by CreateChallenge. The challenge is consumed, so the presentation can not be replayed.
+// The new credential is issued with the status list policy of the profile and refers to the previous
+// credential in the issuance history. Refreshed claims are validated against the claims metadata of the
+// credential configuration. A credential is refreshed once only, as it is marked superseded by the new credential.
+func (s *Service) RefreshCredential(
+	ctx context.Context,
+	profile *profileapi.Issuer,
+	presentation *verifiable.Presentation,
+	challenge string,
+) (*verifiable.Credential, error) {
+	credentials := presentation.Credentials()
+	if len(credentials) != 1 {
+		return nil, resterr.NewValidationError(resterr.InvalidValue, "presentation",
+			errors.New("presentation must contain exactly one credential"))
+	}
+
+	vcc := credentials[0].Contents()
+
+	if vcc.ID == "" {
+		return nil, resterr.NewValidationError(resterr.InvalidValue, "credential.id",
+			errors.New("credential id is required"))
+	}
+
+	if vcc.Issuer == nil || profile.SigningDID == nil || vcc.Issuer.ID != profile.SigningDID.DID {
+		return nil, resterr.NewValidationError(resterr.InvalidValue, "credential.issuer",
+			errors.New("credential is not issued by the profile"))
+	}
+
+	template, ok := lo.Find(profile.CredentialTemplates, func(t *profileapi.CredentialTemplate) bool {
+		return t.RefreshService != nil && lo.Contains(vcc.Types, t.Type)
+	})
+	if !ok {
+		return nil, resterr.NewCustomError(resterr.CredentialTemplateNotFound,
+			fmt.Errorf("refresh service is not configured for credential types %v", vcc.Types))
+	}
+
+	if len(vcc.Subject) != 1 || vcc.Subject[0].ID == "" || vcc.Subject[0].ID != presentation.Holder {
+		return nil, resterr.NewValidationError(resterr.InvalidValue, "presentation.holder",
+			errors.New("presentation holder does not match credential subject"))
+	}
+
+	refreshService := template.RefreshService.TypedID(
+		profileapi.RefreshServiceURL(s.issuerVCSPublicHost, profile.ID, profile.Version))
+
+	if err := s.consumeChallenge(ctx, profile, challenge); err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyPresentation(ctx, profile, presentation, &verifypresentation.Options{
+		Domain:    refreshService.ID,
+		Challenge: challenge,
+	}); err != nil {
+		return nil, err
+	}
+
+	claims, err := s.requestClaims(ctx, profile, template.RefreshService, &vcc)
+	if err != nil {
+		return nil, resterr.NewCustomError(resterr.ClaimsNotReceived, err)
+	}
+
+	claims, err = template.MapClaims(claims)
+	if err != nil {
+		return nil, resterr.NewCustomError(resterr.ClaimsValidationErr, err)
+	}
+
+	if err = validateClaims(profile, template, claims); err != nil {
+		return nil, err
+	}
+
+	credential, err := newCredential(&vcc, template, refreshService, claims)
+	if err != nil {
+		return nil, fmt.Errorf("create credential: %w", err)
+	}
+
+	if err = s.supersessionStore.MarkSuperseded(ctx, vcc.ID, credential.Contents().ID); err != nil {
+		if errors.Is(err, ErrAlreadySuperseded) {
+			return nil, resterr.NewValidationError(resterr.InvalidValue, "presentation",
+				errors.New("credential is already refreshed"))
+		}
+
+		return nil, fmt.Errorf("mark credential superseded: %w", err)
+	}
+
+	refreshed, err := s.credentialIssuer.IssueCredential(ctx, credential, profile,
+		issuecredential.WithPreviousCredentialID(vcc.ID),
+		issuecredential.WithSkipIDPrefix(),
+	)
+	if err != nil {
+		// The credential is not refreshed, so the holder can retry.
+		if unmarkErr := s.supersessionStore.UnmarkSuperseded(ctx, vcc.ID, credential.Contents().ID); unmarkErr != nil {
+			logger.Warnc(ctx, "Failed to unmark superseded credential", log.WithError(unmarkErr))
+		}
+
+		return nil, fmt.Errorf("issue credential: %w", err)
+	}
+
+	return refreshed, nil
+}
+
+// validateClaims validates refreshed claims against the claims metadata of the credential configuration
+// of the template, as the claims are validated at the initial issuance.
+func validateClaims(
+	profile *profileapi.Issuer,
+	template *profileapi.CredentialTemplate,
+	claims map[string]interface{},
+) error {
+	if profile.CredentialMetaData == nil {
+		return nil
+	}
+
+	for _, conf := range profile.CredentialMetaData.CredentialsConfigurationSupported {
+		if conf.CredentialDefinition == nil || !lo.Contains(conf.CredentialDefinition.Type, template.Type) {
+			continue
+		}
+
+		metadata, err := conf.ClaimsMetadata()
+		if err != nil {
+			return fmt.Errorf("get claims metadata: %w", err)
+		}
+
+		if err = profileapi.ValidateClaims(claims, metadata); err != nil {
+			return resterr.NewCustomError(resterr.ClaimsValidationErr, fmt.Errorf("validate claims: %w", err))
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func (s *Service) consumeChallenge(ctx context.Context, profile *profileapi.Issuer, challenge string) error {
+	if challenge == "" {
+		return resterr.NewValidationError(resterr.InvalidValue, "challenge", errors.New("challenge is required"))
+	}
+
+	issued, err := s.challengeStore.GetAndDelete(ctx, challenge)
+	if err != nil {
+		if errors.Is(err, ErrDataNotFound) {
+			return resterr.NewValidationError(resterr.InvalidValue, "challenge",
+				errors.New("challenge is unknown, used or expired"))
+		}
+
+		return fmt.Errorf("get refresh challenge: %w", err)
+	}
+
+	if issued.ExpireAt.Before(time.Now()) {
+		return resterr.NewValidationError(resterr.InvalidValue, "challenge",
+			errors.New("challenge is unknown, used or expired"))
+	}
+
+	if issued.ProfileID != profile.ID || issued.ProfileVersion != profile.Version {
+		return resterr.NewValidationError(resterr.InvalidValue, "challenge",
+			errors.New("challenge is not issued for the profile"))
+	}
+
+	return nil
+}
+
+func (s *Service) verifyPresentation(
+	ctx context.Context,
+	profile *profileapi.Issuer,
+	presentation *verifiable.Presentation,
+	opts *verifypresentation.Options,
+) error {
+	verifier := &profileapi.Verifier{
+		ID:             profile.ID,
+		Version:        profile.Version,
+		OrganizationID: profile.OrganizationID,
+		SigningDID:     profile.SigningDID,
+		Checks: &profileapi.VerificationChecks{
+			Presentation: &profileapi.PresentationChecks{
+				Proof: true,
+			},
+			Credential: profileapi.CredentialChecks{
+				Proof:  true,
+				Status: profile.VCConfig != nil && !profile.VCConfig.Status.Disable,
+			},
+		},
+	}
+
+	result, _, err := s.presentationVerifier.VerifyPresentation(ctx, presentation, opts, verifier)
+	if err != nil {
+		return fmt.Errorf("verify presentation: %w", err)
+	}
+
+	if len(result) > 0 {
+		checks := lo.Map(result, func(r verifypresentation.PresentationVerificationCheckResult, _ int) string {
+			return r.Check + ": " + r.Error
+		})
+
+		return resterr.NewCustomError(resterr.PresentationVerificationFailed,
+			fmt.Errorf("presentation verification checks failed: %s", strings.Join(checks, "; ")))
+	}
+
+	return nil
+}
+
+// requestClaims fetches up-to-date claims of the credential from the claim endpoint of the refresh service.
+// The request is authenticated as configured by the claims request of the refresh service.
+func (s *Service) requestClaims(
+	ctx context.Context,
+	profile *profileapi.Issuer,
+	refreshService *profileapi.RefreshServiceConfig,
+	vcc *verifiable.CredentialContents,
+) (map[string]interface{}, error) {
+	conf := refreshService.ClaimsRequest
+	if conf == nil {
+		return nil, errors.New("claims request is not configured for the refresh service")
+	}
+
+	body, err := json.Marshal(&claimsRequest{
+		CredentialID:   vcc.ID,
+		CredentialType: vcc.Types,
+		SubjectID:      vcc.Subject[0].ID,
+		ProfileID:      profile.ID,
+		ProfileVersion: profile.Version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode claims request: %w", err)
+	}
+
+	var authorization string
+
+	switch conf.AuthMethod { //nolint:exhaustive
+	case profileapi.ClaimsRequestAuthClientCredentials:
+		token, tokenErr := s.getClaimsRequestToken(ctx, profile, conf)
+		if tokenErr != nil {
+			return nil, tokenErr
+		}
+
+		authorization = token.Type() + " " + token.AccessToken
+	case profileapi.ClaimsRequestAuthTLSClient:
+	default:
+		return nil, fmt.Errorf("claims request auth method %q is not supported", conf.AuthMethod)
+	}
+
+	httpClient, err := s.claimsHTTPClient(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	if httpClient != s.httpClient {
+		defer httpClient.CloseIdleConnections()
+	}
+
+	retryInterval := defaultClaimsRequestRetryInterval
+	if conf.RetryInterval != nil {
+		retryInterval = *conf.RetryInterval
+	}
+
+	b, err := backoff.RetryWithData(
+		func() ([]byte, error) {
+			return s.doClaimsRequest(ctx, httpClient, refreshService.ClaimEndpoint, body, authorization, conf)
+		},
+		backoff.WithContext(
+			backoff.WithMaxRetries(backoff.NewConstantBackOff(retryInterval), uint64(conf.MaxRetries)), ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	if err = json.Unmarshal(b, &claims); err != nil {
+		return nil, fmt.Errorf("decode claim data: %w", err)
+	}
+
+	return claims, nil
+}
+
+// doClaimsRequest sends the claims request. Errors of requests which should not be retried are
+// wrapped into backoff.PermanentError.
+func (s *Service) doClaimsRequest(
+	ctx context.Context,
+	httpClient *http.Client,
+	endpoint string,
+	body []byte,
+	authorization string,
+	conf *profileapi.ClaimsRequestConfig,
+) ([]byte, error) {
+	if conf.Timeout != nil {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, *conf.Timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, backoff.Permanent(fmt.Errorf("create request: %w", err))
+	}
+
+	for name, value := range conf.Headers {
+		req.Header.Set(name, value)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read claim data: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		logger.Errorc(ctx, "Failed to fetch claims data",
+			log.WithURL(endpoint),
+			log.WithHTTPStatus(resp.StatusCode),
+			log.WithResponse(b),
+		)
+
+		err = fmt.Errorf("claim endpoint returned status code %d", resp.StatusCode)
+
+		if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+			return nil, err
+		}
+
+		return nil, backoff.Permanent(err)
+	}
+
+	return b, nil
+}
+
+func (s *Service) getClaimsRequestToken(
+	ctx context.Context,
+	profile *profileapi.Issuer,
+	conf *profileapi.ClaimsRequestConfig,
+) (*oauth2.Token, error) {
+	if profile.OIDCConfig == nil {
+		return nil, errors.New("oidc config is not set for the profile")
+	}
+
+	clientCredentials := &clientcredentials.Config{
+		ClientID:     profile.OIDCConfig.ClientID,
+		ClientSecret: profile.OIDCConfig.ClientSecretHandle,
+		TokenURL:     conf.TokenEndpoint,
+		Scopes:       conf.Scopes,
+		AuthStyle:    oauth2.AuthStyleAutoDetect,
+	}
+
+	token, err := clientCredentials.Token(context.WithValue(ctx, oauth2.HTTPClient, s.httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("get client credentials token: %w", err)
+	}
+
+	return token, nil
+}
+
+// claimsHTTPClient returns the http client with the client certificate for tls_client_auth auth method
+// and the default http client otherwise.
+func (s *Service) claimsHTTPClient(conf *profileapi.ClaimsRequestConfig) (*http.Client, error) {
+	if conf.AuthMethod != profileapi.ClaimsRequestAuthTLSClient {
+		return s.httpClient, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(conf.TLSCertFile, conf.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load tls client certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if s.tlsConfig != nil {
+		tlsConfig = s.tlsConfig.Clone()
+	}
+
+	tlsConfig.Certificates = []tls.Certificate{cert}
+
+	httpClient := &http.Client{
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}
+
+	if s.httpClient != nil {
+		httpClient.Timeout = s.httpClient.Timeout
+	}
+
+	return httpClient, nil
+}
+
+func newCredential(
+	previous *verifiable.CredentialContents,
+	template *profileapi.CredentialTemplate,
+	refreshService verifiable.TypedID,
+	claims map[string]interface{},
+) (*verifiable.Credential, error) {
+	vcc := verifiable.CredentialContents{
+		Context:        previous.Context,
+		ID:             uuid.New().URN(),
+		Types:          previous.Types,
+		Issuer:         previous.Issuer,
+		RefreshService: []verifiable.TypedID{refreshService},
+		Subject: []verifiable.Subject{{
+			ID:           previous.Subject[0].ID,
+			CustomFields: claims,
+		}},
+	}
+
+	customFields := map[string]interface{}{}
+
+	now := time.Now().UTC()
+
+	validUntil := now.Add(defaultCredentialExpiration)
+	if template.CredentialDefaultExpirationDuration != nil {
+		validUntil = now.Add(*template.CredentialDefaultExpirationDuration)
+	}
+
+	vcutil.SetValidityPeriod(&vcc, customFields, now, &validUntil)
+
+	return verifiable.CreateCredential(vcc, customFields)
+}
@@ -0,0 +1,615 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package credentialrefresh_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/vc-go/verifiable"
+
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/restapi/resterr"
+	"github.com/trustbloc/vcs/pkg/service/credentialrefresh"
+	"github.com/trustbloc/vcs/pkg/service/issuecredential"
+	"github.com/trustbloc/vcs/pkg/service/verifypresentation"
+)
+
+const (
+	profileID      = "test-profile"
+	profileVersion = "v1.0"
+	issuerDID      = "did:example:issuer"
+	holderDID      = "did:example:holder"
+	credentialID   = "urn:uuid:previous"
+	vcsHost        = "https://vcs.example.com"
+	refreshURL     = vcsHost + "/issuer/profiles/test-profile/v1.0/credentials/refresh"
+	challengeID    = "challenge"
+)
+
+func TestService_RefreshCredential(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var claimsReq map[string]interface{}
+
+		srv := newClaimsServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Equal(t, "Bearer access-token", r.Header.Get("Authorization"))
+			require.Equal(t, "header-value", r.Header.Get("X-Header"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&claimsReq))
+
+			_, _ = w.Write([]byte(`{"name":"John Smith","title":"Manager"}`))
+		}))
+		defer srv.Close()
+
+		verifier := NewMockPresentationVerifier(gomock.NewController(t))
+		verifier.EXPECT().VerifyPresentation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(
+				_ context.Context,
+				_ *verifiable.Presentation,
+				opts *verifypresentation.Options,
+				profile *profileapi.Verifier,
+			) ([]verifypresentation.PresentationVerificationCheckResult, map[string][]string, error) {
+				require.Equal(t, refreshURL, opts.Domain)
+				require.Equal(t, challengeID, opts.Challenge)
+				require.True(t, profile.Checks.Presentation.Proof)
+				require.True(t, profile.Checks.Credential.Proof)
+				require.True(t, profile.Checks.Credential.Status)
+
+				return nil, nil, nil
+			})
+
+		issuer := NewMockCredentialIssuer(gomock.NewController(t))
+		issuer.EXPECT().IssueCredential(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				cred *verifiable.Credential,
+				_ *profileapi.Issuer,
+				_ ...issuecredential.Opts,
+			) (*verifiable.Credential, error) {
+				return cred, nil
+			})
+
+		var supersededBy string
+
+		supersession := NewMockSupersessionStore(gomock.NewController(t))
+		supersession.EXPECT().MarkSuperseded(gomock.Any(), credentialID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _, newCredentialID string) error {
+				supersededBy = newCredentialID
+
+				return nil
+			})
+
+		svc := credentialrefresh.New(&credentialrefresh.Config{
+			PresentationVerifier: verifier,
+			ChallengeStore:       newChallengeStore(t),
+			SupersessionStore:    supersession,
+			CredentialIssuer:     issuer,
+			HTTPClient:           http.DefaultClient,
+			IssuerVCSPublicHost:  vcsHost,
+		})
+
+		cred, err := svc.RefreshCredential(context.Background(), newProfile(srv.URL),
+			newPresentation(t, holderDID, issuerDID), challengeID)
+		require.NoError(t, err)
+
+		vcc := cred.Contents()
+		require.NotEqual(t, credentialID, vcc.ID)
+		require.Equal(t, supersededBy, vcc.ID)
+		require.Equal(t, []string{"VerifiableCredential", "VerifiedEmployee"}, vcc.Types)
+		require.Equal(t, issuerDID, vcc.Issuer.ID)
+		require.Equal(t, holderDID, vcc.Subject[0].ID)
+		require.Equal(t, "Manager", vcc.Subject[0].CustomFields["title"])
+		require.Equal(t, []verifiable.TypedID{{
+			ID:   refreshURL,
+			Type: profileapi.DefaultRefreshServiceType,
+		}}, vcc.RefreshService)
+		require.NotNil(t, vcc.Expired)
+
+		require.Equal(t, credentialID, claimsReq["credential_id"])
+		require.Equal(t, holderDID, claimsReq["subject_id"])
+		require.Equal(t, profileID, claimsReq["profile_id"])
+	})
+
+	t.Run("invalid presentation", func(t *testing.T) {
+		svc := credentialrefresh.New(&credentialrefresh.Config{IssuerVCSPublicHost: vcsHost})
+
+		pres, err := verifiable.NewPresentation()
+		require.NoError(t, err)
+
+		_, err = svc.RefreshCredential(context.Background(), newProfile(""), pres, challengeID)
+		requireCustomError(t, resterr.InvalidValue, err)
+
+		_, err = svc.RefreshCredential(context.Background(), newProfile(""),
+			newPresentation(t, holderDID, "did:example:other"), challengeID)
+		requireCustomError(t, resterr.InvalidValue, err)
+
+		_, err = svc.RefreshCredential(context.Background(), newProfile(""),
+			newPresentation(t, "did:example:other", issuerDID), challengeID)
+		requireCustomError(t, resterr.InvalidValue, err)
+	})
+
+	t.Run("refresh service not configured", func(t *testing.T) {
+		svc := credentialrefresh.New(&credentialrefresh.Config{IssuerVCSPublicHost: vcsHost})
+
+		profile := newProfile("")
+		profile.CredentialTemplates[0].RefreshService = nil
+
+		_, err := svc.RefreshCredential(context.Background(), profile, newPresentation(t, holderDID, issuerDID), challengeID)
+		requireCustomError(t, resterr.CredentialTemplateNotFound, err)
+	})
+
+	t.Run("presentation verification failed", func(t *testing.T) {
+		verifier := NewMockPresentationVerifier(gomock.NewController(t))
+		verifier.EXPECT().VerifyPresentation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+			[]verifypresentation.PresentationVerificationCheckResult{{Check: "credentialStatus", Error: "revoked"}},
+			nil, nil)
+
+		svc := credentialrefresh.New(&credentialrefresh.Config{
+			PresentationVerifier: verifier,
+			ChallengeStore:       newChallengeStore(t),
+			IssuerVCSPublicHost:  vcsHost,
+		})
+
+		_, err := svc.RefreshCredential(context.Background(), newProfile(""),
+			newPresentation(t, holderDID, issuerDID), challengeID)
+		requireCustomError(t, resterr.PresentationVerificationFailed, err)
+		require.ErrorContains(t, err, "credentialStatus: revoked")
+	})
+
+	t.Run("claim endpoint error", func(t *testing.T) {
+		srv := newClaimsServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer srv.Close()
+
+		verifier := NewMockPresentationVerifier(gomock.NewController(t))
+		verifier.EXPECT().VerifyPresentation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, nil, nil)
+
+		svc := credentialrefresh.New(&credentialrefresh.Config{
+			PresentationVerifier: verifier,
+			ChallengeStore:       newChallengeStore(t),
+			HTTPClient:           http.DefaultClient,
+			IssuerVCSPublicHost:  vcsHost,
+		})
+
+		_, err := svc.RefreshCredential(context.Background(), newProfile(srv.URL),
+			newPresentation(t, holderDID, issuerDID), challengeID)
+		requireCustomError(t, resterr.ClaimsNotReceived, err)
+		require.ErrorContains(t, err, "claim endpoint returned status code 404")
+	})
+
+	t.Run("claim endpoint auth error", func(t *testing.T) {
+		srv := newClaimsServer(t, http.NotFoundHandler())
+		defer srv.Close()
+
+		verifier := NewMockPresentationVerifier(gomock.NewController(t))
+		verifier.EXPECT().VerifyPresentation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, nil, nil).Times(2)
+
+		store := NewMockChallengeStore(gomock.NewController(t))
+		store.EXPECT().GetAndDelete(gomock.Any(), challengeID).Return(&credentialrefresh.Challenge{
+			ID:             challengeID,
+			ProfileID:      profileID,
+			ProfileVersion: profileVersion,
+			ExpireAt:       time.Now().Add(time.Minute),
+		}, nil).Times(2)
+
+		svc := credentialrefresh.New(&credentialrefresh.Config{
+			PresentationVerifier: verifier,
+			ChallengeStore:       store,
+			HTTPClient:           http.DefaultClient,
+			IssuerVCSPublicHost:  vcsHost,
+		})
+
+		profile := newProfile(srv.URL)
+		profile.OIDCConfig.ClientSecretHandle = "invalid"
+
+		_, err := svc.RefreshCredential(context.Background(), profile,
+			newPresentation(t, holderDID, issuerDID), challengeID)
+		requireCustomError(t, resterr.ClaimsNotReceived, err)
+		require.ErrorContains(t, err, "get client credentials token")
+
+		profile = newProfile(srv.URL)
+		profile.CredentialTemplates[0].RefreshService.ClaimsRequest = &profileapi.ClaimsRequestConfig{
+			AuthMethod:  profileapi.ClaimsRequestAuthTLSClient,
+			TLSCertFile: "not-found.pem",
+			TLSKeyFile:  "not-found.pem",
+		}
+
+		_, err = svc.RefreshCredential(context.Background(), profile,
+			newPresentation(t, holderDID, issuerDID), challengeID)
+		requireCustomError(t, resterr.ClaimsNotReceived, err)
+		require.ErrorContains(t, err, "load tls client certificate")
+	})
+
+	t.Run("credential already refreshed", func(t *testing.T) {
+		srv := newClaimsServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"name":"John Smith"}`))
+		}))
+		defer srv.Close()
+
+		verifier := NewMockPresentationVerifier(gomock.NewController(t))
+		verifier.EXPECT().VerifyPresentation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, nil, nil).Times(2)
+
+		store := NewMockChallengeStore(gomock.NewController(t))
+		store.EXPECT().GetAndDelete(gomock.Any(), challengeID).Return(&credentialrefresh.Challenge{
+			ID:             challengeID,
+			ProfileID:      profileID,
+			ProfileVersion: profileVersion,
+			ExpireAt:       time.Now().Add(time.Minute),
+		}, nil).Times(2)
+
+		supersession := NewMockSupersessionStore(gomock.NewController(t))
+		supersession.EXPECT().MarkSuperseded(gomock.Any(), credentialID, gomock.Any()).
+			Return(credentialrefresh.ErrAlreadySuperseded)
+		supersession.EXPECT().MarkSuperseded(gomock.Any(), credentialID, gomock.Any()).
+			Return(errors.New("mark error"))
+
+		svc := credentialrefresh.New(&credentialrefresh.Config{
+			PresentationVerifier: verifier,
+			ChallengeStore:       store,
+			SupersessionStore:    supersession,
+			HTTPClient:           http.DefaultClient,
+			IssuerVCSPublicHost:  vcsHost,
+		})
+
+		_, err := svc.RefreshCredential(context.Background(), newProfile(srv.URL),
+			newPresentation(t, holderDID, issuerDID), challengeID)
+		requireCustomError(t, resterr.InvalidValue, err)
+		require.ErrorContains(t, err, "credential is already refreshed")
+
+		_, err = svc.RefreshCredential(context.Background(), newProfile(srv.URL),
+			newPresentation(t, holderDID, issuerDID), challengeID)
+		require.ErrorContains(t, err, "mark credential superseded: mark error")
+	})
+
+	t.Run("invalid claims", func(t *testing.T) {
+		srv := newClaimsServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"name":"John Smith"}`))
+		}))
+		defer srv.Close()
+
+		verifier := NewMockPresentationVerifier(gomock.NewController(t))
+		verifier.EXPECT().VerifyPresentation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, nil, nil)
+
+		svc := credentialrefresh.New(&credentialrefresh.Config{
+			PresentationVerifier: verifier,
+			ChallengeStore:       newChallengeStore(t),
+			SupersessionStore:    NewMockSupersessionStore(gomock.NewController(t)),
+			HTTPClient:           http.DefaultClient,
+			IssuerVCSPublicHost:  vcsHost,
+		})
+
+		profile := newProfile(srv.URL)
+		profile.CredentialMetaData = &profileapi.CredentialMetaData{
+			CredentialsConfigurationSupported: map[string]*profileapi.CredentialsConfigurationSupported{
+				"OtherCredential": {
+					CredentialDefinition: &profileapi.CredentialDefinition{
+						Type: []string{"VerifiableCredential", "OtherCredential"},
+					},
+				},
+				"VerifiedEmployee": {
+					CredentialDefinition: &profileapi.CredentialDefinition{
+						Type: []string{"VerifiableCredential", "VerifiedEmployee"},
+						CredentialSubject: map[string]profileapi.Claim{
+							"title": {Mandatory: true},
+						},
+					},
+				},
+			},
+		}
+
+		_, err := svc.RefreshCredential(context.Background(), profile,
+			newPresentation(t, holderDID, issuerDID), challengeID)
+		requireCustomError(t, resterr.ClaimsValidationErr, err)
+		require.ErrorContains(t, err, `claim "title": mandatory claim is missing`)
+	})
+
+	t.Run("issue credential error", func(t *testing.T) {
+		srv := newClaimsServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"name":"John Smith"}`))
+		}))
+		defer srv.Close()
+
+		verifier := NewMockPresentationVerifier(gomock.NewController(t))
+		verifier.EXPECT().VerifyPresentation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, nil, nil)
+
+		issuer := NewMockCredentialIssuer(gomock.NewController(t))
+		issuer.EXPECT().IssueCredential(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("issue error"))
+
+		supersession := NewMockSupersessionStore(gomock.NewController(t))
+		supersession.EXPECT().MarkSuperseded(gomock.Any(), credentialID, gomock.Any()).Return(nil)
+		supersession.EXPECT().UnmarkSuperseded(gomock.Any(), credentialID, gomock.Any()).
+			Return(errors.New("unmark error"))
+
+		svc := credentialrefresh.New(&credentialrefresh.Config{
+			PresentationVerifier: verifier,
+			ChallengeStore:       newChallengeStore(t),
+			SupersessionStore:    supersession,
+			CredentialIssuer:     issuer,
+			HTTPClient:           http.DefaultClient,
+			IssuerVCSPublicHost:  vcsHost,
+		})
+
+		_, err := svc.RefreshCredential(context.Background(), newProfile(srv.URL),
+			newPresentation(t, holderDID, issuerDID), challengeID)
+		require.ErrorContains(t, err, "issue error")
+	})
+}
+
+func TestService_RefreshCredential_Challenge(t *testing.T) {
+	t.Run("challenge required", func(t *testing.T) {
+		svc := credentialrefresh.New(&credentialrefresh.Config{IssuerVCSPublicHost: vcsHost})
+
+		_, err := svc.RefreshCredential(context.Background(), newProfile(""),
+			newPresentation(t, holderDID, issuerDID), "")
+		requireCustomError(t, resterr.InvalidValue, err)
+		require.ErrorContains(t, err, "challenge is required")
+	})
+
+	t.Run("challenge unknown or used", func(t *testing.T) {
+		store := NewMockChallengeStore(gomock.NewController(t))
+		store.EXPECT().GetAndDelete(gomock.Any(), challengeID).Return(nil, credentialrefresh.ErrDataNotFound)
+
+		svc := credentialrefresh.New(&credentialrefresh.Config{
+			ChallengeStore:      store,
+			IssuerVCSPublicHost: vcsHost,
+		})
+
+		_, err := svc.RefreshCredential(context.Background(), newProfile(""),
+			newPresentation(t, holderDID, issuerDID), challengeID)
+		requireCustomError(t, resterr.InvalidValue, err)
+		require.ErrorContains(t, err, "challenge is unknown, used or expired")
+	})
+
+	t.Run("challenge expired", func(t *testing.T) {
+		store := NewMockChallengeStore(gomock.NewController(t))
+		store.EXPECT().GetAndDelete(gomock.Any(), challengeID).Return(&credentialrefresh.Challenge{
+			ID:             challengeID,
+			ProfileID:      profileID,
+			ProfileVersion: profileVersion,
+			ExpireAt:       time.Now().Add(-time.Second),
+		}, nil)
+
+		svc := credentialrefresh.New(&credentialrefresh.Config{
+			ChallengeStore:      store,
+			IssuerVCSPublicHost: vcsHost,
+		})
+
+		_, err := svc.RefreshCredential(context.Background(), newProfile(""),
+			newPresentation(t, holderDID, issuerDID), challengeID)
+		requireCustomError(t, resterr.InvalidValue, err)
+	})
+
+	t.Run("challenge of other profile", func(t *testing.T) {
+		store := NewMockChallengeStore(gomock.NewController(t))
+		store.EXPECT().GetAndDelete(gomock.Any(), challengeID).Return(&credentialrefresh.Challenge{
+			ID:             challengeID,
+			ProfileID:      "other-profile",
+			ProfileVersion: profileVersion,
+			ExpireAt:       time.Now().Add(time.Minute),
+		}, nil)
+
+		svc := credentialrefresh.New(&credentialrefresh.Config{
+			ChallengeStore:      store,
+			IssuerVCSPublicHost: vcsHost,
+		})
+
+		_, err := svc.RefreshCredential(context.Background(), newProfile(""),
+			newPresentation(t, holderDID, issuerDID), challengeID)
+		requireCustomError(t, resterr.InvalidValue, err)
+		require.ErrorContains(t, err, "challenge is not issued for the profile")
+	})
+
+	t.Run("challenge store error", func(t *testing.T) {
+		store := NewMockChallengeStore(gomock.NewController(t))
+		store.EXPECT().GetAndDelete(gomock.Any(), challengeID).Return(nil, errors.New("store error"))
+
+		svc := credentialrefresh.New(&credentialrefresh.Config{
+			ChallengeStore:      store,
+			IssuerVCSPublicHost: vcsHost,
+		})
+
+		_, err := svc.RefreshCredential(context.Background(), newProfile(""),
+			newPresentation(t, holderDID, issuerDID), challengeID)
+		require.ErrorContains(t, err, "get refresh challenge: store error")
+	})
+}
+
+func TestService_CreateChallenge(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var stored *credentialrefresh.Challenge
+
+		store := NewMockChallengeStore(gomock.NewController(t))
+		store.EXPECT().CountActive(gomock.Any(), profileID, profileVersion).Return(int64(1), nil)
+		store.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, challenge *credentialrefresh.Challenge) error {
+				stored = challenge
+
+				return nil
+			})
+
+		svc := credentialrefresh.New(&credentialrefresh.Config{
+			ChallengeStore:      store,
+			IssuerVCSPublicHost: vcsHost,
+			ChallengeTTL:        time.Minute,
+			MaxActiveChallenges: 2,
+		})
+
+		req, err := svc.CreateChallenge(context.Background(), newProfile(""))
+		require.NoError(t, err)
+		require.Equal(t, refreshURL, req.Domain)
+		require.NotEmpty(t, req.Challenge)
+
+		require.Equal(t, req.Challenge, stored.ID)
+		require.Equal(t, profileID, stored.ProfileID)
+		require.Equal(t, profileVersion, stored.ProfileVersion)
+		require.WithinDuration(t, time.Now().Add(time.Minute), stored.ExpireAt, 5*time.Second)
+	})
+
+	t.Run("refresh service not configured", func(t *testing.T) {
+		svc := credentialrefresh.New(&credentialrefresh.Config{
+			ChallengeStore:      NewMockChallengeStore(gomock.NewController(t)),
+			IssuerVCSPublicHost: vcsHost,
+		})
+
+		profile := newProfile("")
+		profile.CredentialTemplates[0].RefreshService = nil
+
+		_, err := svc.CreateChallenge(context.Background(), profile)
+		requireCustomError(t, resterr.CredentialTemplateNotFound, err)
+	})
+
+	t.Run("too many active challenges", func(t *testing.T) {
+		store := NewMockChallengeStore(gomock.NewController(t))
+		store.EXPECT().CountActive(gomock.Any(), profileID, profileVersion).Return(int64(2), nil)
+
+		svc := credentialrefresh.New(&credentialrefresh.Config{
+			ChallengeStore:      store,
+			IssuerVCSPublicHost: vcsHost,
+			MaxActiveChallenges: 2,
+		})
+
+		_, err := svc.CreateChallenge(context.Background(), newProfile(""))
+		requireCustomError(t, resterr.TooManyRequests, err)
+	})
+
+	t.Run("count error", func(t *testing.T) {
+		store := NewMockChallengeStore(gomock.NewController(t))
+		store.EXPECT().CountActive(gomock.Any(), profileID, profileVersion).Return(int64(0), errors.New("count error"))
+
+		svc := credentialrefresh.New(&credentialrefresh.Config{
+			ChallengeStore:      store,
+			IssuerVCSPublicHost: vcsHost,
+		})
+
+		_, err := svc.CreateChallenge(context.Background(), newProfile(""))
+		require.ErrorContains(t, err, "count refresh challenges: count error")
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		store := NewMockChallengeStore(gomock.NewController(t))
+		store.EXPECT().CountActive(gomock.Any(), profileID, profileVersion).Return(int64(0), nil)
+		store.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("store error"))
+
+		svc := credentialrefresh.New(&credentialrefresh.Config{
+			ChallengeStore:      store,
+			IssuerVCSPublicHost: vcsHost,
+		})
+
+		_, err := svc.CreateChallenge(context.Background(), newProfile(""))
+		require.ErrorContains(t, err, "store refresh challenge: store error")
+	})
+}
+
+func newChallengeStore(t *testing.T) *MockChallengeStore {
+	t.Helper()
+
+	store := NewMockChallengeStore(gomock.NewController(t))
+	store.EXPECT().GetAndDelete(gomock.Any(), challengeID).Return(&credentialrefresh.Challenge{
+		ID:             challengeID,
+		ProfileID:      profileID,
+		ProfileVersion: profileVersion,
+		ExpireAt:       time.Now().Add(time.Minute),
+	}, nil)
+
+	return store
+}
+
+func newProfile(claimEndpoint string) *profileapi.Issuer {
+	return &profileapi.Issuer{
+		ID:         profileID,
+		Version:    profileVersion,
+		SigningDID: &profileapi.SigningDID{DID: issuerDID},
+		VCConfig:   &profileapi.VCConfig{},
+		CredentialTemplates: []*profileapi.CredentialTemplate{
+			{
+				ID:   "templateID",
+				Type: "VerifiedEmployee",
+				RefreshService: &profileapi.RefreshServiceConfig{
+					ClaimEndpoint: claimEndpoint + "/claims",
+					ClaimsRequest: &profileapi.ClaimsRequestConfig{
+						AuthMethod:    profileapi.ClaimsRequestAuthClientCredentials,
+						TokenEndpoint: claimEndpoint + "/token",
+						Headers:       map[string]string{"X-Header": "header-value"},
+					},
+				},
+			},
+		},
+		OIDCConfig: &profileapi.OIDCConfig{
+			ClientID:           "client-id",
+			ClientSecretHandle: "client-secret",
+		},
+	}
+}
+
+// newClaimsServer returns a server of the claim endpoint, which issues client_credentials access tokens
+// to the profile OIDC client.
+func newClaimsServer(t *testing.T, claims http.Handler) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/token" {
+			claims.ServeHTTP(w, r)
+
+			return
+		}
+
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok || clientID != "client-id" || clientSecret != "client-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"access-token","token_type":"Bearer","expires_in":3600}`))
+	}))
+}
+
+func newPresentation(t *testing.T, holder, issuer string) *verifiable.Presentation {
+	t.Helper()
+
+	cred, err := verifiable.CreateCredential(verifiable.CredentialContents{
+		Context: []string{"https://www.w3.org/2018/credentials/v1"},
+		ID:      credentialID,
+		Types:   []string{verifiable.VCType, "VerifiedEmployee"},
+		Issuer:  &verifiable.Issuer{ID: issuer},
+		Subject: []verifiable.Subject{{
+			ID:           holderDID,
+			CustomFields: verifiable.CustomFields{"name": "John Smith"},
+		}},
+	}, nil)
+	require.NoError(t, err)
+
+	pres, err := verifiable.NewPresentation(verifiable.WithCredentials(cred))
+	require.NoError(t, err)
+
+	pres.Holder = holder
+
+	return pres
+}
+
+func requireCustomError(t *testing.T, expectedCode resterr.ErrorCode, actual error) {
+	t.Helper()
+
+	var actualErr *resterr.CustomError
+
+	require.ErrorAs(t, actual, &actualErr)
+	require.Equal(t, expectedCode, actualErr.Code)
+}
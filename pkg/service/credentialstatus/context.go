@@ -0,0 +1,103 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package credentialstatus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/trustbloc/vc-go/verifiable"
+)
+
+type statusListMaxAgeKey struct{}
+
+// WithStatusListMaxAge returns a copy of ctx that limits the age of a cached status list VC
+// that might be used by the status list resolver. Zero maxAge forces status list VC to be fetched.
+func WithStatusListMaxAge(ctx context.Context, maxAge time.Duration) context.Context {
+	return context.WithValue(ctx, statusListMaxAgeKey{}, maxAge)
+}
+
+// StatusListMaxAge returns max age of a cached status list VC set by WithStatusListMaxAge.
+func StatusListMaxAge(ctx context.Context) (time.Duration, bool) {
+	maxAge, ok := ctx.Value(statusListMaxAgeKey{}).(time.Duration)
+
+	return maxAge, ok
+}
+
+type statusListRecorderKey struct{}
+
+// StatusListVersion identifies a version of the status list VC used for a status check.
+type StatusListVersion struct {
+	// URL is the URL the status list VC was resolved from.
+	URL string `json:"url"`
+	// Digest is base64url encoded SHA-256 digest of the serialized status list VC.
+	Digest string `json:"digest"`
+	// ValidFrom is the issuance date of the status list VC, if set.
+	ValidFrom *time.Time `json:"validFrom,omitempty"`
+}
+
+// StatusListRecorder collects versions of status list VCs resolved during status checks. It is safe for
+// concurrent use.
+type StatusListRecorder struct {
+	mu       sync.Mutex
+	versions []StatusListVersion
+}
+
+// WithStatusListRecorder returns a copy of ctx that records versions of status list VCs resolved for status checks.
+func WithStatusListRecorder(ctx context.Context, recorder *StatusListRecorder) context.Context {
+	return context.WithValue(ctx, statusListRecorderKey{}, recorder)
+}
+
+// RecordStatusList adds the status list VC to the recorder set by WithStatusListRecorder. It does nothing
+// if ctx has no recorder.
+func RecordStatusList(ctx context.Context, statusListVCURL string, statusListVC *verifiable.Credential) error {
+	recorder, ok := ctx.Value(statusListRecorderKey{}).(*StatusListRecorder)
+	if !ok {
+		return nil
+	}
+
+	data, err := statusListVC.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("marshal status list vc: %w", err)
+	}
+
+	digest := sha256.Sum256(data)
+
+	version := StatusListVersion{
+		URL:    statusListVCURL,
+		Digest: base64.RawURLEncoding.EncodeToString(digest[:]),
+	}
+
+	if issued := statusListVC.Contents().Issued; issued != nil {
+		version.ValidFrom = &issued.Time
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	for _, v := range recorder.versions {
+		if v.URL == version.URL && v.Digest == version.Digest {
+			return nil
+		}
+	}
+
+	recorder.versions = append(recorder.versions, version)
+
+	return nil
+}
+
+// Versions returns recorded versions of status list VCs in the order they were resolved.
+func (r *StatusListRecorder) Versions() []StatusListVersion {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]StatusListVersion(nil), r.versions...)
+}
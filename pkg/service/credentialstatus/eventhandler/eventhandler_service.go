@@ -4,7 +4,7 @@ Copyright SecureKey Technologies Inc. All Rights Reserved.
 SPDX-License-Identifier: Apache-2.0
 */
 
-//go:generate mockgen -destination service_mocks_test.go -self_package mocks -package eventhandler -source=eventhandler_service.go -mock_names profileService=MockProfileService,kmsRegistry=MockKMSRegistry
+//go:generate mockgen -destination service_mocks_test.go -self_package mocks -package eventhandler -source=eventhandler_service.go -mock_names profileService=MockProfileService,kmsRegistry=MockKMSRegistry,metricsProvider=MockMetricsProvider
 
 package eventhandler
 
@@ -12,7 +12,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/piprate/json-gold/ld"
 	"github.com/trustbloc/logutil-go/pkg/log"
 	"github.com/trustbloc/vc-go/verifiable"
@@ -35,6 +38,12 @@ const (
 	jsonKeyProofPurpose       = "proofPurpose"
 	jsonKeyVerificationMethod = "verificationMethod"
 	jsonKeySignatureOfType    = "type"
+
+	defaultBatchSize   = 1000
+	defaultBatchWindow = 5 * time.Second
+
+	// flushLockTTL limits the time the CSL stays locked in case the instance publishing it is stopped.
+	flushLockTTL = time.Minute
 )
 
 var logger = log.New("credentialstatus-eventhandler")
@@ -52,12 +61,26 @@ type vcCrypto interface {
 		opts ...vccrypto.SigningOpts) (*verifiable.Credential, error)
 }
 
+type metricsProvider interface {
+	CSLPublishLag(value time.Duration)
+}
+
 type Config struct {
 	CSLVCStore     credentialstatus.CSLVCStore
 	ProfileService profileService
 	KMSRegistry    kmsRegistry
 	Crypto         vcCrypto
 	DocumentLoader ld.DocumentLoader
+	Metrics        metricsProvider
+	// PendingChangesStore enables batching of CSL changes. In case it is set, changes are stored
+	// and the CSL is signed and published once per BatchWindow or once BatchSize changes are pending.
+	// The CSL is locked in the store while it is published, so instances sharing the store don't
+	// overwrite each other's changes.
+	PendingChangesStore credentialstatus.CSLPendingChangesStore
+	// BatchWindow is a time window to coalesce CSL changes. Default is 5s.
+	BatchWindow time.Duration
+	// BatchSize is a number of pending changes that triggers publishing of the CSL. Default is 1000.
+	BatchSize int
 }
 
 type Service struct {
@@ -66,15 +89,43 @@ type Service struct {
 	kmsRegistry    kmsRegistry
 	crypto         vcCrypto
 	documentLoader ld.DocumentLoader
+	metrics        metricsProvider
+	pendingStore   credentialstatus.CSLPendingChangesStore
+	batchWindow    time.Duration
+	batchSize      int
+	instanceID     string
+
+	mu           sync.Mutex
+	pendingCount map[string]int
+	timers       map[string]*time.Timer
+	flushMutex   sync.Mutex
 }
 
 func New(conf *Config) *Service {
+	batchSize := conf.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	// Zero window would publish the CSL right after every change, which defeats batching.
+	batchWindow := conf.BatchWindow
+	if batchWindow <= 0 {
+		batchWindow = defaultBatchWindow
+	}
+
 	return &Service{
 		cslStore:       conf.CSLVCStore,
 		profileService: conf.ProfileService,
 		kmsRegistry:    conf.KMSRegistry,
 		crypto:         conf.Crypto,
 		documentLoader: conf.DocumentLoader,
+		metrics:        conf.Metrics,
+		pendingStore:   conf.PendingChangesStore,
+		batchWindow:    batchWindow,
+		batchSize:      batchSize,
+		instanceID:     uuid.NewString(),
+		pendingCount:   map[string]int{},
+		timers:         map[string]*time.Timer{},
 	}
 }
 
@@ -97,35 +148,201 @@ func (s *Service) HandleEvent(ctx context.Context, event *spi.Event) error { //n
 		return err
 	}
 
-	return s.handleEventPayload(ctx, payload)
+	changedAt := time.Now()
+	if event.Time != nil {
+		changedAt = event.Time.Time
+	}
+
+	return s.handleEventPayload(ctx, payload, changedAt)
 }
 
 func (s *Service) handleEventPayload(
-	ctx context.Context, payload credentialstatus.UpdateCredentialStatusEventPayload) error {
-	clsWrapper, err := s.getCSLVCWrapper(ctx, payload.CSLURL)
+	ctx context.Context, payload credentialstatus.UpdateCredentialStatusEventPayload, changedAt time.Time) error {
+	change := &credentialstatus.CSLPendingChange{
+		ID:             uuid.NewString(),
+		CSLURL:         payload.CSLURL,
+		ProfileID:      payload.ProfileID,
+		ProfileVersion: payload.ProfileVersion,
+		Index:          payload.Index,
+		Status:         payload.Status,
+		CreatedAt:      changedAt,
+	}
+
+	if s.pendingStore != nil {
+		return s.addPendingChange(ctx, change)
+	}
+
+	return s.publishChanges(ctx, payload.CSLURL, []*credentialstatus.CSLPendingChange{change})
+}
+
+// FlushPendingChanges signs and publishes all CSLs that have pending changes, e.g. left after restart.
+func (s *Service) FlushPendingChanges(ctx context.Context) error {
+	if s.pendingStore == nil {
+		return nil
+	}
+
+	cslURLs, err := s.pendingStore.GetCSLURLs(ctx)
+	if err != nil {
+		return fmt.Errorf("get CSL URLs of pending changes failed: %w", err)
+	}
+
+	for _, cslURL := range cslURLs {
+		if err = s.flush(ctx, cslURL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addPendingChange stores the change and publishes the CSL in case enough changes are pending.
+// Otherwise, the CSL is published once batch window is elapsed.
+func (s *Service) addPendingChange(ctx context.Context, change *credentialstatus.CSLPendingChange) error {
+	if err := s.pendingStore.Add(ctx, change); err != nil {
+		return fmt.Errorf("store pending change failed: %w", err)
+	}
+
+	s.mu.Lock()
+
+	s.pendingCount[change.CSLURL]++
+
+	if s.pendingCount[change.CSLURL] >= s.batchSize {
+		s.mu.Unlock()
+
+		return s.flush(ctx, change.CSLURL)
+	}
+
+	if _, ok := s.timers[change.CSLURL]; !ok {
+		s.scheduleFlush(change.CSLURL)
+	}
+
+	s.mu.Unlock()
+
+	return nil
+}
+
+// scheduleFlush publishes the CSL after batch window. Must be called under s.mu lock.
+func (s *Service) scheduleFlush(cslURL string) {
+	s.timers[cslURL] = time.AfterFunc(s.batchWindow, func() {
+		ctx := context.Background()
+
+		if err := s.flush(ctx, cslURL); err != nil {
+			logger.Errorc(ctx, "Failed to publish pending CSL changes, retrying later",
+				log.WithURL(cslURL), log.WithError(err))
+
+			s.mu.Lock()
+			if _, ok := s.timers[cslURL]; !ok {
+				s.scheduleFlush(cslURL)
+			}
+			s.mu.Unlock()
+		}
+	})
+}
+
+// flush publishes all pending changes of the CSL.
+func (s *Service) flush(ctx context.Context, cslURL string) error {
+	s.mu.Lock()
+
+	if timer, ok := s.timers[cslURL]; ok {
+		timer.Stop()
+		delete(s.timers, cslURL)
+	}
+
+	delete(s.pendingCount, cslURL)
+
+	s.mu.Unlock()
+
+	s.flushMutex.Lock()
+	defer s.flushMutex.Unlock()
+
+	locked, err := s.pendingStore.Lock(ctx, cslURL, s.instanceID, flushLockTTL)
+	if err != nil {
+		return fmt.Errorf("lock CSL failed: %w", err)
+	}
+
+	if !locked {
+		// Another instance is publishing the CSL. Changes it hasn't read yet are published on the next flush.
+		logger.Debugc(ctx, "CSL is locked by another instance, publishing is postponed", log.WithURL(cslURL))
+
+		s.mu.Lock()
+		if _, ok := s.timers[cslURL]; !ok {
+			s.scheduleFlush(cslURL)
+		}
+		s.mu.Unlock()
+
+		return nil
+	}
+
+	defer func() {
+		if unlockErr := s.pendingStore.Unlock(ctx, cslURL, s.instanceID); unlockErr != nil {
+			logger.Warnc(ctx, "Failed to unlock CSL", log.WithURL(cslURL), log.WithError(unlockErr))
+		}
+	}()
+
+	changes, err := s.pendingStore.GetByCSLURL(ctx, cslURL)
+	if err != nil {
+		return fmt.Errorf("get pending changes failed: %w", err)
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	if err = s.publishChanges(ctx, cslURL, changes); err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(changes))
+	for _, c := range changes {
+		ids = append(ids, c.ID)
+	}
+
+	if err = s.pendingStore.Delete(ctx, ids); err != nil {
+		return fmt.Errorf("delete pending changes failed: %w", err)
+	}
+
+	return nil
+}
+
+// publishChanges applies changes to the CSL in the given order, then signs and stores it.
+func (s *Service) publishChanges(
+	ctx context.Context, cslURL string, changes []*credentialstatus.CSLPendingChange) error {
+	clsWrapper, err := s.getCSLVCWrapper(ctx, cslURL)
 	if err != nil {
 		return fmt.Errorf("get CSL VC wrapper failed: %w", err)
 	}
 
 	cs := clsWrapper.VC.Contents().Subject
 
-	bitString, err := bitstring.DecodeBits(cs[0].CustomFields["encodedList"].(string))
+	encodedList := cs[0].CustomFields["encodedList"].(string) //nolint:errcheck
+
+	bitString, err := bitstring.DecodeBits(encodedList)
 	if err != nil {
 		return fmt.Errorf("get encodedList from CSL customFields failed: %w", err)
 	}
 
-	if errSet := bitString.Set(payload.Index, payload.Status); errSet != nil {
-		return fmt.Errorf("bitString.Set failed: %w", errSet)
+	for _, c := range changes {
+		if errSet := bitString.Set(c.Index, c.Status); errSet != nil {
+			return fmt.Errorf("bitString.Set failed: %w", errSet)
+		}
+	}
+
+	// The latest change defines the profile the CSL is signed with.
+	latest := changes[len(changes)-1]
+
+	var encodeOpts []bitstring.Opt
+	if bitstring.IsMultibaseEncoded(encodedList) {
+		encodeOpts = append(encodeOpts, bitstring.WithMultibaseEncoding())
 	}
 
-	cs[0].CustomFields["encodedList"], err = bitString.EncodeBits()
+	cs[0].CustomFields["encodedList"], err = bitString.EncodeBits(encodeOpts...)
 	if err != nil {
 		return fmt.Errorf("bitString.EncodeBits failed: %w", err)
 	}
 
 	clsWrapper.VC = clsWrapper.VC.WithModifiedSubject(cs)
 
-	signedCredentialBytes, err := s.signCSL(payload.ProfileID, payload.ProfileVersion, clsWrapper.VC)
+	signedCredentialBytes, err := s.signCSL(latest.ProfileID, latest.ProfileVersion, clsWrapper.VC)
 	if err != nil {
 		return fmt.Errorf("failed to sign CSL: %w", err)
 	}
@@ -134,10 +351,14 @@ func (s *Service) handleEventPayload(
 		VCByte: signedCredentialBytes,
 	}
 
-	if err = s.cslStore.Upsert(ctx, payload.CSLURL, vcWrapper); err != nil {
+	if err = s.cslStore.Upsert(ctx, cslURL, vcWrapper); err != nil {
 		return fmt.Errorf("cslStore.Upsert failed: %w", err)
 	}
 
+	if s.metrics != nil {
+		s.metrics.CSLPublishLag(time.Since(changes[0].CreatedAt))
+	}
+
 	return nil
 }
 
@@ -184,7 +405,7 @@ func (s *Service) getCSLVCWrapper(ctx context.Context, cslURL string) (*credenti
 		return nil, fmt.Errorf("failed to get CSL from store: %w", err)
 	}
 
-	cslVC, err := verifiable.ParseCredential(vcWrapper.VCByte,
+	cslVC, err := vc.ParseCredential(vcWrapper.VCByte,
 		verifiable.WithDisabledProofCheck(),
 		verifiable.WithJSONLDDocumentLoader(s.documentLoader))
 	if err != nil {
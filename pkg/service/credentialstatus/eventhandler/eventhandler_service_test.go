@@ -13,11 +13,13 @@ import (
 	"encoding/json"
 	"errors"
 	"net/url"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/piprate/json-gold/ld"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"github.com/trustbloc/kms-go/spi/kms"
 
@@ -253,7 +255,7 @@ func TestService_handleEventPayload(t *testing.T) {
 			Crypto:         crypto,
 		})
 
-		err = s.handleEventPayload(ctx, eventPayload)
+		err = s.handleEventPayload(ctx, eventPayload, time.Now())
 		require.NoError(t, err)
 
 		cslWrapper, err = cslStore.Get(ctx, cslURL)
@@ -284,7 +286,7 @@ func TestService_handleEventPayload(t *testing.T) {
 			Crypto:         crypto,
 		})
 
-		err = s.handleEventPayload(ctx, eventPayload)
+		err = s.handleEventPayload(ctx, eventPayload, time.Now())
 		require.Error(t, err)
 		require.ErrorContains(t, err, "get CSL VC wrapper failed")
 	})
@@ -319,7 +321,7 @@ func TestService_handleEventPayload(t *testing.T) {
 			Crypto:         crypto,
 		})
 
-		err = s.handleEventPayload(ctx, eventPayload)
+		err = s.handleEventPayload(ctx, eventPayload, time.Now())
 		require.Error(t, err)
 		require.ErrorContains(t, err, "get encodedList from CSL customFields failed")
 
@@ -353,7 +355,7 @@ func TestService_handleEventPayload(t *testing.T) {
 			Crypto:         crypto,
 		})
 
-		err = s.handleEventPayload(ctx, eventPayload)
+		err = s.handleEventPayload(ctx, eventPayload, time.Now())
 		require.Error(t, err)
 		require.ErrorContains(t, err, "bitString.Set failed")
 
@@ -390,7 +392,7 @@ func TestService_handleEventPayload(t *testing.T) {
 			Crypto:         crypto,
 		})
 
-		err = s.handleEventPayload(ctx, eventPayload)
+		err = s.handleEventPayload(ctx, eventPayload, time.Now())
 		require.Error(t, err)
 		require.ErrorContains(t, err, "failed to sign CSL")
 
@@ -427,7 +429,7 @@ func TestService_handleEventPayload(t *testing.T) {
 
 		cslStore.createErr = errors.New("some error")
 
-		err = s.handleEventPayload(ctx, eventPayload)
+		err = s.handleEventPayload(ctx, eventPayload, time.Now())
 		require.Error(t, err)
 		require.ErrorContains(t, err, "cslStore.Upsert failed")
 
@@ -437,6 +439,313 @@ func TestService_handleEventPayload(t *testing.T) {
 	})
 }
 
+func TestService_HandleEvent_Batching(t *testing.T) {
+	profile := getTestProfile()
+	loader := testutil.DocumentLoader(t)
+	ctx := context.Background()
+	mockProfileSrv := NewMockProfileService(gomock.NewController(t))
+	mockProfileSrv.EXPECT().GetProfile(gomock.Any(), gomock.Any()).AnyTimes().Return(profile, nil)
+	mockKMSRegistry := NewMockKMSRegistry(gomock.NewController(t))
+	mockKMSRegistry.EXPECT().GetKeyManager(gomock.Any()).AnyTimes().Return(&vcskms.MockKMS{}, nil)
+	crypto := vccrypto.New(
+		&vdrmock.VDRegistry{ResolveValue: createDIDDoc("did:test:abc")}, loader)
+
+	newCSLStore := func(t *testing.T) *mockCSLVCStore {
+		t.Helper()
+
+		cslStore := newMockCSLVCStore()
+
+		var cslWrapper *credentialstatus.CSLVCWrapper
+		require.NoError(t, json.Unmarshal([]byte(cslWrapperBytes), &cslWrapper))
+		cslWrapper.VC = getVerifiedCSL(t, cslWrapper.VCByte, loader, statusBytePositionIndex, false)
+
+		require.NoError(t, cslStore.Upsert(ctx, cslURL, cslWrapper))
+		cslStore.upsertCount = 0
+
+		return cslStore
+	}
+
+	t.Run("publish once batch size is reached", func(t *testing.T) {
+		cslStore := newCSLStore(t)
+		pendingStore := &mockPendingChangesStore{}
+
+		mockMetrics := NewMockMetricsProvider(gomock.NewController(t))
+		mockMetrics.EXPECT().CSLPublishLag(gomock.Any()).Times(1)
+
+		s := New(&Config{
+			DocumentLoader:      loader,
+			CSLVCStore:          cslStore,
+			ProfileService:      mockProfileSrv,
+			KMSRegistry:         mockKMSRegistry,
+			Crypto:              crypto,
+			Metrics:             mockMetrics,
+			PendingChangesStore: pendingStore,
+			BatchWindow:         time.Hour,
+			BatchSize:           3,
+		})
+
+		require.NoError(t, s.HandleEvent(ctx, createStatusUpdatedEvent(t, cslURL, profileID, profileVersion, 1, true)))
+		require.NoError(t, s.HandleEvent(ctx, createStatusUpdatedEvent(t, cslURL, profileID, profileVersion, 2, true)))
+
+		require.Equal(t, 0, cslStore.getUpsertCount())
+		require.Equal(t, 2, pendingStore.count())
+
+		require.NoError(t, s.HandleEvent(ctx, createStatusUpdatedEvent(t, cslURL, profileID, profileVersion, 1, false)))
+
+		require.Equal(t, 1, cslStore.getUpsertCount())
+		require.Equal(t, 0, pendingStore.count())
+
+		cslWrapper, err := cslStore.Get(ctx, cslURL)
+		require.NoError(t, err)
+
+		// Changes are applied in order, so the latest status of index 1 wins.
+		getVerifiedCSL(t, cslWrapper.VCByte, loader, 1, false)
+		getVerifiedCSL(t, cslWrapper.VCByte, loader, 2, true)
+	})
+
+	t.Run("publish once batch window is elapsed", func(t *testing.T) {
+		cslStore := newCSLStore(t)
+		pendingStore := &mockPendingChangesStore{}
+
+		s := New(&Config{
+			DocumentLoader:      loader,
+			CSLVCStore:          cslStore,
+			ProfileService:      mockProfileSrv,
+			KMSRegistry:         mockKMSRegistry,
+			Crypto:              crypto,
+			PendingChangesStore: pendingStore,
+			BatchWindow:         50 * time.Millisecond,
+		})
+
+		require.NoError(t, s.HandleEvent(ctx, createStatusUpdatedEvent(t, cslURL, profileID, profileVersion, 1, true)))
+		require.NoError(t, s.HandleEvent(ctx, createStatusUpdatedEvent(t, cslURL, profileID, profileVersion, 2, true)))
+
+		require.Eventually(t, func() bool {
+			return pendingStore.count() == 0
+		}, 5*time.Second, 10*time.Millisecond)
+
+		require.Equal(t, 1, cslStore.getUpsertCount())
+
+		cslWrapper, err := cslStore.Get(ctx, cslURL)
+		require.NoError(t, err)
+
+		getVerifiedCSL(t, cslWrapper.VCByte, loader, 1, true)
+		getVerifiedCSL(t, cslWrapper.VCByte, loader, 2, true)
+	})
+
+	t.Run("retry publishing after failure", func(t *testing.T) {
+		cslStore := newCSLStore(t)
+		pendingStore := &mockPendingChangesStore{}
+
+		pendingStore.deleteErr = errors.New("delete error")
+
+		s := New(&Config{
+			DocumentLoader:      loader,
+			CSLVCStore:          cslStore,
+			ProfileService:      mockProfileSrv,
+			KMSRegistry:         mockKMSRegistry,
+			Crypto:              crypto,
+			PendingChangesStore: pendingStore,
+			BatchWindow:         20 * time.Millisecond,
+		})
+
+		require.NoError(t, s.HandleEvent(ctx, createStatusUpdatedEvent(t, cslURL, profileID, profileVersion, 1, true)))
+
+		require.Eventually(t, func() bool {
+			return cslStore.getUpsertCount() >= 2
+		}, 5*time.Second, 10*time.Millisecond)
+
+		pendingStore.mu.Lock()
+		pendingStore.deleteErr = nil
+		pendingStore.mu.Unlock()
+
+		require.Eventually(t, func() bool {
+			return pendingStore.count() == 0
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("flush pending changes", func(t *testing.T) {
+		cslStore := newCSLStore(t)
+		pendingStore := &mockPendingChangesStore{
+			changes: []*credentialstatus.CSLPendingChange{
+				{ID: "1", CSLURL: cslURL, ProfileID: profileID, ProfileVersion: profileVersion,
+					Index: 1, Status: true, CreatedAt: time.Now()},
+				{ID: "2", CSLURL: cslURL, ProfileID: profileID, ProfileVersion: profileVersion,
+					Index: 3, Status: true, CreatedAt: time.Now()},
+			},
+		}
+
+		s := New(&Config{
+			DocumentLoader:      loader,
+			CSLVCStore:          cslStore,
+			ProfileService:      mockProfileSrv,
+			KMSRegistry:         mockKMSRegistry,
+			Crypto:              crypto,
+			PendingChangesStore: pendingStore,
+			BatchWindow:         time.Hour,
+		})
+
+		require.NoError(t, s.FlushPendingChanges(ctx))
+		require.Equal(t, 0, pendingStore.count())
+		require.Equal(t, 1, cslStore.getUpsertCount())
+
+		cslWrapper, err := cslStore.Get(ctx, cslURL)
+		require.NoError(t, err)
+
+		getVerifiedCSL(t, cslWrapper.VCByte, loader, 1, true)
+		getVerifiedCSL(t, cslWrapper.VCByte, loader, 3, true)
+
+		// Nothing to flush.
+		require.NoError(t, s.FlushPendingChanges(ctx))
+		require.Equal(t, 1, cslStore.getUpsertCount())
+	})
+
+	t.Run("CSL is locked by another instance", func(t *testing.T) {
+		cslStore := newCSLStore(t)
+		pendingStore := &mockPendingChangesStore{locks: map[string]string{cslURL: "other-instance"}}
+
+		s := New(&Config{
+			DocumentLoader:      loader,
+			CSLVCStore:          cslStore,
+			ProfileService:      mockProfileSrv,
+			KMSRegistry:         mockKMSRegistry,
+			Crypto:              crypto,
+			PendingChangesStore: pendingStore,
+			BatchWindow:         20 * time.Millisecond,
+			BatchSize:           1,
+		})
+
+		// Batch size is reached, but the CSL is published by another instance.
+		require.NoError(t, s.HandleEvent(ctx, createStatusUpdatedEvent(t, cslURL, profileID, profileVersion, 1, true)))
+
+		require.Equal(t, 0, cslStore.getUpsertCount())
+		require.Equal(t, 1, pendingStore.count())
+
+		pendingStore.unlock(cslURL)
+
+		require.Eventually(t, func() bool {
+			return pendingStore.count() == 0
+		}, 5*time.Second, 10*time.Millisecond)
+
+		require.Equal(t, 1, cslStore.getUpsertCount())
+
+		pendingStore.mu.Lock()
+		require.Empty(t, pendingStore.locks)
+		pendingStore.mu.Unlock()
+	})
+
+	t.Run("error lock CSL", func(t *testing.T) {
+		pendingStore := &mockPendingChangesStore{
+			lockErr: errors.New("lock error"),
+			changes: []*credentialstatus.CSLPendingChange{
+				{ID: "1", CSLURL: cslURL, ProfileID: profileID, ProfileVersion: profileVersion, Index: 1},
+			},
+		}
+
+		s := New(&Config{
+			PendingChangesStore: pendingStore,
+			BatchWindow:         time.Hour,
+		})
+
+		require.ErrorContains(t, s.FlushPendingChanges(ctx), "lock CSL failed: lock error")
+	})
+
+	t.Run("default batch window if only batch size is set", func(t *testing.T) {
+		cslStore := newCSLStore(t)
+		pendingStore := &mockPendingChangesStore{}
+
+		s := New(&Config{
+			DocumentLoader:      loader,
+			CSLVCStore:          cslStore,
+			ProfileService:      mockProfileSrv,
+			KMSRegistry:         mockKMSRegistry,
+			Crypto:              crypto,
+			PendingChangesStore: pendingStore,
+			BatchSize:           3,
+		})
+
+		require.Equal(t, defaultBatchWindow, s.batchWindow)
+
+		require.NoError(t, s.HandleEvent(ctx, createStatusUpdatedEvent(t, cslURL, profileID, profileVersion, 1, true)))
+
+		require.Equal(t, 0, cslStore.getUpsertCount())
+		require.Equal(t, 1, pendingStore.count())
+
+		s.mu.Lock()
+		s.timers[cslURL].Stop()
+		s.mu.Unlock()
+	})
+
+	t.Run("flush pending changes - batching disabled", func(t *testing.T) {
+		s := New(&Config{})
+
+		require.NoError(t, s.FlushPendingChanges(ctx))
+	})
+
+	t.Run("error store pending change", func(t *testing.T) {
+		s := New(&Config{
+			PendingChangesStore: &mockPendingChangesStore{addErr: errors.New("add error")},
+			BatchWindow:         time.Hour,
+		})
+
+		err := s.HandleEvent(ctx, createStatusUpdatedEvent(t, cslURL, profileID, profileVersion, 1, true))
+		require.ErrorContains(t, err, "store pending change failed")
+	})
+
+	t.Run("error get CSL URLs", func(t *testing.T) {
+		s := New(&Config{
+			PendingChangesStore: &mockPendingChangesStore{getCSLURLsErr: errors.New("get error")},
+		})
+
+		require.ErrorContains(t, s.FlushPendingChanges(ctx), "get CSL URLs of pending changes failed")
+	})
+
+	t.Run("error get pending changes", func(t *testing.T) {
+		s := New(&Config{
+			PendingChangesStore: &mockPendingChangesStore{
+				getErr:  errors.New("get error"),
+				changes: []*credentialstatus.CSLPendingChange{{ID: "1", CSLURL: cslURL}},
+			},
+		})
+
+		require.ErrorContains(t, s.FlushPendingChanges(ctx), "get pending changes failed")
+	})
+
+	t.Run("error publish pending changes", func(t *testing.T) {
+		pendingStore := &mockPendingChangesStore{
+			changes: []*credentialstatus.CSLPendingChange{{ID: "1", CSLURL: cslURL}},
+		}
+
+		s := New(&Config{
+			CSLVCStore:          newMockCSLVCStore(),
+			PendingChangesStore: pendingStore,
+		})
+
+		require.ErrorContains(t, s.FlushPendingChanges(ctx), "get CSL VC wrapper failed")
+		require.Equal(t, 1, pendingStore.count())
+	})
+
+	t.Run("error delete pending changes", func(t *testing.T) {
+		pendingStore := &mockPendingChangesStore{
+			deleteErr: errors.New("delete error"),
+			changes: []*credentialstatus.CSLPendingChange{
+				{ID: "1", CSLURL: cslURL, ProfileID: profileID, ProfileVersion: profileVersion, Index: 1, Status: true},
+			},
+		}
+
+		s := New(&Config{
+			DocumentLoader:      loader,
+			CSLVCStore:          newCSLStore(t),
+			ProfileService:      mockProfileSrv,
+			KMSRegistry:         mockKMSRegistry,
+			Crypto:              crypto,
+			PendingChangesStore: pendingStore,
+		})
+
+		require.ErrorContains(t, s.FlushPendingChanges(ctx), "delete pending changes failed")
+	})
+}
+
 func TestService_signCSL(t *testing.T) {
 	profile := getTestProfile()
 	loader := testutil.DocumentLoader(t)
@@ -709,10 +1018,12 @@ func createStatusUpdatedEvent(
 }
 
 type mockCSLVCStore struct {
-	createErr error
-	getCSLErr error
-	findErr   error
-	s         map[string]*credentialstatus.CSLVCWrapper
+	mu          sync.Mutex
+	createErr   error
+	getCSLErr   error
+	findErr     error
+	upsertCount int
+	s           map[string]*credentialstatus.CSLVCWrapper
 }
 
 func newMockCSLVCStore() *mockCSLVCStore {
@@ -732,16 +1043,23 @@ func (m *mockCSLVCStore) GetCSLURL(issuerURL, issuerID string, listID credential
 }
 
 func (m *mockCSLVCStore) Upsert(_ context.Context, cslURL string, cslWrapper *credentialstatus.CSLVCWrapper) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	if m.createErr != nil {
 		return m.createErr
 	}
 
 	m.s[cslURL] = cslWrapper
+	m.upsertCount++
 
 	return nil
 }
 
 func (m *mockCSLVCStore) Get(_ context.Context, cslURL string) (*credentialstatus.CSLVCWrapper, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	if m.findErr != nil {
 		return nil, m.findErr
 	}
@@ -754,6 +1072,125 @@ func (m *mockCSLVCStore) Get(_ context.Context, cslURL string) (*credentialstatu
 	return w, nil
 }
 
+func (m *mockCSLVCStore) getUpsertCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.upsertCount
+}
+
+type mockPendingChangesStore struct {
+	mu            sync.Mutex
+	addErr        error
+	getErr        error
+	getCSLURLsErr error
+	deleteErr     error
+	lockErr       error
+	changes       []*credentialstatus.CSLPendingChange
+	locks         map[string]string
+}
+
+func (m *mockPendingChangesStore) Add(_ context.Context, change *credentialstatus.CSLPendingChange) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.addErr != nil {
+		return m.addErr
+	}
+
+	m.changes = append(m.changes, change)
+
+	return nil
+}
+
+func (m *mockPendingChangesStore) GetByCSLURL(
+	_ context.Context, cslURL string) ([]*credentialstatus.CSLPendingChange, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.getErr != nil {
+		return nil, m.getErr
+	}
+
+	return lo.Filter(m.changes, func(c *credentialstatus.CSLPendingChange, _ int) bool {
+		return c.CSLURL == cslURL
+	}), nil
+}
+
+func (m *mockPendingChangesStore) GetCSLURLs(_ context.Context) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.getCSLURLsErr != nil {
+		return nil, m.getCSLURLsErr
+	}
+
+	return lo.Uniq(lo.Map(m.changes, func(c *credentialstatus.CSLPendingChange, _ int) string {
+		return c.CSLURL
+	})), nil
+}
+
+func (m *mockPendingChangesStore) Delete(_ context.Context, ids []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+
+	m.changes = lo.Reject(m.changes, func(c *credentialstatus.CSLPendingChange, _ int) bool {
+		return lo.Contains(ids, c.ID)
+	})
+
+	return nil
+}
+
+func (m *mockPendingChangesStore) Lock(_ context.Context, cslURL, owner string, _ time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.lockErr != nil {
+		return false, m.lockErr
+	}
+
+	if m.locks == nil {
+		m.locks = map[string]string{}
+	}
+
+	if lockOwner, ok := m.locks[cslURL]; ok && lockOwner != owner {
+		return false, nil
+	}
+
+	m.locks[cslURL] = owner
+
+	return true, nil
+}
+
+func (m *mockPendingChangesStore) Unlock(_ context.Context, cslURL, owner string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.locks[cslURL] == owner {
+		delete(m.locks, cslURL)
+	}
+
+	return nil
+}
+
+func (m *mockPendingChangesStore) unlock(cslURL string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.locks, cslURL)
+}
+
+func (m *mockPendingChangesStore) count() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.changes)
+}
+
 func getTestProfile() *profileapi.Issuer {
 	return &profileapi.Issuer{
 		ID:      profileID,
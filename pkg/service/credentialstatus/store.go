@@ -6,7 +6,12 @@ SPDX-License-Identifier: Apache-2.0
 
 package credentialstatus
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/trustbloc/vcs/pkg/doc/vc"
+)
 
 type CSLVCStore interface {
 	// GetCSLURL returns the public URL to the CSL.
@@ -20,15 +25,58 @@ type CSLVCStore interface {
 type CSLIndexStore interface {
 	// Upsert updates CSL Indexes.
 	Upsert(ctx context.Context, cslURL string, cslWrapper *CSLIndexWrapper) error
+	// Create stores CSL Indexes in case they do not exist yet. Returns ErrDataAlreadyExists otherwise.
+	Create(ctx context.Context, cslURL string, cslWrapper *CSLIndexWrapper) error
 	// Get returns CSLIndexWrapper based on URL to the CSL.
 	Get(ctx context.Context, cslURL string) (*CSLIndexWrapper, error)
-	// GetLatestListID returns latest ListID, that is topical on a moment given CSL is creating.
-	GetLatestListID(ctx context.Context) (ListID, error)
-	// UpdateLatestListID updates underlying ListID.
-	UpdateLatestListID(ctx context.Context, id ListID) error
+	// AssignIndex atomically marks index as used in the CSL and returns updated CSLIndexWrapper.
+	// Returns ErrIndexAlreadyUsed in case index is already used, e.g. by another VCS instance.
+	AssignIndex(ctx context.Context, cslURL string, index int) (*CSLIndexWrapper, error)
+	// List returns CSLIndexWrappers of all CSLs which URL starts with cslURLPrefix.
+	List(ctx context.Context, cslURLPrefix string) ([]*CSLIndexWrapper, error)
+	// GetLatestListID returns latest ListID of the sequence, that is topical on a moment given CSL is creating.
+	GetLatestListID(ctx context.Context, seq ListSequence) (ListID, error)
+	// UpdateLatestListID replaces ListID of the sequence with id in case it still equals to prevID.
+	// Returns ErrLatestListIDChanged in case ListID was already updated, e.g. by another VCS instance.
+	UpdateLatestListID(ctx context.Context, seq ListSequence, prevID, id ListID) error
+}
+
+// CSLPendingChangesStore keeps CSL changes that are not signed and published yet.
+type CSLPendingChangesStore interface {
+	// Add stores the pending change.
+	Add(ctx context.Context, change *CSLPendingChange) error
+	// GetByCSLURL returns pending changes of the CSL ordered by creation time.
+	GetByCSLURL(ctx context.Context, cslURL string) ([]*CSLPendingChange, error)
+	// GetCSLURLs returns URLs of all CSLs that have pending changes.
+	GetCSLURLs(ctx context.Context) ([]string, error)
+	// Delete removes pending changes with given IDs.
+	Delete(ctx context.Context, ids []string) error
+	// Lock acquires the lock of the CSL for the owner until it is unlocked or ttl is elapsed.
+	// Returns false if the CSL is locked by another owner.
+	Lock(ctx context.Context, cslURL, owner string, ttl time.Duration) (bool, error)
+	// Unlock releases the lock of the CSL held by the owner.
+	Unlock(ctx context.Context, cslURL, owner string) error
+}
+
+// CSLPendingChange is the status of a single credential to be set in the CSL.
+type CSLPendingChange struct {
+	ID             string
+	CSLURL         string
+	ProfileID      string
+	ProfileVersion string
+	Index          int
+	Status         bool
+	CreatedAt      time.Time
 }
 
 // ListID is used for the pseudo-random shuffling of suffixes of CSL URL during the credential issuance.
-// The value of ListID is common among all issuers.
-// In case any Issuer issued startcmd.cslSize credentials then ListID is updated.
+// The value of ListID is common among all profiles of ListSequence.
+// In case profiles of the sequence issued startcmd.cslSize credentials then ListID is updated.
 type ListID string
+
+// ListSequence identifies the sequence of CSLs with its own latest ListID.
+type ListSequence struct {
+	ProfileGroupID string
+	StatusPurpose  string
+	StatusType     vc.StatusType
+}
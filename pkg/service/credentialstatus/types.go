@@ -19,7 +19,10 @@ import (
 )
 
 var (
-	ErrDataNotFound = errors.New("data not found")
+	ErrDataNotFound        = errors.New("data not found")
+	ErrDataAlreadyExists   = errors.New("data already exists")
+	ErrIndexAlreadyUsed    = errors.New("index already used")
+	ErrLatestListIDChanged = errors.New("latest list id changed")
 )
 
 // CSL (Credential Status List) - is a verifiable.Credential that stores the
@@ -39,6 +42,10 @@ type CSLIndexWrapper struct {
 
 	ProfileGroupID string `json:"profileGroupID"`
 
+	StatusPurpose string `json:"statusPurpose,omitempty"`
+
+	StatusType vc.StatusType `json:"statusType,omitempty"`
+
 	Status string `json:"status"`
 
 	OwnerID string `json:"ownerID"`
@@ -71,6 +78,16 @@ type StatusListEntry struct {
 	TypedID *verifiable.TypedID
 }
 
+// CSLInfo describes the fill level of the CSL.
+type CSLInfo struct {
+	CSLURL        string
+	ListID        ListID
+	StatusPurpose string
+	StatusType    vc.StatusType
+	ListSize      int
+	UsedIndexes   int
+}
+
 type ServiceInterface interface {
 	CreateStatusListEntry(
 		ctx context.Context,
@@ -87,6 +104,7 @@ type ServiceInterface interface {
 	GetStatusListVC(ctx context.Context, profileGroupID profileapi.ID, statusID string) (*CSL, error)
 	UpdateVCStatus(ctx context.Context, params UpdateVCStatusParams) error
 	Resolve(ctx context.Context, statusListVCURI string) (*CSL, error)
+	ListStatusLists(ctx context.Context, profileGroupID profileapi.ID) ([]*CSLInfo, error)
 }
 
 // UpdateCredentialStatusEventPayload represents the event payload for credential status update.
@@ -108,4 +126,6 @@ type CredentialMetadata struct {
 	TransactionID  string            `json:"transactionId,omitempty"`
 	IssuanceDate   *time.TimeWrapper `json:"issuanceDate,omitempty"`
 	ExpirationDate *time.TimeWrapper `json:"expirationDate,omitempty"`
+	// PreviousCredentialID is ID of the credential replaced by the refreshed credential.
+	PreviousCredentialID string `json:"previousCredentialId,omitempty"`
 }
@@ -0,0 +1,298 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+//go:generate mockgen -destination service_mocks_test.go -self_package mocks -package didweb -source=didweb_service.go -mock_names issuerProfileService=MockIssuerProfileService,verifierProfileService=MockVerifierProfileService,didConfigService=MockDIDConfigService
+
+package didweb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/trustbloc/did-go/doc/did"
+	"github.com/trustbloc/did-go/doc/did/endpoint"
+
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/restapi/resterr"
+	"github.com/trustbloc/vcs/pkg/service/didconfiguration"
+)
+
+const (
+	didWebPrefix = "did:web:"
+	wellKnownDir = ".well-known"
+
+	jws2020ContextURL  = "https://w3id.org/security/suites/jws-2020/v1"
+	linkedDomainsType  = "LinkedDomains"
+	linkedDomainsIDFmt = "%s#LinkedDomains"
+)
+
+// ErrDocumentNotFound is returned when there is no did:web profile DID for requested path.
+var ErrDocumentNotFound = resterr.NewCustomError(resterr.DoesntExist, errors.New("did document not found"))
+
+// ErrDIDConfigurationNotFound is returned when there is no did:web profile linked to requested host.
+var ErrDIDConfigurationNotFound = resterr.NewCustomError(resterr.DoesntExist,
+	errors.New("did configuration not found"))
+
+type issuerProfileService interface {
+	ListProfiles() ([]*profileapi.Issuer, error)
+}
+
+type verifierProfileService interface {
+	ListProfiles() ([]*profileapi.Verifier, error)
+}
+
+type didConfigService interface {
+	DidConfig(
+		ctx context.Context,
+		profileType didconfiguration.ProfileType,
+		profileID string,
+		profileVersion string,
+	) (*didconfiguration.DidConfiguration, error)
+}
+
+type Config struct {
+	IssuerProfileService   issuerProfileService
+	VerifierProfileService verifierProfileService
+	DIDConfigService       didConfigService
+}
+
+// Service builds did:web documents of issuer and verifier profiles signing DIDs.
+type Service struct {
+	issuerProfileService   issuerProfileService
+	verifierProfileService verifierProfileService
+	didConfigService       didConfigService
+}
+
+func New(config *Config) *Service {
+	return &Service{
+		issuerProfileService:   config.IssuerProfileService,
+		verifierProfileService: config.VerifierProfileService,
+		didConfigService:       config.DIDConfigService,
+	}
+}
+
+type profileDID struct {
+	signingDID     *profileapi.SigningDID
+	url            string
+	profileType    didconfiguration.ProfileType
+	profileID      string
+	profileVersion string
+}
+
+type linkedDomains struct {
+	Origins []string `json:"origins"`
+}
+
+// DIDDocument returns did:web document published at the given host and path, e.g. "/.well-known/did.json"
+// for did:web:example.com or "/issuer/1/did.json" for did:web:example.com:issuer:1. The document is built
+// from the current profile signing DIDs, so rotated keys are published without any additional steps.
+func (s *Service) DIDDocument(_ context.Context, host, path string) (*did.Doc, error) {
+	profileDIDs, err := s.getProfileDIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	didID, ok := findDID(profileDIDs, host, path)
+	if !ok {
+		return nil, ErrDocumentNotFound
+	}
+
+	doc := &did.Doc{
+		Context: []string{did.ContextV1, jws2020ContextURL},
+		ID:      didID,
+	}
+
+	vmIDs := map[string]struct{}{}
+	var origins []string
+
+	for _, p := range profileDIDs {
+		if p.signingDID.DID != didID {
+			continue
+		}
+
+		for _, v := range p.signingDID.VerificationMethods {
+			if _, ok = vmIDs[v.ID]; ok {
+				continue
+			}
+
+			vmIDs[v.ID] = struct{}{}
+
+			vm, vmErr := did.NewVerificationMethodFromJWK(v.ID, v.Type, didID, v.PublicKeyJWK)
+			if vmErr != nil {
+				return nil, fmt.Errorf("create verification method %s: %w", v.ID, vmErr)
+			}
+
+			doc.VerificationMethod = append(doc.VerificationMethod, *vm)
+			doc.AssertionMethod = append(doc.AssertionMethod, *did.NewReferencedVerification(vm, did.AssertionMethod))
+			doc.Authentication = append(doc.Authentication, *did.NewReferencedVerification(vm, did.Authentication))
+		}
+
+		if p.url != "" && !lo.Contains(origins, p.url) {
+			origins = append(origins, p.url)
+		}
+	}
+
+	// Linked domains are verified with DID configuration published by the origin, see DIDConfiguration.
+	if len(origins) > 0 {
+		doc.Service = []did.Service{{
+			ID:              fmt.Sprintf(linkedDomainsIDFmt, didID),
+			Type:            linkedDomainsType,
+			ServiceEndpoint: endpoint.NewDIDCoreEndpoint(&linkedDomains{Origins: origins}),
+		}}
+	}
+
+	return doc, nil
+}
+
+// DIDConfiguration returns DID configuration published at "/.well-known/did-configuration.json" of the given
+// host. It contains domain linkage credentials of did:web profile DIDs whose profile URL is hosted by the host.
+// For origins hosted elsewhere, DID configuration of the profile has to be published by the origin itself.
+func (s *Service) DIDConfiguration(ctx context.Context, host string) (*didconfiguration.DidConfiguration, error) {
+	profileDIDs, err := s.getProfileDIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		didConfig *didconfiguration.DidConfiguration
+		linked    []string
+	)
+
+	for _, p := range profileDIDs {
+		u, parseErr := url.Parse(p.url)
+		if parseErr != nil || !strings.EqualFold(u.Host, host) {
+			continue
+		}
+
+		// Versions of the same profile share the DID and URL, so the DID is linked once.
+		key := p.signingDID.DID + " " + p.url
+		if lo.Contains(linked, key) {
+			continue
+		}
+
+		linked = append(linked, key)
+
+		profileConfig, configErr := s.didConfigService.DidConfig(ctx, p.profileType, p.profileID, p.profileVersion)
+		if configErr != nil {
+			return nil, fmt.Errorf("did configuration of %s profile %s: %w", p.profileType, p.profileID, configErr)
+		}
+
+		if didConfig == nil {
+			didConfig = &didconfiguration.DidConfiguration{Context: profileConfig.Context}
+		}
+
+		didConfig.LinkedDiDs = append(didConfig.LinkedDiDs, profileConfig.LinkedDiDs...)
+	}
+
+	if didConfig == nil {
+		return nil, ErrDIDConfigurationNotFound
+	}
+
+	return didConfig, nil
+}
+
+func (s *Service) getProfileDIDs() ([]*profileDID, error) {
+	var profileDIDs []*profileDID
+
+	issuers, err := s.issuerProfileService.ListProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("list issuer profiles: %w", err)
+	}
+
+	for _, p := range issuers {
+		if isDIDWeb(p.SigningDID) {
+			profileDIDs = append(profileDIDs, &profileDID{
+				signingDID:     p.SigningDID,
+				url:            p.URL,
+				profileType:    didconfiguration.ProfileTypeIssuer,
+				profileID:      p.ID,
+				profileVersion: p.Version,
+			})
+		}
+	}
+
+	verifiers, err := s.verifierProfileService.ListProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("list verifier profiles: %w", err)
+	}
+
+	for _, p := range verifiers {
+		if isDIDWeb(p.SigningDID) {
+			profileDIDs = append(profileDIDs, &profileDID{
+				signingDID:     p.SigningDID,
+				url:            p.URL,
+				profileType:    didconfiguration.ProfileTypeVerifier,
+				profileID:      p.ID,
+				profileVersion: p.Version,
+			})
+		}
+	}
+
+	return profileDIDs, nil
+}
+
+// findDID finds did:web DID published at the given path. In case several DIDs with different domains
+// are published at the same path, DID of the requested host is preferred.
+func findDID(profileDIDs []*profileDID, host, path string) (string, bool) {
+	var found []string
+
+	for _, p := range profileDIDs {
+		domain, didPath, err := ParseDID(p.signingDID.DID)
+		if err != nil || didPath != path {
+			continue
+		}
+
+		if strings.EqualFold(domain, host) {
+			return p.signingDID.DID, true
+		}
+
+		if !lo.Contains(found, p.signingDID.DID) {
+			found = append(found, p.signingDID.DID)
+		}
+	}
+
+	if len(found) != 1 {
+		return "", false
+	}
+
+	return found[0], true
+}
+
+// ParseDID returns domain (host with optional port) and path of did.json document for the given did:web DID.
+func ParseDID(didID string) (string, string, error) {
+	if !strings.HasPrefix(didID, didWebPrefix) {
+		return "", "", fmt.Errorf("not a did:web DID: %s", didID)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(didID, didWebPrefix), ":")
+
+	domain, err := url.PathUnescape(parts[0])
+	if err != nil || domain == "" {
+		return "", "", fmt.Errorf("invalid did:web domain: %s", didID)
+	}
+
+	pathParts := parts[1:]
+	if len(pathParts) == 0 {
+		pathParts = []string{wellKnownDir}
+	}
+
+	for i, p := range pathParts {
+		pathParts[i], err = url.PathUnescape(p)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid did:web path: %s", didID)
+		}
+	}
+
+	return domain, "/" + strings.Join(pathParts, "/") + "/did.json", nil
+}
+
+func isDIDWeb(signingDID *profileapi.SigningDID) bool {
+	return signingDID != nil && strings.HasPrefix(signingDID.DID, didWebPrefix)
+}
@@ -0,0 +1,323 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package didweb
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/did-go/doc/did"
+	"github.com/trustbloc/kms-go/doc/jose/jwk"
+	"github.com/trustbloc/kms-go/doc/jose/jwk/jwksupport"
+
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/service/didconfiguration"
+)
+
+const (
+	rootDID = "did:web:example.com"
+	pathDID = "did:web:example.com%3A8080:issuer:1"
+)
+
+func TestService_DIDDocument(t *testing.T) {
+	key1, key2 := newJWK(t), newJWK(t)
+
+	issuers := []*profileapi.Issuer{
+		{
+			ID:      "issuer",
+			Version: "v1.0",
+			URL:     "https://issuer.example.com",
+			SigningDID: &profileapi.SigningDID{
+				DID: rootDID,
+				VerificationMethods: []*profileapi.VerificationMethod{
+					{ID: rootDID + "#key1", Type: "JsonWebKey2020", PublicKeyJWK: key1},
+				},
+			},
+		},
+		{
+			ID:      "issuer",
+			Version: "v1.1",
+			URL:     "https://issuer.example.com",
+			SigningDID: &profileapi.SigningDID{
+				DID: rootDID,
+				VerificationMethods: []*profileapi.VerificationMethod{
+					{ID: rootDID + "#key1", Type: "JsonWebKey2020", PublicKeyJWK: key1},
+					{ID: rootDID + "#key2", Type: "JsonWebKey2020", PublicKeyJWK: key2},
+				},
+			},
+		},
+		{
+			ID:         "orb-issuer",
+			SigningDID: &profileapi.SigningDID{DID: "did:orb:abc"},
+		},
+		{
+			ID: "no-did-issuer",
+		},
+	}
+
+	verifiers := []*profileapi.Verifier{
+		{
+			ID:  "verifier",
+			URL: "https://verifier.example.com",
+			SigningDID: &profileapi.SigningDID{
+				DID: rootDID,
+			},
+		},
+		{
+			ID: "path-verifier",
+			SigningDID: &profileapi.SigningDID{
+				DID: pathDID,
+				VerificationMethods: []*profileapi.VerificationMethod{
+					{ID: pathDID + "#key1", Type: "Ed25519VerificationKey2018", PublicKeyJWK: key1},
+				},
+			},
+		},
+	}
+
+	t.Run("well-known did.json", func(t *testing.T) {
+		s := newService(t, issuers, verifiers)
+
+		doc, err := s.DIDDocument(context.Background(), "vcs.example.com", "/.well-known/did.json")
+		require.NoError(t, err)
+		require.Equal(t, rootDID, doc.ID)
+
+		require.Len(t, doc.VerificationMethod, 2)
+		require.Equal(t, rootDID+"#key1", doc.VerificationMethod[0].ID)
+		require.Equal(t, rootDID, doc.VerificationMethod[0].Controller)
+		require.Equal(t, rootDID+"#key2", doc.VerificationMethod[1].ID)
+		require.Len(t, doc.AssertionMethod, 2)
+		require.Len(t, doc.Authentication, 2)
+
+		require.Len(t, doc.Service, 1)
+		require.Equal(t, rootDID+"#LinkedDomains", doc.Service[0].ID)
+
+		b, err := json.Marshal(doc)
+		require.NoError(t, err)
+
+		parsed, err := did.ParseDocument(b)
+		require.NoError(t, err)
+		require.Equal(t, rootDID, parsed.ID)
+		require.Len(t, parsed.AssertionMethod, 2)
+
+		var raw map[string]interface{}
+		require.NoError(t, json.Unmarshal(b, &raw))
+		require.Equal(t, []interface{}{rootDID + "#key1", rootDID + "#key2"}, raw["assertionMethod"])
+		require.Equal(t, map[string]interface{}{
+			"origins": []interface{}{"https://issuer.example.com", "https://verifier.example.com"},
+		}, raw["service"].([]interface{})[0].(map[string]interface{})["serviceEndpoint"])
+	})
+
+	t.Run("did.json with path", func(t *testing.T) {
+		s := newService(t, issuers, verifiers)
+
+		doc, err := s.DIDDocument(context.Background(), "example.com:8080", "/issuer/1/did.json")
+		require.NoError(t, err)
+		require.Equal(t, pathDID, doc.ID)
+		require.Len(t, doc.VerificationMethod, 1)
+		require.Equal(t, "Ed25519VerificationKey2018", doc.VerificationMethod[0].Type)
+		require.Empty(t, doc.Service)
+	})
+
+	t.Run("requested host is preferred", func(t *testing.T) {
+		otherDID := "did:web:other.example.com"
+
+		s := newService(t, append([]*profileapi.Issuer{{
+			ID:         "other-issuer",
+			SigningDID: &profileapi.SigningDID{DID: otherDID},
+		}}, issuers...), verifiers)
+
+		doc, err := s.DIDDocument(context.Background(), "example.com", "/.well-known/did.json")
+		require.NoError(t, err)
+		require.Equal(t, rootDID, doc.ID)
+
+		doc, err = s.DIDDocument(context.Background(), "Other.Example.com", "/.well-known/did.json")
+		require.NoError(t, err)
+		require.Equal(t, otherDID, doc.ID)
+
+		_, err = s.DIDDocument(context.Background(), "vcs.example.com", "/.well-known/did.json")
+		require.ErrorIs(t, err, ErrDocumentNotFound)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		s := newService(t, issuers, verifiers)
+
+		_, err := s.DIDDocument(context.Background(), "example.com", "/verifier/1/did.json")
+		require.ErrorIs(t, err, ErrDocumentNotFound)
+	})
+
+	t.Run("invalid verification method", func(t *testing.T) {
+		s := newService(t, []*profileapi.Issuer{{
+			SigningDID: &profileapi.SigningDID{
+				DID: rootDID,
+				VerificationMethods: []*profileapi.VerificationMethod{
+					{ID: rootDID + "#key1", Type: "JsonWebKey2020", PublicKeyJWK: &jwk.JWK{}},
+				},
+			},
+		}}, nil)
+
+		_, err := s.DIDDocument(context.Background(), "example.com", "/.well-known/did.json")
+		require.ErrorContains(t, err, "create verification method did:web:example.com#key1")
+	})
+
+	t.Run("list issuer profiles error", func(t *testing.T) {
+		issuerProfileService := NewMockIssuerProfileService(gomock.NewController(t))
+		issuerProfileService.EXPECT().ListProfiles().Return(nil, errors.New("list error"))
+
+		s := New(&Config{IssuerProfileService: issuerProfileService})
+
+		_, err := s.DIDDocument(context.Background(), "example.com", "/.well-known/did.json")
+		require.ErrorContains(t, err, "list issuer profiles: list error")
+	})
+
+	t.Run("list verifier profiles error", func(t *testing.T) {
+		issuerProfileService := NewMockIssuerProfileService(gomock.NewController(t))
+		issuerProfileService.EXPECT().ListProfiles().Return(issuers, nil)
+
+		verifierProfileService := NewMockVerifierProfileService(gomock.NewController(t))
+		verifierProfileService.EXPECT().ListProfiles().Return(nil, errors.New("list error"))
+
+		s := New(&Config{
+			IssuerProfileService:   issuerProfileService,
+			VerifierProfileService: verifierProfileService,
+		})
+
+		_, err := s.DIDDocument(context.Background(), "example.com", "/.well-known/did.json")
+		require.ErrorContains(t, err, "list verifier profiles: list error")
+	})
+}
+
+func TestService_DIDConfiguration(t *testing.T) {
+	issuers := []*profileapi.Issuer{
+		{ID: "issuer", Version: "v1.0", URL: "https://vcs.example.com", SigningDID: &profileapi.SigningDID{DID: rootDID}},
+		{ID: "issuer", Version: "v1.1", URL: "https://vcs.example.com", SigningDID: &profileapi.SigningDID{DID: rootDID}},
+		{ID: "other-issuer", URL: "https://other.example.com", SigningDID: &profileapi.SigningDID{DID: rootDID}},
+		{ID: "orb-issuer", URL: "https://vcs.example.com", SigningDID: &profileapi.SigningDID{DID: "did:orb:abc"}},
+	}
+
+	verifiers := []*profileapi.Verifier{
+		{ID: "verifier", Version: "v1.0", URL: "https://vcs.example.com", SigningDID: &profileapi.SigningDID{DID: pathDID}},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		didConfigService := NewMockDIDConfigService(gomock.NewController(t))
+		didConfigService.EXPECT().DidConfig(gomock.Any(), didconfiguration.ProfileTypeIssuer, "issuer", "v1.0").
+			Return(&didconfiguration.DidConfiguration{Context: "ctx", LinkedDiDs: []interface{}{"issuer-jwt"}}, nil)
+		didConfigService.EXPECT().DidConfig(gomock.Any(), didconfiguration.ProfileTypeVerifier, "verifier", "v1.0").
+			Return(&didconfiguration.DidConfiguration{Context: "ctx", LinkedDiDs: []interface{}{"verifier-jwt"}}, nil)
+
+		s := newService(t, issuers, verifiers, didConfigService)
+
+		didConfig, err := s.DIDConfiguration(context.Background(), "VCS.example.com")
+		require.NoError(t, err)
+		require.Equal(t, "ctx", didConfig.Context)
+		require.Equal(t, []interface{}{"issuer-jwt", "verifier-jwt"}, didConfig.LinkedDiDs)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		s := newService(t, issuers, verifiers, NewMockDIDConfigService(gomock.NewController(t)))
+
+		_, err := s.DIDConfiguration(context.Background(), "unknown.example.com")
+		require.ErrorIs(t, err, ErrDIDConfigurationNotFound)
+	})
+
+	t.Run("did config error", func(t *testing.T) {
+		didConfigService := NewMockDIDConfigService(gomock.NewController(t))
+		didConfigService.EXPECT().DidConfig(gomock.Any(), didconfiguration.ProfileTypeIssuer, "other-issuer", "").
+			Return(nil, errors.New("sign error"))
+
+		s := newService(t, issuers, verifiers, didConfigService)
+
+		_, err := s.DIDConfiguration(context.Background(), "other.example.com")
+		require.ErrorContains(t, err, "did configuration of issuer profile other-issuer: sign error")
+	})
+
+	t.Run("list profiles error", func(t *testing.T) {
+		issuerProfileService := NewMockIssuerProfileService(gomock.NewController(t))
+		issuerProfileService.EXPECT().ListProfiles().Return(nil, errors.New("list error"))
+
+		s := New(&Config{IssuerProfileService: issuerProfileService})
+
+		_, err := s.DIDConfiguration(context.Background(), "vcs.example.com")
+		require.ErrorContains(t, err, "list issuer profiles: list error")
+	})
+}
+
+func TestParseDID(t *testing.T) {
+	tests := []struct {
+		did    string
+		domain string
+		path   string
+		err    string
+	}{
+		{did: "did:web:example.com", domain: "example.com", path: "/.well-known/did.json"},
+		{did: "did:web:example.com%3A8080", domain: "example.com:8080", path: "/.well-known/did.json"},
+		{did: "did:web:example.com:user:alice", domain: "example.com", path: "/user/alice/did.json"},
+		{did: "did:key:z6Mk", err: "not a did:web DID"},
+		{did: "did:web:", err: "invalid did:web domain"},
+		{did: "did:web:example.com%zz", err: "invalid did:web domain"},
+		{did: "did:web:example.com:%zz", err: "invalid did:web path"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.did, func(t *testing.T) {
+			domain, path, err := ParseDID(tc.did)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.domain, domain)
+			require.Equal(t, tc.path, path)
+		})
+	}
+}
+
+func newService(
+	t *testing.T,
+	issuers []*profileapi.Issuer,
+	verifiers []*profileapi.Verifier,
+	didConfigService ...didConfigService,
+) *Service {
+	t.Helper()
+
+	issuerProfileService := NewMockIssuerProfileService(gomock.NewController(t))
+	issuerProfileService.EXPECT().ListProfiles().Return(issuers, nil).AnyTimes()
+
+	verifierProfileService := NewMockVerifierProfileService(gomock.NewController(t))
+	verifierProfileService.EXPECT().ListProfiles().Return(verifiers, nil).AnyTimes()
+
+	config := &Config{
+		IssuerProfileService:   issuerProfileService,
+		VerifierProfileService: verifierProfileService,
+	}
+
+	if len(didConfigService) > 0 {
+		config.DIDConfigService = didConfigService[0]
+	}
+
+	return New(config)
+}
+
+func newJWK(t *testing.T) *jwk.JWK {
+	t.Helper()
+
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	j, err := jwksupport.JWKFromKey(pub)
+	require.NoError(t, err)
+
+	return j
+}
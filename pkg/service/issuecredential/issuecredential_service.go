@@ -29,9 +29,10 @@ const (
 
 // signingOpts holds options for the signing credential.
 type issueCredentialOpts struct {
-	transactionID string
-	skipIDPrefix  bool
-	cryptoOpts    []crypto.SigningOpts
+	transactionID        string
+	previousCredentialID string
+	skipIDPrefix         bool
+	cryptoOpts           []crypto.SigningOpts
 }
 
 // Opts is signing credential option.
@@ -44,6 +45,13 @@ func WithTransactionID(transactionID string) Opts {
 	}
 }
 
+// WithPreviousCredentialID is an option to pass ID of the credential replaced by the issued credential.
+func WithPreviousCredentialID(credentialID string) Opts {
+	return func(opts *issueCredentialOpts) {
+		opts.previousCredentialID = credentialID
+	}
+}
+
 // WithSkipIDPrefix is an option to skip ID prefix.
 func WithSkipIDPrefix() Opts {
 	return func(opts *issueCredentialOpts) {
@@ -130,6 +138,8 @@ func (s *Service) IssueCredential(
 		VCStatusListType:        profile.VCConfig.Status.Type,
 		SDJWT:                   profile.VCConfig.SDJWT,
 		DataIntegrityProof:      profile.VCConfig.DataIntegrityProof,
+		CertificateChain:        profile.SigningDID.CertificateChain,
+		SecuringMechanism:       profile.VCConfig.SecuringMechanism,
 	}
 
 	var statusListEntry *credentialstatus.StatusListEntry
@@ -165,13 +175,19 @@ func (s *Service) IssueCredential(
 		return nil, fmt.Errorf("sign credential: %w", err)
 	}
 
+	validFrom, validUntil, err := vcutil.ValidityPeriod(credential)
+	if err != nil {
+		return nil, fmt.Errorf("get validity period: %w", err)
+	}
+
 	credentialMetadata := &credentialstatus.CredentialMetadata{
-		CredentialID:   credential.Contents().ID,
-		Issuer:         credential.Contents().Issuer.ID,
-		CredentialType: credential.Contents().Types,
-		TransactionID:  options.transactionID,
-		IssuanceDate:   credential.Contents().Issued,
-		ExpirationDate: credential.Contents().Expired,
+		CredentialID:         credential.Contents().ID,
+		Issuer:               credential.Contents().Issuer.ID,
+		CredentialType:       credential.Contents().Types,
+		TransactionID:        options.transactionID,
+		IssuanceDate:         validFrom,
+		ExpirationDate:       validUntil,
+		PreviousCredentialID: options.previousCredentialID,
 	}
 
 	err = s.vcStatusManager.StoreIssuedCredentialMetadata(ctx, profile.ID, profile.Version, credentialMetadata)
@@ -231,12 +231,13 @@ func TestService_IssueCredential(t *testing.T) {
 					}, nil)
 
 				expectedCredentialMetadata := &credentialstatus.CredentialMetadata{
-					CredentialID:   "urn:uuid:" + credential.Contents().ID,
-					Issuer:         didDoc.ID,
-					CredentialType: credential.Contents().Types,
-					TransactionID:  transactionID,
-					IssuanceDate:   credential.Contents().Issued,
-					ExpirationDate: credential.Contents().Expired,
+					CredentialID:         "urn:uuid:" + credential.Contents().ID,
+					Issuer:               didDoc.ID,
+					CredentialType:       credential.Contents().Types,
+					TransactionID:        transactionID,
+					IssuanceDate:         credential.Contents().Issued,
+					ExpirationDate:       credential.Contents().Expired,
+					PreviousCredentialID: "urn:uuid:previous",
 				}
 
 				mockVCStatusManager.EXPECT().
@@ -266,6 +267,7 @@ func TestService_IssueCredential(t *testing.T) {
 							KMSKeyID: pubKey.KeyID,
 						}},
 					issuecredential.WithTransactionID(transactionID),
+					issuecredential.WithPreviousCredentialID("urn:uuid:previous"),
 				)
 				require.NoError(t, err)
 				validateVC(t, verifiableCredentials, didDoc, 0, vcs.Jwt)
@@ -0,0 +1,33 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package keyrotation
+
+import (
+	"errors"
+	"time"
+
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+)
+
+// ErrDataNotFound is returned when the profile has no key rotations.
+var ErrDataNotFound = errors.New("data not found")
+
+// Record is an audit record of the profile signing key rotation. The signing DID of the latest record
+// of the profile is applied to the profile when it is read, so rotations are visible to all VCS instances
+// and survive restarts.
+type Record struct {
+	ID                         string
+	OrgID                      string
+	ProfileID                  string
+	ProfileVersion             string
+	DID                        string
+	VerificationMethod         string
+	PreviousVerificationMethod string
+	// SigningDID is the signing DID of the profile after the rotation.
+	SigningDID *profileapi.SigningDID
+	RotatedAt  time.Time
+}
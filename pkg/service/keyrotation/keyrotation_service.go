@@ -0,0 +1,337 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+//go:generate mockgen -destination keyrotation_service_mocks_test.go -self_package mocks -package keyrotation_test -source=keyrotation_service.go -mock_names profileService=MockProfileService,kmsRegistry=MockKMSRegistry,vdrRegistry=MockVDRRegistry,eventService=MockEventService,rotationStore=MockRotationStore,didCache=MockDIDCache
+
+package keyrotation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/trustbloc/did-go/doc/did"
+	vdrapi "github.com/trustbloc/did-go/vdr/api"
+	"github.com/trustbloc/kms-go/doc/jose/jwk"
+	"github.com/trustbloc/logutil-go/pkg/log"
+	"go.uber.org/zap"
+
+	"github.com/trustbloc/vcs/internal/logfields"
+	"github.com/trustbloc/vcs/pkg/doc/vc/crypto"
+	"github.com/trustbloc/vcs/pkg/event/spi"
+	vcskms "github.com/trustbloc/vcs/pkg/kms"
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/restapi/resterr"
+)
+
+const (
+	// UpdateKeyURLOpt is a DID update option with the KMS URL of the profile DID update key.
+	UpdateKeyURLOpt = "updateKeyURL"
+
+	didWebPrefix = "did:web:"
+	didOrbPrefix = "did:orb:"
+	didIONPrefix = "did:ion:"
+)
+
+var logger = log.New("key-rotation")
+
+type profileService interface {
+	GetProfile(profileID profileapi.ID, profileVersion profileapi.Version) (*profileapi.Issuer, error)
+}
+
+type kmsRegistry interface {
+	GetKeyManager(config *vcskms.Config) (vcskms.VCSKeyManager, error)
+}
+
+type vdrRegistry interface {
+	Resolve(didID string, opts ...vdrapi.DIDMethodOption) (*did.DocResolution, error)
+	Update(didDoc *did.Doc, opts ...vdrapi.DIDMethodOption) error
+}
+
+type eventService interface {
+	Publish(ctx context.Context, topic string, messages ...*spi.Event) error
+}
+
+type rotationStore interface {
+	Create(ctx context.Context, record *Record) error
+}
+
+type didCache interface {
+	Invalidate(didID string)
+}
+
+// Config defines dependencies for Service.
+type Config struct {
+	// ProfileService returns profiles with the latest key rotations applied, see ProfileService.
+	ProfileService profileService
+	KMSRegistry    kmsRegistry
+	VDR            vdrRegistry
+	EventSvc       eventService
+	EventTopic     string
+	Store          rotationStore
+	// DIDCache is a cache of resolved DID documents. Optional.
+	DIDCache didCache
+}
+
+// Service rotates signing keys of issuer profiles.
+type Service struct {
+	mu             sync.Mutex
+	profileService profileService
+	kmsRegistry    kmsRegistry
+	vdr            vdrRegistry
+	eventSvc       eventService
+	eventTopic     string
+	store          rotationStore
+	didCache       didCache
+	now            func() time.Time
+}
+
+// RotationResult contains signing DID of the profile after key rotation.
+type RotationResult struct {
+	SigningDID                 *profileapi.SigningDID
+	VerificationMethod         *profileapi.VerificationMethod
+	PreviousVerificationMethod *profileapi.VerificationMethod
+}
+
+// EventPayload is a payload of spi.IssuerSigningKeyRotated event.
+type EventPayload struct {
+	OrgID                      string `json:"orgID"`
+	ProfileID                  string `json:"profileID"`
+	ProfileVersion             string `json:"profileVersion"`
+	DID                        string `json:"did"`
+	VerificationMethod         string `json:"verificationMethod"`
+	PreviousVerificationMethod string `json:"previousVerificationMethod,omitempty"`
+}
+
+// New returns a new instance of Service.
+func New(config *Config) *Service {
+	return &Service{
+		profileService: config.ProfileService,
+		kmsRegistry:    config.KMSRegistry,
+		vdr:            config.VDR,
+		eventSvc:       config.EventSvc,
+		eventTopic:     config.EventTopic,
+		store:          config.Store,
+		didCache:       config.DIDCache,
+		now:            time.Now,
+	}
+}
+
+// RotateKey creates a new signing key for the issuer profile, adds it as a new verification method to the profile
+// DID and switches the profile signing DID creator to it. Previous verification methods are marked as retired
+// but stay in the DID document, so credentials signed with them can still be verified. The rotation takes effect
+// once its record is stored, as profiles are read with the latest rotation applied.
+func (s *Service) RotateKey(
+	ctx context.Context,
+	profileID profileapi.ID,
+	profileVersion profileapi.Version,
+) (*RotationResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	profile, err := s.profileService.GetProfile(profileID, profileVersion)
+	if err != nil {
+		return nil, fmt.Errorf("get profile: %w", err)
+	}
+
+	if profile.SigningDID == nil || profile.VCConfig == nil {
+		return nil, resterr.NewCustomError(resterr.ConditionNotMet,
+			errors.New("profile has no signing did configured"))
+	}
+
+	signingDID := profile.SigningDID
+
+	if !isSupportedDID(signingDID.DID) {
+		return nil, resterr.NewCustomError(resterr.ConditionNotMet,
+			fmt.Errorf("key rotation is not supported for did: %s", signingDID.DID))
+	}
+
+	km, err := s.kmsRegistry.GetKeyManager(profile.KMSConfig)
+	if err != nil {
+		return nil, fmt.Errorf("get key manager: %w", err)
+	}
+
+	keyID, publicKey, err := km.CreateJWKKey(profile.VCConfig.KeyType)
+	if err != nil {
+		return nil, fmt.Errorf("create signing key: %w", err)
+	}
+
+	previousVM := findVerificationMethodByID(signingDID, signingDID.Creator)
+
+	vmType := crypto.JSONWebKey2020
+	if previousVM != nil {
+		vmType = previousVM.Type
+	}
+
+	// did:web document is published by VCS from the profile signing DID, other methods are updated with VDR.
+	if !strings.HasPrefix(signingDID.DID, didWebPrefix) {
+		if err = s.updateDID(signingDID, keyID, vmType, publicKey); err != nil {
+			return nil, err
+		}
+	}
+
+	newVM := &profileapi.VerificationMethod{
+		ID:           signingDID.DID + "#" + keyID,
+		Type:         vmType,
+		PublicKeyJWK: publicKey,
+	}
+
+	now := s.now().UTC()
+
+	rotatedSigningDID := rotateSigningDID(signingDID, newVM, keyID, now)
+
+	previousVMID := signingDID.Creator
+
+	if err = s.store.Create(ctx, &Record{
+		ID:                         uuid.NewString(),
+		OrgID:                      profile.OrganizationID,
+		ProfileID:                  profile.ID,
+		ProfileVersion:             profile.Version,
+		DID:                        signingDID.DID,
+		VerificationMethod:         newVM.ID,
+		PreviousVerificationMethod: previousVMID,
+		SigningDID:                 rotatedSigningDID,
+		RotatedAt:                  now,
+	}); err != nil {
+		return nil, fmt.Errorf("store key rotation: %w", err)
+	}
+
+	// Cached DID document does not contain the new key. For did:web it is published by VCS and is not updated
+	// through VDR, so the cached resolution is invalidated explicitly.
+	if s.didCache != nil {
+		s.didCache.Invalidate(signingDID.DID)
+	}
+
+	logger.Infoc(ctx, "Profile signing key rotated",
+		logfields.WithProfileID(profileID),
+		logfields.WithProfileVersion(profileVersion),
+		zap.String("did", signingDID.DID),
+		zap.String("verificationMethod", newVM.ID),
+		zap.String("previousVerificationMethod", previousVMID),
+	)
+
+	if err = s.sendEvent(ctx, &EventPayload{
+		OrgID:                      profile.OrganizationID,
+		ProfileID:                  profile.ID,
+		ProfileVersion:             profile.Version,
+		DID:                        signingDID.DID,
+		VerificationMethod:         newVM.ID,
+		PreviousVerificationMethod: previousVMID,
+	}); err != nil {
+		return nil, fmt.Errorf("send event: %w", err)
+	}
+
+	result := &RotationResult{
+		SigningDID:         rotatedSigningDID,
+		VerificationMethod: newVM,
+	}
+
+	if previousVMID != "" {
+		result.PreviousVerificationMethod = findVerificationMethodByID(rotatedSigningDID, previousVMID)
+	}
+
+	return result, nil
+}
+
+// updateDID adds the new key to the profile DID document. VDR of the DID method signs the update
+// with the KMS key referenced by UpdateKeyURLOpt option.
+func (s *Service) updateDID(
+	signingDID *profileapi.SigningDID,
+	keyID, vmType string,
+	publicKey *jwk.JWK,
+) error {
+	if signingDID.UpdateKeyURL == "" {
+		return resterr.NewCustomError(resterr.ConditionNotMet,
+			fmt.Errorf("update key is not configured for did: %s", signingDID.DID))
+	}
+
+	docResolution, err := s.vdr.Resolve(signingDID.DID)
+	if err != nil {
+		return fmt.Errorf("resolve did %s: %w", signingDID.DID, err)
+	}
+
+	vm, err := did.NewVerificationMethodFromJWK(keyID, vmType, "", publicKey)
+	if err != nil {
+		return fmt.Errorf("create verification method: %w", err)
+	}
+
+	doc := docResolution.DIDDocument
+
+	doc.Authentication = append(doc.Authentication, did.Verification{
+		VerificationMethod: *vm,
+		Relationship:       did.Authentication,
+		Embedded:           true,
+	})
+	doc.AssertionMethod = append(doc.AssertionMethod, did.Verification{
+		VerificationMethod: *vm,
+		Relationship:       did.AssertionMethod,
+		Embedded:           true,
+	})
+
+	if err = s.vdr.Update(doc, vdrapi.WithOption(UpdateKeyURLOpt, signingDID.UpdateKeyURL)); err != nil {
+		return fmt.Errorf("update did %s: %w", signingDID.DID, err)
+	}
+
+	return nil
+}
+
+func (s *Service) sendEvent(ctx context.Context, ep *EventPayload) error {
+	payload, err := json.Marshal(ep)
+	if err != nil {
+		return err
+	}
+
+	event := spi.NewEventWithPayload(uuid.NewString(), "source://vcs/issuer", spi.IssuerSigningKeyRotated, payload)
+
+	return s.eventSvc.Publish(ctx, s.eventTopic, event)
+}
+
+// rotateSigningDID returns a copy of the signing DID with the new verification method as a creator.
+func rotateSigningDID(
+	signingDID *profileapi.SigningDID,
+	newVM *profileapi.VerificationMethod,
+	keyID string,
+	now time.Time,
+) *profileapi.SigningDID {
+	rotated := *signingDID
+	rotated.Creator = newVM.ID
+	rotated.KMSKeyID = keyID
+	rotated.VerificationMethods = make([]*profileapi.VerificationMethod, 0, len(signingDID.VerificationMethods)+1)
+
+	for _, v := range signingDID.VerificationMethods {
+		vm := *v
+		if vm.RetiredAt == nil {
+			vm.RetiredAt = &now
+		}
+
+		rotated.VerificationMethods = append(rotated.VerificationMethods, &vm)
+	}
+
+	rotated.VerificationMethods = append(rotated.VerificationMethods, newVM)
+
+	return &rotated
+}
+
+func findVerificationMethodByID(signingDID *profileapi.SigningDID, id string) *profileapi.VerificationMethod {
+	for _, v := range signingDID.VerificationMethods {
+		if v.ID == id {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func isSupportedDID(didID string) bool {
+	return strings.HasPrefix(didID, didWebPrefix) ||
+		strings.HasPrefix(didID, didOrbPrefix) ||
+		strings.HasPrefix(didID, didIONPrefix)
+}
@@ -0,0 +1,335 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package keyrotation_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/did-go/doc/did"
+	vdrapi "github.com/trustbloc/did-go/vdr/api"
+	"github.com/trustbloc/kms-go/doc/jose/jwk"
+	"github.com/trustbloc/kms-go/doc/jose/jwk/jwksupport"
+	"github.com/trustbloc/kms-go/spi/kms"
+
+	"github.com/trustbloc/vcs/pkg/event/spi"
+	vcskms "github.com/trustbloc/vcs/pkg/kms"
+	"github.com/trustbloc/vcs/pkg/kms/mocks"
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/restapi/resterr"
+	"github.com/trustbloc/vcs/pkg/service/keyrotation"
+)
+
+const (
+	profileID      = "test-profile"
+	profileVersion = "v1.0"
+	orgID          = "test-org"
+	topic          = "issuer-events"
+
+	webDID = "did:web:example.com"
+	orbDID = "did:orb:uAAA:EiD1"
+)
+
+func TestService_RotateKey(t *testing.T) {
+	oldKey, newKey := newJWK(t), newJWK(t)
+
+	t.Run("success did:web", func(t *testing.T) {
+		profile := newProfile(&profileapi.SigningDID{
+			DID:      webDID,
+			Creator:  webDID + "#key1",
+			KMSKeyID: "key1",
+			VerificationMethods: []*profileapi.VerificationMethod{
+				{ID: webDID + "#key1", Type: "Ed25519VerificationKey2018", PublicKeyJWK: oldKey},
+			},
+		})
+
+		profileSvc := NewMockProfileService(gomock.NewController(t))
+		profileSvc.EXPECT().GetProfile(profileID, profileVersion).Return(profile, nil)
+
+		eventSvc := NewMockEventService(gomock.NewController(t))
+		eventSvc.EXPECT().Publish(gomock.Any(), topic, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, messages ...*spi.Event) error {
+				require.Len(t, messages, 1)
+				require.Equal(t, spi.IssuerSigningKeyRotated, messages[0].Type)
+
+				jsonData, err := json.Marshal(messages[0].Data)
+				require.NoError(t, err)
+
+				var ep keyrotation.EventPayload
+				require.NoError(t, json.Unmarshal(jsonData, &ep))
+				require.Equal(t, keyrotation.EventPayload{
+					OrgID:                      orgID,
+					ProfileID:                  profileID,
+					ProfileVersion:             profileVersion,
+					DID:                        webDID,
+					VerificationMethod:         webDID + "#key2",
+					PreviousVerificationMethod: webDID + "#key1",
+				}, ep)
+
+				return nil
+			})
+
+		var record *keyrotation.Record
+
+		store := NewMockRotationStore(gomock.NewController(t))
+		store.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, r *keyrotation.Record) error {
+				record = r
+
+				return nil
+			})
+
+		didCache := NewMockDIDCache(gomock.NewController(t))
+		didCache.EXPECT().Invalidate(webDID)
+
+		s := keyrotation.New(&keyrotation.Config{
+			ProfileService: profileSvc,
+			KMSRegistry:    newKMSRegistry(t, "key2", newKey),
+			VDR:            NewMockVDRRegistry(gomock.NewController(t)),
+			EventSvc:       eventSvc,
+			EventTopic:     topic,
+			Store:          store,
+			DIDCache:       didCache,
+		})
+
+		res, err := s.RotateKey(context.Background(), profileID, profileVersion)
+		require.NoError(t, err)
+
+		updated := record.SigningDID
+		require.Same(t, updated, res.SigningDID)
+
+		require.NotEmpty(t, record.ID)
+		require.Equal(t, orgID, record.OrgID)
+		require.Equal(t, profileID, record.ProfileID)
+		require.Equal(t, profileVersion, record.ProfileVersion)
+		require.Equal(t, webDID, record.DID)
+		require.Equal(t, webDID+"#key2", record.VerificationMethod)
+		require.Equal(t, webDID+"#key1", record.PreviousVerificationMethod)
+		require.False(t, record.RotatedAt.IsZero())
+
+		require.Equal(t, webDID+"#key2", updated.Creator)
+		require.Equal(t, "key2", updated.KMSKeyID)
+		require.Len(t, updated.VerificationMethods, 2)
+		require.NotNil(t, updated.VerificationMethods[0].RetiredAt)
+		require.Nil(t, updated.VerificationMethods[1].RetiredAt)
+		require.Equal(t, "Ed25519VerificationKey2018", updated.VerificationMethods[1].Type)
+		require.Equal(t, newKey, updated.VerificationMethods[1].PublicKeyJWK)
+
+		require.Equal(t, webDID+"#key2", res.VerificationMethod.ID)
+		require.Equal(t, webDID+"#key1", res.PreviousVerificationMethod.ID)
+
+		// Profile signing DID is not modified in place.
+		require.Equal(t, webDID+"#key1", profile.SigningDID.Creator)
+		require.Nil(t, profile.SigningDID.VerificationMethods[0].RetiredAt)
+	})
+
+	t.Run("success did:orb", func(t *testing.T) {
+		profile := newProfile(&profileapi.SigningDID{
+			DID:          orbDID,
+			Creator:      orbDID + "#key1",
+			KMSKeyID:     "key1",
+			UpdateKeyURL: "update-key",
+		})
+
+		profileSvc := NewMockProfileService(gomock.NewController(t))
+		profileSvc.EXPECT().GetProfile(profileID, profileVersion).Return(profile, nil)
+
+		vdr := NewMockVDRRegistry(gomock.NewController(t))
+		vdr.EXPECT().Resolve(orbDID).Return(&did.DocResolution{DIDDocument: &did.Doc{ID: orbDID}}, nil)
+		vdr.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(doc *did.Doc, opts ...vdrapi.DIDMethodOption) error {
+				require.Len(t, doc.AssertionMethod, 1)
+				require.Equal(t, "key2", doc.AssertionMethod[0].VerificationMethod.ID)
+				require.Len(t, doc.Authentication, 1)
+
+				didMethodOpts := &vdrapi.DIDMethodOpts{Values: map[string]interface{}{}}
+				for _, opt := range opts {
+					opt(didMethodOpts)
+				}
+
+				require.Equal(t, "update-key", didMethodOpts.Values[keyrotation.UpdateKeyURLOpt])
+
+				return nil
+			})
+
+		eventSvc := NewMockEventService(gomock.NewController(t))
+		eventSvc.EXPECT().Publish(gomock.Any(), topic, gomock.Any()).Return(nil)
+
+		s := keyrotation.New(&keyrotation.Config{
+			ProfileService: profileSvc,
+			KMSRegistry:    newKMSRegistry(t, "key2", newKey),
+			VDR:            vdr,
+			EventSvc:       eventSvc,
+			EventTopic:     topic,
+			Store:          newRotationStore(t),
+		})
+
+		res, err := s.RotateKey(context.Background(), profileID, profileVersion)
+		require.NoError(t, err)
+		require.Equal(t, orbDID+"#key2", res.SigningDID.Creator)
+		require.Equal(t, "JsonWebKey2020", res.VerificationMethod.Type)
+		require.Nil(t, res.PreviousVerificationMethod)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			signingDID *profileapi.SigningDID
+			profileErr error
+			kmsErr     error
+			createErr  error
+			resolveErr error
+			updateErr  error
+			storeErr   error
+			publishErr error
+			err        string
+		}{
+			{
+				name:       "get profile",
+				profileErr: resterr.ErrProfileNotFound,
+				err:        "get profile",
+			},
+			{
+				name: "no signing did",
+				err:  "profile has no signing did configured",
+			},
+			{
+				name:       "unsupported did method",
+				signingDID: &profileapi.SigningDID{DID: "did:key:z6Mk"},
+				err:        "key rotation is not supported for did: did:key:z6Mk",
+			},
+			{
+				name:       "get key manager",
+				signingDID: &profileapi.SigningDID{DID: webDID},
+				kmsErr:     errors.New("kms error"),
+				err:        "get key manager: kms error",
+			},
+			{
+				name:       "create key",
+				signingDID: &profileapi.SigningDID{DID: webDID},
+				createErr:  errors.New("create error"),
+				err:        "create signing key: create error",
+			},
+			{
+				name:       "no update key",
+				signingDID: &profileapi.SigningDID{DID: orbDID},
+				err:        "update key is not configured for did: " + orbDID,
+			},
+			{
+				name:       "resolve did",
+				signingDID: &profileapi.SigningDID{DID: orbDID, UpdateKeyURL: "update-key"},
+				resolveErr: errors.New("resolve error"),
+				err:        "resolve did " + orbDID + ": resolve error",
+			},
+			{
+				name:       "update did",
+				signingDID: &profileapi.SigningDID{DID: orbDID, UpdateKeyURL: "update-key"},
+				updateErr:  errors.New("update error"),
+				err:        "update did " + orbDID + ": update error",
+			},
+			{
+				name:       "store rotation",
+				signingDID: &profileapi.SigningDID{DID: webDID},
+				storeErr:   errors.New("store error"),
+				err:        "store key rotation: store error",
+			},
+			{
+				name:       "publish event",
+				signingDID: &profileapi.SigningDID{DID: webDID},
+				publishErr: errors.New("publish error"),
+				err:        "send event: publish error",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				profileSvc := NewMockProfileService(gomock.NewController(t))
+				profileSvc.EXPECT().GetProfile(profileID, profileVersion).
+					Return(newProfile(tc.signingDID), tc.profileErr)
+
+				km := mocks.NewMockVCSKeyManager(gomock.NewController(t))
+				km.EXPECT().CreateJWKKey(kms.ED25519Type).Return("key2", newKey, tc.createErr).AnyTimes()
+
+				kmsRegistry := NewMockKMSRegistry(gomock.NewController(t))
+				kmsRegistry.EXPECT().GetKeyManager(gomock.Any()).Return(km, tc.kmsErr).AnyTimes()
+
+				vdr := NewMockVDRRegistry(gomock.NewController(t))
+				vdr.EXPECT().Resolve(gomock.Any()).
+					Return(&did.DocResolution{DIDDocument: &did.Doc{ID: orbDID}}, tc.resolveErr).AnyTimes()
+				vdr.EXPECT().Update(gomock.Any(), gomock.Any()).Return(tc.updateErr).AnyTimes()
+
+				eventSvc := NewMockEventService(gomock.NewController(t))
+				eventSvc.EXPECT().Publish(gomock.Any(), topic, gomock.Any()).Return(tc.publishErr).AnyTimes()
+
+				store := NewMockRotationStore(gomock.NewController(t))
+				store.EXPECT().Create(gomock.Any(), gomock.Any()).Return(tc.storeErr).AnyTimes()
+
+				s := keyrotation.New(&keyrotation.Config{
+					ProfileService: profileSvc,
+					KMSRegistry:    kmsRegistry,
+					VDR:            vdr,
+					EventSvc:       eventSvc,
+					EventTopic:     topic,
+					Store:          store,
+				})
+
+				_, err := s.RotateKey(context.Background(), profileID, profileVersion)
+				require.ErrorContains(t, err, tc.err)
+			})
+		}
+	})
+}
+
+func newRotationStore(t *testing.T) *MockRotationStore {
+	t.Helper()
+
+	store := NewMockRotationStore(gomock.NewController(t))
+	store.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	return store
+}
+
+func newProfile(signingDID *profileapi.SigningDID) *profileapi.Issuer {
+	return &profileapi.Issuer{
+		ID:             profileID,
+		Version:        profileVersion,
+		OrganizationID: orgID,
+		VCConfig:       &profileapi.VCConfig{KeyType: kms.ED25519Type},
+		KMSConfig:      &vcskms.Config{KMSType: vcskms.Local},
+		SigningDID:     signingDID,
+	}
+}
+
+func newKMSRegistry(t *testing.T, keyID string, key *jwk.JWK) *MockKMSRegistry {
+	t.Helper()
+
+	km := mocks.NewMockVCSKeyManager(gomock.NewController(t))
+	km.EXPECT().CreateJWKKey(kms.ED25519Type).Return(keyID, key, nil)
+
+	kmsRegistry := NewMockKMSRegistry(gomock.NewController(t))
+	kmsRegistry.EXPECT().GetKeyManager(&vcskms.Config{KMSType: vcskms.Local}).Return(km, nil)
+
+	return kmsRegistry
+}
+
+func newJWK(t *testing.T) *jwk.JWK {
+	t.Helper()
+
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	j, err := jwksupport.JWKFromKey(pub)
+	require.NoError(t, err)
+
+	return j
+}
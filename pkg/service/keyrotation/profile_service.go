@@ -0,0 +1,173 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+//go:generate mockgen -destination profile_service_mocks_test.go -self_package mocks -package keyrotation_test -source=profile_service.go -mock_names profileReader=MockProfileReader,recordStore=MockRecordStore
+
+package keyrotation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+)
+
+type profileReader interface {
+	GetProfile(profileID profileapi.ID, profileVersion profileapi.Version) (*profileapi.Issuer, error)
+	GetAllProfiles(orgID string) ([]*profileapi.Issuer, error)
+	ListProfiles() ([]*profileapi.Issuer, error)
+}
+
+type recordStore interface {
+	// GetLatest returns the latest rotation record of the profile or ErrDataNotFound.
+	GetLatest(ctx context.Context, profileID, profileVersion string) (*Record, error)
+	// List returns rotation records of all profiles ordered by rotation time.
+	List(ctx context.Context) ([]*Record, error)
+}
+
+// ProfileServiceConfig defines dependencies for ProfileService.
+type ProfileServiceConfig struct {
+	ProfileService profileReader
+	Store          recordStore
+	// DIDCache is a cache of resolved DID documents. Optional.
+	DIDCache didCache
+}
+
+// ProfileService applies signing DIDs of the latest key rotations to the issuer profiles. Rotations are read
+// from the store on every access, so a rotation made by any VCS instance is visible to all of them.
+// Rotations of profiles which are configured with another DID since the rotation are ignored.
+type ProfileService struct {
+	profileReader
+	store    recordStore
+	didCache didCache
+
+	mu      sync.Mutex
+	applied map[string]string
+}
+
+// NewProfileService returns a new instance of ProfileService.
+func NewProfileService(config *ProfileServiceConfig) *ProfileService {
+	return &ProfileService{
+		profileReader: config.ProfileService,
+		store:         config.Store,
+		didCache:      config.DIDCache,
+		applied:       map[string]string{},
+	}
+}
+
+// GetProfile returns profile with given id and the signing DID of its latest key rotation.
+func (s *ProfileService) GetProfile(
+	profileID profileapi.ID,
+	profileVersion profileapi.Version,
+) (*profileapi.Issuer, error) {
+	profile, err := s.profileReader.GetProfile(profileID, profileVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile.SigningDID == nil {
+		return profile, nil
+	}
+
+	// Version of the profile is used, as the profile can be requested by "latest" tag.
+	record, err := s.store.GetLatest(context.Background(), profile.ID, profile.Version)
+	if err != nil {
+		if errors.Is(err, ErrDataNotFound) {
+			return profile, nil
+		}
+
+		return nil, fmt.Errorf("get latest key rotation: %w", err)
+	}
+
+	return s.apply(profile, record), nil
+}
+
+// GetAllProfiles returns all profiles with given organization id and signing DIDs of their latest key rotations.
+func (s *ProfileService) GetAllProfiles(orgID string) ([]*profileapi.Issuer, error) {
+	profiles, err := s.profileReader.GetAllProfiles(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.applyLatest(profiles)
+}
+
+// ListProfiles returns all versions of all profiles and signing DIDs of their latest key rotations.
+func (s *ProfileService) ListProfiles() ([]*profileapi.Issuer, error) {
+	profiles, err := s.profileReader.ListProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.applyLatest(profiles)
+}
+
+func (s *ProfileService) applyLatest(profiles []*profileapi.Issuer) ([]*profileapi.Issuer, error) {
+	if len(profiles) == 0 {
+		return profiles, nil
+	}
+
+	records, err := s.store.List(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("list key rotations: %w", err)
+	}
+
+	latest := make(map[string]*Record, len(records))
+
+	for _, r := range records {
+		latest[profileKey(r.ProfileID, r.ProfileVersion)] = r
+	}
+
+	result := make([]*profileapi.Issuer, 0, len(profiles))
+
+	for _, p := range profiles {
+		if record, ok := latest[profileKey(p.ID, p.Version)]; ok {
+			p = s.apply(p, record)
+		}
+
+		result = append(result, p)
+	}
+
+	return result, nil
+}
+
+// apply returns a copy of the profile with the signing DID of the rotation record. Profile returned
+// by the profile reader is not modified.
+func (s *ProfileService) apply(profile *profileapi.Issuer, record *Record) *profileapi.Issuer {
+	if profile.SigningDID == nil || profile.SigningDID.DID != record.DID || record.SigningDID == nil {
+		return profile
+	}
+
+	s.invalidateDIDCache(profileKey(profile.ID, profile.Version), record)
+
+	updated := *profile
+	updated.SigningDID = record.SigningDID
+
+	return &updated
+}
+
+// invalidateDIDCache invalidates the cached DID document once the instance sees a new rotation of the profile,
+// as the rotation can be made by another VCS instance.
+func (s *ProfileService) invalidateDIDCache(key string, record *Record) {
+	if s.didCache == nil {
+		return
+	}
+
+	s.mu.Lock()
+	changed := s.applied[key] != record.ID
+	s.applied[key] = record.ID
+	s.mu.Unlock()
+
+	if changed {
+		s.didCache.Invalidate(record.DID)
+	}
+}
+
+func profileKey(profileID profileapi.ID, profileVersion profileapi.Version) string {
+	return profileID + "_" + profileVersion
+}
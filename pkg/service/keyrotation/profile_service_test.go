@@ -0,0 +1,216 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package keyrotation_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/restapi/resterr"
+	"github.com/trustbloc/vcs/pkg/service/keyrotation"
+)
+
+func TestProfileService_GetProfile(t *testing.T) {
+	rotated := &profileapi.SigningDID{DID: webDID, Creator: webDID + "#key3", KMSKeyID: "key3"}
+
+	t.Run("rotation applied", func(t *testing.T) {
+		profile := newProfile(&profileapi.SigningDID{DID: webDID, Creator: webDID + "#key1"})
+
+		reader := NewMockProfileReader(gomock.NewController(t))
+		reader.EXPECT().GetProfile(profileID, "latest").Return(profile, nil).Times(2)
+
+		store := NewMockRecordStore(gomock.NewController(t))
+		store.EXPECT().GetLatest(gomock.Any(), profileID, profileVersion).
+			Return(&keyrotation.Record{ID: "rotation1", DID: webDID, SigningDID: rotated}, nil).Times(2)
+
+		// Cached DID is invalidated once per rotation.
+		didCache := NewMockDIDCache(gomock.NewController(t))
+		didCache.EXPECT().Invalidate(webDID).Times(1)
+
+		s := keyrotation.NewProfileService(&keyrotation.ProfileServiceConfig{
+			ProfileService: reader,
+			Store:          store,
+			DIDCache:       didCache,
+		})
+
+		for i := 0; i < 2; i++ {
+			p, err := s.GetProfile(profileID, "latest")
+			require.NoError(t, err)
+			require.Same(t, rotated, p.SigningDID)
+			require.Equal(t, profileVersion, p.Version)
+		}
+
+		// Profile of the reader is not modified.
+		require.Equal(t, webDID+"#key1", profile.SigningDID.Creator)
+	})
+
+	t.Run("no rotations", func(t *testing.T) {
+		profile := newProfile(&profileapi.SigningDID{DID: webDID})
+
+		reader := NewMockProfileReader(gomock.NewController(t))
+		reader.EXPECT().GetProfile(profileID, profileVersion).Return(profile, nil)
+
+		store := NewMockRecordStore(gomock.NewController(t))
+		store.EXPECT().GetLatest(gomock.Any(), profileID, profileVersion).Return(nil, keyrotation.ErrDataNotFound)
+
+		s := keyrotation.NewProfileService(&keyrotation.ProfileServiceConfig{ProfileService: reader, Store: store})
+
+		p, err := s.GetProfile(profileID, profileVersion)
+		require.NoError(t, err)
+		require.Same(t, profile, p)
+	})
+
+	t.Run("profile configured with another did", func(t *testing.T) {
+		profile := newProfile(&profileapi.SigningDID{DID: orbDID})
+
+		reader := NewMockProfileReader(gomock.NewController(t))
+		reader.EXPECT().GetProfile(profileID, profileVersion).Return(profile, nil)
+
+		store := NewMockRecordStore(gomock.NewController(t))
+		store.EXPECT().GetLatest(gomock.Any(), profileID, profileVersion).
+			Return(&keyrotation.Record{ID: "rotation1", DID: webDID, SigningDID: rotated}, nil)
+
+		s := keyrotation.NewProfileService(&keyrotation.ProfileServiceConfig{
+			ProfileService: reader,
+			Store:          store,
+			DIDCache:       NewMockDIDCache(gomock.NewController(t)),
+		})
+
+		p, err := s.GetProfile(profileID, profileVersion)
+		require.NoError(t, err)
+		require.Same(t, profile, p)
+	})
+
+	t.Run("profile without signing did", func(t *testing.T) {
+		profile := newProfile(nil)
+
+		reader := NewMockProfileReader(gomock.NewController(t))
+		reader.EXPECT().GetProfile(profileID, profileVersion).Return(profile, nil)
+
+		s := keyrotation.NewProfileService(&keyrotation.ProfileServiceConfig{
+			ProfileService: reader,
+			Store:          NewMockRecordStore(gomock.NewController(t)),
+		})
+
+		p, err := s.GetProfile(profileID, profileVersion)
+		require.NoError(t, err)
+		require.Same(t, profile, p)
+	})
+
+	t.Run("profile error", func(t *testing.T) {
+		reader := NewMockProfileReader(gomock.NewController(t))
+		reader.EXPECT().GetProfile(profileID, profileVersion).Return(nil, resterr.ErrProfileNotFound)
+
+		s := keyrotation.NewProfileService(&keyrotation.ProfileServiceConfig{
+			ProfileService: reader,
+			Store:          NewMockRecordStore(gomock.NewController(t)),
+		})
+
+		_, err := s.GetProfile(profileID, profileVersion)
+		require.ErrorIs(t, err, resterr.ErrProfileNotFound)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		reader := NewMockProfileReader(gomock.NewController(t))
+		reader.EXPECT().GetProfile(profileID, profileVersion).
+			Return(newProfile(&profileapi.SigningDID{DID: webDID}), nil)
+
+		store := NewMockRecordStore(gomock.NewController(t))
+		store.EXPECT().GetLatest(gomock.Any(), profileID, profileVersion).Return(nil, errors.New("find error"))
+
+		s := keyrotation.NewProfileService(&keyrotation.ProfileServiceConfig{ProfileService: reader, Store: store})
+
+		_, err := s.GetProfile(profileID, profileVersion)
+		require.ErrorContains(t, err, "get latest key rotation: find error")
+	})
+}
+
+func TestProfileService_ListProfiles(t *testing.T) {
+	rotated := &profileapi.SigningDID{DID: webDID, Creator: webDID + "#key3", KMSKeyID: "key3"}
+
+	profile := newProfile(&profileapi.SigningDID{DID: webDID, Creator: webDID + "#key1"})
+	other := &profileapi.Issuer{ID: "other-profile", Version: profileVersion}
+
+	t.Run("success", func(t *testing.T) {
+		reader := NewMockProfileReader(gomock.NewController(t))
+		reader.EXPECT().ListProfiles().Return([]*profileapi.Issuer{profile, other}, nil)
+		reader.EXPECT().GetAllProfiles(orgID).Return([]*profileapi.Issuer{profile}, nil)
+
+		store := NewMockRecordStore(gomock.NewController(t))
+		store.EXPECT().List(gomock.Any()).Return([]*keyrotation.Record{
+			{
+				ID:             "rotation1",
+				ProfileID:      profileID,
+				ProfileVersion: profileVersion,
+				DID:            webDID,
+				SigningDID:     &profileapi.SigningDID{DID: webDID, Creator: webDID + "#key2"},
+			},
+			{ID: "rotation2", ProfileID: profileID, ProfileVersion: profileVersion, DID: webDID, SigningDID: rotated},
+		}, nil).Times(2)
+
+		s := keyrotation.NewProfileService(&keyrotation.ProfileServiceConfig{ProfileService: reader, Store: store})
+
+		profiles, err := s.ListProfiles()
+		require.NoError(t, err)
+		require.Len(t, profiles, 2)
+		require.Same(t, rotated, profiles[0].SigningDID)
+		require.Same(t, other, profiles[1])
+
+		profiles, err = s.GetAllProfiles(orgID)
+		require.NoError(t, err)
+		require.Len(t, profiles, 1)
+		require.Same(t, rotated, profiles[0].SigningDID)
+	})
+
+	t.Run("no profiles", func(t *testing.T) {
+		reader := NewMockProfileReader(gomock.NewController(t))
+		reader.EXPECT().GetAllProfiles(orgID).Return(nil, nil)
+
+		s := keyrotation.NewProfileService(&keyrotation.ProfileServiceConfig{
+			ProfileService: reader,
+			Store:          NewMockRecordStore(gomock.NewController(t)),
+		})
+
+		profiles, err := s.GetAllProfiles(orgID)
+		require.NoError(t, err)
+		require.Empty(t, profiles)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		reader := NewMockProfileReader(gomock.NewController(t))
+		reader.EXPECT().ListProfiles().Return([]*profileapi.Issuer{profile}, nil)
+
+		store := NewMockRecordStore(gomock.NewController(t))
+		store.EXPECT().List(gomock.Any()).Return(nil, errors.New("list error"))
+
+		s := keyrotation.NewProfileService(&keyrotation.ProfileServiceConfig{ProfileService: reader, Store: store})
+
+		_, err := s.ListProfiles()
+		require.ErrorContains(t, err, "list key rotations: list error")
+	})
+
+	t.Run("reader error", func(t *testing.T) {
+		reader := NewMockProfileReader(gomock.NewController(t))
+		reader.EXPECT().ListProfiles().Return(nil, errors.New("read error"))
+		reader.EXPECT().GetAllProfiles(orgID).Return(nil, errors.New("read error"))
+
+		s := keyrotation.NewProfileService(&keyrotation.ProfileServiceConfig{
+			ProfileService: reader,
+			Store:          NewMockRecordStore(gomock.NewController(t)),
+		})
+
+		_, err := s.ListProfiles()
+		require.ErrorContains(t, err, "read error")
+
+		_, err = s.GetAllProfiles(orgID)
+		require.ErrorContains(t, err, "read error")
+	})
+}
@@ -44,6 +44,7 @@ const (
 	TransactionStateAwaitingIssuerOIDCAuthorization = TransactionState(3) // auth only
 	TransactionStateIssuerOIDCAuthorizationDone     = TransactionState(4)
 	TransactionStateCredentialsIssued               = TransactionState(5)
+	TransactionStateCancelled                       = TransactionState(6)
 )
 
 const (
@@ -109,6 +110,14 @@ type TxCredentialConfiguration struct {
 	// If "scope" param is used, this field will stay empty.
 	AuthorizationDetails           *AuthorizationDetails
 	CredentialComposeConfiguration *CredentialComposeConfiguration
+	// DataModelVersion is W3C VC data model version of the credential, resolved from the template and the profile.
+	DataModelVersion vcsverifiable.DataModelVersion
+	// ClaimsMetadata is metadata of the claims from the credential configuration keyed by claim path.
+	// Claim data is validated against it.
+	ClaimsMetadata map[string]*profileapi.Claim
+	// Display is display metadata of the credential and its claims resolved for the locales of the initiate
+	// issuance request.
+	Display *profileapi.LocalizedCredentialDisplay
 }
 
 type CredentialComposeConfiguration struct {
@@ -182,6 +191,18 @@ type InitiateIssuanceRequest struct {
 	WalletInitiatedIssuance   bool
 	// CredentialConfiguration aimed to initialise multi credential issuance.
 	CredentialConfiguration []InitiateIssuanceCredentialConfiguration
+	// Locales are preferred locales of the display metadata of the credentials sent in transaction events.
+	Locales []string
+	// TxCodeDelivery requests VCS to deliver the transaction code to the holder. Requires UserPinRequired.
+	TxCodeDelivery *TxCodeDelivery
+}
+
+// TxCodeDelivery defines recipient addresses of the transaction code delivered by VCS.
+type TxCodeDelivery struct {
+	Email string
+	Phone string
+	// OmitFromResponse removes the transaction code from the initiate issuance response.
+	OmitFromResponse bool
 }
 
 type InitiateIssuanceCredentialConfiguration struct {
@@ -285,6 +306,8 @@ type EventPayload struct {
 	// Deprecated: use Credentials instead.
 	Format      vcsverifiable.OIDCFormat            `json:"format,omitempty"`
 	Credentials map[string]vcsverifiable.OIDCFormat `json:"credentials"`
+	// CredentialsDisplay is display metadata of the credentials keyed by credential template ID.
+	CredentialsDisplay map[string]*profileapi.LocalizedCredentialDisplay `json:"credentialsDisplay,omitempty"`
 }
 
 type AuthorizationCodeGrant struct {
@@ -351,6 +374,9 @@ type ServiceInterface interface {
 		clientAssertion string,
 	) (*Transaction, error)
 	PrepareCredential(ctx context.Context, req *PrepareCredential) (*PrepareCredentialResult, error)
+	GetTransaction(ctx context.Context, profile *profileapi.Issuer, txID TxID) (*Transaction, error)
+	ListTransactions(ctx context.Context, profile *profileapi.Issuer) ([]*Transaction, error)
+	CancelTransaction(ctx context.Context, profile *profileapi.Issuer, txID TxID) (*Transaction, error)
 }
 
 type Ack struct {
@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
 	"github.com/trustbloc/vcs/pkg/restapi/resterr"
 )
 
@@ -43,3 +44,20 @@ func (s *Service) DecryptClaims(ctx context.Context, data *ClaimData) (map[strin
 
 	return finalMap, nil
 }
+
+// mapClaims maps claim data to credential subject claims using claims mapping of the credential template.
+func mapClaims(
+	claims map[string]interface{},
+	credentialTemplate *profileapi.CredentialTemplate,
+) (map[string]interface{}, error) {
+	if credentialTemplate == nil {
+		return claims, nil
+	}
+
+	mapped, err := credentialTemplate.MapClaims(claims)
+	if err != nil {
+		return nil, resterr.NewCustomError(resterr.ClaimsValidationErr, fmt.Errorf("map claims: %w", err))
+	}
+
+	return mapped, nil
+}
@@ -12,8 +12,9 @@ import (
 	"text/template"
 
 	"github.com/google/uuid"
-	util "github.com/trustbloc/did-go/doc/util/time"
 	"github.com/trustbloc/vc-go/verifiable"
+
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
 )
 
 type CredentialComposer struct {
@@ -68,8 +69,15 @@ func (c *CredentialComposer) Compose(
 		credential = credential.WithModifiedSubject(newSubjects)
 	}
 
-	if credential.Contents().Expired == nil && txCredentialConfiguration.CredentialExpiresAt != nil {
-		credential.SetCustomField("expirationDate", util.NewTime(*txCredentialConfiguration.CredentialExpiresAt))
+	if txCredentialConfiguration.CredentialExpiresAt != nil {
+		_, validUntil, err := vcutil.ValidityPeriod(credential)
+		if err != nil {
+			return nil, err
+		}
+
+		if validUntil == nil {
+			vcutil.SetValidUntil(credential, *txCredentialConfiguration.CredentialExpiresAt)
+		}
 	}
 
 	return credential, nil
@@ -118,6 +118,46 @@ func TestComposer(t *testing.T) {
 		assert.EqualValues(t, "some-awesome-did", resp.Contents().Subject[0].ID)
 	})
 
+	t.Run("success data model v2.0", func(t *testing.T) {
+		srv := oidc4ci.NewCredentialComposer()
+
+		cred, err := verifiable.CreateCredential(verifiable.CredentialContents{
+			Types:   []string{"VerifiableCredential"},
+			Context: []string{"https://www.w3.org/ns/credentials/v2"},
+			Subject: []verifiable.Subject{{ID: "xxx:yyy"}},
+		}, verifiable.CustomFields{
+			"validFrom": "2024-01-01T00:00:00Z",
+		})
+		assert.NoError(t, err)
+
+		expectedExpiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		resp, err := srv.Compose(
+			context.TODO(),
+			cred,
+			&oidc4ci.Transaction{
+				ID: "some-awesome-id",
+				TransactionData: oidc4ci.TransactionData{
+					DID: "did:example:123",
+				},
+			},
+			&oidc4ci.TxCredentialConfiguration{
+				CredentialComposeConfiguration: &oidc4ci.CredentialComposeConfiguration{
+					OverrideIssuer: true,
+				},
+				CredentialExpiresAt: &expectedExpiration,
+			},
+			&oidc4ci.PrepareCredentialRequest{},
+		)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+
+		assert.Nil(t, resp.CustomField("expirationDate"))
+		assert.EqualValues(t, "2030-01-01T00:00:00Z", resp.CustomField("validUntil"))
+		assert.EqualValues(t, "2024-01-01T00:00:00Z", resp.CustomField("validFrom"))
+	})
+
 	t.Run("invalid template", func(t *testing.T) {
 		srv := oidc4ci.NewCredentialComposer()
 
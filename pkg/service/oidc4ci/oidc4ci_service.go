@@ -4,16 +4,16 @@ Copyright SecureKey Technologies Inc. All Rights Reserved.
 SPDX-License-Identifier: Apache-2.0
 */
 
-//go:generate mockgen -destination oidc4ci_service_mocks_test.go -self_package mocks -package oidc4ci_test -source=oidc4ci_service.go -mock_names transactionStore=MockTransactionStore,wellKnownService=MockWellKnownService,eventService=MockEventService,pinGenerator=MockPinGenerator,credentialOfferReferenceStore=MockCredentialOfferReferenceStore,claimDataStore=MockClaimDataStore,profileService=MockProfileService,dataProtector=MockDataProtector,kmsRegistry=MockKMSRegistry,cryptoJWTSigner=MockCryptoJWTSigner,jsonSchemaValidator=MockJSONSchemaValidator,trustRegistry=MockTrustRegistry,ackStore=MockAckStore,ackService=MockAckService,composer=MockComposer,documentLoader=MockDocumentLoader
+//go:generate mockgen -destination oidc4ci_service_mocks_test.go -self_package mocks -package oidc4ci_test -source=oidc4ci_service.go -mock_names transactionStore=MockTransactionStore,wellKnownService=MockWellKnownService,eventService=MockEventService,pinGenerator=MockPinGenerator,credentialOfferReferenceStore=MockCredentialOfferReferenceStore,claimDataStore=MockClaimDataStore,authStateStore=MockAuthStateStore,profileService=MockProfileService,dataProtector=MockDataProtector,kmsRegistry=MockKMSRegistry,cryptoJWTSigner=MockCryptoJWTSigner,jsonSchemaValidator=MockJSONSchemaValidator,trustRegistry=MockTrustRegistry,ackStore=MockAckStore,ackService=MockAckService,composer=MockComposer,documentLoader=MockDocumentLoader,txCodeNotifier=MockTxCodeNotifier
 
 package oidc4ci
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"strings"
 	"time"
@@ -21,12 +21,12 @@ import (
 	"github.com/google/uuid"
 	"github.com/piprate/json-gold/ld"
 	"github.com/samber/lo"
-	util "github.com/trustbloc/did-go/doc/util/time"
 	"github.com/trustbloc/logutil-go/pkg/log"
 	"github.com/trustbloc/vc-go/verifiable"
 
 	"github.com/trustbloc/vcs/pkg/dataprotect"
 	"github.com/trustbloc/vcs/pkg/doc/vc"
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
 	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
 	"github.com/trustbloc/vcs/pkg/event/spi"
 	vcskms "github.com/trustbloc/vcs/pkg/kms"
@@ -34,6 +34,7 @@ import (
 	"github.com/trustbloc/vcs/pkg/restapi/resterr"
 	"github.com/trustbloc/vcs/pkg/restapi/v1/common"
 	"github.com/trustbloc/vcs/pkg/service/trustregistry"
+	"github.com/trustbloc/vcs/pkg/service/txcodenotifier"
 )
 
 const (
@@ -73,11 +74,22 @@ type transactionStore interface {
 		ctx context.Context,
 		tx *Transaction,
 	) error
+
+	FindOpenByProfile(
+		ctx context.Context,
+		profileID profileapi.ID,
+		profileVersion profileapi.Version,
+	) ([]*Transaction, error)
 }
 
 type claimDataStore interface {
 	Create(ctx context.Context, profileTTLSec int32, data *ClaimData) (string, error)
 	GetAndDelete(ctx context.Context, id string) (*ClaimData, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type authStateStore interface {
+	DeleteAuthorizeState(ctx context.Context, opState string) error
 }
 
 type wellKnownService interface {
@@ -159,14 +171,25 @@ type documentLoader interface {
 	LoadDocument(u string) (*ld.RemoteDocument, error)
 }
 
+type txCodeNotifier interface {
+	NotifyTxCode(
+		ctx context.Context,
+		config *profileapi.TxCodeNotifierConfig,
+		recipient *txcodenotifier.Recipient,
+		data *txcodenotifier.MessageData,
+	) error
+}
+
 // Config holds configuration options and dependencies for Service.
 type Config struct {
 	TransactionStore              transactionStore
 	ClaimDataStore                claimDataStore
+	AuthStateStore                authStateStore
 	WellKnownService              wellKnownService
 	ProfileService                profileService
 	IssuerVCSPublicHost           string
 	HTTPClient                    *http.Client
+	TLSConfig                     *tls.Config // used to build TLS client auth requests to claim endpoints
 	EventService                  eventService
 	PinGenerator                  pinGenerator
 	EventTopic                    string
@@ -180,16 +203,19 @@ type Config struct {
 	AckService                    ackService
 	Composer                      composer
 	DocumentLoader                documentLoader
+	TxCodeNotifier                txCodeNotifier
 }
 
 // Service implements VCS credential interaction API for OIDC credential issuance.
 type Service struct {
 	store                         transactionStore
 	claimDataStore                claimDataStore
+	authStateStore                authStateStore
 	wellKnownService              wellKnownService
 	profileService                profileService
 	issuerVCSPublicHost           string
 	httpClient                    *http.Client
+	tlsConfig                     *tls.Config
 	eventSvc                      eventService
 	eventTopic                    string
 	pinGenerator                  pinGenerator
@@ -203,6 +229,7 @@ type Service struct {
 	ackService                    ackService
 	composer                      composer
 	documentLoader                documentLoader
+	txCodeNotifier                txCodeNotifier
 }
 
 // NewService returns a new Service instance.
@@ -210,10 +237,12 @@ func NewService(config *Config) (*Service, error) {
 	return &Service{
 		store:                         config.TransactionStore,
 		claimDataStore:                config.ClaimDataStore,
+		authStateStore:                config.AuthStateStore,
 		wellKnownService:              config.WellKnownService,
 		profileService:                config.ProfileService,
 		issuerVCSPublicHost:           config.IssuerVCSPublicHost,
 		httpClient:                    config.HTTPClient,
+		tlsConfig:                     config.TLSConfig,
 		eventSvc:                      config.EventService,
 		eventTopic:                    config.EventTopic,
 		pinGenerator:                  config.PinGenerator,
@@ -227,6 +256,7 @@ func NewService(config *Config) (*Service, error) {
 		ackService:                    config.AckService,
 		composer:                      config.Composer,
 		documentLoader:                config.DocumentLoader,
+		txCodeNotifier:                config.TxCodeNotifier,
 	}, nil
 }
 
@@ -696,7 +726,7 @@ func (s *Service) checkPolicy(
 		}
 	}
 
-	if profile.Checks.Policy.PolicyURL != "" {
+	if profile.Checks.Policy.IsEnabled() {
 		var credentialTypes []string
 
 		for _, credentialConfig := range tx.CredentialConfiguration {
@@ -749,6 +779,11 @@ func (s *Service) PrepareCredential( //nolint:funlen
 		return nil, fmt.Errorf("get tx: %w", err)
 	}
 
+	if tx.State == TransactionStateCancelled {
+		return nil, resterr.NewCustomError(resterr.InvalidStateTransition,
+			fmt.Errorf("unexpected transition from %v to %v", tx.State, TransactionStateCredentialsIssued))
+	}
+
 	prepareCredentialResult := &PrepareCredentialResult{
 		ProfileID:      tx.ProfileID,
 		ProfileVersion: tx.ProfileVersion,
@@ -756,6 +791,7 @@ func (s *Service) PrepareCredential( //nolint:funlen
 	}
 
 	requestedTxCredentialConfigurationIDs := make(map[string]struct{})
+	claimsCache := claimsResponseCache{}
 
 	for _, requestedCredential := range req.CredentialRequests {
 		if err = s.validateRequestAudienceClaim(
@@ -780,7 +816,8 @@ func (s *Service) PrepareCredential( //nolint:funlen
 
 		requestedTxCredentialConfigurationIDs[txCredentialConfiguration.ID] = struct{}{}
 
-		cred, ackID, prepareCredError := s.prepareCredential(ctx, tx, txCredentialConfiguration, requestedCredential)
+		cred, ackID, prepareCredError := s.prepareCredential(
+			ctx, tx, txCredentialConfiguration, requestedCredential, claimsCache)
 		if prepareCredError != nil {
 			s.sendFailedTransactionEvent(ctx, tx, prepareCredError)
 
@@ -879,13 +916,16 @@ func (s *Service) prepareCredentialFromClaims(
 		contexts = []string{defaultCtx}
 	}
 
+	if txCredentialConfiguration.DataModelVersion == vcsverifiable.DataModelV2 {
+		contexts = vcutil.ContextsForDataModel(contexts, txCredentialConfiguration.DataModelVersion)
+	}
+
 	// prepare credential for signing
 	vcc := verifiable.CredentialContents{
 		Context: contexts,
 		ID:      uuid.New().URN(),
 		Types:   []string{"VerifiableCredential", txCredentialConfiguration.CredentialTemplate.Type},
 		Issuer:  &verifiable.Issuer{ID: tx.DID},
-		Issued:  util.NewTime(time.Now()),
 	}
 
 	customFields := map[string]interface{}{}
@@ -897,8 +937,11 @@ func (s *Service) prepareCredentialFromClaims(
 		customFields["name"] = txCredentialConfiguration.CredentialName
 	}
 
-	if txCredentialConfiguration.CredentialExpiresAt != nil {
-		vcc.Expired = util.NewTime(*txCredentialConfiguration.CredentialExpiresAt)
+	vcutil.SetValidityPeriod(&vcc, customFields, time.Now(), txCredentialConfiguration.CredentialExpiresAt)
+
+	if refreshService := txCredentialConfiguration.CredentialTemplate.RefreshService; refreshService != nil {
+		vcc.RefreshService = []verifiable.TypedID{refreshService.TypedID(
+			profileapi.RefreshServiceURL(s.issuerVCSPublicHost, tx.ProfileID, tx.ProfileVersion))}
 	}
 
 	if claimData != nil {
@@ -936,8 +979,9 @@ func (s *Service) prepareCredential( //nolint:funlen
 	tx *Transaction,
 	txCredentialConfiguration *TxCredentialConfiguration,
 	prepareCredentialRequest *PrepareCredentialRequest,
+	claimsCache claimsResponseCache,
 ) (*verifiable.Credential, *string, error) {
-	claimData, err := s.getClaimsData(ctx, tx, txCredentialConfiguration)
+	claimData, err := s.getClaimsData(ctx, tx, txCredentialConfiguration, claimsCache)
 	if err != nil {
 		return nil, nil, fmt.Errorf("get claims data: %w", err)
 	}
@@ -987,13 +1031,23 @@ func (s *Service) getClaimsData(
 	ctx context.Context,
 	tx *Transaction,
 	txCredentialConfiguration *TxCredentialConfiguration,
+	claimsCache claimsResponseCache,
 ) (map[string]interface{}, error) {
 	if !tx.IsPreAuthFlow {
-		claims, err := s.requestClaims(ctx, tx, txCredentialConfiguration)
+		claims, err := s.requestClaims(ctx, tx, txCredentialConfiguration, claimsCache)
 		if err != nil {
 			return nil, resterr.NewSystemError(resterr.IssuerSvcComponent, "RequestClaims", err)
 		}
 
+		if claims, err = mapClaims(claims, txCredentialConfiguration.CredentialTemplate); err != nil {
+			return nil, err
+		}
+
+		if err = profileapi.ValidateClaims(claims, txCredentialConfiguration.ClaimsMetadata); err != nil {
+			return nil, resterr.NewCustomError(resterr.ClaimsValidationErr,
+				fmt.Errorf("validate claims: %w", err))
+		}
+
 		return claims, nil
 	}
 
@@ -1010,48 +1064,6 @@ func (s *Service) getClaimsData(
 	return decryptedClaims, nil
 }
 
-func (s *Service) requestClaims(
-	ctx context.Context,
-	tx *Transaction,
-	txCredentialConfiguration *TxCredentialConfiguration,
-) (map[string]interface{}, error) {
-	r, err := http.NewRequestWithContext(ctx, http.MethodPost, txCredentialConfiguration.ClaimEndpoint, http.NoBody)
-	if err != nil {
-		return nil, fmt.Errorf("create request: %w", err)
-	}
-
-	r.Header.Set("Authorization", "Bearer "+tx.IssuerToken)
-
-	resp, err := s.httpClient.Do(r)
-	if err != nil {
-		return nil, fmt.Errorf("do request: %w", err)
-	}
-
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		b, ioErr := io.ReadAll(resp.Body)
-		if ioErr != nil {
-			log.ReadRequestBodyError(logger, ioErr)
-		} else {
-			logger.Errorc(ctx, "Failed to fetch claims data",
-				log.WithURL(txCredentialConfiguration.ClaimEndpoint),
-				log.WithHTTPStatus(resp.StatusCode),
-				log.WithResponse(b),
-			)
-		}
-
-		return nil, fmt.Errorf("claim endpoint returned status code %d", resp.StatusCode)
-	}
-
-	var m map[string]interface{}
-	if err = json.NewDecoder(resp.Body).Decode(&m); err != nil {
-		return nil, fmt.Errorf("decode claim data: %w", err)
-	}
-
-	return m, nil
-}
-
 func createEvent(
 	eventType spi.EventType,
 	transactionID TxID,
@@ -1116,6 +1128,7 @@ func createTxEventPayload(tx *Transaction) *EventPayload {
 	)
 
 	credentialsData := map[string]vcsverifiable.OIDCFormat{}
+	credentialsDisplay := map[string]*profileapi.LocalizedCredentialDisplay{}
 
 	for _, txCredentialConf := range tx.CredentialConfiguration {
 		var templateID string
@@ -1130,6 +1143,10 @@ func createTxEventPayload(tx *Transaction) *EventPayload {
 		}
 
 		credentialsData[templateID] = txCredentialConf.OIDCCredentialFormat
+
+		if txCredentialConf.Display != nil {
+			credentialsDisplay[templateID] = txCredentialConf.Display
+		}
 	}
 
 	return &EventPayload{
@@ -1143,6 +1160,7 @@ func createTxEventPayload(tx *Transaction) *EventPayload {
 		PinRequired:          tx.UserPin != "",
 		PreAuthFlow:          tx.IsPreAuthFlow,
 		Credentials:          credentialsData,
+		CredentialsDisplay:   credentialsDisplay,
 	}
 }
 
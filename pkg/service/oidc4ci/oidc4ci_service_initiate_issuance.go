@@ -23,9 +23,11 @@ import (
 
 	"github.com/trustbloc/vcs/internal/logfields"
 	"github.com/trustbloc/vcs/pkg/doc/vc"
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
 	"github.com/trustbloc/vcs/pkg/doc/verifiable"
 	profileapi "github.com/trustbloc/vcs/pkg/profile"
 	"github.com/trustbloc/vcs/pkg/restapi/resterr"
+	"github.com/trustbloc/vcs/pkg/service/txcodenotifier"
 )
 
 const (
@@ -33,6 +35,8 @@ const (
 
 	GrantTypeAuthorizationCode = "authorization_code"
 	GrantTypePreAuthorizedCode = "urn:ietf:params:oauth:grant-type:pre-authorized_code"
+
+	defaultInitiateIssuanceURL = "openid-credential-offer://"
 )
 
 // InitiateIssuance creates credential issuance transaction and builds initiate issuance URL.
@@ -64,11 +68,15 @@ func (s *Service) InitiateIssuance( // nolint:funlen,gocyclo,gocognit
 		return nil, resterr.ErrAuthorizedCodeFlowNotSupported
 	}
 
+	if err := validateTxCodeDelivery(req, profile); err != nil {
+		return nil, err
+	}
+
 	issuedCredentialConfiguration := make([]*TxCredentialConfiguration, 0, len(req.CredentialConfiguration))
 
 	for _, credentialConfiguration := range req.CredentialConfiguration {
 		txCredentialConf, err := s.newTxCredentialConf(
-			ctx, credentialConfiguration, isPreAuthFlow, profile)
+			ctx, credentialConfiguration, isPreAuthFlow, profile, req.Locales)
 		if err != nil {
 			return nil, err
 		}
@@ -136,6 +144,28 @@ func (s *Service) InitiateIssuance( // nolint:funlen,gocyclo,gocognit
 		return nil, err
 	}
 
+	userPin := tx.UserPin
+
+	if delivery := req.TxCodeDelivery; delivery != nil {
+		if err = s.txCodeNotifier.NotifyTxCode(ctx, profile.TxCodeNotifier,
+			&txcodenotifier.Recipient{
+				Email: delivery.Email,
+				Phone: delivery.Phone,
+			},
+			&txcodenotifier.MessageData{
+				TxCode:              tx.UserPin,
+				IssuerName:          profile.Name,
+				InitiateIssuanceURL: finalURL,
+			},
+		); err != nil {
+			return nil, resterr.NewSystemError(resterr.TxCodeNotifierComponent, "NotifyTxCode", err)
+		}
+
+		if delivery.OmitFromResponse {
+			userPin = ""
+		}
+	}
+
 	if errSendEvent := s.sendInitiateIssuanceEvent(ctx, tx, finalURL); errSendEvent != nil {
 		return nil, errSendEvent
 	}
@@ -143,12 +173,41 @@ func (s *Service) InitiateIssuance( // nolint:funlen,gocyclo,gocognit
 	return &InitiateIssuanceResponse{
 		InitiateIssuanceURL: finalURL,
 		TxID:                tx.ID,
-		UserPin:             tx.UserPin,
+		UserPin:             userPin,
 		Tx:                  tx,
 		ContentType:         contentType,
 	}, nil
 }
 
+// validateTxCodeDelivery checks that the transaction code can be delivered to the requested addresses.
+func validateTxCodeDelivery(req *InitiateIssuanceRequest, profile *profileapi.Issuer) error {
+	delivery := req.TxCodeDelivery
+	if delivery == nil {
+		return nil
+	}
+
+	var err error
+
+	switch {
+	case !req.UserPinRequired:
+		err = errors.New("user pin is not required")
+	case delivery.Email == "" && delivery.Phone == "":
+		err = errors.New("email or phone is required")
+	case profile.TxCodeNotifier == nil:
+		err = errors.New("tx code notifier is not configured for the profile")
+	case delivery.Email != "" && profile.TxCodeNotifier.SMTP == nil:
+		err = errors.New("email delivery is not configured for the profile")
+	case delivery.Phone != "" && profile.TxCodeNotifier.SMSGateway == nil:
+		err = errors.New("sms delivery is not configured for the profile")
+	}
+
+	if err != nil {
+		return resterr.NewValidationError(resterr.InvalidValue, "tx_code_delivery", err)
+	}
+
+	return nil
+}
+
 func (s *Service) validateFlowSpecificRequestParams(
 	isPreAuthFlow bool,
 	req InitiateIssuanceCredentialConfiguration,
@@ -173,6 +232,7 @@ func (s *Service) newTxCredentialConf(
 	credentialConfiguration InitiateIssuanceCredentialConfiguration,
 	isPreAuthFlow bool,
 	profile *profileapi.Issuer,
+	locales []string,
 ) (*TxCredentialConfiguration, error) {
 	err := s.validateFlowSpecificRequestParams(
 		isPreAuthFlow,
@@ -212,6 +272,12 @@ func (s *Service) newTxCredentialConf(
 
 	metaCredentialConfiguration := profileMeta.CredentialsConfigurationSupported[credentialConfigurationID]
 
+	claimsMetadata, err := metaCredentialConfiguration.ClaimsMetadata()
+	if err != nil {
+		return nil, resterr.NewValidationError(resterr.InvalidValue, "credential_configuration",
+			fmt.Errorf("get claims metadata: %w", err))
+	}
+
 	txCredentialConfiguration := &TxCredentialConfiguration{
 		ID:                    uuid.NewString(),
 		CredentialTemplate:    targetCredentialTemplate,
@@ -225,6 +291,11 @@ func (s *Service) newTxCredentialConf(
 		ClaimDataID:               "",
 		PreAuthCodeExpiresAt:      nil,
 		AuthorizationDetails:      nil,
+		DataModelVersion: verifiable.ResolveDataModelVersion(
+			targetCredentialTemplate.DataModelVersion, profile.VCConfig.DataModelVersion),
+		ClaimsMetadata: claimsMetadata,
+		Display: profileapi.LocalizeDisplay(metaCredentialConfiguration.Display, claimsMetadata,
+			metaCredentialConfiguration.Order, locales),
 	}
 
 	if isPreAuthFlow {
@@ -244,9 +315,14 @@ func (s *Service) newTxCredentialConf(
 }
 
 func (s *Service) validateComposeCredential(credential map[string]interface{}) error {
+	isDataModelV2 := vcutil.IsDataModelV2JSON(credential)
+
 	requiredFields := map[string]string{
-		"issuer":       "did:orb:anything",
-		"issuanceDate": "2021-01-01T00:00:00Z",
+		"issuer": "did:orb:anything",
+	}
+
+	if !isDataModelV2 {
+		requiredFields["issuanceDate"] = "2021-01-01T00:00:00Z"
 	}
 
 	var missingFieldsAdded []string
@@ -258,15 +334,29 @@ func (s *Service) validateComposeCredential(credential map[string]interface{}) e
 		}
 	}
 
-	if _, credCheckErr := verifiable2.ParseCredentialJSON(credential,
+	opts := []verifiable2.CredentialOpt{
 		verifiable2.WithJSONLDDocumentLoader(s.documentLoader),
 		verifiable2.WithDisabledProofCheck(),
 		verifiable2.WithStrictValidation(),
-	); credCheckErr != nil {
+	}
+
+	if isDataModelV2 {
+		opts = append(opts, verifiable2.WithCredDisableValidation())
+	}
+
+	parsed, credCheckErr := verifiable2.ParseCredentialJSON(credential, opts...)
+	if credCheckErr != nil {
 		return resterr.NewValidationError(resterr.InvalidValue, "credential",
 			fmt.Errorf("parse credential: %w", credCheckErr))
 	}
 
+	if isDataModelV2 {
+		if err := vc.ValidateDataModelV2(parsed, s.documentLoader, true); err != nil {
+			return resterr.NewValidationError(resterr.InvalidValue, "credential",
+				fmt.Errorf("parse credential: %w", err))
+		}
+	}
+
 	for _, key := range missingFieldsAdded {
 		delete(credential, key)
 	}
@@ -314,12 +404,17 @@ func (s *Service) applyPreAuthFlowModifications(
 			logger.Debugc(ctx, "issuer claim keys", logfields.WithClaimKeys(claimKeys))
 		}
 
-		if e := s.validateClaims(req.ClaimData, credentialTemplate); e != nil {
+		claims, e := mapClaims(req.ClaimData, credentialTemplate)
+		if e != nil {
+			return e
+		}
+
+		if e = s.validateClaims(claims, credentialTemplate, txCredentialConfiguration.ClaimsMetadata); e != nil {
 			return resterr.NewCustomError(resterr.ClaimsValidationErr,
 				fmt.Errorf("validate claims: %w", e))
 		}
 
-		targetClaims = req.ClaimData
+		targetClaims = claims
 		txCredentialConfiguration.ClaimDataType = ClaimDataTypeClaims
 	} else if req.ComposeCredential != nil {
 		targetClaims = lo.FromPtr(req.ComposeCredential.Credential)
@@ -643,9 +738,12 @@ func (s *Service) buildInitiateIssuanceURL(
 		ct = ContentTypeApplicationJWT
 	}
 
-	initiateIssuanceURL := s.getInitiateIssuanceURL(ctx, req)
+	if initiateIssuanceURL := s.getInitiateIssuanceURL(ctx, req); initiateIssuanceURL != "" {
+		return initiateIssuanceURL + "?" + initiateIssuanceQueryParams.Encode(), ct, nil
+	}
 
-	return initiateIssuanceURL + "?" + initiateIssuanceQueryParams.Encode(), ct, nil
+	return profile.OIDCConfig.WalletURL.BuildURL(defaultInitiateIssuanceURL, initiateIssuanceQueryParams.Encode()),
+		ct, nil
 }
 
 func (s *Service) getInitiateIssuanceQueryParams(
@@ -675,6 +773,8 @@ func (s *Service) getInitiateIssuanceQueryParams(
 	return q, nil
 }
 
+// getInitiateIssuanceURL returns initiate issuance URL of the client wallet. Returns empty string if the client
+// wallet URL is unknown.
 func (s *Service) getInitiateIssuanceURL(ctx context.Context, req *InitiateIssuanceRequest) string {
 	var initiateIssuanceURL string
 
@@ -690,17 +790,18 @@ func (s *Service) getInitiateIssuanceURL(ctx context.Context, req *InitiateIssua
 		}
 	}
 
-	if initiateIssuanceURL == "" {
-		initiateIssuanceURL = "openid-credential-offer://"
-	}
-
 	return initiateIssuanceURL
 }
 
 func (s *Service) validateClaims(
 	claims map[string]interface{},
 	credentialTemplate *profileapi.CredentialTemplate,
+	claimsMetadata map[string]*profileapi.Claim,
 ) error {
+	if err := profileapi.ValidateClaims(claims, claimsMetadata); err != nil {
+		return err
+	}
+
 	if credentialTemplate == nil || credentialTemplate.JSONSchemaID == "" {
 		return nil
 	}
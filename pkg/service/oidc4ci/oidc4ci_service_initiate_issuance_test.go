@@ -13,6 +13,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	profileapi "github.com/trustbloc/vcs/pkg/profile"
 	"github.com/trustbloc/vcs/pkg/restapi/resterr"
 	"github.com/trustbloc/vcs/pkg/service/oidc4ci"
+	"github.com/trustbloc/vcs/pkg/service/txcodenotifier"
 )
 
 const (
@@ -55,6 +57,7 @@ type mocks struct {
 	jsonSchemaValidator *MockJSONSchemaValidator
 	ackService          *MockAckService
 	documentLoader      *jsonld.DefaultDocumentLoader
+	txCodeNotifier      *MockTxCodeNotifier
 }
 
 func TestService_InitiateIssuance(t *testing.T) {
@@ -1632,6 +1635,264 @@ func TestService_InitiateIssuance(t *testing.T) {
 				require.Contains(t, resp.InitiateIssuanceURL, "openid-credential-offer://")
 			},
 		},
+		{
+			name: "Initiate issuance URL from profile wallet url",
+			setup: func(mocks *mocks) {
+				mocks.transactionStore.EXPECT().Create(gomock.Any(), int32(0), gomock.Any()).Return(
+					&oidc4ci.Transaction{
+						TransactionData: oidc4ci.TransactionData{
+							CredentialConfiguration: []*oidc4ci.TxCredentialConfiguration{
+								{
+									OIDCCredentialFormat: verifiable.JwtVCJsonLD,
+								},
+							},
+						},
+					}, nil)
+
+				mocks.wellKnownService.EXPECT().GetOIDCConfiguration(gomock.Any(), issuerWellKnownURL).Return(
+					&oidc4ci.IssuerIDPOIDCConfiguration{}, nil)
+
+				mocks.eventService.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).Return(nil)
+
+				issuanceReq = &oidc4ci.InitiateIssuanceRequest{
+					OpState:   "eyJhbGciOiJSU0Et",
+					GrantType: oidc4ci.GrantTypeAuthorizationCode,
+					CredentialConfiguration: []oidc4ci.InitiateIssuanceCredentialConfiguration{
+						{
+							ClaimEndpoint:        "https://vcs.pb.example.com/claim",
+							CredentialTemplateID: "templateID",
+						},
+					},
+				}
+
+				oidcConfig := *testProfile.OIDCConfig
+				oidcConfig.WalletURL = &profileapi.WalletURLConfig{
+					UniversalLinkTemplate: "https://wallet.example.com/offer?{query}",
+				}
+
+				p := testProfile
+				p.OIDCConfig = &oidcConfig
+
+				profile = &p
+			},
+			check: func(t *testing.T, resp *oidc4ci.InitiateIssuanceResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, strings.HasPrefix(resp.InitiateIssuanceURL,
+					"https://wallet.example.com/offer?credential_offer="))
+			},
+		},
+		{
+			name: "Credential display resolved for locales",
+			setup: func(mocks *mocks) {
+				mocks.transactionStore.EXPECT().Create(gomock.Any(), int32(0), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ int32, data *oidc4ci.TransactionData) (*oidc4ci.Transaction, error) {
+						assert.Equal(t, &profileapi.LocalizedCredentialDisplay{
+							Name:   "Carte de résident permanent",
+							Locale: "fr-CA",
+							Claims: []*profileapi.LocalizedClaimDisplay{
+								{Path: "familyName", Name: "Nom de famille", Locale: "fr-CA"},
+								{Path: "givenName", Name: "Prénom", Locale: "fr-CA"},
+							},
+						}, data.CredentialConfiguration[0].Display)
+
+						return &oidc4ci.Transaction{ID: "txID", TransactionData: *data}, nil
+					})
+
+				mocks.wellKnownService.EXPECT().GetOIDCConfiguration(gomock.Any(), issuerWellKnownURL).Return(
+					&oidc4ci.IssuerIDPOIDCConfiguration{}, nil)
+
+				mocks.eventService.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, _ string, events ...*spi.Event) error {
+						var ep oidc4ci.EventPayload
+
+						b, err := json.Marshal(events[0].Data)
+						assert.NoError(t, err)
+						assert.NoError(t, json.Unmarshal(b, &ep))
+
+						assert.Equal(t, "Carte de résident permanent", ep.CredentialsDisplay["templateID"].Name)
+						assert.Len(t, ep.CredentialsDisplay["templateID"].Claims, 2)
+
+						return nil
+					})
+
+				issuanceReq = &oidc4ci.InitiateIssuanceRequest{
+					OpState:   "eyJhbGciOiJSU0Et",
+					GrantType: oidc4ci.GrantTypeAuthorizationCode,
+					CredentialConfiguration: []oidc4ci.InitiateIssuanceCredentialConfiguration{
+						{
+							ClaimEndpoint:        "https://vcs.pb.example.com/claim",
+							CredentialTemplateID: "templateID",
+						},
+					},
+					Locales: []string{"fr"},
+				}
+
+				var p profileapi.Issuer
+				require.NoError(t, json.Unmarshal(profileJSON, &p))
+
+				p.SigningDID = &profileapi.SigningDID{DID: "did:123"}
+
+				conf := p.CredentialMetaData.CredentialsConfigurationSupported["PermanentResidentCardIdentifier"]
+				conf.Display = []*profileapi.CredentialDisplay{
+					{Name: "Permanent Resident Card", Locale: "en-US"},
+					{Name: "Carte de résident permanent", Locale: "fr-CA"},
+				}
+				conf.CredentialDefinition.CredentialSubject = map[string]profileapi.Claim{
+					"givenName": {Display: []profileapi.L10n{
+						{Name: "Given Name", Locale: "en-US"},
+						{Name: "Prénom", Locale: "fr-CA"},
+					}},
+					"familyName": {Display: []profileapi.L10n{
+						{Name: "Family Name", Locale: "en-US"},
+						{Name: "Nom de famille", Locale: "fr-CA"},
+					}},
+				}
+				conf.Order = []string{"familyName", "givenName"}
+
+				profile = &p
+			},
+			check: func(t *testing.T, resp *oidc4ci.InitiateIssuanceResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, resp.Tx.CredentialConfiguration[0].Display)
+			},
+		},
+		{
+			name: "Tx code delivered by notifier",
+			setup: func(mocks *mocks) {
+				mocks.transactionStore.EXPECT().Create(gomock.Any(), int32(0), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ int32, data *oidc4ci.TransactionData) (*oidc4ci.Transaction, error) {
+						return &oidc4ci.Transaction{ID: "txID", TransactionData: *data}, nil
+					})
+
+				mocks.wellKnownService.EXPECT().GetOIDCConfiguration(gomock.Any(), issuerWellKnownURL).Return(
+					&oidc4ci.IssuerIDPOIDCConfiguration{}, nil)
+
+				mocks.pinGenerator.EXPECT().Generate(gomock.Any()).Return("123456")
+
+				mocks.txCodeNotifier.EXPECT().NotifyTxCode(gomock.Any(), gomock.Any(),
+					&txcodenotifier.Recipient{Email: "holder@example.com", Phone: "+15555550100"}, gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						config *profileapi.TxCodeNotifierConfig,
+						_ *txcodenotifier.Recipient,
+						data *txcodenotifier.MessageData,
+					) error {
+						assert.Equal(t, profile.TxCodeNotifier, config)
+						assert.Equal(t, "123456", data.TxCode)
+						assert.Equal(t, profile.Name, data.IssuerName)
+						assert.NotEmpty(t, data.InitiateIssuanceURL)
+
+						return nil
+					})
+
+				mocks.eventService.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).Return(nil)
+
+				issuanceReq = &oidc4ci.InitiateIssuanceRequest{
+					OpState:         "eyJhbGciOiJSU0Et",
+					GrantType:       oidc4ci.GrantTypeAuthorizationCode,
+					UserPinRequired: true,
+					CredentialConfiguration: []oidc4ci.InitiateIssuanceCredentialConfiguration{
+						{
+							ClaimEndpoint:        "https://vcs.pb.example.com/claim",
+							CredentialTemplateID: "templateID",
+						},
+					},
+					TxCodeDelivery: &oidc4ci.TxCodeDelivery{
+						Email:            "holder@example.com",
+						Phone:            "+15555550100",
+						OmitFromResponse: true,
+					},
+				}
+
+				p := testProfile
+				p.TxCodeNotifier = &profileapi.TxCodeNotifierConfig{
+					SMTP:       &profileapi.SMTPConfig{Host: "smtp.example.com", Port: 587, From: "noreply@example.com"},
+					SMSGateway: &profileapi.SMSGatewayConfig{URL: "https://sms.example.com"},
+				}
+
+				profile = &p
+			},
+			check: func(t *testing.T, resp *oidc4ci.InitiateIssuanceResponse, err error) {
+				require.NoError(t, err)
+				require.Empty(t, resp.UserPin)
+				require.Equal(t, "123456", resp.Tx.UserPin)
+			},
+		},
+		{
+			name: "Tx code notifier error",
+			setup: func(mocks *mocks) {
+				mocks.transactionStore.EXPECT().Create(gomock.Any(), int32(0), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ int32, data *oidc4ci.TransactionData) (*oidc4ci.Transaction, error) {
+						return &oidc4ci.Transaction{ID: "txID", TransactionData: *data}, nil
+					})
+
+				mocks.wellKnownService.EXPECT().GetOIDCConfiguration(gomock.Any(), issuerWellKnownURL).Return(
+					&oidc4ci.IssuerIDPOIDCConfiguration{}, nil)
+
+				mocks.pinGenerator.EXPECT().Generate(gomock.Any()).Return("123456")
+
+				mocks.txCodeNotifier.EXPECT().NotifyTxCode(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("smtp error"))
+
+				mocks.eventService.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				issuanceReq = &oidc4ci.InitiateIssuanceRequest{
+					OpState:         "eyJhbGciOiJSU0Et",
+					GrantType:       oidc4ci.GrantTypeAuthorizationCode,
+					UserPinRequired: true,
+					CredentialConfiguration: []oidc4ci.InitiateIssuanceCredentialConfiguration{
+						{
+							ClaimEndpoint:        "https://vcs.pb.example.com/claim",
+							CredentialTemplateID: "templateID",
+						},
+					},
+					TxCodeDelivery: &oidc4ci.TxCodeDelivery{Email: "holder@example.com"},
+				}
+
+				p := testProfile
+				p.TxCodeNotifier = &profileapi.TxCodeNotifierConfig{
+					SMTP: &profileapi.SMTPConfig{Host: "smtp.example.com", Port: 587, From: "noreply@example.com"},
+				}
+
+				profile = &p
+			},
+			check: func(t *testing.T, resp *oidc4ci.InitiateIssuanceResponse, err error) {
+				require.Nil(t, resp)
+				require.ErrorContains(t, err, "smtp error")
+
+				var customErr *resterr.CustomError
+				require.ErrorAs(t, err, &customErr)
+				require.Equal(t, resterr.TxCodeNotifierComponent, customErr.Component)
+			},
+		},
+		{
+			name: "Invalid tx code delivery",
+			setup: func(mocks *mocks) {
+				issuanceReq = &oidc4ci.InitiateIssuanceRequest{
+					OpState:         "eyJhbGciOiJSU0Et",
+					GrantType:       oidc4ci.GrantTypeAuthorizationCode,
+					UserPinRequired: true,
+					CredentialConfiguration: []oidc4ci.InitiateIssuanceCredentialConfiguration{
+						{
+							ClaimEndpoint:        "https://vcs.pb.example.com/claim",
+							CredentialTemplateID: "templateID",
+						},
+					},
+					TxCodeDelivery: &oidc4ci.TxCodeDelivery{Phone: "+15555550100"},
+				}
+
+				p := testProfile
+				p.TxCodeNotifier = &profileapi.TxCodeNotifierConfig{
+					SMTP: &profileapi.SMTPConfig{Host: "smtp.example.com", Port: 587, From: "noreply@example.com"},
+				}
+
+				profile = &p
+			},
+			check: func(t *testing.T, resp *oidc4ci.InitiateIssuanceResponse, err error) {
+				require.Nil(t, resp)
+				require.ErrorContains(t, err, "sms delivery is not configured for the profile")
+			},
+		},
 		{
 			name: "Fail to get OIDC configuration",
 			setup: func(mocks *mocks) {
@@ -1853,6 +2114,85 @@ func TestService_InitiateIssuance(t *testing.T) {
 				require.Nil(t, resp)
 			},
 		},
+		{
+			name: "Error because of claims metadata violation",
+			setup: func(mocks *mocks) {
+				mocks.wellKnownService.EXPECT().GetOIDCConfiguration(gomock.Any(), gomock.Any()).Times(0)
+				mocks.jsonSchemaValidator.EXPECT().Validate(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				var p profileapi.Issuer
+				require.NoError(t, json.Unmarshal(profileJSON, &p))
+
+				p.SigningDID = &profileapi.SigningDID{DID: "did:123"}
+				p.CredentialMetaData.CredentialsConfigurationSupported["PermanentResidentCardIdentifier"].
+					CredentialDefinition.CredentialSubject = map[string]profileapi.Claim{
+					"name":  {Mandatory: true, ValueType: "string"},
+					"email": {Mandatory: true},
+				}
+
+				issuanceReq = &oidc4ci.InitiateIssuanceRequest{
+					ClientWellKnownURL: walletWellKnownURL,
+					OpState:            "eyJhbGciOiJSU0Et",
+					GrantType:          oidc4ci.GrantTypePreAuthorizedCode,
+					CredentialConfiguration: []oidc4ci.InitiateIssuanceCredentialConfiguration{
+						{
+							ClaimData:            map[string]interface{}{"name": 1},
+							CredentialTemplateID: "templateID",
+						},
+					},
+				}
+
+				profile = &p
+			},
+			check: func(t *testing.T, resp *oidc4ci.InitiateIssuanceResponse, err error) {
+				require.Nil(t, resp)
+
+				var customErr *resterr.CustomError
+				require.ErrorAs(t, err, &customErr)
+				require.Equal(t, resterr.ClaimsValidationErr, customErr.Code)
+
+				require.ErrorContains(t, err, `claim "email": mandatory claim is missing`)
+				require.ErrorContains(t, err, `claim "name": value is not of type string`)
+			},
+		},
+		{
+			name: "Error because of claims mapping",
+			setup: func(mocks *mocks) {
+				mocks.wellKnownService.EXPECT().GetOIDCConfiguration(gomock.Any(), gomock.Any()).Times(0)
+				mocks.jsonSchemaValidator.EXPECT().Validate(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				var p profileapi.Issuer
+				require.NoError(t, json.Unmarshal(profileJSON, &p))
+
+				p.SigningDID = &profileapi.SigningDID{DID: "did:123"}
+				p.CredentialTemplates[0].ClaimsMapping = map[string]*profileapi.ClaimMapping{
+					"birthDate": {Path: "$.dob", Transform: profileapi.ClaimTransformDate},
+				}
+
+				issuanceReq = &oidc4ci.InitiateIssuanceRequest{
+					ClientWellKnownURL: walletWellKnownURL,
+					OpState:            "eyJhbGciOiJSU0Et",
+					GrantType:          oidc4ci.GrantTypePreAuthorizedCode,
+					CredentialConfiguration: []oidc4ci.InitiateIssuanceCredentialConfiguration{
+						{
+							ClaimData:            map[string]interface{}{"dob": "bad"},
+							CredentialTemplateID: "templateID",
+						},
+					},
+				}
+
+				profile = &p
+			},
+			check: func(t *testing.T, resp *oidc4ci.InitiateIssuanceResponse, err error) {
+				require.Nil(t, resp)
+
+				var customErr *resterr.CustomError
+				require.ErrorAs(t, err, &customErr)
+				require.Equal(t, resterr.ClaimsValidationErr, customErr.Code)
+
+				require.ErrorContains(t, err, `map claims: claim mapping "birthDate": value "bad" is not a date`)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1865,6 +2205,7 @@ func TestService_InitiateIssuance(t *testing.T) {
 				crypto:              NewMockDataProtector(gomock.NewController(t)),
 				jsonSchemaValidator: NewMockJSONSchemaValidator(gomock.NewController(t)),
 				documentLoader:      jsonld.NewDefaultDocumentLoader(http.DefaultClient),
+				txCodeNotifier:      NewMockTxCodeNotifier(gomock.NewController(t)),
 			}
 
 			tt.setup(m)
@@ -1881,6 +2222,7 @@ func TestService_InitiateIssuance(t *testing.T) {
 				JSONSchemaValidator: m.jsonSchemaValidator,
 				Composer:            m.composer,
 				DocumentLoader:      m.documentLoader,
+				TxCodeNotifier:      m.txCodeNotifier,
 			})
 			require.NoError(t, err)
 
@@ -0,0 +1,377 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package oidc4ci
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/trustbloc/logutil-go/pkg/log"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/trustbloc/vcs/pkg/doc/vc"
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/restapi/resterr"
+	"github.com/trustbloc/vcs/pkg/restapi/v1/common"
+)
+
+const (
+	defaultClaimsRequestRetryInterval = 500 * time.Millisecond
+	signedClaimsRequestTTL            = 5 * time.Minute
+)
+
+// claimsResponseCache keeps claim endpoint responses of one credential request keyed by the claims request,
+// so batch credential requests of the transaction can share a single claim endpoint call.
+type claimsResponseCache map[string][]byte
+
+// claimsRequestData is the data of the claims request body template.
+type claimsRequestData struct {
+	TxID                      string
+	OpState                   string
+	IssuerToken               string
+	ProfileID                 string
+	ProfileVersion            string
+	CredentialType            string
+	CredentialConfigurationID string
+	Format                    string
+	AuthorizationDetails      *common.AuthorizationDetails
+}
+
+func (s *Service) requestClaims(
+	ctx context.Context,
+	tx *Transaction,
+	txCredentialConfiguration *TxCredentialConfiguration,
+	claimsCache claimsResponseCache,
+) (map[string]interface{}, error) {
+	conf := &profileapi.ClaimsRequestConfig{}
+	if txCredentialConfiguration.CredentialTemplate != nil &&
+		txCredentialConfiguration.CredentialTemplate.ClaimsRequest != nil {
+		conf = txCredentialConfiguration.CredentialTemplate.ClaimsRequest
+	}
+
+	endpoint := txCredentialConfiguration.ClaimEndpoint
+
+	body, err := claimsRequestBody(conf, tx, txCredentialConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := endpoint + "\n" + string(body)
+
+	if cached, ok := claimsCache[cacheKey]; ok && conf.CacheResponse {
+		return decodeClaimData(cached)
+	}
+
+	var profile *profileapi.Issuer
+
+	if conf.AuthMethod == profileapi.ClaimsRequestAuthClientCredentials ||
+		conf.AuthMethod == profileapi.ClaimsRequestAuthSignedJWT {
+		if profile, err = s.profileService.GetProfile(tx.ProfileID, tx.ProfileVersion); err != nil {
+			return nil, fmt.Errorf("get profile: %w", err)
+		}
+	}
+
+	req := &claimsRequest{
+		endpoint: endpoint,
+		body:     body,
+		headers:  conf.Headers,
+		timeout:  conf.Timeout,
+	}
+
+	if len(body) > 0 {
+		req.contentType = "application/json"
+	}
+
+	switch conf.AuthMethod { //nolint:exhaustive
+	case profileapi.ClaimsRequestAuthClientCredentials:
+		var token *oauth2.Token
+
+		if token, err = s.getClaimsRequestToken(ctx, profile, conf); err != nil {
+			return nil, err
+		}
+
+		req.authorization = token.Type() + " " + token.AccessToken
+	case profileapi.ClaimsRequestAuthSignedJWT:
+		if req.body, err = s.signClaimsRequest(profile, endpoint, body); err != nil {
+			return nil, err
+		}
+
+		req.contentType = "application/jwt"
+		req.authorization = "Bearer " + tx.IssuerToken
+	default:
+		req.authorization = "Bearer " + tx.IssuerToken
+	}
+
+	httpClient, err := s.claimsHTTPClient(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	if httpClient != s.httpClient {
+		defer httpClient.CloseIdleConnections()
+	}
+
+	retryInterval := defaultClaimsRequestRetryInterval
+	if conf.RetryInterval != nil {
+		retryInterval = *conf.RetryInterval
+	}
+
+	respBody, err := backoff.RetryWithData(
+		func() ([]byte, error) {
+			return s.doClaimsRequest(ctx, httpClient, req)
+		},
+		backoff.WithContext(
+			backoff.WithMaxRetries(backoff.NewConstantBackOff(retryInterval), uint64(conf.MaxRetries)), ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.CacheResponse {
+		claimsCache[cacheKey] = respBody
+	}
+
+	return decodeClaimData(respBody)
+}
+
+type claimsRequest struct {
+	endpoint      string
+	body          []byte
+	contentType   string
+	authorization string
+	headers       map[string]string
+	timeout       *time.Duration
+}
+
+// doClaimsRequest sends the claims request. Errors of requests which should not be retried are
+// wrapped into backoff.PermanentError.
+func (s *Service) doClaimsRequest(
+	ctx context.Context,
+	httpClient *http.Client,
+	req *claimsRequest,
+) ([]byte, error) {
+	if req.timeout != nil {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, *req.timeout)
+		defer cancel()
+	}
+
+	var body io.Reader = http.NoBody
+	if len(req.body) > 0 {
+		body = bytes.NewReader(req.body)
+	}
+
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, req.endpoint, body)
+	if err != nil {
+		return nil, backoff.Permanent(fmt.Errorf("create request: %w", err))
+	}
+
+	for name, value := range req.headers {
+		r.Header.Set(name, value)
+	}
+
+	if req.contentType != "" && r.Header.Get("Content-Type") == "" {
+		r.Header.Set("Content-Type", req.contentType)
+	}
+
+	r.Header.Set("Authorization", req.authorization)
+
+	resp, err := httpClient.Do(r)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		b, ioErr := io.ReadAll(resp.Body)
+		if ioErr != nil {
+			log.ReadRequestBodyError(logger, ioErr)
+		} else {
+			logger.Errorc(ctx, "Failed to fetch claims data",
+				log.WithURL(req.endpoint),
+				log.WithHTTPStatus(resp.StatusCode),
+				log.WithResponse(b),
+			)
+		}
+
+		err = fmt.Errorf("claim endpoint returned status code %d", resp.StatusCode)
+
+		if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+			return nil, err
+		}
+
+		return nil, backoff.Permanent(err)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read claim data: %w", err)
+	}
+
+	return b, nil
+}
+
+func claimsRequestBody(
+	conf *profileapi.ClaimsRequestConfig,
+	tx *Transaction,
+	txCredentialConfiguration *TxCredentialConfiguration,
+) ([]byte, error) {
+	tmpl, err := conf.ParseBodyTemplate()
+	if err != nil || tmpl == nil {
+		return nil, err
+	}
+
+	data := &claimsRequestData{
+		TxID:                      string(tx.ID),
+		OpState:                   tx.OpState,
+		IssuerToken:               tx.IssuerToken,
+		ProfileID:                 tx.ProfileID,
+		ProfileVersion:            tx.ProfileVersion,
+		CredentialConfigurationID: txCredentialConfiguration.CredentialConfigurationID,
+		Format:                    string(txCredentialConfiguration.OIDCCredentialFormat),
+	}
+
+	if txCredentialConfiguration.CredentialTemplate != nil {
+		data.CredentialType = txCredentialConfiguration.CredentialTemplate.Type
+	}
+
+	if txCredentialConfiguration.AuthorizationDetails != nil {
+		data.AuthorizationDetails = lo.ToPtr(txCredentialConfiguration.AuthorizationDetails.ToDTO())
+	}
+
+	var buf bytes.Buffer
+
+	if err = tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("execute claims request body template: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *Service) getClaimsRequestToken(
+	ctx context.Context,
+	profile *profileapi.Issuer,
+	conf *profileapi.ClaimsRequestConfig,
+) (*oauth2.Token, error) {
+	if profile.OIDCConfig == nil {
+		return nil, errors.New("oidc config is not set for the profile")
+	}
+
+	clientCredentials := &clientcredentials.Config{
+		ClientID:     profile.OIDCConfig.ClientID,
+		ClientSecret: profile.OIDCConfig.ClientSecretHandle,
+		TokenURL:     conf.TokenEndpoint,
+		Scopes:       conf.Scopes,
+		AuthStyle:    oauth2.AuthStyleAutoDetect,
+	}
+
+	token, err := clientCredentials.Token(context.WithValue(ctx, oauth2.HTTPClient, s.httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("get client credentials token: %w", err)
+	}
+
+	return token, nil
+}
+
+// signClaimsRequest returns the request body as a JWT signed with the signing key of the profile.
+// The body must be a JSON object.
+func (s *Service) signClaimsRequest(
+	profile *profileapi.Issuer,
+	endpoint string,
+	body []byte,
+) ([]byte, error) {
+	claims := map[string]interface{}{}
+
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &claims); err != nil {
+			return nil, fmt.Errorf("claims request body is not a json object: %w", err)
+		}
+	}
+
+	if profile.SigningDID == nil {
+		return nil, errors.New("signing did is not set for the profile")
+	}
+
+	kms, err := s.kmsRegistry.GetKeyManager(profile.KMSConfig)
+	if err != nil {
+		return nil, fmt.Errorf("get kms: %w", err)
+	}
+
+	now := time.Now()
+
+	claims["iss"] = profile.SigningDID.DID
+	claims["aud"] = endpoint
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(signedClaimsRequestTTL).Unix()
+	claims["jti"] = uuid.NewString()
+
+	signed, err := s.cryptoJWTSigner.NewJWTSigned(claims, &vc.Signer{
+		KeyType:       profile.VCConfig.KeyType,
+		KMSKeyID:      profile.SigningDID.KMSKeyID,
+		KMS:           kms,
+		SignatureType: profile.VCConfig.SigningAlgorithm,
+		Creator:       profile.SigningDID.Creator,
+	})
+	if err != nil {
+		return nil, resterr.NewSystemError(resterr.CryptoJWTSignerComponent, "sign",
+			fmt.Errorf("sign claims request: %w", err))
+	}
+
+	return []byte(signed), nil
+}
+
+// claimsHTTPClient returns the http client with the client certificate for tls_client_auth auth method
+// and the default http client otherwise.
+func (s *Service) claimsHTTPClient(conf *profileapi.ClaimsRequestConfig) (*http.Client, error) {
+	if conf.AuthMethod != profileapi.ClaimsRequestAuthTLSClient {
+		return s.httpClient, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(conf.TLSCertFile, conf.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load tls client certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if s.tlsConfig != nil {
+		tlsConfig = s.tlsConfig.Clone()
+	}
+
+	tlsConfig.Certificates = []tls.Certificate{cert}
+
+	httpClient := &http.Client{
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}
+
+	if s.httpClient != nil {
+		httpClient.Timeout = s.httpClient.Timeout
+	}
+
+	return httpClient, nil
+}
+
+func decodeClaimData(b []byte) (map[string]interface{}, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("decode claim data: %w", err)
+	}
+
+	return m, nil
+}
@@ -0,0 +1,425 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package oidc4ci_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trustbloc/vcs/pkg/doc/vc"
+	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
+	"github.com/trustbloc/vcs/pkg/event/spi"
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/service/oidc4ci"
+)
+
+func TestService_PrepareCredential_ClaimsRequest(t *testing.T) {
+	const (
+		claimEndpoint = "https://issuer.example.com/claims"
+		tokenEndpoint = "https://issuer.example.com/token"
+		claimData     = `{"surname":"Smith","givenName":"Pat","jobTitle":"Worker"}`
+	)
+
+	var (
+		transactionStore *MockTransactionStore
+		profileService   *MockProfileService
+		kmsRegistry      *MockKMSRegistry
+		cryptoJWTSigner  *MockCryptoJWTSigner
+		ackService       *MockAckService
+		eventService     *MockEventService
+	)
+
+	newService := func(t *testing.T, roundTrip func(req *http.Request) (*http.Response, error)) *oidc4ci.Service {
+		t.Helper()
+
+		ctrl := gomock.NewController(t)
+
+		transactionStore = NewMockTransactionStore(ctrl)
+		profileService = NewMockProfileService(ctrl)
+		kmsRegistry = NewMockKMSRegistry(ctrl)
+		cryptoJWTSigner = NewMockCryptoJWTSigner(ctrl)
+		ackService = NewMockAckService(ctrl)
+		eventService = NewMockEventService(ctrl)
+
+		srv, err := oidc4ci.NewService(&oidc4ci.Config{
+			TransactionStore: transactionStore,
+			ProfileService:   profileService,
+			KMSRegistry:      kmsRegistry,
+			CryptoJWTSigner:  cryptoJWTSigner,
+			HTTPClient:       &http.Client{Transport: &mockTransport{roundTrip}},
+			AckService:       ackService,
+			EventService:     eventService,
+			EventTopic:       spi.IssuerEventTopic,
+		})
+		assert.NoError(t, err)
+
+		return srv
+	}
+
+	newTx := func(conf *profileapi.ClaimsRequestConfig, types ...string) *oidc4ci.Transaction {
+		tx := &oidc4ci.Transaction{
+			ID: "txID",
+			TransactionData: oidc4ci.TransactionData{
+				ProfileID:      "profileID",
+				ProfileVersion: "v1.0",
+				OpState:        "opState",
+				IssuerToken:    "issuer-access-token",
+			},
+		}
+
+		for _, credentialType := range types {
+			tx.CredentialConfiguration = append(tx.CredentialConfiguration, &oidc4ci.TxCredentialConfiguration{
+				ID:                   uuid.NewString(),
+				OIDCCredentialFormat: vcsverifiable.JwtVCJsonLD,
+				ClaimEndpoint:        claimEndpoint,
+				CredentialTemplate: &profileapi.CredentialTemplate{
+					ID:            credentialType,
+					Type:          credentialType,
+					ClaimsRequest: conf,
+				},
+				CredentialConfigurationID: credentialType + "Identifier",
+			})
+		}
+
+		return tx
+	}
+
+	newRequest := func(types ...string) *oidc4ci.PrepareCredential {
+		req := &oidc4ci.PrepareCredential{TxID: "txID"}
+
+		for _, credentialType := range types {
+			req.CredentialRequests = append(req.CredentialRequests, &oidc4ci.PrepareCredentialRequest{
+				AudienceClaim:    "/oidc/idp/profileID/v1.0",
+				CredentialFormat: vcsverifiable.JwtVCJsonLD,
+				CredentialTypes:  []string{credentialType},
+			})
+		}
+
+		return req
+	}
+
+	expectSuccess := func(credentials int) {
+		ackService.EXPECT().CreateAck(gomock.Any(), gomock.Any()).Times(credentials).Return(lo.ToPtr("ackID"), nil)
+		transactionStore.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		eventService.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).Return(nil)
+	}
+
+	response := func(status int, body string) *http.Response {
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(bytes.NewBufferString(body)),
+		}
+	}
+
+	t.Run("body template, headers and cached response", func(t *testing.T) {
+		calls := 0
+
+		srv := newService(t, func(req *http.Request) (*http.Response, error) {
+			calls++
+
+			assert.Equal(t, claimEndpoint, req.URL.String())
+			assert.Equal(t, "Bearer issuer-access-token", req.Header.Get("Authorization"))
+			assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+			assert.Equal(t, "vcs", req.Header.Get("X-Client"))
+
+			body, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"tx_id":"txID","op_state":"opState","profile":"profileID"}`, string(body))
+
+			return response(http.StatusOK, claimData), nil
+		})
+
+		conf := &profileapi.ClaimsRequestConfig{
+			BodyTemplate:  `{"tx_id":{{json .TxID}},"op_state":{{json .OpState}},"profile":{{json .ProfileID}}}`,
+			Headers:       map[string]string{"X-Client": "vcs"},
+			CacheResponse: true,
+		}
+
+		transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).
+			Return(newTx(conf, "VerifiedEmployee", "PermanentResidentCard"), nil)
+		expectSuccess(2)
+
+		resp, err := srv.PrepareCredential(context.TODO(), newRequest("VerifiedEmployee", "PermanentResidentCard"))
+		assert.NoError(t, err)
+		assert.Len(t, resp.Credentials, 2)
+		assert.Equal(t, 1, calls)
+
+		for _, c := range resp.Credentials {
+			assert.Equal(t, "Pat", c.Credential.Contents().Subject[0].CustomFields["givenName"])
+		}
+	})
+
+	t.Run("response is not cached", func(t *testing.T) {
+		var bodies []string
+
+		srv := newService(t, func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+
+			bodies = append(bodies, string(body))
+
+			return response(http.StatusOK, claimData), nil
+		})
+
+		conf := &profileapi.ClaimsRequestConfig{
+			BodyTemplate: `{"type":{{json .CredentialType}}}`,
+		}
+
+		transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).
+			Return(newTx(conf, "VerifiedEmployee", "PermanentResidentCard"), nil)
+		expectSuccess(2)
+
+		_, err := srv.PrepareCredential(context.TODO(), newRequest("VerifiedEmployee", "PermanentResidentCard"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{`{"type":"VerifiedEmployee"}`, `{"type":"PermanentResidentCard"}`}, bodies)
+	})
+
+	t.Run("retry on server error", func(t *testing.T) {
+		calls := 0
+
+		srv := newService(t, func(req *http.Request) (*http.Response, error) {
+			calls++
+
+			if calls == 1 {
+				return response(http.StatusServiceUnavailable, "unavailable"), nil
+			}
+
+			return response(http.StatusOK, claimData), nil
+		})
+
+		conf := &profileapi.ClaimsRequestConfig{
+			MaxRetries:    2,
+			RetryInterval: lo.ToPtr(time.Millisecond),
+		}
+
+		transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(newTx(conf, "VerifiedEmployee"), nil)
+		expectSuccess(1)
+
+		_, err := srv.PrepareCredential(context.TODO(), newRequest("VerifiedEmployee"))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("no retry on client error", func(t *testing.T) {
+		calls := 0
+
+		srv := newService(t, func(req *http.Request) (*http.Response, error) {
+			calls++
+
+			return response(http.StatusForbidden, "forbidden"), nil
+		})
+
+		conf := &profileapi.ClaimsRequestConfig{
+			MaxRetries:    2,
+			RetryInterval: lo.ToPtr(time.Millisecond),
+		}
+
+		transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(newTx(conf, "VerifiedEmployee"), nil)
+		eventService.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).Return(nil)
+
+		_, err := srv.PrepareCredential(context.TODO(), newRequest("VerifiedEmployee"))
+		assert.ErrorContains(t, err, "claim endpoint returned status code 403")
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("retries exceeded", func(t *testing.T) {
+		calls := 0
+
+		srv := newService(t, func(req *http.Request) (*http.Response, error) {
+			calls++
+
+			return nil, errors.New("connection refused")
+		})
+
+		conf := &profileapi.ClaimsRequestConfig{
+			MaxRetries:    2,
+			RetryInterval: lo.ToPtr(time.Millisecond),
+		}
+
+		transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(newTx(conf, "VerifiedEmployee"), nil)
+		eventService.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).Return(nil)
+
+		_, err := srv.PrepareCredential(context.TODO(), newRequest("VerifiedEmployee"))
+		assert.ErrorContains(t, err, "connection refused")
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("client credentials", func(t *testing.T) {
+		srv := newService(t, func(req *http.Request) (*http.Response, error) {
+			if req.URL.String() == tokenEndpoint {
+				assert.NoError(t, req.ParseForm())
+				assert.Equal(t, "client_credentials", req.Form.Get("grant_type"))
+				assert.Equal(t, "claims", req.Form.Get("scope"))
+
+				return response(http.StatusOK, `{"access_token":"client-token","token_type":"Bearer"}`), nil
+			}
+
+			assert.Equal(t, "Bearer client-token", req.Header.Get("Authorization"))
+
+			return response(http.StatusOK, claimData), nil
+		})
+
+		conf := &profileapi.ClaimsRequestConfig{
+			AuthMethod:    profileapi.ClaimsRequestAuthClientCredentials,
+			TokenEndpoint: tokenEndpoint,
+			Scopes:        []string{"claims"},
+		}
+
+		profileService.EXPECT().GetProfile("profileID", "v1.0").Return(&profileapi.Issuer{
+			OIDCConfig: &profileapi.OIDCConfig{ClientID: "client", ClientSecretHandle: "secret"},
+		}, nil)
+		transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(newTx(conf, "VerifiedEmployee"), nil)
+		expectSuccess(1)
+
+		_, err := srv.PrepareCredential(context.TODO(), newRequest("VerifiedEmployee"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("client credentials token error", func(t *testing.T) {
+		srv := newService(t, func(req *http.Request) (*http.Response, error) {
+			return response(http.StatusUnauthorized, `{"error":"invalid_client"}`), nil
+		})
+
+		conf := &profileapi.ClaimsRequestConfig{
+			AuthMethod:    profileapi.ClaimsRequestAuthClientCredentials,
+			TokenEndpoint: tokenEndpoint,
+		}
+
+		profileService.EXPECT().GetProfile("profileID", "v1.0").Return(&profileapi.Issuer{
+			OIDCConfig: &profileapi.OIDCConfig{ClientID: "client", ClientSecretHandle: "secret"},
+		}, nil)
+		transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(newTx(conf, "VerifiedEmployee"), nil)
+		eventService.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).Return(nil)
+
+		_, err := srv.PrepareCredential(context.TODO(), newRequest("VerifiedEmployee"))
+		assert.ErrorContains(t, err, "get client credentials token")
+	})
+
+	t.Run("signed request jwt", func(t *testing.T) {
+		srv := newService(t, func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "application/jwt", req.Header.Get("Content-Type"))
+			assert.Equal(t, "Bearer issuer-access-token", req.Header.Get("Authorization"))
+
+			body, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, "signed.request.jwt", string(body))
+
+			return response(http.StatusOK, claimData), nil
+		})
+
+		conf := &profileapi.ClaimsRequestConfig{
+			AuthMethod:   profileapi.ClaimsRequestAuthSignedJWT,
+			BodyTemplate: `{"credential_type":{{json .CredentialType}}}`,
+		}
+
+		profileService.EXPECT().GetProfile("profileID", "v1.0").Return(&profileapi.Issuer{
+			SigningDID: &profileapi.SigningDID{DID: "did:example:issuer", KMSKeyID: "keyID"},
+			VCConfig:   &profileapi.VCConfig{},
+		}, nil)
+		kmsRegistry.EXPECT().GetKeyManager(gomock.Any()).Return(nil, nil)
+		cryptoJWTSigner.EXPECT().NewJWTSigned(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(claims interface{}, signer *vc.Signer) (string, error) {
+				m, ok := claims.(map[string]interface{})
+				assert.True(t, ok)
+
+				assert.Equal(t, "VerifiedEmployee", m["credential_type"])
+				assert.Equal(t, "did:example:issuer", m["iss"])
+				assert.Equal(t, claimEndpoint, m["aud"])
+				assert.NotEmpty(t, m["jti"])
+				assert.Equal(t, "keyID", signer.KMSKeyID)
+
+				return "signed.request.jwt", nil
+			})
+		transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(newTx(conf, "VerifiedEmployee"), nil)
+		expectSuccess(1)
+
+		_, err := srv.PrepareCredential(context.TODO(), newRequest("VerifiedEmployee"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("signed request jwt sign error", func(t *testing.T) {
+		srv := newService(t, nil)
+
+		conf := &profileapi.ClaimsRequestConfig{AuthMethod: profileapi.ClaimsRequestAuthSignedJWT}
+
+		profileService.EXPECT().GetProfile("profileID", "v1.0").Return(&profileapi.Issuer{
+			SigningDID: &profileapi.SigningDID{DID: "did:example:issuer"},
+			VCConfig:   &profileapi.VCConfig{},
+		}, nil)
+		kmsRegistry.EXPECT().GetKeyManager(gomock.Any()).Return(nil, nil)
+		cryptoJWTSigner.EXPECT().NewJWTSigned(gomock.Any(), gomock.Any()).Return("", errors.New("sign error"))
+		transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(newTx(conf, "VerifiedEmployee"), nil)
+		eventService.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).Return(nil)
+
+		_, err := srv.PrepareCredential(context.TODO(), newRequest("VerifiedEmployee"))
+		assert.ErrorContains(t, err, "sign claims request: sign error")
+	})
+
+	t.Run("profile not found", func(t *testing.T) {
+		srv := newService(t, nil)
+
+		conf := &profileapi.ClaimsRequestConfig{AuthMethod: profileapi.ClaimsRequestAuthSignedJWT}
+
+		profileService.EXPECT().GetProfile("profileID", "v1.0").Return(nil, errors.New("not found"))
+		transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(newTx(conf, "VerifiedEmployee"), nil)
+		eventService.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).Return(nil)
+
+		_, err := srv.PrepareCredential(context.TODO(), newRequest("VerifiedEmployee"))
+		assert.ErrorContains(t, err, "get profile: not found")
+	})
+
+	t.Run("tls client certificate error", func(t *testing.T) {
+		srv := newService(t, nil)
+
+		conf := &profileapi.ClaimsRequestConfig{
+			AuthMethod:  profileapi.ClaimsRequestAuthTLSClient,
+			TLSCertFile: "missing-cert.pem",
+			TLSKeyFile:  "missing-key.pem",
+		}
+
+		transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(newTx(conf, "VerifiedEmployee"), nil)
+		eventService.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).Return(nil)
+
+		_, err := srv.PrepareCredential(context.TODO(), newRequest("VerifiedEmployee"))
+		assert.ErrorContains(t, err, "load tls client certificate")
+	})
+
+	t.Run("body template error", func(t *testing.T) {
+		srv := newService(t, nil)
+
+		conf := &profileapi.ClaimsRequestConfig{BodyTemplate: `{{.Unknown}}`}
+
+		transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(newTx(conf, "VerifiedEmployee"), nil)
+		eventService.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).Return(nil)
+
+		_, err := srv.PrepareCredential(context.TODO(), newRequest("VerifiedEmployee"))
+		assert.ErrorContains(t, err, "execute claims request body template")
+	})
+
+	t.Run("invalid claim data", func(t *testing.T) {
+		srv := newService(t, func(req *http.Request) (*http.Response, error) {
+			return response(http.StatusOK, "not json"), nil
+		})
+
+		transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(newTx(nil, "VerifiedEmployee"), nil)
+		eventService.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).Return(nil)
+
+		_, err := srv.PrepareCredential(context.TODO(), newRequest("VerifiedEmployee"))
+		assert.ErrorContains(t, err, "decode claim data")
+	})
+}
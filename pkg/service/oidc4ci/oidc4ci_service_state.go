@@ -41,6 +41,11 @@ func (s *Service) validateStateTransition(
 		return nil
 	}
 
+	if newState == TransactionStateCancelled &&
+		oldState >= TransactionStateIssuanceInitiated && oldState < TransactionStateCredentialsIssued {
+		return nil // any open transaction can be cancelled by the issuer
+	}
+
 	return resterr.NewCustomError(resterr.InvalidStateTransition,
 		fmt.Errorf("unexpected transition from %v to %v", oldState, newState))
 }
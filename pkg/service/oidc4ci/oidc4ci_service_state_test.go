@@ -41,6 +41,18 @@ func TestValidateTransition(t *testing.T) {
 			from: TransactionStateIssuerOIDCAuthorizationDone,
 			to:   TransactionStateCredentialsIssued,
 		},
+		{
+			from: TransactionStateIssuanceInitiated,
+			to:   TransactionStateCancelled,
+		},
+		{
+			from: TransactionStatePreAuthCodeValidated,
+			to:   TransactionStateCancelled,
+		},
+		{
+			from: TransactionStateIssuerOIDCAuthorizationDone,
+			to:   TransactionStateCancelled,
+		},
 	}
 
 	for _, tCase := range testCases {
@@ -56,4 +68,8 @@ func TestInvalidTransition(t *testing.T) {
 
 	assert.ErrorContains(t, s.validateStateTransition(TransactionStateUnknown, TransactionStateIssuanceInitiated),
 		"unexpected transition from 0 to 1")
+	assert.ErrorContains(t, s.validateStateTransition(TransactionStateCredentialsIssued, TransactionStateCancelled),
+		"unexpected transition from 5 to 6")
+	assert.ErrorContains(t, s.validateStateTransition(TransactionStateCancelled, TransactionStatePreAuthCodeValidated),
+		"unexpected transition from 6 to 2")
 }
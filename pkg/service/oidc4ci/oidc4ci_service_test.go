@@ -23,6 +23,7 @@ import (
 	"github.com/trustbloc/vc-go/verifiable"
 
 	"github.com/trustbloc/vcs/pkg/dataprotect"
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
 	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
 	"github.com/trustbloc/vcs/pkg/event/spi"
 	profileapi "github.com/trustbloc/vcs/pkg/profile"
@@ -4364,6 +4365,211 @@ func TestService_PrepareCredential(t *testing.T) {
 				assert.NotNil(t, resp)
 			},
 		},
+		{
+			name: "Success LDP with claims mapping",
+			setup: func(m *mocks) {
+				m.transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(&oidc4ci.Transaction{
+					ID: "txID",
+					TransactionData: oidc4ci.TransactionData{
+						IssuerToken: "issuer-access-token",
+						CredentialConfiguration: []*oidc4ci.TxCredentialConfiguration{
+							{
+								ID:                   uuid.NewString(),
+								OIDCCredentialFormat: vcsverifiable.LdpVC,
+								CredentialTemplate: &profileapi.CredentialTemplate{
+									ID:   "VerifiedEmployee",
+									Type: "VerifiedEmployee",
+									ClaimsMapping: map[string]*profileapi.ClaimMapping{
+										"displayName": {
+											Transform: profileapi.ClaimTransformConcat,
+											Paths:     []string{"$.given_name", "$.family_name"},
+										},
+										"jobTitle": {Path: "$.job.title", Default: "Worker"},
+									},
+								},
+								CredentialConfigurationID: "VerifiedEmployeeIdentifier",
+								ClaimsMetadata: map[string]*profileapi.Claim{
+									"displayName": {Mandatory: true},
+								},
+							},
+						},
+					},
+				}, nil)
+
+				claimData := `{"family_name":"Smith","given_name":"Pat"}`
+				m.ackService.EXPECT().CreateAck(gomock.Any(), gomock.Any()).
+					Return(lo.ToPtr("ackID"), nil)
+
+				httpClient = &http.Client{
+					Transport: &mockTransport{
+						func(req *http.Request) (*http.Response, error) {
+							return &http.Response{
+								StatusCode: http.StatusOK,
+								Body:       io.NopCloser(bytes.NewBuffer([]byte(claimData))),
+							}, nil
+						},
+					},
+				}
+
+				m.transactionStore.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				m.eventService.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).Return(nil)
+
+				req = &oidc4ci.PrepareCredential{
+					TxID: "txID",
+					CredentialRequests: []*oidc4ci.PrepareCredentialRequest{
+						{
+							AudienceClaim:    "/oidc/idp//",
+							CredentialFormat: vcsverifiable.LdpVC,
+							CredentialTypes:  []string{"VerifiedEmployee"},
+						},
+					},
+				}
+			},
+			check: func(t *testing.T, resp *oidc4ci.PrepareCredentialResult, err error) {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+
+				subject := resp.Credentials[0].Credential.Contents().Subject
+				assert.Len(t, subject, 1)
+				assert.Equal(t, verifiable.CustomFields{
+					"displayName": "Pat Smith",
+					"jobTitle":    "Worker",
+				}, subject[0].CustomFields)
+			},
+		},
+		{
+			name: "Claims mapping of claim endpoint data fails",
+			setup: func(m *mocks) {
+				m.transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(&oidc4ci.Transaction{
+					TransactionData: oidc4ci.TransactionData{
+						CredentialConfiguration: []*oidc4ci.TxCredentialConfiguration{
+							{
+								ID:                   uuid.NewString(),
+								OIDCCredentialFormat: vcsverifiable.JwtVCJsonLD,
+								CredentialTemplate: &profileapi.CredentialTemplate{
+									ID:   "VerifiedEmployee",
+									Type: "VerifiedEmployee",
+									ClaimsMapping: map[string]*profileapi.ClaimMapping{
+										"hireDate": {Path: "$.hired", Transform: profileapi.ClaimTransformDate},
+									},
+								},
+								CredentialConfigurationID: "VerifiedEmployeeIdentifier",
+							},
+						},
+					},
+				}, nil)
+
+				httpClient = &http.Client{
+					Transport: &mockTransport{
+						func(req *http.Request) (*http.Response, error) {
+							return &http.Response{
+								StatusCode: http.StatusOK,
+								Body:       io.NopCloser(bytes.NewBuffer([]byte(`{"hired":"yesterday"}`))),
+							}, nil
+						},
+					},
+				}
+
+				m.eventService.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).Return(nil)
+
+				req = &oidc4ci.PrepareCredential{
+					TxID: "txID",
+					CredentialRequests: []*oidc4ci.PrepareCredentialRequest{
+						{
+							AudienceClaim:    "/oidc/idp//",
+							CredentialFormat: vcsverifiable.JwtVCJsonLD,
+							CredentialTypes:  []string{"VerifiedEmployee"},
+						},
+					},
+				}
+			},
+			check: func(t *testing.T, resp *oidc4ci.PrepareCredentialResult, err error) {
+				assert.ErrorContains(t, err, `map claims: claim mapping "hireDate": value "yesterday" is not a date`)
+				assert.Nil(t, resp)
+			},
+		},
+		{
+			name: "Success LDP data model v2.0",
+			setup: func(m *mocks) {
+				m.transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(&oidc4ci.Transaction{
+					ID: "txID",
+					TransactionData: oidc4ci.TransactionData{
+						IssuerToken: "issuer-access-token",
+						CredentialConfiguration: []*oidc4ci.TxCredentialConfiguration{
+							{
+								ID:                   uuid.NewString(),
+								OIDCCredentialFormat: vcsverifiable.LdpVC,
+								CredentialTemplate: &profileapi.CredentialTemplate{
+									ID:   "VerifiedEmployee",
+									Type: "VerifiedEmployee",
+								},
+								CredentialExpiresAt:       lo.ToPtr(time.Now().UTC().Add(55 * time.Hour)),
+								CredentialConfigurationID: "VerifiedEmployeeIdentifier",
+								DataModelVersion:          vcsverifiable.DataModelV2,
+							},
+						},
+					},
+				}, nil)
+
+				claimData := `{"surname":"Smith","givenName":"Pat","jobTitle":"Worker"}`
+				m.ackService.EXPECT().CreateAck(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, ack *oidc4ci.Ack) (*string, error) {
+						return lo.ToPtr("ackID"), nil
+					})
+
+				httpClient = &http.Client{
+					Transport: &mockTransport{
+						func(req *http.Request) (*http.Response, error) {
+							assert.Contains(t, req.Header.Get("Authorization"), "Bearer issuer-access-token")
+							return &http.Response{
+								StatusCode: http.StatusOK,
+								Body:       io.NopCloser(bytes.NewBuffer([]byte(claimData))),
+							}, nil
+						},
+					},
+				}
+
+				m.transactionStore.EXPECT().Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, tx *oidc4ci.Transaction) error {
+						assert.Equal(t, oidc4ci.TransactionStateCredentialsIssued, tx.State)
+						return nil
+					})
+
+				m.eventService.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, topic string, messages ...*spi.Event) error {
+						assert.Len(t, messages, 1)
+						assert.Equal(t, messages[0].Type, spi.IssuerOIDCInteractionSucceeded)
+
+						return nil
+					})
+
+				req = &oidc4ci.PrepareCredential{
+					TxID: "txID",
+					CredentialRequests: []*oidc4ci.PrepareCredentialRequest{
+						{
+							AudienceClaim:    "/oidc/idp//",
+							CredentialFormat: vcsverifiable.LdpVC,
+							CredentialTypes:  []string{"VerifiedEmployee"},
+						},
+					},
+				}
+			},
+			check: func(t *testing.T, resp *oidc4ci.PrepareCredentialResult, err error) {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+
+				cred := resp.Credentials[0].Credential
+				assert.Equal(t, vcutil.DefVCContextV2, cred.Contents().Context[0])
+				assert.Nil(t, cred.Contents().Issued)
+				assert.Nil(t, cred.Contents().Expired)
+
+				validFrom, validUntil, err := vcutil.ValidityPeriod(cred)
+				assert.NoError(t, err)
+				assert.NotNil(t, validFrom)
+				assert.Equal(t, time.Now().UTC().Add(55*time.Hour).Truncate(time.Hour*24),
+					validUntil.Time.Truncate(time.Hour*24))
+			},
+		},
 		{
 			name: "Success LDP with name and description",
 			setup: func(m *mocks) {
@@ -4992,6 +5198,25 @@ func TestService_PrepareCredential(t *testing.T) {
 				assert.Nil(t, resp)
 			},
 		},
+		{
+			name: "Transaction is cancelled",
+			setup: func(m *mocks) {
+				m.transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(&oidc4ci.Transaction{
+					ID: "txID",
+					TransactionData: oidc4ci.TransactionData{
+						State: oidc4ci.TransactionStateCancelled,
+					},
+				}, nil)
+
+				req = &oidc4ci.PrepareCredential{
+					TxID: "txID",
+				}
+			},
+			check: func(t *testing.T, resp *oidc4ci.PrepareCredentialResult, err error) {
+				assert.ErrorContains(t, err, "unexpected transition from 6 to 5")
+				assert.Nil(t, resp)
+			},
+		},
 		{
 			name: "Fail to make request to claim endpoint",
 			setup: func(m *mocks) {
@@ -5206,6 +5431,65 @@ func TestService_PrepareCredential(t *testing.T) {
 				assert.Nil(t, resp)
 			},
 		},
+		{
+			name: "Claim data from claim endpoint violates claims metadata",
+			setup: func(m *mocks) {
+				m.transactionStore.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(&oidc4ci.Transaction{
+					TransactionData: oidc4ci.TransactionData{
+						CredentialConfiguration: []*oidc4ci.TxCredentialConfiguration{
+							{
+								ID:                   uuid.NewString(),
+								OIDCCredentialFormat: vcsverifiable.JwtVCJsonLD,
+								CredentialTemplate: &profileapi.CredentialTemplate{
+									ID:   "VerifiedEmployee",
+									Type: "VerifiedEmployee",
+								},
+								CredentialConfigurationID: "VerifiedEmployeeIdentifier",
+								ClaimsMetadata: map[string]*profileapi.Claim{
+									"surname":  {Mandatory: true},
+									"jobTitle": {Pattern: "^[A-Z]"},
+								},
+							},
+						},
+					},
+				}, nil)
+
+				httpClient = &http.Client{
+					Transport: &mockTransport{
+						func(req *http.Request) (*http.Response, error) {
+							return &http.Response{
+								StatusCode: http.StatusOK,
+								Body:       io.NopCloser(bytes.NewBuffer([]byte(`{"givenName":"Pat","jobTitle":"worker"}`))),
+							}, nil
+						},
+					},
+				}
+
+				m.eventService.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, topic string, messages ...*spi.Event) error {
+						assert.Len(t, messages, 1)
+						assert.Equal(t, messages[0].Type, spi.IssuerOIDCInteractionFailed)
+
+						return nil
+					})
+
+				req = &oidc4ci.PrepareCredential{
+					TxID: "txID",
+					CredentialRequests: []*oidc4ci.PrepareCredentialRequest{
+						{
+							AudienceClaim:    "/oidc/idp//",
+							CredentialFormat: vcsverifiable.JwtVCJsonLD,
+							CredentialTypes:  []string{"VerifiedEmployee"},
+						},
+					},
+				}
+			},
+			check: func(t *testing.T, resp *oidc4ci.PrepareCredentialResult, err error) {
+				assert.ErrorContains(t, err, `claim "jobTitle": value does not match pattern "^[A-Z]"`)
+				assert.ErrorContains(t, err, `claim "surname": mandatory claim is missing`)
+				assert.Nil(t, resp)
+			},
+		},
 		{
 			name: "Invalid audience claim",
 			setup: func(m *mocks) {
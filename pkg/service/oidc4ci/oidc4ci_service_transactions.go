@@ -0,0 +1,108 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package oidc4ci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/trustbloc/logutil-go/pkg/log"
+
+	"github.com/trustbloc/vcs/pkg/event/spi"
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/restapi/resterr"
+)
+
+// GetTransaction returns the issuance transaction of the issuer profile.
+func (s *Service) GetTransaction(
+	ctx context.Context,
+	profile *profileapi.Issuer,
+	txID TxID,
+) (*Transaction, error) {
+	tx, err := s.store.Get(ctx, txID)
+	if err != nil {
+		if errors.Is(err, resterr.ErrDataNotFound) {
+			return nil, resterr.NewCustomError(resterr.OIDCTxNotFound, fmt.Errorf("get tx: %w", err))
+		}
+
+		return nil, resterr.NewSystemError(resterr.TransactionStoreComponent, "Get", err)
+	}
+
+	// Transactions of other profiles are not disclosed.
+	if tx.ProfileID != profile.ID || tx.ProfileVersion != profile.Version {
+		return nil, resterr.NewCustomError(resterr.OIDCTxNotFound,
+			fmt.Errorf("get tx: %w", resterr.ErrDataNotFound))
+	}
+
+	return tx, nil
+}
+
+// ListTransactions returns issuance transactions of the issuer profile that are neither completed nor cancelled.
+func (s *Service) ListTransactions(
+	ctx context.Context,
+	profile *profileapi.Issuer,
+) ([]*Transaction, error) {
+	txs, err := s.store.FindOpenByProfile(ctx, profile.ID, profile.Version)
+	if err != nil {
+		return nil, resterr.NewSystemError(resterr.TransactionStoreComponent, "FindOpenByProfile", err)
+	}
+
+	return txs, nil
+}
+
+// CancelTransaction cancels the open issuance transaction of the issuer profile. Pre-authorized code, user pin
+// and issuer tokens of the transaction are invalidated, claim data and authorization state are deleted,
+// so the transaction can not be used to issue credentials anymore.
+func (s *Service) CancelTransaction(
+	ctx context.Context,
+	profile *profileapi.Issuer,
+	txID TxID,
+) (*Transaction, error) {
+	tx, err := s.GetTransaction(ctx, profile, txID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.validateStateTransition(tx.State, TransactionStateCancelled); err != nil {
+		return nil, err
+	}
+
+	for _, credentialConfiguration := range tx.CredentialConfiguration {
+		if credentialConfiguration.ClaimDataID == "" {
+			continue
+		}
+
+		if err = s.claimDataStore.Delete(ctx, credentialConfiguration.ClaimDataID); err != nil {
+			return nil, resterr.NewSystemError(resterr.ClaimDataStoreComponent, "Delete", err)
+		}
+
+		credentialConfiguration.ClaimDataID = ""
+	}
+
+	if !tx.IsPreAuthFlow && tx.OpState != "" {
+		if err = s.authStateStore.DeleteAuthorizeState(ctx, tx.OpState); err != nil {
+			return nil, resterr.NewSystemError(resterr.TransactionStoreComponent, "DeleteAuthorizeState", err)
+		}
+	}
+
+	tx.State = TransactionStateCancelled
+	tx.PreAuthCode = ""
+	tx.UserPin = ""
+	tx.IssuerAuthCode = ""
+	tx.IssuerToken = ""
+
+	if err = s.store.Update(ctx, tx); err != nil {
+		return nil, resterr.NewSystemError(resterr.TransactionStoreComponent, "Update", err)
+	}
+
+	if errSendEvent := s.sendTransactionEvent(ctx, tx, spi.IssuerOIDCInteractionCancelled); errSendEvent != nil {
+		logger.Warnc(ctx, "Failed to send OIDC issuer event. Ignoring..", log.WithError(errSendEvent))
+	}
+
+	return tx, nil
+}
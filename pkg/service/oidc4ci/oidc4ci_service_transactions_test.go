@@ -0,0 +1,267 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package oidc4ci_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trustbloc/vcs/pkg/event/spi"
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/restapi/resterr"
+	"github.com/trustbloc/vcs/pkg/service/oidc4ci"
+)
+
+func TestGetTransaction(t *testing.T) {
+	profile := &profileapi.Issuer{ID: "profileID", Version: "v1.0"}
+
+	store := NewMockTransactionStore(gomock.NewController(t))
+
+	srv, err := oidc4ci.NewService(&oidc4ci.Config{
+		TransactionStore: store,
+	})
+	assert.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		store.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(&oidc4ci.Transaction{
+			ID: "txID",
+			TransactionData: oidc4ci.TransactionData{
+				ProfileID:      "profileID",
+				ProfileVersion: "v1.0",
+			},
+		}, nil)
+
+		tx, getErr := srv.GetTransaction(context.TODO(), profile, "txID")
+		assert.NoError(t, getErr)
+		assert.Equal(t, oidc4ci.TxID("txID"), tx.ID)
+	})
+
+	t.Run("transaction of other profile", func(t *testing.T) {
+		store.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(&oidc4ci.Transaction{
+			ID: "txID",
+			TransactionData: oidc4ci.TransactionData{
+				ProfileID:      "profileID",
+				ProfileVersion: "v2.0",
+			},
+		}, nil)
+
+		tx, getErr := srv.GetTransaction(context.TODO(), profile, "txID")
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, getErr, resterr.ErrDataNotFound)
+
+		var customErr *resterr.CustomError
+		assert.ErrorAs(t, getErr, &customErr)
+		assert.Equal(t, resterr.OIDCTxNotFound, customErr.Code)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		store.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(nil, resterr.ErrDataNotFound)
+
+		tx, getErr := srv.GetTransaction(context.TODO(), profile, "txID")
+		assert.Nil(t, tx)
+		assert.ErrorContains(t, getErr, "oidc-tx-not-found")
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		store.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(nil, errors.New("get error"))
+
+		tx, getErr := srv.GetTransaction(context.TODO(), profile, "txID")
+		assert.Nil(t, tx)
+		assert.ErrorContains(t, getErr, "get error")
+	})
+}
+
+func TestListTransactions(t *testing.T) {
+	profile := &profileapi.Issuer{ID: "profileID", Version: "v1.0"}
+
+	store := NewMockTransactionStore(gomock.NewController(t))
+
+	srv, err := oidc4ci.NewService(&oidc4ci.Config{
+		TransactionStore: store,
+	})
+	assert.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		store.EXPECT().FindOpenByProfile(gomock.Any(), "profileID", "v1.0").
+			Return([]*oidc4ci.Transaction{{ID: "txID1"}, {ID: "txID2"}}, nil)
+
+		txs, listErr := srv.ListTransactions(context.TODO(), profile)
+		assert.NoError(t, listErr)
+		assert.Len(t, txs, 2)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		store.EXPECT().FindOpenByProfile(gomock.Any(), "profileID", "v1.0").
+			Return(nil, errors.New("find error"))
+
+		txs, listErr := srv.ListTransactions(context.TODO(), profile)
+		assert.Nil(t, txs)
+		assert.ErrorContains(t, listErr, "find error")
+	})
+}
+
+func TestCancelTransaction(t *testing.T) {
+	profile := &profileapi.Issuer{ID: "profileID", Version: "v1.0"}
+
+	var (
+		store          *MockTransactionStore
+		claimDataStore *MockClaimDataStore
+		authStateStore *MockAuthStateStore
+		eventMock      *MockEventService
+	)
+
+	newService := func(t *testing.T) *oidc4ci.Service {
+		t.Helper()
+
+		ctrl := gomock.NewController(t)
+
+		store = NewMockTransactionStore(ctrl)
+		claimDataStore = NewMockClaimDataStore(ctrl)
+		authStateStore = NewMockAuthStateStore(ctrl)
+		eventMock = NewMockEventService(ctrl)
+
+		srv, err := oidc4ci.NewService(&oidc4ci.Config{
+			TransactionStore: store,
+			ClaimDataStore:   claimDataStore,
+			AuthStateStore:   authStateStore,
+			EventService:     eventMock,
+			EventTopic:       spi.IssuerEventTopic,
+		})
+		assert.NoError(t, err)
+
+		return srv
+	}
+
+	newTx := func(preAuth bool, state oidc4ci.TransactionState) *oidc4ci.Transaction {
+		return &oidc4ci.Transaction{
+			ID: "txID",
+			TransactionData: oidc4ci.TransactionData{
+				ProfileID:      "profileID",
+				ProfileVersion: "v1.0",
+				IsPreAuthFlow:  preAuth,
+				PreAuthCode:    "preAuthCode",
+				OpState:        "opState",
+				UserPin:        "1234",
+				IssuerToken:    "issuerToken",
+				State:          state,
+				CredentialConfiguration: []*oidc4ci.TxCredentialConfiguration{
+					{ID: "conf1", ClaimDataID: "claimDataID"},
+					{ID: "conf2"},
+				},
+			},
+		}
+	}
+
+	t.Run("success pre-auth flow", func(t *testing.T) {
+		srv := newService(t)
+
+		store.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).
+			Return(newTx(true, oidc4ci.TransactionStatePreAuthCodeValidated), nil)
+		claimDataStore.EXPECT().Delete(gomock.Any(), "claimDataID").Return(nil)
+		store.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, tx *oidc4ci.Transaction) error {
+				assert.Equal(t, oidc4ci.TransactionStateCancelled, tx.State)
+				assert.Empty(t, tx.PreAuthCode)
+				assert.Empty(t, tx.UserPin)
+				assert.Empty(t, tx.IssuerToken)
+				assert.Empty(t, tx.CredentialConfiguration[0].ClaimDataID)
+
+				return nil
+			})
+		eventMock.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, topic string, messages ...*spi.Event) error {
+				assert.Len(t, messages, 1)
+				assert.Equal(t, spi.IssuerOIDCInteractionCancelled, messages[0].Type)
+				assert.Equal(t, "txID", messages[0].TransactionID)
+
+				return nil
+			})
+
+		tx, err := srv.CancelTransaction(context.TODO(), profile, "txID")
+		assert.NoError(t, err)
+		assert.Equal(t, oidc4ci.TransactionStateCancelled, tx.State)
+	})
+
+	t.Run("success auth flow", func(t *testing.T) {
+		srv := newService(t)
+
+		store.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).
+			Return(newTx(false, oidc4ci.TransactionStateAwaitingIssuerOIDCAuthorization), nil)
+		claimDataStore.EXPECT().Delete(gomock.Any(), "claimDataID").Return(nil)
+		authStateStore.EXPECT().DeleteAuthorizeState(gomock.Any(), "opState").Return(nil)
+		store.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		eventMock.EXPECT().Publish(gomock.Any(), spi.IssuerEventTopic, gomock.Any()).
+			Return(errors.New("publish error"))
+
+		tx, err := srv.CancelTransaction(context.TODO(), profile, "txID")
+		assert.NoError(t, err)
+		assert.Equal(t, oidc4ci.TransactionStateCancelled, tx.State)
+	})
+
+	t.Run("already issued", func(t *testing.T) {
+		srv := newService(t)
+
+		store.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).
+			Return(newTx(true, oidc4ci.TransactionStateCredentialsIssued), nil)
+
+		tx, err := srv.CancelTransaction(context.TODO(), profile, "txID")
+		assert.Nil(t, tx)
+		assert.ErrorContains(t, err, "unexpected transition from 5 to 6")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		srv := newService(t)
+
+		store.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).Return(nil, resterr.ErrDataNotFound)
+
+		tx, err := srv.CancelTransaction(context.TODO(), profile, "txID")
+		assert.Nil(t, tx)
+		assert.ErrorContains(t, err, "oidc-tx-not-found")
+	})
+
+	t.Run("delete claim data error", func(t *testing.T) {
+		srv := newService(t)
+
+		store.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).
+			Return(newTx(true, oidc4ci.TransactionStateIssuanceInitiated), nil)
+		claimDataStore.EXPECT().Delete(gomock.Any(), "claimDataID").Return(errors.New("delete error"))
+
+		tx, err := srv.CancelTransaction(context.TODO(), profile, "txID")
+		assert.Nil(t, tx)
+		assert.ErrorContains(t, err, "delete error")
+	})
+
+	t.Run("delete auth state error", func(t *testing.T) {
+		srv := newService(t)
+
+		store.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).
+			Return(newTx(false, oidc4ci.TransactionStateIssuanceInitiated), nil)
+		claimDataStore.EXPECT().Delete(gomock.Any(), "claimDataID").Return(nil)
+		authStateStore.EXPECT().DeleteAuthorizeState(gomock.Any(), "opState").Return(errors.New("delete error"))
+
+		tx, err := srv.CancelTransaction(context.TODO(), profile, "txID")
+		assert.Nil(t, tx)
+		assert.ErrorContains(t, err, "delete error")
+	})
+
+	t.Run("update error", func(t *testing.T) {
+		srv := newService(t)
+
+		store.EXPECT().Get(gomock.Any(), oidc4ci.TxID("txID")).
+			Return(newTx(true, oidc4ci.TransactionStateIssuanceInitiated), nil)
+		claimDataStore.EXPECT().Delete(gomock.Any(), "claimDataID").Return(nil)
+		store.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("update error"))
+
+		tx, err := srv.CancelTransaction(context.TODO(), profile, "txID")
+		assert.Nil(t, tx)
+		assert.ErrorContains(t, err, "update error")
+	})
+}
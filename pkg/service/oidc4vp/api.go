@@ -30,6 +30,16 @@ type AuthorizationResponseParsed struct {
 	CustomScopeClaims map[string]Claims
 	VPTokens          []*ProcessedVPToken
 	AttestationVP     string
+	// PresentationSubmission is the presentation submission of the authorization response.
+	PresentationSubmission map[string]interface{}
+}
+
+// AuthorizationResponseResult is a result of the authorization response processing.
+type AuthorizationResponseResult struct {
+	// RedirectURI is the URI the wallet redirects the user to, set for the same-device flow only.
+	RedirectURI string
+	// ResponseCode is a one-time code added to RedirectURI.
+	ResponseCode string
 }
 
 type ProcessedVPToken struct {
@@ -38,6 +48,8 @@ type ProcessedVPToken struct {
 	SignerDIDID   string
 	VpTokenFormat vcsverifiable.Format
 	Presentation  *verifiable.Presentation
+	// RawToken is the VP token as received from the wallet.
+	RawToken string
 }
 
 type CredentialMetadata struct {
@@ -52,6 +64,9 @@ type CredentialMetadata struct {
 	Name        interface{} `json:"name,omitempty"`
 	AwardedDate interface{} `json:"awardedDate,omitempty"`
 	Description interface{} `json:"description,omitempty"`
+
+	// Display is display metadata of the credential and its claims resolved for the requested locales.
+	Display *profileapi.LocalizedCredentialDisplay `json:"display,omitempty"`
 }
 
 type ServiceInterface interface {
@@ -60,11 +75,22 @@ type ServiceInterface interface {
 		presentationDefinition *presexch.PresentationDefinition,
 		purpose string,
 		customScopes []string,
+		responseRedirectURI string,
 		profile *profileapi.Verifier,
 	) (*InteractionInfo, error)
-	VerifyOIDCVerifiablePresentation(ctx context.Context, txID TxID, authResponse *AuthorizationResponseParsed) error
+	VerifyOIDCVerifiablePresentation(
+		ctx context.Context,
+		txID TxID,
+		authResponse *AuthorizationResponseParsed,
+	) (*AuthorizationResponseResult, error)
+	RequestObjectFetched(ctx context.Context, txID TxID) error
 	GetTx(ctx context.Context, id TxID) (*Transaction, error)
-	RetrieveClaims(ctx context.Context, tx *Transaction, profile *profileapi.Verifier) map[string]CredentialMetadata
+	RetrieveClaims(
+		ctx context.Context,
+		tx *Transaction,
+		profile *profileapi.Verifier,
+		locales []string,
+	) map[string]CredentialMetadata
 	DeleteClaims(ctx context.Context, receivedClaimsID string) error
 }
 
@@ -80,6 +106,9 @@ type EventPayload struct {
 	ErrorCode                string                    `json:"errorCode,omitempty"`
 	ErrorComponent           string                    `json:"errorComponent,omitempty"`
 	Credentials              []*CredentialEventPayload `json:"credentials,omitempty"`
+	// VerificationReceipt is the signed verification receipt in JWT format. It is attached to the interaction
+	// succeeded event if enabled in the verifier profile.
+	VerificationReceipt string `json:"verificationReceipt,omitempty"`
 }
 
 type Filter struct {
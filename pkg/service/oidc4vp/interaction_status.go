@@ -0,0 +1,151 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+//go:generate mockgen -destination interaction_status_mocks_test.go -self_package mocks -package oidc4vp_test -source=interaction_status.go -mock_names interactionStatusStore=MockInteractionStatusStore
+
+package oidc4vp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/trustbloc/logutil-go/pkg/log"
+
+	"github.com/trustbloc/vcs/internal/logfields"
+	"github.com/trustbloc/vcs/pkg/event/spi"
+)
+
+// InteractionState is a state of the OIDC4VP interaction.
+type InteractionState string
+
+const (
+	// InteractionStateInitiated means that the authorization request was created.
+	InteractionStateInitiated InteractionState = "initiated"
+	// InteractionStateRequestObjectFetched means that the wallet fetched the request object.
+	InteractionStateRequestObjectFetched InteractionState = "request-object-fetched"
+	// InteractionStatePresentationReceived means that the wallet submitted the authorization response.
+	InteractionStatePresentationReceived InteractionState = "presentation-received"
+	// InteractionStateSucceeded means that the presentation was verified and claims are available.
+	InteractionStateSucceeded InteractionState = "succeeded"
+	// InteractionStateFailed means that the interaction failed.
+	InteractionStateFailed InteractionState = "failed"
+)
+
+var interactionStates = map[spi.EventType]InteractionState{
+	spi.VerifierOIDCInteractionInitiated:            InteractionStateInitiated,
+	spi.VerifierOIDCInteractionRequestObjectFetched: InteractionStateRequestObjectFetched,
+	spi.VerifierOIDCInteractionQRScanned:            InteractionStatePresentationReceived,
+	spi.VerifierOIDCInteractionSucceeded:            InteractionStateSucceeded,
+	spi.VerifierOIDCInteractionFailed:               InteractionStateFailed,
+}
+
+// InteractionStatus is a status of the OIDC4VP interaction.
+type InteractionStatus struct {
+	State          InteractionState `json:"state"`
+	ErrorCode      string           `json:"errorCode,omitempty"`
+	ErrorComponent string           `json:"errorComponent,omitempty"`
+	Error          string           `json:"error,omitempty"`
+	UpdatedAt      time.Time        `json:"updatedAt"`
+}
+
+// IsFinal returns true if the interaction is either succeeded or failed.
+func (s *InteractionStatus) IsFinal() bool {
+	return s != nil && (s.State == InteractionStateSucceeded || s.State == InteractionStateFailed)
+}
+
+// StatusStreamToken authorizes subscription to status changes of the single OIDC4VP interaction.
+// Only the hash of the token is stored in ID.
+type StatusStreamToken struct {
+	ID       string
+	TxID     TxID
+	ExpireAt time.Time
+}
+
+type interactionStatusStore interface {
+	// UpdateStatus sets status of the interaction unless the current status is final,
+	// in which case ErrInteractionFinished is returned.
+	UpdateStatus(txID TxID, status *InteractionStatus) error
+}
+
+// InteractionStatusHandler updates status of the interactions from the verifier events.
+type InteractionStatusHandler struct {
+	store interactionStatusStore
+}
+
+// NewInteractionStatusHandler returns a new InteractionStatusHandler.
+func NewInteractionStatusHandler(store interactionStatusStore) *InteractionStatusHandler {
+	return &InteractionStatusHandler{
+		store: store,
+	}
+}
+
+// HandleEvent updates status of the interaction the event belongs to. Events of other types are ignored.
+// Status of the finished interaction is not changed. The check is done by the store in the same operation
+// as the update, as events of the interaction can be handled concurrently and out of order.
+func (h *InteractionStatusHandler) HandleEvent(ctx context.Context, event *spi.Event) error {
+	state, ok := interactionStates[event.Type]
+	if !ok || event.TransactionID == "" {
+		return nil
+	}
+
+	status := &InteractionStatus{
+		State:     state,
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	if event.Time != nil {
+		status.UpdatedAt = event.Time.Time
+	}
+
+	if state == InteractionStateFailed {
+		if err := setErrorDetails(status, event); err != nil {
+			return err
+		}
+	}
+
+	if err := h.store.UpdateStatus(TxID(event.TransactionID), status); err != nil {
+		if errors.Is(err, ErrDataNotFound) {
+			return nil
+		}
+
+		if errors.Is(err, ErrInteractionFinished) {
+			logger.Debugc(ctx, "interaction is finished, status is not updated",
+				log.WithTxID(event.TransactionID), logfields.WithEvent(event))
+
+			return nil
+		}
+
+		return fmt.Errorf("update tx status: %w", err)
+	}
+
+	return nil
+}
+
+func setErrorDetails(status *InteractionStatus, event *spi.Event) error {
+	if event.Data == nil {
+		return nil
+	}
+
+	b, err := json.Marshal(event.Data)
+	if err != nil {
+		return fmt.Errorf("marshal event data: %w", err)
+	}
+
+	var payload EventPayload
+
+	if err = json.Unmarshal(b, &payload); err != nil {
+		return fmt.Errorf("unmarshal event payload: %w", err)
+	}
+
+	status.Error = payload.Error
+	status.ErrorCode = payload.ErrorCode
+	status.ErrorComponent = payload.ErrorComponent
+
+	return nil
+}
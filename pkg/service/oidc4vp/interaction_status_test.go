@@ -0,0 +1,125 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package oidc4vp_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/vcs/pkg/event/spi"
+	"github.com/trustbloc/vcs/pkg/restapi/resterr"
+	"github.com/trustbloc/vcs/pkg/service/oidc4vp"
+)
+
+func TestInteractionStatusHandler_HandleEvent(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		tests := []struct {
+			eventType spi.EventType
+			state     oidc4vp.InteractionState
+		}{
+			{eventType: spi.VerifierOIDCInteractionInitiated, state: oidc4vp.InteractionStateInitiated},
+			{
+				eventType: spi.VerifierOIDCInteractionRequestObjectFetched,
+				state:     oidc4vp.InteractionStateRequestObjectFetched,
+			},
+			{eventType: spi.VerifierOIDCInteractionQRScanned, state: oidc4vp.InteractionStatePresentationReceived},
+			{eventType: spi.VerifierOIDCInteractionSucceeded, state: oidc4vp.InteractionStateSucceeded},
+		}
+
+		for _, tt := range tests {
+			t.Run(string(tt.eventType), func(t *testing.T) {
+				store := NewMockInteractionStatusStore(gomock.NewController(t))
+				store.EXPECT().UpdateStatus(oidc4vp.TxID("txID"), gomock.Any()).DoAndReturn(
+					func(_ oidc4vp.TxID, status *oidc4vp.InteractionStatus) error {
+						require.Equal(t, tt.state, status.State)
+						require.False(t, status.UpdatedAt.IsZero())
+						require.Empty(t, status.Error)
+
+						return nil
+					})
+
+				event, err := oidc4vp.CreateEvent(tt.eventType, "txID", &oidc4vp.EventPayload{})
+				require.NoError(t, err)
+
+				require.NoError(t, oidc4vp.NewInteractionStatusHandler(store).HandleEvent(context.Background(), event))
+			})
+		}
+	})
+
+	t.Run("Failed event with error details", func(t *testing.T) {
+		store := NewMockInteractionStatusStore(gomock.NewController(t))
+		store.EXPECT().UpdateStatus(oidc4vp.TxID("txID"), gomock.Any()).DoAndReturn(
+			func(_ oidc4vp.TxID, status *oidc4vp.InteractionStatus) error {
+				require.Equal(t, oidc4vp.InteractionStateFailed, status.State)
+				require.Equal(t, "invalid-value", status.ErrorCode)
+				require.Equal(t, "verifier.oidc4vp-service", status.ErrorComponent)
+				require.Equal(t, "some error", status.Error)
+
+				return nil
+			})
+
+		event, err := oidc4vp.CreateEvent(spi.VerifierOIDCInteractionFailed, "txID", &oidc4vp.EventPayload{
+			Error:          "some error",
+			ErrorCode:      string(resterr.InvalidValue),
+			ErrorComponent: string(resterr.VerifierOIDC4vpSvcComponent),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, oidc4vp.NewInteractionStatusHandler(store).HandleEvent(context.Background(), event))
+	})
+
+	t.Run("Final status is not changed", func(t *testing.T) {
+		store := NewMockInteractionStatusStore(gomock.NewController(t))
+		store.EXPECT().UpdateStatus(oidc4vp.TxID("txID"), gomock.Any()).Return(oidc4vp.ErrInteractionFinished)
+
+		// Event of the earlier step is delivered after the interaction is finished.
+		event, err := oidc4vp.CreateEvent(spi.VerifierOIDCInteractionQRScanned, "txID", &oidc4vp.EventPayload{})
+		require.NoError(t, err)
+
+		require.NoError(t, oidc4vp.NewInteractionStatusHandler(store).HandleEvent(context.Background(), event))
+	})
+
+	t.Run("Other events are ignored", func(t *testing.T) {
+		store := NewMockInteractionStatusStore(gomock.NewController(t))
+		store.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Times(0)
+
+		event, err := oidc4vp.CreateEvent(spi.VerifierOIDCInteractionClaimsRetrieved, "txID", &oidc4vp.EventPayload{})
+		require.NoError(t, err)
+
+		require.NoError(t, oidc4vp.NewInteractionStatusHandler(store).HandleEvent(context.Background(), event))
+
+		event, err = oidc4vp.CreateEvent(spi.VerifierOIDCInteractionInitiated, "", &oidc4vp.EventPayload{})
+		require.NoError(t, err)
+
+		require.NoError(t, oidc4vp.NewInteractionStatusHandler(store).HandleEvent(context.Background(), event))
+	})
+
+	t.Run("Tx not found", func(t *testing.T) {
+		store := NewMockInteractionStatusStore(gomock.NewController(t))
+		store.EXPECT().UpdateStatus(oidc4vp.TxID("txID"), gomock.Any()).Return(oidc4vp.ErrDataNotFound)
+
+		event, err := oidc4vp.CreateEvent(spi.VerifierOIDCInteractionInitiated, "txID", &oidc4vp.EventPayload{})
+		require.NoError(t, err)
+
+		require.NoError(t, oidc4vp.NewInteractionStatusHandler(store).HandleEvent(context.Background(), event))
+	})
+
+	t.Run("Update status error", func(t *testing.T) {
+		store := NewMockInteractionStatusStore(gomock.NewController(t))
+		store.EXPECT().UpdateStatus(oidc4vp.TxID("txID"), gomock.Any()).Return(errors.New("update error"))
+
+		event, err := oidc4vp.CreateEvent(spi.VerifierOIDCInteractionInitiated, "txID", &oidc4vp.EventPayload{})
+		require.NoError(t, err)
+
+		err = oidc4vp.NewInteractionStatusHandler(store).HandleEvent(context.Background(), event)
+		require.ErrorContains(t, err, "update tx status: update error")
+	})
+}
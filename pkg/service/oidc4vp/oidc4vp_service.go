@@ -4,7 +4,7 @@ Copyright SecureKey Technologies Inc. All Rights Reserved.
 SPDX-License-Identifier: Apache-2.0
 */
 
-//go:generate mockgen -destination oidc4vp_service_mocks_test.go -self_package mocks -package oidc4vp_test -source=oidc4vp_service.go -mock_names transactionManager=MockTransactionManager,events=MockEvents,kmsRegistry=MockKMSRegistry,requestObjectPublicStore=MockRequestObjectPublicStore,profileService=MockProfileService,presentationVerifier=MockPresentationVerifier,trustRegistry=MockTrustRegistry
+//go:generate mockgen -destination oidc4vp_service_mocks_test.go -self_package mocks -package oidc4vp_test -source=oidc4vp_service.go -mock_names transactionManager=MockTransactionManager,receiptService=MockReceiptService,presentationArchive=MockPresentationArchive,events=MockEvents,kmsRegistry=MockKMSRegistry,requestObjectPublicStore=MockRequestObjectPublicStore,profileService=MockProfileService,presentationVerifier=MockPresentationVerifier,trustRegistry=MockTrustRegistry
 
 package oidc4vp
 
@@ -13,6 +13,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 	"sync"
 	"time"
@@ -25,6 +26,7 @@ import (
 	kmsapi "github.com/trustbloc/kms-go/spi/kms"
 	"github.com/trustbloc/logutil-go/pkg/log"
 	"github.com/trustbloc/vc-go/dataintegrity"
+	"github.com/trustbloc/vc-go/dataintegrity/models"
 	"github.com/trustbloc/vc-go/dataintegrity/suite/ecdsa2019"
 	"github.com/trustbloc/vc-go/jwt"
 	"github.com/trustbloc/vc-go/presexch"
@@ -36,13 +38,19 @@ import (
 	"github.com/trustbloc/vcs/internal/logfields"
 	"github.com/trustbloc/vcs/pkg/doc/vc"
 	"github.com/trustbloc/vcs/pkg/doc/vc/crypto"
+	"github.com/trustbloc/vcs/pkg/doc/vc/dataintegrity/bbsplus2023"
+	"github.com/trustbloc/vcs/pkg/doc/vc/dataintegrity/eddsa2022"
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
 	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
 	"github.com/trustbloc/vcs/pkg/event/spi"
 	vcskms "github.com/trustbloc/vcs/pkg/kms"
 	noopMetricsProvider "github.com/trustbloc/vcs/pkg/observability/metrics/noop"
 	profileapi "github.com/trustbloc/vcs/pkg/profile"
 	"github.com/trustbloc/vcs/pkg/restapi/resterr"
+	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
+	"github.com/trustbloc/vcs/pkg/service/presentationarchive"
 	"github.com/trustbloc/vcs/pkg/service/trustregistry"
+	"github.com/trustbloc/vcs/pkg/service/verificationreceipt"
 	"github.com/trustbloc/vcs/pkg/service/verifypresentation"
 )
 
@@ -51,6 +59,8 @@ var logger = log.New("oidc4vp-service")
 const (
 	vpSubmissionProperty = "presentation_submission"
 	customScopeProperty  = "_scope"
+
+	defaultAuthorizationRequestURL = "openid-vc://"
 )
 
 const (
@@ -61,6 +71,9 @@ const (
 
 var ErrDataNotFound = errors.New("data not found")
 
+// ErrInteractionFinished is returned by the store when status of the finished interaction is updated.
+var ErrInteractionFinished = errors.New("interaction is finished")
+
 type eventService interface {
 	Publish(ctx context.Context, topic string, messages ...*spi.Event) error
 }
@@ -71,10 +84,12 @@ type transactionManager interface {
 		profileID, profileVersion string,
 		profileTransactionDataTTL int32,
 		profileNonceStoreDataTTL int32,
-		customScopes []string) (*Transaction, string, error)
+		customScopes []string,
+		responseRedirectURI string) (*Transaction, string, error)
 	StoreReceivedClaims(
 		txID TxID,
 		claims *ReceivedClaims,
+		responseCode string,
 		profileTransactionDataTTL int32,
 		profileReceivedClaimsDataTTL int32,
 	) error
@@ -110,6 +125,19 @@ type trustRegistry interface {
 	trustregistry.ValidatePresentation
 }
 
+type receiptService interface {
+	Issue(ctx context.Context, profile *profileapi.Verifier, receipt *verificationreceipt.Receipt) (string, error)
+}
+
+type presentationArchive interface {
+	Archive(
+		ctx context.Context,
+		profile *profileapi.Verifier,
+		txID string,
+		presentation *presentationarchive.Presentation,
+	) error
+}
+
 type RequestObjectClaims struct {
 	VPToken VPToken `json:"vp_token"`
 }
@@ -146,6 +174,11 @@ type Config struct {
 	PresentationVerifier     presentationVerifier
 	VDR                      vdrapi.Registry
 	TrustRegistry            trustRegistry
+	ReceiptService           receiptService
+	PresentationArchive      presentationArchive
+	// ProofChecker checks proofs of credentials in the presentations. Defaults to the proof checker
+	// resolving keys with VDR.
+	ProofChecker verifiable.CombinedProofChecker
 
 	RedirectURL   string
 	TokenLifetime time.Duration
@@ -167,6 +200,9 @@ type Service struct {
 	presentationVerifier     presentationVerifier
 	vdr                      vdrapi.Registry
 	trustRegistry            trustRegistry
+	receiptService           receiptService
+	presentationArchive      presentationArchive
+	proofChecker             verifiable.CombinedProofChecker
 
 	redirectURL   string
 	tokenLifetime time.Duration
@@ -175,11 +211,23 @@ type Service struct {
 }
 
 type RequestObjectRegistration struct {
-	ClientName                  string           `json:"client_name"`
-	SubjectSyntaxTypesSupported []string         `json:"subject_syntax_types_supported"`
-	VPFormats                   *presexch.Format `json:"vp_formats"`
-	ClientPurpose               string           `json:"client_purpose"`
-	LogoURI                     string           `json:"logo_uri"`
+	ClientName                  string     `json:"client_name"`
+	SubjectSyntaxTypesSupported []string   `json:"subject_syntax_types_supported"`
+	VPFormats                   *VPFormats `json:"vp_formats"`
+	ClientPurpose               string     `json:"client_purpose"`
+	LogoURI                     string     `json:"logo_uri"`
+}
+
+// VPFormats extends presentation exchange formats with the Data Integrity credential format.
+type VPFormats struct {
+	*presexch.Format
+	DIVC *DataIntegrityFormat `json:"di_vc,omitempty"`
+}
+
+// DataIntegrityFormat contains proof types and cryptosuites of Data Integrity proofs.
+type DataIntegrityFormat struct {
+	ProofType   []string `json:"proof_type,omitempty"`
+	Cryptosuite []string `json:"cryptosuite,omitempty"`
 }
 
 func NewService(cfg *Config) *Service {
@@ -189,6 +237,11 @@ func NewService(cfg *Config) *Service {
 		metrics = &noopMetricsProvider.NoMetrics{}
 	}
 
+	proofChecker := cfg.ProofChecker
+	if proofChecker == nil {
+		proofChecker = defaults.NewDefaultProofChecker(vermethod.NewVDRResolver(cfg.VDR))
+	}
+
 	return &Service{
 		eventSvc:                 cfg.EventSvc,
 		eventTopic:               cfg.EventTopic,
@@ -202,6 +255,9 @@ func NewService(cfg *Config) *Service {
 		tokenLifetime:            cfg.TokenLifetime,
 		vdr:                      cfg.VDR,
 		trustRegistry:            cfg.TrustRegistry,
+		receiptService:           cfg.ReceiptService,
+		presentationArchive:      cfg.PresentationArchive,
+		proofChecker:             proofChecker,
 		metrics:                  metrics,
 	}
 }
@@ -262,6 +318,7 @@ func (s *Service) InitiateOidcInteraction(
 	presentationDefinition *presexch.PresentationDefinition,
 	purpose string,
 	customScopes []string,
+	responseRedirectURI string,
 	profile *profileapi.Verifier,
 ) (*InteractionInfo, error) {
 	logger.Debugc(ctx, "InitiateOidcInteraction begin")
@@ -278,6 +335,7 @@ func (s *Service) InitiateOidcInteraction(
 		profile.DataConfig.OIDC4VPTransactionDataTTL,
 		profile.DataConfig.OIDC4VPNonceStoreDataTTL,
 		customScopes,
+		responseRedirectURI,
 	)
 	if err != nil {
 		return nil, resterr.NewSystemError(resterr.VerifierTxnMgrComponent, "create-txn",
@@ -306,7 +364,12 @@ func (s *Service) InitiateOidcInteraction(
 
 	logger.Debugc(ctx, "InitiateOidcInteraction request object published")
 
-	authorizationRequest := "openid-vc://?request_uri=" + requestURI
+	var walletURL *profileapi.WalletURLConfig
+	if profile.OIDCConfig != nil {
+		walletURL = profile.OIDCConfig.WalletURL
+	}
+
+	authorizationRequest := walletURL.BuildURL(defaultAuthorizationRequestURL, "request_uri="+requestURI)
 
 	if errSendEvent := s.sendOIDCInteractionInitiatedEvent(ctx, tx, profile, authorizationRequest); errSendEvent != nil {
 		return nil, errSendEvent
@@ -403,7 +466,7 @@ func (s *Service) VerifyOIDCVerifiablePresentation(
 	ctx context.Context,
 	txID TxID,
 	authResponse *AuthorizationResponseParsed,
-) error {
+) (*AuthorizationResponseResult, error) {
 	logger.Debugc(ctx, "VerifyOIDCVerifiablePresentation begin")
 	startTime := time.Now()
 
@@ -413,19 +476,19 @@ func (s *Service) VerifyOIDCVerifiablePresentation(
 
 	if len(authResponse.VPTokens) == 0 {
 		// this should never happen
-		return resterr.NewValidationError(resterr.InvalidValue, "tokens",
+		return nil, resterr.NewValidationError(resterr.InvalidValue, "tokens",
 			fmt.Errorf("must have at least one token"))
 	}
 
 	// All tokens have same nonce
 	tx, validNonce, err := s.transactionManager.GetByOneTimeToken(authResponse.VPTokens[0].Nonce)
 	if err != nil {
-		return resterr.NewSystemError(resterr.VerifierTxnMgrComponent, "get-by-one-time-token",
+		return nil, resterr.NewSystemError(resterr.VerifierTxnMgrComponent, "get-by-one-time-token",
 			fmt.Errorf("get tx by nonce failed: %w", err))
 	}
 
 	if !validNonce || tx.ID != txID {
-		return resterr.NewValidationError(resterr.InvalidValue, "nonce",
+		return nil, resterr.NewValidationError(resterr.InvalidValue, "nonce",
 			fmt.Errorf("invalid nonce"))
 	}
 
@@ -439,7 +502,7 @@ func (s *Service) VerifyOIDCVerifiablePresentation(
 	})
 
 	if unexpectedClaimsAmount || noAdditionalClaimsSupplied {
-		return resterr.NewValidationError(resterr.InvalidValue, "_scope",
+		return nil, resterr.NewValidationError(resterr.InvalidValue, "_scope",
 			fmt.Errorf("invalid _scope"))
 	}
 
@@ -447,12 +510,12 @@ func (s *Service) VerifyOIDCVerifiablePresentation(
 
 	profile, err := s.profileService.GetProfile(tx.ProfileID, tx.ProfileVersion)
 	if err != nil {
-		return resterr.NewValidationError(resterr.ConditionNotMet, "profile",
+		return nil, resterr.NewValidationError(resterr.ConditionNotMet, "profile",
 			fmt.Errorf("inconsistent transaction state %w", err))
 	}
 
 	if errSendEvent := s.sendTxEvent(ctx, spi.VerifierOIDCInteractionQRScanned, tx, profile); errSendEvent != nil {
-		return errSendEvent
+		return nil, errSendEvent
 	}
 
 	logger.Debugc(ctx, "VerifyOIDCVerifiablePresentation profile fetched", logfields.WithProfileID(profile.ID))
@@ -465,53 +528,179 @@ func (s *Service) VerifyOIDCVerifiablePresentation(
 
 	logger.Debugc(ctx, fmt.Sprintf("VerifyOIDCVerifiablePresentation count of tokens is %v", len(authResponse.VPTokens)))
 
-	verifiedPresentations, err := s.verifyTokens(ctx, tx, profile, authResponse.VPTokens)
+	statusLists := &credentialstatus.StatusListRecorder{}
+
+	verifyCtx := ctx
+	if profile.VerificationReceipt != nil {
+		verifyCtx = credentialstatus.WithStatusListRecorder(ctx, statusLists)
+	}
+
+	verifiedPresentations, err := s.verifyTokens(verifyCtx, tx, profile, authResponse.VPTokens)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if policyErr := <-policyChan; policyErr != nil {
-		return policyErr
+		return nil, policyErr
 	}
 
 	receivedClaims, err := s.extractClaimData(ctx, tx, authResponse, profile, verifiedPresentations)
 	if err != nil {
 		s.sendFailedTransactionEvent(ctx, tx, profile, err)
 
-		return err
+		return nil, err
+	}
+
+	result, err := newAuthorizationResponseResult(tx)
+	if err != nil {
+		s.sendFailedTransactionEvent(ctx, tx, profile, err)
+
+		return nil, err
+	}
+
+	receipt, err := s.issueReceipt(ctx, tx, profile, authResponse.VPTokens, statusLists.Versions())
+	if err != nil {
+		s.sendFailedTransactionEvent(ctx, tx, profile, err)
+
+		return nil, err
+	}
+
+	if err = s.archivePresentation(ctx, tx, profile, authResponse); err != nil {
+		s.sendFailedTransactionEvent(ctx, tx, profile, err)
+
+		return nil, err
 	}
 
 	err = s.transactionManager.StoreReceivedClaims(
 		tx.ID,
 		receivedClaims,
+		result.ResponseCode,
 		profile.DataConfig.OIDC4VPTransactionDataTTL,
 		profile.DataConfig.OIDC4VPReceivedClaimsDataTTL,
 	)
 	if err != nil {
 		s.sendFailedTransactionEvent(ctx, tx, profile, err)
 
-		return resterr.NewSystemError(resterr.VerifierTxnMgrComponent, "store-received-claims",
+		return nil, resterr.NewSystemError(resterr.VerifierTxnMgrComponent, "store-received-claims",
 			fmt.Errorf("store received claims: %w", err))
 	}
 
 	logger.Debugc(ctx, "extractClaimData claims stored")
 
-	err = s.sendOIDCInteractionEvent(ctx, spi.VerifierOIDCInteractionSucceeded, tx, profile, receivedClaims)
+	var eventReceipt string
+	if profile.VerificationReceipt != nil && profile.VerificationReceipt.AttachToEvent {
+		eventReceipt = receipt
+	}
+
+	err = s.sendOIDCInteractionEvent(ctx, spi.VerifierOIDCInteractionSucceeded, tx, profile, receivedClaims,
+		eventReceipt)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	logger.Debugc(ctx, "VerifyOIDCVerifiablePresentation succeed")
+	return result, nil
+}
+
+// issueReceipt issues a signed receipt of the verified presentations. The receipt has the ID of the transaction.
+// Empty receipt is returned if verification receipts are not enabled for the profile.
+func (s *Service) issueReceipt(
+	ctx context.Context,
+	tx *Transaction,
+	profile *profileapi.Verifier,
+	tokens []*ProcessedVPToken,
+	statusLists []credentialstatus.StatusListVersion,
+) (string, error) {
+	if profile.VerificationReceipt == nil {
+		return "", nil
+	}
+
+	presentations := lo.Map(tokens, func(token *ProcessedVPToken, _ int) *verifiable.Presentation {
+		return token.Presentation
+	})
+
+	// Tokens are verified at this point, so all checks have passed.
+	receipt, err := verificationreceipt.NewPresentationReceipt(string(tx.ID), profile, presentations, nil,
+		statusLists)
+	if err != nil {
+		return "", resterr.NewSystemError(resterr.VerifierReceiptSvcComponent, "create-receipt", err)
+	}
+
+	if profile.Checks.Policy.IsEnabled() {
+		receipt.Checks = append(receipt.Checks, &verificationreceipt.CheckResult{Check: "policy", Passed: true})
+	}
+
+	signed, err := s.receiptService.Issue(ctx, profile, receipt)
+	if err != nil {
+		return "", resterr.NewSystemError(resterr.VerifierReceiptSvcComponent, "issue-receipt",
+			fmt.Errorf("issue verification receipt: %w", err))
+	}
+
+	return signed, nil
+}
+
+// archivePresentation archives raw VP tokens and the presentation submission of the authorization response,
+// if the presentation archive is enabled for the profile.
+func (s *Service) archivePresentation(
+	ctx context.Context,
+	tx *Transaction,
+	profile *profileapi.Verifier,
+	authResponse *AuthorizationResponseParsed,
+) error {
+	if profile.PresentationArchive == nil {
+		return nil
+	}
+
+	presentation := &presentationarchive.Presentation{
+		VPTokens: lo.Map(authResponse.VPTokens, func(token *ProcessedVPToken, _ int) string {
+			return token.RawToken
+		}),
+		PresentationSubmission: authResponse.PresentationSubmission,
+	}
+
+	if err := s.presentationArchive.Archive(ctx, profile, string(tx.ID), presentation); err != nil {
+		return resterr.NewSystemError(resterr.VerifierPresentationArchiveComponent, "archive-presentation",
+			fmt.Errorf("archive presentation: %w", err))
+	}
+
 	return nil
 }
 
+// newAuthorizationResponseResult generates a one-time response code and adds it to the response redirect URI
+// of the transaction. Result is empty if the transaction has no response redirect URI (cross-device flow).
+func newAuthorizationResponseResult(tx *Transaction) (*AuthorizationResponseResult, error) {
+	if tx.ResponseRedirectURI == "" {
+		return &AuthorizationResponseResult{}, nil
+	}
+
+	redirectURI, err := url.Parse(tx.ResponseRedirectURI)
+	if err != nil {
+		return nil, resterr.NewSystemError(resterr.VerifierOIDC4vpSvcComponent, "parse-response-redirect-uri",
+			fmt.Errorf("parse response redirect uri: %w", err))
+	}
+
+	responseCode, err := genResponseCode()
+	if err != nil {
+		return nil, resterr.NewSystemError(resterr.VerifierOIDC4vpSvcComponent, "generate-response-code", err)
+	}
+
+	query := redirectURI.Query()
+	query.Set("response_code", responseCode)
+	redirectURI.RawQuery = query.Encode()
+
+	return &AuthorizationResponseResult{
+		RedirectURI:  redirectURI.String(),
+		ResponseCode: responseCode,
+	}, nil
+}
+
 func (s *Service) checkPolicy(
 	ctx context.Context,
 	profile *profileapi.Verifier,
 	attestationVP string,
 	vpTokens []*ProcessedVPToken,
 ) error {
-	if profile.Checks.Policy.PolicyURL == "" {
+	if !profile.Checks.Policy.IsEnabled() {
 		return nil
 	}
 
@@ -523,14 +712,19 @@ func (s *Service) checkPolicy(
 		for _, credential := range token.Presentation.Credentials() {
 			vcc := credential.Contents()
 
+			validFrom, validUntil, err := vcutil.ValidityPeriod(credential)
+			if err != nil {
+				return fmt.Errorf("get validity period: %w", err)
+			}
+
 			var iss, exp string
 
-			if vcc.Issued != nil {
-				iss = vcc.Issued.FormatToString()
+			if validFrom != nil {
+				iss = validFrom.FormatToString()
 			}
 
-			if vcc.Expired != nil {
-				exp = vcc.Expired.FormatToString()
+			if validUntil != nil {
+				exp = validUntil.FormatToString()
 			}
 
 			matches = append(matches, trustregistry.CredentialMatches{
@@ -562,6 +756,21 @@ func (s *Service) checkPolicy(
 	return nil
 }
 
+// RequestObjectFetched publishes an event that the wallet fetched the request object of the transaction.
+func (s *Service) RequestObjectFetched(ctx context.Context, txID TxID) error {
+	tx, err := s.transactionManager.Get(txID)
+	if err != nil {
+		return fmt.Errorf("get tx: %w", err)
+	}
+
+	profile, err := s.profileService.GetProfile(tx.ProfileID, tx.ProfileVersion)
+	if err != nil {
+		return fmt.Errorf("get profile: %w", err)
+	}
+
+	return s.sendTxEvent(ctx, spi.VerifierOIDCInteractionRequestObjectFetched, tx, profile)
+}
+
 func (s *Service) GetTx(_ context.Context, id TxID) (*Transaction, error) {
 	return s.transactionManager.Get(id)
 }
@@ -570,6 +779,7 @@ func (s *Service) RetrieveClaims(
 	ctx context.Context,
 	tx *Transaction,
 	profile *profileapi.Verifier,
+	locales []string,
 ) map[string]CredentialMetadata {
 	logger.Debugc(ctx, "RetrieveClaims begin")
 	result := map[string]CredentialMetadata{}
@@ -591,20 +801,30 @@ func (s *Service) RetrieveClaims(
 		}
 		credContents := cred.Contents()
 
+		conf := profile.CredentialMetaData.FindCredentialConfiguration(credContents.Types)
+		claimsMetadata := credentialClaimsMetadata(ctx, conf)
+
 		// TODO: review this code change. This code shouldn't be dependent on how vc-go serialize
 		// issuer and subject into credential. It has complicated logic like serialize as just string if issuer
 		// have only id. Any changes or extension of how vc works will affect some internal code, that should be
 		// isolated from this kind of changes.
 		subject := lo.Map(credContents.Subject, func(subj verifiable.Subject, index int) verifiable.JSONObject {
-			return verifiable.SubjectToJSON(subj)
+			return profileapi.MaskClaims(verifiable.SubjectToJSON(subj), claimsMetadata)
 		})
 
+		validFrom, validUntil, err := vcutil.ValidityPeriod(cred)
+		if err != nil {
+			logger.Debugc(ctx, "RetrieveClaims - failed to get validity period", log.WithError(err))
+			continue
+		}
+
 		credMeta := CredentialMetadata{
 			Format:         credType,
 			Type:           credContents.Types,
 			SubjectData:    subject,
-			IssuanceDate:   credContents.Issued,
-			ExpirationDate: credContents.Expired,
+			IssuanceDate:   validFrom,
+			ExpirationDate: validUntil,
+			Display:        resolveCredentialDisplay(conf, claimsMetadata, locales),
 		}
 
 		credMeta.Name = cred.CustomField(additionalClaimFieldName)
@@ -626,7 +846,8 @@ func (s *Service) RetrieveClaims(
 
 	logger.Debugc(ctx, "RetrieveClaims succeed")
 
-	err := s.sendOIDCInteractionEvent(ctx, spi.VerifierOIDCInteractionClaimsRetrieved, tx, profile, tx.ReceivedClaims)
+	err := s.sendOIDCInteractionEvent(ctx, spi.VerifierOIDCInteractionClaimsRetrieved, tx, profile, tx.ReceivedClaims,
+		"")
 	if err != nil {
 		logger.Warnc(ctx, "Failed to send event", log.WithError(err))
 	}
@@ -634,18 +855,60 @@ func (s *Service) RetrieveClaims(
 	return result
 }
 
+// credentialClaimsMetadata returns claims metadata of the credential configuration. Masks and display names
+// of the claims returned by the verifier are resolved from it.
+func credentialClaimsMetadata(
+	ctx context.Context,
+	conf *profileapi.CredentialsConfigurationSupported,
+) map[string]*profileapi.Claim {
+	if conf == nil {
+		return nil
+	}
+
+	claimsMetadata, err := conf.ClaimsMetadata()
+	if err != nil {
+		logger.Debugc(ctx, "failed to get claims metadata", log.WithError(err))
+	}
+
+	return claimsMetadata
+}
+
+// resolveCredentialDisplay resolves display metadata of the credential from the credential configuration.
+func resolveCredentialDisplay(
+	conf *profileapi.CredentialsConfigurationSupported,
+	claimsMetadata map[string]*profileapi.Claim,
+	locales []string,
+) *profileapi.LocalizedCredentialDisplay {
+	var (
+		display []*profileapi.CredentialDisplay
+		order   []string
+	)
+
+	if conf != nil {
+		display, order = conf.Display, conf.Order
+	}
+
+	return profileapi.LocalizeDisplay(display, claimsMetadata, order, locales)
+}
+
 func (s *Service) DeleteClaims(_ context.Context, claimsID string) error {
 	return s.transactionManager.DeleteReceivedClaims(claimsID)
 }
 
 func (s *Service) getDataIntegrityVerifier() (*dataintegrity.Verifier, error) {
-	verifySuite := ecdsa2019.NewVerifierInitializer(&ecdsa2019.VerifierInitializerOptions{
-		LDDocumentLoader: s.documentLoader,
-	})
-
 	verifier, err := dataintegrity.NewVerifier(&dataintegrity.Options{
 		DIDResolver: s.vdr,
-	}, verifySuite)
+	},
+		ecdsa2019.NewVerifierInitializer(&ecdsa2019.VerifierInitializerOptions{
+			LDDocumentLoader: s.documentLoader,
+		}),
+		eddsa2022.NewVerifierInitializer(&eddsa2022.VerifierInitializerOptions{
+			LDDocumentLoader: s.documentLoader,
+		}),
+		bbsplus2023.NewVerifierInitializer(&bbsplus2023.VerifierInitializerOptions{
+			LDDocumentLoader: s.documentLoader,
+		}),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("new verifier: %w", err)
 	}
@@ -678,7 +941,7 @@ func (s *Service) extractClaimData(
 			verifiable.WithDataIntegrityVerifier(diVerifier),
 			verifiable.WithExpectedDataIntegrityFields(crypto.AssertionMethod, "", ""),
 			verifiable.WithJSONLDDocumentLoader(s.documentLoader),
-			verifiable.WithProofChecker(defaults.NewDefaultProofChecker(vermethod.NewVDRResolver(s.vdr)))),
+			verifiable.WithProofChecker(s.proofChecker)),
 		presexch.WithDisableSchemaValidation(),
 	}
 
@@ -764,8 +1027,11 @@ func (s *Service) createRequestObjectJWT(presentationDefinition *presexch.Presen
 			fmt.Errorf("initiate oidc interaction: get key manager failed: %w", err))
 	}
 
-	vpFormats := GetSupportedVPFormats(
-		kms.SupportedKeyTypes(), profile.Checks.Presentation.Format, profile.Checks.Credential.Format)
+	vpFormats := &VPFormats{
+		Format: GetSupportedVPFormats(
+			kms.SupportedKeyTypes(), profile.Checks.Presentation.Format, profile.Checks.Credential.Format),
+		DIVC: GetSupportedDataIntegrityFormat(profile.Checks.Credential.Format),
+	}
 
 	ro := s.createRequestObject(presentationDefinition, vpFormats, tx, nonce, purpose, customScopes, profile)
 
@@ -853,9 +1119,22 @@ func GetSupportedVPFormats(
 	return formats
 }
 
+// GetSupportedDataIntegrityFormat returns Data Integrity proof cryptosuites supported for credentials
+// in ldp format.
+func GetSupportedDataIntegrityFormat(supportedVCFormats []vcsverifiable.Format) *DataIntegrityFormat {
+	if !lo.Contains(supportedVCFormats, vcsverifiable.Ldp) {
+		return nil
+	}
+
+	return &DataIntegrityFormat{
+		ProofType:   []string{models.DataIntegrityProof},
+		Cryptosuite: []string{ecdsa2019.SuiteType, eddsa2022.SuiteType, bbsplus2023.SuiteType},
+	}
+}
+
 func (s *Service) createRequestObject(
 	presentationDefinition *presexch.PresentationDefinition,
-	vpFormats *presexch.Format,
+	vpFormats *VPFormats,
 	tx *Transaction,
 	nonce string,
 	purpose string,
@@ -894,8 +1173,10 @@ func (s *Service) sendOIDCInteractionEvent(
 	tx *Transaction,
 	profile *profileapi.Verifier,
 	receivedClaims *ReceivedClaims,
+	receipt string,
 ) error {
 	ep := createTxEventPayload(tx, profile)
+	ep.VerificationReceipt = receipt
 
 	for _, c := range receivedClaims.Credentials {
 		cred := c.Contents()
@@ -905,6 +1186,10 @@ func (s *Service) sendOIDCInteractionEvent(
 			logger.Warnc(ctx, "Unable to extract ID from credential subject: %w", log.WithError(err))
 		}
 
+		if subjectID != "" {
+			subjectID = maskSubjectID(ctx, profile, cred.Types, subjectID)
+		}
+
 		var issuerID string
 		if cred.Issuer != nil {
 			issuerID = cred.Issuer.ID
@@ -931,6 +1216,19 @@ func (s *Service) sendOIDCInteractionEvent(
 	return nil
 }
 
+// maskSubjectID masks the subject ID as the claims returned by RetrieveClaims are, since the subject ID is
+// a claim of the credential too.
+func maskSubjectID(ctx context.Context, profile *profileapi.Verifier, types []string, subjectID string) string {
+	claimsMetadata := credentialClaimsMetadata(ctx, profile.CredentialMetaData.FindCredentialConfiguration(types))
+
+	masked, ok := profileapi.MaskClaims(map[string]interface{}{"id": subjectID}, claimsMetadata)["id"].(string)
+	if !ok {
+		return subjectID
+	}
+
+	return masked
+}
+
 func getScope(customScopes []string) string {
 	scope := "openid"
 	if len(customScopes) > 0 {
@@ -13,6 +13,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -38,14 +40,20 @@ import (
 	"github.com/trustbloc/kms-go/secretlock/noop"
 	"github.com/trustbloc/kms-go/spi/kms"
 	"github.com/trustbloc/vc-go/presexch"
+	"github.com/trustbloc/vc-go/proof/defaults"
 	"github.com/trustbloc/vc-go/verifiable"
+	"github.com/trustbloc/vc-go/vermethod"
 
 	"github.com/trustbloc/vcs/internal/mock/vcskms"
 	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
 	"github.com/trustbloc/vcs/pkg/event/spi"
 	"github.com/trustbloc/vcs/pkg/internal/testutil"
 	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
 	"github.com/trustbloc/vcs/pkg/service/oidc4vp"
+	"github.com/trustbloc/vcs/pkg/service/presentationarchive"
+	"github.com/trustbloc/vcs/pkg/service/verificationreceipt"
+	"github.com/trustbloc/vcs/pkg/service/verifypresentation"
 )
 
 var (
@@ -77,7 +85,7 @@ func TestService_InitiateOidcInteraction(t *testing.T) {
 
 	txManager := NewMockTransactionManager(gomock.NewController(t))
 	txManager.EXPECT().CreateTx(
-		gomock.Any(), gomock.Any(), gomock.Any(), int32(20), int32(10), []string{customScope}).AnyTimes().
+		gomock.Any(), gomock.Any(), gomock.Any(), int32(20), int32(10), []string{customScope}, gomock.Any()).AnyTimes().
 		Return(&oidc4vp.Transaction{
 			ID:                     "TxID1",
 			ProfileID:              "test4",
@@ -139,10 +147,27 @@ func TestService_InitiateOidcInteraction(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		info, err := s.InitiateOidcInteraction(context.TODO(), &presexch.PresentationDefinition{
 			ID: "test",
-		}, "test", []string{customScope}, correctProfile)
+		}, "test", []string{customScope}, "", correctProfile)
 
 		require.NoError(t, err)
 		require.NotNil(t, info)
+		require.Equal(t, "openid-vc://?request_uri=someurl/abc", info.AuthorizationRequest)
+	})
+
+	t.Run("Success with wallet url scheme", func(t *testing.T) {
+		profile := &profileapi.Verifier{}
+		require.NoError(t, copier.Copy(profile, correctProfile))
+		profile.OIDCConfig = &profileapi.OIDC4VPConfig{
+			KeyType:   kms.ED25519Type,
+			WalletURL: &profileapi.WalletURLConfig{Scheme: "haip"},
+		}
+
+		info, err := s.InitiateOidcInteraction(context.TODO(), &presexch.PresentationDefinition{
+			ID: "test",
+		}, "test", []string{customScope}, "", profile)
+
+		require.NoError(t, err)
+		require.Equal(t, "haip://?request_uri=someurl/abc", info.AuthorizationRequest)
 	})
 
 	t.Run("No signature did", func(t *testing.T) {
@@ -151,7 +176,7 @@ func TestService_InitiateOidcInteraction(t *testing.T) {
 		incorrectProfile.SigningDID = nil
 
 		info, err := s.InitiateOidcInteraction(
-			context.TODO(), &presexch.PresentationDefinition{}, "test", []string{customScope}, incorrectProfile)
+			context.TODO(), &presexch.PresentationDefinition{}, "test", []string{customScope}, "", incorrectProfile)
 
 		require.Error(t, err)
 		require.Nil(t, info)
@@ -160,7 +185,7 @@ func TestService_InitiateOidcInteraction(t *testing.T) {
 	t.Run("Tx create failed", func(t *testing.T) {
 		txManagerErr := NewMockTransactionManager(gomock.NewController(t))
 		txManagerErr.EXPECT().CreateTx(
-			gomock.Any(), gomock.Any(), gomock.Any(), int32(20), int32(10), []string{customScope}).
+			gomock.Any(), gomock.Any(), gomock.Any(), int32(20), int32(10), []string{customScope}, gomock.Any()).
 			AnyTimes().
 			Return(nil, "", errors.New("fail"))
 
@@ -178,6 +203,7 @@ func TestService_InitiateOidcInteraction(t *testing.T) {
 			&presexch.PresentationDefinition{},
 			"test",
 			[]string{customScope},
+			"",
 			correctProfile,
 		)
 
@@ -204,6 +230,7 @@ func TestService_InitiateOidcInteraction(t *testing.T) {
 			&presexch.PresentationDefinition{},
 			"test",
 			[]string{customScope},
+			"",
 			correctProfile,
 		)
 
@@ -229,6 +256,7 @@ func TestService_InitiateOidcInteraction(t *testing.T) {
 			&presexch.PresentationDefinition{},
 			"test",
 			[]string{customScope},
+			"",
 			correctProfile,
 		)
 
@@ -242,7 +270,7 @@ func TestService_InitiateOidcInteraction(t *testing.T) {
 		incorrectProfile.SigningDID.KMSKeyID = "invalid"
 
 		info, err := s.InitiateOidcInteraction(
-			context.TODO(), &presexch.PresentationDefinition{}, "test", []string{customScope}, incorrectProfile)
+			context.TODO(), &presexch.PresentationDefinition{}, "test", []string{customScope}, "", incorrectProfile)
 
 		require.Error(t, err)
 		require.Nil(t, info)
@@ -254,7 +282,7 @@ func TestService_InitiateOidcInteraction(t *testing.T) {
 		incorrectProfile.OIDCConfig.KeyType = "invalid"
 
 		info, err := s.InitiateOidcInteraction(
-			context.TODO(), &presexch.PresentationDefinition{}, "test", []string{customScope}, incorrectProfile)
+			context.TODO(), &presexch.PresentationDefinition{}, "test", []string{customScope}, "", incorrectProfile)
 
 		require.Error(t, err)
 		require.Nil(t, info)
@@ -293,7 +321,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 	}, true, nil)
 
 	txManager.EXPECT().StoreReceivedClaims(
-		oidc4vp.TxID("txID1"), gomock.Any(), int32(20), int32(10)).AnyTimes().Return(nil)
+		oidc4vp.TxID("txID1"), gomock.Any(), "", int32(20), int32(10)).AnyTimes().Return(nil)
 
 	profileService.EXPECT().GetProfile(profileID, profileVersion).AnyTimes().Return(&profileapi.Verifier{
 		ID:      profileID,
@@ -332,10 +360,11 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 			PresentationDefinition: pd,
 		}, true, nil)
 
-		txManager2.EXPECT().StoreReceivedClaims(oidc4vp.TxID("txID1"), gomock.Any(), int32(20), int32(10)).Times(1).
+		txManager2.EXPECT().StoreReceivedClaims(oidc4vp.TxID("txID1"), gomock.Any(), "", int32(20), int32(10)).Times(1).
 			DoAndReturn(func(
 				txID oidc4vp.TxID,
 				claims *oidc4vp.ReceivedClaims,
+				responseCode string,
 				profileTransactionDataTTL, profileReceivedClaimsDataTTL int32) error {
 				require.Nil(t, claims.CustomScopeClaims)
 
@@ -353,7 +382,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 			TrustRegistry:        trustRegistry,
 		})
 
-		err = s2.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+		_, err = s2.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: nil,
 				VPTokens: []*oidc4vp.ProcessedVPToken{{
@@ -368,6 +397,347 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("Success - same-device flow", func(t *testing.T) {
+		txManager2 := NewMockTransactionManager(gomock.NewController(t))
+
+		txManager2.EXPECT().GetByOneTimeToken("nonce1").AnyTimes().Return(&oidc4vp.Transaction{
+			ID:                     "txID1",
+			ProfileID:              profileID,
+			ProfileVersion:         profileVersion,
+			PresentationDefinition: pd,
+			ResponseRedirectURI:    "https://verifier.example.com/cb?session=1",
+		}, true, nil)
+
+		var storedResponseCode string
+
+		txManager2.EXPECT().StoreReceivedClaims(
+			oidc4vp.TxID("txID1"), gomock.Any(), gomock.Any(), int32(20), int32(10)).Times(1).
+			DoAndReturn(func(
+				txID oidc4vp.TxID,
+				claims *oidc4vp.ReceivedClaims,
+				responseCode string,
+				profileTransactionDataTTL, profileReceivedClaimsDataTTL int32) error {
+				storedResponseCode = responseCode
+
+				return nil
+			})
+
+		s2 := oidc4vp.NewService(&oidc4vp.Config{
+			EventSvc:             &mockEvent{},
+			EventTopic:           spi.VerifierEventTopic,
+			TransactionManager:   txManager2,
+			PresentationVerifier: presentationVerifier,
+			ProfileService:       profileService,
+			DocumentLoader:       loader,
+			VDR:                  vdr,
+			TrustRegistry:        trustRegistry,
+		})
+
+		result, verifyErr := s2.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+			&oidc4vp.AuthorizationResponseParsed{
+				VPTokens: []*oidc4vp.ProcessedVPToken{{
+					Nonce:         "nonce1",
+					Presentation:  vp,
+					SignerDIDID:   issuer,
+					VpTokenFormat: vcsverifiable.Jwt,
+				}},
+			},
+		)
+		require.NoError(t, verifyErr)
+		require.NotEmpty(t, storedResponseCode)
+		require.Equal(t, storedResponseCode, result.ResponseCode)
+
+		redirectURI, parseErr := url.Parse(result.RedirectURI)
+		require.NoError(t, parseErr)
+		require.Equal(t, "verifier.example.com", redirectURI.Host)
+		require.Equal(t, "/cb", redirectURI.Path)
+		require.Equal(t, "1", redirectURI.Query().Get("session"))
+		require.Equal(t, storedResponseCode, redirectURI.Query().Get("response_code"))
+	})
+
+	t.Run("Success - verification receipt", func(t *testing.T) {
+		receiptProfile := &profileapi.Verifier{
+			ID:      profileID,
+			Version: profileVersion,
+			Active:  true,
+			Checks: &profileapi.VerificationChecks{
+				Credential: profileapi.CredentialChecks{
+					Status: true,
+				},
+				Presentation: &profileapi.PresentationChecks{
+					Proof: true,
+					Format: []vcsverifiable.Format{
+						vcsverifiable.Jwt,
+					},
+				},
+				Policy: profileapi.PolicyCheck{
+					PolicyURL: presentationPolicyURL,
+				},
+			},
+			VerificationReceipt: &profileapi.VerificationReceiptConfig{
+				AttachToEvent: true,
+			},
+			DataConfig: profileapi.VerifierDataConfig{
+				OIDC4VPTransactionDataTTL:    20,
+				OIDC4VPReceivedClaimsDataTTL: 10,
+			},
+		}
+
+		receiptProfileService := NewMockProfileService(gomock.NewController(t))
+		receiptProfileService.EXPECT().GetProfile(profileID, profileVersion).Return(receiptProfile, nil)
+
+		receiptPresentationVerifier := NewMockPresentationVerifier(gomock.NewController(t))
+		receiptPresentationVerifier.EXPECT().VerifyPresentation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(
+				ctx context.Context,
+				_ *verifiable.Presentation,
+				_ *verifypresentation.Options,
+				_ *profileapi.Verifier,
+			) ([]verifypresentation.PresentationVerificationCheckResult, map[string][]string, error) {
+				statusListVC, err := verifiable.CreateCredential(verifiable.CredentialContents{
+					ID: "https://example.com/status/1",
+				}, nil)
+				require.NoError(t, err)
+
+				require.NoError(t, credentialstatus.RecordStatusList(ctx, "https://example.com/status/1", statusListVC))
+
+				return nil, nil, nil
+			})
+
+		receiptService := NewMockReceiptService(gomock.NewController(t))
+		receiptService.EXPECT().Issue(gomock.Any(), receiptProfile, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *profileapi.Verifier, receipt *verificationreceipt.Receipt) (string, error) {
+				require.Equal(t, "txID1", receipt.ID)
+				require.Len(t, receipt.PresentationDigests, 1)
+				require.Len(t, receipt.Credentials, len(vp.Credentials()))
+				require.Len(t, receipt.StatusLists, 1)
+				require.Equal(t, "https://example.com/status/1", receipt.StatusLists[0].URL)
+				require.Equal(t, []*verificationreceipt.CheckResult{
+					{Check: "proof", Passed: true},
+					{Check: "credentialStatus", Passed: true},
+					{Check: "policy", Passed: true},
+				}, receipt.Checks)
+
+				return "receipt-jwt", nil
+			})
+
+		eventSvc := &mockEvent{}
+
+		s2 := oidc4vp.NewService(&oidc4vp.Config{
+			EventSvc:             eventSvc,
+			EventTopic:           spi.VerifierEventTopic,
+			TransactionManager:   txManager,
+			PresentationVerifier: receiptPresentationVerifier,
+			ProfileService:       receiptProfileService,
+			DocumentLoader:       loader,
+			VDR:                  vdr,
+			TrustRegistry:        trustRegistry,
+			ReceiptService:       receiptService,
+		})
+
+		_, err = s2.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+			&oidc4vp.AuthorizationResponseParsed{
+				VPTokens: []*oidc4vp.ProcessedVPToken{{
+					Nonce:         "nonce1",
+					Presentation:  vp,
+					SignerDIDID:   issuer,
+					VpTokenFormat: vcsverifiable.Jwt,
+				}},
+			},
+		)
+		require.NoError(t, err)
+
+		succeeded, ok := lo.Find(eventSvc.published, func(e *spi.Event) bool {
+			return e.Type == spi.VerifierOIDCInteractionSucceeded
+		})
+		require.True(t, ok)
+
+		data, ok := succeeded.Data.(map[string]interface{})
+		require.True(t, ok)
+		require.Equal(t, "receipt-jwt", data["verificationReceipt"])
+	})
+
+	t.Run("Error - issue verification receipt", func(t *testing.T) {
+		receiptProfileService := NewMockProfileService(gomock.NewController(t))
+		receiptProfileService.EXPECT().GetProfile(profileID, profileVersion).Return(&profileapi.Verifier{
+			ID:      profileID,
+			Version: profileVersion,
+			Active:  true,
+			Checks: &profileapi.VerificationChecks{
+				Presentation: &profileapi.PresentationChecks{
+					Format: []vcsverifiable.Format{
+						vcsverifiable.Jwt,
+					},
+				},
+			},
+			VerificationReceipt: &profileapi.VerificationReceiptConfig{},
+		}, nil)
+
+		receiptPresentationVerifier := NewMockPresentationVerifier(gomock.NewController(t))
+		receiptPresentationVerifier.EXPECT().VerifyPresentation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, nil, nil)
+
+		receiptService := NewMockReceiptService(gomock.NewController(t))
+		receiptService.EXPECT().Issue(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("issue error"))
+
+		txManager2 := NewMockTransactionManager(gomock.NewController(t))
+		txManager2.EXPECT().GetByOneTimeToken("nonce1").Return(&oidc4vp.Transaction{
+			ID:                     "txID1",
+			ProfileID:              profileID,
+			ProfileVersion:         profileVersion,
+			PresentationDefinition: pd,
+		}, true, nil)
+		txManager2.EXPECT().StoreReceivedClaims(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(0)
+
+		s2 := oidc4vp.NewService(&oidc4vp.Config{
+			EventSvc:             &mockEvent{},
+			EventTopic:           spi.VerifierEventTopic,
+			TransactionManager:   txManager2,
+			PresentationVerifier: receiptPresentationVerifier,
+			ProfileService:       receiptProfileService,
+			DocumentLoader:       loader,
+			VDR:                  vdr,
+			TrustRegistry:        trustRegistry,
+			ReceiptService:       receiptService,
+		})
+
+		_, err = s2.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+			&oidc4vp.AuthorizationResponseParsed{
+				VPTokens: []*oidc4vp.ProcessedVPToken{{
+					Nonce:         "nonce1",
+					Presentation:  vp,
+					SignerDIDID:   issuer,
+					VpTokenFormat: vcsverifiable.Jwt,
+				}},
+			},
+		)
+		require.ErrorContains(t, err, "issue verification receipt: issue error")
+	})
+
+	t.Run("Success - presentation archived", func(t *testing.T) {
+		archiveProfile := &profileapi.Verifier{
+			ID:      profileID,
+			Version: profileVersion,
+			Active:  true,
+			Checks: &profileapi.VerificationChecks{
+				Presentation: &profileapi.PresentationChecks{
+					Format: []vcsverifiable.Format{
+						vcsverifiable.Jwt,
+					},
+				},
+			},
+			PresentationArchive: &profileapi.PresentationArchiveConfig{
+				RetentionDays: 365,
+			},
+			DataConfig: profileapi.VerifierDataConfig{
+				OIDC4VPTransactionDataTTL:    20,
+				OIDC4VPReceivedClaimsDataTTL: 10,
+			},
+		}
+
+		archiveProfileService := NewMockProfileService(gomock.NewController(t))
+		archiveProfileService.EXPECT().GetProfile(profileID, profileVersion).Return(archiveProfile, nil)
+
+		archivePresentationVerifier := NewMockPresentationVerifier(gomock.NewController(t))
+		archivePresentationVerifier.EXPECT().VerifyPresentation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, nil, nil)
+
+		submission := map[string]interface{}{"id": "submission1", "definition_id": "pd1"}
+
+		archive := NewMockPresentationArchive(gomock.NewController(t))
+		archive.EXPECT().Archive(gomock.Any(), archiveProfile, "txID1", &presentationarchive.Presentation{
+			VPTokens:               []string{"raw-vp-token"},
+			PresentationSubmission: submission,
+		}).Return(nil)
+
+		s2 := oidc4vp.NewService(&oidc4vp.Config{
+			EventSvc:             &mockEvent{},
+			EventTopic:           spi.VerifierEventTopic,
+			TransactionManager:   txManager,
+			PresentationVerifier: archivePresentationVerifier,
+			ProfileService:       archiveProfileService,
+			DocumentLoader:       loader,
+			VDR:                  vdr,
+			TrustRegistry:        trustRegistry,
+			PresentationArchive:  archive,
+		})
+
+		_, err = s2.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+			&oidc4vp.AuthorizationResponseParsed{
+				VPTokens: []*oidc4vp.ProcessedVPToken{{
+					Nonce:         "nonce1",
+					Presentation:  vp,
+					SignerDIDID:   issuer,
+					VpTokenFormat: vcsverifiable.Jwt,
+					RawToken:      "raw-vp-token",
+				}},
+				PresentationSubmission: submission,
+			},
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("Error - archive presentation", func(t *testing.T) {
+		archiveProfileService := NewMockProfileService(gomock.NewController(t))
+		archiveProfileService.EXPECT().GetProfile(profileID, profileVersion).Return(&profileapi.Verifier{
+			ID:      profileID,
+			Version: profileVersion,
+			Active:  true,
+			Checks: &profileapi.VerificationChecks{
+				Presentation: &profileapi.PresentationChecks{
+					Format: []vcsverifiable.Format{
+						vcsverifiable.Jwt,
+					},
+				},
+			},
+			PresentationArchive: &profileapi.PresentationArchiveConfig{
+				RetentionDays: 365,
+			},
+		}, nil)
+
+		archivePresentationVerifier := NewMockPresentationVerifier(gomock.NewController(t))
+		archivePresentationVerifier.EXPECT().VerifyPresentation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, nil, nil)
+
+		archive := NewMockPresentationArchive(gomock.NewController(t))
+		archive.EXPECT().Archive(gomock.Any(), gomock.Any(), "txID1", gomock.Any()).Return(errors.New("archive error"))
+
+		txManager2 := NewMockTransactionManager(gomock.NewController(t))
+		txManager2.EXPECT().GetByOneTimeToken("nonce1").Return(&oidc4vp.Transaction{
+			ID:                     "txID1",
+			ProfileID:              profileID,
+			ProfileVersion:         profileVersion,
+			PresentationDefinition: pd,
+		}, true, nil)
+		txManager2.EXPECT().StoreReceivedClaims(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(0)
+
+		s2 := oidc4vp.NewService(&oidc4vp.Config{
+			EventSvc:             &mockEvent{},
+			EventTopic:           spi.VerifierEventTopic,
+			TransactionManager:   txManager2,
+			PresentationVerifier: archivePresentationVerifier,
+			ProfileService:       archiveProfileService,
+			DocumentLoader:       loader,
+			VDR:                  vdr,
+			TrustRegistry:        trustRegistry,
+			PresentationArchive:  archive,
+		})
+
+		_, err = s2.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+			&oidc4vp.AuthorizationResponseParsed{
+				VPTokens: []*oidc4vp.ProcessedVPToken{{
+					Nonce:         "nonce1",
+					Presentation:  vp,
+					SignerDIDID:   issuer,
+					VpTokenFormat: vcsverifiable.Jwt,
+				}},
+			},
+		)
+		require.ErrorContains(t, err, "archive presentation: archive error")
+	})
+
 	t.Run("Success - two VP tokens (merged) with custom claims and attestation vp", func(t *testing.T) {
 		var descriptors []*presexch.InputDescriptor
 		err = json.Unmarshal([]byte(twoInputDescriptors), &descriptors)
@@ -436,10 +806,11 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 			CustomScopes:           []string{customScope},
 		}, true, nil)
 
-		txManager2.EXPECT().StoreReceivedClaims(oidc4vp.TxID("txID1"), gomock.Any(), int32(20), int32(10)).Times(1).
+		txManager2.EXPECT().StoreReceivedClaims(oidc4vp.TxID("txID1"), gomock.Any(), "", int32(20), int32(10)).Times(1).
 			DoAndReturn(func(
 				txID oidc4vp.TxID,
 				claims *oidc4vp.ReceivedClaims,
+				responseCode string,
 				profileTransactionDataTTL, profileReceivedClaimsDataTTL int32) error {
 				require.Equal(t, map[string]oidc4vp.Claims{
 					customScope: {
@@ -450,7 +821,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 				return nil
 			})
 
-		err = s2.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+		_, err = s2.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: map[string]oidc4vp.Claims{
 					customScope: {
@@ -479,7 +850,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 	})
 
 	t.Run("Unsupported vp token format", func(t *testing.T) {
-		err = s.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+		_, err = s.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: nil,
 				VPTokens: []*oidc4vp.ProcessedVPToken{{
@@ -562,12 +933,12 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 		}, true, nil)
 
 		txManager2.EXPECT().StoreReceivedClaims(
-			oidc4vp.TxID("txID1"), gomock.Any(), int32(20), int32(10)).AnyTimes().Return(nil)
+			oidc4vp.TxID("txID1"), gomock.Any(), "", int32(20), int32(10)).AnyTimes().Return(nil)
 
 		vp1.ID = ""
 		vp2.ID = ""
 
-		err = s2.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+		_, err = s2.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: nil,
 				VPTokens: []*oidc4vp.ProcessedVPToken{
@@ -592,7 +963,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 	})
 
 	t.Run("Must have at least one token", func(t *testing.T) {
-		err = s.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+		_, err = s.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: nil,
 				VPTokens:          []*oidc4vp.ProcessedVPToken{},
@@ -604,7 +975,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 	})
 
 	t.Run("VC subject is not much with vp signer", func(t *testing.T) {
-		err = s.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+		_, err = s.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: nil,
 				VPTokens: []*oidc4vp.ProcessedVPToken{{
@@ -631,7 +1002,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 			DocumentLoader:       loader,
 		})
 
-		err = withError.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+		_, err = withError.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: nil,
 				VPTokens: []*oidc4vp.ProcessedVPToken{{
@@ -644,7 +1015,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 	})
 
 	t.Run("Invalid Nonce 2", func(t *testing.T) {
-		err = s.VerifyOIDCVerifiablePresentation(context.Background(), "txID2",
+		_, err = s.VerifyOIDCVerifiablePresentation(context.Background(), "txID2",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: nil,
 				VPTokens: []*oidc4vp.ProcessedVPToken{{
@@ -670,7 +1041,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 			CustomScopes:           []string{customScope},
 		}, true, nil)
 
-		err = withError.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+		_, err = withError.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: nil,
 				VPTokens: []*oidc4vp.ProcessedVPToken{{
@@ -694,7 +1065,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 			CustomScopes:           []string{customScope},
 		}, true, nil)
 
-		err = withError.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+		_, err = withError.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: map[string]oidc4vp.Claims{
 					"customScope2": {},
@@ -707,7 +1078,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 	})
 
 	t.Run("Invalid _scope 3", func(t *testing.T) {
-		err = s.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+		_, err = s.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: map[string]oidc4vp.Claims{
 					customScope: {},
@@ -733,7 +1104,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 			DocumentLoader:       loader,
 		})
 
-		err = withError.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+		_, err = withError.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: nil,
 				VPTokens: []*oidc4vp.ProcessedVPToken{{
@@ -761,7 +1132,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 			TrustRegistry:        trustRegistry,
 		})
 
-		err = withError.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+		_, err = withError.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: nil,
 				VPTokens: []*oidc4vp.ProcessedVPToken{{
@@ -775,7 +1146,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 	})
 
 	t.Run("Match failed", func(t *testing.T) {
-		err = s.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+		_, err = s.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: nil,
 				VPTokens: []*oidc4vp.ProcessedVPToken{{
@@ -786,6 +1157,30 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 		require.Contains(t, err.Error(), "match:")
 	})
 
+	t.Run("Match failed - credential proof rejected by proof checker", func(t *testing.T) {
+		withChecker := oidc4vp.NewService(&oidc4vp.Config{
+			EventSvc:             &mockEvent{},
+			EventTopic:           spi.VerifierEventTopic,
+			TransactionManager:   txManager,
+			PresentationVerifier: presentationVerifier,
+			ProfileService:       profileService,
+			DocumentLoader:       loader,
+			VDR:                  vdr,
+			TrustRegistry:        trustRegistry,
+			ProofChecker:         defaults.NewDefaultProofChecker(vermethod.NewVDRResolver(&vdrmock.VDRegistry{})),
+		})
+
+		_, err = withChecker.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+			&oidc4vp.AuthorizationResponseParsed{
+				VPTokens: []*oidc4vp.ProcessedVPToken{{
+					Nonce:         "nonce1",
+					Presentation:  vp,
+					SignerDIDID:   issuer,
+					VpTokenFormat: vcsverifiable.Jwt,
+				}}})
+		require.ErrorContains(t, err, "match:")
+	})
+
 	t.Run("Store error", func(t *testing.T) {
 		errTxManager := NewMockTransactionManager(gomock.NewController(t))
 		errTxManager.EXPECT().GetByOneTimeToken("nonce1").AnyTimes().Return(&oidc4vp.Transaction{
@@ -795,7 +1190,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 			PresentationDefinition: pd,
 		}, true, nil)
 
-		errTxManager.EXPECT().StoreReceivedClaims(oidc4vp.TxID("txID1"), gomock.Any(), int32(20), int32(10)).
+		errTxManager.EXPECT().StoreReceivedClaims(oidc4vp.TxID("txID1"), gomock.Any(), "", int32(20), int32(10)).
 			Return(errors.New("store error"))
 
 		withError := oidc4vp.NewService(&oidc4vp.Config{
@@ -809,7 +1204,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 			TrustRegistry:        trustRegistry,
 		})
 
-		err = withError.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+		_, err = withError.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: nil,
 				VPTokens: []*oidc4vp.ProcessedVPToken{{
@@ -838,7 +1233,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 			TrustRegistry:        errTrustRegistry,
 		})
 
-		err = withError.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+		_, err = withError.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: nil,
 				VPTokens: []*oidc4vp.ProcessedVPToken{{
@@ -861,7 +1256,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 			PresentationDefinition: pd,
 		}, true, nil)
 
-		txManager2.EXPECT().StoreReceivedClaims(oidc4vp.TxID("txID1"), gomock.Any(), int32(20), int32(10)).Times(1)
+		txManager2.EXPECT().StoreReceivedClaims(oidc4vp.TxID("txID1"), gomock.Any(), "", int32(20), int32(10)).Times(1)
 
 		errExpected := errors.New("injected publish error")
 
@@ -893,7 +1288,7 @@ func TestService_VerifyOIDCVerifiablePresentation(t *testing.T) {
 			TrustRegistry:        trustRegistry,
 		})
 
-		err = s2.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
+		_, err = s2.VerifyOIDCVerifiablePresentation(context.Background(), "txID1",
 			&oidc4vp.AuthorizationResponseParsed{
 				CustomScopeClaims: nil,
 				VPTokens: []*oidc4vp.ProcessedVPToken{{
@@ -927,6 +1322,69 @@ func TestService_GetTx(t *testing.T) {
 	})
 }
 
+func TestService_RequestObjectFetched(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		txManager := NewMockTransactionManager(gomock.NewController(t))
+		txManager.EXPECT().Get(oidc4vp.TxID("txID")).Return(&oidc4vp.Transaction{
+			ID:             "txID",
+			ProfileID:      profileID,
+			ProfileVersion: profileVersion,
+		}, nil)
+
+		profileService := NewMockProfileService(gomock.NewController(t))
+		profileService.EXPECT().GetProfile(profileID, profileVersion).Return(&profileapi.Verifier{
+			ID:      profileID,
+			Version: profileVersion,
+		}, nil)
+
+		mockEventSvc := NewMockeventService(gomock.NewController(t))
+		mockEventSvc.EXPECT().Publish(gomock.Any(), spi.VerifierEventTopic, gomock.Any()).DoAndReturn(
+			expectedPublishEventFunc(t, spi.VerifierOIDCInteractionRequestObjectFetched, nil),
+		)
+
+		svc := oidc4vp.NewService(&oidc4vp.Config{
+			TransactionManager: txManager,
+			ProfileService:     profileService,
+			EventSvc:           mockEventSvc,
+			EventTopic:         spi.VerifierEventTopic,
+		})
+
+		require.NoError(t, svc.RequestObjectFetched(context.Background(), "txID"))
+	})
+
+	t.Run("Get tx error", func(t *testing.T) {
+		txManager := NewMockTransactionManager(gomock.NewController(t))
+		txManager.EXPECT().Get(oidc4vp.TxID("txID")).Return(nil, oidc4vp.ErrDataNotFound)
+
+		svc := oidc4vp.NewService(&oidc4vp.Config{
+			TransactionManager: txManager,
+		})
+
+		err := svc.RequestObjectFetched(context.Background(), "txID")
+		require.ErrorIs(t, err, oidc4vp.ErrDataNotFound)
+	})
+
+	t.Run("Get profile error", func(t *testing.T) {
+		txManager := NewMockTransactionManager(gomock.NewController(t))
+		txManager.EXPECT().Get(oidc4vp.TxID("txID")).Return(&oidc4vp.Transaction{
+			ID:             "txID",
+			ProfileID:      profileID,
+			ProfileVersion: profileVersion,
+		}, nil)
+
+		profileService := NewMockProfileService(gomock.NewController(t))
+		profileService.EXPECT().GetProfile(profileID, profileVersion).Return(nil, errors.New("profile error"))
+
+		svc := oidc4vp.NewService(&oidc4vp.Config{
+			TransactionManager: txManager,
+			ProfileService:     profileService,
+		})
+
+		err := svc.RequestObjectFetched(context.Background(), "txID")
+		require.ErrorContains(t, err, "profile error")
+	})
+}
+
 func TestService_DeleteClaims(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		txManager := NewMockTransactionManager(gomock.NewController(t))
@@ -982,7 +1440,7 @@ func TestService_RetrieveClaims(t *testing.T) {
 					},
 				},
 			},
-		}, &profileapi.Verifier{})
+		}, &profileapi.Verifier{}, nil)
 
 		require.NotNil(t, claims)
 		subjects, ok := claims["http://example.gov/credentials/3732"].SubjectData.([]map[string]interface{})
@@ -1020,7 +1478,7 @@ func TestService_RetrieveClaims(t *testing.T) {
 		claims := svc.RetrieveClaims(context.Background(), &oidc4vp.Transaction{
 			ReceivedClaims: &oidc4vp.ReceivedClaims{Credentials: []*verifiable.Credential{
 				ldvc,
-			}}}, &profileapi.Verifier{})
+			}}}, &profileapi.Verifier{}, nil)
 
 		require.NotNil(t, claims)
 		subjects, ok := claims["http://example.gov/credentials/3732"].SubjectData.([]map[string]interface{})
@@ -1034,6 +1492,122 @@ func TestService_RetrieveClaims(t *testing.T) {
 		require.Empty(t, claims["_scope"])
 	})
 
+	t.Run("Success with masked claims", func(t *testing.T) {
+		maskedSubjectID := "did:example:" + strings.Repeat("•", len("ebfeb1f712ebc6f1c276e12ec21"))
+
+		mockEventSvc := NewMockeventService(gomock.NewController(t))
+		mockEventSvc.EXPECT().Publish(gomock.Any(), spi.VerifierEventTopic, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, topic string, messages ...*spi.Event) error {
+				require.Len(t, messages, 1)
+				require.Equal(t, spi.VerifierOIDCInteractionClaimsRetrieved, messages[0].Type)
+
+				data, ok := messages[0].Data.(map[string]interface{})
+				require.True(t, ok)
+
+				credentials, ok := data["credentials"].([]interface{})
+				require.True(t, ok)
+				require.Len(t, credentials, 1)
+				require.Equal(t, maskedSubjectID,
+					credentials[0].(map[string]interface{})["subjectID"])
+
+				return nil
+			},
+		)
+
+		svc := oidc4vp.NewService(&oidc4vp.Config{EventSvc: mockEventSvc, EventTopic: spi.VerifierEventTopic})
+		ldvc, err := verifiable.ParseCredential([]byte(sampleVCJsonLD),
+			verifiable.WithJSONLDDocumentLoader(loader),
+			verifiable.WithDisabledProofCheck())
+
+		require.NoError(t, err)
+
+		claims := svc.RetrieveClaims(context.Background(), &oidc4vp.Transaction{
+			ReceivedClaims: &oidc4vp.ReceivedClaims{Credentials: []*verifiable.Credential{
+				ldvc,
+			}}}, &profileapi.Verifier{
+			CredentialMetaData: &profileapi.CredentialMetaData{
+				CredentialsConfigurationSupported: map[string]*profileapi.CredentialsConfigurationSupported{
+					"UniversityDegreeCredential": {
+						CredentialDefinition: &profileapi.CredentialDefinition{
+							Type: []string{"VerifiableCredential", "UniversityDegreeCredential"},
+							CredentialSubject: map[string]profileapi.Claim{
+								"id":   {Mask: "regex(^did:example:(.*)$)"},
+								"name": {Mask: "regex(^(.*).{3}$)"},
+							},
+						},
+						Claims: map[string]interface{}{
+							"degree": map[string]interface{}{
+								"degree": map[string]interface{}{"mask": "***"},
+							},
+						},
+					},
+				},
+			},
+		}, nil)
+
+		require.NotNil(t, claims)
+		subjects, ok := claims["http://example.gov/credentials/3732"].SubjectData.([]map[string]interface{})
+
+		require.True(t, ok)
+		require.Equal(t, maskedSubjectID, subjects[0]["id"])
+		require.Equal(t, "•••••••Doe", subjects[0]["name"])
+		require.Equal(t, map[string]interface{}{"type": "BachelorDegree", "degree": "***"}, subjects[0]["degree"])
+		require.Equal(t, "did:example:c276e12ec21ebfeb1f712ebc6f1", subjects[0]["spouse"])
+
+		// received claims are not modified
+		require.Equal(t, "Jayden Doe", ldvc.Contents().Subject[0].CustomFields["name"])
+	})
+
+	t.Run("Success with localized display", func(t *testing.T) {
+		mockEventSvc := NewMockeventService(gomock.NewController(t))
+		mockEventSvc.EXPECT().Publish(gomock.Any(), spi.VerifierEventTopic, gomock.Any()).DoAndReturn(
+			expectedPublishEventFunc(t, spi.VerifierOIDCInteractionClaimsRetrieved, nil),
+		)
+
+		svc := oidc4vp.NewService(&oidc4vp.Config{EventSvc: mockEventSvc, EventTopic: spi.VerifierEventTopic})
+		ldvc, err := verifiable.ParseCredential([]byte(sampleVCJsonLD),
+			verifiable.WithJSONLDDocumentLoader(loader),
+			verifiable.WithDisabledProofCheck())
+
+		require.NoError(t, err)
+
+		claims := svc.RetrieveClaims(context.Background(), &oidc4vp.Transaction{
+			ReceivedClaims: &oidc4vp.ReceivedClaims{Credentials: []*verifiable.Credential{
+				ldvc,
+			}}}, &profileapi.Verifier{
+			CredentialMetaData: &profileapi.CredentialMetaData{
+				CredentialsConfigurationSupported: map[string]*profileapi.CredentialsConfigurationSupported{
+					"UniversityDegreeCredential": {
+						CredentialDefinition: &profileapi.CredentialDefinition{
+							Type: []string{"VerifiableCredential", "UniversityDegreeCredential"},
+							CredentialSubject: map[string]profileapi.Claim{
+								"name": {Display: []profileapi.L10n{
+									{Name: "Name", Locale: "en"},
+									{Name: "Nom", Locale: "fr"},
+								}},
+								"spouse": {Display: []profileapi.L10n{{Name: "Conjoint", Locale: "fr"}}},
+							},
+						},
+						Display: []*profileapi.CredentialDisplay{
+							{Name: "University Degree", Locale: "en"},
+							{Name: "Diplôme universitaire", Locale: "fr"},
+						},
+						Order: []string{"spouse", "name"},
+					},
+				},
+			},
+		}, []string{"fr-CA"})
+
+		require.Equal(t, &profileapi.LocalizedCredentialDisplay{
+			Name:   "Diplôme universitaire",
+			Locale: "fr",
+			Claims: []*profileapi.LocalizedClaimDisplay{
+				{Path: "spouse", Name: "Conjoint", Locale: "fr"},
+				{Path: "name", Name: "Nom", Locale: "fr"},
+			},
+		}, claims["http://example.gov/credentials/3732"].Display)
+	})
+
 	t.Run("Empty claims", func(t *testing.T) {
 		mockEventSvc := NewMockeventService(gomock.NewController(t))
 		mockEventSvc.EXPECT().Publish(gomock.Any(), spi.VerifierEventTopic, gomock.Any()).DoAndReturn(
@@ -1053,7 +1627,7 @@ func TestService_RetrieveClaims(t *testing.T) {
 		claims := svc.RetrieveClaims(context.Background(), &oidc4vp.Transaction{
 			ReceivedClaims: &oidc4vp.ReceivedClaims{Credentials: []*verifiable.Credential{
 				credential,
-			}}}, &profileapi.Verifier{})
+			}}}, &profileapi.Verifier{}, nil)
 
 		require.Empty(t, claims)
 	})
@@ -1083,7 +1657,7 @@ func TestService_RetrieveClaims(t *testing.T) {
 					},
 				},
 			},
-		}, &profileapi.Verifier{})
+		}, &profileapi.Verifier{}, nil)
 
 		require.NotNil(t, claims)
 		subjects, ok := claims["http://example.gov/credentials/3732"].SubjectData.([]map[string]interface{})
@@ -1114,14 +1688,17 @@ func createCryptoSuite(t *testing.T) api.Suite {
 }
 
 type mockEvent struct {
-	err error
+	err       error
+	published []*spi.Event
 }
 
-func (m *mockEvent) Publish(_ context.Context, _ string, _ ...*spi.Event) error {
+func (m *mockEvent) Publish(_ context.Context, _ string, events ...*spi.Event) error {
 	if m.err != nil {
 		return m.err
 	}
 
+	m.published = append(m.published, events...)
+
 	return nil
 }
 
@@ -1511,6 +2088,19 @@ func Test_GetSupportedVPFormats(t *testing.T) {
 	}
 }
 
+func Test_GetSupportedDataIntegrityFormat(t *testing.T) {
+	t.Run("ldp", func(t *testing.T) {
+		got := oidc4vp.GetSupportedDataIntegrityFormat([]vcsverifiable.Format{vcsverifiable.Jwt, vcsverifiable.Ldp})
+		require.NotNil(t, got)
+		require.Equal(t, []string{"DataIntegrityProof"}, got.ProofType)
+		require.Equal(t, []string{"ecdsa-2019", "eddsa-rdfc-2022", "bbsplus-2023"}, got.Cryptosuite)
+	})
+
+	t.Run("jwt only", func(t *testing.T) {
+		require.Nil(t, oidc4vp.GetSupportedDataIntegrityFormat([]vcsverifiable.Format{vcsverifiable.Jwt}))
+	})
+}
+
 type eventPublishFunc func(ctx context.Context, topic string, messages ...*spi.Event) error
 
 func expectedPublishEventFunc(t *testing.T, eventType spi.EventType, err error) eventPublishFunc { //nolint:unparam
@@ -23,8 +23,9 @@ import (
 )
 
 const (
-	nonceSize  = 10
-	maxRetries = 10
+	nonceSize        = 10
+	responseCodeSize = 32
+	maxRetries       = 10
 )
 
 type TxID string
@@ -37,6 +38,12 @@ type Transaction struct {
 	ReceivedClaims         *ReceivedClaims
 	ReceivedClaimsID       string
 	CustomScopes           []string
+	// ResponseRedirectURI is the URI the wallet redirects the user to after the presentation (same-device flow).
+	ResponseRedirectURI string
+	// ResponseCode is a one-time code added to ResponseRedirectURI, verifier exchanges it for the received claims.
+	ResponseCode string
+	// Status is the last known status of the interaction, nil if no events were handled yet.
+	Status *InteractionStatus
 }
 
 type ReceivedClaims struct {
@@ -57,6 +64,7 @@ type ClaimData struct {
 type TransactionUpdate struct {
 	ID               TxID
 	ReceivedClaimsID string
+	ResponseCode     string
 }
 
 type txStore interface {
@@ -65,8 +73,10 @@ type txStore interface {
 		profileID, profileVersion string,
 		profileTransactionDataTTL int32,
 		customScopes []string,
+		responseRedirectURI string,
 	) (TxID, *Transaction, error)
 	Update(update TransactionUpdate, profileTransactionDataTTL int32) error
+	UpdateStatus(txID TxID, status *InteractionStatus) error
 	Get(txID TxID) (*Transaction, error)
 }
 
@@ -119,8 +129,10 @@ func (tm *TxManager) CreateTx(
 	profileTransactionDataTTL int32,
 	profileNonceStoreDataTTL int32,
 	customScopes []string,
+	responseRedirectURI string,
 ) (*Transaction, string, error) {
-	txID, tx, err := tm.txStore.Create(pd, profileID, profileVersion, profileTransactionDataTTL, customScopes)
+	txID, tx, err := tm.txStore.Create(
+		pd, profileID, profileVersion, profileTransactionDataTTL, customScopes, responseRedirectURI)
 	if err != nil {
 		return nil, "", fmt.Errorf("oidc tx create failed: %w", err)
 	}
@@ -137,9 +149,11 @@ func (tm *TxManager) DeleteReceivedClaims(claimsID string) error {
 	return tm.txClaimsStore.Delete(claimsID)
 }
 
+// StoreReceivedClaims stores encrypted claims and the response code (if any) of the transaction.
 func (tm *TxManager) StoreReceivedClaims(
 	txID TxID,
 	claims *ReceivedClaims,
+	responseCode string,
 	profileTransactionDataTTL, profileReceivedClaimsDataTTL int32) error {
 	encrypted, err := tm.EncryptClaims(context.TODO(), claims)
 	if err != nil {
@@ -151,7 +165,11 @@ func (tm *TxManager) StoreReceivedClaims(
 		return err
 	}
 
-	return tm.txStore.Update(TransactionUpdate{ID: txID, ReceivedClaimsID: receivedClaimsID}, profileTransactionDataTTL)
+	return tm.txStore.Update(TransactionUpdate{
+		ID:               txID,
+		ReceivedClaimsID: receivedClaimsID,
+		ResponseCode:     responseCode,
+	}, profileTransactionDataTTL)
 }
 
 // Get transaction id.
@@ -230,3 +248,13 @@ func genNonce() (string, error) {
 
 	return base64.URLEncoding.EncodeToString(nonceBytes), nil
 }
+
+func genResponseCode() (string, error) {
+	codeBytes := make([]byte, responseCodeSize)
+
+	if _, err := rand.Read(codeBytes); err != nil {
+		return "", fmt.Errorf("response code generating random failed: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(codeBytes), nil
+}
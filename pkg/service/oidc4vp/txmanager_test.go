@@ -27,13 +27,15 @@ import (
 func TestTxManager_CreateTx(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		store := NewMockTxStore(gomock.NewController(t))
-		store.EXPECT().Create(gomock.Any(), profileID, profileVersion, int32(20), []string{customScope}).Return(
+		store.EXPECT().Create(gomock.Any(), profileID, profileVersion, int32(20), []string{customScope},
+			"https://verifier.example.com/cb").Return(
 			oidc4vp.TxID("txID"),
 			&oidc4vp.Transaction{
-				ID:             "txID",
-				ProfileID:      profileID,
-				ProfileVersion: profileVersion,
-				CustomScopes:   []string{customScope},
+				ID:                  "txID",
+				ProfileID:           profileID,
+				ProfileVersion:      profileVersion,
+				CustomScopes:        []string{customScope},
+				ResponseRedirectURI: "https://verifier.example.com/cb",
 			},
 			nil,
 		)
@@ -49,8 +51,8 @@ func TestTxManager_CreateTx(t *testing.T) {
 		manager := oidc4vp.NewTxManager(nonceStore, store, claimsStore, crypto,
 			testutil.DocumentLoader(t))
 
-		tx, nonce, err := manager.CreateTx(
-			&presexch.PresentationDefinition{}, profileID, profileVersion, int32(20), int32(10), []string{customScope})
+		tx, nonce, err := manager.CreateTx(&presexch.PresentationDefinition{}, profileID, profileVersion,
+			int32(20), int32(10), []string{customScope}, "https://verifier.example.com/cb")
 
 		require.NoError(t, err)
 		require.NotEmpty(t, nonce)
@@ -58,11 +60,12 @@ func TestTxManager_CreateTx(t *testing.T) {
 		require.Equal(t, profileID, tx.ProfileID)
 		require.Equal(t, profileVersion, tx.ProfileVersion)
 		require.Equal(t, []string{customScope}, tx.CustomScopes)
+		require.Equal(t, "https://verifier.example.com/cb", tx.ResponseRedirectURI)
 	})
 
 	t.Run("Fail", func(t *testing.T) {
 		store := NewMockTxStore(gomock.NewController(t))
-		store.EXPECT().Create(gomock.Any(), profileID, profileVersion, int32(20), []string{customScope}).
+		store.EXPECT().Create(gomock.Any(), profileID, profileVersion, int32(20), []string{customScope}, "").
 			Return(oidc4vp.TxID(""), nil, errors.New("test error"))
 
 		claimsStore := NewMockTxClaimsStore(gomock.NewController(t))
@@ -74,14 +77,15 @@ func TestTxManager_CreateTx(t *testing.T) {
 			testutil.DocumentLoader(t))
 
 		_, _, err := manager.CreateTx(
-			&presexch.PresentationDefinition{}, profileID, profileVersion, int32(20), int32(10), []string{customScope})
+			&presexch.PresentationDefinition{}, profileID, profileVersion, int32(20), int32(10), []string{customScope}, "")
 
 		require.Contains(t, err.Error(), "test error")
 	})
 
 	t.Run("Fail", func(t *testing.T) {
 		store := NewMockTxStore(gomock.NewController(t))
-		store.EXPECT().Create(gomock.Any(), profileID, profileVersion, int32(20), nil).Return(oidc4vp.TxID("txID"), nil, nil)
+		store.EXPECT().Create(gomock.Any(), profileID, profileVersion, int32(20), nil, "").
+			Return(oidc4vp.TxID("txID"), nil, nil)
 
 		claimsStore := NewMockTxClaimsStore(gomock.NewController(t))
 
@@ -94,7 +98,7 @@ func TestTxManager_CreateTx(t *testing.T) {
 			testutil.DocumentLoader(t))
 
 		_, _, err := manager.CreateTx(
-			&presexch.PresentationDefinition{}, profileID, profileVersion, int32(20), int32(10), nil)
+			&presexch.PresentationDefinition{}, profileID, profileVersion, int32(20), int32(10), nil, "")
 
 		require.Contains(t, err.Error(), "test error")
 	})
@@ -429,7 +433,11 @@ func TestTxManager_Get(t *testing.T) {
 func TestTxManagerStoreReceivedClaims(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		store := NewMockTxStore(gomock.NewController(t))
-		store.EXPECT().Update(gomock.Any(), int32(20)).Return(nil)
+		store.EXPECT().Update(oidc4vp.TransactionUpdate{
+			ID:               "txID",
+			ReceivedClaimsID: "claimsID",
+			ResponseCode:     "response-code",
+		}, int32(20)).Return(nil)
 
 		claimsStore := NewMockTxClaimsStore(gomock.NewController(t))
 
@@ -477,7 +485,7 @@ func TestTxManagerStoreReceivedClaims(t *testing.T) {
 				vcSD,
 				ld,
 			},
-		}, 20, 10)
+		}, "response-code", 20, 10)
 
 		require.NoError(t, err)
 	})
@@ -497,7 +505,7 @@ func TestTxManagerStoreReceivedClaims(t *testing.T) {
 
 		err := manager.StoreReceivedClaims("txID", &oidc4vp.ReceivedClaims{
 			Credentials: []*verifiable.Credential{},
-		}, 20, 10)
+		}, "", 20, 10)
 
 		require.ErrorContains(t, err, "can not encrypt")
 	})
@@ -518,7 +526,7 @@ func TestTxManagerStoreReceivedClaims(t *testing.T) {
 
 		err := manager.StoreReceivedClaims("txID", &oidc4vp.ReceivedClaims{
 			Credentials: []*verifiable.Credential{},
-		}, 20, 10)
+		}, "", 20, 10)
 
 		require.ErrorContains(t, err, "can not store claims")
 	})
@@ -0,0 +1,62 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package presentationarchive
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrDataNotFound = errors.New("data not found")
+	// ErrChainConflict is returned by the store if a record with the same sequence number of the profile
+	// chain already exists.
+	ErrChainConflict = errors.New("chain conflict")
+	// ErrIntegrity is returned if the archived presentation or its link in the hash chain was modified.
+	ErrIntegrity = errors.New("archive integrity check failed")
+)
+
+// Presentation is the presentation received from the wallet in the authorization response.
+type Presentation struct {
+	// VPTokens are the raw VP tokens as received from the wallet.
+	VPTokens []string `json:"vpTokens"`
+	// PresentationSubmission is the presentation submission of the authorization response.
+	PresentationSubmission map[string]interface{} `json:"presentationSubmission,omitempty"`
+}
+
+// Record is a link of the hash chain of the archived presentations of the verifier profile.
+// Record keeps no presentation data, the encrypted presentation is stored separately under the transaction ID.
+type Record struct {
+	TxID           string
+	ProfileID      string
+	ProfileVersion string
+	// Sequence is the position of the record in the hash chain of the profile, starting from 1.
+	Sequence int64
+	// PrevHash is the hash of the previous record in the chain, empty for the first record.
+	PrevHash string
+	// Hash is base64url encoded sha-256 digest of the record fields, including PrevHash and PayloadDigest.
+	Hash string
+	// PayloadDigest is base64url encoded sha-256 digest of the stored encrypted presentation.
+	PayloadDigest string
+	// EncryptedKey is the data key of the presentation wrapped with the key encryption key. It is removed
+	// when the record is purged.
+	EncryptedKey []byte
+	// Signature is a JWT signed by the signing DID of the profile over the record hash.
+	Signature string
+	CreatedAt time.Time
+	ExpireAt  time.Time
+	// Purged is set once the retention period is over and the data key and the encrypted presentation
+	// are deleted.
+	// Purged record remains in the chain to keep it verifiable.
+	Purged bool
+}
+
+// ArchivedPresentation is the decrypted archived presentation together with its hash chain record.
+type ArchivedPresentation struct {
+	*Record
+	*Presentation
+}
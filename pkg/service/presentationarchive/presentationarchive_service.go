@@ -0,0 +1,676 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+//go:generate mockgen -destination presentationarchive_service_mocks_test.go -self_package mocks -package presentationarchive_test -source=presentationarchive_service.go -mock_names store=MockStore,payloadStore=MockPayloadStore,dataProtector=MockDataProtector,kmsRegistry=MockKMSRegistry,proofChecker=MockProofChecker,profileService=MockProfileService
+
+package presentationarchive
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/trustbloc/kms-go/doc/jose"
+	"github.com/trustbloc/logutil-go/pkg/log"
+	"github.com/trustbloc/vc-go/jwt"
+
+	"github.com/trustbloc/vcs/internal/logfields"
+	"github.com/trustbloc/vcs/pkg/dataprotect"
+	"github.com/trustbloc/vcs/pkg/doc/vc/jws"
+	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
+	vcskms "github.com/trustbloc/vcs/pkg/kms"
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+)
+
+const (
+	defaultPurgeInterval = time.Hour
+	purgeBatchSize       = 100
+	maxAppendRetries     = 10
+	dataKeySize          = 32
+)
+
+var logger = log.New("presentation-archive")
+
+type store interface {
+	// Latest returns the last record of the hash chain of the profile.
+	Latest(ctx context.Context, profileID string) (*Record, error)
+	// Create appends the record to the hash chain. ErrChainConflict is returned if the sequence is taken.
+	Create(ctx context.Context, record *Record) error
+	Get(ctx context.Context, txID string) (*Record, error)
+	GetBySequence(ctx context.Context, profileID string, sequence int64) (*Record, error)
+	// FindExpired returns records which are not purged yet and expired before the given time.
+	FindExpired(ctx context.Context, before time.Time, limit int) ([]*Record, error)
+	// MarkPurged marks the record as purged unless it is already purged, in which case ErrDataNotFound is returned.
+	MarkPurged(ctx context.Context, txID string) error
+}
+
+type payloadStore interface {
+	Put(ctx context.Context, txID string, data []byte) error
+	Get(ctx context.Context, txID string) ([]byte, error)
+	Delete(ctx context.Context, txID string) error
+}
+
+type dataProtector interface {
+	Encrypt(ctx context.Context, msg []byte) (*dataprotect.EncryptedData, error)
+	Decrypt(ctx context.Context, encryptedData *dataprotect.EncryptedData) ([]byte, error)
+}
+
+type kmsRegistry interface {
+	GetKeyManager(config *vcskms.Config) (vcskms.VCSKeyManager, error)
+}
+
+type proofChecker interface {
+	CheckJWTProof(headers jose.Headers, expectedProofIssuer string, msg, signature []byte) error
+}
+
+type profileService interface {
+	GetProfile(profileID profileapi.ID, profileVersion profileapi.Version) (*profileapi.Verifier, error)
+}
+
+// Config defines dependencies for Service.
+type Config struct {
+	Store         store
+	PayloadStore  payloadStore
+	DataProtector dataProtector
+	// KMSRegistry provides the key manager of the profile to sign archive records.
+	KMSRegistry kmsRegistry
+	// ProofChecker checks signatures of archive records.
+	ProofChecker   proofChecker
+	ProfileService profileService
+	// PurgeInterval is an interval of purging presentations with expired retention period. Defaults to 1h.
+	PurgeInterval time.Duration
+}
+
+// Service archives presentations received in OIDC4VP interactions. Presentations are encrypted and linked
+// in a hash chain per verifier profile, so that modification or removal of an archived presentation
+// before the end of its retention period is detected on retrieval. Each record is signed with the signing key
+// of the profile when it is appended as the head of the chain, so the chain can not be rebuilt without the key.
+type Service struct {
+	store          store
+	payloadStore   payloadStore
+	dataProtector  dataProtector
+	kmsRegistry    kmsRegistry
+	proofChecker   proofChecker
+	profileService profileService
+	purgeInterval  time.Duration
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// chainLink is the content of the record covered by the record hash.
+type chainLink struct {
+	TxID           string `json:"txId"`
+	ProfileID      string `json:"profileId"`
+	ProfileVersion string `json:"profileVersion"`
+	Sequence       int64  `json:"sequence"`
+	PrevHash       string `json:"prevHash"`
+	PayloadDigest  string `json:"payloadDigest"`
+	CreatedAt      int64  `json:"createdAt"`
+	ExpireAt       int64  `json:"expireAt"`
+}
+
+// recordClaims are the claims of the record signature.
+type recordClaims struct {
+	Issuer    string `json:"iss"`
+	IssuedAt  int64  `json:"iat"`
+	ProfileID string `json:"profile_id"`
+	Sequence  int64  `json:"sequence"`
+	Hash      string `json:"record_hash"`
+}
+
+// payloadBinding is the additional authenticated data of the encrypted presentation. It binds the presentation
+// to the transaction and the profile, so the payload of one record can not be served for another.
+type payloadBinding struct {
+	TxID           string `json:"txId"`
+	ProfileID      string `json:"profileId"`
+	ProfileVersion string `json:"profileVersion"`
+}
+
+// New returns a new Service instance.
+func New(config *Config) *Service {
+	purgeInterval := config.PurgeInterval
+	if purgeInterval <= 0 {
+		purgeInterval = defaultPurgeInterval
+	}
+
+	return &Service{
+		store:          config.Store,
+		payloadStore:   config.PayloadStore,
+		dataProtector:  config.DataProtector,
+		kmsRegistry:    config.KMSRegistry,
+		proofChecker:   config.ProofChecker,
+		profileService: config.ProfileService,
+		purgeInterval:  purgeInterval,
+		stop:           make(chan struct{}),
+	}
+}
+
+// Archive encrypts the presentation of the transaction with a new data key, stores it and appends it
+// to the hash chain of the profile. The data key is stored with the record, wrapped with the key encryption key.
+func (s *Service) Archive(
+	ctx context.Context,
+	profile *profileapi.Verifier,
+	txID string,
+	presentation *Presentation,
+) error {
+	if profile.PresentationArchive == nil {
+		return errors.New("presentation archive is not configured")
+	}
+
+	if profile.SigningDID == nil {
+		return errors.New("signing did is not set")
+	}
+
+	data, err := json.Marshal(presentation)
+	if err != nil {
+		return fmt.Errorf("marshal presentation: %w", err)
+	}
+
+	dataKey := make([]byte, dataKeySize)
+
+	if _, err = rand.Read(dataKey); err != nil {
+		return fmt.Errorf("generate data key: %w", err)
+	}
+
+	aad, err := json.Marshal(&payloadBinding{
+		TxID:           txID,
+		ProfileID:      profile.ID,
+		ProfileVersion: profile.Version,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal payload binding: %w", err)
+	}
+
+	payload, err := seal(dataKey, data, aad)
+	if err != nil {
+		return fmt.Errorf("encrypt presentation: %w", err)
+	}
+
+	wrappedKey, err := s.dataProtector.Encrypt(ctx, dataKey)
+	if err != nil {
+		return fmt.Errorf("encrypt data key: %w", err)
+	}
+
+	encryptedKey, err := json.Marshal(wrappedKey)
+	if err != nil {
+		return fmt.Errorf("marshal encrypted data key: %w", err)
+	}
+
+	if err = s.payloadStore.Put(ctx, txID, payload); err != nil {
+		return fmt.Errorf("store presentation: %w", err)
+	}
+
+	// Timestamps are truncated to milliseconds as stores may not keep a higher precision.
+	createdAt := time.Now().UTC().Truncate(time.Millisecond)
+
+	record := &Record{
+		TxID:           txID,
+		ProfileID:      profile.ID,
+		ProfileVersion: profile.Version,
+		PayloadDigest:  digest(payload),
+		EncryptedKey:   encryptedKey,
+		CreatedAt:      createdAt,
+		ExpireAt:       createdAt.Add(profile.PresentationArchive.RetentionPeriod()),
+	}
+
+	if err = s.appendToChain(ctx, profile, record); err != nil {
+		if deleteErr := s.payloadStore.Delete(ctx, txID); deleteErr != nil {
+			logger.Warnc(ctx, "Failed to delete presentation not added to archive chain",
+				logfields.WithTransactionID(txID), log.WithError(deleteErr))
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// appendToChain links the record to the last record of the profile chain, signs it and stores it. Appending
+// is retried if a concurrent archive took the sequence number.
+func (s *Service) appendToChain(ctx context.Context, profile *profileapi.Verifier, record *Record) error {
+	for i := 0; i < maxAppendRetries; i++ {
+		latest, err := s.store.Latest(ctx, record.ProfileID)
+		if err != nil && !errors.Is(err, ErrDataNotFound) {
+			return fmt.Errorf("get latest archive record: %w", err)
+		}
+
+		record.Sequence = 1
+		record.PrevHash = ""
+
+		if latest != nil {
+			record.Sequence = latest.Sequence + 1
+			record.PrevHash = latest.Hash
+		}
+
+		record.Hash, err = hashRecord(record)
+		if err != nil {
+			return err
+		}
+
+		record.Signature, err = s.signRecord(profile, record)
+		if err != nil {
+			return err
+		}
+
+		err = s.store.Create(ctx, record)
+		if errors.Is(err, ErrChainConflict) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("create archive record: %w", err)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("append to archive chain after %d retries: %w", maxAppendRetries, ErrChainConflict)
+}
+
+// Get returns the archived presentation of the transaction. ErrIntegrity is returned if the presentation
+// or its record does not match the hash chain. ErrDataNotFound is returned if the presentation was not archived
+// or its retention period is over.
+func (s *Service) Get(ctx context.Context, txID string) (*ArchivedPresentation, error) {
+	record, err := s.GetRecord(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetPresentation(ctx, record)
+}
+
+// GetRecord returns the hash chain record of the archived presentation of the transaction without decrypting
+// the presentation, so the profile of the record can be checked first. ErrDataNotFound is returned
+// if the presentation was not archived or its retention period is over.
+func (s *Service) GetRecord(ctx context.Context, txID string) (*Record, error) {
+	record, err := s.store.Get(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Purged || record.ExpireAt.Before(time.Now()) {
+		return nil, ErrDataNotFound
+	}
+
+	return record, nil
+}
+
+// GetPresentation verifies the record against the hash chain and decrypts its archived presentation.
+// ErrIntegrity is returned if the presentation or its record does not match the hash chain.
+func (s *Service) GetPresentation(ctx context.Context, record *Record) (*ArchivedPresentation, error) {
+	if err := s.verifyRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	payload, err := s.payloadStore.Get(ctx, record.TxID)
+	if err != nil {
+		if errors.Is(err, ErrDataNotFound) {
+			return nil, fmt.Errorf("%w: presentation is missing", ErrIntegrity)
+		}
+
+		return nil, fmt.Errorf("get presentation: %w", err)
+	}
+
+	if digest(payload) != record.PayloadDigest {
+		return nil, fmt.Errorf("%w: presentation digest mismatch", ErrIntegrity)
+	}
+
+	data, err := s.decryptPayload(ctx, record, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var presentation Presentation
+
+	if err = json.Unmarshal(data, &presentation); err != nil {
+		return nil, fmt.Errorf("unmarshal presentation: %w", err)
+	}
+
+	return &ArchivedPresentation{
+		Record:       record,
+		Presentation: &presentation,
+	}, nil
+}
+
+// verifyRecord checks the hash and the signature of the record and its links to the previous and the next
+// records of the chain.
+func (s *Service) verifyRecord(ctx context.Context, record *Record) error {
+	hash, err := hashRecord(record)
+	if err != nil {
+		return err
+	}
+
+	if hash != record.Hash {
+		return fmt.Errorf("%w: record hash mismatch", ErrIntegrity)
+	}
+
+	if err = s.checkSignature(record); err != nil {
+		return err
+	}
+
+	if err = s.checkPrevLink(ctx, record); err != nil {
+		return err
+	}
+
+	return s.checkNextLink(ctx, record)
+}
+
+func (s *Service) checkPrevLink(ctx context.Context, record *Record) error {
+	if record.Sequence <= 1 {
+		if record.PrevHash != "" {
+			return fmt.Errorf("%w: unexpected previous hash of the first record", ErrIntegrity)
+		}
+
+		return nil
+	}
+
+	prev, err := s.store.GetBySequence(ctx, record.ProfileID, record.Sequence-1)
+	if err != nil {
+		if errors.Is(err, ErrDataNotFound) {
+			return fmt.Errorf("%w: previous record is missing", ErrIntegrity)
+		}
+
+		return fmt.Errorf("get previous archive record: %w", err)
+	}
+
+	if prev.Hash != record.PrevHash {
+		return fmt.Errorf("%w: previous record hash mismatch", ErrIntegrity)
+	}
+
+	return nil
+}
+
+// checkNextLink checks that the next record of the chain links to the record. If there is no next record,
+// the record must be the head of the chain.
+func (s *Service) checkNextLink(ctx context.Context, record *Record) error {
+	next, err := s.store.GetBySequence(ctx, record.ProfileID, record.Sequence+1)
+	if err == nil {
+		if next.PrevHash != record.Hash {
+			return fmt.Errorf("%w: next record hash mismatch", ErrIntegrity)
+		}
+
+		return nil
+	}
+
+	if !errors.Is(err, ErrDataNotFound) {
+		return fmt.Errorf("get next archive record: %w", err)
+	}
+
+	latest, err := s.store.Latest(ctx, record.ProfileID)
+	if err != nil {
+		return fmt.Errorf("get latest archive record: %w", err)
+	}
+
+	if latest.Sequence != record.Sequence {
+		return fmt.Errorf("%w: next record is missing", ErrIntegrity)
+	}
+
+	return nil
+}
+
+// signRecord signs the record hash with the signing DID of the profile. Signature is a JWT.
+func (s *Service) signRecord(profile *profileapi.Verifier, record *Record) (string, error) {
+	if profile.OIDCConfig == nil {
+		return "", errors.New("signing key type is not set")
+	}
+
+	keyType := profile.OIDCConfig.KeyType
+
+	signatureTypes := vcsverifiable.GetSignatureTypesByKeyTypeFormat(keyType, vcsverifiable.Jwt)
+	if len(signatureTypes) == 0 {
+		return "", fmt.Errorf("unsupported jwt key type %s", keyType)
+	}
+
+	km, err := s.kmsRegistry.GetKeyManager(profile.KMSConfig)
+	if err != nil {
+		return "", fmt.Errorf("get key manager: %w", err)
+	}
+
+	vcsSigner, err := km.NewVCSigner(profile.SigningDID.KMSKeyID, signatureTypes[0])
+	if err != nil {
+		return "", fmt.Errorf("create signer: %w", err)
+	}
+
+	token, err := jwt.NewJoseSigned(&recordClaims{
+		Issuer:    profile.SigningDID.DID,
+		IssuedAt:  record.CreatedAt.Unix(),
+		ProfileID: record.ProfileID,
+		Sequence:  record.Sequence,
+		Hash:      record.Hash,
+	}, nil, jws.NewSigner(profile.SigningDID.Creator, vcsSigner.Alg(), vcsSigner))
+	if err != nil {
+		return "", fmt.Errorf("sign archive record: %w", err)
+	}
+
+	signed, err := token.Serialize(false)
+	if err != nil {
+		return "", fmt.Errorf("serialize archive record signature: %w", err)
+	}
+
+	return signed, nil
+}
+
+// checkSignature checks that the record is signed by the signing DID of its profile.
+func (s *Service) checkSignature(record *Record) error {
+	if record.Signature == "" {
+		return fmt.Errorf("%w: record signature is missing", ErrIntegrity)
+	}
+
+	profile, err := s.profileService.GetProfile(record.ProfileID, record.ProfileVersion)
+	if err != nil {
+		return fmt.Errorf("get profile: %w", err)
+	}
+
+	if profile.SigningDID == nil {
+		return errors.New("signing did is not set")
+	}
+
+	token, _, err := jwt.ParseAndCheckProof(record.Signature, s.proofChecker, true)
+	if err != nil {
+		return fmt.Errorf("%w: check record signature: %w", ErrIntegrity, err)
+	}
+
+	var claims recordClaims
+
+	if err = token.DecodeClaims(&claims); err != nil {
+		return fmt.Errorf("%w: decode record signature claims: %w", ErrIntegrity, err)
+	}
+
+	if claims.Issuer != profile.SigningDID.DID {
+		return fmt.Errorf("%w: record is not signed by the profile", ErrIntegrity)
+	}
+
+	if claims.ProfileID != record.ProfileID || claims.Sequence != record.Sequence || claims.Hash != record.Hash {
+		return fmt.Errorf("%w: record signature mismatch", ErrIntegrity)
+	}
+
+	return nil
+}
+
+// decryptPayload unwraps the data key of the record and decrypts the presentation. Decryption fails if
+// the payload is not bound to the transaction and the profile of the record.
+func (s *Service) decryptPayload(ctx context.Context, record *Record, payload []byte) ([]byte, error) {
+	if len(record.EncryptedKey) == 0 {
+		return nil, fmt.Errorf("%w: data key is missing", ErrIntegrity)
+	}
+
+	var wrappedKey dataprotect.EncryptedData
+
+	if err := json.Unmarshal(record.EncryptedKey, &wrappedKey); err != nil {
+		return nil, fmt.Errorf("unmarshal encrypted data key: %w", err)
+	}
+
+	dataKey, err := s.dataProtector.Decrypt(ctx, &wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt data key: %w", err)
+	}
+
+	aad, err := json.Marshal(&payloadBinding{
+		TxID:           record.TxID,
+		ProfileID:      record.ProfileID,
+		ProfileVersion: record.ProfileVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal payload binding: %w", err)
+	}
+
+	data, err := open(dataKey, payload, aad)
+	if err != nil {
+		return nil, fmt.Errorf("%w: decrypt presentation: %w", ErrIntegrity, err)
+	}
+
+	return data, nil
+}
+
+// Purge crypto-shreds presentations with expired retention period: the data key of the presentation is removed
+// from the record, so the encrypted presentation can not be decrypted anymore, including its copies kept
+// by the payload store (e.g. object versions). The encrypted presentation is deleted afterwards. Purged records
+// keep the digests and signatures only, so the hash chain stays verifiable. Returns the number of purged
+// presentations. Purge is run by every instance, records already purged by another instance are skipped.
+func (s *Service) Purge(ctx context.Context) (int, error) {
+	purged := 0
+
+	for {
+		records, err := s.store.FindExpired(ctx, time.Now(), purgeBatchSize)
+		if err != nil {
+			return purged, fmt.Errorf("find expired archive records: %w", err)
+		}
+
+		for _, record := range records {
+			err = s.store.MarkPurged(ctx, record.TxID)
+			if errors.Is(err, ErrDataNotFound) {
+				continue
+			}
+
+			if err != nil {
+				return purged, fmt.Errorf("mark archive record purged: %w", err)
+			}
+
+			err = s.payloadStore.Delete(ctx, record.TxID)
+			if err != nil && !errors.Is(err, ErrDataNotFound) {
+				logger.Warnc(ctx, "Failed to delete purged presentation",
+					logfields.WithTransactionID(record.TxID), log.WithError(err))
+			}
+
+			purged++
+		}
+
+		if len(records) < purgeBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// Start starts purging presentations with expired retention period in the background.
+func (s *Service) Start() {
+	go func() {
+		ticker := time.NewTicker(s.purgeInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				ctx := context.Background()
+
+				purged, err := s.Purge(ctx)
+				if err != nil {
+					logger.Errorc(ctx, "Failed to purge archived presentations", log.WithError(err))
+				}
+
+				if purged > 0 {
+					logger.Infoc(ctx, fmt.Sprintf("Purged %d archived presentations", purged))
+				}
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops purging presentations.
+func (s *Service) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+}
+
+func hashRecord(record *Record) (string, error) {
+	b, err := json.Marshal(&chainLink{
+		TxID:           record.TxID,
+		ProfileID:      record.ProfileID,
+		ProfileVersion: record.ProfileVersion,
+		Sequence:       record.Sequence,
+		PrevHash:       record.PrevHash,
+		PayloadDigest:  record.PayloadDigest,
+		CreatedAt:      record.CreatedAt.UnixMilli(),
+		ExpireAt:       record.ExpireAt.UnixMilli(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal archive record: %w", err)
+	}
+
+	return digest(b), nil
+}
+
+// seal encrypts data with AES-GCM. Nonce is prepended to the ciphertext.
+func seal(key, data, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+
+	return gcm.Seal(nonce, nonce, data, aad), nil
+}
+
+func open(key, sealed, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+
+	return gcm.Open(nil, nonce, ciphertext, aad)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create gcm: %w", err)
+	}
+
+	return gcm, nil
+}
+
+func digest(data []byte) string {
+	h := sha256.Sum256(data)
+
+	return base64.RawURLEncoding.EncodeToString(h[:])
+}
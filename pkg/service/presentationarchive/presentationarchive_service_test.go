@@ -0,0 +1,893 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package presentationarchive_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/did-go/legacy/mock/storage"
+	arieskms "github.com/trustbloc/kms-go/kms"
+	"github.com/trustbloc/kms-go/secretlock/noop"
+	"github.com/trustbloc/kms-go/spi/kms"
+	"github.com/trustbloc/kms-go/wrapper/localsuite"
+	"github.com/trustbloc/vc-go/jwt"
+
+	"github.com/trustbloc/vcs/internal/mock/vcskms"
+	"github.com/trustbloc/vcs/pkg/dataprotect"
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/service/presentationarchive"
+)
+
+const (
+	profileID      = "profile1"
+	profileVersion = "v1.0"
+	verifierDID    = "did:example:verifier"
+)
+
+func TestService_Archive(t *testing.T) {
+	profile, keyManager := testProfile(t)
+
+	t.Run("Success - first record of the chain", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		payloadStore.EXPECT().Put(gomock.Any(), "tx1", gomock.Any()).Return(nil)
+		store.EXPECT().Latest(gomock.Any(), profileID).Return(nil, presentationarchive.ErrDataNotFound)
+		store.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, record *presentationarchive.Record) error {
+				require.Equal(t, "tx1", record.TxID)
+				require.Equal(t, profileID, record.ProfileID)
+				require.Equal(t, profileVersion, record.ProfileVersion)
+				require.EqualValues(t, 1, record.Sequence)
+				require.Empty(t, record.PrevHash)
+				require.NotEmpty(t, record.Hash)
+				require.NotEmpty(t, record.PayloadDigest)
+				require.NotEmpty(t, record.EncryptedKey)
+				require.Equal(t, 30*24*time.Hour, record.ExpireAt.Sub(record.CreatedAt))
+
+				token, _, err := jwt.Parse(record.Signature)
+				require.NoError(t, err)
+
+				kid, ok := token.Headers.KeyID()
+				require.True(t, ok)
+				require.Equal(t, profile.SigningDID.Creator, kid)
+
+				var claims struct {
+					Issuer    string `json:"iss"`
+					ProfileID string `json:"profile_id"`
+					Sequence  int64  `json:"sequence"`
+					Hash      string `json:"record_hash"`
+				}
+
+				require.NoError(t, token.DecodeClaims(&claims))
+				require.Equal(t, verifierDID, claims.Issuer)
+				require.Equal(t, profileID, claims.ProfileID)
+				require.EqualValues(t, 1, claims.Sequence)
+				require.Equal(t, record.Hash, claims.Hash)
+
+				return nil
+			})
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			Store:         store,
+			PayloadStore:  payloadStore,
+			DataProtector: dataprotect.NewNilDataProtector(),
+			KMSRegistry:   newKMSRegistry(t, keyManager),
+		})
+
+		require.NoError(t, s.Archive(context.Background(), profile, "tx1", testPresentation()))
+	})
+
+	t.Run("Success - retry on chain conflict", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		payloadStore.EXPECT().Put(gomock.Any(), "tx1", gomock.Any()).Return(nil)
+
+		gomock.InOrder(
+			store.EXPECT().Latest(gomock.Any(), profileID).Return(&presentationarchive.Record{
+				Sequence: 5,
+				Hash:     "hash5",
+			}, nil),
+			store.EXPECT().Create(gomock.Any(), gomock.Any()).Return(presentationarchive.ErrChainConflict),
+			store.EXPECT().Latest(gomock.Any(), profileID).Return(&presentationarchive.Record{
+				Sequence: 6,
+				Hash:     "hash6",
+			}, nil),
+			store.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, record *presentationarchive.Record) error {
+					require.EqualValues(t, 7, record.Sequence)
+					require.Equal(t, "hash6", record.PrevHash)
+
+					return nil
+				}),
+		)
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			Store:         store,
+			PayloadStore:  payloadStore,
+			DataProtector: dataprotect.NewNilDataProtector(),
+			KMSRegistry:   newKMSRegistry(t, keyManager),
+		})
+
+		require.NoError(t, s.Archive(context.Background(), profile, "tx1", testPresentation()))
+	})
+
+	t.Run("Error - archive is not configured", func(t *testing.T) {
+		s := presentationarchive.New(&presentationarchive.Config{})
+
+		require.ErrorContains(t, s.Archive(context.Background(), &profileapi.Verifier{}, "tx1", testPresentation()),
+			"presentation archive is not configured")
+	})
+
+	t.Run("Error - signing did is not set", func(t *testing.T) {
+		s := presentationarchive.New(&presentationarchive.Config{})
+
+		noDID := *profile
+		noDID.SigningDID = nil
+
+		require.ErrorContains(t, s.Archive(context.Background(), &noDID, "tx1", testPresentation()),
+			"signing did is not set")
+	})
+
+	t.Run("Error - encrypt data key", func(t *testing.T) {
+		dataProtector := NewMockDataProtector(gomock.NewController(t))
+		dataProtector.EXPECT().Encrypt(gomock.Any(), gomock.Any()).Return(nil, errors.New("encrypt error"))
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			DataProtector: dataProtector,
+		})
+
+		require.ErrorContains(t, s.Archive(context.Background(), profile, "tx1", testPresentation()),
+			"encrypt data key: encrypt error")
+	})
+
+	t.Run("Error - store presentation", func(t *testing.T) {
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+		payloadStore.EXPECT().Put(gomock.Any(), "tx1", gomock.Any()).Return(errors.New("put error"))
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			PayloadStore:  payloadStore,
+			DataProtector: dataprotect.NewNilDataProtector(),
+		})
+
+		require.ErrorContains(t, s.Archive(context.Background(), profile, "tx1", testPresentation()),
+			"store presentation: put error")
+	})
+
+	t.Run("Error - get latest record", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		payloadStore.EXPECT().Put(gomock.Any(), "tx1", gomock.Any()).Return(nil)
+		store.EXPECT().Latest(gomock.Any(), profileID).Return(nil, errors.New("latest error"))
+		payloadStore.EXPECT().Delete(gomock.Any(), "tx1").Return(errors.New("delete error"))
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			Store:         store,
+			PayloadStore:  payloadStore,
+			DataProtector: dataprotect.NewNilDataProtector(),
+		})
+
+		require.ErrorContains(t, s.Archive(context.Background(), profile, "tx1", testPresentation()),
+			"get latest archive record: latest error")
+	})
+
+	t.Run("Error - signing key type is not set", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		payloadStore.EXPECT().Put(gomock.Any(), "tx1", gomock.Any()).Return(nil)
+		store.EXPECT().Latest(gomock.Any(), profileID).Return(nil, presentationarchive.ErrDataNotFound)
+		payloadStore.EXPECT().Delete(gomock.Any(), "tx1").Return(nil)
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			Store:         store,
+			PayloadStore:  payloadStore,
+			DataProtector: dataprotect.NewNilDataProtector(),
+		})
+
+		noKeyType := *profile
+		noKeyType.OIDCConfig = nil
+
+		require.ErrorContains(t, s.Archive(context.Background(), &noKeyType, "tx1", testPresentation()),
+			"signing key type is not set")
+	})
+
+	t.Run("Error - get key manager", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+		kmsRegistry := NewMockKMSRegistry(gomock.NewController(t))
+
+		payloadStore.EXPECT().Put(gomock.Any(), "tx1", gomock.Any()).Return(nil)
+		store.EXPECT().Latest(gomock.Any(), profileID).Return(nil, presentationarchive.ErrDataNotFound)
+		kmsRegistry.EXPECT().GetKeyManager(gomock.Any()).Return(nil, errors.New("kms error"))
+		payloadStore.EXPECT().Delete(gomock.Any(), "tx1").Return(nil)
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			Store:         store,
+			PayloadStore:  payloadStore,
+			DataProtector: dataprotect.NewNilDataProtector(),
+			KMSRegistry:   kmsRegistry,
+		})
+
+		require.ErrorContains(t, s.Archive(context.Background(), profile, "tx1", testPresentation()),
+			"get key manager: kms error")
+	})
+
+	t.Run("Error - create record", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		payloadStore.EXPECT().Put(gomock.Any(), "tx1", gomock.Any()).Return(nil)
+		store.EXPECT().Latest(gomock.Any(), profileID).Return(nil, presentationarchive.ErrDataNotFound)
+		store.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("create error"))
+		payloadStore.EXPECT().Delete(gomock.Any(), "tx1").Return(nil)
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			Store:         store,
+			PayloadStore:  payloadStore,
+			DataProtector: dataprotect.NewNilDataProtector(),
+			KMSRegistry:   newKMSRegistry(t, keyManager),
+		})
+
+		require.ErrorContains(t, s.Archive(context.Background(), profile, "tx1", testPresentation()),
+			"create archive record: create error")
+	})
+
+	t.Run("Error - chain conflict retries exceeded", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		payloadStore.EXPECT().Put(gomock.Any(), "tx1", gomock.Any()).Return(nil)
+		store.EXPECT().Latest(gomock.Any(), profileID).Return(nil, presentationarchive.ErrDataNotFound).Times(10)
+		store.EXPECT().Create(gomock.Any(), gomock.Any()).Return(presentationarchive.ErrChainConflict).Times(10)
+		payloadStore.EXPECT().Delete(gomock.Any(), "tx1").Return(nil)
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			Store:         store,
+			PayloadStore:  payloadStore,
+			DataProtector: dataprotect.NewNilDataProtector(),
+			KMSRegistry:   newKMSRegistry(t, keyManager),
+		})
+
+		err := s.Archive(context.Background(), profile, "tx1", testPresentation())
+		require.ErrorIs(t, err, presentationarchive.ErrChainConflict)
+		require.ErrorContains(t, err, "append to archive chain after 10 retries")
+	})
+}
+
+func TestService_Get(t *testing.T) {
+	profile, keyManager := testProfile(t)
+
+	first, firstPayload := archive(t, profile, keyManager, "tx1", nil)
+	second, secondPayload := archive(t, profile, keyManager, "tx2", first)
+
+	// newService returns the service which checks records of the profile, record signatures are accepted
+	// unless proofErr is set.
+	newService := func(
+		t *testing.T,
+		store *MockStore,
+		payloadStore *MockPayloadStore,
+		proofErr error,
+	) *presentationarchive.Service {
+		t.Helper()
+
+		profileService := NewMockProfileService(gomock.NewController(t))
+		profileService.EXPECT().GetProfile(profileID, profileVersion).Return(profile, nil).AnyTimes()
+
+		proofChecker := NewMockProofChecker(gomock.NewController(t))
+		proofChecker.EXPECT().CheckJWTProof(gomock.Any(), verifierDID, gomock.Any(), gomock.Any()).
+			Return(proofErr).AnyTimes()
+
+		return presentationarchive.New(&presentationarchive.Config{
+			Store:          store,
+			PayloadStore:   payloadStore,
+			DataProtector:  dataprotect.NewNilDataProtector(),
+			ProofChecker:   proofChecker,
+			ProfileService: profileService,
+		})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		store.EXPECT().Get(gomock.Any(), "tx2").Return(second, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(1)).Return(first, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(3)).
+			Return(nil, presentationarchive.ErrDataNotFound)
+		store.EXPECT().Latest(gomock.Any(), profileID).Return(second, nil)
+		payloadStore.EXPECT().Get(gomock.Any(), "tx2").Return(secondPayload, nil)
+
+		archived, err := newService(t, store, payloadStore, nil).Get(context.Background(), "tx2")
+		require.NoError(t, err)
+		require.Equal(t, second, archived.Record)
+		require.Equal(t, testPresentation(), archived.Presentation)
+	})
+
+	t.Run("Success - first record", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		store.EXPECT().Get(gomock.Any(), "tx1").Return(first, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(2)).Return(second, nil)
+		payloadStore.EXPECT().Get(gomock.Any(), "tx1").Return(firstPayload, nil)
+
+		archived, err := newService(t, store, payloadStore, nil).Get(context.Background(), "tx1")
+		require.NoError(t, err)
+		require.Equal(t, testPresentation(), archived.Presentation)
+	})
+
+	t.Run("Error - not found", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), "tx1").Return(nil, presentationarchive.ErrDataNotFound)
+
+		s := presentationarchive.New(&presentationarchive.Config{Store: store})
+
+		_, err := s.Get(context.Background(), "tx1")
+		require.ErrorIs(t, err, presentationarchive.ErrDataNotFound)
+	})
+
+	t.Run("Error - purged", func(t *testing.T) {
+		purged := *first
+		purged.Purged = true
+
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), "tx1").Return(&purged, nil)
+
+		s := presentationarchive.New(&presentationarchive.Config{Store: store})
+
+		_, err := s.Get(context.Background(), "tx1")
+		require.ErrorIs(t, err, presentationarchive.ErrDataNotFound)
+	})
+
+	t.Run("Error - retention period is over", func(t *testing.T) {
+		expired := *first
+		expired.ExpireAt = time.Now().Add(-time.Minute)
+
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), "tx1").Return(&expired, nil)
+
+		s := presentationarchive.New(&presentationarchive.Config{Store: store})
+
+		_, err := s.Get(context.Background(), "tx1")
+		require.ErrorIs(t, err, presentationarchive.ErrDataNotFound)
+	})
+
+	t.Run("Error - record modified", func(t *testing.T) {
+		modified := *second
+		modified.ExpireAt = modified.ExpireAt.Add(time.Hour)
+
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), "tx2").Return(&modified, nil)
+
+		_, err := newService(t, store, nil, nil).Get(context.Background(), "tx2")
+		require.ErrorIs(t, err, presentationarchive.ErrIntegrity)
+		require.ErrorContains(t, err, "record hash mismatch")
+	})
+
+	t.Run("Error - signature is missing", func(t *testing.T) {
+		unsigned := *second
+		unsigned.Signature = ""
+
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), "tx2").Return(&unsigned, nil)
+
+		_, err := newService(t, store, nil, nil).Get(context.Background(), "tx2")
+		require.ErrorIs(t, err, presentationarchive.ErrIntegrity)
+		require.ErrorContains(t, err, "record signature is missing")
+	})
+
+	t.Run("Error - invalid signature", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), "tx2").Return(second, nil)
+
+		_, err := newService(t, store, nil, errors.New("invalid signature")).Get(context.Background(), "tx2")
+		require.ErrorIs(t, err, presentationarchive.ErrIntegrity)
+		require.ErrorContains(t, err, "check record signature")
+	})
+
+	t.Run("Error - signature of another record", func(t *testing.T) {
+		resigned := *second
+		resigned.Signature = first.Signature
+
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), "tx2").Return(&resigned, nil)
+
+		_, err := newService(t, store, nil, nil).Get(context.Background(), "tx2")
+		require.ErrorIs(t, err, presentationarchive.ErrIntegrity)
+		require.ErrorContains(t, err, "record signature mismatch")
+	})
+
+	t.Run("Error - record is not signed by the profile", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), "tx2").Return(second, nil)
+
+		otherDID := *profile
+		otherDID.SigningDID = &profileapi.SigningDID{DID: "did:example:other"}
+
+		profileService := NewMockProfileService(gomock.NewController(t))
+		profileService.EXPECT().GetProfile(profileID, profileVersion).Return(&otherDID, nil)
+
+		proofChecker := NewMockProofChecker(gomock.NewController(t))
+		proofChecker.EXPECT().CheckJWTProof(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			Store:          store,
+			ProofChecker:   proofChecker,
+			ProfileService: profileService,
+		})
+
+		_, err := s.Get(context.Background(), "tx2")
+		require.ErrorIs(t, err, presentationarchive.ErrIntegrity)
+		require.ErrorContains(t, err, "record is not signed by the profile")
+	})
+
+	t.Run("Error - get profile", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), "tx2").Return(second, nil)
+
+		profileService := NewMockProfileService(gomock.NewController(t))
+		profileService.EXPECT().GetProfile(profileID, profileVersion).Return(nil, errors.New("profile error"))
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			Store:          store,
+			ProfileService: profileService,
+		})
+
+		_, err := s.Get(context.Background(), "tx2")
+		require.ErrorContains(t, err, "get profile: profile error")
+	})
+
+	t.Run("Error - previous record is missing", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), "tx2").Return(second, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(1)).
+			Return(nil, presentationarchive.ErrDataNotFound)
+
+		_, err := newService(t, store, nil, nil).Get(context.Background(), "tx2")
+		require.ErrorIs(t, err, presentationarchive.ErrIntegrity)
+		require.ErrorContains(t, err, "previous record is missing")
+	})
+
+	t.Run("Error - previous record hash mismatch", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), "tx2").Return(second, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(1)).Return(second, nil)
+
+		_, err := newService(t, store, nil, nil).Get(context.Background(), "tx2")
+		require.ErrorIs(t, err, presentationarchive.ErrIntegrity)
+		require.ErrorContains(t, err, "previous record hash mismatch")
+	})
+
+	t.Run("Error - get previous record", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), "tx2").Return(second, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(1)).Return(nil, errors.New("get error"))
+
+		_, err := newService(t, store, nil, nil).Get(context.Background(), "tx2")
+		require.ErrorContains(t, err, "get previous archive record: get error")
+	})
+
+	t.Run("Error - next record hash mismatch", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), "tx1").Return(first, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(2)).Return(first, nil)
+
+		_, err := newService(t, store, nil, nil).Get(context.Background(), "tx1")
+		require.ErrorIs(t, err, presentationarchive.ErrIntegrity)
+		require.ErrorContains(t, err, "next record hash mismatch")
+	})
+
+	t.Run("Error - next record is missing", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), "tx1").Return(first, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(2)).
+			Return(nil, presentationarchive.ErrDataNotFound)
+		store.EXPECT().Latest(gomock.Any(), profileID).Return(&presentationarchive.Record{Sequence: 3}, nil)
+
+		_, err := newService(t, store, nil, nil).Get(context.Background(), "tx1")
+		require.ErrorIs(t, err, presentationarchive.ErrIntegrity)
+		require.ErrorContains(t, err, "next record is missing")
+	})
+
+	t.Run("Error - get next record", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), "tx1").Return(first, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(2)).Return(nil, errors.New("get error"))
+
+		_, err := newService(t, store, nil, nil).Get(context.Background(), "tx1")
+		require.ErrorContains(t, err, "get next archive record: get error")
+	})
+
+	t.Run("Error - get latest record", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Get(gomock.Any(), "tx1").Return(first, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(2)).
+			Return(nil, presentationarchive.ErrDataNotFound)
+		store.EXPECT().Latest(gomock.Any(), profileID).Return(nil, errors.New("latest error"))
+
+		_, err := newService(t, store, nil, nil).Get(context.Background(), "tx1")
+		require.ErrorContains(t, err, "get latest archive record: latest error")
+	})
+
+	t.Run("Error - presentation is missing", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		store.EXPECT().Get(gomock.Any(), "tx1").Return(first, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(2)).Return(second, nil)
+		payloadStore.EXPECT().Get(gomock.Any(), "tx1").Return(nil, presentationarchive.ErrDataNotFound)
+
+		_, err := newService(t, store, payloadStore, nil).Get(context.Background(), "tx1")
+		require.ErrorIs(t, err, presentationarchive.ErrIntegrity)
+		require.ErrorContains(t, err, "presentation is missing")
+	})
+
+	t.Run("Error - get presentation", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		store.EXPECT().Get(gomock.Any(), "tx1").Return(first, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(2)).Return(second, nil)
+		payloadStore.EXPECT().Get(gomock.Any(), "tx1").Return(nil, errors.New("get error"))
+
+		_, err := newService(t, store, payloadStore, nil).Get(context.Background(), "tx1")
+		require.ErrorContains(t, err, "get presentation: get error")
+	})
+
+	t.Run("Error - presentation modified", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		store.EXPECT().Get(gomock.Any(), "tx1").Return(first, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(2)).Return(second, nil)
+		payloadStore.EXPECT().Get(gomock.Any(), "tx1").Return(secondPayload, nil)
+
+		_, err := newService(t, store, payloadStore, nil).Get(context.Background(), "tx1")
+		require.ErrorIs(t, err, presentationarchive.ErrIntegrity)
+		require.ErrorContains(t, err, "presentation digest mismatch")
+	})
+
+	t.Run("Error - data key is missing", func(t *testing.T) {
+		noKey := *second
+		noKey.EncryptedKey = nil
+
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		store.EXPECT().Get(gomock.Any(), "tx2").Return(&noKey, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(1)).Return(first, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(3)).
+			Return(nil, presentationarchive.ErrDataNotFound)
+		store.EXPECT().Latest(gomock.Any(), profileID).Return(second, nil)
+		payloadStore.EXPECT().Get(gomock.Any(), "tx2").Return(secondPayload, nil)
+
+		_, err := newService(t, store, payloadStore, nil).Get(context.Background(), "tx2")
+		require.ErrorIs(t, err, presentationarchive.ErrIntegrity)
+		require.ErrorContains(t, err, "data key is missing")
+	})
+
+	t.Run("Error - data key of another record", func(t *testing.T) {
+		swapped := *second
+		swapped.EncryptedKey = first.EncryptedKey
+
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		store.EXPECT().Get(gomock.Any(), "tx2").Return(&swapped, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(1)).Return(first, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(3)).
+			Return(nil, presentationarchive.ErrDataNotFound)
+		store.EXPECT().Latest(gomock.Any(), profileID).Return(second, nil)
+		payloadStore.EXPECT().Get(gomock.Any(), "tx2").Return(secondPayload, nil)
+
+		_, err := newService(t, store, payloadStore, nil).Get(context.Background(), "tx2")
+		require.ErrorIs(t, err, presentationarchive.ErrIntegrity)
+		require.ErrorContains(t, err, "decrypt presentation")
+	})
+
+	t.Run("Error - decrypt data key", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+		dataProtector := NewMockDataProtector(gomock.NewController(t))
+
+		store.EXPECT().Get(gomock.Any(), "tx1").Return(first, nil)
+		store.EXPECT().GetBySequence(gomock.Any(), profileID, int64(2)).Return(second, nil)
+		payloadStore.EXPECT().Get(gomock.Any(), "tx1").Return(firstPayload, nil)
+		dataProtector.EXPECT().Decrypt(gomock.Any(), gomock.Any()).Return(nil, errors.New("decrypt error"))
+
+		profileService := NewMockProfileService(gomock.NewController(t))
+		profileService.EXPECT().GetProfile(profileID, profileVersion).Return(profile, nil)
+
+		proofChecker := NewMockProofChecker(gomock.NewController(t))
+		proofChecker.EXPECT().CheckJWTProof(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			Store:          store,
+			PayloadStore:   payloadStore,
+			DataProtector:  dataProtector,
+			ProofChecker:   proofChecker,
+			ProfileService: profileService,
+		})
+
+		_, err := s.Get(context.Background(), "tx1")
+		require.ErrorContains(t, err, "decrypt data key: decrypt error")
+	})
+}
+
+func TestService_Purge(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		store.EXPECT().FindExpired(gomock.Any(), gomock.Any(), 100).Return([]*presentationarchive.Record{
+			{TxID: "tx1"},
+			{TxID: "tx2"},
+		}, nil)
+
+		gomock.InOrder(
+			store.EXPECT().MarkPurged(gomock.Any(), "tx1").Return(nil),
+			payloadStore.EXPECT().Delete(gomock.Any(), "tx1").Return(nil),
+		)
+
+		gomock.InOrder(
+			store.EXPECT().MarkPurged(gomock.Any(), "tx2").Return(nil),
+			payloadStore.EXPECT().Delete(gomock.Any(), "tx2").Return(presentationarchive.ErrDataNotFound),
+		)
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			Store:        store,
+			PayloadStore: payloadStore,
+		})
+
+		purged, err := s.Purge(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 2, purged)
+	})
+
+	t.Run("Success - several batches", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		batch := make([]*presentationarchive.Record, 100)
+		for i := range batch {
+			batch[i] = &presentationarchive.Record{TxID: "tx"}
+		}
+
+		gomock.InOrder(
+			store.EXPECT().FindExpired(gomock.Any(), gomock.Any(), 100).Return(batch, nil),
+			store.EXPECT().FindExpired(gomock.Any(), gomock.Any(), 100).Return(nil, nil),
+		)
+
+		payloadStore.EXPECT().Delete(gomock.Any(), "tx").Return(nil).Times(100)
+		store.EXPECT().MarkPurged(gomock.Any(), "tx").Return(nil).Times(100)
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			Store:        store,
+			PayloadStore: payloadStore,
+		})
+
+		purged, err := s.Purge(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 100, purged)
+	})
+
+	t.Run("Success - delete presentation fails after data key is destroyed", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		store.EXPECT().FindExpired(gomock.Any(), gomock.Any(), 100).Return([]*presentationarchive.Record{
+			{TxID: "tx1"},
+		}, nil)
+		store.EXPECT().MarkPurged(gomock.Any(), "tx1").Return(nil)
+		payloadStore.EXPECT().Delete(gomock.Any(), "tx1").Return(errors.New("delete error"))
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			Store:        store,
+			PayloadStore: payloadStore,
+		})
+
+		purged, err := s.Purge(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, purged)
+	})
+
+	t.Run("Success - record purged by another instance is skipped", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		store.EXPECT().FindExpired(gomock.Any(), gomock.Any(), 100).Return([]*presentationarchive.Record{
+			{TxID: "tx1"},
+			{TxID: "tx2"},
+		}, nil)
+		store.EXPECT().MarkPurged(gomock.Any(), "tx1").Return(presentationarchive.ErrDataNotFound)
+		store.EXPECT().MarkPurged(gomock.Any(), "tx2").Return(nil)
+		payloadStore.EXPECT().Delete(gomock.Any(), "tx2").Return(nil)
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			Store:        store,
+			PayloadStore: payloadStore,
+		})
+
+		purged, err := s.Purge(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, purged)
+	})
+
+	t.Run("Error - find expired", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().FindExpired(gomock.Any(), gomock.Any(), 100).Return(nil, errors.New("find error"))
+
+		s := presentationarchive.New(&presentationarchive.Config{Store: store})
+
+		_, err := s.Purge(context.Background())
+		require.ErrorContains(t, err, "find expired archive records: find error")
+	})
+
+	t.Run("Error - mark purged", func(t *testing.T) {
+		store := NewMockStore(gomock.NewController(t))
+		payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+		store.EXPECT().FindExpired(gomock.Any(), gomock.Any(), 100).Return([]*presentationarchive.Record{
+			{TxID: "tx1"},
+		}, nil)
+		store.EXPECT().MarkPurged(gomock.Any(), "tx1").Return(errors.New("mark error"))
+
+		s := presentationarchive.New(&presentationarchive.Config{
+			Store:        store,
+			PayloadStore: payloadStore,
+		})
+
+		_, err := s.Purge(context.Background())
+		require.ErrorContains(t, err, "mark archive record purged: mark error")
+	})
+}
+
+func TestService_Start(t *testing.T) {
+	store := NewMockStore(gomock.NewController(t))
+
+	called := make(chan struct{}, 1)
+
+	store.EXPECT().FindExpired(gomock.Any(), gomock.Any(), 100).DoAndReturn(
+		func(context.Context, time.Time, int) ([]*presentationarchive.Record, error) {
+			select {
+			case called <- struct{}{}:
+			default:
+			}
+
+			return nil, errors.New("find error")
+		}).MinTimes(1)
+
+	s := presentationarchive.New(&presentationarchive.Config{
+		Store:         store,
+		PurgeInterval: 10 * time.Millisecond,
+	})
+
+	s.Start()
+
+	select {
+	case <-called:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "purge was not started")
+	}
+
+	s.Stop()
+	s.Stop()
+}
+
+// archive archives the test presentation and returns the stored record and payload.
+func archive(
+	t *testing.T,
+	profile *profileapi.Verifier,
+	keyManager *vcskms.MockKMS,
+	txID string,
+	prev *presentationarchive.Record,
+) (*presentationarchive.Record, []byte) {
+	t.Helper()
+
+	store := NewMockStore(gomock.NewController(t))
+	payloadStore := NewMockPayloadStore(gomock.NewController(t))
+
+	var (
+		record  *presentationarchive.Record
+		payload []byte
+	)
+
+	payloadStore.EXPECT().Put(gomock.Any(), txID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, data []byte) error {
+			payload = data
+
+			return nil
+		})
+
+	if prev != nil {
+		store.EXPECT().Latest(gomock.Any(), profileID).Return(prev, nil)
+	} else {
+		store.EXPECT().Latest(gomock.Any(), profileID).Return(nil, presentationarchive.ErrDataNotFound)
+	}
+
+	store.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r *presentationarchive.Record) error {
+			record = r
+
+			return nil
+		})
+
+	s := presentationarchive.New(&presentationarchive.Config{
+		Store:         store,
+		PayloadStore:  payloadStore,
+		DataProtector: dataprotect.NewNilDataProtector(),
+		KMSRegistry:   newKMSRegistry(t, keyManager),
+	})
+
+	require.NoError(t, s.Archive(context.Background(), profile, txID, testPresentation()))
+
+	return record, payload
+}
+
+func newKMSRegistry(t *testing.T, keyManager *vcskms.MockKMS) *MockKMSRegistry {
+	t.Helper()
+
+	kmsRegistry := NewMockKMSRegistry(gomock.NewController(t))
+	kmsRegistry.EXPECT().GetKeyManager(gomock.Any()).Return(keyManager, nil).AnyTimes()
+
+	return kmsRegistry
+}
+
+// testProfile returns the verifier profile with a new signing key and the key manager of the key.
+func testProfile(t *testing.T) (*profileapi.Verifier, *vcskms.MockKMS) {
+	t.Helper()
+
+	p, err := arieskms.NewAriesProviderWrapper(storage.NewMockStoreProvider())
+	require.NoError(t, err)
+
+	cryptoSuite, err := localsuite.NewLocalCryptoSuite("local-lock://custom/primary/key/", p, &noop.NoLock{})
+	require.NoError(t, err)
+
+	keyCreator, err := cryptoSuite.KeyCreator()
+	require.NoError(t, err)
+
+	pubKey, err := keyCreator.Create(kms.ED25519Type)
+	require.NoError(t, err)
+
+	signer, err := cryptoSuite.KMSCryptoMultiSigner()
+	require.NoError(t, err)
+
+	return &profileapi.Verifier{
+		ID:      profileID,
+		Version: profileVersion,
+		SigningDID: &profileapi.SigningDID{
+			DID:      verifierDID,
+			Creator:  verifierDID + "#" + pubKey.KeyID,
+			KMSKeyID: pubKey.KeyID,
+		},
+		OIDCConfig: &profileapi.OIDC4VPConfig{
+			KeyType: kms.ED25519Type,
+		},
+		PresentationArchive: &profileapi.PresentationArchiveConfig{
+			RetentionDays: 30,
+		},
+	}, &vcskms.MockKMS{Signer: signer}
+}
+
+func testPresentation() *presentationarchive.Presentation {
+	return &presentationarchive.Presentation{
+		VPTokens: []string{"eyJhbGciOiJFZERTQSJ9.eyJ2cCI6e319.c2lnbmF0dXJl"},
+		PresentationSubmission: map[string]interface{}{
+			"id":             "submission1",
+			"definition_id":  "pd1",
+			"descriptor_map": []interface{}{},
+		},
+	}
+}
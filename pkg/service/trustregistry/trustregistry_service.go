@@ -61,7 +61,7 @@ func NewService(config *Config) *Service {
 	}
 }
 
-// ValidateIssuance validates attestation VP and requests issuance policy evaluation.
+// ValidateIssuance validates attestation VP and evaluates issuance policy.
 func (s *Service) ValidateIssuance(
 	ctx context.Context,
 	profile *profileapi.Issuer,
@@ -71,11 +71,12 @@ func (s *Service) ValidateIssuance(
 		zap.String("profileID", profile.ID),
 		zap.String("profileVersion", profile.Version),
 		zap.String("policyURL", profile.Checks.Policy.PolicyURL),
+		zap.Bool("localPolicy", profile.Checks.Policy.Local != nil),
 		zap.String("attestationVP", data.AttestationVP),
 		zap.Strings("credentialTypes", data.CredentialTypes),
 	)
 
-	if profile.Checks.Policy.PolicyURL == "" {
+	if !profile.Checks.Policy.IsEnabled() {
 		return nil
 	}
 
@@ -84,38 +85,29 @@ func (s *Service) ValidateIssuance(
 		CredentialTypes: removeDuplicates(data.CredentialTypes),
 	}
 
+	var attestationVCs []*verifiable.Credential
+
 	if data.AttestationVP != "" {
 		if err := verifyAudience(data.AttestationVP, profile.SigningDID.DID); err != nil {
 			return fmt.Errorf("verify audience: %w", err)
 		}
 
-		attestationVCs, err := s.parseAttestationVP(data.AttestationVP, data.Nonce, true)
+		var err error
+
+		attestationVCs, err = s.parseAttestationVP(data.AttestationVP, data.Nonce, true)
 		if err != nil {
 			return err
 		}
 
-		req.AttestationVC = lo.ToPtr(attestationVCs)
-	}
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		return fmt.Errorf("marshal request: %w", err)
-	}
-
-	resp, err := s.requestPolicyEvaluation(ctx, profile.Checks.Policy.PolicyURL, payload)
-	if err != nil {
-		return fmt.Errorf("policy evaluation: %w", err)
-	}
-
-	if !resp.Allowed {
-		if resp.DenyReasons != nil && len(*resp.DenyReasons) > 0 {
-			return fmt.Errorf("%w: %s", ErrInteractionRestricted, lo.FromPtr(resp.DenyReasons))
+		jwtVCs, err := toJWTStrings(attestationVCs)
+		if err != nil {
+			return err
 		}
 
-		return ErrInteractionRestricted
+		req.AttestationVC = lo.ToPtr(jwtVCs)
 	}
 
-	return nil
+	return s.evaluatePolicy(ctx, profile.ID, profile.Version, &profile.Checks.Policy, req, attestationVCs)
 }
 
 func verifyAudience(jwtVP string, issuerDID string) error {
@@ -137,9 +129,7 @@ func verifyAudience(jwtVP string, issuerDID string) error {
 	return nil
 }
 
-// ValidatePresentation validates attestation VP and requests presentation policy evaluation.
-//
-//nolint:funlen,gocognit
+// ValidatePresentation validates attestation VP and evaluates presentation policy.
 func (s *Service) ValidatePresentation(
 	ctx context.Context,
 	profile *profileapi.Verifier,
@@ -149,10 +139,11 @@ func (s *Service) ValidatePresentation(
 		zap.String("profileID", profile.ID),
 		zap.String("profileVersion", profile.Version),
 		zap.String("policyURL", profile.Checks.Policy.PolicyURL),
+		zap.Bool("localPolicy", profile.Checks.Policy.Local != nil),
 		zap.String("attestationVP", data.AttestationVP),
 	)
 
-	if profile.Checks.Policy.PolicyURL == "" {
+	if !profile.Checks.Policy.IsEnabled() {
 		return nil
 	}
 
@@ -161,13 +152,56 @@ func (s *Service) ValidatePresentation(
 		CredentialMatches: data.CredentialMatches,
 	}
 
+	var attestationVCs []*verifiable.Credential
+
 	if data.AttestationVP != "" {
-		attestationVCs, err := s.parseAttestationVP(data.AttestationVP, "", false)
+		var err error
+
+		attestationVCs, err = s.parseAttestationVP(data.AttestationVP, "", false)
+		if err != nil {
+			return err
+		}
+
+		jwtVCs, err := toJWTStrings(attestationVCs)
 		if err != nil {
 			return err
 		}
 
-		req.AttestationVC = lo.ToPtr(attestationVCs)
+		req.AttestationVC = lo.ToPtr(jwtVCs)
+	}
+
+	return s.evaluatePolicy(ctx, profile.ID, profile.Version, &profile.Checks.Policy, req, attestationVCs)
+}
+
+// evaluatePolicy evaluates the local policy first, if configured, and then requests evaluation of the remote
+// policy. The interaction is restricted if any of the policies denies it.
+func (s *Service) evaluatePolicy(
+	ctx context.Context,
+	profileID, profileVersion string,
+	policy *profileapi.PolicyCheck,
+	req interface{},
+	attestationVCs []*verifiable.Credential,
+) error {
+	if policy.Local != nil {
+		input, err := localPolicyInput(req, attestationVCs)
+		if err != nil {
+			return fmt.Errorf("local policy input: %w", err)
+		}
+
+		decision, err := policy.Local.Evaluate(ctx, input)
+		if err != nil {
+			return fmt.Errorf("local policy evaluation: %w", err)
+		}
+
+		logDecision(ctx, profileID, profileVersion, "local", decision.Allowed, decision.DenyReasons)
+
+		if !decision.Allowed {
+			return restrictedError(decision.DenyReasons)
+		}
+	}
+
+	if policy.PolicyURL == "" {
+		return nil
 	}
 
 	payload, err := json.Marshal(req)
@@ -175,23 +209,68 @@ func (s *Service) ValidatePresentation(
 		return fmt.Errorf("marshal request: %w", err)
 	}
 
-	resp, err := s.requestPolicyEvaluation(ctx, profile.Checks.Policy.PolicyURL, payload)
+	resp, err := s.requestPolicyEvaluation(ctx, policy.PolicyURL, payload)
 	if err != nil {
 		return fmt.Errorf("policy evaluation: %w", err)
 	}
 
-	if !resp.Allowed {
-		if resp.DenyReasons != nil && len(*resp.DenyReasons) > 0 {
-			return fmt.Errorf("%w: %s", ErrInteractionRestricted, lo.FromPtr(resp.DenyReasons))
-		}
+	logDecision(ctx, profileID, profileVersion, policy.PolicyURL, resp.Allowed, lo.FromPtr(resp.DenyReasons))
 
-		return ErrInteractionRestricted
+	if !resp.Allowed {
+		return restrictedError(lo.FromPtr(resp.DenyReasons))
 	}
 
 	return nil
 }
 
-func (s *Service) parseAttestationVP(jwtVP, nonce string, requireNonce bool) ([]string, error) {
+// localPolicyInput converts the policy evaluation request to the input of the local policy. Along with
+// the fields of the request, the input contains decoded attestation VCs under "attestation_credentials".
+func localPolicyInput(req interface{}, attestationVCs []*verifiable.Credential) (map[string]interface{}, error) {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	var input map[string]interface{}
+
+	if err = json.Unmarshal(b, &input); err != nil {
+		return nil, fmt.Errorf("unmarshal request: %w", err)
+	}
+
+	credentials := make([]interface{}, 0, len(attestationVCs))
+
+	for _, vc := range attestationVCs {
+		credentials = append(credentials, map[string]interface{}(vc.ToRawJSON()))
+	}
+
+	input["attestation_credentials"] = credentials
+
+	if _, ok := input["attestation_vc"]; !ok {
+		input["attestation_vc"] = []interface{}{}
+	}
+
+	return input, nil
+}
+
+func logDecision(ctx context.Context, profileID, profileVersion, policy string, allowed bool, denyReasons []string) {
+	logger.Infoc(ctx, "policy decision",
+		zap.String("profileID", profileID),
+		zap.String("profileVersion", profileVersion),
+		zap.String("policy", policy),
+		zap.Bool("allowed", allowed),
+		zap.Strings("denyReasons", denyReasons),
+	)
+}
+
+func restrictedError(denyReasons []string) error {
+	if len(denyReasons) > 0 {
+		return fmt.Errorf("%w: %s", ErrInteractionRestricted, denyReasons)
+	}
+
+	return ErrInteractionRestricted
+}
+
+func (s *Service) parseAttestationVP(jwtVP, nonce string, requireNonce bool) ([]*verifiable.Credential, error) {
 	attestationVP, err := verifiable.ParsePresentation(
 		[]byte(jwtVP),
 		// The verification of proof is conducted manually, along with an extra verification to ensure that signer of
@@ -209,7 +288,7 @@ func (s *Service) parseAttestationVP(jwtVP, nonce string, requireNonce bool) ([]
 		}
 	}
 
-	attestationVCs := make([]string, 0)
+	attestationVCs := make([]*verifiable.Credential, 0)
 
 	for _, vc := range attestationVP.Credentials() {
 		if !lo.Contains(vc.Contents().Types, WalletAttestationVCType) {
@@ -241,15 +320,25 @@ func (s *Service) parseAttestationVP(jwtVP, nonce string, requireNonce bool) ([]
 			return nil, fmt.Errorf("check attestation vp proof: %w", err)
 		}
 
-		jwtVC, marshalErr := vc.ToJWTString()
-		if marshalErr != nil {
-			return nil, fmt.Errorf("marshal attestation vc to jwt: %w", marshalErr)
+		attestationVCs = append(attestationVCs, vc)
+	}
+
+	return attestationVCs, nil
+}
+
+func toJWTStrings(vcs []*verifiable.Credential) ([]string, error) {
+	jwtVCs := make([]string, 0, len(vcs))
+
+	for _, vc := range vcs {
+		jwtVC, err := vc.ToJWTString()
+		if err != nil {
+			return nil, fmt.Errorf("marshal attestation vc to jwt: %w", err)
 		}
 
-		attestationVCs = append(attestationVCs, jwtVC)
+		jwtVCs = append(jwtVCs, jwtVC)
 	}
 
-	return attestationVCs, nil
+	return jwtVCs, nil
 }
 
 func (s *Service) requestPolicyEvaluation(
@@ -313,6 +313,97 @@ func TestService_ValidateIssuance(t *testing.T) {
 				require.ErrorContains(t, err, "issuer is not authorized")
 			},
 		},
+		{
+			name: "local policy allows issuance before remote policy evaluation",
+			setup: func() {
+				proofChecker = defaultProofChecker
+
+				httpClient.EXPECT().Do(gomock.Any()).DoAndReturn(
+					func(req *http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       io.NopCloser(bytes.NewBufferString(`{"allowed":true}`)),
+						}, nil
+					},
+				)
+
+				attestationVC := createAttestationVC(t, attestationProofCreator, walletDID, false)
+				attestationVP = createAttestationVP(t, attestationVC, walletProofCreator, issuerDID, testNonce)
+				nonce = testNonce
+				credentialTypes = []string{"Credential1"}
+
+				profile = createIssuerProfile(t)
+				profile.Checks.Policy.Local = &profileapi.LocalPolicy{
+					Rules: []*profileapi.PolicyRule{
+						{
+							Name: "attested-wallet",
+							Condition: `!("Credential1" in $.credential_types) || ` +
+								`"WalletAttestationCredential" in $.attestation_credentials[*].type[*]`,
+						},
+						{
+							Name:      "issuer",
+							Condition: `$.issuer_did == "did:example:issuer"`,
+						},
+					},
+				}
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "local policy denies issuance",
+			setup: func() {
+				proofChecker = defaultProofChecker
+
+				httpClient.EXPECT().Do(gomock.Any()).Times(0)
+
+				attestationVP = ""
+				nonce = testNonce
+				credentialTypes = []string{"Credential1"}
+
+				profile = &profileapi.Issuer{
+					SigningDID: &profileapi.SigningDID{DID: issuerDID},
+					Checks: profileapi.IssuanceChecks{
+						Policy: profileapi.PolicyCheck{
+							Local: &profileapi.LocalPolicy{
+								Rules: []*profileapi.PolicyRule{
+									{
+										Name: "attested-wallet",
+										Condition: `!("Credential1" in $.credential_types) || ` +
+											`"WalletAttestationCredential" in $.attestation_credentials[*].type[*]`,
+										DenyReason: "Credential1 requires an attested wallet",
+									},
+								},
+							},
+						},
+					},
+				}
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, trustregistry.ErrInteractionRestricted)
+				require.ErrorContains(t, err, "Credential1 requires an attested wallet")
+			},
+		},
+		{
+			name: "fail to evaluate local policy",
+			setup: func() {
+				proofChecker = defaultProofChecker
+
+				httpClient.EXPECT().Do(gomock.Any()).Times(0)
+
+				attestationVP = ""
+				nonce = testNonce
+
+				profile = createIssuerProfile(t)
+				profile.Checks.Policy.Local = &profileapi.LocalPolicy{
+					Rules: []*profileapi.PolicyRule{{Name: "unknown", Condition: `$.unknown == 1`}},
+				}
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "local policy evaluation")
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -517,6 +608,32 @@ func TestService_ValidatePresentation(t *testing.T) {
 				require.ErrorIs(t, err, trustregistry.ErrInteractionRestricted)
 			},
 		},
+		{
+			name: "local policy denies presentation",
+			setup: func() {
+				proofChecker = defaultProofChecker
+
+				httpClient.EXPECT().Do(gomock.Any()).Times(0)
+
+				attestationVC := createAttestationVC(t, attestationProofCreator, walletDID, false)
+				attestationVP = createAttestationVP(t, attestationVC, walletProofCreator, "", "")
+
+				profile = createVerifierProfile(t)
+				profile.Checks.Policy.Local = &profileapi.LocalPolicy{
+					Rules: []*profileapi.PolicyRule{
+						{
+							Name:       "trusted-verifier",
+							Condition:  `$.verifier_did == "did:example:trusted-verifier"`,
+							DenyReason: "verifier is not trusted",
+						},
+					},
+				}
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, trustregistry.ErrInteractionRestricted)
+				require.ErrorContains(t, err, "verifier is not trusted")
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,97 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package txcodenotifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+)
+
+const maxErrorBodySize = 1024
+
+// HTTPNotifier sends messages by SMS through a generic HTTP gateway.
+type HTTPNotifier struct {
+	config     *profileapi.SMSGatewayConfig
+	httpClient *http.Client
+}
+
+type gatewayRequest struct {
+	To      string `json:"to"`
+	Message string `json:"message"`
+}
+
+// NewHTTPNotifier returns a new HTTPNotifier instance.
+func NewHTTPNotifier(config *profileapi.SMSGatewayConfig, httpClient *http.Client) *HTTPNotifier {
+	return &HTTPNotifier{
+		config:     config,
+		httpClient: httpClient,
+	}
+}
+
+// Notify posts the message to the gateway. Any 2xx response status means the message is accepted.
+func (n *HTTPNotifier) Notify(ctx context.Context, to string, msg *Message) error {
+	body, err := n.requestBody(to, msg)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("new request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for k, v := range n.config.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize)) //nolint:errcheck
+
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, b)
+	}
+
+	return nil
+}
+
+func (n *HTTPNotifier) requestBody(to string, msg *Message) ([]byte, error) {
+	data := &gatewayRequest{
+		To:      to,
+		Message: msg.Body,
+	}
+
+	tmpl, err := n.config.ParseBodyTemplate()
+	if err != nil {
+		return nil, err
+	}
+
+	if tmpl == nil {
+		return json.Marshal(data)
+	}
+
+	var buf bytes.Buffer
+
+	if err = tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("execute body template: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
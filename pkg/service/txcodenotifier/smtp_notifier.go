@@ -0,0 +1,124 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package txcodenotifier
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+)
+
+// SMTPNotifier sends messages by email.
+type SMTPNotifier struct {
+	config *profileapi.SMTPConfig
+}
+
+// NewSMTPNotifier returns a new SMTPNotifier instance.
+func NewSMTPNotifier(config *profileapi.SMTPConfig) *SMTPNotifier {
+	return &SMTPNotifier{
+		config: config,
+	}
+}
+
+// Notify sends the message to the email address. The connection is upgraded with STARTTLS, servers not
+// supporting it are rejected unless plaintext is explicitly allowed by the config.
+func (n *SMTPNotifier) Notify(ctx context.Context, to string, msg *Message) error {
+	toAddr, err := mail.ParseAddress(to)
+	if err != nil {
+		return fmt.Errorf("invalid email address %q: %w", to, err)
+	}
+
+	fromAddr, err := mail.ParseAddress(n.config.From)
+	if err != nil {
+		return fmt.Errorf("invalid from address %q: %w", n.config.From, err)
+	}
+
+	addr := net.JoinHostPort(n.config.Host, strconv.Itoa(n.config.Port))
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("dial %s: %w", addr, err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline) //nolint:errcheck
+	}
+
+	client, err := smtp.NewClient(conn, n.config.Host)
+	if err != nil {
+		_ = conn.Close() //nolint:errcheck
+
+		return fmt.Errorf("new smtp client: %w", err)
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err = client.StartTLS(&tls.Config{ServerName: n.config.Host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("starttls: %w", err)
+		}
+	} else if !n.config.AllowPlaintext {
+		return errors.New("smtp server doesn't support STARTTLS")
+	}
+
+	if n.config.Username != "" {
+		if err = client.Auth(smtp.PlainAuth("", n.config.Username, n.config.Password, n.config.Host)); err != nil {
+			return fmt.Errorf("auth: %w", err)
+		}
+	}
+
+	if err = client.Mail(fromAddr.Address); err != nil {
+		return fmt.Errorf("mail: %w", err)
+	}
+
+	if err = client.Rcpt(toAddr.Address); err != nil {
+		return fmt.Errorf("rcpt: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("data: %w", err)
+	}
+
+	if _, err = w.Write(buildEmail(fromAddr, toAddr, msg)); err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+
+	if err = w.Close(); err != nil {
+		return fmt.Errorf("close message: %w", err)
+	}
+
+	return client.Quit()
+}
+
+func buildEmail(from, to *mail.Address, msg *Message) []byte {
+	// Line breaks in the subject would inject headers.
+	subject := strings.NewReplacer("\r", " ", "\n", " ").Replace(msg.Subject)
+
+	var sb strings.Builder
+
+	sb.WriteString("From: " + from.String() + "\r\n")
+	sb.WriteString("To: " + to.String() + "\r\n")
+	sb.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", subject) + "\r\n")
+	sb.WriteString("MIME-Version: 1.0\r\n")
+	sb.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	sb.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	sb.WriteString("\r\n")
+	sb.WriteString(strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n"))
+	sb.WriteString("\r\n")
+
+	return []byte(sb.String())
+}
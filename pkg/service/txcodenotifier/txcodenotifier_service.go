@@ -0,0 +1,138 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package txcodenotifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+)
+
+const (
+	defaultEmailSubjectTemplate = "Your credential offer code"
+	defaultEmailBodyTemplate    = "Your code to receive a credential from {{.IssuerName}} is {{.TxCode}}."
+	defaultSMSTemplate          = "Your code to receive a credential from {{.IssuerName}} is {{.TxCode}}."
+)
+
+// Notifier delivers messages to recipients.
+type Notifier interface {
+	Notify(ctx context.Context, to string, msg *Message) error
+}
+
+// Message is a message delivered by Notifier. Subject is not used by SMS notifiers.
+type Message struct {
+	Subject string
+	Body    string
+}
+
+// Recipient is a recipient of the transaction code. The code is sent to every address set.
+type Recipient struct {
+	Email string
+	Phone string
+}
+
+// MessageData is data of the message templates.
+type MessageData struct {
+	TxCode              string
+	IssuerName          string
+	InitiateIssuanceURL string
+}
+
+// Config defines dependencies for Service.
+type Config struct {
+	HTTPClient *http.Client
+}
+
+// Service delivers transaction codes by email and SMS according to the notifier config of the issuer profile.
+type Service struct {
+	httpClient *http.Client
+}
+
+// New returns a new Service instance.
+func New(config *Config) *Service {
+	return &Service{
+		httpClient: config.HTTPClient,
+	}
+}
+
+// NotifyTxCode renders messages with the transaction code and delivers them to the recipient.
+func (s *Service) NotifyTxCode(
+	ctx context.Context,
+	config *profileapi.TxCodeNotifierConfig,
+	recipient *Recipient,
+	data *MessageData,
+) error {
+	if config == nil {
+		return errors.New("tx code notifier is not configured")
+	}
+
+	if recipient.Email != "" {
+		if config.SMTP == nil {
+			return errors.New("email delivery is not configured")
+		}
+
+		subject, err := render(config.EmailSubjectTemplate, defaultEmailSubjectTemplate, data)
+		if err != nil {
+			return fmt.Errorf("render email subject: %w", err)
+		}
+
+		body, err := render(config.EmailBodyTemplate, defaultEmailBodyTemplate, data)
+		if err != nil {
+			return fmt.Errorf("render email body: %w", err)
+		}
+
+		if err = NewSMTPNotifier(config.SMTP).Notify(ctx, recipient.Email, &Message{
+			Subject: subject,
+			Body:    body,
+		}); err != nil {
+			return fmt.Errorf("send email: %w", err)
+		}
+	}
+
+	if recipient.Phone != "" {
+		if config.SMSGateway == nil {
+			return errors.New("sms delivery is not configured")
+		}
+
+		body, err := render(config.SMSTemplate, defaultSMSTemplate, data)
+		if err != nil {
+			return fmt.Errorf("render sms: %w", err)
+		}
+
+		if err = NewHTTPNotifier(config.SMSGateway, s.httpClient).Notify(ctx, recipient.Phone, &Message{
+			Body: body,
+		}); err != nil {
+			return fmt.Errorf("send sms: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func render(text, defaultText string, data *MessageData) (string, error) {
+	if text == "" {
+		text = defaultText
+	}
+
+	tmpl, err := template.New("message").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+
+	if err = tmpl.Execute(&sb, data); err != nil {
+		return "", err
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,351 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package txcodenotifier_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/service/txcodenotifier"
+)
+
+var messageData = &txcodenotifier.MessageData{
+	TxCode:              "123456",
+	IssuerName:          "Bank Issuer",
+	InitiateIssuanceURL: "openid-credential-offer://?credential_offer_uri=https%3A%2F%2Fvcs.example.com",
+}
+
+func TestService_NotifyTxCode(t *testing.T) {
+	t.Run("email", func(t *testing.T) {
+		srv := startSMTPServer(t, &smtpServer{})
+
+		svc := txcodenotifier.New(&txcodenotifier.Config{HTTPClient: http.DefaultClient})
+
+		err := svc.NotifyTxCode(context.Background(), &profileapi.TxCodeNotifierConfig{
+			SMTP: &profileapi.SMTPConfig{
+				Host:           "127.0.0.1",
+				Port:           srv.port,
+				From:           "Bank Issuer <noreply@bank.example.com>",
+				AllowPlaintext: true,
+			},
+			EmailSubjectTemplate: "Credential from {{.IssuerName}}",
+		}, &txcodenotifier.Recipient{Email: "holder@example.com"}, messageData)
+		require.NoError(t, err)
+
+		msg := <-srv.messages
+		require.Equal(t, "<noreply@bank.example.com>", msg.from)
+		require.Equal(t, []string{"<holder@example.com>"}, msg.rcpt)
+		require.Contains(t, msg.data, "Subject: Credential from Bank Issuer\r\n")
+		require.Contains(t, msg.data, "Your code to receive a credential from Bank Issuer is 123456.")
+		require.Empty(t, msg.auth)
+	})
+
+	t.Run("email with auth", func(t *testing.T) {
+		srv := startSMTPServer(t, &smtpServer{withAuth: true})
+
+		svc := txcodenotifier.New(&txcodenotifier.Config{HTTPClient: http.DefaultClient})
+
+		err := svc.NotifyTxCode(context.Background(), &profileapi.TxCodeNotifierConfig{
+			SMTP: &profileapi.SMTPConfig{
+				Host:           "localhost",
+				Port:           srv.port,
+				Username:       "user",
+				Password:       "secret",
+				From:           "noreply@bank.example.com",
+				AllowPlaintext: true,
+			},
+			EmailBodyTemplate: "Code: {{.TxCode}}\nOffer: {{.InitiateIssuanceURL}}",
+		}, &txcodenotifier.Recipient{Email: "holder@example.com"}, messageData)
+		require.NoError(t, err)
+
+		msg := <-srv.messages
+		require.Equal(t, "\x00user\x00secret", msg.auth)
+		require.Contains(t, msg.data, "Subject: Your credential offer code\r\n")
+		require.Contains(t, msg.data, "Code: 123456\r\nOffer: openid-credential-offer://")
+	})
+
+	t.Run("sms", func(t *testing.T) {
+		var received map[string]string
+
+		gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer gateway.Close()
+
+		svc := txcodenotifier.New(&txcodenotifier.Config{HTTPClient: http.DefaultClient})
+
+		err := svc.NotifyTxCode(context.Background(), &profileapi.TxCodeNotifierConfig{
+			SMSGateway: &profileapi.SMSGatewayConfig{
+				URL:     gateway.URL,
+				Headers: map[string]string{"Authorization": "Bearer token"},
+			},
+			SMSTemplate: "{{.IssuerName}} code: {{.TxCode}}",
+		}, &txcodenotifier.Recipient{Phone: "+15555550100"}, messageData)
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]string{"to": "+15555550100", "message": "Bank Issuer code: 123456"}, received)
+	})
+
+	t.Run("sms with body template", func(t *testing.T) {
+		var received map[string]interface{}
+
+		gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		}))
+		defer gateway.Close()
+
+		svc := txcodenotifier.New(&txcodenotifier.Config{HTTPClient: http.DefaultClient})
+
+		err := svc.NotifyTxCode(context.Background(), &profileapi.TxCodeNotifierConfig{
+			SMSGateway: &profileapi.SMSGatewayConfig{
+				URL:          gateway.URL,
+				BodyTemplate: `{"destination":{"number":{{json .To}}},"text":{{json .Message}}}`,
+			},
+		}, &txcodenotifier.Recipient{Phone: "+15555550100"}, messageData)
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]interface{}{
+			"destination": map[string]interface{}{"number": "+15555550100"},
+			"text":        "Your code to receive a credential from Bank Issuer is 123456.",
+		}, received)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		svc := txcodenotifier.New(&txcodenotifier.Config{HTTPClient: http.DefaultClient})
+		ctx := context.Background()
+
+		err := svc.NotifyTxCode(ctx, nil, &txcodenotifier.Recipient{Email: "holder@example.com"}, messageData)
+		require.ErrorContains(t, err, "tx code notifier is not configured")
+
+		err = svc.NotifyTxCode(ctx, &profileapi.TxCodeNotifierConfig{},
+			&txcodenotifier.Recipient{Email: "holder@example.com"}, messageData)
+		require.ErrorContains(t, err, "email delivery is not configured")
+
+		err = svc.NotifyTxCode(ctx, &profileapi.TxCodeNotifierConfig{},
+			&txcodenotifier.Recipient{Phone: "+15555550100"}, messageData)
+		require.ErrorContains(t, err, "sms delivery is not configured")
+
+		err = svc.NotifyTxCode(ctx, &profileapi.TxCodeNotifierConfig{
+			SMTP:                 &profileapi.SMTPConfig{Host: "127.0.0.1", Port: 1, From: "noreply@example.com"},
+			EmailSubjectTemplate: "{{.Unknown}}",
+		}, &txcodenotifier.Recipient{Email: "holder@example.com"}, messageData)
+		require.ErrorContains(t, err, "render email subject")
+
+		err = svc.NotifyTxCode(ctx, &profileapi.TxCodeNotifierConfig{
+			SMTP: &profileapi.SMTPConfig{Host: "127.0.0.1", Port: 1, From: "noreply@example.com"},
+		}, &txcodenotifier.Recipient{Email: "not an email"}, messageData)
+		require.ErrorContains(t, err, "invalid email address")
+
+		err = svc.NotifyTxCode(ctx, &profileapi.TxCodeNotifierConfig{
+			SMSGateway:  &profileapi.SMSGatewayConfig{URL: "http://127.0.0.1:1"},
+			SMSTemplate: "{{",
+		}, &txcodenotifier.Recipient{Phone: "+15555550100"}, messageData)
+		require.ErrorContains(t, err, "render sms")
+
+		gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("invalid number"))
+		}))
+		defer gateway.Close()
+
+		err = svc.NotifyTxCode(ctx, &profileapi.TxCodeNotifierConfig{
+			SMSGateway: &profileapi.SMSGatewayConfig{URL: gateway.URL},
+		}, &txcodenotifier.Recipient{Phone: "+15555550100"}, messageData)
+		require.ErrorContains(t, err, "send sms: unexpected status code 400: invalid number")
+	})
+}
+
+func TestSMTPNotifier_Notify(t *testing.T) {
+	t.Run("server rejects recipient", func(t *testing.T) {
+		srv := startSMTPServer(t, &smtpServer{rejectRcpt: true})
+
+		err := txcodenotifier.NewSMTPNotifier(&profileapi.SMTPConfig{
+			Host:           "127.0.0.1",
+			Port:           srv.port,
+			From:           "noreply@example.com",
+			AllowPlaintext: true,
+		}).Notify(context.Background(), "holder@example.com", &txcodenotifier.Message{Body: "code"})
+		require.ErrorContains(t, err, "rcpt")
+	})
+
+	t.Run("starttls is required", func(t *testing.T) {
+		srv := startSMTPServer(t, &smtpServer{})
+
+		err := txcodenotifier.NewSMTPNotifier(&profileapi.SMTPConfig{
+			Host: "127.0.0.1",
+			Port: srv.port,
+			From: "noreply@example.com",
+		}).Notify(context.Background(), "holder@example.com", &txcodenotifier.Message{Body: "code"})
+		require.EqualError(t, err, "smtp server doesn't support STARTTLS")
+	})
+
+	t.Run("starttls failed", func(t *testing.T) {
+		srv := startSMTPServer(t, &smtpServer{withStartTLS: true})
+
+		err := txcodenotifier.NewSMTPNotifier(&profileapi.SMTPConfig{
+			Host:           "127.0.0.1",
+			Port:           srv.port,
+			From:           "noreply@example.com",
+			AllowPlaintext: true,
+		}).Notify(context.Background(), "holder@example.com", &txcodenotifier.Message{Body: "code"})
+		require.ErrorContains(t, err, "starttls")
+	})
+
+	t.Run("connection refused", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		port := l.Addr().(*net.TCPAddr).Port
+		require.NoError(t, l.Close())
+
+		err = txcodenotifier.NewSMTPNotifier(&profileapi.SMTPConfig{
+			Host: "127.0.0.1",
+			Port: port,
+			From: "noreply@example.com",
+		}).Notify(context.Background(), "holder@example.com", &txcodenotifier.Message{Body: "code"})
+		require.ErrorContains(t, err, "dial")
+	})
+}
+
+type smtpMessage struct {
+	from string
+	rcpt []string
+	auth string
+	data string
+}
+
+type smtpServer struct {
+	port         int
+	withAuth     bool
+	withStartTLS bool
+	rejectRcpt   bool
+	messages     chan *smtpMessage
+}
+
+// startSMTPServer starts a minimal SMTP server accepting a single connection.
+func startSMTPServer(t *testing.T, srv *smtpServer) *smtpServer {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = l.Close() })
+
+	srv.port = l.Addr().(*net.TCPAddr).Port
+	srv.messages = make(chan *smtpMessage, 1)
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+
+		defer conn.Close()
+
+		srv.serve(conn)
+	}()
+
+	return srv
+}
+
+func (s *smtpServer) serve(conn net.Conn) {
+	r := bufio.NewReader(conn)
+	msg := &smtpMessage{}
+
+	reply := func(line string) {
+		_, _ = io.WriteString(conn, line+"\r\n")
+	}
+
+	reply("220 localhost ESMTP")
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+		switch cmd {
+		case "EHLO":
+			switch {
+			case s.withAuth:
+				reply("250-localhost")
+				reply("250 AUTH PLAIN")
+			case s.withStartTLS:
+				reply("250-localhost")
+				reply("250 STARTTLS")
+			default:
+				reply("250 localhost")
+			}
+		case "STARTTLS":
+			// TLS handshake is not supported, the connection is closed after the reply.
+			reply("220 Ready to start TLS")
+
+			return
+		case "AUTH":
+			parts := strings.Fields(line)
+
+			b, _ := base64.StdEncoding.DecodeString(parts[len(parts)-1])
+			msg.auth = string(b)
+
+			reply("235 Authentication successful")
+		case "MAIL":
+			msg.from = strings.TrimPrefix(line, "MAIL FROM:")
+			reply("250 OK")
+		case "RCPT":
+			if s.rejectRcpt {
+				reply("550 No such user")
+
+				continue
+			}
+
+			msg.rcpt = append(msg.rcpt, strings.TrimPrefix(line, "RCPT TO:"))
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+
+			var sb strings.Builder
+
+			for {
+				dataLine, err := r.ReadString('\n')
+				if err != nil || dataLine == ".\r\n" {
+					break
+				}
+
+				sb.WriteString(dataLine)
+			}
+
+			msg.data = sb.String()
+			reply("250 OK")
+
+			s.messages <- msg
+		case "QUIT":
+			reply("221 Bye")
+
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
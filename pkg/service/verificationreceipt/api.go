@@ -0,0 +1,63 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verificationreceipt
+
+import (
+	"errors"
+	"time"
+
+	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
+)
+
+// DigestAlgorithm is the algorithm of the credential and presentation digests of the receipt.
+const DigestAlgorithm = "sha-256"
+
+var ErrDataNotFound = errors.New("data not found")
+
+// Receipt records what was verified by the verifier. It is signed as the "verification_receipt" claim
+// of a JWT issued by the signing DID of the verifier profile.
+type Receipt struct {
+	// ID is the ID of the receipt. For OIDC4VP interactions it is the ID of the transaction.
+	ID             string `json:"id"`
+	ProfileID      string `json:"profileId"`
+	ProfileVersion string `json:"profileVersion"`
+	// DigestAlgorithm is the algorithm of the credential and presentation digests.
+	DigestAlgorithm string `json:"digestAlg"`
+	// PresentationDigests are base64url encoded digests of the verified presentations.
+	PresentationDigests []string `json:"presentationDigests,omitempty"`
+	// Credentials are digests of the verified credentials.
+	Credentials []*CredentialDigest `json:"credentials,omitempty"`
+	// Checks are the checks performed by the verifier.
+	Checks []*CheckResult `json:"checks"`
+	// StatusLists are versions of the status lists used for the credential status check.
+	StatusLists []credentialstatus.StatusListVersion `json:"statusLists,omitempty"`
+}
+
+// CredentialDigest is a digest of the verified credential.
+type CredentialDigest struct {
+	ID string `json:"id,omitempty"`
+	// Digest is base64url encoded digest of the credential. For credentials in JWT format it is the digest
+	// of the issuer-signed JWT, for other formats it is the digest of the serialized credential.
+	Digest string `json:"digest"`
+}
+
+// CheckResult is a result of the check performed by the verifier.
+type CheckResult struct {
+	Check  string `json:"check"`
+	Passed bool   `json:"passed"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Record is a stored signed receipt.
+type Record struct {
+	ID             string
+	ProfileID      string
+	ProfileVersion string
+	// Receipt is the signed receipt in JWT format.
+	Receipt   string
+	CreatedAt time.Time
+}
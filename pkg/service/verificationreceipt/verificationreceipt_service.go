@@ -0,0 +1,322 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+//go:generate mockgen -destination verificationreceipt_service_mocks_test.go -self_package mocks -package verificationreceipt_test -source=verificationreceipt_service.go -mock_names kmsRegistry=MockKMSRegistry,store=MockStore
+
+package verificationreceipt
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/trustbloc/vc-go/jwt"
+	"github.com/trustbloc/vc-go/verifiable"
+
+	"github.com/trustbloc/vcs/pkg/doc/vc/jws"
+	vcsverifiable "github.com/trustbloc/vcs/pkg/doc/verifiable"
+	vcskms "github.com/trustbloc/vcs/pkg/kms"
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
+	"github.com/trustbloc/vcs/pkg/service/verifycredential"
+	"github.com/trustbloc/vcs/pkg/service/verifypresentation"
+)
+
+type kmsRegistry interface {
+	GetKeyManager(config *vcskms.Config) (vcskms.VCSKeyManager, error)
+}
+
+type store interface {
+	Create(ctx context.Context, record *Record) error
+	Get(ctx context.Context, id string) (*Record, error)
+}
+
+// Config defines dependencies for Service.
+type Config struct {
+	KMSRegistry kmsRegistry
+	Store       store
+}
+
+// Service issues and stores signed verification receipts.
+type Service struct {
+	kmsRegistry kmsRegistry
+	store       store
+}
+
+// receiptClaims are the claims of the signed receipt.
+type receiptClaims struct {
+	Issuer   string   `json:"iss"`
+	ID       string   `json:"jti"`
+	IssuedAt int64    `json:"iat"`
+	Receipt  *Receipt `json:"verification_receipt"`
+}
+
+// New returns a new Service instance.
+func New(config *Config) *Service {
+	return &Service{
+		kmsRegistry: config.KMSRegistry,
+		store:       config.Store,
+	}
+}
+
+// Issue signs the receipt with the signing DID of the verifier profile and stores it. Signed receipt
+// in JWT format is returned.
+func (s *Service) Issue(ctx context.Context, profile *profileapi.Verifier, receipt *Receipt) (string, error) {
+	if profile.VerificationReceipt == nil {
+		return "", errors.New("verification receipt is not configured")
+	}
+
+	if profile.SigningDID == nil {
+		return "", errors.New("signing did is not set")
+	}
+
+	keyType := profile.VerificationReceipt.SigningKeyType(profile)
+
+	signatureTypes := vcsverifiable.GetSignatureTypesByKeyTypeFormat(keyType, vcsverifiable.Jwt)
+	if len(signatureTypes) == 0 {
+		return "", fmt.Errorf("unsupported jwt key type %s", keyType)
+	}
+
+	km, err := s.kmsRegistry.GetKeyManager(profile.KMSConfig)
+	if err != nil {
+		return "", fmt.Errorf("get key manager: %w", err)
+	}
+
+	vcsSigner, err := km.NewVCSigner(profile.SigningDID.KMSKeyID, signatureTypes[0])
+	if err != nil {
+		return "", fmt.Errorf("create signer: %w", err)
+	}
+
+	issuedAt := time.Now().UTC()
+
+	token, err := jwt.NewJoseSigned(&receiptClaims{
+		Issuer:   profile.SigningDID.DID,
+		ID:       receipt.ID,
+		IssuedAt: issuedAt.Unix(),
+		Receipt:  receipt,
+	}, nil, jws.NewSigner(profile.SigningDID.Creator, vcsSigner.Alg(), vcsSigner))
+	if err != nil {
+		return "", fmt.Errorf("sign receipt: %w", err)
+	}
+
+	signed, err := token.Serialize(false)
+	if err != nil {
+		return "", fmt.Errorf("serialize receipt: %w", err)
+	}
+
+	err = s.store.Create(ctx, &Record{
+		ID:             receipt.ID,
+		ProfileID:      receipt.ProfileID,
+		ProfileVersion: receipt.ProfileVersion,
+		Receipt:        signed,
+		CreatedAt:      issuedAt,
+	})
+	if err != nil {
+		return "", fmt.Errorf("store receipt: %w", err)
+	}
+
+	return signed, nil
+}
+
+// Get returns the stored receipt by ID.
+func (s *Service) Get(ctx context.Context, id string) (*Record, error) {
+	return s.store.Get(ctx, id)
+}
+
+// NewCredentialReceipt creates a receipt of the credential verification. Results are the failed checks
+// returned by the credential verifier.
+func NewCredentialReceipt(
+	id string,
+	profile *profileapi.Verifier,
+	credential *verifiable.Credential,
+	results []verifycredential.CredentialsVerificationCheckResult,
+	statusLists []credentialstatus.StatusListVersion,
+) (*Receipt, error) {
+	credentialDigest, err := digestCredential(credential)
+	if err != nil {
+		return nil, err
+	}
+
+	failed := make(map[string]string, len(results))
+	for _, r := range results {
+		failed[r.Check] = r.Error
+	}
+
+	return &Receipt{
+		ID:              id,
+		ProfileID:       profile.ID,
+		ProfileVersion:  profile.Version,
+		DigestAlgorithm: DigestAlgorithm,
+		Credentials:     []*CredentialDigest{credentialDigest},
+		Checks:          checkResults(credentialChecks(profile), failed),
+		StatusLists:     statusLists,
+	}, nil
+}
+
+// NewPresentationReceipt creates a receipt of the presentations verification. Results are the failed checks
+// returned by the presentation verifier.
+func NewPresentationReceipt(
+	id string,
+	profile *profileapi.Verifier,
+	presentations []*verifiable.Presentation,
+	results []verifypresentation.PresentationVerificationCheckResult,
+	statusLists []credentialstatus.StatusListVersion,
+) (*Receipt, error) {
+	receipt := &Receipt{
+		ID:              id,
+		ProfileID:       profile.ID,
+		ProfileVersion:  profile.Version,
+		DigestAlgorithm: DigestAlgorithm,
+		StatusLists:     statusLists,
+	}
+
+	for _, presentation := range presentations {
+		presentationDigest, err := digestPresentation(presentation)
+		if err != nil {
+			return nil, err
+		}
+
+		receipt.PresentationDigests = append(receipt.PresentationDigests, presentationDigest)
+
+		for _, credential := range presentation.Credentials() {
+			credentialDigest, err := digestCredential(credential)
+			if err != nil {
+				return nil, err
+			}
+
+			receipt.Credentials = append(receipt.Credentials, credentialDigest)
+		}
+	}
+
+	failed := make(map[string]string, len(results))
+	for _, r := range results {
+		failed[r.Check] = r.Error
+	}
+
+	receipt.Checks = checkResults(presentationChecks(profile), failed)
+
+	return receipt, nil
+}
+
+// credentialChecks returns the checks performed on credential verification in the order they are performed.
+func credentialChecks(profile *profileapi.Verifier) []string {
+	checks := profile.Checks.Credential
+
+	var names []string
+
+	if checks.CredentialExpiry {
+		names = append(names, "credentialExpiry")
+	}
+
+	if checks.LinkedDomain {
+		names = append(names, "linkedDomain")
+	}
+
+	if checks.Proof {
+		names = append(names, "proof")
+	}
+
+	if checks.Status {
+		names = append(names, "credentialStatus")
+	}
+
+	return names
+}
+
+// presentationChecks returns the checks performed on presentation verification in the order they are performed.
+func presentationChecks(profile *profileapi.Verifier) []string {
+	checks := profile.Checks.Credential
+
+	var names []string
+
+	if profile.Checks.Presentation != nil && profile.Checks.Presentation.Proof {
+		names = append(names, "proof")
+	}
+
+	if len(checks.IssuerTrustList) > 0 {
+		names = append(names, "issuerTrustList")
+	}
+
+	if checks.CredentialExpiry {
+		names = append(names, "credentialExpiry")
+	}
+
+	if checks.Proof {
+		names = append(names, "credentialProof")
+	}
+
+	if checks.Status {
+		names = append(names, "credentialStatus")
+	}
+
+	if checks.LinkedDomain {
+		names = append(names, "linkedDomain")
+	}
+
+	if checks.Strict {
+		names = append(names, "credentialStrict")
+	}
+
+	return names
+}
+
+func checkResults(performed []string, failed map[string]string) []*CheckResult {
+	results := make([]*CheckResult, 0, len(performed))
+
+	for _, check := range performed {
+		errMsg, isFailed := failed[check]
+
+		results = append(results, &CheckResult{
+			Check:  check,
+			Passed: !isFailed,
+			Error:  errMsg,
+		})
+	}
+
+	return results
+}
+
+func digestCredential(credential *verifiable.Credential) (*CredentialDigest, error) {
+	var data []byte
+
+	if credential.JWTEnvelope != nil && credential.JWTEnvelope.JWT != "" {
+		data = []byte(credential.JWTEnvelope.JWT)
+	} else {
+		var err error
+
+		data, err = credential.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("marshal credential: %w", err)
+		}
+	}
+
+	return &CredentialDigest{
+		ID:     credential.Contents().ID,
+		Digest: digest(data),
+	}, nil
+}
+
+func digestPresentation(presentation *verifiable.Presentation) (string, error) {
+	if presentation.JWT != "" {
+		return digest([]byte(presentation.JWT)), nil
+	}
+
+	data, err := presentation.MarshalJSON()
+	if err != nil {
+		return "", fmt.Errorf("marshal presentation: %w", err)
+	}
+
+	return digest(data), nil
+}
+
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,289 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verificationreceipt_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/did-go/legacy/mock/storage"
+	arieskms "github.com/trustbloc/kms-go/kms"
+	"github.com/trustbloc/kms-go/secretlock/noop"
+	"github.com/trustbloc/kms-go/spi/kms"
+	"github.com/trustbloc/kms-go/wrapper/localsuite"
+	"github.com/trustbloc/vc-go/jwt"
+	"github.com/trustbloc/vc-go/verifiable"
+
+	"github.com/trustbloc/vcs/internal/mock/vcskms"
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
+	"github.com/trustbloc/vcs/pkg/service/verificationreceipt"
+	"github.com/trustbloc/vcs/pkg/service/verifycredential"
+	"github.com/trustbloc/vcs/pkg/service/verifypresentation"
+)
+
+func TestService_Issue(t *testing.T) {
+	p, err := arieskms.NewAriesProviderWrapper(storage.NewMockStoreProvider())
+	require.NoError(t, err)
+
+	cryptoSuite, err := localsuite.NewLocalCryptoSuite("local-lock://custom/primary/key/", p, &noop.NoLock{})
+	require.NoError(t, err)
+
+	keyCreator, err := cryptoSuite.KeyCreator()
+	require.NoError(t, err)
+
+	pubKey, err := keyCreator.Create(kms.ED25519Type)
+	require.NoError(t, err)
+
+	signer, err := cryptoSuite.KMSCryptoMultiSigner()
+	require.NoError(t, err)
+
+	profile := &profileapi.Verifier{
+		ID:      "profileID",
+		Version: "v1.0",
+		SigningDID: &profileapi.SigningDID{
+			DID:      "did:example:verifier",
+			Creator:  "did:example:verifier#" + pubKey.KeyID,
+			KMSKeyID: pubKey.KeyID,
+		},
+		VerificationReceipt: &profileapi.VerificationReceiptConfig{
+			KeyType: kms.ED25519Type,
+		},
+	}
+
+	receipt := &verificationreceipt.Receipt{
+		ID:              "receiptID",
+		ProfileID:       "profileID",
+		ProfileVersion:  "v1.0",
+		DigestAlgorithm: verificationreceipt.DigestAlgorithm,
+		Checks:          []*verificationreceipt.CheckResult{{Check: "proof", Passed: true}},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		kmsRegistry := NewMockKMSRegistry(gomock.NewController(t))
+		kmsRegistry.EXPECT().GetKeyManager(gomock.Any()).Return(&vcskms.MockKMS{Signer: signer}, nil)
+
+		var stored *verificationreceipt.Record
+
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, record *verificationreceipt.Record) error {
+				stored = record
+
+				return nil
+			})
+
+		svc := verificationreceipt.New(&verificationreceipt.Config{
+			KMSRegistry: kmsRegistry,
+			Store:       store,
+		})
+
+		signed, err := svc.Issue(context.Background(), profile, receipt)
+		require.NoError(t, err)
+
+		require.Equal(t, "receiptID", stored.ID)
+		require.Equal(t, "profileID", stored.ProfileID)
+		require.Equal(t, "v1.0", stored.ProfileVersion)
+		require.Equal(t, signed, stored.Receipt)
+		require.False(t, stored.CreatedAt.IsZero())
+
+		token, _, err := jwt.Parse(signed)
+		require.NoError(t, err)
+
+		kid, ok := token.Headers.KeyID()
+		require.True(t, ok)
+		require.Equal(t, profile.SigningDID.Creator, kid)
+
+		var claims struct {
+			Issuer   string                       `json:"iss"`
+			ID       string                       `json:"jti"`
+			IssuedAt int64                        `json:"iat"`
+			Receipt  *verificationreceipt.Receipt `json:"verification_receipt"`
+		}
+
+		require.NoError(t, token.DecodeClaims(&claims))
+		require.Equal(t, "did:example:verifier", claims.Issuer)
+		require.Equal(t, "receiptID", claims.ID)
+		require.Equal(t, stored.CreatedAt.Unix(), claims.IssuedAt)
+		require.Equal(t, receipt, claims.Receipt)
+	})
+
+	t.Run("Receipt is not configured", func(t *testing.T) {
+		svc := verificationreceipt.New(&verificationreceipt.Config{})
+
+		_, err = svc.Issue(context.Background(), &profileapi.Verifier{}, receipt)
+		require.ErrorContains(t, err, "verification receipt is not configured")
+	})
+
+	t.Run("Signing DID is not set", func(t *testing.T) {
+		svc := verificationreceipt.New(&verificationreceipt.Config{})
+
+		_, err = svc.Issue(context.Background(), &profileapi.Verifier{
+			VerificationReceipt: &profileapi.VerificationReceiptConfig{},
+		}, receipt)
+		require.ErrorContains(t, err, "signing did is not set")
+	})
+
+	t.Run("Unsupported key type", func(t *testing.T) {
+		svc := verificationreceipt.New(&verificationreceipt.Config{})
+
+		_, err = svc.Issue(context.Background(), &profileapi.Verifier{
+			SigningDID:          profile.SigningDID,
+			VerificationReceipt: &profileapi.VerificationReceiptConfig{},
+		}, receipt)
+		require.ErrorContains(t, err, "unsupported jwt key type")
+	})
+
+	t.Run("Get key manager error", func(t *testing.T) {
+		kmsRegistry := NewMockKMSRegistry(gomock.NewController(t))
+		kmsRegistry.EXPECT().GetKeyManager(gomock.Any()).Return(nil, errors.New("kms error"))
+
+		svc := verificationreceipt.New(&verificationreceipt.Config{KMSRegistry: kmsRegistry})
+
+		_, err = svc.Issue(context.Background(), profile, receipt)
+		require.ErrorContains(t, err, "get key manager: kms error")
+	})
+
+	t.Run("Create signer error", func(t *testing.T) {
+		kmsRegistry := NewMockKMSRegistry(gomock.NewController(t))
+		kmsRegistry.EXPECT().GetKeyManager(gomock.Any()).Return(
+			&vcskms.MockKMS{VCSignerErr: errors.New("signer error")}, nil)
+
+		svc := verificationreceipt.New(&verificationreceipt.Config{KMSRegistry: kmsRegistry})
+
+		_, err = svc.Issue(context.Background(), profile, receipt)
+		require.ErrorContains(t, err, "create signer: signer error")
+	})
+
+	t.Run("Store error", func(t *testing.T) {
+		kmsRegistry := NewMockKMSRegistry(gomock.NewController(t))
+		kmsRegistry.EXPECT().GetKeyManager(gomock.Any()).Return(&vcskms.MockKMS{Signer: signer}, nil)
+
+		store := NewMockStore(gomock.NewController(t))
+		store.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("store error"))
+
+		svc := verificationreceipt.New(&verificationreceipt.Config{
+			KMSRegistry: kmsRegistry,
+			Store:       store,
+		})
+
+		_, err = svc.Issue(context.Background(), profile, receipt)
+		require.ErrorContains(t, err, "store receipt: store error")
+	})
+}
+
+func TestService_Get(t *testing.T) {
+	store := NewMockStore(gomock.NewController(t))
+	store.EXPECT().Get(gomock.Any(), "receiptID").Return(&verificationreceipt.Record{ID: "receiptID"}, nil)
+
+	svc := verificationreceipt.New(&verificationreceipt.Config{Store: store})
+
+	record, err := svc.Get(context.Background(), "receiptID")
+	require.NoError(t, err)
+	require.Equal(t, "receiptID", record.ID)
+}
+
+func TestNewCredentialReceipt(t *testing.T) {
+	credential, err := verifiable.CreateCredential(verifiable.CredentialContents{
+		ID:      "http://example.edu/credentials/1872",
+		Context: []string{"https://www.w3.org/2018/credentials/v1"},
+		Types:   []string{verifiable.VCType},
+		Issuer:  &verifiable.Issuer{ID: "did:example:issuer"},
+	}, nil)
+	require.NoError(t, err)
+
+	profile := &profileapi.Verifier{
+		ID:      "profileID",
+		Version: "v1.0",
+		Checks: &profileapi.VerificationChecks{
+			Credential: profileapi.CredentialChecks{
+				Proof:            true,
+				Status:           true,
+				CredentialExpiry: true,
+				LinkedDomain:     true,
+			},
+		},
+	}
+
+	statusLists := []credentialstatus.StatusListVersion{{URL: "https://example.com/status/1", Digest: "digest"}}
+
+	receipt, err := verificationreceipt.NewCredentialReceipt("receiptID", profile, credential,
+		[]verifycredential.CredentialsVerificationCheckResult{{Check: "credentialStatus", Error: "revoked"}},
+		statusLists)
+	require.NoError(t, err)
+
+	require.Equal(t, "receiptID", receipt.ID)
+	require.Equal(t, "profileID", receipt.ProfileID)
+	require.Equal(t, "v1.0", receipt.ProfileVersion)
+	require.Equal(t, verificationreceipt.DigestAlgorithm, receipt.DigestAlgorithm)
+	require.Empty(t, receipt.PresentationDigests)
+	require.Len(t, receipt.Credentials, 1)
+	require.Equal(t, "http://example.edu/credentials/1872", receipt.Credentials[0].ID)
+	require.NotEmpty(t, receipt.Credentials[0].Digest)
+	require.Equal(t, statusLists, receipt.StatusLists)
+	require.Equal(t, []*verificationreceipt.CheckResult{
+		{Check: "credentialExpiry", Passed: true},
+		{Check: "linkedDomain", Passed: true},
+		{Check: "proof", Passed: true},
+		{Check: "credentialStatus", Passed: false, Error: "revoked"},
+	}, receipt.Checks)
+}
+
+func TestNewPresentationReceipt(t *testing.T) {
+	credential, err := verifiable.CreateCredential(verifiable.CredentialContents{
+		ID:      "http://example.edu/credentials/1872",
+		Context: []string{"https://www.w3.org/2018/credentials/v1"},
+		Types:   []string{verifiable.VCType},
+		Issuer:  &verifiable.Issuer{ID: "did:example:issuer"},
+	}, nil)
+	require.NoError(t, err)
+
+	presentation, err := verifiable.NewPresentation(verifiable.WithCredentials(credential))
+	require.NoError(t, err)
+
+	jwtPresentation, err := verifiable.NewPresentation()
+	require.NoError(t, err)
+
+	jwtPresentation.JWT = "eyJhbGciOiJFZERTQSJ9.e30.c2ln"
+
+	profile := &profileapi.Verifier{
+		ID:      "profileID",
+		Version: "v1.0",
+		Checks: &profileapi.VerificationChecks{
+			Credential: profileapi.CredentialChecks{
+				Proof:           true,
+				Strict:          true,
+				IssuerTrustList: map[string]profileapi.TrustList{"did:example:issuer": {}},
+			},
+			Presentation: &profileapi.PresentationChecks{
+				Proof: true,
+			},
+		},
+	}
+
+	receipt, err := verificationreceipt.NewPresentationReceipt("receiptID", profile,
+		[]*verifiable.Presentation{presentation, jwtPresentation},
+		[]verifypresentation.PresentationVerificationCheckResult{{Check: "credentialStrict", Error: "strict error"}},
+		nil)
+	require.NoError(t, err)
+
+	require.Equal(t, "receiptID", receipt.ID)
+	require.Len(t, receipt.PresentationDigests, 2)
+	require.NotEqual(t, receipt.PresentationDigests[0], receipt.PresentationDigests[1])
+	require.Len(t, receipt.Credentials, 1)
+	require.Equal(t, "http://example.edu/credentials/1872", receipt.Credentials[0].ID)
+	require.Empty(t, receipt.StatusLists)
+	require.Equal(t, []*verificationreceipt.CheckResult{
+		{Check: "proof", Passed: true},
+		{Check: "issuerTrustList", Passed: true},
+		{Check: "credentialProof", Passed: true},
+		{Check: "credentialStrict", Passed: false, Error: "strict error"},
+	}, receipt.Checks)
+}
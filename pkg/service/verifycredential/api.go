@@ -19,6 +19,17 @@ type CredentialsVerificationCheckResult struct {
 	Check              string
 	Error              string
 	VerificationMethod string
+	// Proofs contains verification results of every credential proof for "proof" check.
+	Proofs []ProofVerificationResult
+}
+
+// ProofVerificationResult contains verification result of a single credential proof.
+type ProofVerificationResult struct {
+	ID                 string
+	Type               string
+	Cryptosuite        string
+	VerificationMethod string
+	Error              string
 }
 
 // Options represents options for verify credential.
@@ -10,9 +10,12 @@ package verifycredential
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/piprate/json-gold/ld"
 	"github.com/trustbloc/vc-go/proof/defaults"
@@ -20,14 +23,21 @@ import (
 
 	vdrapi "github.com/trustbloc/did-go/vdr/api"
 	"github.com/trustbloc/vc-go/dataintegrity"
+	"github.com/trustbloc/vc-go/dataintegrity/models"
+	"github.com/trustbloc/vc-go/dataintegrity/suite"
 	"github.com/trustbloc/vc-go/dataintegrity/suite/ecdsa2019"
 	"github.com/trustbloc/vc-go/verifiable"
 
 	"github.com/trustbloc/vcs/pkg/doc/vc"
 	"github.com/trustbloc/vcs/pkg/doc/vc/bitstring"
 	"github.com/trustbloc/vcs/pkg/doc/vc/crypto"
+	"github.com/trustbloc/vcs/pkg/doc/vc/dataintegrity/bbsplus2023"
+	"github.com/trustbloc/vcs/pkg/doc/vc/dataintegrity/eddsa2022"
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
+	"github.com/trustbloc/vcs/pkg/doc/vc/x5c"
 	"github.com/trustbloc/vcs/pkg/internal/common/diddoc"
 	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
 )
 
 const (
@@ -48,6 +58,8 @@ type Config struct {
 	DocumentLoader          ld.DocumentLoader
 	VDR                     vdrapi.Registry
 	HTTPClient              httpClient
+	// X509TrustStore validates certificate chains of credentials with "x5c" header.
+	X509TrustStore *x5c.TrustStore
 }
 
 type Service struct {
@@ -56,6 +68,7 @@ type Service struct {
 	documentLoader          ld.DocumentLoader
 	vdr                     vdrapi.Registry
 	httpClient              httpClient
+	x509TrustStore          *x5c.TrustStore
 }
 
 func New(config *Config) *Service {
@@ -65,6 +78,7 @@ func New(config *Config) *Service {
 		documentLoader:          config.DocumentLoader,
 		vdr:                     config.VDR,
 		httpClient:              config.HTTPClient,
+		x509TrustStore:          config.X509TrustStore,
 	}
 }
 
@@ -83,22 +97,39 @@ func (s *Service) VerifyCredential(ctx context.Context, credential *verifiable.C
 		}
 	}
 	if checks.Proof {
-		err := s.ValidateCredentialProof(ctx, credential, opts.Challenge, opts.Domain, false, !credential.IsJWT())
+		proofs, err := s.verifyProofs(credential, opts.Challenge, opts.Domain, false, !credential.IsJWT(),
+			checks.ProofPolicy)
 		if err != nil {
 			result = append(result, CredentialsVerificationCheckResult{
-				Check: "proof",
-				Error: err.Error(),
+				Check:  "proof",
+				Error:  err.Error(),
+				Proofs: proofs,
 			})
 		}
 	}
 	if checks.Status {
 		credentialContents := credential.Contents()
 
+		if vc.IsCOSEEnvelopedCredential(credential) {
+			securedCredential, err := vc.ParseCOSECredential(credential, nil,
+				verifiable.WithJSONLDDocumentLoader(s.documentLoader))
+			if err != nil {
+				return nil, fmt.Errorf("parse vc+cose credential: %w", err)
+			}
+
+			credentialContents = securedCredential.Contents()
+		}
+
 		if credentialContents.Status == nil {
 			return nil, fmt.Errorf("vc missing status list field")
 		}
 
-		err := s.ValidateVCStatus(ctx, credentialContents.Status, credentialContents.Issuer)
+		statusCtx := ctx
+		if checks.StatusListMaxAge != nil {
+			statusCtx = credentialstatus.WithStatusListMaxAge(ctx, *checks.StatusListMaxAge)
+		}
+
+		err := s.ValidateVCStatus(statusCtx, credentialContents.Status, credentialContents.Issuer)
 		if err != nil {
 			result = append(result, CredentialsVerificationCheckResult{
 				Check: "credentialStatus",
@@ -110,15 +141,15 @@ func (s *Service) VerifyCredential(ctx context.Context, credential *verifiable.C
 	return result, nil
 }
 
-func (s *Service) verifyVC(vc *verifiable.Credential, strictValidation bool) error {
+func (s *Service) credentialOpts(strictValidation bool) ([]verifiable.CredentialOpt, error) {
 	diVerifier, err := s.getDataIntegrityVerifier()
 	if err != nil {
-		return fmt.Errorf("get data integrity verifier: %w", err)
+		return nil, fmt.Errorf("get data integrity verifier: %w", err)
 	}
 
 	opts := []verifiable.CredentialOpt{
 		verifiable.WithProofChecker(
-			defaults.NewDefaultProofChecker(vermethod.NewVDRResolver(s.vdr)),
+			x5c.NewProofChecker(defaults.NewDefaultProofChecker(vermethod.NewVDRResolver(s.vdr)), s.x509TrustStore),
 		),
 		verifiable.WithJSONLDDocumentLoader(s.documentLoader),
 		verifiable.WithDataIntegrityVerifier(diVerifier),
@@ -132,12 +163,42 @@ func (s *Service) verifyVC(vc *verifiable.Credential, strictValidation bool) err
 		opts = append(opts, verifiable.WithStrictValidation())
 	}
 
-	err = vc.ValidateCredential(opts...)
+	return opts, nil
+}
+
+// validateCredential validates the credential against its VC Data Model version.
+// vc-go supports VC Data Model v1.1 only, so v2.0 credentials are validated separately.
+func (s *Service) validateCredential(
+	credential *verifiable.Credential,
+	opts []verifiable.CredentialOpt,
+	strictValidation bool,
+) error {
+	var err error
+
+	if vcutil.IsDataModelV2(credential.Contents().Context) {
+		err = vc.ValidateDataModelV2(credential, s.documentLoader, strictValidation)
+	} else {
+		err = credential.ValidateCredential(opts...)
+	}
+
 	if err != nil {
 		return fmt.Errorf("verifiable credential validation error : %w", err)
 	}
 
-	err = vc.CheckProof(opts...)
+	return nil
+}
+
+func (s *Service) verifyVC(credential *verifiable.Credential, strictValidation bool) error {
+	opts, err := s.credentialOpts(strictValidation)
+	if err != nil {
+		return err
+	}
+
+	if err = s.validateCredential(credential, opts, strictValidation); err != nil {
+		return err
+	}
+
+	err = credential.CheckProof(opts...)
 	if err != nil {
 		return fmt.Errorf("verifiable credential proof check error : %w", err)
 	}
@@ -145,24 +206,126 @@ func (s *Service) verifyVC(vc *verifiable.Credential, strictValidation bool) err
 	return nil
 }
 
-// ValidateCredentialProof validate credential proof.
-func (s *Service) ValidateCredentialProof(_ context.Context, credential *verifiable.Credential, proofChallenge,
-	proofDomain string, vcInVPValidation, strictValidation bool) error { // nolint: lll,gocyclo
-	err := s.verifyVC(credential, strictValidation)
+// verifyCOSEVC checks COSE_Sign1 signature of the enveloped credential and validates the secured credential.
+func (s *Service) verifyCOSEVC(credential *verifiable.Credential, strictValidation bool) error {
+	securedCredential, err := vc.ParseCOSECredential(credential,
+		defaults.NewDefaultProofChecker(vermethod.NewVDRResolver(s.vdr)),
+		verifiable.WithJSONLDDocumentLoader(s.documentLoader))
+	if err != nil {
+		return fmt.Errorf("verifiable credential proof check error : %w", err)
+	}
+
+	opts, err := s.credentialOpts(strictValidation)
 	if err != nil {
 		return err
 	}
 
+	return s.validateCredential(securedCredential, opts, strictValidation)
+}
+
+// ValidateCredentialProof validate credential proof. All proofs of the credential must be valid.
+func (s *Service) ValidateCredentialProof(_ context.Context, credential *verifiable.Credential, proofChallenge,
+	proofDomain string, vcInVPValidation, strictValidation bool) error {
+	_, err := s.verifyProofs(credential, proofChallenge, proofDomain, vcInVPValidation, strictValidation, nil)
+
+	return err
+}
+
+// verifyProofs verifies every proof of the credential and applies the proof policy to the results.
+func (s *Service) verifyProofs(
+	credential *verifiable.Credential,
+	proofChallenge, proofDomain string,
+	vcInVPValidation, strictValidation bool,
+	policy *profileapi.ProofPolicy,
+) ([]ProofVerificationResult, error) {
 	if credential.IsJWT() {
-		return nil
+		return nil, s.verifyVC(credential, strictValidation)
+	}
+
+	if vc.IsCOSEEnvelopedCredential(credential) {
+		return nil, s.verifyCOSEVC(credential, strictValidation)
+	}
+
+	opts, err := s.credentialOpts(strictValidation)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.validateCredential(credential, opts, strictValidation); err != nil {
+		return nil, err
 	}
 
-	if len(credential.Proofs()) == 0 {
-		return errors.New("verifiable credential doesn't contains proof")
+	proofs := credential.Proofs()
+	if len(proofs) == 0 {
+		return nil, errors.New("verifiable credential doesn't contains proof")
 	}
 
-	// TODO https://github.com/trustbloc/vcs/issues/412 figure out the process when vc has more than one proof
-	proof := credential.Proofs()[0]
+	proofsByID := map[string]verifiable.Proof{}
+
+	for _, proof := range proofs {
+		if id := proofField(proof, "id"); id != "" {
+			proofsByID[id] = proof
+		}
+	}
+
+	results := make([]ProofVerificationResult, len(proofs))
+	errs := make([]error, len(proofs))
+
+	for i, proof := range proofs {
+		results[i] = ProofVerificationResult{
+			ID:                 proofField(proof, "id"),
+			Type:               proofField(proof, "type"),
+			Cryptosuite:        proofField(proof, "cryptosuite"),
+			VerificationMethod: proofField(proof, "verificationMethod"),
+		}
+
+		proofVC := credential
+		if len(proofs) > 1 {
+			if proofVC, err = s.withSingleProof(credential, proof); err != nil {
+				return nil, err
+			}
+		}
+
+		errs[i] = s.verifyProof(proofVC, proof, proofsByID, proofChallenge, proofDomain, vcInVPValidation, opts)
+		if errs[i] != nil {
+			results[i].Error = errs[i].Error()
+		}
+	}
+
+	return results, applyProofPolicy(policy, results, errs)
+}
+
+// verifyProof verifies a credential proof. Credential is expected to contain only the given proof.
+func (s *Service) verifyProof(
+	credential *verifiable.Credential,
+	proof verifiable.Proof,
+	proofsByID map[string]verifiable.Proof,
+	proofChallenge, proofDomain string,
+	vcInVPValidation bool,
+	opts []verifiable.CredentialOpt,
+) error {
+	var previousProofs []verifiable.Proof
+
+	for _, previousProof := range previousProofIDs(proof) {
+		p, ok := proofsByID[previousProof]
+		if !ok {
+			return fmt.Errorf("previous proof %s not found", previousProof)
+		}
+
+		previousProofs = append(previousProofs, p)
+	}
+
+	var checkErr error
+
+	if len(previousProofs) > 0 {
+		checkErr = s.checkChainedProof(credential, proof, previousProofs)
+	} else {
+		checkErr = credential.CheckProof(opts...)
+	}
+
+	if checkErr != nil {
+		return fmt.Errorf("verifiable credential proof check error : %w", checkErr)
+	}
 
 	if !vcInVPValidation {
 		// validate challenge
@@ -202,6 +365,199 @@ func (s *Service) ValidateCredentialProof(_ context.Context, credential *verifia
 	return nil
 }
 
+// checkChainedProof checks the data integrity proof of a proof chain. As defined by the data integrity
+// specification, the proof is verified over the credential secured with the proofs referenced
+// by its previousProof.
+func (s *Service) checkChainedProof(
+	credential *verifiable.Credential,
+	proof verifiable.Proof,
+	previousProofs []verifiable.Proof,
+) error {
+	if proofField(proof, "type") != models.DataIntegrityProof {
+		return fmt.Errorf("previousProof is supported for %s only", models.DataIntegrityProof)
+	}
+
+	proofBytes, err := json.Marshal(proof)
+	if err != nil {
+		return fmt.Errorf("marshal proof: %w", err)
+	}
+
+	var diProof models.Proof
+
+	if err = json.Unmarshal(proofBytes, &diProof); err != nil {
+		return fmt.Errorf("unmarshal data integrity proof: %w", err)
+	}
+
+	created, err := time.Parse(models.DateTimeFormat, diProof.Created)
+	if err != nil {
+		return fmt.Errorf("parse proof created: %w", err)
+	}
+
+	securedWith := make([]interface{}, 0, len(previousProofs))
+	for _, p := range previousProofs {
+		securedWith = append(securedWith, map[string]interface{}(p))
+	}
+
+	raw := credential.ToRawJSON()
+	raw["proof"] = securedWith
+
+	doc, err := json.Marshal(raw)
+	if err != nil {
+		return fmt.Errorf("marshal credential: %w", err)
+	}
+
+	verificationMethod, err := s.resolveVerificationMethod(diProof.VerificationMethod)
+	if err != nil {
+		return err
+	}
+
+	suiteVerifier, err := s.getDataIntegritySuiteVerifier(diProof.CryptoSuite)
+	if err != nil {
+		return err
+	}
+
+	return suiteVerifier.VerifyProof(doc, &diProof, &models.ProofOptions{
+		Purpose:              diProof.ProofPurpose,
+		VerificationMethodID: diProof.VerificationMethod,
+		VerificationMethod:   verificationMethod,
+		ProofType:            models.DataIntegrityProof,
+		SuiteType:            diProof.CryptoSuite,
+		Created:              created,
+	})
+}
+
+func (s *Service) resolveVerificationMethod(vmID string) (*models.VerificationMethod, error) {
+	didDoc, err := diddoc.GetDIDDocFromVerificationMethod(vmID, s.vdr)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, verifications := range didDoc.VerificationMethods() {
+		for _, v := range verifications {
+			if v.VerificationMethod.ID == vmID || didDoc.ID+v.VerificationMethod.ID == vmID {
+				vm := v.VerificationMethod
+
+				return &vm, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("verification method %s not found", vmID)
+}
+
+// withSingleProof returns a copy of the credential with the given proof only.
+func (s *Service) withSingleProof(
+	credential *verifiable.Credential,
+	proof verifiable.Proof,
+) (*verifiable.Credential, error) {
+	raw := credential.ToRawJSON()
+	raw["proof"] = map[string]interface{}(proof)
+
+	vcBytes, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("marshal credential: %w", err)
+	}
+
+	proofVC, err := verifiable.ParseCredential(vcBytes,
+		verifiable.WithDisabledProofCheck(),
+		verifiable.WithCredDisableValidation(),
+		verifiable.WithJSONLDDocumentLoader(s.documentLoader),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("parse credential: %w", err)
+	}
+
+	return proofVC, nil
+}
+
+// applyProofPolicy returns an error if the proof verification results do not satisfy the policy.
+func applyProofPolicy(policy *profileapi.ProofPolicy, results []ProofVerificationResult, errs []error) error {
+	policyType := profileapi.ProofPolicyAll
+	if policy != nil && policy.Type != "" {
+		policyType = policy.Type
+	}
+
+	switch policyType {
+	case profileapi.ProofPolicyAll:
+		for i, err := range errs {
+			if err == nil {
+				continue
+			}
+
+			if len(errs) == 1 {
+				return err
+			}
+
+			return fmt.Errorf("proof %d: %w", i, err)
+		}
+
+		return nil
+	case profileapi.ProofPolicyAny:
+		for _, err := range errs {
+			if err == nil {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("no valid proof: %w", errors.Join(errs...))
+	case profileapi.ProofPolicyCryptosuites:
+		var missing []string
+
+		for _, suite := range policy.Cryptosuites {
+			if !hasValidProof(suite, results) {
+				missing = append(missing, suite)
+			}
+		}
+
+		if len(missing) > 0 {
+			return fmt.Errorf("no valid proof for required cryptosuites: %s", strings.Join(missing, ", "))
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported proof policy type: %s", policyType)
+	}
+}
+
+func hasValidProof(suite string, results []ProofVerificationResult) bool {
+	for _, r := range results {
+		if r.Error != "" {
+			continue
+		}
+
+		if r.Cryptosuite == suite || (r.Cryptosuite == "" && r.Type == suite) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func previousProofIDs(proof verifiable.Proof) []string {
+	switch v := proof["previousProof"].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		ids := make([]string, 0, len(v))
+
+		for _, id := range v {
+			if s, ok := id.(string); ok {
+				ids = append(ids, s)
+			}
+		}
+
+		return ids
+	default:
+		return nil
+	}
+}
+
+func proofField(proof verifiable.Proof, name string) string {
+	s, _ := proof[name].(string) //nolint:errcheck
+
+	return s
+}
+
 func (s *Service) ValidateVCStatus(ctx context.Context, vcStatus *verifiable.TypedID,
 	issuer *verifiable.Issuer) error {
 	vcStatusProcessor, err := s.vcStatusProcessorGetter(vc.StatusType(vcStatus.Type))
@@ -228,6 +584,10 @@ func (s *Service) ValidateVCStatus(ctx context.Context, vcStatus *verifiable.Typ
 		return err
 	}
 
+	if err = credentialstatus.RecordStatusList(ctx, statusVCURL, statusListVC); err != nil {
+		return err
+	}
+
 	statusListVCC := statusListVC.Contents()
 
 	// TODO: check this on review. Previously we compared only issuer ids. So in case if both have empty issuers
@@ -259,16 +619,36 @@ func (s *Service) ValidateVCStatus(ctx context.Context, vcStatus *verifiable.Typ
 }
 
 func (s *Service) getDataIntegrityVerifier() (*dataintegrity.Verifier, error) {
-	verifySuite := ecdsa2019.NewVerifierInitializer(&ecdsa2019.VerifierInitializerOptions{
-		LDDocumentLoader: s.documentLoader,
-	})
-
 	verifier, err := dataintegrity.NewVerifier(&dataintegrity.Options{
 		DIDResolver: s.vdr,
-	}, verifySuite)
+	}, s.dataIntegritySuites()...)
 	if err != nil {
 		return nil, fmt.Errorf("new verifier: %w", err)
 	}
 
 	return verifier, nil
 }
+
+func (s *Service) getDataIntegritySuiteVerifier(cryptosuite string) (suite.Verifier, error) {
+	for _, initializer := range s.dataIntegritySuites() {
+		if initializer.Type() == cryptosuite {
+			return initializer.Verifier()
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported cryptosuite %s", cryptosuite)
+}
+
+func (s *Service) dataIntegritySuites() []suite.VerifierInitializer {
+	return []suite.VerifierInitializer{
+		ecdsa2019.NewVerifierInitializer(&ecdsa2019.VerifierInitializerOptions{
+			LDDocumentLoader: s.documentLoader,
+		}),
+		eddsa2022.NewVerifierInitializer(&eddsa2022.VerifierInitializerOptions{
+			LDDocumentLoader: s.documentLoader,
+		}),
+		bbsplus2023.NewVerifierInitializer(&bbsplus2023.VerifierInitializerOptions{
+			LDDocumentLoader: s.documentLoader,
+		}),
+	}
+}
@@ -9,12 +9,16 @@ package verifycredential
 import (
 	"context"
 	_ "embed"
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/trustbloc/did-go/doc/did"
+	utiltime "github.com/trustbloc/did-go/doc/util/time"
 	ariesmockstorage "github.com/trustbloc/did-go/legacy/mock/storage"
 	vdrapi "github.com/trustbloc/did-go/vdr/api"
 	vdrmock "github.com/trustbloc/did-go/vdr/mock"
@@ -24,15 +28,18 @@ import (
 	"github.com/trustbloc/kms-go/wrapper/api"
 	"github.com/trustbloc/kms-go/wrapper/localsuite"
 	"github.com/trustbloc/vc-go/dataintegrity"
+	"github.com/trustbloc/vc-go/dataintegrity/models"
 	"github.com/trustbloc/vc-go/dataintegrity/suite/ecdsa2019"
 	"github.com/trustbloc/vc-go/verifiable"
 
+	"github.com/trustbloc/vcs/internal/mock/vcskms"
 	"github.com/trustbloc/vcs/pkg/doc/vc"
 	"github.com/trustbloc/vcs/pkg/doc/vc/crypto"
 	vcs "github.com/trustbloc/vcs/pkg/doc/verifiable"
 	"github.com/trustbloc/vcs/pkg/internal/mock/status"
 	"github.com/trustbloc/vcs/pkg/internal/testutil"
 	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
 )
 
 var (
@@ -287,6 +294,52 @@ func TestService_VerifyCredential(t *testing.T) {
 			})
 		})
 	})
+
+	t.Run("Status list max age", func(t *testing.T) {
+		t.Parallel()
+		loader := testutil.DocumentLoader(t)
+		maxAge := 30 * time.Second
+
+		mockStatusListVCGetter := NewMockStatusListVCResolver(gomock.NewController(t))
+		mockStatusListVCGetter.EXPECT().Resolve(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+			func(ctx context.Context, _ string) (*verifiable.Credential, error) {
+				ctxMaxAge, ok := credentialstatus.StatusListMaxAge(ctx)
+				require.True(t, ok)
+				require.Equal(t, maxAge, ctxMaxAge)
+
+				return createVC(t, verifiable.CredentialContents{
+					Subject: []verifiable.Subject{{
+						CustomFields: map[string]interface{}{
+							"encodedList": "H4sIAAAAAAAA_2IABAAA__-N7wLSAQAAAA",
+						},
+					}},
+				}), nil
+			})
+
+		vc, vdr := testutil.SignedVC(t, []byte(sampleVCJsonLD), kmskeytypes.ED25519Type,
+			verifiable.SignatureProofValue, vcs.Ldp, loader, crypto.AssertionMethod, false)
+
+		profile := *testProfile
+		profile.Checks = &profileapi.VerificationChecks{
+			Credential: profileapi.CredentialChecks{
+				Status:           true,
+				StatusListMaxAge: &maxAge,
+			},
+		}
+
+		op := New(&Config{
+			VCStatusProcessorGetter: (&status.MockStatusProcessorGetter{
+				StatusProcessor: &status.MockVCStatusProcessor{StatusListIndex: 1},
+			}).GetMockStatusProcessor,
+			StatusListVCResolver: mockStatusListVCGetter,
+			VDR:                  vdr,
+			DocumentLoader:       loader,
+		})
+
+		res, err := op.VerifyCredential(context.Background(), vc, &Options{}, &profile)
+		require.NoError(t, err)
+		require.Nil(t, res)
+	})
 }
 
 func TestService_checkVCStatus(t *testing.T) {
@@ -639,6 +692,47 @@ func TestService_checkVCStatus(t *testing.T) {
 	}
 }
 
+func TestService_ValidateVCStatus_RecordStatusList(t *testing.T) {
+	issued := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	statusListVCResolver := NewMockStatusListVCResolver(gomock.NewController(t))
+	statusListVCResolver.EXPECT().Resolve(gomock.Any(), "https://example.com/status/1").Times(2).Return(
+		createVC(t, verifiable.CredentialContents{
+			ID: "https://example.com/status/1",
+			Subject: []verifiable.Subject{{
+				CustomFields: map[string]interface{}{
+					"encodedList": "H4sIAAAAAAAA_2IABAAA__-N7wLSAQAAAA",
+				},
+			}},
+			Issuer: &verifiable.Issuer{ID: "did:trustblock:abc"},
+			Issued: utiltime.NewTime(issued),
+		}), nil)
+
+	s := &Service{
+		vcStatusProcessorGetter: (&status.MockStatusProcessorGetter{
+			StatusProcessor: &status.MockVCStatusProcessor{
+				StatusVCURI:     "https://example.com/status/1",
+				StatusListIndex: 1,
+			},
+		}).GetMockStatusProcessor,
+		statusListVCURIResolver: statusListVCResolver,
+	}
+
+	recorder := &credentialstatus.StatusListRecorder{}
+	ctx := credentialstatus.WithStatusListRecorder(context.Background(), recorder)
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, s.ValidateVCStatus(ctx, &verifiable.TypedID{Type: "StatusList2021Entry"},
+			&verifiable.Issuer{ID: "did:trustblock:abc"}))
+	}
+
+	versions := recorder.Versions()
+	require.Len(t, versions, 1)
+	require.Equal(t, "https://example.com/status/1", versions[0].URL)
+	require.NotEmpty(t, versions[0].Digest)
+	require.True(t, issued.Equal(*versions[0].ValidFrom))
+}
+
 func TestService_ValidateCredentialProof(t *testing.T) {
 	loader := testutil.DocumentLoader(t)
 	signedVC, vdr := testutil.SignedVC(
@@ -800,6 +894,474 @@ func Test_DataIntegrity_SignVerify(t *testing.T) {
 	})
 }
 
+func TestService_VerifyCredential_COSE(t *testing.T) {
+	const issuerDID = "did:example:cose-issuer"
+
+	loader := testutil.DocumentLoader(t)
+	suite := createKMS(t)
+
+	keyCreator, err := suite.KeyCreator()
+	require.NoError(t, err)
+
+	multiSigner, err := suite.KMSCryptoMultiSigner()
+	require.NoError(t, err)
+
+	newVDR := func(t *testing.T, keyType kmskeytypes.KeyType) (*vdrmock.VDRegistry, string) {
+		t.Helper()
+
+		key, createErr := keyCreator.Create(keyType)
+		require.NoError(t, createErr)
+
+		vm, vmErr := did.NewVerificationMethodFromJWK(issuerDID+"#"+key.KeyID, "JsonWebKey2020", issuerDID, key)
+		require.NoError(t, vmErr)
+
+		return &vdrmock.VDRegistry{ResolveValue: &did.Doc{
+			ID:                 issuerDID,
+			VerificationMethod: []did.VerificationMethod{*vm},
+			AssertionMethod:    []did.Verification{{VerificationMethod: *vm, Relationship: did.AssertionMethod}},
+			Authentication:     []did.Verification{{VerificationMethod: *vm, Relationship: did.Authentication}},
+		}}, key.KeyID
+	}
+
+	credential, err := vc.ParseCredential([]byte(`{
+		"@context": ["https://www.w3.org/ns/credentials/v2"],
+		"id": "https://example.com/credentials/1872",
+		"type": ["VerifiableCredential"],
+		"issuer": "`+issuerDID+`",
+		"validFrom": "2024-01-01T00:00:00Z",
+		"credentialSubject": {"id": "did:example:ebfeb1f712ebc6f1c276e12ec21"}
+	}`), verifiable.WithDisabledProofCheck(), verifiable.WithJSONLDDocumentLoader(loader))
+	require.NoError(t, err)
+
+	profile := &profileapi.Verifier{
+		Checks: &profileapi.VerificationChecks{
+			Credential: profileapi.CredentialChecks{Proof: true},
+		},
+	}
+
+	for _, keyType := range []kmskeytypes.KeyType{kmskeytypes.ED25519Type, kmskeytypes.ECDSAP256TypeIEEEP1363} {
+		t.Run(string(keyType), func(t *testing.T) {
+			vdr, keyID := newVDR(t, keyType)
+
+			signed, err := crypto.New(vdr, loader).SignCredential(&vc.Signer{
+				DID:               issuerDID,
+				Creator:           issuerDID + "#" + keyID,
+				KMSKeyID:          keyID,
+				SignatureType:     vcs.JSONWebSignature2020,
+				KeyType:           keyType,
+				KMS:               &vcskms.MockKMS{Signer: multiSigner},
+				Format:            vcs.Jwt,
+				SecuringMechanism: vcs.COSE,
+			}, credential)
+			require.NoError(t, err)
+			require.True(t, vc.IsCOSEEnvelopedCredential(signed))
+
+			res, err := New(&Config{VDR: vdr, DocumentLoader: loader}).
+				VerifyCredential(context.Background(), signed, &Options{}, profile)
+			require.NoError(t, err)
+			require.Empty(t, res)
+
+			// Issuer DID is resolved to another key.
+			otherVDR, _ := newVDR(t, keyType)
+
+			res, err = New(&Config{VDR: otherVDR, DocumentLoader: loader}).
+				VerifyCredential(context.Background(), signed, &Options{}, profile)
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			require.Equal(t, "proof", res[0].Check)
+			require.Contains(t, res[0].Error, "check COSE proof")
+		})
+	}
+}
+
+func TestService_VerifyCredential_MultipleProofs(t *testing.T) {
+	vcJSON := `
+	{
+	 "@context": [
+	   "https://www.w3.org/2018/credentials/v1",
+	   "https://w3c-ccg.github.io/lds-jws2020/contexts/lds-jws2020-v1.json",
+	   "https://www.w3.org/2018/credentials/examples/v1",
+	   "https://w3id.org/security/data-integrity/v1"
+	 ],
+	 "id": "https://example.com/credentials/1872",
+	 "type": [
+	   "VerifiableCredential",
+	   "UniversityDegreeCredential"
+	 ],
+	 "issuer": "did:trustblock:abc",
+	 "issuanceDate": "2020-01-17T15:14:09.724Z",
+	 "credentialSubject": {
+	   "id": "did:example:ebfeb1f712ebc6f1c276e12ec21",
+	   "name": "Jayden Doe"
+	 }
+	}
+	`
+
+	loader := testutil.DocumentLoader(t)
+
+	ldVC, ldVDR := testutil.SignedVC(t, []byte(vcJSON), kmskeytypes.ED25519Type,
+		verifiable.SignatureProofValue, vcs.Ldp, loader, crypto.AssertionMethod, false)
+
+	docResolution, err := ldVDR.Resolve("did:trustblock:abc")
+	require.NoError(t, err)
+
+	didDoc := docResolution.DIDDocument
+
+	kmsCrypto, err := createKMS(t).KMSCrypto()
+	require.NoError(t, err)
+
+	diKey, err := kmsCrypto.Create(kmskeytypes.ECDSAP256IEEEP1363)
+	require.NoError(t, err)
+
+	diVM, err := did.NewVerificationMethodFromJWK(didDoc.ID+"#di-key", "JsonWebKey2020", didDoc.ID, diKey)
+	require.NoError(t, err)
+
+	didDoc.VerificationMethod = append(didDoc.VerificationMethod, *diVM)
+	didDoc.AssertionMethod = append(didDoc.AssertionMethod, did.Verification{VerificationMethod: *diVM})
+
+	vdr := &vdrmock.VDRegistry{
+		ResolveFunc: func(didID string, opts ...vdrapi.DIDMethodOption) (*did.DocResolution, error) {
+			return &did.DocResolution{DIDDocument: didDoc}, nil
+		}}
+
+	diSigner, err := dataintegrity.NewSigner(&dataintegrity.Options{DIDResolver: vdr},
+		ecdsa2019.NewSignerInitializer(&ecdsa2019.SignerInitializerOptions{
+			SignerGetter:     ecdsa2019.WithKMSCryptoWrapper(kmsCrypto),
+			LDDocumentLoader: loader,
+		}))
+	require.NoError(t, err)
+
+	diVC, err := verifiable.ParseCredential([]byte(vcJSON),
+		verifiable.WithDisabledProofCheck(),
+		verifiable.WithJSONLDDocumentLoader(loader))
+	require.NoError(t, err)
+
+	require.NoError(t, diVC.AddDataIntegrityProof(&verifiable.DataIntegrityProofContext{
+		SigningKeyID: diVM.ID,
+		ProofPurpose: crypto.AssertionMethod,
+		CryptoSuite:  ecdsa2019.SuiteType,
+		Domain:       crypto.Domain,
+		Challenge:    crypto.Challenge,
+	}, diSigner))
+
+	withProofs := func(t *testing.T, diProofModifier func(proof map[string]interface{})) *verifiable.Credential {
+		t.Helper()
+
+		diProof := map[string]interface{}{}
+		for k, v := range diVC.Proofs()[0] {
+			diProof[k] = v
+		}
+
+		if diProofModifier != nil {
+			diProofModifier(diProof)
+		}
+
+		raw := ldVC.ToRawJSON()
+		raw["proof"] = []interface{}{map[string]interface{}(ldVC.Proofs()[0]), diProof}
+
+		vcBytes, marshalErr := json.Marshal(raw)
+		require.NoError(t, marshalErr)
+
+		credential, parseErr := verifiable.ParseCredential(vcBytes,
+			verifiable.WithDisabledProofCheck(),
+			verifiable.WithJSONLDDocumentLoader(loader))
+		require.NoError(t, parseErr)
+		require.Len(t, credential.Proofs(), 2)
+
+		return credential
+	}
+
+	invalidDIProof := func(proof map[string]interface{}) {
+		proof["proofValue"] = "z" + strings.Repeat("1", 86)
+	}
+
+	verifyWithPolicy := func(
+		t *testing.T,
+		credential *verifiable.Credential,
+		policy *profileapi.ProofPolicy,
+	) []CredentialsVerificationCheckResult {
+		t.Helper()
+
+		s := New(&Config{
+			VDR:            vdr,
+			DocumentLoader: loader,
+		})
+
+		res, verifyErr := s.VerifyCredential(context.Background(), credential, &Options{
+			Challenge: crypto.Challenge,
+			Domain:    crypto.Domain,
+		}, &profileapi.Verifier{
+			Checks: &profileapi.VerificationChecks{
+				Credential: profileapi.CredentialChecks{
+					Proof:       true,
+					ProofPolicy: policy,
+				},
+			},
+		})
+		require.NoError(t, verifyErr)
+
+		return res
+	}
+
+	t.Run("all proofs valid", func(t *testing.T) {
+		credential := withProofs(t, nil)
+
+		require.Nil(t, verifyWithPolicy(t, credential, nil))
+		require.Nil(t, verifyWithPolicy(t, credential, &profileapi.ProofPolicy{Type: profileapi.ProofPolicyAll}))
+		require.Nil(t, verifyWithPolicy(t, credential, &profileapi.ProofPolicy{
+			Type:         profileapi.ProofPolicyCryptosuites,
+			Cryptosuites: []string{"JsonWebSignature2020", ecdsa2019.SuiteType},
+		}))
+
+		s := New(&Config{VDR: vdr, DocumentLoader: loader})
+		require.NoError(t, s.ValidateCredentialProof(context.Background(), credential, crypto.Challenge,
+			crypto.Domain, false, true))
+	})
+
+	t.Run("policy all - invalid proof", func(t *testing.T) {
+		res := verifyWithPolicy(t, withProofs(t, invalidDIProof), nil)
+		require.Len(t, res, 1)
+		require.Equal(t, "proof", res[0].Check)
+		require.Contains(t, res[0].Error, "proof 1: verifiable credential proof check error")
+
+		require.Len(t, res[0].Proofs, 2)
+		require.Equal(t, "JsonWebSignature2020", res[0].Proofs[0].Type)
+		require.Empty(t, res[0].Proofs[0].Error)
+		require.Equal(t, "DataIntegrityProof", res[0].Proofs[1].Type)
+		require.Equal(t, ecdsa2019.SuiteType, res[0].Proofs[1].Cryptosuite)
+		require.Equal(t, diVM.ID, res[0].Proofs[1].VerificationMethod)
+		require.NotEmpty(t, res[0].Proofs[1].Error)
+	})
+
+	t.Run("policy any", func(t *testing.T) {
+		policy := &profileapi.ProofPolicy{Type: profileapi.ProofPolicyAny}
+
+		require.Nil(t, verifyWithPolicy(t, withProofs(t, invalidDIProof), policy))
+
+		res := verifyWithPolicy(t, withProofs(t, func(proof map[string]interface{}) {
+			proof["previousProof"] = "urn:uuid:missing"
+		}), &profileapi.ProofPolicy{Type: profileapi.ProofPolicyAny})
+		require.Nil(t, res)
+	})
+
+	t.Run("policy cryptosuites", func(t *testing.T) {
+		credential := withProofs(t, invalidDIProof)
+
+		require.Nil(t, verifyWithPolicy(t, credential, &profileapi.ProofPolicy{
+			Type:         profileapi.ProofPolicyCryptosuites,
+			Cryptosuites: []string{"JsonWebSignature2020"},
+		}))
+
+		res := verifyWithPolicy(t, credential, &profileapi.ProofPolicy{
+			Type:         profileapi.ProofPolicyCryptosuites,
+			Cryptosuites: []string{"JsonWebSignature2020", ecdsa2019.SuiteType},
+		})
+		require.Len(t, res, 1)
+		require.Equal(t, "no valid proof for required cryptosuites: ecdsa-2019", res[0].Error)
+	})
+
+	t.Run("previous proof not found", func(t *testing.T) {
+		res := verifyWithPolicy(t, withProofs(t, func(proof map[string]interface{}) {
+			proof["previousProof"] = "urn:uuid:missing"
+		}), nil)
+		require.Len(t, res, 1)
+		require.Contains(t, res[0].Error, "previous proof urn:uuid:missing not found")
+	})
+
+	t.Run("unsupported policy", func(t *testing.T) {
+		res := verifyWithPolicy(t, withProofs(t, nil), &profileapi.ProofPolicy{Type: "unknown"})
+		require.Len(t, res, 1)
+		require.Equal(t, "unsupported proof policy type: unknown", res[0].Error)
+	})
+}
+
+func TestService_VerifyCredential_ProofChain(t *testing.T) {
+	vcJSON := `
+	{
+	 "@context": [
+	   "https://www.w3.org/2018/credentials/v1",
+	   "https://www.w3.org/2018/credentials/examples/v1",
+	   "https://w3id.org/security/data-integrity/v1"
+	 ],
+	 "id": "https://example.com/credentials/1872",
+	 "type": [
+	   "VerifiableCredential",
+	   "UniversityDegreeCredential"
+	 ],
+	 "issuer": "did:foo:bar",
+	 "issuanceDate": "2020-01-17T15:14:09.724Z",
+	 "credentialSubject": {
+	   "id": "did:example:ebfeb1f712ebc6f1c276e12ec21",
+	   "name": "Jayden Doe"
+	 }
+	}
+	`
+
+	const signingDID = "did:foo:bar"
+
+	loader := testutil.DocumentLoader(t)
+
+	kmsCrypto, err := createKMS(t).KMSCrypto()
+	require.NoError(t, err)
+
+	key, err := kmsCrypto.Create(kmskeytypes.ECDSAP256IEEEP1363)
+	require.NoError(t, err)
+
+	vm, err := did.NewVerificationMethodFromJWK(signingDID+"#key-1", "JsonWebKey2020", signingDID, key)
+	require.NoError(t, err)
+
+	vdr := &vdrmock.VDRegistry{
+		ResolveFunc: func(didID string, opts ...vdrapi.DIDMethodOption) (*did.DocResolution, error) {
+			return makeMockDIDResolution(signingDID, vm, did.AssertionMethod), nil
+		}}
+
+	suiteSigner, err := ecdsa2019.NewSignerInitializer(&ecdsa2019.SignerInitializerOptions{
+		SignerGetter:     ecdsa2019.WithKMSCryptoWrapper(kmsCrypto),
+		LDDocumentLoader: loader,
+	}).Signer()
+	require.NoError(t, err)
+
+	unsecured := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal([]byte(vcJSON), &unsecured))
+
+	// createProof signs the document secured with the previous proof, following the data integrity
+	// add proof algorithm for proof chains.
+	createProof := func(t *testing.T, id string, previousProof map[string]interface{}) map[string]interface{} {
+		t.Helper()
+
+		doc := map[string]interface{}{}
+		for k, v := range unsecured {
+			doc[k] = v
+		}
+
+		if previousProof != nil {
+			doc["proof"] = []interface{}{previousProof}
+		}
+
+		docBytes, marshalErr := json.Marshal(doc)
+		require.NoError(t, marshalErr)
+
+		proof, createErr := suiteSigner.CreateProof(docBytes, &models.ProofOptions{
+			Purpose:              crypto.AssertionMethod,
+			VerificationMethodID: vm.ID,
+			VerificationMethod:   vm,
+			ProofType:            models.DataIntegrityProof,
+			SuiteType:            ecdsa2019.SuiteType,
+			Domain:               crypto.Domain,
+			Challenge:            crypto.Challenge,
+			Created:              time.Now(),
+		})
+		require.NoError(t, createErr)
+
+		proof.ID = id
+
+		if previousProof != nil {
+			proof.PreviousProof = previousProof["id"].(string)
+		}
+
+		proofBytes, marshalErr := json.Marshal(proof)
+		require.NoError(t, marshalErr)
+
+		proofMap := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(proofBytes, &proofMap))
+
+		return proofMap
+	}
+
+	withProofs := func(t *testing.T, proofs ...map[string]interface{}) *verifiable.Credential {
+		t.Helper()
+
+		doc := map[string]interface{}{}
+		for k, v := range unsecured {
+			doc[k] = v
+		}
+
+		allProofs := make([]interface{}, 0, len(proofs))
+		for _, p := range proofs {
+			allProofs = append(allProofs, p)
+		}
+
+		doc["proof"] = allProofs
+
+		vcBytes, marshalErr := json.Marshal(doc)
+		require.NoError(t, marshalErr)
+
+		credential, parseErr := verifiable.ParseCredential(vcBytes,
+			verifiable.WithDisabledProofCheck(),
+			verifiable.WithJSONLDDocumentLoader(loader))
+		require.NoError(t, parseErr)
+
+		return credential
+	}
+
+	verify := func(t *testing.T, credential *verifiable.Credential) []CredentialsVerificationCheckResult {
+		t.Helper()
+
+		s := New(&Config{
+			VDR:            vdr,
+			DocumentLoader: loader,
+		})
+
+		res, verifyErr := s.VerifyCredential(context.Background(), credential, &Options{
+			Challenge: crypto.Challenge,
+			Domain:    crypto.Domain,
+		}, &profileapi.Verifier{
+			Checks: &profileapi.VerificationChecks{
+				Credential: profileapi.CredentialChecks{
+					Proof: true,
+				},
+			},
+		})
+		require.NoError(t, verifyErr)
+
+		return res
+	}
+
+	first := createProof(t, "urn:uuid:proof-1", nil)
+
+	t.Run("chained proof valid", func(t *testing.T) {
+		second := createProof(t, "urn:uuid:proof-2", first)
+
+		require.Nil(t, verify(t, withProofs(t, first, second)))
+	})
+
+	t.Run("chained proof not created over previous proof", func(t *testing.T) {
+		second := createProof(t, "urn:uuid:proof-2", nil)
+		second["previousProof"] = "urn:uuid:proof-1"
+
+		res := verify(t, withProofs(t, first, second))
+		require.Len(t, res, 1)
+		require.Contains(t, res[0].Error, "proof 1: verifiable credential proof check error")
+		require.Empty(t, res[0].Proofs[0].Error)
+		require.NotEmpty(t, res[0].Proofs[1].Error)
+	})
+
+	t.Run("previous proof modified", func(t *testing.T) {
+		second := createProof(t, "urn:uuid:proof-2", first)
+
+		modified := map[string]interface{}{}
+		for k, v := range first {
+			modified[k] = v
+		}
+
+		modified["created"] = "2020-01-01T00:00:00Z"
+
+		res := verify(t, withProofs(t, modified, second))
+		require.Len(t, res, 1)
+		require.NotEmpty(t, res[0].Proofs[0].Error)
+		require.NotEmpty(t, res[0].Proofs[1].Error)
+	})
+
+	t.Run("previousProof of not data integrity proof", func(t *testing.T) {
+		second := createProof(t, "urn:uuid:proof-2", first)
+		second["type"] = "JsonWebSignature2020"
+
+		res := verify(t, withProofs(t, first, second))
+		require.Len(t, res, 1)
+		require.Contains(t, res[0].Error, "previousProof is supported for DataIntegrityProof only")
+	})
+}
+
 func makeMockDIDResolution(id string, vm *did.VerificationMethod, vr did.VerificationRelationship) *did.DocResolution {
 	ver := []did.Verification{{
 		VerificationMethod: *vm,
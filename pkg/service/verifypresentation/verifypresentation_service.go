@@ -19,14 +19,17 @@ import (
 	"github.com/trustbloc/did-go/doc/ld/validator"
 	vdrapi "github.com/trustbloc/did-go/vdr/api"
 	"github.com/trustbloc/logutil-go/pkg/log"
+	"github.com/trustbloc/vc-go/jwt"
 	"github.com/trustbloc/vc-go/proof/defaults"
 	"github.com/trustbloc/vc-go/verifiable"
 	"github.com/trustbloc/vc-go/vermethod"
 
 	"github.com/trustbloc/vcs/internal/logfields"
 	"github.com/trustbloc/vcs/pkg/doc/vc/crypto"
+	"github.com/trustbloc/vcs/pkg/doc/vc/vcutil"
 	"github.com/trustbloc/vcs/pkg/internal/common/diddoc"
 	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
 )
 
 type vcVerifier interface {
@@ -39,19 +42,28 @@ type Config struct {
 	VDR            vdrapi.Registry
 	DocumentLoader ld.DocumentLoader
 	VcVerifier     vcVerifier
+	// ProofChecker checks presentation proofs. Defaults to the proof checker resolving keys with VDR.
+	ProofChecker verifiable.CombinedProofChecker
 }
 
 type Service struct {
 	vdr            vdrapi.Registry
 	documentLoader ld.DocumentLoader
 	vcVerifier     vcVerifier
+	proofChecker   verifiable.CombinedProofChecker
 }
 
 func New(config *Config) *Service {
+	proofChecker := config.ProofChecker
+	if proofChecker == nil {
+		proofChecker = defaults.NewDefaultProofChecker(vermethod.NewVDRResolver(config.VDR))
+	}
+
 	return &Service{
 		vdr:            config.VDR,
 		documentLoader: config.DocumentLoader,
 		vcVerifier:     config.VcVerifier,
+		proofChecker:   proofChecker,
 	}
 }
 
@@ -133,7 +145,12 @@ func (s *Service) VerifyPresentation( //nolint:funlen,gocognit
 	if profile.Checks.Credential.Status {
 		st := time.Now()
 
-		err := s.validateCredentialsStatus(ctx, credentials)
+		statusCtx := ctx
+		if profile.Checks.Credential.StatusListMaxAge != nil {
+			statusCtx = credentialstatus.WithStatusListMaxAge(ctx, *profile.Checks.Credential.StatusListMaxAge)
+		}
+
+		err := s.validateCredentialsStatus(statusCtx, credentials)
 		if err != nil {
 			result = append(result, PresentationVerificationCheckResult{
 				Check: "credentialStatus",
@@ -228,10 +245,8 @@ func (s *Service) checkCredentialStrict(
 
 func (s *Service) checkCredentialExpiry(credentials []*verifiable.Credential) error {
 	for _, credential := range credentials {
-		vcc := credential.Contents()
-
-		if vcc.Expired != nil && time.Now().UTC().After(vcc.Expired.Time) {
-			return errors.New("credential expired")
+		if err := vcutil.CheckValidityPeriod(credential); err != nil {
+			return err
 		}
 	}
 
@@ -282,9 +297,7 @@ func (s *Service) validatePresentationProof(targetPresentation interface{}, opts
 	case []byte:
 		vp, err := verifiable.ParsePresentation(
 			pres,
-			verifiable.WithPresProofChecker(
-				defaults.NewDefaultProofChecker(vermethod.NewVDRResolver(s.vdr)),
-			),
+			verifiable.WithPresProofChecker(s.proofChecker),
 			verifiable.WithPresJSONLDDocumentLoader(s.documentLoader),
 		)
 		if err != nil {
@@ -295,6 +308,42 @@ func (s *Service) validatePresentationProof(targetPresentation interface{}, opts
 	if final.JWT == "" {
 		return s.validateProofData(final, opts)
 	}
+
+	return validateJWTProofData(final.JWT, opts)
+}
+
+// vpJWTClaims are claims of JWT VP binding it to the verifier.
+type vpJWTClaims struct {
+	*jwt.Claims
+
+	Nonce string `json:"nonce"`
+}
+
+// validateJWTProofData checks that JWT VP is issued for the expected domain (aud) and challenge (nonce).
+func validateJWTProofData(vpJWT string, opts *Options) error {
+	if opts == nil || (opts.Challenge == "" && opts.Domain == "") {
+		return nil
+	}
+
+	token, _, err := jwt.Parse(vpJWT)
+	if err != nil {
+		return fmt.Errorf("parse verifiable presentation jwt: %w", err)
+	}
+
+	claims := &vpJWTClaims{}
+
+	if err = token.DecodeClaims(claims); err != nil {
+		return fmt.Errorf("decode verifiable presentation jwt claims: %w", err)
+	}
+
+	if opts.Challenge != "" && claims.Nonce != opts.Challenge {
+		return fmt.Errorf("invalid nonce in the jwt : expected=%s actual=%s", opts.Challenge, claims.Nonce)
+	}
+
+	if opts.Domain != "" && (claims.Claims == nil || !claims.Audience.Contains(opts.Domain)) {
+		return fmt.Errorf("invalid aud in the jwt : expected=%s", opts.Domain)
+	}
+
 	return nil
 }
 
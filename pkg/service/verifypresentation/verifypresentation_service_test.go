@@ -9,6 +9,8 @@ package verifypresentation
 import (
 	"context"
 	_ "embed"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -20,13 +22,16 @@ import (
 	timeutil "github.com/trustbloc/did-go/doc/util/time"
 	vdrapi "github.com/trustbloc/did-go/vdr/api"
 	mockvdr "github.com/trustbloc/did-go/vdr/mock"
+	"github.com/trustbloc/vc-go/proof/defaults"
 	"github.com/trustbloc/vc-go/sdjwt/common"
 	"github.com/trustbloc/vc-go/verifiable"
+	"github.com/trustbloc/vc-go/vermethod"
 
 	"github.com/trustbloc/vcs/pkg/doc/vc/crypto"
 	vcs "github.com/trustbloc/vcs/pkg/doc/verifiable"
 	"github.com/trustbloc/vcs/pkg/internal/testutil"
 	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
 )
 
 var (
@@ -43,6 +48,8 @@ const (
 func TestNew(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
+	proofChecker := defaults.NewDefaultProofChecker(vermethod.NewVDRResolver(&mockvdr.VDRegistry{}))
+
 	type args struct {
 		config *Config
 	}
@@ -58,12 +65,14 @@ func TestNew(t *testing.T) {
 					VDR:            &mockvdr.VDRegistry{},
 					DocumentLoader: testutil.DocumentLoader(t),
 					VcVerifier:     NewMockVcVerifier(ctrl),
+					ProofChecker:   proofChecker,
 				},
 			},
 			want: &Service{
 				vdr:            &mockvdr.VDRegistry{},
 				documentLoader: testutil.DocumentLoader(t),
 				vcVerifier:     NewMockVcVerifier(ctrl),
+				proofChecker:   proofChecker,
 			},
 		},
 	}
@@ -80,6 +89,7 @@ func TestService_VerifyPresentation(t *testing.T) {
 	loader := testutil.DocumentLoader(t)
 	signedClientAttestationVP := testutil.SignedVP(t, clientAttestationVP, vcs.Ldp)
 	signedRequestedCredentialsVP := testutil.SignedVP(t, requestedCredentialsVP, vcs.Ldp)
+	statusListMaxAge := time.Minute
 
 	type fields struct {
 		getVDR        func() vdrapi.Registry
@@ -157,6 +167,50 @@ func TestService_VerifyPresentation(t *testing.T) {
 			want:    nil,
 			wantErr: false,
 		},
+		{
+			name: "OK with status list max age",
+			fields: fields{
+				getVDR: func() vdrapi.Registry {
+					return signedRequestedCredentialsVP.VDR
+				},
+				getVcVerifier: func(t *testing.T) vcVerifier {
+					mockVerifier := NewMockVcVerifier(gomock.NewController(t))
+					mockVerifier.EXPECT().ValidateVCStatus(
+						gomock.Any(),
+						gomock.Any(),
+						gomock.Any()).Times(1).DoAndReturn(
+						func(ctx context.Context, _ *verifiable.TypedID, _ *verifiable.Issuer) error {
+							maxAge, ok := credentialstatus.StatusListMaxAge(ctx)
+							assert.True(t, ok)
+							assert.Equal(t, statusListMaxAge, maxAge)
+
+							return nil
+						})
+					return mockVerifier
+				},
+			},
+			args: args{
+				getPresentation: func(t *testing.T) *verifiable.Presentation {
+					return signedRequestedCredentialsVP.Presentation
+				},
+				profile: &profileapi.Verifier{
+					SigningDID: &profileapi.SigningDID{DID: verifierDID},
+					Checks: &profileapi.VerificationChecks{
+						Presentation: &profileapi.PresentationChecks{},
+						Credential: profileapi.CredentialChecks{
+							Status:           true,
+							StatusListMaxAge: &statusListMaxAge,
+						},
+					},
+				},
+				opts: &Options{
+					Domain:    crypto.Domain,
+					Challenge: crypto.Challenge,
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
 		{
 			name: "Err credential type not in trust list",
 			fields: fields{
@@ -428,10 +482,10 @@ func TestService_validatePresentationProof(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := &Service{
-				vdr:            tt.fields.getVDR(),
-				documentLoader: loader,
-			}
+			s := New(&Config{
+				VDR:            tt.fields.getVDR(),
+				DocumentLoader: loader,
+			})
 			err := s.validatePresentationProof(
 				tt.args.getVpBytes(),
 				tt.args.getOpts(),
@@ -442,6 +496,22 @@ func TestService_validatePresentationProof(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("Injected proof checker", func(t *testing.T) {
+		s := New(&Config{
+			VDR:            signedVPResult.VDR,
+			DocumentLoader: loader,
+			ProofChecker:   defaults.NewDefaultProofChecker(vermethod.NewVDRResolver(&mockvdr.VDRegistry{})),
+		})
+
+		b, err := signedVPResult.Presentation.MarshalJSON()
+		assert.NoError(t, err)
+
+		assert.Error(t, s.validatePresentationProof(b, &Options{
+			Domain:    crypto.Domain,
+			Challenge: crypto.Challenge,
+		}))
+	})
 }
 
 func TestService_validateProofData(t *testing.T) {
@@ -1044,3 +1114,74 @@ func TestService_checkCredentialExpiry(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateJWTProofData(t *testing.T) {
+	vpJWT := func(claims map[string]interface{}) string {
+		header, err := json.Marshal(map[string]interface{}{"alg": "none"})
+		assert.NoError(t, err)
+
+		payload, err := json.Marshal(claims)
+		assert.NoError(t, err)
+
+		return base64.RawURLEncoding.EncodeToString(header) + "." +
+			base64.RawURLEncoding.EncodeToString(payload) + "."
+	}
+
+	token := vpJWT(map[string]interface{}{
+		"aud":   crypto.Domain,
+		"nonce": crypto.Challenge,
+		"vp":    map[string]interface{}{},
+	})
+
+	tests := []struct {
+		name    string
+		vpJWT   string
+		opts    *Options
+		wantErr string
+	}{
+		{
+			name:  "OK",
+			vpJWT: token,
+			opts:  &Options{Domain: crypto.Domain, Challenge: crypto.Challenge},
+		},
+		{
+			name:  "OK no options",
+			vpJWT: vpJWT(map[string]interface{}{"vp": map[string]interface{}{}}),
+		},
+		{
+			name:    "Error nonce mismatch",
+			vpJWT:   token,
+			opts:    &Options{Domain: crypto.Domain, Challenge: "other"},
+			wantErr: "invalid nonce in the jwt",
+		},
+		{
+			name:    "Error nonce missing",
+			vpJWT:   vpJWT(map[string]interface{}{"aud": crypto.Domain}),
+			opts:    &Options{Challenge: crypto.Challenge},
+			wantErr: "invalid nonce in the jwt",
+		},
+		{
+			name:    "Error aud mismatch",
+			vpJWT:   token,
+			opts:    &Options{Domain: "https://other.example.com", Challenge: crypto.Challenge},
+			wantErr: "invalid aud in the jwt",
+		},
+		{
+			name:    "Error invalid jwt",
+			vpJWT:   "invalid",
+			opts:    &Options{Challenge: crypto.Challenge},
+			wantErr: "parse verifiable presentation jwt",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateJWTProofData(tt.vpJWT, tt.opts)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
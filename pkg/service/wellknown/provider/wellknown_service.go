@@ -234,6 +234,11 @@ func (s *Service) buildCredentialConfigurationsSupported(
 		if issuerProfile.VCConfig != nil {
 			cryptographicBindingMethodsSupported = []string{string(issuerProfile.VCConfig.DIDMethod)}
 			signingAlgValuesSupported = []string{string(issuerProfile.VCConfig.KeyType)}
+
+			// Credentials secured with Data Integrity proofs are advertised with cryptosuite identifier.
+			if issuerProfile.VCConfig.DataIntegrityProof.Enable {
+				signingAlgValuesSupported = []string{issuerProfile.VCConfig.DataIntegrityProof.SuiteType}
+			}
 		}
 
 		display := s.buildCredentialConfigurationsSupportedDisplay(credentialSupported.Display)
@@ -123,6 +123,27 @@ func TestController_GetOpenIDCredentialIssuerConfig(t *testing.T) {
 				assert.Nil(t, err)
 			},
 		},
+		{
+			name: "Success data integrity proof",
+			setup: func() {
+				mockTestIssuerProfile = loadProfile(t)
+				mockTestIssuerProfile.OIDCConfig.SignedIssuerMetadataSupported = false
+				mockTestIssuerProfile.VCConfig.DataIntegrityProof = vc.DataIntegrityProofConfig{
+					Enable:    true,
+					SuiteType: "bbsplus-2023",
+				}
+
+				mockKMSRegistry = NewMockKMSRegistry(gomock.NewController(t))
+				mockCryptoJWTSigner = NewMockCryptoJWTSigner(gomock.NewController(t))
+			},
+			check: func(t *testing.T, res *issuer.WellKnownOpenIDIssuerConfiguration, jwt string, err error) {
+				assert.NoError(t, err)
+
+				for _, conf := range res.CredentialConfigurationsSupported.AdditionalProperties {
+					assert.Equal(t, []string{"bbsplus-2023"}, lo.FromPtr(conf.CredentialSigningAlgValuesSupported))
+				}
+			},
+		},
 		{
 			name: "Error kmsRegistry",
 			setup: func() {
@@ -10,6 +10,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/trustbloc/vcs/pkg/storage/mongodb/internal"
@@ -26,6 +29,8 @@ const (
 	latestListIDDBEntryKey     = "LatestListID"
 	mongoDBDocumentIDFieldName = "_id"
 	idFieldName                = "id"
+	usedIndexesFieldName       = "usedIndexes"
+	listIDFieldName            = "listId"
 )
 
 // Store manages profile in mongodb.
@@ -34,8 +39,11 @@ type Store struct {
 }
 
 type latestListIDDocument struct {
-	ID     string `json:"id,omitempty" bson:"_id,omitempty"`
-	ListID string `json:"listId,omitempty" bson:"listId,omitempty"`
+	ID             string `json:"id,omitempty" bson:"_id,omitempty"`
+	ListID         string `json:"listId,omitempty" bson:"listId,omitempty"`
+	ProfileGroupID string `json:"profileGroupId,omitempty" bson:"profileGroupId,omitempty"`
+	StatusPurpose  string `json:"statusPurpose,omitempty" bson:"statusPurpose,omitempty"`
+	StatusType     string `json:"statusType,omitempty" bson:"statusType,omitempty"`
 }
 
 // NewStore creates Store.
@@ -58,6 +66,79 @@ func (p *Store) Upsert(ctx context.Context, cslURL string, cslWrapper *credentia
 	return err
 }
 
+// Create inserts cslWrapper into underlying MongoDB in case there is no wrapper for the given cslURL yet.
+func (p *Store) Create(ctx context.Context, cslURL string, cslWrapper *credentialstatus.CSLIndexWrapper) error {
+	mongoDBDocument, err := internal.PrepareDataForBSONStorage(cslWrapper)
+	if err != nil {
+		return err
+	}
+
+	mongoDBDocument[mongoDBDocumentIDFieldName] = cslURL
+
+	if mongoDBDocument[usedIndexesFieldName] == nil {
+		mongoDBDocument[usedIndexesFieldName] = []int{}
+	}
+
+	collection := p.mongoClient.Database().Collection(cslIndexStoreName)
+
+	_, err = collection.InsertOne(ctx, mongoDBDocument)
+	if mongo.IsDuplicateKeyError(err) {
+		return credentialstatus.ErrDataAlreadyExists
+	}
+
+	return err
+}
+
+// AssignIndex atomically appends index to the used indexes of credentialstatus.CSLIndexWrapper
+// in case it is not used yet.
+func (p *Store) AssignIndex(
+	ctx context.Context, cslURL string, index int) (*credentialstatus.CSLIndexWrapper, error) {
+	collection := p.mongoClient.Database().Collection(cslIndexStoreName)
+
+	mongoDBDocument := map[string]interface{}{}
+
+	err := collection.FindOneAndUpdate(ctx,
+		bson.M{
+			mongoDBDocumentIDFieldName: cslURL,
+			usedIndexesFieldName:       bson.M{"$ne": index},
+		},
+		bson.M{
+			"$push": bson.M{usedIndexesFieldName: index},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(mongoDBDocument)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		// Either the index is used already or there is no such CSL.
+		if _, getErr := p.Get(ctx, cslURL); getErr != nil {
+			return nil, getErr
+		}
+
+		return nil, credentialstatus.ErrIndexAlreadyUsed
+	}
+
+	if err != nil {
+		// Wrappers stored by previous versions might have null instead of an empty array of used indexes.
+		// $push fails on such documents, so convert null to an empty array and try once again.
+		res, updateErr := collection.UpdateOne(ctx,
+			bson.M{mongoDBDocumentIDFieldName: cslURL, usedIndexesFieldName: nil},
+			bson.M{"$set": bson.M{usedIndexesFieldName: []int{}}})
+		if updateErr == nil && res.ModifiedCount == 1 {
+			return p.AssignIndex(ctx, cslURL, index)
+		}
+
+		return nil, fmt.Errorf("CSLIndexWrapper assign index failed: %w", err)
+	}
+
+	cslWrapper := &credentialstatus.CSLIndexWrapper{}
+
+	err = mongodb.MapToStructure(mongoDBDocument, cslWrapper)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode to CSLIndexWrapper: %w", err)
+	}
+
+	return cslWrapper, nil
+}
+
 // Get returns credentialstatus.CSLIndexWrapper based on credentialstatus.CSL URL.
 func (p *Store) Get(ctx context.Context, cslURL string) (*credentialstatus.CSLIndexWrapper, error) {
 	collection := p.mongoClient.Database().Collection(cslIndexStoreName)
@@ -88,26 +169,87 @@ func (p *Store) Get(ctx context.Context, cslURL string) (*credentialstatus.CSLIn
 	return cslWrapper, nil
 }
 
-func (p *Store) UpdateLatestListID(ctx context.Context, id credentialstatus.ListID) error {
+// List returns all credentialstatus.CSLIndexWrapper which credentialstatus.CSL URL starts with cslURLPrefix.
+func (p *Store) List(ctx context.Context, cslURLPrefix string) ([]*credentialstatus.CSLIndexWrapper, error) {
+	collection := p.mongoClient.Database().Collection(cslIndexStoreName)
+
+	cursor, err := collection.Find(ctx, bson.M{
+		mongoDBDocumentIDFieldName: bson.M{"$regex": "^" + regexp.QuoteMeta(cslURLPrefix)},
+	}, options.Find().SetSort(bson.M{mongoDBDocumentIDFieldName: 1}))
+	if err != nil {
+		return nil, fmt.Errorf("CSLIndexWrapper find failed: %w", err)
+	}
+
+	defer cursor.Close(ctx)
+
+	var wrappers []*credentialstatus.CSLIndexWrapper
+
+	for cursor.Next(ctx) {
+		mongoDBDocument := map[string]interface{}{}
+
+		if err = cursor.Decode(&mongoDBDocument); err != nil {
+			return nil, fmt.Errorf("failed to decode CSLIndexWrapper document: %w", err)
+		}
+
+		cslWrapper := &credentialstatus.CSLIndexWrapper{}
+
+		if err = mongodb.MapToStructure(mongoDBDocument, cslWrapper); err != nil {
+			return nil, fmt.Errorf("failed to decode to CSLIndexWrapper: %w", err)
+		}
+
+		wrappers = append(wrappers, cslWrapper)
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, fmt.Errorf("CSLIndexWrapper cursor failed: %w", err)
+	}
+
+	return wrappers, nil
+}
+
+// UpdateLatestListID replaces latest credentialstatus.ListID of seq with id in case it still equals to prevID.
+func (p *Store) UpdateLatestListID(
+	ctx context.Context, seq credentialstatus.ListSequence, prevID, id credentialstatus.ListID) error {
 	collection := p.mongoClient.Database().Collection(cslIndexStoreName)
-	_, err := collection.UpdateByID(ctx, latestListIDDBEntryKey, bson.M{
-		"$set": latestListIDDocument{
-			ListID: string(id),
+
+	res, err := collection.UpdateOne(ctx,
+		bson.M{
+			mongoDBDocumentIDFieldName: latestListIDDocumentID(seq),
+			listIDFieldName:            string(prevID),
 		},
-	})
+		bson.M{
+			"$set": bson.M{listIDFieldName: string(id)},
+		})
+	if err != nil {
+		return err
+	}
 
-	return err
+	if res.MatchedCount == 0 {
+		return credentialstatus.ErrLatestListIDChanged
+	}
+
+	return nil
 }
 
-func (p *Store) GetLatestListID(ctx context.Context) (credentialstatus.ListID, error) {
+// GetLatestListID returns latest credentialstatus.ListID of seq. Creates the first one in case it does not exist.
+func (p *Store) GetLatestListID(
+	ctx context.Context, seq credentialstatus.ListSequence) (credentialstatus.ListID, error) {
+	latestListID, err := p.findLatestListID(ctx, latestListIDDocumentID(seq))
+	if errors.Is(err, credentialstatus.ErrDataNotFound) {
+		return p.createFirstListID(ctx, seq)
+	}
+
+	return latestListID, err
+}
+
+func (p *Store) findLatestListID(ctx context.Context, id string) (credentialstatus.ListID, error) {
 	collection := p.mongoClient.Database().Collection(cslIndexStoreName)
 
 	mongoDBDocument := map[string]interface{}{}
 
-	err := collection.FindOne(ctx,
-		bson.M{mongoDBDocumentIDFieldName: latestListIDDBEntryKey}).Decode(mongoDBDocument)
+	err := collection.FindOne(ctx, bson.M{mongoDBDocumentIDFieldName: id}).Decode(mongoDBDocument)
 	if errors.Is(err, mongo.ErrNoDocuments) {
-		return p.createFirstListID(ctx)
+		return "", credentialstatus.ErrDataNotFound
 	}
 
 	if err != nil {
@@ -124,17 +266,46 @@ func (p *Store) GetLatestListID(ctx context.Context) (credentialstatus.ListID, e
 	return credentialstatus.ListID(latestListID.ListID), nil
 }
 
-func (p *Store) createFirstListID(ctx context.Context) (credentialstatus.ListID, error) {
-	listID := uuid.NewString()
+// createFirstListID creates the latest list ID document of seq.
+// Previous versions kept single latest list ID shared by all profile groups. In case such document exists,
+// the sequence continues from it, so already created CSLs of the profile group are filled up before rollover.
+func (p *Store) createFirstListID(
+	ctx context.Context, seq credentialstatus.ListSequence) (credentialstatus.ListID, error) {
+	listID, err := p.findLatestListID(ctx, latestListIDDBEntryKey)
+	if err != nil {
+		if !errors.Is(err, credentialstatus.ErrDataNotFound) {
+			return "", fmt.Errorf("failed to get legacy list id: %w", err)
+		}
+
+		listID = credentialstatus.ListID(uuid.NewString())
+	}
 
 	collection := p.mongoClient.Database().Collection(cslIndexStoreName)
-	_, err := collection.InsertOne(ctx, latestListIDDocument{
-		ID:     latestListIDDBEntryKey,
-		ListID: listID,
+	_, err = collection.InsertOne(ctx, latestListIDDocument{
+		ID:             latestListIDDocumentID(seq),
+		ListID:         string(listID),
+		ProfileGroupID: seq.ProfileGroupID,
+		StatusPurpose:  seq.StatusPurpose,
+		StatusType:     string(seq.StatusType),
 	})
+	if mongo.IsDuplicateKeyError(err) {
+		// First list ID was created by another instance.
+		return p.findLatestListID(ctx, latestListIDDocumentID(seq))
+	}
+
 	if err != nil {
 		return "", fmt.Errorf("failed to create first list id: %w", err)
 	}
 
-	return credentialstatus.ListID(listID), nil
+	return listID, nil
+}
+
+// latestListIDDocumentID returns ID of the latest list ID document of seq.
+func latestListIDDocumentID(seq credentialstatus.ListSequence) string {
+	return strings.Join([]string{
+		latestListIDDBEntryKey,
+		url.PathEscape(seq.ProfileGroupID),
+		url.PathEscape(seq.StatusPurpose),
+		url.PathEscape(string(seq.StatusType)),
+	}, "/")
 }
@@ -11,12 +11,14 @@ import (
 	_ "embed"
 	"fmt"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	dctest "github.com/ory/dockertest/v3"
 	dc "github.com/ory/dockertest/v3/docker"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/trustbloc/vc-go/verifiable"
@@ -25,6 +27,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/trustbloc/vcs/pkg/doc/vc"
 	"github.com/trustbloc/vcs/pkg/internal/testutil"
 	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
 	"github.com/trustbloc/vcs/pkg/storage/mongodb"
@@ -186,25 +189,285 @@ func TestLatestListID(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("Find non-existing ID", func(t *testing.T) {
-		listID, err := store.GetLatestListID(ctx)
+		listID, err := store.GetLatestListID(ctx, testListSequence)
 
 		assert.NotEmpty(t, listID)
 		assert.NoError(t, err)
 	})
 
 	t.Run("Update - Get LatestListID", func(t *testing.T) {
-		receivedListID, err := store.GetLatestListID(ctx)
+		receivedListID, err := store.GetLatestListID(ctx, testListSequence)
 		require.NoError(t, err)
 		require.NotEmpty(t, receivedListID)
 
-		err = store.UpdateLatestListID(ctx, "1")
+		err = store.UpdateLatestListID(ctx, testListSequence, receivedListID, "1")
 		require.NoError(t, err)
 
-		receivedListIDAfterUpdate, err := store.GetLatestListID(ctx)
+		receivedListIDAfterUpdate, err := store.GetLatestListID(ctx, testListSequence)
 		require.NoError(t, err)
 		require.NotEmpty(t, receivedListIDAfterUpdate)
 		require.NotEqual(t, receivedListID, receivedListIDAfterUpdate)
 	})
+
+	t.Run("Update LatestListID - already changed", func(t *testing.T) {
+		receivedListID, err := store.GetLatestListID(ctx, testListSequence)
+		require.NoError(t, err)
+
+		err = store.UpdateLatestListID(ctx, testListSequence, "outdated", "2")
+		require.ErrorIs(t, err, credentialstatus.ErrLatestListIDChanged)
+
+		receivedListIDAfterUpdate, err := store.GetLatestListID(ctx, testListSequence)
+		require.NoError(t, err)
+		require.Equal(t, receivedListID, receivedListIDAfterUpdate)
+	})
+
+	t.Run("Sequences are independent", func(t *testing.T) {
+		otherGroupSequence := testListSequence
+		otherGroupSequence.ProfileGroupID = "otherGroup"
+
+		otherTypeSequence := testListSequence
+		otherTypeSequence.StatusType = vc.RevocationList2021VCStatus
+
+		receivedListID, err := store.GetLatestListID(ctx, testListSequence)
+		require.NoError(t, err)
+
+		otherGroupListID, err := store.GetLatestListID(ctx, otherGroupSequence)
+		require.NoError(t, err)
+		require.NotEqual(t, receivedListID, otherGroupListID)
+
+		otherTypeListID, err := store.GetLatestListID(ctx, otherTypeSequence)
+		require.NoError(t, err)
+		require.NotEqual(t, receivedListID, otherTypeListID)
+
+		err = store.UpdateLatestListID(ctx, otherGroupSequence, otherGroupListID, "3")
+		require.NoError(t, err)
+
+		receivedListIDAfterUpdate, err := store.GetLatestListID(ctx, testListSequence)
+		require.NoError(t, err)
+		require.Equal(t, receivedListID, receivedListIDAfterUpdate)
+	})
+
+	t.Run("Migrate legacy LatestListID", func(t *testing.T) {
+		_, err := client.Database().Collection(cslIndexStoreName).InsertOne(ctx, latestListIDDocument{
+			ID:     latestListIDDBEntryKey,
+			ListID: "legacy",
+		})
+		require.NoError(t, err)
+
+		groupA := testListSequence
+		groupA.ProfileGroupID = "legacyGroupA"
+
+		groupB := testListSequence
+		groupB.ProfileGroupID = "legacyGroupB"
+
+		// Every sequence continues from the global list ID of previous versions.
+		listIDA, err := store.GetLatestListID(ctx, groupA)
+		require.NoError(t, err)
+		require.Equal(t, credentialstatus.ListID("legacy"), listIDA)
+
+		listIDB, err := store.GetLatestListID(ctx, groupB)
+		require.NoError(t, err)
+		require.Equal(t, credentialstatus.ListID("legacy"), listIDB)
+
+		// And then rolls over independently.
+		err = store.UpdateLatestListID(ctx, groupA, listIDA, "newA")
+		require.NoError(t, err)
+
+		listIDB, err = store.GetLatestListID(ctx, groupB)
+		require.NoError(t, err)
+		require.Equal(t, credentialstatus.ListID("legacy"), listIDB)
+	})
+}
+
+func TestList(t *testing.T) {
+	pool, mongoDBResource := startMongoDBContainer(t)
+
+	defer func() {
+		require.NoError(t, pool.Purge(mongoDBResource), "failed to purge MongoDB resource")
+	}()
+
+	client, err := mongodb.New(mongoDBConnString, "testdb", mongodb.WithTimeout(time.Second*10))
+	require.NoError(t, err)
+
+	store := NewStore(client)
+
+	defer func() {
+		require.NoError(t, client.Close(), "failed to close mongodb client")
+	}()
+
+	ctx := context.Background()
+
+	for _, cslURL := range []string{
+		"https://example.com/issuer/groups/group/credentials/status/1",
+		"https://example.com/issuer/groups/group/credentials/status/2",
+		"https://example.com/issuer/groups/group.1/credentials/status/1",
+		"https://example.com/issuer/groups/group1/credentials/status/1",
+	} {
+		require.NoError(t, store.Create(ctx, cslURL, &credentialstatus.CSLIndexWrapper{
+			CSLURL:      cslURL,
+			UsedIndexes: []int{1},
+		}))
+	}
+
+	_, err = store.GetLatestListID(ctx, testListSequence)
+	require.NoError(t, err)
+
+	wrappers, err := store.List(ctx, "https://example.com/issuer/groups/group/credentials/status/")
+	require.NoError(t, err)
+	require.Len(t, wrappers, 2)
+	require.Equal(t, "https://example.com/issuer/groups/group/credentials/status/1", wrappers[0].CSLURL)
+	require.Equal(t, []int{1}, wrappers[0].UsedIndexes)
+	require.Equal(t, "https://example.com/issuer/groups/group/credentials/status/2", wrappers[1].CSLURL)
+
+	wrappers, err = store.List(ctx, "https://example.com/issuer/groups/unknown/credentials/status/")
+	require.NoError(t, err)
+	require.Empty(t, wrappers)
+}
+
+func TestAssignIndex(t *testing.T) {
+	pool, mongoDBResource := startMongoDBContainer(t)
+
+	defer func() {
+		require.NoError(t, pool.Purge(mongoDBResource), "failed to purge MongoDB resource")
+	}()
+
+	client, clientErr := mongodb.New(mongoDBConnString, "testdb3", mongodb.WithTimeout(time.Second*10))
+	require.NoError(t, clientErr)
+
+	store := NewStore(client)
+
+	defer func() {
+		require.NoError(t, client.Close(), "failed to close mongodb client")
+	}()
+
+	ctx := context.Background()
+
+	t.Run("Create - AssignIndex", func(t *testing.T) {
+		cslURL := "https://example.com/credentials/status/1"
+
+		err := store.Create(ctx, cslURL, &credentialstatus.CSLIndexWrapper{CSLURL: cslURL})
+		require.NoError(t, err)
+
+		err = store.Create(ctx, cslURL, &credentialstatus.CSLIndexWrapper{CSLURL: cslURL})
+		require.ErrorIs(t, err, credentialstatus.ErrDataAlreadyExists)
+
+		wrapper, err := store.AssignIndex(ctx, cslURL, 5)
+		require.NoError(t, err)
+		require.Equal(t, []int{5}, wrapper.UsedIndexes)
+
+		_, err = store.AssignIndex(ctx, cslURL, 5)
+		require.ErrorIs(t, err, credentialstatus.ErrIndexAlreadyUsed)
+
+		wrapper, err = store.AssignIndex(ctx, cslURL, 7)
+		require.NoError(t, err)
+		require.Equal(t, []int{5, 7}, wrapper.UsedIndexes)
+	})
+
+	t.Run("AssignIndex - wrapper with null used indexes", func(t *testing.T) {
+		cslURL := "https://example.com/credentials/status/2"
+
+		require.NoError(t, store.Upsert(ctx, cslURL, &credentialstatus.CSLIndexWrapper{CSLURL: cslURL}))
+
+		wrapper, err := store.AssignIndex(ctx, cslURL, 1)
+		require.NoError(t, err)
+		require.Equal(t, []int{1}, wrapper.UsedIndexes)
+	})
+
+	t.Run("AssignIndex - non-existing wrapper", func(t *testing.T) {
+		_, err := store.AssignIndex(ctx, "https://example.com/credentials/status/3", 1)
+		require.ErrorIs(t, err, credentialstatus.ErrDataNotFound)
+	})
+
+	t.Run("Concurrent AssignIndex from multiple instances", func(t *testing.T) {
+		const (
+			instances = 5
+			listSize  = 20
+		)
+
+		cslURL := "https://example.com/credentials/status/4"
+
+		require.NoError(t, store.Create(ctx, cslURL, &credentialstatus.CSLIndexWrapper{CSLURL: cslURL}))
+
+		var (
+			wg       sync.WaitGroup
+			mu       sync.Mutex
+			assigned []int
+		)
+
+		for i := 0; i < instances; i++ {
+			// Each instance has its own connection to MongoDB, as separate VCS replicas have.
+			instanceClient, err := mongodb.New(mongoDBConnString, "testdb3", mongodb.WithTimeout(time.Second*10))
+			require.NoError(t, err)
+
+			instanceStore := NewStore(instanceClient)
+
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+				defer instanceClient.Close() //nolint:errcheck
+
+				// Every instance tries to take every index of the list.
+				for index := 0; index < listSize; index++ {
+					if _, err := instanceStore.AssignIndex(ctx, cslURL, index); err == nil {
+						mu.Lock()
+						assigned = append(assigned, index)
+						mu.Unlock()
+					} else {
+						assert.ErrorIs(t, err, credentialstatus.ErrIndexAlreadyUsed)
+					}
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		// Each index must be assigned exactly once.
+		require.Len(t, assigned, listSize)
+		require.ElementsMatch(t, assigned, lo.Range(listSize))
+
+		wrapper, err := store.Get(ctx, cslURL)
+		require.NoError(t, err)
+		require.ElementsMatch(t, wrapper.UsedIndexes, lo.Range(listSize))
+	})
+
+	t.Run("Concurrent UpdateLatestListID from multiple instances", func(t *testing.T) {
+		const instances = 5
+
+		latestListID, err := store.GetLatestListID(ctx, testListSequence)
+		require.NoError(t, err)
+
+		var (
+			wg      sync.WaitGroup
+			mu      sync.Mutex
+			updated int
+		)
+
+		for i := 0; i < instances; i++ {
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+
+				if store.UpdateLatestListID(ctx, testListSequence, latestListID, credentialstatus.ListID(fmt.Sprintf("list-%d", i))) == nil {
+					mu.Lock()
+					updated++
+					mu.Unlock()
+				}
+			}(i)
+		}
+
+		wg.Wait()
+
+		// Only one instance is allowed to roll over the list.
+		require.Equal(t, 1, updated)
+	})
+}
+
+var testListSequence = credentialstatus.ListSequence{ //nolint:gochecknoglobals
+	ProfileGroupID: "testGroup",
+	StatusPurpose:  "revocation",
+	StatusType:     vc.StatusList2021VCStatus,
 }
 
 func startMongoDBContainer(t *testing.T) (*dctest.Pool, *dctest.Resource) {
@@ -0,0 +1,209 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cslpendingchangesstore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb"
+)
+
+const (
+	collectionName      = "csl_pending_changes"
+	lockCollectionName  = "csl_pending_changes_lock"
+	ownerFieldName      = "owner"
+	expireAtFieldName   = "expireAt"
+	cslURLFieldName     = "cslUrl"
+	createdAtFieldName  = "createdAt"
+	documentIDFieldName = "_id"
+)
+
+type mongoDocument struct {
+	ID             string    `bson:"_id"`
+	CSLURL         string    `bson:"cslUrl"`
+	ProfileID      string    `bson:"profileId"`
+	ProfileVersion string    `bson:"profileVersion"`
+	Index          int       `bson:"index"`
+	Status         bool      `bson:"status"`
+	CreatedAt      time.Time `bson:"createdAt"`
+}
+
+// Store manages credentialstatus.CSLPendingChange in MongoDB.
+type Store struct {
+	mongoClient *mongodb.Client
+}
+
+// New creates Store.
+func New(ctx context.Context, mongoClient *mongodb.Client) (*Store, error) {
+	s := &Store{mongoClient: mongoClient}
+
+	if err := s.migrate(ctx); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Store) migrate(ctx context.Context) error {
+	_, err := s.mongoClient.Database().Collection(collectionName).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: cslURLFieldName, Value: 1},
+			{Key: createdAtFieldName, Value: 1},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("create index for collection %s: %w", collectionName, err)
+	}
+
+	_, err = s.mongoClient.Database().Collection(lockCollectionName).Indexes().CreateOne(ctx, mongo.IndexModel{
+		// ttl index https://www.mongodb.com/community/forums/t/ttl-index-internals/4086/2
+		Keys:    bson.M{expireAtFieldName: 1},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		return fmt.Errorf("create index for collection %s: %w", lockCollectionName, err)
+	}
+
+	return nil
+}
+
+// Add stores credentialstatus.CSLPendingChange.
+func (s *Store) Add(ctx context.Context, change *credentialstatus.CSLPendingChange) error {
+	_, err := s.mongoClient.Database().Collection(collectionName).InsertOne(ctx, &mongoDocument{
+		ID:             change.ID,
+		CSLURL:         change.CSLURL,
+		ProfileID:      change.ProfileID,
+		ProfileVersion: change.ProfileVersion,
+		Index:          change.Index,
+		Status:         change.Status,
+		CreatedAt:      change.CreatedAt.UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("insert pending change: %w", err)
+	}
+
+	return nil
+}
+
+// GetByCSLURL returns credentialstatus.CSLPendingChange list of the CSL ordered by creation time.
+func (s *Store) GetByCSLURL(ctx context.Context, cslURL string) ([]*credentialstatus.CSLPendingChange, error) {
+	opts := options.Find().SetSort(bson.D{
+		{Key: createdAtFieldName, Value: 1},
+		{Key: documentIDFieldName, Value: 1},
+	})
+
+	cursor, err := s.mongoClient.Database().Collection(collectionName).Find(ctx,
+		bson.M{cslURLFieldName: cslURL}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("find pending changes: %w", err)
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var docs []*mongoDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("decode pending changes: %w", err)
+	}
+
+	changes := make([]*credentialstatus.CSLPendingChange, 0, len(docs))
+
+	for _, doc := range docs {
+		changes = append(changes, &credentialstatus.CSLPendingChange{
+			ID:             doc.ID,
+			CSLURL:         doc.CSLURL,
+			ProfileID:      doc.ProfileID,
+			ProfileVersion: doc.ProfileVersion,
+			Index:          doc.Index,
+			Status:         doc.Status,
+			CreatedAt:      doc.CreatedAt,
+		})
+	}
+
+	return changes, nil
+}
+
+// GetCSLURLs returns URLs of all CSLs that have pending changes.
+func (s *Store) GetCSLURLs(ctx context.Context) ([]string, error) {
+	values, err := s.mongoClient.Database().Collection(collectionName).Distinct(ctx, cslURLFieldName, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("find CSL URLs of pending changes: %w", err)
+	}
+
+	cslURLs := make([]string, 0, len(values))
+
+	for _, v := range values {
+		if cslURL, ok := v.(string); ok {
+			cslURLs = append(cslURLs, cslURL)
+		}
+	}
+
+	return cslURLs, nil
+}
+
+// Delete removes credentialstatus.CSLPendingChange list by IDs.
+func (s *Store) Delete(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := s.mongoClient.Database().Collection(collectionName).DeleteMany(ctx,
+		bson.M{documentIDFieldName: bson.M{"$in": ids}})
+	if err != nil {
+		return fmt.Errorf("delete pending changes: %w", err)
+	}
+
+	return nil
+}
+
+// Lock acquires the lock of the CSL for the owner. The lock is acquired if it doesn't exist, is held by
+// the same owner or is expired. Expired locks are removed by the ttl index in the background, so they are
+// also checked on acquiring.
+func (s *Store) Lock(ctx context.Context, cslURL, owner string, ttl time.Duration) (bool, error) {
+	now := time.Now().UTC()
+
+	_, err := s.mongoClient.Database().Collection(lockCollectionName).UpdateOne(ctx,
+		bson.M{
+			documentIDFieldName: cslURL,
+			"$or": bson.A{
+				bson.M{ownerFieldName: owner},
+				bson.M{expireAtFieldName: bson.M{"$lte": now}},
+			},
+		},
+		bson.M{"$set": bson.M{ownerFieldName: owner, expireAtFieldName: now.Add(ttl)}},
+		options.Update().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		// Upsert failed because the lock is held by another owner.
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("lock CSL: %w", err)
+	}
+
+	return true, nil
+}
+
+// Unlock releases the lock of the CSL held by the owner.
+func (s *Store) Unlock(ctx context.Context, cslURL, owner string) error {
+	_, err := s.mongoClient.Database().Collection(lockCollectionName).DeleteOne(ctx,
+		bson.M{documentIDFieldName: cslURL, ownerFieldName: owner})
+	if err != nil {
+		return fmt.Errorf("unlock CSL: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cslpendingchangesstore
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	dctest "github.com/ory/dockertest/v3"
+	dc "github.com/ory/dockertest/v3/docker"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/trustbloc/vcs/pkg/service/credentialstatus"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb"
+)
+
+const (
+	mongoDBConnString  = "mongodb://localhost:27042"
+	dockerMongoDBImage = "mongo"
+	dockerMongoDBTag   = "4.0.0"
+	cslURL1            = "https://example.com/issuer/groups/group/credentials/status/1"
+	cslURL2            = "https://example.com/issuer/groups/group/credentials/status/2"
+)
+
+func TestStore(t *testing.T) {
+	pool, mongoDBResource := startMongoDBContainer(t)
+
+	defer func() {
+		require.NoError(t, pool.Purge(mongoDBResource), "failed to purge MongoDB resource")
+	}()
+
+	client, err := mongodb.New(mongoDBConnString, "testdb", mongodb.WithTimeout(time.Second*10))
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, client.Close(), "failed to close mongodb client")
+	}()
+
+	ctx := context.Background()
+
+	store, err := New(ctx, client)
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	changes := []*credentialstatus.CSLPendingChange{
+		{ID: "3", CSLURL: cslURL1, ProfileID: "p", ProfileVersion: "v1", Index: 7, Status: true, CreatedAt: now},
+		{ID: "1", CSLURL: cslURL1, ProfileID: "p", ProfileVersion: "v1", Index: 5, Status: true,
+			CreatedAt: now.Add(-time.Second)},
+		{ID: "2", CSLURL: cslURL2, ProfileID: "p", ProfileVersion: "v1", Index: 1, Status: false, CreatedAt: now},
+	}
+
+	for _, c := range changes {
+		require.NoError(t, store.Add(ctx, c))
+	}
+
+	t.Run("Add duplicate", func(t *testing.T) {
+		require.Error(t, store.Add(ctx, changes[0]))
+	})
+
+	t.Run("GetByCSLURL", func(t *testing.T) {
+		found, err := store.GetByCSLURL(ctx, cslURL1)
+		require.NoError(t, err)
+		require.Len(t, found, 2)
+		require.Equal(t, changes[1], found[0])
+		require.Equal(t, changes[0], found[1])
+
+		found, err = store.GetByCSLURL(ctx, "https://example.com/unknown")
+		require.NoError(t, err)
+		require.Empty(t, found)
+	})
+
+	t.Run("GetCSLURLs", func(t *testing.T) {
+		cslURLs, err := store.GetCSLURLs(ctx)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{cslURL1, cslURL2}, cslURLs)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, store.Delete(ctx, nil))
+		require.NoError(t, store.Delete(ctx, []string{"1", "3"}))
+
+		found, err := store.GetByCSLURL(ctx, cslURL1)
+		require.NoError(t, err)
+		require.Empty(t, found)
+
+		cslURLs, err := store.GetCSLURLs(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{cslURL2}, cslURLs)
+	})
+
+	t.Run("Lock", func(t *testing.T) {
+		locked, err := store.Lock(ctx, cslURL1, "owner1", time.Minute)
+		require.NoError(t, err)
+		require.True(t, locked)
+
+		// Lock is extended by the owner.
+		locked, err = store.Lock(ctx, cslURL1, "owner1", time.Minute)
+		require.NoError(t, err)
+		require.True(t, locked)
+
+		locked, err = store.Lock(ctx, cslURL1, "owner2", time.Minute)
+		require.NoError(t, err)
+		require.False(t, locked)
+
+		// Other CSL is not locked.
+		locked, err = store.Lock(ctx, cslURL2, "owner2", time.Minute)
+		require.NoError(t, err)
+		require.True(t, locked)
+
+		// Unlock by other owner is ignored.
+		require.NoError(t, store.Unlock(ctx, cslURL1, "owner2"))
+
+		locked, err = store.Lock(ctx, cslURL1, "owner2", time.Minute)
+		require.NoError(t, err)
+		require.False(t, locked)
+
+		require.NoError(t, store.Unlock(ctx, cslURL1, "owner1"))
+
+		locked, err = store.Lock(ctx, cslURL1, "owner2", time.Minute)
+		require.NoError(t, err)
+		require.True(t, locked)
+	})
+
+	t.Run("Lock expired", func(t *testing.T) {
+		const cslURL = "https://example.com/issuer/groups/group/credentials/status/3"
+
+		locked, err := store.Lock(ctx, cslURL, "owner1", time.Millisecond)
+		require.NoError(t, err)
+		require.True(t, locked)
+
+		time.Sleep(10 * time.Millisecond)
+
+		locked, err = store.Lock(ctx, cslURL, "owner2", time.Minute)
+		require.NoError(t, err)
+		require.True(t, locked)
+	})
+}
+
+func startMongoDBContainer(t *testing.T) (*dctest.Pool, *dctest.Resource) {
+	t.Helper()
+
+	pool, err := dctest.NewPool("")
+	require.NoError(t, err)
+
+	mongoDBResource, err := pool.RunWithOptions(&dctest.RunOptions{
+		Repository: dockerMongoDBImage,
+		Tag:        dockerMongoDBTag,
+		PortBindings: map[dc.Port][]dc.PortBinding{
+			"27017/tcp": {{HostIP: "", HostPort: "27042"}},
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, waitForMongoDBToBeUp())
+
+	return pool, mongoDBResource
+}
+
+func waitForMongoDBToBeUp() error {
+	return backoff.Retry(pingMongoDB, backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Second), 30))
+}
+
+func pingMongoDB() error {
+	var err error
+
+	tM := reflect.TypeOf(bson.M{})
+	reg := bson.NewRegistryBuilder().RegisterTypeMapEntry(bsontype.EmbeddedDocument, tM).Build()
+	clientOpts := options.Client().SetRegistry(reg).ApplyURI(mongoDBConnString)
+
+	mongoClient, err := mongo.NewClient(clientOpts)
+	if err != nil {
+		return err
+	}
+
+	err = mongoClient.Connect(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+
+	db := mongoClient.Database("test")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	return db.Client().Ping(ctx, nil)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package keyrotationstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/service/keyrotation"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb"
+)
+
+const (
+	collectionName = "signing_key_rotation"
+)
+
+type mongoDocument struct {
+	ID                         string    `bson:"_id"`
+	OrgID                      string    `bson:"orgID"`
+	ProfileID                  string    `bson:"profileID"`
+	ProfileVersion             string    `bson:"profileVersion"`
+	DID                        string    `bson:"did"`
+	VerificationMethod         string    `bson:"verificationMethod"`
+	PreviousVerificationMethod string    `bson:"previousVerificationMethod"`
+	SigningDID                 string    `bson:"signingDID"`
+	RotatedAt                  time.Time `bson:"rotatedAt"`
+}
+
+// Store stores signing key rotation records in mongodb. Records are never updated or deleted.
+type Store struct {
+	mongoClient *mongodb.Client
+}
+
+// NewStore creates Store.
+func NewStore(ctx context.Context, mongoClient *mongodb.Client) (*Store, error) {
+	s := &Store{
+		mongoClient: mongoClient,
+	}
+
+	if err := s.migrate(ctx); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Store) migrate(ctx context.Context) error {
+	_, err := s.mongoClient.Database().Collection(collectionName).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "rotatedAt", Value: 1}},
+		},
+		{
+			Keys: bson.D{
+				{Key: "profileID", Value: 1},
+				{Key: "profileVersion", Value: 1},
+				{Key: "rotatedAt", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("create index for collection %s: %w", collectionName, err)
+	}
+
+	return nil
+}
+
+// Create stores the rotation record.
+func (s *Store) Create(ctx context.Context, record *keyrotation.Record) error {
+	// Signing DID is stored as JSON, as public keys are not bson serializable.
+	signingDID, err := json.Marshal(record.SigningDID)
+	if err != nil {
+		return fmt.Errorf("marshal signing did: %w", err)
+	}
+
+	_, err = s.mongoClient.Database().Collection(collectionName).InsertOne(ctx, &mongoDocument{
+		ID:                         record.ID,
+		OrgID:                      record.OrgID,
+		ProfileID:                  record.ProfileID,
+		ProfileVersion:             record.ProfileVersion,
+		DID:                        record.DID,
+		VerificationMethod:         record.VerificationMethod,
+		PreviousVerificationMethod: record.PreviousVerificationMethod,
+		SigningDID:                 string(signingDID),
+		RotatedAt:                  record.RotatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("insert key rotation: %w", err)
+	}
+
+	return nil
+}
+
+// List returns rotation records of all profiles ordered by rotation time.
+func (s *Store) List(ctx context.Context) ([]*keyrotation.Record, error) {
+	cursor, err := s.mongoClient.Database().Collection(collectionName).Find(ctx, bson.M{},
+		options.Find().SetSort(bson.D{{Key: "rotatedAt", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("find key rotations: %w", err)
+	}
+
+	var docs []*mongoDocument
+
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("decode key rotations: %w", err)
+	}
+
+	records := make([]*keyrotation.Record, 0, len(docs))
+
+	for _, doc := range docs {
+		record, mapErr := mapDocument(doc)
+		if mapErr != nil {
+			return nil, mapErr
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// GetLatest returns the latest rotation record of the profile with given id and version.
+func (s *Store) GetLatest(ctx context.Context, profileID, profileVersion string) (*keyrotation.Record, error) {
+	doc := &mongoDocument{}
+
+	err := s.mongoClient.Database().Collection(collectionName).FindOne(ctx,
+		bson.M{"profileID": profileID, "profileVersion": profileVersion},
+		options.FindOne().SetSort(bson.D{{Key: "rotatedAt", Value: -1}}),
+	).Decode(doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, keyrotation.ErrDataNotFound
+		}
+
+		return nil, fmt.Errorf("find key rotation: %w", err)
+	}
+
+	return mapDocument(doc)
+}
+
+func mapDocument(doc *mongoDocument) (*keyrotation.Record, error) {
+	var signingDID profileapi.SigningDID
+
+	if err := json.Unmarshal([]byte(doc.SigningDID), &signingDID); err != nil {
+		return nil, fmt.Errorf("unmarshal signing did: %w", err)
+	}
+
+	return &keyrotation.Record{
+		ID:                         doc.ID,
+		OrgID:                      doc.OrgID,
+		ProfileID:                  doc.ProfileID,
+		ProfileVersion:             doc.ProfileVersion,
+		DID:                        doc.DID,
+		VerificationMethod:         doc.VerificationMethod,
+		PreviousVerificationMethod: doc.PreviousVerificationMethod,
+		SigningDID:                 &signingDID,
+		RotatedAt:                  doc.RotatedAt,
+	}, nil
+}
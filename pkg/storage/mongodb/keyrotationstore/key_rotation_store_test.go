@@ -0,0 +1,160 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package keyrotationstore
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	dctest "github.com/ory/dockertest/v3"
+	dc "github.com/ory/dockertest/v3/docker"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
+	"github.com/trustbloc/vcs/pkg/service/keyrotation"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb"
+)
+
+const (
+	mongoDBConnString  = "mongodb://localhost:27046"
+	dockerMongoDBImage = "mongo"
+	dockerMongoDBTag   = "4.0.0"
+)
+
+func TestStore(t *testing.T) {
+	pool, mongoDBResource := startMongoDBContainer(t)
+
+	defer func() {
+		require.NoError(t, pool.Purge(mongoDBResource), "failed to purge MongoDB resource")
+	}()
+
+	client, err := mongodb.New(mongoDBConnString, "testdb", mongodb.WithTimeout(time.Second*10))
+	require.NoError(t, err)
+
+	store, err := NewStore(context.Background(), client)
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	newRecord := func(id string, rotatedAt time.Time) *keyrotation.Record {
+		return &keyrotation.Record{
+			ID:                         id,
+			OrgID:                      "orgID",
+			ProfileID:                  "profileID",
+			ProfileVersion:             "v1.0",
+			DID:                        "did:web:example.com",
+			VerificationMethod:         "did:web:example.com#" + id,
+			PreviousVerificationMethod: "did:web:example.com#key1",
+			SigningDID: &profileapi.SigningDID{
+				DID:      "did:web:example.com",
+				Creator:  "did:web:example.com#" + id,
+				KMSKeyID: id,
+				VerificationMethods: []*profileapi.VerificationMethod{
+					{ID: "did:web:example.com#key1", Type: "JsonWebKey2020", RetiredAt: &now},
+				},
+			},
+			RotatedAt: rotatedAt,
+		}
+	}
+
+	t.Run("Create and list", func(t *testing.T) {
+		second := newRecord("key3", now)
+		first := newRecord("key2", now.Add(-time.Minute))
+
+		require.NoError(t, store.Create(context.Background(), second))
+		require.NoError(t, store.Create(context.Background(), first))
+
+		records, err := store.List(context.Background())
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		require.Equal(t, first, records[0])
+		require.Equal(t, second, records[1])
+	})
+
+	t.Run("Get latest", func(t *testing.T) {
+		record, err := store.GetLatest(context.Background(), "profileID", "v1.0")
+		require.NoError(t, err)
+		require.Equal(t, newRecord("key3", now), record)
+
+		_, err = store.GetLatest(context.Background(), "profileID", "v2.0")
+		require.ErrorIs(t, err, keyrotation.ErrDataNotFound)
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		require.ErrorContains(t, store.Create(context.Background(), newRecord("key2", now)), "insert key rotation")
+	})
+
+	t.Run("Context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		require.ErrorContains(t, store.Create(ctx, newRecord("key4", now)), "context canceled")
+
+		_, err = store.List(ctx)
+		require.ErrorContains(t, err, "context canceled")
+
+		_, err = store.GetLatest(ctx, "profileID", "v1.0")
+		require.ErrorContains(t, err, "context canceled")
+	})
+}
+
+func startMongoDBContainer(t *testing.T) (*dctest.Pool, *dctest.Resource) {
+	t.Helper()
+
+	pool, err := dctest.NewPool("")
+	require.NoError(t, err)
+
+	mongoDBResource, err := pool.RunWithOptions(&dctest.RunOptions{
+		Repository: dockerMongoDBImage,
+		Tag:        dockerMongoDBTag,
+		PortBindings: map[dc.Port][]dc.PortBinding{
+			"27017/tcp": {{HostIP: "", HostPort: "27046"}},
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, waitForMongoDBToBeUp())
+
+	return pool, mongoDBResource
+}
+
+func waitForMongoDBToBeUp() error {
+	return backoff.Retry(pingMongoDB, backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Second), 30))
+}
+
+func pingMongoDB() error {
+	var err error
+
+	tM := reflect.TypeOf(bson.M{})
+	reg := bson.NewRegistryBuilder().RegisterTypeMapEntry(bsontype.EmbeddedDocument, tM).Build()
+	clientOpts := options.Client().SetRegistry(reg).ApplyURI(mongoDBConnString)
+
+	mongoClient, err := mongo.NewClient(clientOpts)
+	if err != nil {
+		return err
+	}
+
+	err = mongoClient.Connect(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+
+	db := mongoClient.Database("test")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	return db.Client().Ping(ctx, nil)
+}
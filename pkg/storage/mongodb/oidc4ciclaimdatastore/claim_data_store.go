@@ -111,3 +111,17 @@ func (s *Store) GetAndDelete(ctx context.Context, claimDataID string) (*oidc4ci.
 
 	return &claimData, nil
 }
+
+// Delete deletes claim data. Deleting of a non-existing claim data is not an error.
+func (s *Store) Delete(ctx context.Context, claimDataID string) error {
+	id, err := primitive.ObjectIDFromHex(claimDataID)
+	if err != nil {
+		return fmt.Errorf("parse id %s: %w", claimDataID, err)
+	}
+
+	if _, err = s.mongoClient.Database().Collection(collectionName).DeleteOne(ctx, bson.M{"_id": id}); err != nil {
+		return fmt.Errorf("delete: %w", err)
+	}
+
+	return nil
+}
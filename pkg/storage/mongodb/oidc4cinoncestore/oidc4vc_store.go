@@ -9,6 +9,7 @@ package oidc4cinoncestore
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -16,6 +17,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
 	"github.com/trustbloc/vcs/pkg/restapi/resterr"
 	"github.com/trustbloc/vcs/pkg/service/oidc4ci"
 	"github.com/trustbloc/vcs/pkg/storage/mongodb"
@@ -81,6 +83,12 @@ func (s *Store) migrate(ctx context.Context) error {
 				},
 				Options: options.Index().SetUnique(true),
 			},
+			{
+				Keys: bson.D{
+					{Key: "profileid", Value: 1},
+					{Key: "profileversion", Value: 1},
+				},
+			},
 			{ // ttl index https://www.mongodb.com/community/forums/t/ttl-index-internals/4086/2
 				Keys: map[string]interface{}{
 					"expireAt": 1,
@@ -141,6 +149,52 @@ func (s *Store) FindByOpState(ctx context.Context, opState string) (*oidc4ci.Tra
 	return s.findOne(ctx, bson.M{"opState": opState})
 }
 
+// FindOpenByProfile returns not expired transactions of the profile that are neither completed nor cancelled.
+func (s *Store) FindOpenByProfile(
+	ctx context.Context,
+	profileID profileapi.ID,
+	profileVersion profileapi.Version,
+) ([]*oidc4ci.Transaction, error) {
+	collection := s.mongoClient.Database().Collection(collectionName)
+
+	cursor, err := collection.Find(ctx, bson.M{
+		"profileid":      profileID,
+		"profileversion": profileVersion,
+		"status": bson.M{
+			"$nin": []oidc4ci.TransactionState{
+				oidc4ci.TransactionStateCredentialsIssued,
+				oidc4ci.TransactionStateCancelled,
+			},
+		},
+		"expireAt": bson.M{"$gt": time.Now().UTC()},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("find: %w", err)
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var txs []*oidc4ci.Transaction
+
+	for cursor.Next(ctx) {
+		var doc mongoDocument
+
+		if err = cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode: %w", err)
+		}
+
+		txs = append(txs, mapDocumentToTransaction(&doc))
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor: %w", err)
+	}
+
+	return txs, nil
+}
+
 func (s *Store) findOne(ctx context.Context, filter interface{}) (*oidc4ci.Transaction, error) {
 	collection := s.mongoClient.Database().Collection(collectionName)
 
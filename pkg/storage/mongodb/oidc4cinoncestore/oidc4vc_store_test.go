@@ -249,6 +249,42 @@ func TestStore(t *testing.T) {
 		assert.Equal(t, ad, found.CredentialConfiguration[0].AuthorizationDetails)
 	})
 
+	t.Run("find open by profile", func(t *testing.T) {
+		profileID := uuid.NewString()
+
+		open, createErr := store.Create(context.TODO(), 0, &oidc4ci.TransactionData{
+			ProfileID:      profileID,
+			ProfileVersion: "v1.0",
+			OpState:        uuid.NewString(),
+			State:          oidc4ci.TransactionStateIssuanceInitiated,
+		})
+		assert.NoError(t, createErr)
+
+		cancelled, createErr := store.Create(context.TODO(), 0, &oidc4ci.TransactionData{
+			ProfileID:      profileID,
+			ProfileVersion: "v1.0",
+			OpState:        uuid.NewString(),
+			State:          oidc4ci.TransactionStateIssuanceInitiated,
+		})
+		assert.NoError(t, createErr)
+
+		cancelled.State = oidc4ci.TransactionStateCancelled
+		assert.NoError(t, store.Update(context.TODO(), cancelled))
+
+		_, createErr = store.Create(context.TODO(), 0, &oidc4ci.TransactionData{
+			ProfileID:      profileID,
+			ProfileVersion: "v2.0",
+			OpState:        uuid.NewString(),
+			State:          oidc4ci.TransactionStateIssuanceInitiated,
+		})
+		assert.NoError(t, createErr)
+
+		txs, findErr := store.FindOpenByProfile(context.TODO(), profileID, "v1.0")
+		assert.NoError(t, findErr)
+		assert.Len(t, txs, 1)
+		assert.Equal(t, open.ID, txs[0].ID)
+	})
+
 	t.Run("find non existing document", func(t *testing.T) {
 		id := uuid.New().String()
 
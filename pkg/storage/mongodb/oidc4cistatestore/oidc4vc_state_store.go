@@ -121,6 +121,15 @@ func (s *Store) GetAuthorizeState(ctx context.Context, opState string) (*oidc4ci
 	return doc.State, nil
 }
 
+// DeleteAuthorizeState deletes authorize state. Deleting of a non-existing state is not an error.
+func (s *Store) DeleteAuthorizeState(ctx context.Context, opState string) error {
+	_, err := s.mongoClient.Database().Collection(collectionName).DeleteOne(ctx, bson.M{
+		"opState": opState,
+	})
+
+	return err
+}
+
 func (s *Store) mapTransactionDataToMongoDocument(
 	opState string,
 	data *oidc4ci.AuthorizeState,
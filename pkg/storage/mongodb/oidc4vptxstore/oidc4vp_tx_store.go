@@ -34,11 +34,23 @@ type txDocument struct {
 	PresentationDefinition map[string]interface{} `bson:"presentationDefinition"`
 	ReceivedClaimsID       string                 `bson:"receivedClaimsID"`
 	CustomScopes           []string               `bson:"customScopes,omitempty"`
+	ResponseRedirectURI    string                 `bson:"responseRedirectURI,omitempty"`
+	ResponseCode           string                 `bson:"responseCode,omitempty"`
+	Status                 *txStatusDocument      `bson:"status,omitempty"`
 	ExpireAt               time.Time              `bson:"expire_at"`
 }
 
+type txStatusDocument struct {
+	State          string    `bson:"state"`
+	ErrorCode      string    `bson:"errorCode,omitempty"`
+	ErrorComponent string    `bson:"errorComponent,omitempty"`
+	Error          string    `bson:"error,omitempty"`
+	UpdatedAt      time.Time `bson:"updatedAt"`
+}
+
 type txUpdateDocument struct {
 	ReceivedClaimsID string `bson:"receivedClaimsID"`
+	ResponseCode     string `bson:"responseCode,omitempty"`
 }
 
 // TxStore manages profile in mongodb.
@@ -87,6 +99,7 @@ func (p *TxStore) Create(
 	profileID, profileVersion string,
 	profileTransactionDataTTL int32,
 	customScopes []string,
+	responseRedirectURI string,
 ) (oidc4vp.TxID, *oidc4vp.Transaction, error) {
 	ctxWithTimeout, cancel := p.mongoClient.ContextWithTimeout()
 	defer cancel()
@@ -109,6 +122,7 @@ func (p *TxStore) Create(
 		ProfileVersion:         profileVersion,
 		PresentationDefinition: pdContent,
 		CustomScopes:           customScopes,
+		ResponseRedirectURI:    responseRedirectURI,
 	}
 
 	result, err := collection.InsertOne(ctxWithTimeout, txDoc)
@@ -174,6 +188,7 @@ func (p *TxStore) Update(update oidc4vp.TransactionUpdate, _ int32) error {
 	result, err := collection.UpdateOne(ctxWithTimeout,
 		bson.D{{"_id", id}}, bson.D{{"$set", txUpdateDocument{
 			ReceivedClaimsID: update.ReceivedClaimsID,
+			ResponseCode:     update.ResponseCode,
 		}}})
 	if err != nil {
 		return err
@@ -186,6 +201,55 @@ func (p *TxStore) Update(update oidc4vp.TransactionUpdate, _ int32) error {
 	return nil
 }
 
+// UpdateStatus sets status of the interaction. Status of the finished interaction is not changed,
+// oidc4vp.ErrInteractionFinished is returned instead.
+func (p *TxStore) UpdateStatus(txID oidc4vp.TxID, status *oidc4vp.InteractionStatus) error {
+	ctxWithTimeout, cancel := p.mongoClient.ContextWithTimeout()
+	defer cancel()
+
+	collection := p.mongoClient.Database().Collection(txCollection)
+
+	id, err := txIDFromString(txID)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{
+		"_id": id,
+		"status.state": bson.M{"$nin": bson.A{
+			string(oidc4vp.InteractionStateSucceeded),
+			string(oidc4vp.InteractionStateFailed),
+		}},
+	}
+
+	result, err := collection.UpdateOne(ctxWithTimeout,
+		filter, bson.M{"$set": bson.M{"status": &txStatusDocument{
+			State:          string(status.State),
+			ErrorCode:      status.ErrorCode,
+			ErrorComponent: status.ErrorComponent,
+			Error:          status.Error,
+			UpdatedAt:      status.UpdatedAt,
+		}}})
+	if err != nil {
+		return fmt.Errorf("tx update status: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		count, countErr := collection.CountDocuments(ctxWithTimeout, bson.M{"_id": id})
+		if countErr != nil {
+			return fmt.Errorf("tx count: %w", countErr)
+		}
+
+		if count == 0 {
+			return oidc4vp.ErrDataNotFound
+		}
+
+		return oidc4vp.ErrInteractionFinished
+	}
+
+	return nil
+}
+
 func txIDFromString(strID oidc4vp.TxID) (primitive.ObjectID, error) {
 	if strID == "" {
 		return primitive.NilObjectID, nil
@@ -214,5 +278,22 @@ func txFromDocument(txDoc *txDocument) (*oidc4vp.Transaction, error) {
 		PresentationDefinition: pd,
 		ReceivedClaimsID:       txDoc.ReceivedClaimsID,
 		CustomScopes:           txDoc.CustomScopes,
+		ResponseRedirectURI:    txDoc.ResponseRedirectURI,
+		ResponseCode:           txDoc.ResponseCode,
+		Status:                 statusFromDocument(txDoc.Status),
 	}, nil
 }
+
+func statusFromDocument(doc *txStatusDocument) *oidc4vp.InteractionStatus {
+	if doc == nil {
+		return nil
+	}
+
+	return &oidc4vp.InteractionStatus{
+		State:          oidc4vp.InteractionState(doc.State),
+		ErrorCode:      doc.ErrorCode,
+		ErrorComponent: doc.ErrorComponent,
+		Error:          doc.Error,
+		UpdatedAt:      doc.UpdatedAt,
+	}
+}
@@ -61,13 +61,15 @@ func TestTxStore_Success(t *testing.T) {
 	}()
 
 	t.Run("Create tx", func(t *testing.T) {
-		id, _, err := store.Create(&presexch.PresentationDefinition{}, profileID, profileVersion, 0, []string{customScope})
+		id, _, err := store.Create(
+			&presexch.PresentationDefinition{}, profileID, profileVersion, 0, []string{customScope}, "")
 		require.NoError(t, err)
 		require.NotNil(t, id)
 	})
 
 	t.Run("Create tx then Get by id", func(t *testing.T) {
-		id, _, err := store.Create(&presexch.PresentationDefinition{}, profileID, profileVersion, 0, []string{customScope})
+		id, _, err := store.Create(
+			&presexch.PresentationDefinition{}, profileID, profileVersion, 0, []string{customScope}, "")
 
 		require.NoError(t, err)
 		require.NotNil(t, id)
@@ -79,7 +81,7 @@ func TestTxStore_Success(t *testing.T) {
 	})
 
 	t.Run("Create tx then update with received claims ID", func(t *testing.T) {
-		id, _, err := store.Create(&presexch.PresentationDefinition{}, profileID, profileVersion, 0, nil)
+		id, _, err := store.Create(&presexch.PresentationDefinition{}, profileID, profileVersion, 0, nil, "")
 
 		require.NoError(t, err)
 		require.NotNil(t, id)
@@ -96,6 +98,77 @@ func TestTxStore_Success(t *testing.T) {
 		require.Nil(t, tx.ReceivedClaims)
 		require.Nil(t, tx.CustomScopes)
 	})
+
+	t.Run("Create tx with response redirect uri then update with response code", func(t *testing.T) {
+		id, txCreate, err := store.Create(&presexch.PresentationDefinition{}, profileID, profileVersion, 0, nil,
+			"https://verifier.example.com/cb")
+		require.NoError(t, err)
+		require.Equal(t, "https://verifier.example.com/cb", txCreate.ResponseRedirectURI)
+
+		err = store.Update(oidc4vp.TransactionUpdate{
+			ID:               id,
+			ReceivedClaimsID: receivedClaimsID,
+			ResponseCode:     "response-code",
+		}, 0)
+		require.NoError(t, err)
+
+		tx, err := store.Get(id)
+		require.NoError(t, err)
+		require.Equal(t, "https://verifier.example.com/cb", tx.ResponseRedirectURI)
+		require.Equal(t, "response-code", tx.ResponseCode)
+		require.Equal(t, receivedClaimsID, tx.ReceivedClaimsID)
+	})
+
+	t.Run("Create tx then update status", func(t *testing.T) {
+		id, _, err := store.Create(&presexch.PresentationDefinition{}, profileID, profileVersion, 0, nil, "")
+		require.NoError(t, err)
+
+		tx, err := store.Get(id)
+		require.NoError(t, err)
+		require.Nil(t, tx.Status)
+
+		status := &oidc4vp.InteractionStatus{
+			State:          oidc4vp.InteractionStateFailed,
+			ErrorCode:      "invalid_request",
+			ErrorComponent: "verifier",
+			Error:          "some error",
+			UpdatedAt:      time.Now().UTC().Truncate(time.Millisecond),
+		}
+
+		require.NoError(t, store.UpdateStatus(id, status))
+
+		tx, err = store.Get(id)
+		require.NoError(t, err)
+		require.Equal(t, status, tx.Status)
+	})
+
+	t.Run("Final status is not changed by out of order update", func(t *testing.T) {
+		id, _, err := store.Create(&presexch.PresentationDefinition{}, profileID, profileVersion, 0, nil, "")
+		require.NoError(t, err)
+
+		require.NoError(t, store.UpdateStatus(id, &oidc4vp.InteractionStatus{
+			State:     oidc4vp.InteractionStatePresentationReceived,
+			UpdatedAt: time.Now().UTC().Truncate(time.Millisecond),
+		}))
+
+		succeeded := &oidc4vp.InteractionStatus{
+			State:     oidc4vp.InteractionStateSucceeded,
+			UpdatedAt: time.Now().UTC().Truncate(time.Millisecond),
+		}
+
+		require.NoError(t, store.UpdateStatus(id, succeeded))
+
+		// Event of the earlier step is delivered after the interaction is finished.
+		err = store.UpdateStatus(id, &oidc4vp.InteractionStatus{
+			State:     oidc4vp.InteractionStateRequestObjectFetched,
+			UpdatedAt: time.Now().UTC().Truncate(time.Millisecond),
+		})
+		require.ErrorIs(t, err, oidc4vp.ErrInteractionFinished)
+
+		tx, err := store.Get(id)
+		require.NoError(t, err)
+		require.Equal(t, succeeded, tx.Status)
+	})
 }
 
 func TestTxStore_Fails(t *testing.T) {
@@ -125,6 +198,11 @@ func TestTxStore_Fails(t *testing.T) {
 		require.Contains(t, err.Error(), oidc4vp.ErrDataNotFound.Error())
 	})
 
+	t.Run("Update status of not existing tx", func(t *testing.T) {
+		err := store.UpdateStatus("121212121212121212121212", &oidc4vp.InteractionStatus{State: oidc4vp.InteractionStateInitiated})
+		require.ErrorIs(t, err, oidc4vp.ErrDataNotFound)
+	})
+
 	t.Run("Get not existing tx id", func(t *testing.T) {
 		_, err := store.Get("121212121212121212121212")
 		require.EqualError(t, err, oidc4vp.ErrDataNotFound.Error())
@@ -167,7 +245,7 @@ func TestTxStore_Fails(t *testing.T) {
 		require.NoError(t, err)
 
 		id, _, err := storeExpired.Create(
-			&presexch.PresentationDefinition{}, profileID, profileVersion, 0, []string{customScope})
+			&presexch.PresentationDefinition{}, profileID, profileVersion, 0, []string{customScope}, "")
 		require.NoError(t, err)
 		require.NotNil(t, id)
 
@@ -183,7 +261,7 @@ func TestTxStore_Fails(t *testing.T) {
 		require.NoError(t, err)
 
 		id, _, err := storeExpired.Create(
-			&presexch.PresentationDefinition{}, profileID, profileVersion, 1, []string{customScope})
+			&presexch.PresentationDefinition{}, profileID, profileVersion, 1, []string{customScope}, "")
 		require.NoError(t, err)
 		require.NotNil(t, id)
 
@@ -0,0 +1,84 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package presentationarchivestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/trustbloc/vcs/pkg/service/presentationarchive"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb"
+)
+
+const (
+	payloadCollectionName = "presentation_archive_payload"
+)
+
+type payloadDocument struct {
+	TxID string `bson:"_id"`
+	Data []byte `bson:"data"`
+}
+
+// PayloadStore stores encrypted archived presentations in mongodb.
+type PayloadStore struct {
+	mongoClient *mongodb.Client
+}
+
+// NewPayloadStore creates PayloadStore.
+func NewPayloadStore(mongoClient *mongodb.Client) *PayloadStore {
+	return &PayloadStore{mongoClient: mongoClient}
+}
+
+// Put stores the encrypted presentation of the transaction.
+func (s *PayloadStore) Put(ctx context.Context, txID string, data []byte) error {
+	collection := s.mongoClient.Database().Collection(payloadCollectionName)
+
+	_, err := collection.ReplaceOne(ctx, bson.M{"_id": txID}, &payloadDocument{
+		TxID: txID,
+		Data: data,
+	}, options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("replace archived presentation: %w", err)
+	}
+
+	return nil
+}
+
+// Get returns the encrypted presentation of the transaction.
+func (s *PayloadStore) Get(ctx context.Context, txID string) ([]byte, error) {
+	collection := s.mongoClient.Database().Collection(payloadCollectionName)
+
+	doc := &payloadDocument{}
+
+	err := collection.FindOne(ctx, bson.M{"_id": txID}).Decode(doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, presentationarchive.ErrDataNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("find archived presentation: %w", err)
+	}
+
+	return doc.Data, nil
+}
+
+// Delete deletes the encrypted presentation of the transaction.
+func (s *PayloadStore) Delete(ctx context.Context, txID string) error {
+	collection := s.mongoClient.Database().Collection(payloadCollectionName)
+
+	_, err := collection.DeleteOne(ctx, bson.M{"_id": txID})
+	if err != nil {
+		return fmt.Errorf("delete archived presentation: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package presentationarchivestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/trustbloc/vcs/pkg/service/presentationarchive"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb"
+)
+
+const (
+	collectionName = "presentation_archive"
+)
+
+type mongoDocument struct {
+	TxID           string    `bson:"_id"`
+	ProfileID      string    `bson:"profileID"`
+	ProfileVersion string    `bson:"profileVersion"`
+	Sequence       int64     `bson:"sequence"`
+	PrevHash       string    `bson:"prevHash"`
+	Hash           string    `bson:"hash"`
+	PayloadDigest  string    `bson:"payloadDigest"`
+	CreatedAt      time.Time `bson:"createdAt"`
+	ExpireAt       time.Time `bson:"expireAt"`
+	Purged         bool      `bson:"purged"`
+	EncryptedKey   []byte    `bson:"encryptedKey,omitempty"`
+	Signature      string    `bson:"signature"`
+}
+
+// Store stores the hash chain records of archived presentations in mongodb. Records are kept after
+// the retention period is over, so no TTL index is used.
+type Store struct {
+	mongoClient *mongodb.Client
+}
+
+// NewStore creates Store.
+func NewStore(ctx context.Context, mongoClient *mongodb.Client) (*Store, error) {
+	s := &Store{
+		mongoClient: mongoClient,
+	}
+
+	if err := s.migrate(ctx); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Store) migrate(ctx context.Context) error {
+	_, err := s.mongoClient.Database().Collection(collectionName).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			// Sequence number is unique within the profile chain, so concurrent appends can not fork it.
+			Keys: bson.D{
+				{Key: "profileID", Value: 1},
+				{Key: "sequence", Value: -1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "purged", Value: 1},
+				{Key: "expireAt", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("create index for collection %s: %w", collectionName, err)
+	}
+
+	return nil
+}
+
+// Latest returns the last record of the hash chain of the profile.
+func (s *Store) Latest(ctx context.Context, profileID string) (*presentationarchive.Record, error) {
+	return s.findOne(ctx, bson.M{"profileID": profileID},
+		options.FindOne().SetSort(bson.D{{Key: "sequence", Value: -1}}))
+}
+
+// Create appends the record to the hash chain. ErrChainConflict is returned if the sequence number of the record
+// is taken or the presentation of the transaction is already archived.
+func (s *Store) Create(ctx context.Context, record *presentationarchive.Record) error {
+	collection := s.mongoClient.Database().Collection(collectionName)
+
+	doc := &mongoDocument{
+		TxID:           record.TxID,
+		ProfileID:      record.ProfileID,
+		ProfileVersion: record.ProfileVersion,
+		Sequence:       record.Sequence,
+		PrevHash:       record.PrevHash,
+		Hash:           record.Hash,
+		PayloadDigest:  record.PayloadDigest,
+		CreatedAt:      record.CreatedAt,
+		ExpireAt:       record.ExpireAt,
+		Purged:         record.Purged,
+		EncryptedKey:   record.EncryptedKey,
+		Signature:      record.Signature,
+	}
+
+	_, err := collection.InsertOne(ctx, doc)
+	if mongo.IsDuplicateKeyError(err) {
+		return presentationarchive.ErrChainConflict
+	}
+
+	if err != nil {
+		return fmt.Errorf("insert archive record: %w", err)
+	}
+
+	return nil
+}
+
+// Get returns the record of the transaction.
+func (s *Store) Get(ctx context.Context, txID string) (*presentationarchive.Record, error) {
+	return s.findOne(ctx, bson.M{"_id": txID})
+}
+
+// GetBySequence returns the record of the profile chain by its sequence number.
+func (s *Store) GetBySequence(
+	ctx context.Context,
+	profileID string,
+	sequence int64,
+) (*presentationarchive.Record, error) {
+	return s.findOne(ctx, bson.M{"profileID": profileID, "sequence": sequence})
+}
+
+// FindExpired returns records which are not purged yet and expired before the given time.
+func (s *Store) FindExpired(
+	ctx context.Context,
+	before time.Time,
+	limit int,
+) ([]*presentationarchive.Record, error) {
+	collection := s.mongoClient.Database().Collection(collectionName)
+
+	cursor, err := collection.Find(ctx,
+		bson.M{
+			"purged":   false,
+			"expireAt": bson.M{"$lt": before},
+		},
+		options.Find().SetLimit(int64(limit)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("find expired archive records: %w", err)
+	}
+
+	var docs []*mongoDocument
+
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("decode expired archive records: %w", err)
+	}
+
+	records := make([]*presentationarchive.Record, 0, len(docs))
+
+	for _, doc := range docs {
+		records = append(records, mapDocument(doc))
+	}
+
+	return records, nil
+}
+
+// MarkPurged marks the record of the transaction as purged and removes the encrypted data key of the presentation.
+// Only a record which is not purged yet is updated, ErrDataNotFound is returned otherwise, so concurrent purges
+// of the same record are detected.
+func (s *Store) MarkPurged(ctx context.Context, txID string) error {
+	collection := s.mongoClient.Database().Collection(collectionName)
+
+	result, err := collection.UpdateOne(ctx, bson.M{"_id": txID, "purged": false}, bson.M{
+		"$set":   bson.M{"purged": true},
+		"$unset": bson.M{"encryptedKey": ""},
+	})
+	if err != nil {
+		return fmt.Errorf("update archive record: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return presentationarchive.ErrDataNotFound
+	}
+
+	return nil
+}
+
+func (s *Store) findOne(
+	ctx context.Context,
+	filter bson.M,
+	opts ...*options.FindOneOptions,
+) (*presentationarchive.Record, error) {
+	collection := s.mongoClient.Database().Collection(collectionName)
+
+	doc := &mongoDocument{}
+
+	err := collection.FindOne(ctx, filter, opts...).Decode(doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, presentationarchive.ErrDataNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("find archive record: %w", err)
+	}
+
+	return mapDocument(doc), nil
+}
+
+func mapDocument(doc *mongoDocument) *presentationarchive.Record {
+	return &presentationarchive.Record{
+		TxID:           doc.TxID,
+		ProfileID:      doc.ProfileID,
+		ProfileVersion: doc.ProfileVersion,
+		Sequence:       doc.Sequence,
+		PrevHash:       doc.PrevHash,
+		Hash:           doc.Hash,
+		PayloadDigest:  doc.PayloadDigest,
+		CreatedAt:      doc.CreatedAt,
+		ExpireAt:       doc.ExpireAt,
+		Purged:         doc.Purged,
+		EncryptedKey:   doc.EncryptedKey,
+		Signature:      doc.Signature,
+	}
+}
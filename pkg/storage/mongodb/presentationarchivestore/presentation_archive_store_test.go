@@ -0,0 +1,266 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package presentationarchivestore
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	dctest "github.com/ory/dockertest/v3"
+	dc "github.com/ory/dockertest/v3/docker"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/trustbloc/vcs/pkg/service/presentationarchive"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb"
+)
+
+const (
+	mongoDBConnString  = "mongodb://localhost:27044"
+	dockerMongoDBImage = "mongo"
+	dockerMongoDBTag   = "4.0.0"
+)
+
+func TestStore(t *testing.T) {
+	pool, mongoDBResource := startMongoDBContainer(t)
+
+	defer func() {
+		require.NoError(t, pool.Purge(mongoDBResource), "failed to purge MongoDB resource")
+	}()
+
+	client, err := mongodb.New(mongoDBConnString, "testdb", mongodb.WithTimeout(time.Second*10))
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, client.Close(), "failed to close mongodb client")
+	}()
+
+	store, err := NewStore(context.Background(), client)
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	first := &presentationarchive.Record{
+		TxID:           "tx1",
+		ProfileID:      "profileID",
+		ProfileVersion: "v1.0",
+		Sequence:       1,
+		Hash:           "hash1",
+		PayloadDigest:  "digest1",
+		EncryptedKey:   []byte("key1"),
+		Signature:      "signature1",
+		CreatedAt:      now,
+		ExpireAt:       now.Add(-time.Minute),
+	}
+
+	second := &presentationarchive.Record{
+		TxID:           "tx2",
+		ProfileID:      "profileID",
+		ProfileVersion: "v1.0",
+		Sequence:       2,
+		PrevHash:       "hash1",
+		Hash:           "hash2",
+		PayloadDigest:  "digest2",
+		EncryptedKey:   []byte("key2"),
+		Signature:      "signature2",
+		CreatedAt:      now,
+		ExpireAt:       now.Add(time.Hour),
+	}
+
+	t.Run("Latest not found", func(t *testing.T) {
+		_, err = store.Latest(context.Background(), "profileID")
+		require.ErrorIs(t, err, presentationarchive.ErrDataNotFound)
+	})
+
+	t.Run("Create and get", func(t *testing.T) {
+		require.NoError(t, store.Create(context.Background(), first))
+		require.NoError(t, store.Create(context.Background(), second))
+
+		stored, err := store.Get(context.Background(), "tx2")
+		require.NoError(t, err)
+		require.Equal(t, second.TxID, stored.TxID)
+		require.Equal(t, second.ProfileID, stored.ProfileID)
+		require.Equal(t, second.ProfileVersion, stored.ProfileVersion)
+		require.Equal(t, second.Sequence, stored.Sequence)
+		require.Equal(t, second.PrevHash, stored.PrevHash)
+		require.Equal(t, second.Hash, stored.Hash)
+		require.Equal(t, second.PayloadDigest, stored.PayloadDigest)
+		require.Equal(t, second.EncryptedKey, stored.EncryptedKey)
+		require.Equal(t, second.Signature, stored.Signature)
+		require.True(t, second.CreatedAt.Equal(stored.CreatedAt))
+		require.True(t, second.ExpireAt.Equal(stored.ExpireAt))
+		require.False(t, stored.Purged)
+
+		latest, err := store.Latest(context.Background(), "profileID")
+		require.NoError(t, err)
+		require.Equal(t, "tx2", latest.TxID)
+
+		prev, err := store.GetBySequence(context.Background(), "profileID", 1)
+		require.NoError(t, err)
+		require.Equal(t, "tx1", prev.TxID)
+	})
+
+	t.Run("Chain conflict", func(t *testing.T) {
+		err = store.Create(context.Background(), &presentationarchive.Record{
+			TxID:      "tx3",
+			ProfileID: "profileID",
+			Sequence:  2,
+		})
+		require.ErrorIs(t, err, presentationarchive.ErrChainConflict)
+
+		err = store.Create(context.Background(), &presentationarchive.Record{
+			TxID:      "tx1",
+			ProfileID: "profileID",
+			Sequence:  3,
+		})
+		require.ErrorIs(t, err, presentationarchive.ErrChainConflict)
+	})
+
+	t.Run("Find expired and mark purged", func(t *testing.T) {
+		expired, err := store.FindExpired(context.Background(), time.Now(), 10)
+		require.NoError(t, err)
+		require.Len(t, expired, 1)
+		require.Equal(t, "tx1", expired[0].TxID)
+
+		require.NoError(t, store.MarkPurged(context.Background(), "tx1"))
+
+		// Record purged by another instance is not updated again.
+		require.ErrorIs(t, store.MarkPurged(context.Background(), "tx1"), presentationarchive.ErrDataNotFound)
+
+		expired, err = store.FindExpired(context.Background(), time.Now(), 10)
+		require.NoError(t, err)
+		require.Empty(t, expired)
+
+		stored, err := store.Get(context.Background(), "tx1")
+		require.NoError(t, err)
+		require.True(t, stored.Purged)
+		require.Empty(t, stored.EncryptedKey)
+		require.Equal(t, "hash1", stored.Hash)
+		require.Equal(t, "signature1", stored.Signature)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		_, err = store.Get(context.Background(), "unknown")
+		require.ErrorIs(t, err, presentationarchive.ErrDataNotFound)
+
+		_, err = store.GetBySequence(context.Background(), "profileID", 10)
+		require.ErrorIs(t, err, presentationarchive.ErrDataNotFound)
+	})
+
+	t.Run("Context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		require.ErrorContains(t, store.Create(ctx, &presentationarchive.Record{TxID: "id"}), "context canceled")
+		require.ErrorContains(t, store.MarkPurged(ctx, "id"), "context canceled")
+
+		_, err = store.Get(ctx, "id")
+		require.ErrorContains(t, err, "context canceled")
+
+		_, err = store.FindExpired(ctx, time.Now(), 10)
+		require.ErrorContains(t, err, "context canceled")
+
+		_, err = NewStore(ctx, client)
+		require.ErrorContains(t, err, "context canceled")
+	})
+}
+
+func TestPayloadStore(t *testing.T) {
+	pool, mongoDBResource := startMongoDBContainer(t)
+
+	defer func() {
+		require.NoError(t, pool.Purge(mongoDBResource), "failed to purge MongoDB resource")
+	}()
+
+	client, err := mongodb.New(mongoDBConnString, "testdb", mongodb.WithTimeout(time.Second*10))
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, client.Close(), "failed to close mongodb client")
+	}()
+
+	store := NewPayloadStore(client)
+
+	t.Run("Put, get and delete", func(t *testing.T) {
+		require.NoError(t, store.Put(context.Background(), "tx1", []byte("data")))
+
+		data, err := store.Get(context.Background(), "tx1")
+		require.NoError(t, err)
+		require.Equal(t, []byte("data"), data)
+
+		require.NoError(t, store.Delete(context.Background(), "tx1"))
+
+		_, err = store.Get(context.Background(), "tx1")
+		require.ErrorIs(t, err, presentationarchive.ErrDataNotFound)
+	})
+
+	t.Run("Context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		require.ErrorContains(t, store.Put(ctx, "id", nil), "context canceled")
+		require.ErrorContains(t, store.Delete(ctx, "id"), "context canceled")
+
+		_, err = store.Get(ctx, "id")
+		require.ErrorContains(t, err, "context canceled")
+	})
+}
+func startMongoDBContainer(t *testing.T) (*dctest.Pool, *dctest.Resource) {
+	t.Helper()
+
+	pool, err := dctest.NewPool("")
+	require.NoError(t, err)
+
+	mongoDBResource, err := pool.RunWithOptions(&dctest.RunOptions{
+		Repository: dockerMongoDBImage,
+		Tag:        dockerMongoDBTag,
+		PortBindings: map[dc.Port][]dc.PortBinding{
+			"27017/tcp": {{HostIP: "", HostPort: "27044"}},
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, waitForMongoDBToBeUp())
+
+	return pool, mongoDBResource
+}
+
+func waitForMongoDBToBeUp() error {
+	return backoff.Retry(pingMongoDB, backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Second), 30))
+}
+
+func pingMongoDB() error {
+	var err error
+
+	tM := reflect.TypeOf(bson.M{})
+	reg := bson.NewRegistryBuilder().RegisterTypeMapEntry(bsontype.EmbeddedDocument, tM).Build()
+	clientOpts := options.Client().SetRegistry(reg).ApplyURI(mongoDBConnString)
+
+	mongoClient, err := mongo.NewClient(clientOpts)
+	if err != nil {
+		return err
+	}
+
+	err = mongoClient.Connect(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+
+	db := mongoClient.Database("test")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	return db.Client().Ping(ctx, nil)
+}
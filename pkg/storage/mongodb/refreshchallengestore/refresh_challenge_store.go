@@ -0,0 +1,171 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package refreshchallengestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/trustbloc/vcs/pkg/service/credentialrefresh"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb"
+)
+
+const (
+	collectionName             = "credential_refresh_challenge"
+	supersessionCollectionName = "credential_refresh_supersession"
+)
+
+type mongoDocument struct {
+	ID             string    `bson:"_id"`
+	ProfileID      string    `bson:"profileID"`
+	ProfileVersion string    `bson:"profileVersion"`
+	ExpireAt       time.Time `bson:"expireAt"`
+}
+
+type supersessionDocument struct {
+	ID           string    `bson:"_id"`
+	SupersededBy string    `bson:"supersededBy"`
+	CreatedAt    time.Time `bson:"createdAt"`
+}
+
+// Store stores one-time credential refresh challenges and records of credentials superseded by
+// the refreshed credentials in mongodb.
+type Store struct {
+	mongoClient *mongodb.Client
+}
+
+// NewStore creates Store.
+func NewStore(ctx context.Context, mongoClient *mongodb.Client) (*Store, error) {
+	s := &Store{
+		mongoClient: mongoClient,
+	}
+
+	if err := s.migrate(ctx); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Store) migrate(ctx context.Context) error {
+	_, err := s.mongoClient.Database().Collection(collectionName).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			// ttl index https://www.mongodb.com/community/forums/t/ttl-index-internals/4086/2
+			Keys:    bson.M{"expireAt": 1},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+		{
+			Keys: bson.D{
+				{Key: "profileID", Value: 1},
+				{Key: "profileVersion", Value: 1},
+				{Key: "expireAt", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("create index for collection %s: %w", collectionName, err)
+	}
+
+	return nil
+}
+
+// Create stores the challenge.
+func (s *Store) Create(ctx context.Context, challenge *credentialrefresh.Challenge) error {
+	collection := s.mongoClient.Database().Collection(collectionName)
+
+	_, err := collection.InsertOne(ctx, &mongoDocument{
+		ID:             challenge.ID,
+		ProfileID:      challenge.ProfileID,
+		ProfileVersion: challenge.ProfileVersion,
+		ExpireAt:       challenge.ExpireAt,
+	})
+	if err != nil {
+		return fmt.Errorf("insert challenge: %w", err)
+	}
+
+	return nil
+}
+
+// MarkSuperseded records that the credential is superseded by the refreshed credential. The credential ID is
+// the document ID, so only one refresh of the credential is recorded across VCS instances.
+func (s *Store) MarkSuperseded(ctx context.Context, credentialID, supersededBy string) error {
+	_, err := s.mongoClient.Database().Collection(supersessionCollectionName).InsertOne(ctx, &supersessionDocument{
+		ID:           credentialID,
+		SupersededBy: supersededBy,
+		CreatedAt:    time.Now().UTC(),
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return credentialrefresh.ErrAlreadySuperseded
+		}
+
+		return fmt.Errorf("insert supersession: %w", err)
+	}
+
+	return nil
+}
+
+// UnmarkSuperseded removes the supersession record of the credential made for the refreshed credential.
+func (s *Store) UnmarkSuperseded(ctx context.Context, credentialID, supersededBy string) error {
+	_, err := s.mongoClient.Database().Collection(supersessionCollectionName).DeleteOne(ctx, bson.M{
+		"_id":          credentialID,
+		"supersededBy": supersededBy,
+	})
+	if err != nil {
+		return fmt.Errorf("delete supersession: %w", err)
+	}
+
+	return nil
+}
+
+// CountActive returns the number of unexpired challenges of the profile.
+func (s *Store) CountActive(ctx context.Context, profileID, profileVersion string) (int64, error) {
+	count, err := s.mongoClient.Database().Collection(collectionName).CountDocuments(ctx, bson.M{
+		"profileID":      profileID,
+		"profileVersion": profileVersion,
+		"expireAt":       bson.M{"$gt": time.Now()},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("count challenges: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetAndDelete returns and deletes the challenge in a single operation, so the challenge can be used once only.
+func (s *Store) GetAndDelete(ctx context.Context, id string) (*credentialrefresh.Challenge, error) {
+	collection := s.mongoClient.Database().Collection(collectionName)
+
+	doc := &mongoDocument{}
+
+	err := collection.FindOneAndDelete(ctx, bson.M{"_id": id}).Decode(doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, credentialrefresh.ErrDataNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("find and delete challenge: %w", err)
+	}
+
+	// Expired documents are removed by the ttl index in the background, so they may still be found.
+	if doc.ExpireAt.Before(time.Now()) {
+		return nil, credentialrefresh.ErrDataNotFound
+	}
+
+	return &credentialrefresh.Challenge{
+		ID:             doc.ID,
+		ProfileID:      doc.ProfileID,
+		ProfileVersion: doc.ProfileVersion,
+		ExpireAt:       doc.ExpireAt,
+	}, nil
+}
@@ -0,0 +1,181 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package refreshchallengestore
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	dctest "github.com/ory/dockertest/v3"
+	dc "github.com/ory/dockertest/v3/docker"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/trustbloc/vcs/pkg/service/credentialrefresh"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb"
+)
+
+const (
+	mongoDBConnString  = "mongodb://localhost:27045"
+	dockerMongoDBImage = "mongo"
+	dockerMongoDBTag   = "4.0.0"
+)
+
+func TestStore(t *testing.T) {
+	pool, mongoDBResource := startMongoDBContainer(t)
+
+	defer func() {
+		require.NoError(t, pool.Purge(mongoDBResource), "failed to purge MongoDB resource")
+	}()
+
+	client, err := mongodb.New(mongoDBConnString, "testdb", mongodb.WithTimeout(time.Second*10))
+	require.NoError(t, err)
+
+	store, err := NewStore(context.Background(), client)
+	require.NoError(t, err)
+
+	t.Run("Create and consume", func(t *testing.T) {
+		challenge := &credentialrefresh.Challenge{
+			ID:             "challenge-1",
+			ProfileID:      "profileID",
+			ProfileVersion: "v1.0",
+			ExpireAt:       time.Now().UTC().Add(time.Minute).Truncate(time.Millisecond),
+		}
+
+		require.NoError(t, store.Create(context.Background(), challenge))
+
+		stored, err := store.GetAndDelete(context.Background(), "challenge-1")
+		require.NoError(t, err)
+		require.Equal(t, challenge, stored)
+
+		_, err = store.GetAndDelete(context.Background(), "challenge-1")
+		require.ErrorIs(t, err, credentialrefresh.ErrDataNotFound)
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		challenge := &credentialrefresh.Challenge{ID: "challenge-2", ExpireAt: time.Now().Add(time.Minute)}
+
+		require.NoError(t, store.Create(context.Background(), challenge))
+		require.ErrorContains(t, store.Create(context.Background(), challenge), "insert challenge")
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		require.NoError(t, store.Create(context.Background(), &credentialrefresh.Challenge{
+			ID:       "challenge-3",
+			ExpireAt: time.Now().Add(-time.Minute),
+		}))
+
+		_, err = store.GetAndDelete(context.Background(), "challenge-3")
+		require.ErrorIs(t, err, credentialrefresh.ErrDataNotFound)
+	})
+
+	t.Run("Count active", func(t *testing.T) {
+		for i, expireAt := range []time.Time{time.Now().Add(time.Minute), time.Now().Add(-time.Minute)} {
+			require.NoError(t, store.Create(context.Background(), &credentialrefresh.Challenge{
+				ID:             fmt.Sprintf("count-%d", i),
+				ProfileID:      "countProfileID",
+				ProfileVersion: "v1.0",
+				ExpireAt:       expireAt,
+			}))
+		}
+
+		count, err := store.CountActive(context.Background(), "countProfileID", "v1.0")
+		require.NoError(t, err)
+		require.EqualValues(t, 1, count)
+	})
+
+	t.Run("Supersession", func(t *testing.T) {
+		require.NoError(t, store.MarkSuperseded(context.Background(), "urn:uuid:vc1", "urn:uuid:vc2"))
+		require.ErrorIs(t, store.MarkSuperseded(context.Background(), "urn:uuid:vc1", "urn:uuid:vc3"),
+			credentialrefresh.ErrAlreadySuperseded)
+
+		// Record of other refreshed credential is not removed.
+		require.NoError(t, store.UnmarkSuperseded(context.Background(), "urn:uuid:vc1", "urn:uuid:vc3"))
+		require.ErrorIs(t, store.MarkSuperseded(context.Background(), "urn:uuid:vc1", "urn:uuid:vc3"),
+			credentialrefresh.ErrAlreadySuperseded)
+
+		require.NoError(t, store.UnmarkSuperseded(context.Background(), "urn:uuid:vc1", "urn:uuid:vc2"))
+		require.NoError(t, store.MarkSuperseded(context.Background(), "urn:uuid:vc1", "urn:uuid:vc3"))
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		_, err = store.GetAndDelete(context.Background(), "unknown")
+		require.ErrorIs(t, err, credentialrefresh.ErrDataNotFound)
+	})
+
+	t.Run("Context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		require.ErrorContains(t, store.Create(ctx, &credentialrefresh.Challenge{ID: "id"}), "context canceled")
+
+		_, err = store.GetAndDelete(ctx, "id")
+		require.ErrorContains(t, err, "context canceled")
+
+		_, err = store.CountActive(ctx, "profileID", "v1.0")
+		require.ErrorContains(t, err, "context canceled")
+
+		require.ErrorContains(t, store.MarkSuperseded(ctx, "id", "id2"), "context canceled")
+		require.ErrorContains(t, store.UnmarkSuperseded(ctx, "id", "id2"), "context canceled")
+	})
+}
+
+func startMongoDBContainer(t *testing.T) (*dctest.Pool, *dctest.Resource) {
+	t.Helper()
+
+	pool, err := dctest.NewPool("")
+	require.NoError(t, err)
+
+	mongoDBResource, err := pool.RunWithOptions(&dctest.RunOptions{
+		Repository: dockerMongoDBImage,
+		Tag:        dockerMongoDBTag,
+		PortBindings: map[dc.Port][]dc.PortBinding{
+			"27017/tcp": {{HostIP: "", HostPort: "27045"}},
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, waitForMongoDBToBeUp())
+
+	return pool, mongoDBResource
+}
+
+func waitForMongoDBToBeUp() error {
+	return backoff.Retry(pingMongoDB, backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Second), 30))
+}
+
+func pingMongoDB() error {
+	var err error
+
+	tM := reflect.TypeOf(bson.M{})
+	reg := bson.NewRegistryBuilder().RegisterTypeMapEntry(bsontype.EmbeddedDocument, tM).Build()
+	clientOpts := options.Client().SetRegistry(reg).ApplyURI(mongoDBConnString)
+
+	mongoClient, err := mongo.NewClient(clientOpts)
+	if err != nil {
+		return err
+	}
+
+	err = mongoClient.Connect(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+
+	db := mongoClient.Database("test")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	return db.Client().Ping(ctx, nil)
+}
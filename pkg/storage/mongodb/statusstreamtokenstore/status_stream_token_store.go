@@ -0,0 +1,105 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statusstreamtokenstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/trustbloc/vcs/pkg/service/oidc4vp"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb"
+)
+
+const (
+	collectionName = "oidc4vp_status_stream_token"
+)
+
+type mongoDocument struct {
+	ID       string    `bson:"_id"`
+	TxID     string    `bson:"txID"`
+	ExpireAt time.Time `bson:"expireAt"`
+}
+
+// Store stores interaction status stream tokens in mongodb.
+type Store struct {
+	mongoClient *mongodb.Client
+}
+
+// NewStore creates Store.
+func NewStore(ctx context.Context, mongoClient *mongodb.Client) (*Store, error) {
+	s := &Store{
+		mongoClient: mongoClient,
+	}
+
+	if err := s.migrate(ctx); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Store) migrate(ctx context.Context) error {
+	_, err := s.mongoClient.Database().Collection(collectionName).Indexes().CreateOne(ctx, mongo.IndexModel{
+		// ttl index https://www.mongodb.com/community/forums/t/ttl-index-internals/4086/2
+		Keys:    bson.M{"expireAt": 1},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		return fmt.Errorf("create index for collection %s: %w", collectionName, err)
+	}
+
+	return nil
+}
+
+// Create stores the token.
+func (s *Store) Create(ctx context.Context, token *oidc4vp.StatusStreamToken) error {
+	collection := s.mongoClient.Database().Collection(collectionName)
+
+	_, err := collection.InsertOne(ctx, &mongoDocument{
+		ID:       token.ID,
+		TxID:     string(token.TxID),
+		ExpireAt: token.ExpireAt,
+	})
+	if err != nil {
+		return fmt.Errorf("insert token: %w", err)
+	}
+
+	return nil
+}
+
+// Get returns the token. Expired tokens are not returned.
+func (s *Store) Get(ctx context.Context, id string) (*oidc4vp.StatusStreamToken, error) {
+	collection := s.mongoClient.Database().Collection(collectionName)
+
+	doc := &mongoDocument{}
+
+	err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, oidc4vp.ErrDataNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("find token: %w", err)
+	}
+
+	// Expired documents are removed by the ttl index in the background, so they may still be found.
+	if doc.ExpireAt.Before(time.Now()) {
+		return nil, oidc4vp.ErrDataNotFound
+	}
+
+	return &oidc4vp.StatusStreamToken{
+		ID:       doc.ID,
+		TxID:     oidc4vp.TxID(doc.TxID),
+		ExpireAt: doc.ExpireAt,
+	}, nil
+}
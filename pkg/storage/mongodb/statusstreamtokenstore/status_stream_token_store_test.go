@@ -0,0 +1,145 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statusstreamtokenstore
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	dctest "github.com/ory/dockertest/v3"
+	dc "github.com/ory/dockertest/v3/docker"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/trustbloc/vcs/pkg/service/oidc4vp"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb"
+)
+
+const (
+	mongoDBConnString  = "mongodb://localhost:27047"
+	dockerMongoDBImage = "mongo"
+	dockerMongoDBTag   = "4.0.0"
+)
+
+func TestStore(t *testing.T) {
+	pool, mongoDBResource := startMongoDBContainer(t)
+
+	defer func() {
+		require.NoError(t, pool.Purge(mongoDBResource), "failed to purge MongoDB resource")
+	}()
+
+	client, err := mongodb.New(mongoDBConnString, "testdb", mongodb.WithTimeout(time.Second*10))
+	require.NoError(t, err)
+
+	store, err := NewStore(context.Background(), client)
+	require.NoError(t, err)
+
+	t.Run("Create and get", func(t *testing.T) {
+		token := &oidc4vp.StatusStreamToken{
+			ID:       "token-1",
+			TxID:     "txID",
+			ExpireAt: time.Now().UTC().Add(time.Minute).Truncate(time.Millisecond),
+		}
+
+		require.NoError(t, store.Create(context.Background(), token))
+
+		// Token can be used more than once, e.g. when the client reconnects to the stream.
+		for i := 0; i < 2; i++ {
+			stored, err := store.Get(context.Background(), "token-1")
+			require.NoError(t, err)
+			require.Equal(t, token, stored)
+		}
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		token := &oidc4vp.StatusStreamToken{ID: "token-2", ExpireAt: time.Now().Add(time.Minute)}
+
+		require.NoError(t, store.Create(context.Background(), token))
+		require.ErrorContains(t, store.Create(context.Background(), token), "insert token")
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		require.NoError(t, store.Create(context.Background(), &oidc4vp.StatusStreamToken{
+			ID:       "token-3",
+			ExpireAt: time.Now().Add(-time.Minute),
+		}))
+
+		_, err = store.Get(context.Background(), "token-3")
+		require.ErrorIs(t, err, oidc4vp.ErrDataNotFound)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		_, err = store.Get(context.Background(), "unknown")
+		require.ErrorIs(t, err, oidc4vp.ErrDataNotFound)
+	})
+
+	t.Run("Context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		require.ErrorContains(t, store.Create(ctx, &oidc4vp.StatusStreamToken{ID: "id"}), "context canceled")
+
+		_, err = store.Get(ctx, "id")
+		require.ErrorContains(t, err, "context canceled")
+	})
+}
+
+func startMongoDBContainer(t *testing.T) (*dctest.Pool, *dctest.Resource) {
+	t.Helper()
+
+	pool, err := dctest.NewPool("")
+	require.NoError(t, err)
+
+	mongoDBResource, err := pool.RunWithOptions(&dctest.RunOptions{
+		Repository: dockerMongoDBImage,
+		Tag:        dockerMongoDBTag,
+		PortBindings: map[dc.Port][]dc.PortBinding{
+			"27017/tcp": {{HostIP: "", HostPort: "27047"}},
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, waitForMongoDBToBeUp())
+
+	return pool, mongoDBResource
+}
+
+func waitForMongoDBToBeUp() error {
+	return backoff.Retry(pingMongoDB, backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Second), 30))
+}
+
+func pingMongoDB() error {
+	var err error
+
+	tM := reflect.TypeOf(bson.M{})
+	reg := bson.NewRegistryBuilder().RegisterTypeMapEntry(bsontype.EmbeddedDocument, tM).Build()
+	clientOpts := options.Client().SetRegistry(reg).ApplyURI(mongoDBConnString)
+
+	mongoClient, err := mongo.NewClient(clientOpts)
+	if err != nil {
+		return err
+	}
+
+	err = mongoClient.Connect(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+
+	db := mongoClient.Database("test")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	return db.Client().Ping(ctx, nil)
+}
@@ -39,6 +39,7 @@ type credentialMetadata struct {
 	TransactionID  string     `json:"transactionId"`
 	IssuanceDate   *time.Time `json:"issuanceDate,omitempty"`
 	ExpirationDate *time.Time `json:"expirationDate,omitempty"`
+	PreviousVcID   string     `json:"previousVcID,omitempty"`
 }
 
 // Store manages verifiable.TypedID in MongoDB.
@@ -110,6 +111,7 @@ func createMongoDocument(
 			TransactionID:  metadata.TransactionID,
 			IssuanceDate:   getTime(metadata.IssuanceDate),
 			ExpirationDate: getTime(metadata.ExpirationDate),
+			PreviousVcID:   metadata.PreviousCredentialID,
 		},
 	}
 }
@@ -125,13 +127,14 @@ func parseMongoDocuments(
 		}
 
 		credentialMetadataList[i] = &credentialstatus.CredentialMetadata{
-			CredentialID:   index.CredentialMetadata.VcID,
-			ProfileVersion: index.ProfileVersion,
-			Issuer:         index.CredentialMetadata.Issuer,
-			CredentialType: index.CredentialMetadata.CredentialType,
-			TransactionID:  index.CredentialMetadata.TransactionID,
-			IssuanceDate:   parseTime(index.CredentialMetadata.IssuanceDate),
-			ExpirationDate: parseTime(index.CredentialMetadata.ExpirationDate),
+			CredentialID:         index.CredentialMetadata.VcID,
+			ProfileVersion:       index.ProfileVersion,
+			Issuer:               index.CredentialMetadata.Issuer,
+			CredentialType:       index.CredentialMetadata.CredentialType,
+			TransactionID:        index.CredentialMetadata.TransactionID,
+			IssuanceDate:         parseTime(index.CredentialMetadata.IssuanceDate),
+			ExpirationDate:       parseTime(index.CredentialMetadata.ExpirationDate),
+			PreviousCredentialID: index.CredentialMetadata.PreviousVcID,
 		}
 	}
 
@@ -82,6 +82,7 @@ func TestVCIssuanceHistoryStore(t *testing.T) {
 		credentialMetaNew := &credentialstatus.CredentialMetadata{}
 		*credentialMetaNew = *credentialMeta
 		credentialMetaNew.CredentialID = "credentialIDNew"
+		credentialMetaNew.PreviousCredentialID = "credentialID"
 		credentialMetaNew.IssuanceDate = timeutil.NewTime(time.Now().Add(time.Hour).Round(time.Second).UTC())
 		err = store.Put(ctx, testProfile, testProfileVersion10, credentialMetaNew)
 		assert.NoError(t, err)
@@ -0,0 +1,87 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verificationreceiptstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/trustbloc/vcs/pkg/service/verificationreceipt"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb"
+)
+
+const (
+	collectionName = "verification_receipt"
+)
+
+type mongoDocument struct {
+	ID             string    `bson:"_id"`
+	ProfileID      string    `bson:"profileID"`
+	ProfileVersion string    `bson:"profileVersion"`
+	Receipt        string    `bson:"receipt"`
+	CreatedAt      time.Time `bson:"createdAt"`
+}
+
+// Store stores signed verification receipts in mongodb.
+type Store struct {
+	mongoClient *mongodb.Client
+}
+
+// NewStore creates Store.
+func NewStore(mongoClient *mongodb.Client) *Store {
+	return &Store{mongoClient: mongoClient}
+}
+
+// Create stores the receipt record. Receipt with the same ID is replaced.
+func (s *Store) Create(ctx context.Context, record *verificationreceipt.Record) error {
+	collection := s.mongoClient.Database().Collection(collectionName)
+
+	doc := &mongoDocument{
+		ID:             record.ID,
+		ProfileID:      record.ProfileID,
+		ProfileVersion: record.ProfileVersion,
+		Receipt:        record.Receipt,
+		CreatedAt:      record.CreatedAt,
+	}
+
+	_, err := collection.ReplaceOne(ctx, bson.M{"_id": record.ID}, doc, options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("replace receipt: %w", err)
+	}
+
+	return nil
+}
+
+// Get returns the receipt record by ID.
+func (s *Store) Get(ctx context.Context, id string) (*verificationreceipt.Record, error) {
+	collection := s.mongoClient.Database().Collection(collectionName)
+
+	doc := &mongoDocument{}
+
+	err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, verificationreceipt.ErrDataNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("find receipt: %w", err)
+	}
+
+	return &verificationreceipt.Record{
+		ID:             doc.ID,
+		ProfileID:      doc.ProfileID,
+		ProfileVersion: doc.ProfileVersion,
+		Receipt:        doc.Receipt,
+		CreatedAt:      doc.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verificationreceiptstore
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	dctest "github.com/ory/dockertest/v3"
+	dc "github.com/ory/dockertest/v3/docker"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/trustbloc/vcs/pkg/service/verificationreceipt"
+	"github.com/trustbloc/vcs/pkg/storage/mongodb"
+)
+
+const (
+	mongoDBConnString  = "mongodb://localhost:27043"
+	dockerMongoDBImage = "mongo"
+	dockerMongoDBTag   = "4.0.0"
+)
+
+func TestStore(t *testing.T) {
+	pool, mongoDBResource := startMongoDBContainer(t)
+
+	defer func() {
+		require.NoError(t, pool.Purge(mongoDBResource), "failed to purge MongoDB resource")
+	}()
+
+	client, err := mongodb.New(mongoDBConnString, "testdb", mongodb.WithTimeout(time.Second*10))
+	require.NoError(t, err)
+
+	store := NewStore(client)
+
+	defer func() {
+		require.NoError(t, client.Close(), "failed to close mongodb client")
+	}()
+
+	t.Run("Create and get", func(t *testing.T) {
+		record := &verificationreceipt.Record{
+			ID:             "txID",
+			ProfileID:      "profileID",
+			ProfileVersion: "v1.0",
+			Receipt:        "receipt-jwt",
+			CreatedAt:      time.Now().UTC().Truncate(time.Millisecond),
+		}
+
+		require.NoError(t, store.Create(context.Background(), record))
+
+		stored, err := store.Get(context.Background(), "txID")
+		require.NoError(t, err)
+		require.Equal(t, record.ID, stored.ID)
+		require.Equal(t, record.ProfileID, stored.ProfileID)
+		require.Equal(t, record.ProfileVersion, stored.ProfileVersion)
+		require.Equal(t, record.Receipt, stored.Receipt)
+		require.True(t, record.CreatedAt.Equal(stored.CreatedAt))
+	})
+
+	t.Run("Create replaces receipt with the same id", func(t *testing.T) {
+		require.NoError(t, store.Create(context.Background(), &verificationreceipt.Record{
+			ID:      "receiptID",
+			Receipt: "receipt-1",
+		}))
+		require.NoError(t, store.Create(context.Background(), &verificationreceipt.Record{
+			ID:      "receiptID",
+			Receipt: "receipt-2",
+		}))
+
+		stored, err := store.Get(context.Background(), "receiptID")
+		require.NoError(t, err)
+		require.Equal(t, "receipt-2", stored.Receipt)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		_, err = store.Get(context.Background(), "unknown")
+		require.ErrorIs(t, err, verificationreceipt.ErrDataNotFound)
+	})
+
+	t.Run("Context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		require.ErrorContains(t, store.Create(ctx, &verificationreceipt.Record{ID: "id"}), "context canceled")
+
+		_, err = store.Get(ctx, "id")
+		require.ErrorContains(t, err, "context canceled")
+	})
+}
+
+func startMongoDBContainer(t *testing.T) (*dctest.Pool, *dctest.Resource) {
+	t.Helper()
+
+	pool, err := dctest.NewPool("")
+	require.NoError(t, err)
+
+	mongoDBResource, err := pool.RunWithOptions(&dctest.RunOptions{
+		Repository: dockerMongoDBImage,
+		Tag:        dockerMongoDBTag,
+		PortBindings: map[dc.Port][]dc.PortBinding{
+			"27017/tcp": {{HostIP: "", HostPort: "27043"}},
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, waitForMongoDBToBeUp())
+
+	return pool, mongoDBResource
+}
+
+func waitForMongoDBToBeUp() error {
+	return backoff.Retry(pingMongoDB, backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Second), 30))
+}
+
+func pingMongoDB() error {
+	var err error
+
+	tM := reflect.TypeOf(bson.M{})
+	reg := bson.NewRegistryBuilder().RegisterTypeMapEntry(bsontype.EmbeddedDocument, tM).Build()
+	clientOpts := options.Client().SetRegistry(reg).ApplyURI(mongoDBConnString)
+
+	mongoClient, err := mongo.NewClient(clientOpts)
+	if err != nil {
+		return err
+	}
+
+	err = mongoClient.Connect(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+
+	db := mongoClient.Database("test")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	return db.Client().Ping(ctx, nil)
+}
@@ -84,6 +84,15 @@ func (s *Store) GetAndDelete(ctx context.Context, claimDataID string) (*oidc4ci.
 	return &claimData, nil
 }
 
+// Delete deletes claim data. Deleting of a non-existing claim data is not an error.
+func (s *Store) Delete(ctx context.Context, claimDataID string) error {
+	if err := s.redisClient.API().Del(ctx, claimDataID).Err(); err != nil {
+		return fmt.Errorf("del failed: %w", err)
+	}
+
+	return nil
+}
+
 func resolveRedisKey(id string) string {
 	return fmt.Sprintf("%s-%s", keyPrefix, id)
 }
@@ -65,6 +65,20 @@ func TestStore(t *testing.T) {
 		assert.ErrorIs(t, err, resterr.ErrDataNotFound)
 	})
 
+	t.Run("test delete", func(t *testing.T) {
+		id, err := store.Create(context.Background(), 0, &oidc4ci.ClaimData{
+			EncryptedData: &dataprotect.EncryptedData{Encrypted: []byte{0x1}},
+		})
+		assert.NoError(t, err)
+
+		assert.NoError(t, store.Delete(context.Background(), id))
+		assert.NoError(t, store.Delete(context.Background(), id))
+
+		claimsInDB, err := store.GetAndDelete(context.Background(), id)
+		assert.Nil(t, claimsInDB)
+		assert.ErrorIs(t, err, resterr.ErrDataNotFound)
+	})
+
 	t.Run("get non existing document", func(t *testing.T) {
 		id := uuid.NewString()
 
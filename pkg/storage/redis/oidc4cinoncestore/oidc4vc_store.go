@@ -11,11 +11,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	redisapi "github.com/redis/go-redis/v9"
 
+	profileapi "github.com/trustbloc/vcs/pkg/profile"
 	"github.com/trustbloc/vcs/pkg/restapi/resterr"
 	"github.com/trustbloc/vcs/pkg/service/oidc4ci"
 	"github.com/trustbloc/vcs/pkg/storage/redis"
@@ -24,8 +26,21 @@ import (
 const (
 	keyPrefix             = "oidc4vcnoncestore"
 	intermediateKeyPrefix = keyPrefix + "-" + "intermediate"
+	profileKeyPrefix      = keyPrefix + "-" + "profile"
 )
 
+// indexTransactionScript indexes the transaction by profile scored by its expiration time. Expired transactions
+// are pruned from the index, and the index expires together with its last transaction.
+var indexTransactionScript = redisapi.NewScript(`
+redis.call("ZADD", KEYS[1], ARGV[1], ARGV[2])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", ARGV[3])
+local last = redis.call("ZRANGE", KEYS[1], -1, -1, "WITHSCORES")
+if last[2] then
+	redis.call("EXPIREAT", KEYS[1], math.ceil(tonumber(last[2])) + 1)
+end
+return 1
+`)
+
 // Store stores oidc transactions in redis.
 type Store struct {
 	defaultTTL  time.Duration
@@ -69,6 +84,8 @@ func (s *Store) Create(
 		TransactionData: transactionData,
 	}
 
+	profileKey := resolveProfileKey(transactionData.ProfileID, transactionData.ProfileVersion)
+
 	transactionIDBasedKey := resolveRedisKey(keyPrefix, transactionID)
 	intermediateKey := resolveRedisKey(intermediateKeyPrefix, uuid.NewString())
 
@@ -79,6 +96,8 @@ func (s *Store) Create(
 	pipeline.Set(ctx, opStatueBasedKey, intermediateKey, ttl)
 	// Set intermediateKey that points to redisDocument
 	pipeline.Set(ctx, intermediateKey, doc, ttl)
+	// Index transactionID by profile, scored by expiration time
+	indexTransaction(ctx, pipeline, profileKey, transactionID, doc.ExpireAt)
 
 	if _, err = pipeline.Exec(ctx); err != nil {
 		return nil, fmt.Errorf("transactionData create: %w", err)
@@ -164,6 +183,8 @@ func (s *Store) Update(ctx context.Context, tx *oidc4ci.Transaction) error {
 	pipeline.Set(ctx, opStatueBasedKey, intermediateKey, s.defaultTTL)
 	// Set intermediateKey that points to redisDocument
 	pipeline.Set(ctx, intermediateKey, doc, s.defaultTTL)
+	// Update expiration time of transactionID in profile index
+	indexTransaction(ctx, pipeline, resolveProfileKey(tx.ProfileID, tx.ProfileVersion), string(tx.ID), doc.ExpireAt)
 
 	if _, err = pipeline.Exec(ctx); err != nil {
 		return fmt.Errorf("transactionData Update: %w", err)
@@ -172,6 +193,62 @@ func (s *Store) Update(ctx context.Context, tx *oidc4ci.Transaction) error {
 	return nil
 }
 
+// FindOpenByProfile returns not expired transactions of the profile that are neither completed nor cancelled.
+func (s *Store) FindOpenByProfile(
+	ctx context.Context,
+	profileID profileapi.ID,
+	profileVersion profileapi.Version,
+) ([]*oidc4ci.Transaction, error) {
+	clientAPI := s.redisClient.API()
+	profileKey := resolveProfileKey(profileID, profileVersion)
+	now := strconv.FormatInt(time.Now().UTC().Unix(), 10)
+
+	// Remove expired transactions from profile index.
+	if err := clientAPI.ZRemRangeByScore(ctx, profileKey, "-inf", now).Err(); err != nil {
+		return nil, fmt.Errorf("remove expired: %w", err)
+	}
+
+	ids, err := clientAPI.ZRangeByScore(ctx, profileKey, &redisapi.ZRangeBy{Min: "(" + now, Max: "+inf"}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("find by profile: %w", err)
+	}
+
+	var txs []*oidc4ci.Transaction
+
+	for _, id := range ids {
+		tx, getErr := s.Get(ctx, oidc4ci.TxID(id))
+		if getErr != nil {
+			if errors.Is(getErr, resterr.ErrDataNotFound) {
+				continue
+			}
+
+			return nil, getErr
+		}
+
+		if tx.State == oidc4ci.TransactionStateCredentialsIssued || tx.State == oidc4ci.TransactionStateCancelled {
+			continue
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+func indexTransaction(
+	ctx context.Context,
+	pipeline redisapi.Pipeliner,
+	profileKey, transactionID string,
+	expireAt time.Time,
+) {
+	indexTransactionScript.Eval(ctx, pipeline, []string{profileKey},
+		expireAt.Unix(), transactionID, time.Now().UTC().Unix())
+}
+
 func resolveRedisKey(prefix, id string) string {
 	return fmt.Sprintf("%s-%s", prefix, id)
 }
+
+func resolveProfileKey(profileID profileapi.ID, profileVersion profileapi.Version) string {
+	return fmt.Sprintf("%s-%s-%s", profileKeyPrefix, profileID, profileVersion)
+}
@@ -221,6 +221,79 @@ func TestStore(t *testing.T) {
 		assert.Equal(t, ad, found.CredentialConfiguration[0].AuthorizationDetails)
 	})
 
+	t.Run("find open by profile", func(t *testing.T) {
+		profileID := uuid.NewString()
+
+		open, createErr := store.Create(context.TODO(), 0, &oidc4ci.TransactionData{
+			ProfileID:      profileID,
+			ProfileVersion: "v1.0",
+			OpState:        uuid.NewString(),
+			State:          oidc4ci.TransactionStateIssuanceInitiated,
+		})
+		assert.NoError(t, createErr)
+
+		cancelled, createErr := store.Create(context.TODO(), 0, &oidc4ci.TransactionData{
+			ProfileID:      profileID,
+			ProfileVersion: "v1.0",
+			OpState:        uuid.NewString(),
+			State:          oidc4ci.TransactionStateIssuanceInitiated,
+		})
+		assert.NoError(t, createErr)
+
+		cancelled.State = oidc4ci.TransactionStateCancelled
+		assert.NoError(t, store.Update(context.TODO(), cancelled))
+
+		_, createErr = store.Create(context.TODO(), 0, &oidc4ci.TransactionData{
+			ProfileID:      profileID,
+			ProfileVersion: "v2.0",
+			OpState:        uuid.NewString(),
+			State:          oidc4ci.TransactionStateIssuanceInitiated,
+		})
+		assert.NoError(t, createErr)
+
+		txs, findErr := store.FindOpenByProfile(context.TODO(), profileID, "v1.0")
+		assert.NoError(t, findErr)
+		assert.Len(t, txs, 1)
+		assert.Equal(t, open.ID, txs[0].ID)
+	})
+
+	t.Run("profile index expires with transactions", func(t *testing.T) {
+		profileID := uuid.NewString()
+		profileKey := resolveProfileKey(profileID, "v1.0")
+
+		expired, createErr := New(client, 1).Create(context.TODO(), 0, &oidc4ci.TransactionData{
+			ProfileID:      profileID,
+			ProfileVersion: "v1.0",
+			OpState:        uuid.NewString(),
+		})
+		assert.NoError(t, createErr)
+
+		ttl, ttlErr := client.API().TTL(context.TODO(), profileKey).Result()
+		assert.NoError(t, ttlErr)
+		assert.Greater(t, ttl, time.Duration(0))
+		assert.LessOrEqual(t, ttl, 2*time.Second)
+
+		time.Sleep(2 * time.Second)
+
+		_, createErr = store.Create(context.TODO(), 1000, &oidc4ci.TransactionData{
+			ProfileID:      profileID,
+			ProfileVersion: "v1.0",
+			OpState:        uuid.NewString(),
+		})
+		assert.NoError(t, createErr)
+
+		// Expired transaction is pruned from the index.
+		ids, rangeErr := client.API().ZRange(context.TODO(), profileKey, 0, -1).Result()
+		assert.NoError(t, rangeErr)
+		assert.NotContains(t, ids, string(expired.ID))
+		assert.Len(t, ids, 1)
+
+		// Index expires together with the last transaction.
+		ttl, ttlErr = client.API().TTL(context.TODO(), profileKey).Result()
+		assert.NoError(t, ttlErr)
+		assert.Greater(t, ttl, 999*time.Second)
+	})
+
 	t.Run("find non existing document", func(t *testing.T) {
 		id := uuid.New().String()
 
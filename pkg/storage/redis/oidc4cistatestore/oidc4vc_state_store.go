@@ -97,6 +97,15 @@ func (s *Store) GetAuthorizeState(ctx context.Context, opState string) (*oidc4ci
 	return doc.State, nil
 }
 
+// DeleteAuthorizeState deletes authorize state. Deleting of a non-existing state is not an error.
+func (s *Store) DeleteAuthorizeState(ctx context.Context, opState string) error {
+	if err := s.redisClient.API().Del(ctx, resolveRedisKey(opState)).Err(); err != nil {
+		return resterr.NewSystemError(resterr.RedisComponent, "Del", fmt.Errorf("deleteAuthorizeState failed: %w", err))
+	}
+
+	return nil
+}
+
 func resolveRedisKey(id string) string {
 	return fmt.Sprintf("%s-%s", keyPrefix, id)
 }
@@ -101,6 +101,21 @@ func TestStore(t *testing.T) {
 		assert.Equal(t, toInsert, resp2)
 	})
 
+	t.Run("test delete", func(t *testing.T) {
+		id := uuid.New().String()
+
+		assert.NoError(t, store.SaveAuthorizeState(context.Background(), 0, id, &oidc4ci.AuthorizeState{
+			RespondMode: "random",
+		}))
+
+		assert.NoError(t, store.DeleteAuthorizeState(context.Background(), id))
+		assert.NoError(t, store.DeleteAuthorizeState(context.Background(), id))
+
+		resp, err2 := store.GetAuthorizeState(context.Background(), id)
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err2, resterr.ErrDataNotFound)
+	})
+
 	t.Run("find non existing document", func(t *testing.T) {
 		id := uuid.New().String()
 
@@ -20,6 +20,8 @@ type txDocument struct {
 	PresentationDefinition *presexch.PresentationDefinition `json:"presentationDefinition"`
 	ExpireAt               time.Time                        `json:"expireAt"`
 	CustomScopes           []string                         `json:"customScopes,omitempty"`
+	ResponseRedirectURI    string                           `json:"responseRedirectUri,omitempty"`
+	ResponseCode           string                           `json:"responseCode,omitempty"`
 }
 
 func (d *txDocument) MarshalBinary() ([]byte, error) {
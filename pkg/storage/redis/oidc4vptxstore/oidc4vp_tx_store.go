@@ -23,9 +23,24 @@ import (
 )
 
 const (
-	keyPrefix = "oidc4vp_tx"
+	keyPrefix       = "oidc4vp_tx"
+	statusKeyPrefix = "oidc4vp_tx_status"
 )
 
+// updateStatusScript sets the status of the interaction unless the current status is final.
+// Returns 0 if the status is not set.
+var updateStatusScript = redisapi.NewScript(`
+local current = redis.call("GET", KEYS[1])
+if current then
+	local state = cjson.decode(current)["state"]
+	if state == ARGV[3] or state == ARGV[4] then
+		return 0
+	end
+end
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+return 1
+`)
+
 // TxStore manages profile in redis.
 type TxStore struct {
 	defaultTTL     time.Duration
@@ -51,6 +66,7 @@ func (p *TxStore) Create(
 	profileID, profileVersion string,
 	profileTransactionDataTTL int32,
 	customScopes []string,
+	responseRedirectURI string,
 ) (oidc4vp.TxID, *oidc4vp.Transaction, error) {
 	ttl := p.defaultTTL
 	if profileTransactionDataTTL > 0 {
@@ -66,6 +82,7 @@ func (p *TxStore) Create(
 		ProfileVersion:         profileVersion,
 		PresentationDefinition: pd,
 		CustomScopes:           customScopes,
+		ResponseRedirectURI:    responseRedirectURI,
 	}
 
 	txID := uuid.NewString()
@@ -90,7 +107,69 @@ func (p *TxStore) Get(strID oidc4vp.TxID) (*oidc4vp.Transaction, error) {
 		return nil, err
 	}
 
-	return txFromDocument(strID, doc), nil
+	tx := txFromDocument(strID, doc)
+
+	tx.Status, err = p.getStatus(ctxWithTimeout, strID)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// UpdateStatus sets status of the interaction. Status is kept under a separate key,
+// so it does not interfere with the tx document updates. Status of the finished interaction
+// is not changed, oidc4vp.ErrInteractionFinished is returned instead.
+func (p *TxStore) UpdateStatus(txID oidc4vp.TxID, status *oidc4vp.InteractionStatus) error {
+	ctxWithTimeout, cancel := p.redisClient.ContextWithTimeout()
+	defer cancel()
+
+	txDoc, err := p.getTxDocument(ctxWithTimeout, txID)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("marshal tx status: %w", err)
+	}
+
+	// The tx document can expire right after it was read.
+	ttl := time.Until(txDoc.ExpireAt).Milliseconds()
+	if ttl < 1 {
+		ttl = 1
+	}
+
+	updated, err := updateStatusScript.Run(ctxWithTimeout, p.redisClient.API(),
+		[]string{resolveStatusRedisKey(string(txID))}, b, ttl,
+		string(oidc4vp.InteractionStateSucceeded), string(oidc4vp.InteractionStateFailed)).Int()
+	if err != nil {
+		return fmt.Errorf("tx update status: %w", err)
+	}
+
+	if updated == 0 {
+		return oidc4vp.ErrInteractionFinished
+	}
+
+	return nil
+}
+
+func (p *TxStore) getStatus(ctx context.Context, strID oidc4vp.TxID) (*oidc4vp.InteractionStatus, error) {
+	b, err := p.redisClient.API().Get(ctx, resolveStatusRedisKey(string(strID))).Bytes()
+	if err != nil {
+		if errors.Is(err, redisapi.Nil) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, fmt.Errorf("find tx status: %w", err)
+	}
+
+	status := &oidc4vp.InteractionStatus{}
+	if err = json.Unmarshal(b, status); err != nil {
+		return nil, fmt.Errorf("decode tx status: %w", err)
+	}
+
+	return status, nil
 }
 
 // Get returns txDocument by given id.
@@ -133,6 +212,7 @@ func (p *TxStore) Update(update oidc4vp.TransactionUpdate, profileTransactionDat
 	}
 
 	txDoc.ReceivedClaimsID = update.ReceivedClaimsID
+	txDoc.ResponseCode = update.ResponseCode
 
 	key := resolveRedisKey(string(update.ID))
 
@@ -151,9 +231,15 @@ func txFromDocument(id oidc4vp.TxID, txDoc *txDocument) *oidc4vp.Transaction {
 		PresentationDefinition: txDoc.PresentationDefinition,
 		ReceivedClaimsID:       txDoc.ReceivedClaimsID,
 		CustomScopes:           txDoc.CustomScopes,
+		ResponseRedirectURI:    txDoc.ResponseRedirectURI,
+		ResponseCode:           txDoc.ResponseCode,
 	}
 }
 
 func resolveRedisKey(id string) string {
 	return fmt.Sprintf("%s-%s", keyPrefix, id)
 }
+
+func resolveStatusRedisKey(id string) string {
+	return fmt.Sprintf("%s-%s", statusKeyPrefix, id)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vdrcachestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	keyPrefix = "vdr_cache"
+)
+
+// Store stores resolved DID documents shared across VCS instances.
+type Store struct {
+	redisClient redisClient
+}
+
+// New creates DID resolution cache store.
+func New(redisClient redisClient) *Store {
+	return &Store{
+		redisClient: redisClient,
+	}
+}
+
+// Get returns cached value by DID. Nil value is returned in case DID is not cached.
+func (s *Store) Get(ctx context.Context, didID string) ([]byte, error) {
+	b, err := s.redisClient.API().Get(ctx, resolveRedisKey(didID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("redis get DID resolution: %w", err)
+	}
+
+	return b, nil
+}
+
+// Set caches value by DID.
+func (s *Store) Set(ctx context.Context, didID string, value []byte, ttl time.Duration) error {
+	if err := s.redisClient.API().Set(ctx, resolveRedisKey(didID), value, ttl).Err(); err != nil {
+		return fmt.Errorf("redis set DID resolution: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes cached value by DID.
+func (s *Store) Delete(ctx context.Context, didID string) error {
+	if err := s.redisClient.API().Del(ctx, resolveRedisKey(didID)).Err(); err != nil {
+		return fmt.Errorf("redis delete DID resolution: %w", err)
+	}
+
+	return nil
+}
+
+func resolveRedisKey(didID string) string {
+	return fmt.Sprintf("%s-%s", keyPrefix, didID)
+}
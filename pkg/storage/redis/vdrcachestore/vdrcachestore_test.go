@@ -0,0 +1,126 @@
+package vdrcachestore_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	redisapi "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trustbloc/vcs/pkg/storage/redis/vdrcachestore"
+)
+
+const (
+	didID    = "did:web:example.com"
+	redisKey = "vdr_cache-did:web:example.com"
+)
+
+func TestGet(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		cl := NewMockredisClient(gomock.NewController(t))
+		api := NewMockredisApi(gomock.NewController(t))
+
+		cl.EXPECT().API().Return(api).AnyTimes()
+
+		resp := redisapi.NewStringCmd(context.TODO())
+		resp.SetVal("value")
+
+		api.EXPECT().Get(gomock.Any(), redisKey).Return(resp)
+
+		b, err := vdrcachestore.New(cl).Get(context.TODO(), didID)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("value"), b)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		cl := NewMockredisClient(gomock.NewController(t))
+		api := NewMockredisApi(gomock.NewController(t))
+
+		cl.EXPECT().API().Return(api).AnyTimes()
+
+		resp := redisapi.NewStringCmd(context.TODO())
+		resp.SetErr(redisapi.Nil)
+
+		api.EXPECT().Get(gomock.Any(), redisKey).Return(resp)
+
+		b, err := vdrcachestore.New(cl).Get(context.TODO(), didID)
+		assert.NoError(t, err)
+		assert.Nil(t, b)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		cl := NewMockredisClient(gomock.NewController(t))
+		api := NewMockredisApi(gomock.NewController(t))
+
+		cl.EXPECT().API().Return(api).AnyTimes()
+
+		resp := redisapi.NewStringCmd(context.TODO())
+		resp.SetErr(errors.New("get error"))
+
+		api.EXPECT().Get(gomock.Any(), redisKey).Return(resp)
+
+		b, err := vdrcachestore.New(cl).Get(context.TODO(), didID)
+		assert.ErrorContains(t, err, "redis get DID resolution: get error")
+		assert.Nil(t, b)
+	})
+}
+
+func TestSet(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		cl := NewMockredisClient(gomock.NewController(t))
+		api := NewMockredisApi(gomock.NewController(t))
+
+		cl.EXPECT().API().Return(api).AnyTimes()
+
+		api.EXPECT().Set(gomock.Any(), redisKey, []byte("value"), time.Minute).
+			Return(&redisapi.StatusCmd{})
+
+		assert.NoError(t, vdrcachestore.New(cl).Set(context.TODO(), didID, []byte("value"), time.Minute))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		cl := NewMockredisClient(gomock.NewController(t))
+		api := NewMockredisApi(gomock.NewController(t))
+
+		cl.EXPECT().API().Return(api).AnyTimes()
+
+		resp := &redisapi.StatusCmd{}
+		resp.SetErr(errors.New("set error"))
+
+		api.EXPECT().Set(gomock.Any(), redisKey, []byte("value"), time.Minute).Return(resp)
+
+		err := vdrcachestore.New(cl).Set(context.TODO(), didID, []byte("value"), time.Minute)
+		assert.ErrorContains(t, err, "redis set DID resolution: set error")
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		cl := NewMockredisClient(gomock.NewController(t))
+		api := NewMockredisApi(gomock.NewController(t))
+
+		cl.EXPECT().API().Return(api).AnyTimes()
+
+		api.EXPECT().Del(gomock.Any(), redisKey).Return(&redisapi.IntCmd{})
+
+		assert.NoError(t, vdrcachestore.New(cl).Delete(context.TODO(), didID))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		cl := NewMockredisClient(gomock.NewController(t))
+		api := NewMockredisApi(gomock.NewController(t))
+
+		cl.EXPECT().API().Return(api).AnyTimes()
+
+		resp := &redisapi.IntCmd{}
+		resp.SetErr(errors.New("del error"))
+
+		api.EXPECT().Del(gomock.Any(), redisKey).Return(resp)
+
+		err := vdrcachestore.New(cl).Delete(context.TODO(), didID)
+		assert.ErrorContains(t, err, "redis delete DID resolution: del error")
+	})
+}
@@ -0,0 +1,146 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+//go:generate mockgen -destination payload_store_mocks_test.go -package presentationarchivestore_test -source=payload_store.go -mock_names s3Client=MockS3Client
+
+package presentationarchivestore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/trustbloc/vcs/pkg/service/presentationarchive"
+)
+
+const (
+	contentType = "application/json"
+)
+
+type s3Client interface {
+	PutObject(
+		ctx context.Context,
+		input *s3.PutObjectInput,
+		opts ...func(*s3.Options),
+	) (*s3.PutObjectOutput, error)
+
+	GetObject(
+		ctx context.Context,
+		input *s3.GetObjectInput,
+		opts ...func(*s3.Options),
+	) (*s3.GetObjectOutput, error)
+
+	ListObjectVersions(
+		ctx context.Context,
+		input *s3.ListObjectVersionsInput,
+		opts ...func(*s3.Options),
+	) (*s3.ListObjectVersionsOutput, error)
+
+	DeleteObject(
+		ctx context.Context,
+		input *s3.DeleteObjectInput,
+		opts ...func(*s3.Options),
+	) (*s3.DeleteObjectOutput, error)
+}
+
+// PayloadStore stores encrypted archived presentations in S3.
+type PayloadStore struct {
+	s3Client s3Client
+	bucket   string
+}
+
+// NewPayloadStore creates PayloadStore.
+func NewPayloadStore(s3Client s3Client, bucket string) *PayloadStore {
+	return &PayloadStore{
+		s3Client: s3Client,
+		bucket:   bucket,
+	}
+}
+
+// Put stores the encrypted presentation of the transaction.
+func (p *PayloadStore) Put(ctx context.Context, txID string, data []byte) error {
+	_, err := p.s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Body:        bytes.NewReader(data),
+		Key:         aws.String(txID),
+		Bucket:      aws.String(p.bucket),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return fmt.Errorf("put archived presentation: %w", err)
+	}
+
+	return nil
+}
+
+// Get returns the encrypted presentation of the transaction.
+func (p *PayloadStore) Get(ctx context.Context, txID string) ([]byte, error) {
+	res, err := p.s3Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(p.bucket),
+		Key:    aws.String(txID),
+	})
+	if err != nil {
+		var awsError *types.NoSuchKey
+		if errors.As(err, &awsError) {
+			return nil, presentationarchive.ErrDataNotFound
+		}
+
+		return nil, fmt.Errorf("get archived presentation: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read archived presentation: %w", err)
+	}
+
+	return data, nil
+}
+
+// Delete deletes all versions of the encrypted presentation of the transaction. Deleting the object only
+// would keep previous versions in a versioned bucket.
+func (p *PayloadStore) Delete(ctx context.Context, txID string) error {
+	versions, err := p.s3Client.ListObjectVersions(ctx, &s3.ListObjectVersionsInput{
+		Bucket: aws.String(p.bucket),
+		Prefix: aws.String(txID),
+	})
+	if err != nil {
+		return fmt.Errorf("list archived presentation versions: %w", err)
+	}
+
+	var versionIDs []*string
+
+	for _, v := range versions.Versions {
+		if aws.ToString(v.Key) == txID {
+			versionIDs = append(versionIDs, v.VersionId)
+		}
+	}
+
+	for _, m := range versions.DeleteMarkers {
+		if aws.ToString(m.Key) == txID {
+			versionIDs = append(versionIDs, m.VersionId)
+		}
+	}
+
+	for _, versionID := range versionIDs {
+		_, err = p.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+			Bucket:    aws.String(p.bucket),
+			Key:       aws.String(txID),
+			VersionId: versionID,
+		})
+		if err != nil {
+			return fmt.Errorf("delete archived presentation: %w", err)
+		}
+	}
+
+	return nil
+}
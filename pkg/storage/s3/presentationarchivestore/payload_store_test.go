@@ -0,0 +1,201 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package presentationarchivestore_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trustbloc/vcs/pkg/service/presentationarchive"
+	"github.com/trustbloc/vcs/pkg/storage/s3/presentationarchivestore"
+)
+
+const bucket = "archive-bucket"
+
+func TestPut(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := NewMockS3Client(gomock.NewController(t))
+		client.EXPECT().PutObject(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				input *s3.PutObjectInput,
+				_ ...func(*s3.Options),
+			) (*s3.PutObjectOutput, error) {
+				assert.Equal(t, "application/json", *input.ContentType)
+				assert.Equal(t, "tx1", *input.Key)
+				assert.Equal(t, bucket, *input.Bucket)
+
+				data, err := io.ReadAll(input.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, []byte("data"), data)
+
+				return &s3.PutObjectOutput{}, nil
+			})
+
+		store := presentationarchivestore.NewPayloadStore(client, bucket)
+
+		assert.NoError(t, store.Put(context.Background(), "tx1", []byte("data")))
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		client := NewMockS3Client(gomock.NewController(t))
+		client.EXPECT().PutObject(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("s3 error"))
+
+		store := presentationarchivestore.NewPayloadStore(client, bucket)
+
+		assert.ErrorContains(t, store.Put(context.Background(), "tx1", []byte("data")),
+			"put archived presentation: s3 error")
+	})
+}
+
+func TestGet(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := NewMockS3Client(gomock.NewController(t))
+		client.EXPECT().GetObject(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				input *s3.GetObjectInput,
+				_ ...func(*s3.Options),
+			) (*s3.GetObjectOutput, error) {
+				assert.Equal(t, "tx1", *input.Key)
+				assert.Equal(t, bucket, *input.Bucket)
+
+				return &s3.GetObjectOutput{
+					Body: io.NopCloser(bytes.NewReader([]byte("data"))),
+				}, nil
+			})
+
+		store := presentationarchivestore.NewPayloadStore(client, bucket)
+
+		data, err := store.Get(context.Background(), "tx1")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("data"), data)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		client := NewMockS3Client(gomock.NewController(t))
+		client.EXPECT().GetObject(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, &types.NoSuchKey{})
+
+		store := presentationarchivestore.NewPayloadStore(client, bucket)
+
+		_, err := store.Get(context.Background(), "tx1")
+		assert.ErrorIs(t, err, presentationarchive.ErrDataNotFound)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		client := NewMockS3Client(gomock.NewController(t))
+		client.EXPECT().GetObject(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("s3 error"))
+
+		store := presentationarchivestore.NewPayloadStore(client, bucket)
+
+		_, err := store.Get(context.Background(), "tx1")
+		assert.ErrorContains(t, err, "get archived presentation: s3 error")
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		client := NewMockS3Client(gomock.NewController(t))
+		client.EXPECT().GetObject(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&s3.GetObjectOutput{
+				Body: io.NopCloser(&errReader{}),
+			}, nil)
+
+		store := presentationarchivestore.NewPayloadStore(client, bucket)
+
+		_, err := store.Get(context.Background(), "tx1")
+		assert.ErrorContains(t, err, "read archived presentation: read error")
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("success - all versions deleted", func(t *testing.T) {
+		client := NewMockS3Client(gomock.NewController(t))
+		client.EXPECT().ListObjectVersions(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				input *s3.ListObjectVersionsInput,
+				_ ...func(*s3.Options),
+			) (*s3.ListObjectVersionsOutput, error) {
+				assert.Equal(t, "tx1", *input.Prefix)
+				assert.Equal(t, bucket, *input.Bucket)
+
+				return &s3.ListObjectVersionsOutput{
+					Versions: []types.ObjectVersion{
+						{Key: aws.String("tx1"), VersionId: aws.String("v1")},
+						{Key: aws.String("tx10"), VersionId: aws.String("v2")},
+					},
+					DeleteMarkers: []types.DeleteMarkerEntry{
+						{Key: aws.String("tx1"), VersionId: aws.String("v3")},
+					},
+				}, nil
+			})
+
+		var deleted []string
+
+		client.EXPECT().DeleteObject(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				input *s3.DeleteObjectInput,
+				_ ...func(*s3.Options),
+			) (*s3.DeleteObjectOutput, error) {
+				assert.Equal(t, "tx1", *input.Key)
+				deleted = append(deleted, *input.VersionId)
+
+				return &s3.DeleteObjectOutput{}, nil
+			}).Times(2)
+
+		store := presentationarchivestore.NewPayloadStore(client, bucket)
+
+		assert.NoError(t, store.Delete(context.Background(), "tx1"))
+		assert.Equal(t, []string{"v1", "v3"}, deleted)
+	})
+
+	t.Run("list versions error", func(t *testing.T) {
+		client := NewMockS3Client(gomock.NewController(t))
+		client.EXPECT().ListObjectVersions(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("s3 error"))
+
+		store := presentationarchivestore.NewPayloadStore(client, bucket)
+
+		assert.ErrorContains(t, store.Delete(context.Background(), "tx1"),
+			"list archived presentation versions: s3 error")
+	})
+
+	t.Run("delete error", func(t *testing.T) {
+		client := NewMockS3Client(gomock.NewController(t))
+		client.EXPECT().ListObjectVersions(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&s3.ListObjectVersionsOutput{
+				Versions: []types.ObjectVersion{
+					{Key: aws.String("tx1"), VersionId: aws.String("v1")},
+				},
+			}, nil)
+		client.EXPECT().DeleteObject(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("s3 error"))
+
+		store := presentationarchivestore.NewPayloadStore(client, bucket)
+
+		assert.ErrorContains(t, store.Delete(context.Background(), "tx1"),
+			"delete archived presentation: s3 error")
+	})
+}
+
+type errReader struct{}
+
+func (r *errReader) Read([]byte) (int, error) {
+	return 0, errors.New("read error")
+}
@@ -0,0 +1,289 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+//go:generate mockgen -destination gomocks_test.go -self_package github.com/trustbloc/vcs/pkg/vdrcache -package vdrcache -source=registry.go -mock_names SharedCache=MockSharedCache,metricsProvider=MockMetricsProvider
+
+package vdrcache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/trustbloc/did-go/doc/did"
+	vdrapi "github.com/trustbloc/did-go/vdr/api"
+	"github.com/trustbloc/logutil-go/pkg/log"
+	"golang.org/x/sync/singleflight"
+)
+
+var logger = log.New("vdr-cache")
+
+const (
+	defaultSize = 1000
+	defaultTTL  = 5 * time.Minute
+
+	// notFoundValue is stored in the shared cache for DIDs that do not exist.
+	notFoundValue = "notFound"
+)
+
+// SharedCache is a cache shared across VCS instances (e.g. Redis). Get returns nil value in case key is not found.
+type SharedCache interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, key string) error
+}
+
+type metricsProvider interface {
+	VDRCacheHit(method string)
+	VDRCacheMiss(method string)
+}
+
+// Config configures caching Registry.
+type Config struct {
+	// VDR is a registry DIDs are resolved with.
+	VDR vdrapi.Registry
+	// Size is a max number of DID resolutions kept in memory. Default: 1000.
+	Size int
+	// TTL is a lifetime of cached DID resolution. Default: 5m.
+	TTL time.Duration
+	// MethodTTL overrides TTL per DID method. Zero TTL disables caching for the method.
+	MethodTTL map[string]time.Duration
+	// NotFoundTTL is a lifetime of cached not found result. Zero disables negative caching.
+	NotFoundTTL time.Duration
+	// SharedCache is an optional cache shared across VCS instances.
+	SharedCache SharedCache
+	Metrics     metricsProvider
+}
+
+type entry struct {
+	docResolution *did.DocResolution
+	err           error
+	expiresAt     time.Time
+}
+
+// Registry is a vdrapi.Registry that caches resolved DID documents.
+type Registry struct {
+	vdrapi.Registry
+
+	entries     *lru.Cache[string, *entry]
+	ttl         time.Duration
+	methodTTL   map[string]time.Duration
+	notFoundTTL time.Duration
+	sharedCache SharedCache
+	metrics     metricsProvider
+	group       singleflight.Group
+	now         func() time.Time
+}
+
+// New returns caching Registry.
+func New(config *Config) (*Registry, error) {
+	size := config.Size
+	if size <= 0 {
+		size = defaultSize
+	}
+
+	entries, err := lru.New[string, *entry](size)
+	if err != nil {
+		return nil, fmt.Errorf("create DID resolution cache: %w", err)
+	}
+
+	ttl := config.TTL
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+
+	return &Registry{
+		Registry:    config.VDR,
+		entries:     entries,
+		ttl:         ttl,
+		methodTTL:   config.MethodTTL,
+		notFoundTTL: config.NotFoundTTL,
+		sharedCache: config.SharedCache,
+		metrics:     config.Metrics,
+		now:         time.Now,
+	}, nil
+}
+
+// Resolve resolves DID document. Resolution options bypass the cache.
+func (r *Registry) Resolve(didID string, opts ...vdrapi.DIDMethodOption) (*did.DocResolution, error) {
+	method := getMethod(didID)
+
+	ttl := r.getTTL(method)
+	if len(opts) > 0 || ttl <= 0 {
+		return r.Registry.Resolve(didID, opts...)
+	}
+
+	if e, ok := r.entries.Get(didID); ok && r.now().Before(e.expiresAt) {
+		r.hit(method)
+
+		return e.docResolution, e.err
+	}
+
+	v, err, _ := r.group.Do(didID, func() (interface{}, error) {
+		return r.resolve(didID, method, ttl)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*did.DocResolution), nil
+}
+
+func (r *Registry) resolve(didID, method string, ttl time.Duration) (*did.DocResolution, error) {
+	ctx := context.Background()
+
+	if e, ok := r.getShared(ctx, didID, method); ok {
+		r.hit(method)
+
+		return e.docResolution, e.err
+	}
+
+	r.miss(method)
+
+	docResolution, err := r.Registry.Resolve(didID)
+	if err != nil {
+		if errors.Is(err, vdrapi.ErrNotFound) && r.notFoundTTL > 0 {
+			r.entries.Add(didID, &entry{err: err, expiresAt: r.now().Add(r.notFoundTTL)})
+			r.setShared(ctx, didID, []byte(notFoundValue), r.notFoundTTL)
+		}
+
+		return nil, err
+	}
+
+	r.entries.Add(didID, &entry{docResolution: docResolution, expiresAt: r.now().Add(ttl)})
+
+	if r.sharedCache != nil {
+		b, marshalErr := docResolution.JSONBytes()
+		if marshalErr != nil {
+			logger.Warn("Failed to marshal DID resolution", log.WithError(marshalErr))
+		} else {
+			r.setShared(ctx, didID, b, ttl)
+		}
+	}
+
+	return docResolution, nil
+}
+
+func (r *Registry) getShared(ctx context.Context, didID, method string) (*entry, bool) {
+	if r.sharedCache == nil {
+		return nil, false
+	}
+
+	b, err := r.sharedCache.Get(ctx, didID)
+	if err != nil {
+		logger.Warn("Failed to get DID resolution from shared cache", log.WithError(err))
+
+		return nil, false
+	}
+
+	if b == nil {
+		return nil, false
+	}
+
+	var e *entry
+
+	// Shared cache doesn't return remaining TTL, so the entry is kept in memory for the full TTL.
+	if string(b) == notFoundValue {
+		e = &entry{
+			err:       fmt.Errorf("resolve %s: %w", didID, vdrapi.ErrNotFound),
+			expiresAt: r.now().Add(r.notFoundTTL),
+		}
+	} else {
+		docResolution, parseErr := did.ParseDocumentResolution(b)
+		if parseErr != nil {
+			logger.Warn("Failed to parse DID resolution from shared cache", log.WithError(parseErr))
+
+			return nil, false
+		}
+
+		e = &entry{
+			docResolution: docResolution,
+			expiresAt:     r.now().Add(r.getTTL(method)),
+		}
+	}
+
+	r.entries.Add(didID, e)
+
+	return e, true
+}
+
+func (r *Registry) setShared(ctx context.Context, didID string, value []byte, ttl time.Duration) {
+	if r.sharedCache == nil {
+		return
+	}
+
+	if err := r.sharedCache.Set(ctx, didID, value, ttl); err != nil {
+		logger.Warn("Failed to store DID resolution in shared cache", log.WithError(err))
+	}
+}
+
+// Update updates DID document and invalidates its cached resolution.
+func (r *Registry) Update(didDoc *did.Doc, opts ...vdrapi.DIDMethodOption) error {
+	if err := r.Registry.Update(didDoc, opts...); err != nil {
+		return err
+	}
+
+	r.Invalidate(didDoc.ID)
+
+	return nil
+}
+
+// Deactivate deactivates DID and invalidates its cached resolution.
+func (r *Registry) Deactivate(didID string, opts ...vdrapi.DIDMethodOption) error {
+	if err := r.Registry.Deactivate(didID, opts...); err != nil {
+		return err
+	}
+
+	r.Invalidate(didID)
+
+	return nil
+}
+
+// Invalidate removes cached resolution of the DID, e.g. after the DID document is changed outside of the registry.
+func (r *Registry) Invalidate(didID string) {
+	r.entries.Remove(didID)
+
+	if r.sharedCache == nil {
+		return
+	}
+
+	if err := r.sharedCache.Delete(context.Background(), didID); err != nil {
+		logger.Warn("Failed to delete DID resolution from shared cache", log.WithError(err))
+	}
+}
+
+func (r *Registry) getTTL(method string) time.Duration {
+	if ttl, ok := r.methodTTL[method]; ok {
+		return ttl
+	}
+
+	return r.ttl
+}
+
+func (r *Registry) hit(method string) {
+	if r.metrics != nil {
+		r.metrics.VDRCacheHit(method)
+	}
+}
+
+func (r *Registry) miss(method string) {
+	if r.metrics != nil {
+		r.metrics.VDRCacheMiss(method)
+	}
+}
+
+// getMethod returns DID method, e.g. "web" for "did:web:example.com".
+func getMethod(didID string) string {
+	parts := strings.SplitN(didID, ":", 3) //nolint:gomnd
+	if len(parts) < 3 || parts[0] != "did" {
+		return ""
+	}
+
+	return parts[1]
+}
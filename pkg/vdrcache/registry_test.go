@@ -0,0 +1,364 @@
+/*
+Copyright Gen Digital Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vdrcache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/trustbloc/did-go/doc/did"
+	vdrapi "github.com/trustbloc/did-go/vdr/api"
+	vdrmock "github.com/trustbloc/did-go/vdr/mock"
+)
+
+const (
+	webDID = "did:web:example.com"
+	keyDID = "did:key:z6MkiTBz1ymuepAQ4HEHYSF1H8quG5GLVVQR3djdX3mDooWp"
+)
+
+func TestRegistry_Resolve(t *testing.T) {
+	t.Run("cached until TTL", func(t *testing.T) {
+		vdr := newCountingVDR()
+
+		metrics := NewMockMetricsProvider(gomock.NewController(t))
+		metrics.EXPECT().VDRCacheMiss("web").Times(2)
+		metrics.EXPECT().VDRCacheHit("web").Times(1)
+
+		r, clock := newTestRegistry(t, &Config{VDR: vdr, TTL: time.Minute, Metrics: metrics})
+
+		docRes, err := r.Resolve(webDID)
+		require.NoError(t, err)
+		require.Equal(t, webDID, docRes.DIDDocument.ID)
+
+		cached, err := r.Resolve(webDID)
+		require.NoError(t, err)
+		require.Same(t, docRes, cached)
+		require.EqualValues(t, 1, vdr.resolves.Load())
+
+		clock.add(time.Minute)
+
+		_, err = r.Resolve(webDID)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, vdr.resolves.Load())
+	})
+
+	t.Run("TTL per DID method", func(t *testing.T) {
+		vdr := newCountingVDR()
+
+		r, clock := newTestRegistry(t, &Config{
+			VDR:       vdr,
+			TTL:       time.Hour,
+			MethodTTL: map[string]time.Duration{"web": time.Minute, "key": 0},
+		})
+
+		for i := 0; i < 2; i++ {
+			_, err := r.Resolve(keyDID)
+			require.NoError(t, err)
+		}
+
+		// Caching is disabled for did:key.
+		require.EqualValues(t, 2, vdr.resolves.Load())
+
+		_, err := r.Resolve(webDID)
+		require.NoError(t, err)
+
+		clock.add(2 * time.Minute)
+
+		_, err = r.Resolve(webDID)
+		require.NoError(t, err)
+		require.EqualValues(t, 4, vdr.resolves.Load())
+	})
+
+	t.Run("resolution options bypass cache", func(t *testing.T) {
+		vdr := newCountingVDR()
+		r, _ := newTestRegistry(t, &Config{VDR: vdr})
+
+		for i := 0; i < 2; i++ {
+			_, err := r.Resolve(webDID, vdrapi.WithOption("key", "value"))
+			require.NoError(t, err)
+		}
+
+		require.EqualValues(t, 2, vdr.resolves.Load())
+	})
+
+	t.Run("negative caching", func(t *testing.T) {
+		vdr := newCountingVDR()
+		vdr.err = fmt.Errorf("did method read failed: %w", vdrapi.ErrNotFound)
+
+		r, clock := newTestRegistry(t, &Config{VDR: vdr, NotFoundTTL: 10 * time.Second})
+
+		for i := 0; i < 2; i++ {
+			_, err := r.Resolve(webDID)
+			require.ErrorIs(t, err, vdrapi.ErrNotFound)
+		}
+
+		require.EqualValues(t, 1, vdr.resolves.Load())
+
+		clock.add(10 * time.Second)
+
+		_, err := r.Resolve(webDID)
+		require.ErrorIs(t, err, vdrapi.ErrNotFound)
+		require.EqualValues(t, 2, vdr.resolves.Load())
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		vdr := newCountingVDR()
+		vdr.err = errors.New("resolve error")
+
+		r, _ := newTestRegistry(t, &Config{VDR: vdr, NotFoundTTL: 10 * time.Second})
+
+		for i := 0; i < 2; i++ {
+			_, err := r.Resolve(webDID)
+			require.ErrorContains(t, err, "resolve error")
+		}
+
+		require.EqualValues(t, 2, vdr.resolves.Load())
+	})
+
+	t.Run("concurrent lookups are de-duplicated", func(t *testing.T) {
+		vdr := newCountingVDR()
+		vdr.release = make(chan struct{})
+
+		r, _ := newTestRegistry(t, &Config{VDR: vdr})
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				docRes, err := r.Resolve(webDID)
+				require.NoError(t, err)
+				require.NotNil(t, docRes)
+			}()
+		}
+
+		time.Sleep(50 * time.Millisecond)
+		close(vdr.release)
+
+		wg.Wait()
+
+		require.EqualValues(t, 1, vdr.resolves.Load())
+	})
+
+	t.Run("shared cache", func(t *testing.T) {
+		vdr := newCountingVDR()
+		shared := NewMockSharedCache(gomock.NewController(t))
+
+		r1, _ := newTestRegistry(t, &Config{VDR: vdr, TTL: time.Minute, SharedCache: shared})
+		r2, _ := newTestRegistry(t, &Config{VDR: vdr, TTL: time.Minute, SharedCache: shared})
+
+		var stored []byte
+
+		shared.EXPECT().Get(gomock.Any(), webDID).Return(nil, nil)
+		shared.EXPECT().Set(gomock.Any(), webDID, gomock.Any(), time.Minute).DoAndReturn(
+			func(_ context.Context, _ string, value []byte, _ time.Duration) error {
+				stored = value
+
+				return nil
+			})
+
+		_, err := r1.Resolve(webDID)
+		require.NoError(t, err)
+
+		shared.EXPECT().Get(gomock.Any(), webDID).DoAndReturn(
+			func(context.Context, string) ([]byte, error) {
+				return stored, nil
+			})
+
+		docRes, err := r2.Resolve(webDID)
+		require.NoError(t, err)
+		require.Equal(t, webDID, docRes.DIDDocument.ID)
+
+		// Second instance keeps resolution in memory.
+		_, err = r2.Resolve(webDID)
+		require.NoError(t, err)
+
+		require.EqualValues(t, 1, vdr.resolves.Load())
+	})
+
+	t.Run("shared cache - not found", func(t *testing.T) {
+		vdr := newCountingVDR()
+		vdr.err = vdrapi.ErrNotFound
+
+		shared := NewMockSharedCache(gomock.NewController(t))
+
+		r1, _ := newTestRegistry(t, &Config{VDR: vdr, NotFoundTTL: time.Minute, SharedCache: shared})
+		r2, _ := newTestRegistry(t, &Config{VDR: vdr, NotFoundTTL: time.Minute, SharedCache: shared})
+
+		shared.EXPECT().Get(gomock.Any(), webDID).Return(nil, nil)
+		shared.EXPECT().Set(gomock.Any(), webDID, []byte(notFoundValue), time.Minute).Return(nil)
+
+		_, err := r1.Resolve(webDID)
+		require.ErrorIs(t, err, vdrapi.ErrNotFound)
+
+		shared.EXPECT().Get(gomock.Any(), webDID).Return([]byte(notFoundValue), nil)
+
+		_, err = r2.Resolve(webDID)
+		require.ErrorIs(t, err, vdrapi.ErrNotFound)
+
+		require.EqualValues(t, 1, vdr.resolves.Load())
+	})
+
+	t.Run("shared cache errors are ignored", func(t *testing.T) {
+		vdr := newCountingVDR()
+		shared := NewMockSharedCache(gomock.NewController(t))
+
+		r, _ := newTestRegistry(t, &Config{VDR: vdr, SharedCache: shared})
+
+		shared.EXPECT().Get(gomock.Any(), webDID).Return(nil, errors.New("get error"))
+		shared.EXPECT().Set(gomock.Any(), webDID, gomock.Any(), gomock.Any()).Return(errors.New("set error"))
+
+		_, err := r.Resolve(webDID)
+		require.NoError(t, err)
+
+		r, _ = newTestRegistry(t, &Config{VDR: vdr, SharedCache: shared})
+
+		shared.EXPECT().Get(gomock.Any(), webDID).Return([]byte("invalid"), nil)
+		shared.EXPECT().Set(gomock.Any(), webDID, gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err = r.Resolve(webDID)
+		require.NoError(t, err)
+
+		require.EqualValues(t, 2, vdr.resolves.Load())
+	})
+}
+
+func TestRegistry_Invalidate(t *testing.T) {
+	t.Run("update", func(t *testing.T) {
+		vdr := newCountingVDR()
+		shared := NewMockSharedCache(gomock.NewController(t))
+		shared.EXPECT().Get(gomock.Any(), webDID).Return(nil, nil).Times(2)
+		shared.EXPECT().Set(gomock.Any(), webDID, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		shared.EXPECT().Delete(gomock.Any(), webDID).Return(nil)
+
+		r, _ := newTestRegistry(t, &Config{VDR: vdr, SharedCache: shared})
+
+		_, err := r.Resolve(webDID)
+		require.NoError(t, err)
+
+		require.NoError(t, r.Update(&did.Doc{ID: webDID}))
+
+		_, err = r.Resolve(webDID)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, vdr.resolves.Load())
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		vdr := newCountingVDR()
+
+		r, _ := newTestRegistry(t, &Config{VDR: vdr})
+
+		_, err := r.Resolve(webDID)
+		require.NoError(t, err)
+
+		r.Invalidate(webDID)
+
+		_, err = r.Resolve(webDID)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, vdr.resolves.Load())
+	})
+
+	t.Run("deactivate", func(t *testing.T) {
+		vdr := newCountingVDR()
+		shared := NewMockSharedCache(gomock.NewController(t))
+		shared.EXPECT().Delete(gomock.Any(), webDID).Return(errors.New("delete error"))
+
+		r, _ := newTestRegistry(t, &Config{VDR: vdr, SharedCache: shared})
+
+		require.NoError(t, r.Deactivate(webDID))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		vdr := newCountingVDR()
+		vdr.UpdateFunc = func(*did.Doc, ...vdrapi.DIDMethodOption) error { return errors.New("update error") }
+		vdr.DeactivateFunc = func(string, ...vdrapi.DIDMethodOption) error { return errors.New("deactivate error") }
+
+		r, _ := newTestRegistry(t, &Config{VDR: vdr})
+
+		require.ErrorContains(t, r.Update(&did.Doc{ID: webDID}), "update error")
+		require.ErrorContains(t, r.Deactivate(webDID), "deactivate error")
+	})
+}
+
+func TestGetMethod(t *testing.T) {
+	require.Equal(t, "web", getMethod(webDID))
+	require.Equal(t, "key", getMethod(keyDID))
+	require.Empty(t, getMethod("did:web"))
+	require.Empty(t, getMethod("https://example.com"))
+}
+
+type countingVDR struct {
+	vdrmock.VDRegistry
+
+	err      error
+	release  chan struct{}
+	resolves atomic.Int32
+}
+
+func newCountingVDR() *countingVDR {
+	v := &countingVDR{}
+
+	v.ResolveFunc = func(didID string, _ ...vdrapi.DIDMethodOption) (*did.DocResolution, error) {
+		v.resolves.Add(1)
+
+		if v.release != nil {
+			<-v.release
+		}
+
+		if v.err != nil {
+			return nil, v.err
+		}
+
+		return &did.DocResolution{
+			Context:     []string{"https://w3id.org/did-resolution/v1"},
+			DIDDocument: &did.Doc{Context: []string{did.ContextV1}, ID: didID},
+		}, nil
+	}
+
+	return v
+}
+
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) get() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *testClock) add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func newTestRegistry(t *testing.T, config *Config) (*Registry, *testClock) {
+	t.Helper()
+
+	r, err := New(config)
+	require.NoError(t, err)
+
+	clock := &testClock{now: time.Now()}
+	r.now = clock.get
+
+	return r, clock
+}